@@ -22,7 +22,39 @@ import (
 // HandlerOptions are the options required for constructing a default SDK AnteHandler.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	CircuitKeeper circuitante.CircuitBreaker
+	CircuitKeeper    circuitante.CircuitBreaker
+	GasSponsorKeeper GasSponsorKeeper
+}
+
+// GasSponsorKeeper defines the script keeper methods used to let scripts pay the fees
+// of transactions that call them
+type GasSponsorKeeper interface {
+	// GetGasSponsor returns the script that sponsors a transaction from user, without modifying state
+	GetGasSponsor(ctx context.Context, user sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (sdk.AccAddress, bool)
+	// UseGasSponsorship charges a sponsored fee to the sponsorship of a script
+	UseGasSponsorship(ctx context.Context, sponsor, user sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// gasSponsorFeegrantKeeper adapts a GasSponsorKeeper to the fee grant keeper used by the
+// standard fee deduction, so sponsored fees are deducted from the script account
+type gasSponsorFeegrantKeeper struct {
+	sk GasSponsorKeeper
+}
+
+// UseGrantedFees implements ante.FeegrantKeeper
+func (k gasSponsorFeegrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	return k.sk.UseGasSponsorship(ctx, granter, grantee, fee, msgs)
+}
+
+// sponsoredFeeTx wraps a FeeTx and reports the sponsoring script as the fee granter
+type sponsoredFeeTx struct {
+	sdk.FeeTx
+	sponsor sdk.AccAddress
+}
+
+// FeeGranter implements sdk.FeeTx
+func (tx sponsoredFeeTx) FeeGranter() []byte {
+	return tx.sponsor
 }
 
 // AutoCreateAccountKeeper wraps an AccountKeeper and automatically creates accounts
@@ -120,16 +152,25 @@ func (acak *AutoCreateAccountKeeper) ResetNewlyCreatedTracking() {
 }
 
 // ConditionalDeductFeeDecorator wraps the standard DeductFeeDecorator
-// and only applies fee deduction when fees are non-zero
+// and only applies fee deduction when fees are non-zero. Fees of transactions
+// that only call a script sponsoring them are deducted from the script account.
 type ConditionalDeductFeeDecorator struct {
-	deductFeeDecorator ante.DeductFeeDecorator
+	deductFeeDecorator          ante.DeductFeeDecorator
+	sponsoredDeductFeeDecorator ante.DeductFeeDecorator
+	gasSponsorKeeper            GasSponsorKeeper
 }
 
-// NewConditionalDeductFeeDecorator creates a new ConditionalDeductFeeDecorator
-func NewConditionalDeductFeeDecorator(ak ante.AccountKeeper, bk authtypes.BankKeeper, fk ante.FeegrantKeeper, tfc ante.TxFeeChecker) ConditionalDeductFeeDecorator {
-	return ConditionalDeductFeeDecorator{
+// NewConditionalDeductFeeDecorator creates a new ConditionalDeductFeeDecorator.
+// The gas sponsor keeper is optional.
+func NewConditionalDeductFeeDecorator(ak ante.AccountKeeper, bk authtypes.BankKeeper, fk ante.FeegrantKeeper, tfc ante.TxFeeChecker, sk GasSponsorKeeper) ConditionalDeductFeeDecorator {
+	cfdd := ConditionalDeductFeeDecorator{
 		deductFeeDecorator: ante.NewDeductFeeDecorator(ak, bk, fk, tfc),
+		gasSponsorKeeper:   sk,
+	}
+	if sk != nil {
+		cfdd.sponsoredDeductFeeDecorator = ante.NewDeductFeeDecorator(ak, bk, gasSponsorFeegrantKeeper{sk: sk}, tfc)
 	}
+	return cfdd
 }
 
 // AnteHandle implements the AnteDecorator interface
@@ -144,6 +185,16 @@ func (cfdd ConditionalDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx,
 		return next(ctx, tx, simulate)
 	}
 
+	// Without an explicit fee granter, a script sponsoring every message pays the fee
+	if cfdd.gasSponsorKeeper != nil && len(feeTx.FeeGranter()) == 0 {
+		if sponsor, ok := cfdd.gasSponsorKeeper.GetGasSponsor(ctx, feeTx.FeePayer(), feeTx.GetFee(), tx.GetMsgs()); ok {
+			sponsoredTx := sponsoredFeeTx{FeeTx: feeTx, sponsor: sponsor}
+			return cfdd.sponsoredDeductFeeDecorator.AnteHandle(ctx, sponsoredTx, simulate, func(ctx sdk.Context, _ sdk.Tx, simulate bool) (sdk.Context, error) {
+				return next(ctx, tx, simulate)
+			})
+		}
+	}
+
 	// If fees are non-zero, apply the standard fee deduction logic
 	return cfdd.deductFeeDecorator.AnteHandle(ctx, tx, simulate, next)
 }
@@ -463,7 +514,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewConditionalDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
+		NewConditionalDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker, options.GasSponsorKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		NewConditionalSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler, options.SigVerifyOptions...), // Use conditional wrapper
@@ -121,22 +121,195 @@ func (x *_Task_9_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_Task_20_list)(nil)
+
+type _Task_20_list struct {
+	list *[]*TaskRun
+}
+
+func (x *_Task_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Task_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Task_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TaskRun)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Task_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TaskRun)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Task_20_list) AppendMutable() protoreflect.Value {
+	v := new(TaskRun)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Task_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Task_20_list) NewElement() protoreflect.Value {
+	v := new(TaskRun)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Task_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Task_24_list)(nil)
+
+type _Task_24_list struct {
+	list *[]*TaskDependency
+}
+
+func (x *_Task_24_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Task_24_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Task_24_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TaskDependency)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Task_24_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TaskDependency)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Task_24_list) AppendMutable() protoreflect.Value {
+	v := new(TaskDependency)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Task_24_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Task_24_list) NewElement() protoreflect.Value {
+	v := new(TaskDependency)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Task_24_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Task_32_list)(nil)
+
+type _Task_32_list struct {
+	list *[]*TaskAttempt
+}
+
+func (x *_Task_32_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Task_32_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Task_32_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TaskAttempt)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Task_32_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*TaskAttempt)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Task_32_list) AppendMutable() protoreflect.Value {
+	v := new(TaskAttempt)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Task_32_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Task_32_list) NewElement() protoreflect.Value {
+	v := new(TaskAttempt)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Task_32_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Task                     protoreflect.MessageDescriptor
-	fd_Task_task_id             protoreflect.FieldDescriptor
-	fd_Task_creator             protoreflect.FieldDescriptor
-	fd_Task_scheduled_timestamp protoreflect.FieldDescriptor
-	fd_Task_expiry_timestamp    protoreflect.FieldDescriptor
-	fd_Task_task_gas_limit      protoreflect.FieldDescriptor
-	fd_Task_task_gas_price      protoreflect.FieldDescriptor
-	fd_Task_task_gas_fee        protoreflect.FieldDescriptor
-	fd_Task_msgs                protoreflect.FieldDescriptor
-	fd_Task_msg_results         protoreflect.FieldDescriptor
-	fd_Task_status              protoreflect.FieldDescriptor
-	fd_Task_creation_time       protoreflect.FieldDescriptor
-	fd_Task_error_log           protoreflect.FieldDescriptor
-	fd_Task_task_gas_consumed   protoreflect.FieldDescriptor
-	fd_Task_execution_timestamp protoreflect.FieldDescriptor
+	md_Task                        protoreflect.MessageDescriptor
+	fd_Task_task_id                protoreflect.FieldDescriptor
+	fd_Task_creator                protoreflect.FieldDescriptor
+	fd_Task_scheduled_timestamp    protoreflect.FieldDescriptor
+	fd_Task_expiry_timestamp       protoreflect.FieldDescriptor
+	fd_Task_task_gas_limit         protoreflect.FieldDescriptor
+	fd_Task_task_gas_price         protoreflect.FieldDescriptor
+	fd_Task_task_gas_fee           protoreflect.FieldDescriptor
+	fd_Task_msgs                   protoreflect.FieldDescriptor
+	fd_Task_msg_results            protoreflect.FieldDescriptor
+	fd_Task_status                 protoreflect.FieldDescriptor
+	fd_Task_creation_time          protoreflect.FieldDescriptor
+	fd_Task_error_log              protoreflect.FieldDescriptor
+	fd_Task_task_gas_consumed      protoreflect.FieldDescriptor
+	fd_Task_execution_timestamp    protoreflect.FieldDescriptor
+	fd_Task_cron_expression        protoreflect.FieldDescriptor
+	fd_Task_interval_seconds       protoreflect.FieldDescriptor
+	fd_Task_max_occurrences        protoreflect.FieldDescriptor
+	fd_Task_end_timestamp          protoreflect.FieldDescriptor
+	fd_Task_occurrences            protoreflect.FieldDescriptor
+	fd_Task_run_history            protoreflect.FieldDescriptor
+	fd_Task_escrow                 protoreflect.FieldDescriptor
+	fd_Task_condition              protoreflect.FieldDescriptor
+	fd_Task_condition_checks       protoreflect.FieldDescriptor
+	fd_Task_dependencies           protoreflect.FieldDescriptor
+	fd_Task_workflow_id            protoreflect.FieldDescriptor
+	fd_Task_deferrals              protoreflect.FieldDescriptor
+	fd_Task_execution_phase        protoreflect.FieldDescriptor
+	fd_Task_scheduled_height       protoreflect.FieldDescriptor
+	fd_Task_execution_height       protoreflect.FieldDescriptor
+	fd_Task_retry_policy           protoreflect.FieldDescriptor
+	fd_Task_attempts               protoreflect.FieldDescriptor
+	fd_Task_attempt_log            protoreflect.FieldDescriptor
+	fd_Task_next_attempt_timestamp protoreflect.FieldDescriptor
+	fd_Task_callback               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -156,6 +329,26 @@ func init() {
 	fd_Task_error_log = md_Task.Fields().ByName("error_log")
 	fd_Task_task_gas_consumed = md_Task.Fields().ByName("task_gas_consumed")
 	fd_Task_execution_timestamp = md_Task.Fields().ByName("execution_timestamp")
+	fd_Task_cron_expression = md_Task.Fields().ByName("cron_expression")
+	fd_Task_interval_seconds = md_Task.Fields().ByName("interval_seconds")
+	fd_Task_max_occurrences = md_Task.Fields().ByName("max_occurrences")
+	fd_Task_end_timestamp = md_Task.Fields().ByName("end_timestamp")
+	fd_Task_occurrences = md_Task.Fields().ByName("occurrences")
+	fd_Task_run_history = md_Task.Fields().ByName("run_history")
+	fd_Task_escrow = md_Task.Fields().ByName("escrow")
+	fd_Task_condition = md_Task.Fields().ByName("condition")
+	fd_Task_condition_checks = md_Task.Fields().ByName("condition_checks")
+	fd_Task_dependencies = md_Task.Fields().ByName("dependencies")
+	fd_Task_workflow_id = md_Task.Fields().ByName("workflow_id")
+	fd_Task_deferrals = md_Task.Fields().ByName("deferrals")
+	fd_Task_execution_phase = md_Task.Fields().ByName("execution_phase")
+	fd_Task_scheduled_height = md_Task.Fields().ByName("scheduled_height")
+	fd_Task_execution_height = md_Task.Fields().ByName("execution_height")
+	fd_Task_retry_policy = md_Task.Fields().ByName("retry_policy")
+	fd_Task_attempts = md_Task.Fields().ByName("attempts")
+	fd_Task_attempt_log = md_Task.Fields().ByName("attempt_log")
+	fd_Task_next_attempt_timestamp = md_Task.Fields().ByName("next_attempt_timestamp")
+	fd_Task_callback = md_Task.Fields().ByName("callback")
 }
 
 var _ protoreflect.Message = (*fastReflection_Task)(nil)
@@ -307,6 +500,126 @@ func (x *fastReflection_Task) Range(f func(protoreflect.FieldDescriptor, protore
 			return
 		}
 	}
+	if x.CronExpression != "" {
+		value := protoreflect.ValueOfString(x.CronExpression)
+		if !f(fd_Task_cron_expression, value) {
+			return
+		}
+	}
+	if x.IntervalSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.IntervalSeconds)
+		if !f(fd_Task_interval_seconds, value) {
+			return
+		}
+	}
+	if x.MaxOccurrences != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxOccurrences)
+		if !f(fd_Task_max_occurrences, value) {
+			return
+		}
+	}
+	if x.EndTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndTimestamp)
+		if !f(fd_Task_end_timestamp, value) {
+			return
+		}
+	}
+	if x.Occurrences != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Occurrences)
+		if !f(fd_Task_occurrences, value) {
+			return
+		}
+	}
+	if len(x.RunHistory) != 0 {
+		value := protoreflect.ValueOfList(&_Task_20_list{list: &x.RunHistory})
+		if !f(fd_Task_run_history, value) {
+			return
+		}
+	}
+	if x.Escrow != nil {
+		value := protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+		if !f(fd_Task_escrow, value) {
+			return
+		}
+	}
+	if x.Condition != nil {
+		value := protoreflect.ValueOfMessage(x.Condition.ProtoReflect())
+		if !f(fd_Task_condition, value) {
+			return
+		}
+	}
+	if x.ConditionChecks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ConditionChecks)
+		if !f(fd_Task_condition_checks, value) {
+			return
+		}
+	}
+	if len(x.Dependencies) != 0 {
+		value := protoreflect.ValueOfList(&_Task_24_list{list: &x.Dependencies})
+		if !f(fd_Task_dependencies, value) {
+			return
+		}
+	}
+	if x.WorkflowId != "" {
+		value := protoreflect.ValueOfString(x.WorkflowId)
+		if !f(fd_Task_workflow_id, value) {
+			return
+		}
+	}
+	if x.Deferrals != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Deferrals)
+		if !f(fd_Task_deferrals, value) {
+			return
+		}
+	}
+	if x.ExecutionPhase != "" {
+		value := protoreflect.ValueOfString(x.ExecutionPhase)
+		if !f(fd_Task_execution_phase, value) {
+			return
+		}
+	}
+	if x.ScheduledHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ScheduledHeight)
+		if !f(fd_Task_scheduled_height, value) {
+			return
+		}
+	}
+	if x.ExecutionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionHeight)
+		if !f(fd_Task_execution_height, value) {
+			return
+		}
+	}
+	if x.RetryPolicy != nil {
+		value := protoreflect.ValueOfMessage(x.RetryPolicy.ProtoReflect())
+		if !f(fd_Task_retry_policy, value) {
+			return
+		}
+	}
+	if x.Attempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempts)
+		if !f(fd_Task_attempts, value) {
+			return
+		}
+	}
+	if len(x.AttemptLog) != 0 {
+		value := protoreflect.ValueOfList(&_Task_32_list{list: &x.AttemptLog})
+		if !f(fd_Task_attempt_log, value) {
+			return
+		}
+	}
+	if x.NextAttemptTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextAttemptTimestamp)
+		if !f(fd_Task_next_attempt_timestamp, value) {
+			return
+		}
+	}
+	if x.Callback != nil {
+		value := protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
+		if !f(fd_Task_callback, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -350,6 +663,46 @@ func (x *fastReflection_Task) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.TaskGasConsumed != uint64(0)
 	case "dysonprotocol.crontask.v1.Task.execution_timestamp":
 		return x.ExecutionTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.Task.cron_expression":
+		return x.CronExpression != ""
+	case "dysonprotocol.crontask.v1.Task.interval_seconds":
+		return x.IntervalSeconds != int64(0)
+	case "dysonprotocol.crontask.v1.Task.max_occurrences":
+		return x.MaxOccurrences != uint64(0)
+	case "dysonprotocol.crontask.v1.Task.end_timestamp":
+		return x.EndTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.Task.occurrences":
+		return x.Occurrences != uint64(0)
+	case "dysonprotocol.crontask.v1.Task.run_history":
+		return len(x.RunHistory) != 0
+	case "dysonprotocol.crontask.v1.Task.escrow":
+		return x.Escrow != nil
+	case "dysonprotocol.crontask.v1.Task.condition":
+		return x.Condition != nil
+	case "dysonprotocol.crontask.v1.Task.condition_checks":
+		return x.ConditionChecks != uint64(0)
+	case "dysonprotocol.crontask.v1.Task.dependencies":
+		return len(x.Dependencies) != 0
+	case "dysonprotocol.crontask.v1.Task.workflow_id":
+		return x.WorkflowId != ""
+	case "dysonprotocol.crontask.v1.Task.deferrals":
+		return x.Deferrals != uint64(0)
+	case "dysonprotocol.crontask.v1.Task.execution_phase":
+		return x.ExecutionPhase != ""
+	case "dysonprotocol.crontask.v1.Task.scheduled_height":
+		return x.ScheduledHeight != int64(0)
+	case "dysonprotocol.crontask.v1.Task.execution_height":
+		return x.ExecutionHeight != int64(0)
+	case "dysonprotocol.crontask.v1.Task.retry_policy":
+		return x.RetryPolicy != nil
+	case "dysonprotocol.crontask.v1.Task.attempts":
+		return x.Attempts != uint32(0)
+	case "dysonprotocol.crontask.v1.Task.attempt_log":
+		return len(x.AttemptLog) != 0
+	case "dysonprotocol.crontask.v1.Task.next_attempt_timestamp":
+		return x.NextAttemptTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.Task.callback":
+		return x.Callback != nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Task"))
@@ -394,6 +747,46 @@ func (x *fastReflection_Task) Clear(fd protoreflect.FieldDescriptor) {
 		x.TaskGasConsumed = uint64(0)
 	case "dysonprotocol.crontask.v1.Task.execution_timestamp":
 		x.ExecutionTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.Task.cron_expression":
+		x.CronExpression = ""
+	case "dysonprotocol.crontask.v1.Task.interval_seconds":
+		x.IntervalSeconds = int64(0)
+	case "dysonprotocol.crontask.v1.Task.max_occurrences":
+		x.MaxOccurrences = uint64(0)
+	case "dysonprotocol.crontask.v1.Task.end_timestamp":
+		x.EndTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.Task.occurrences":
+		x.Occurrences = uint64(0)
+	case "dysonprotocol.crontask.v1.Task.run_history":
+		x.RunHistory = nil
+	case "dysonprotocol.crontask.v1.Task.escrow":
+		x.Escrow = nil
+	case "dysonprotocol.crontask.v1.Task.condition":
+		x.Condition = nil
+	case "dysonprotocol.crontask.v1.Task.condition_checks":
+		x.ConditionChecks = uint64(0)
+	case "dysonprotocol.crontask.v1.Task.dependencies":
+		x.Dependencies = nil
+	case "dysonprotocol.crontask.v1.Task.workflow_id":
+		x.WorkflowId = ""
+	case "dysonprotocol.crontask.v1.Task.deferrals":
+		x.Deferrals = uint64(0)
+	case "dysonprotocol.crontask.v1.Task.execution_phase":
+		x.ExecutionPhase = ""
+	case "dysonprotocol.crontask.v1.Task.scheduled_height":
+		x.ScheduledHeight = int64(0)
+	case "dysonprotocol.crontask.v1.Task.execution_height":
+		x.ExecutionHeight = int64(0)
+	case "dysonprotocol.crontask.v1.Task.retry_policy":
+		x.RetryPolicy = nil
+	case "dysonprotocol.crontask.v1.Task.attempts":
+		x.Attempts = uint32(0)
+	case "dysonprotocol.crontask.v1.Task.attempt_log":
+		x.AttemptLog = nil
+	case "dysonprotocol.crontask.v1.Task.next_attempt_timestamp":
+		x.NextAttemptTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.Task.callback":
+		x.Callback = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Task"))
@@ -458,6 +851,75 @@ func (x *fastReflection_Task) Get(descriptor protoreflect.FieldDescriptor) proto
 	case "dysonprotocol.crontask.v1.Task.execution_timestamp":
 		value := x.ExecutionTimestamp
 		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Task.cron_expression":
+		value := x.CronExpression
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.Task.interval_seconds":
+		value := x.IntervalSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Task.max_occurrences":
+		value := x.MaxOccurrences
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.Task.end_timestamp":
+		value := x.EndTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Task.occurrences":
+		value := x.Occurrences
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.Task.run_history":
+		if len(x.RunHistory) == 0 {
+			return protoreflect.ValueOfList(&_Task_20_list{})
+		}
+		listValue := &_Task_20_list{list: &x.RunHistory}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.Task.escrow":
+		value := x.Escrow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.condition":
+		value := x.Condition
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.condition_checks":
+		value := x.ConditionChecks
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.Task.dependencies":
+		if len(x.Dependencies) == 0 {
+			return protoreflect.ValueOfList(&_Task_24_list{})
+		}
+		listValue := &_Task_24_list{list: &x.Dependencies}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.Task.workflow_id":
+		value := x.WorkflowId
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.Task.deferrals":
+		value := x.Deferrals
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.Task.execution_phase":
+		value := x.ExecutionPhase
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.Task.scheduled_height":
+		value := x.ScheduledHeight
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Task.execution_height":
+		value := x.ExecutionHeight
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Task.retry_policy":
+		value := x.RetryPolicy
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.attempts":
+		value := x.Attempts
+		return protoreflect.ValueOfUint32(value)
+	case "dysonprotocol.crontask.v1.Task.attempt_log":
+		if len(x.AttemptLog) == 0 {
+			return protoreflect.ValueOfList(&_Task_32_list{})
+		}
+		listValue := &_Task_32_list{list: &x.AttemptLog}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.Task.next_attempt_timestamp":
+		value := x.NextAttemptTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Task.callback":
+		value := x.Callback
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Task"))
@@ -510,6 +972,52 @@ func (x *fastReflection_Task) Set(fd protoreflect.FieldDescriptor, value protore
 		x.TaskGasConsumed = value.Uint()
 	case "dysonprotocol.crontask.v1.Task.execution_timestamp":
 		x.ExecutionTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.Task.cron_expression":
+		x.CronExpression = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.Task.interval_seconds":
+		x.IntervalSeconds = value.Int()
+	case "dysonprotocol.crontask.v1.Task.max_occurrences":
+		x.MaxOccurrences = value.Uint()
+	case "dysonprotocol.crontask.v1.Task.end_timestamp":
+		x.EndTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.Task.occurrences":
+		x.Occurrences = value.Uint()
+	case "dysonprotocol.crontask.v1.Task.run_history":
+		lv := value.List()
+		clv := lv.(*_Task_20_list)
+		x.RunHistory = *clv.list
+	case "dysonprotocol.crontask.v1.Task.escrow":
+		x.Escrow = value.Message().Interface().(*v1beta1.Coin)
+	case "dysonprotocol.crontask.v1.Task.condition":
+		x.Condition = value.Message().Interface().(*TaskCondition)
+	case "dysonprotocol.crontask.v1.Task.condition_checks":
+		x.ConditionChecks = value.Uint()
+	case "dysonprotocol.crontask.v1.Task.dependencies":
+		lv := value.List()
+		clv := lv.(*_Task_24_list)
+		x.Dependencies = *clv.list
+	case "dysonprotocol.crontask.v1.Task.workflow_id":
+		x.WorkflowId = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.Task.deferrals":
+		x.Deferrals = value.Uint()
+	case "dysonprotocol.crontask.v1.Task.execution_phase":
+		x.ExecutionPhase = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.Task.scheduled_height":
+		x.ScheduledHeight = value.Int()
+	case "dysonprotocol.crontask.v1.Task.execution_height":
+		x.ExecutionHeight = value.Int()
+	case "dysonprotocol.crontask.v1.Task.retry_policy":
+		x.RetryPolicy = value.Message().Interface().(*RetryPolicy)
+	case "dysonprotocol.crontask.v1.Task.attempts":
+		x.Attempts = uint32(value.Uint())
+	case "dysonprotocol.crontask.v1.Task.attempt_log":
+		lv := value.List()
+		clv := lv.(*_Task_32_list)
+		x.AttemptLog = *clv.list
+	case "dysonprotocol.crontask.v1.Task.next_attempt_timestamp":
+		x.NextAttemptTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.Task.callback":
+		x.Callback = value.Message().Interface().(*TaskCallback)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Task"))
@@ -552,6 +1060,44 @@ func (x *fastReflection_Task) Mutable(fd protoreflect.FieldDescriptor) protorefl
 		}
 		value := &_Task_9_list{list: &x.MsgResults}
 		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.Task.run_history":
+		if x.RunHistory == nil {
+			x.RunHistory = []*TaskRun{}
+		}
+		value := &_Task_20_list{list: &x.RunHistory}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.Task.escrow":
+		if x.Escrow == nil {
+			x.Escrow = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Escrow.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.condition":
+		if x.Condition == nil {
+			x.Condition = new(TaskCondition)
+		}
+		return protoreflect.ValueOfMessage(x.Condition.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.dependencies":
+		if x.Dependencies == nil {
+			x.Dependencies = []*TaskDependency{}
+		}
+		value := &_Task_24_list{list: &x.Dependencies}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.Task.retry_policy":
+		if x.RetryPolicy == nil {
+			x.RetryPolicy = new(RetryPolicy)
+		}
+		return protoreflect.ValueOfMessage(x.RetryPolicy.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.attempt_log":
+		if x.AttemptLog == nil {
+			x.AttemptLog = []*TaskAttempt{}
+		}
+		value := &_Task_32_list{list: &x.AttemptLog}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.Task.callback":
+		if x.Callback == nil {
+			x.Callback = new(TaskCallback)
+		}
+		return protoreflect.ValueOfMessage(x.Callback.ProtoReflect())
 	case "dysonprotocol.crontask.v1.Task.task_id":
 		panic(fmt.Errorf("field task_id of message dysonprotocol.crontask.v1.Task is not mutable"))
 	case "dysonprotocol.crontask.v1.Task.creator":
@@ -572,6 +1118,32 @@ func (x *fastReflection_Task) Mutable(fd protoreflect.FieldDescriptor) protorefl
 		panic(fmt.Errorf("field task_gas_consumed of message dysonprotocol.crontask.v1.Task is not mutable"))
 	case "dysonprotocol.crontask.v1.Task.execution_timestamp":
 		panic(fmt.Errorf("field execution_timestamp of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.cron_expression":
+		panic(fmt.Errorf("field cron_expression of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.interval_seconds":
+		panic(fmt.Errorf("field interval_seconds of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.max_occurrences":
+		panic(fmt.Errorf("field max_occurrences of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.end_timestamp":
+		panic(fmt.Errorf("field end_timestamp of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.occurrences":
+		panic(fmt.Errorf("field occurrences of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.condition_checks":
+		panic(fmt.Errorf("field condition_checks of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.workflow_id":
+		panic(fmt.Errorf("field workflow_id of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.deferrals":
+		panic(fmt.Errorf("field deferrals of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.execution_phase":
+		panic(fmt.Errorf("field execution_phase of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.scheduled_height":
+		panic(fmt.Errorf("field scheduled_height of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.execution_height":
+		panic(fmt.Errorf("field execution_height of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.attempts":
+		panic(fmt.Errorf("field attempts of message dysonprotocol.crontask.v1.Task is not mutable"))
+	case "dysonprotocol.crontask.v1.Task.next_attempt_timestamp":
+		panic(fmt.Errorf("field next_attempt_timestamp of message dysonprotocol.crontask.v1.Task is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Task"))
@@ -617,6 +1189,53 @@ func (x *fastReflection_Task) NewField(fd protoreflect.FieldDescriptor) protoref
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "dysonprotocol.crontask.v1.Task.execution_timestamp":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Task.cron_expression":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.Task.interval_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Task.max_occurrences":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.Task.end_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Task.occurrences":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.Task.run_history":
+		list := []*TaskRun{}
+		return protoreflect.ValueOfList(&_Task_20_list{list: &list})
+	case "dysonprotocol.crontask.v1.Task.escrow":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.condition":
+		m := new(TaskCondition)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.condition_checks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.Task.dependencies":
+		list := []*TaskDependency{}
+		return protoreflect.ValueOfList(&_Task_24_list{list: &list})
+	case "dysonprotocol.crontask.v1.Task.workflow_id":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.Task.deferrals":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.Task.execution_phase":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.Task.scheduled_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Task.execution_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Task.retry_policy":
+		m := new(RetryPolicy)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.Task.attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dysonprotocol.crontask.v1.Task.attempt_log":
+		list := []*TaskAttempt{}
+		return protoreflect.ValueOfList(&_Task_32_list{list: &list})
+	case "dysonprotocol.crontask.v1.Task.next_attempt_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Task.callback":
+		m := new(TaskCallback)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Task"))
@@ -739,6 +1358,82 @@ func (x *fastReflection_Task) ProtoMethods() *protoiface.Methods {
 		if x.ExecutionTimestamp != 0 {
 			n += 1 + runtime.Sov(uint64(x.ExecutionTimestamp))
 		}
+		l = len(x.CronExpression)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IntervalSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.IntervalSeconds))
+		}
+		if x.MaxOccurrences != 0 {
+			n += 2 + runtime.Sov(uint64(x.MaxOccurrences))
+		}
+		if x.EndTimestamp != 0 {
+			n += 2 + runtime.Sov(uint64(x.EndTimestamp))
+		}
+		if x.Occurrences != 0 {
+			n += 2 + runtime.Sov(uint64(x.Occurrences))
+		}
+		if len(x.RunHistory) > 0 {
+			for _, e := range x.RunHistory {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Escrow != nil {
+			l = options.Size(x.Escrow)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Condition != nil {
+			l = options.Size(x.Condition)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ConditionChecks != 0 {
+			n += 2 + runtime.Sov(uint64(x.ConditionChecks))
+		}
+		if len(x.Dependencies) > 0 {
+			for _, e := range x.Dependencies {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.WorkflowId)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Deferrals != 0 {
+			n += 2 + runtime.Sov(uint64(x.Deferrals))
+		}
+		l = len(x.ExecutionPhase)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.ScheduledHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.ScheduledHeight))
+		}
+		if x.ExecutionHeight != 0 {
+			n += 2 + runtime.Sov(uint64(x.ExecutionHeight))
+		}
+		if x.RetryPolicy != nil {
+			l = options.Size(x.RetryPolicy)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Attempts != 0 {
+			n += 2 + runtime.Sov(uint64(x.Attempts))
+		}
+		if len(x.AttemptLog) > 0 {
+			for _, e := range x.AttemptLog {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextAttemptTimestamp != 0 {
+			n += 2 + runtime.Sov(uint64(x.NextAttemptTimestamp))
+		}
+		if x.Callback != nil {
+			l = options.Size(x.Callback)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -768,12 +1463,225 @@ func (x *fastReflection_Task) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.ExecutionTimestamp != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionTimestamp))
-			i--
-			dAtA[i] = 0x70
-		}
-		if x.TaskGasConsumed != 0 {
+		if x.Callback != nil {
+			encoded, err := options.Marshal(x.Callback)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x92
+		}
+		if x.NextAttemptTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextAttemptTimestamp))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.AttemptLog) > 0 {
+			for iNdEx := len(x.AttemptLog) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AttemptLog[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if x.Attempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempts))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf8
+		}
+		if x.RetryPolicy != nil {
+			encoded, err := options.Marshal(x.RetryPolicy)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+		if x.ExecutionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe8
+		}
+		if x.ScheduledHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduledHeight))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe0
+		}
+		if len(x.ExecutionPhase) > 0 {
+			i -= len(x.ExecutionPhase)
+			copy(dAtA[i:], x.ExecutionPhase)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecutionPhase)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+		if x.Deferrals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Deferrals))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.WorkflowId) > 0 {
+			i -= len(x.WorkflowId)
+			copy(dAtA[i:], x.WorkflowId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WorkflowId)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if len(x.Dependencies) > 0 {
+			for iNdEx := len(x.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Dependencies[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xc2
+			}
+		}
+		if x.ConditionChecks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ConditionChecks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.Condition != nil {
+			encoded, err := options.Marshal(x.Condition)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+		if x.Escrow != nil {
+			encoded, err := options.Marshal(x.Escrow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.RunHistory) > 0 {
+			for iNdEx := len(x.RunHistory) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RunHistory[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if x.Occurrences != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Occurrences))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.EndTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndTimestamp))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if x.MaxOccurrences != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxOccurrences))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.IntervalSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalSeconds))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if len(x.CronExpression) > 0 {
+			i -= len(x.CronExpression)
+			copy(dAtA[i:], x.CronExpression)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CronExpression)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.ExecutionTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionTimestamp))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.TaskGasConsumed != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.TaskGasConsumed))
 			i--
 			dAtA[i] = 0x68
@@ -1302,117 +2210,6914 @@ func (x *fastReflection_Task) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.CronExpression = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_Params                    protoreflect.MessageDescriptor
-	fd_Params_block_gas_limit    protoreflect.FieldDescriptor
-	fd_Params_expiry_limit       protoreflect.FieldDescriptor
-	fd_Params_max_scheduled_time protoreflect.FieldDescriptor
-	fd_Params_clean_up_time      protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_dysonprotocol_crontask_v1_crontask_proto_init()
-	md_Params = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("Params")
-	fd_Params_block_gas_limit = md_Params.Fields().ByName("block_gas_limit")
-	fd_Params_expiry_limit = md_Params.Fields().ByName("expiry_limit")
-	fd_Params_max_scheduled_time = md_Params.Fields().ByName("max_scheduled_time")
-	fd_Params_clean_up_time = md_Params.Fields().ByName("clean_up_time")
-}
-
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
-
-type fastReflection_Params Params
-
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
-}
-
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
-
-type fastReflection_Params_messageType struct{}
-
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
-}
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
-}
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
-}
-
+				x.IntervalSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxOccurrences", wireType)
+				}
+				x.MaxOccurrences = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxOccurrences |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+				}
+				x.EndTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Occurrences", wireType)
+				}
+				x.Occurrences = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Occurrences |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RunHistory", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RunHistory = append(x.RunHistory, &TaskRun{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RunHistory[len(x.RunHistory)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Escrow == nil {
+					x.Escrow = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Condition == nil {
+					x.Condition = &TaskCondition{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Condition); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ConditionChecks", wireType)
+				}
+				x.ConditionChecks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ConditionChecks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 24:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Dependencies = append(x.Dependencies, &TaskDependency{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Dependencies[len(x.Dependencies)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WorkflowId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deferrals", wireType)
+				}
+				x.Deferrals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Deferrals |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 27:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionPhase", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionPhase = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 28:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+				}
+				x.ScheduledHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduledHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 29:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+				}
+				x.ExecutionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 30:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RetryPolicy == nil {
+					x.RetryPolicy = &RetryPolicy{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RetryPolicy); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 31:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+				}
+				x.Attempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 32:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AttemptLog", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AttemptLog = append(x.AttemptLog, &TaskAttempt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AttemptLog[len(x.AttemptLog)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 33:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextAttemptTimestamp", wireType)
+				}
+				x.NextAttemptTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextAttemptTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 34:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Callback == nil {
+					x.Callback = &TaskCallback{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Callback); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TaskCallback                protoreflect.MessageDescriptor
+	fd_TaskCallback_script_address protoreflect.FieldDescriptor
+	fd_TaskCallback_function_name  protoreflect.FieldDescriptor
+	fd_TaskCallback_gas_limit      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_TaskCallback = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("TaskCallback")
+	fd_TaskCallback_script_address = md_TaskCallback.Fields().ByName("script_address")
+	fd_TaskCallback_function_name = md_TaskCallback.Fields().ByName("function_name")
+	fd_TaskCallback_gas_limit = md_TaskCallback.Fields().ByName("gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_TaskCallback)(nil)
+
+type fastReflection_TaskCallback TaskCallback
+
+func (x *TaskCallback) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TaskCallback)(x)
+}
+
+func (x *TaskCallback) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TaskCallback_messageType fastReflection_TaskCallback_messageType
+var _ protoreflect.MessageType = fastReflection_TaskCallback_messageType{}
+
+type fastReflection_TaskCallback_messageType struct{}
+
+func (x fastReflection_TaskCallback_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TaskCallback)(nil)
+}
+func (x fastReflection_TaskCallback_messageType) New() protoreflect.Message {
+	return new(fastReflection_TaskCallback)
+}
+func (x fastReflection_TaskCallback_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskCallback
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TaskCallback) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskCallback
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TaskCallback) Type() protoreflect.MessageType {
+	return _fastReflection_TaskCallback_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TaskCallback) New() protoreflect.Message {
+	return new(fastReflection_TaskCallback)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TaskCallback) Interface() protoreflect.ProtoMessage {
+	return (*TaskCallback)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TaskCallback) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScriptAddress != "" {
+		value := protoreflect.ValueOfString(x.ScriptAddress)
+		if !f(fd_TaskCallback_script_address, value) {
+			return
+		}
+	}
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_TaskCallback_function_name, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_TaskCallback_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TaskCallback) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCallback.script_address":
+		return x.ScriptAddress != ""
+	case "dysonprotocol.crontask.v1.TaskCallback.function_name":
+		return x.FunctionName != ""
+	case "dysonprotocol.crontask.v1.TaskCallback.gas_limit":
+		return x.GasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCallback"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCallback) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCallback.script_address":
+		x.ScriptAddress = ""
+	case "dysonprotocol.crontask.v1.TaskCallback.function_name":
+		x.FunctionName = ""
+	case "dysonprotocol.crontask.v1.TaskCallback.gas_limit":
+		x.GasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCallback"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TaskCallback) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCallback.script_address":
+		value := x.ScriptAddress
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.TaskCallback.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.TaskCallback.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCallback"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCallback does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCallback) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCallback.script_address":
+		x.ScriptAddress = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.TaskCallback.function_name":
+		x.FunctionName = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.TaskCallback.gas_limit":
+		x.GasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCallback"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCallback) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCallback.script_address":
+		panic(fmt.Errorf("field script_address of message dysonprotocol.crontask.v1.TaskCallback is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskCallback.function_name":
+		panic(fmt.Errorf("field function_name of message dysonprotocol.crontask.v1.TaskCallback is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskCallback.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message dysonprotocol.crontask.v1.TaskCallback is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCallback"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TaskCallback) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCallback.script_address":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.TaskCallback.function_name":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.TaskCallback.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCallback"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCallback does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TaskCallback) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.TaskCallback", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TaskCallback) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCallback) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TaskCallback) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TaskCallback) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TaskCallback)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ScriptAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FunctionName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TaskCallback)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ScriptAddress) > 0 {
+			i -= len(x.ScriptAddress)
+			copy(dAtA[i:], x.ScriptAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScriptAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TaskCallback)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskCallback: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScriptAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_RetryPolicy_5_list)(nil)
+
+type _RetryPolicy_5_list struct {
+	list *[]string
+}
+
+func (x *_RetryPolicy_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RetryPolicy_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_RetryPolicy_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RetryPolicy_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RetryPolicy_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RetryPolicy at list field RetryableErrors as it is not of Message kind"))
+}
+
+func (x *_RetryPolicy_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RetryPolicy_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_RetryPolicy_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_RetryPolicy                     protoreflect.MessageDescriptor
+	fd_RetryPolicy_max_attempts        protoreflect.FieldDescriptor
+	fd_RetryPolicy_backoff             protoreflect.FieldDescriptor
+	fd_RetryPolicy_backoff_seconds     protoreflect.FieldDescriptor
+	fd_RetryPolicy_max_backoff_seconds protoreflect.FieldDescriptor
+	fd_RetryPolicy_retryable_errors    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_RetryPolicy = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("RetryPolicy")
+	fd_RetryPolicy_max_attempts = md_RetryPolicy.Fields().ByName("max_attempts")
+	fd_RetryPolicy_backoff = md_RetryPolicy.Fields().ByName("backoff")
+	fd_RetryPolicy_backoff_seconds = md_RetryPolicy.Fields().ByName("backoff_seconds")
+	fd_RetryPolicy_max_backoff_seconds = md_RetryPolicy.Fields().ByName("max_backoff_seconds")
+	fd_RetryPolicy_retryable_errors = md_RetryPolicy.Fields().ByName("retryable_errors")
+}
+
+var _ protoreflect.Message = (*fastReflection_RetryPolicy)(nil)
+
+type fastReflection_RetryPolicy RetryPolicy
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RetryPolicy)(x)
+}
+
+func (x *RetryPolicy) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RetryPolicy_messageType fastReflection_RetryPolicy_messageType
+var _ protoreflect.MessageType = fastReflection_RetryPolicy_messageType{}
+
+type fastReflection_RetryPolicy_messageType struct{}
+
+func (x fastReflection_RetryPolicy_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RetryPolicy)(nil)
+}
+func (x fastReflection_RetryPolicy_messageType) New() protoreflect.Message {
+	return new(fastReflection_RetryPolicy)
+}
+func (x fastReflection_RetryPolicy_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RetryPolicy
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RetryPolicy) Descriptor() protoreflect.MessageDescriptor {
+	return md_RetryPolicy
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RetryPolicy) Type() protoreflect.MessageType {
+	return _fastReflection_RetryPolicy_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RetryPolicy) New() protoreflect.Message {
+	return new(fastReflection_RetryPolicy)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RetryPolicy) Interface() protoreflect.ProtoMessage {
+	return (*RetryPolicy)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RetryPolicy) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxAttempts != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxAttempts)
+		if !f(fd_RetryPolicy_max_attempts, value) {
+			return
+		}
+	}
+	if x.Backoff != "" {
+		value := protoreflect.ValueOfString(x.Backoff)
+		if !f(fd_RetryPolicy_backoff, value) {
+			return
+		}
+	}
+	if x.BackoffSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BackoffSeconds)
+		if !f(fd_RetryPolicy_backoff_seconds, value) {
+			return
+		}
+	}
+	if x.MaxBackoffSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxBackoffSeconds)
+		if !f(fd_RetryPolicy_max_backoff_seconds, value) {
+			return
+		}
+	}
+	if len(x.RetryableErrors) != 0 {
+		value := protoreflect.ValueOfList(&_RetryPolicy_5_list{list: &x.RetryableErrors})
+		if !f(fd_RetryPolicy_retryable_errors, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RetryPolicy) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_attempts":
+		return x.MaxAttempts != uint32(0)
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff":
+		return x.Backoff != ""
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff_seconds":
+		return x.BackoffSeconds != int64(0)
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_backoff_seconds":
+		return x.MaxBackoffSeconds != int64(0)
+	case "dysonprotocol.crontask.v1.RetryPolicy.retryable_errors":
+		return len(x.RetryableErrors) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.RetryPolicy"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.RetryPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetryPolicy) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_attempts":
+		x.MaxAttempts = uint32(0)
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff":
+		x.Backoff = ""
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff_seconds":
+		x.BackoffSeconds = int64(0)
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_backoff_seconds":
+		x.MaxBackoffSeconds = int64(0)
+	case "dysonprotocol.crontask.v1.RetryPolicy.retryable_errors":
+		x.RetryableErrors = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.RetryPolicy"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.RetryPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RetryPolicy) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_attempts":
+		value := x.MaxAttempts
+		return protoreflect.ValueOfUint32(value)
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff":
+		value := x.Backoff
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff_seconds":
+		value := x.BackoffSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_backoff_seconds":
+		value := x.MaxBackoffSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.RetryPolicy.retryable_errors":
+		if len(x.RetryableErrors) == 0 {
+			return protoreflect.ValueOfList(&_RetryPolicy_5_list{})
+		}
+		listValue := &_RetryPolicy_5_list{list: &x.RetryableErrors}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.RetryPolicy"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.RetryPolicy does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetryPolicy) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_attempts":
+		x.MaxAttempts = uint32(value.Uint())
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff":
+		x.Backoff = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff_seconds":
+		x.BackoffSeconds = value.Int()
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_backoff_seconds":
+		x.MaxBackoffSeconds = value.Int()
+	case "dysonprotocol.crontask.v1.RetryPolicy.retryable_errors":
+		lv := value.List()
+		clv := lv.(*_RetryPolicy_5_list)
+		x.RetryableErrors = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.RetryPolicy"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.RetryPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetryPolicy) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.RetryPolicy.retryable_errors":
+		if x.RetryableErrors == nil {
+			x.RetryableErrors = []string{}
+		}
+		value := &_RetryPolicy_5_list{list: &x.RetryableErrors}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_attempts":
+		panic(fmt.Errorf("field max_attempts of message dysonprotocol.crontask.v1.RetryPolicy is not mutable"))
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff":
+		panic(fmt.Errorf("field backoff of message dysonprotocol.crontask.v1.RetryPolicy is not mutable"))
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff_seconds":
+		panic(fmt.Errorf("field backoff_seconds of message dysonprotocol.crontask.v1.RetryPolicy is not mutable"))
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_backoff_seconds":
+		panic(fmt.Errorf("field max_backoff_seconds of message dysonprotocol.crontask.v1.RetryPolicy is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.RetryPolicy"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.RetryPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RetryPolicy) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_attempts":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.RetryPolicy.backoff_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.RetryPolicy.max_backoff_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.RetryPolicy.retryable_errors":
+		list := []string{}
+		return protoreflect.ValueOfList(&_RetryPolicy_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.RetryPolicy"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.RetryPolicy does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RetryPolicy) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.RetryPolicy", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RetryPolicy) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RetryPolicy) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RetryPolicy) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RetryPolicy) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RetryPolicy)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MaxAttempts != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxAttempts))
+		}
+		l = len(x.Backoff)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BackoffSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.BackoffSeconds))
+		}
+		if x.MaxBackoffSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBackoffSeconds))
+		}
+		if len(x.RetryableErrors) > 0 {
+			for _, s := range x.RetryableErrors {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RetryPolicy)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RetryableErrors) > 0 {
+			for iNdEx := len(x.RetryableErrors) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RetryableErrors[iNdEx])
+				copy(dAtA[i:], x.RetryableErrors[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetryableErrors[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if x.MaxBackoffSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBackoffSeconds))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.BackoffSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BackoffSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Backoff) > 0 {
+			i -= len(x.Backoff)
+			copy(dAtA[i:], x.Backoff)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Backoff)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxAttempts != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxAttempts))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RetryPolicy)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+				}
+				x.MaxAttempts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxAttempts |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Backoff = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BackoffSeconds", wireType)
+				}
+				x.BackoffSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BackoffSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffSeconds", wireType)
+				}
+				x.MaxBackoffSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBackoffSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryableErrors", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetryableErrors = append(x.RetryableErrors, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TaskAttempt                     protoreflect.MessageDescriptor
+	fd_TaskAttempt_attempt             protoreflect.FieldDescriptor
+	fd_TaskAttempt_execution_timestamp protoreflect.FieldDescriptor
+	fd_TaskAttempt_execution_height    protoreflect.FieldDescriptor
+	fd_TaskAttempt_codespace           protoreflect.FieldDescriptor
+	fd_TaskAttempt_code                protoreflect.FieldDescriptor
+	fd_TaskAttempt_error_log           protoreflect.FieldDescriptor
+	fd_TaskAttempt_retried             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_TaskAttempt = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("TaskAttempt")
+	fd_TaskAttempt_attempt = md_TaskAttempt.Fields().ByName("attempt")
+	fd_TaskAttempt_execution_timestamp = md_TaskAttempt.Fields().ByName("execution_timestamp")
+	fd_TaskAttempt_execution_height = md_TaskAttempt.Fields().ByName("execution_height")
+	fd_TaskAttempt_codespace = md_TaskAttempt.Fields().ByName("codespace")
+	fd_TaskAttempt_code = md_TaskAttempt.Fields().ByName("code")
+	fd_TaskAttempt_error_log = md_TaskAttempt.Fields().ByName("error_log")
+	fd_TaskAttempt_retried = md_TaskAttempt.Fields().ByName("retried")
+}
+
+var _ protoreflect.Message = (*fastReflection_TaskAttempt)(nil)
+
+type fastReflection_TaskAttempt TaskAttempt
+
+func (x *TaskAttempt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TaskAttempt)(x)
+}
+
+func (x *TaskAttempt) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TaskAttempt_messageType fastReflection_TaskAttempt_messageType
+var _ protoreflect.MessageType = fastReflection_TaskAttempt_messageType{}
+
+type fastReflection_TaskAttempt_messageType struct{}
+
+func (x fastReflection_TaskAttempt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TaskAttempt)(nil)
+}
+func (x fastReflection_TaskAttempt_messageType) New() protoreflect.Message {
+	return new(fastReflection_TaskAttempt)
+}
+func (x fastReflection_TaskAttempt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskAttempt
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TaskAttempt) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskAttempt
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TaskAttempt) Type() protoreflect.MessageType {
+	return _fastReflection_TaskAttempt_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TaskAttempt) New() protoreflect.Message {
+	return new(fastReflection_TaskAttempt)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TaskAttempt) Interface() protoreflect.ProtoMessage {
+	return (*TaskAttempt)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TaskAttempt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Attempt != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Attempt)
+		if !f(fd_TaskAttempt_attempt, value) {
+			return
+		}
+	}
+	if x.ExecutionTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionTimestamp)
+		if !f(fd_TaskAttempt_execution_timestamp, value) {
+			return
+		}
+	}
+	if x.ExecutionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionHeight)
+		if !f(fd_TaskAttempt_execution_height, value) {
+			return
+		}
+	}
+	if x.Codespace != "" {
+		value := protoreflect.ValueOfString(x.Codespace)
+		if !f(fd_TaskAttempt_codespace, value) {
+			return
+		}
+	}
+	if x.Code != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Code)
+		if !f(fd_TaskAttempt_code, value) {
+			return
+		}
+	}
+	if x.ErrorLog != "" {
+		value := protoreflect.ValueOfString(x.ErrorLog)
+		if !f(fd_TaskAttempt_error_log, value) {
+			return
+		}
+	}
+	if x.Retried != false {
+		value := protoreflect.ValueOfBool(x.Retried)
+		if !f(fd_TaskAttempt_retried, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TaskAttempt) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskAttempt.attempt":
+		return x.Attempt != uint32(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_timestamp":
+		return x.ExecutionTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_height":
+		return x.ExecutionHeight != int64(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.codespace":
+		return x.Codespace != ""
+	case "dysonprotocol.crontask.v1.TaskAttempt.code":
+		return x.Code != uint32(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.error_log":
+		return x.ErrorLog != ""
+	case "dysonprotocol.crontask.v1.TaskAttempt.retried":
+		return x.Retried != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskAttempt"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskAttempt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskAttempt) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskAttempt.attempt":
+		x.Attempt = uint32(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_timestamp":
+		x.ExecutionTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_height":
+		x.ExecutionHeight = int64(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.codespace":
+		x.Codespace = ""
+	case "dysonprotocol.crontask.v1.TaskAttempt.code":
+		x.Code = uint32(0)
+	case "dysonprotocol.crontask.v1.TaskAttempt.error_log":
+		x.ErrorLog = ""
+	case "dysonprotocol.crontask.v1.TaskAttempt.retried":
+		x.Retried = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskAttempt"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskAttempt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TaskAttempt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.TaskAttempt.attempt":
+		value := x.Attempt
+		return protoreflect.ValueOfUint32(value)
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_timestamp":
+		value := x.ExecutionTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_height":
+		value := x.ExecutionHeight
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.TaskAttempt.codespace":
+		value := x.Codespace
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.TaskAttempt.code":
+		value := x.Code
+		return protoreflect.ValueOfUint32(value)
+	case "dysonprotocol.crontask.v1.TaskAttempt.error_log":
+		value := x.ErrorLog
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.TaskAttempt.retried":
+		value := x.Retried
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskAttempt"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskAttempt does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskAttempt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskAttempt.attempt":
+		x.Attempt = uint32(value.Uint())
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_timestamp":
+		x.ExecutionTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_height":
+		x.ExecutionHeight = value.Int()
+	case "dysonprotocol.crontask.v1.TaskAttempt.codespace":
+		x.Codespace = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.TaskAttempt.code":
+		x.Code = uint32(value.Uint())
+	case "dysonprotocol.crontask.v1.TaskAttempt.error_log":
+		x.ErrorLog = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.TaskAttempt.retried":
+		x.Retried = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskAttempt"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskAttempt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskAttempt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskAttempt.attempt":
+		panic(fmt.Errorf("field attempt of message dysonprotocol.crontask.v1.TaskAttempt is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_timestamp":
+		panic(fmt.Errorf("field execution_timestamp of message dysonprotocol.crontask.v1.TaskAttempt is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_height":
+		panic(fmt.Errorf("field execution_height of message dysonprotocol.crontask.v1.TaskAttempt is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskAttempt.codespace":
+		panic(fmt.Errorf("field codespace of message dysonprotocol.crontask.v1.TaskAttempt is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskAttempt.code":
+		panic(fmt.Errorf("field code of message dysonprotocol.crontask.v1.TaskAttempt is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskAttempt.error_log":
+		panic(fmt.Errorf("field error_log of message dysonprotocol.crontask.v1.TaskAttempt is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskAttempt.retried":
+		panic(fmt.Errorf("field retried of message dysonprotocol.crontask.v1.TaskAttempt is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskAttempt"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskAttempt does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TaskAttempt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskAttempt.attempt":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.TaskAttempt.execution_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.TaskAttempt.codespace":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.TaskAttempt.code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dysonprotocol.crontask.v1.TaskAttempt.error_log":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.TaskAttempt.retried":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskAttempt"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskAttempt does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TaskAttempt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.TaskAttempt", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TaskAttempt) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskAttempt) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TaskAttempt) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TaskAttempt) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TaskAttempt)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Attempt != 0 {
+			n += 1 + runtime.Sov(uint64(x.Attempt))
+		}
+		if x.ExecutionTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionTimestamp))
+		}
+		if x.ExecutionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionHeight))
+		}
+		l = len(x.Codespace)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Code != 0 {
+			n += 1 + runtime.Sov(uint64(x.Code))
+		}
+		l = len(x.ErrorLog)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Retried {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TaskAttempt)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Retried {
+			i--
+			if x.Retried {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.ErrorLog) > 0 {
+			i -= len(x.ErrorLog)
+			copy(dAtA[i:], x.ErrorLog)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorLog)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Code != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Code))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Codespace) > 0 {
+			i -= len(x.Codespace)
+			copy(dAtA[i:], x.Codespace)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Codespace)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ExecutionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ExecutionTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionTimestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Attempt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Attempt))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TaskAttempt)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskAttempt: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+				}
+				x.Attempt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Attempt |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionTimestamp", wireType)
+				}
+				x.ExecutionTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+				}
+				x.ExecutionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Codespace = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				x.Code = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Code |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ErrorLog = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retried", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Retried = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TaskDependency         protoreflect.MessageDescriptor
+	fd_TaskDependency_task_id protoreflect.FieldDescriptor
+	fd_TaskDependency_outcome protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_TaskDependency = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("TaskDependency")
+	fd_TaskDependency_task_id = md_TaskDependency.Fields().ByName("task_id")
+	fd_TaskDependency_outcome = md_TaskDependency.Fields().ByName("outcome")
+}
+
+var _ protoreflect.Message = (*fastReflection_TaskDependency)(nil)
+
+type fastReflection_TaskDependency TaskDependency
+
+func (x *TaskDependency) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TaskDependency)(x)
+}
+
+func (x *TaskDependency) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TaskDependency_messageType fastReflection_TaskDependency_messageType
+var _ protoreflect.MessageType = fastReflection_TaskDependency_messageType{}
+
+type fastReflection_TaskDependency_messageType struct{}
+
+func (x fastReflection_TaskDependency_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TaskDependency)(nil)
+}
+func (x fastReflection_TaskDependency_messageType) New() protoreflect.Message {
+	return new(fastReflection_TaskDependency)
+}
+func (x fastReflection_TaskDependency_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskDependency
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TaskDependency) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskDependency
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TaskDependency) Type() protoreflect.MessageType {
+	return _fastReflection_TaskDependency_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TaskDependency) New() protoreflect.Message {
+	return new(fastReflection_TaskDependency)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TaskDependency) Interface() protoreflect.ProtoMessage {
+	return (*TaskDependency)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TaskDependency) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.TaskId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TaskId)
+		if !f(fd_TaskDependency_task_id, value) {
+			return
+		}
+	}
+	if x.Outcome != "" {
+		value := protoreflect.ValueOfString(x.Outcome)
+		if !f(fd_TaskDependency_outcome, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TaskDependency) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskDependency.task_id":
+		return x.TaskId != uint64(0)
+	case "dysonprotocol.crontask.v1.TaskDependency.outcome":
+		return x.Outcome != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskDependency"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskDependency does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskDependency) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskDependency.task_id":
+		x.TaskId = uint64(0)
+	case "dysonprotocol.crontask.v1.TaskDependency.outcome":
+		x.Outcome = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskDependency"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskDependency does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TaskDependency) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.TaskDependency.task_id":
+		value := x.TaskId
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.TaskDependency.outcome":
+		value := x.Outcome
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskDependency"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskDependency does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskDependency) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskDependency.task_id":
+		x.TaskId = value.Uint()
+	case "dysonprotocol.crontask.v1.TaskDependency.outcome":
+		x.Outcome = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskDependency"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskDependency does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskDependency) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskDependency.task_id":
+		panic(fmt.Errorf("field task_id of message dysonprotocol.crontask.v1.TaskDependency is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskDependency.outcome":
+		panic(fmt.Errorf("field outcome of message dysonprotocol.crontask.v1.TaskDependency is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskDependency"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskDependency does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TaskDependency) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskDependency.task_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.TaskDependency.outcome":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskDependency"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskDependency does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TaskDependency) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.TaskDependency", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TaskDependency) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskDependency) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TaskDependency) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TaskDependency) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TaskDependency)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.TaskId != 0 {
+			n += 1 + runtime.Sov(uint64(x.TaskId))
+		}
+		l = len(x.Outcome)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TaskDependency)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Outcome) > 0 {
+			i -= len(x.Outcome)
+			copy(dAtA[i:], x.Outcome)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Outcome)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.TaskId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TaskId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TaskDependency)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskDependency: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				}
+				x.TaskId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TaskId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Outcome = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TaskCondition                 protoreflect.MessageDescriptor
+	fd_TaskCondition_balance_below   protoreflect.FieldDescriptor
+	fd_TaskCondition_storage_changed protoreflect.FieldDescriptor
+	fd_TaskCondition_name_expired    protoreflect.FieldDescriptor
+	fd_TaskCondition_script_view     protoreflect.FieldDescriptor
+	fd_TaskCondition_gas_limit       protoreflect.FieldDescriptor
+	fd_TaskCondition_deposit         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_TaskCondition = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("TaskCondition")
+	fd_TaskCondition_balance_below = md_TaskCondition.Fields().ByName("balance_below")
+	fd_TaskCondition_storage_changed = md_TaskCondition.Fields().ByName("storage_changed")
+	fd_TaskCondition_name_expired = md_TaskCondition.Fields().ByName("name_expired")
+	fd_TaskCondition_script_view = md_TaskCondition.Fields().ByName("script_view")
+	fd_TaskCondition_gas_limit = md_TaskCondition.Fields().ByName("gas_limit")
+	fd_TaskCondition_deposit = md_TaskCondition.Fields().ByName("deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_TaskCondition)(nil)
+
+type fastReflection_TaskCondition TaskCondition
+
+func (x *TaskCondition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TaskCondition)(x)
+}
+
+func (x *TaskCondition) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TaskCondition_messageType fastReflection_TaskCondition_messageType
+var _ protoreflect.MessageType = fastReflection_TaskCondition_messageType{}
+
+type fastReflection_TaskCondition_messageType struct{}
+
+func (x fastReflection_TaskCondition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TaskCondition)(nil)
+}
+func (x fastReflection_TaskCondition_messageType) New() protoreflect.Message {
+	return new(fastReflection_TaskCondition)
+}
+func (x fastReflection_TaskCondition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskCondition
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TaskCondition) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskCondition
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TaskCondition) Type() protoreflect.MessageType {
+	return _fastReflection_TaskCondition_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TaskCondition) New() protoreflect.Message {
+	return new(fastReflection_TaskCondition)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TaskCondition) Interface() protoreflect.ProtoMessage {
+	return (*TaskCondition)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TaskCondition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BalanceBelow != nil {
+		value := protoreflect.ValueOfMessage(x.BalanceBelow.ProtoReflect())
+		if !f(fd_TaskCondition_balance_below, value) {
+			return
+		}
+	}
+	if x.StorageChanged != nil {
+		value := protoreflect.ValueOfMessage(x.StorageChanged.ProtoReflect())
+		if !f(fd_TaskCondition_storage_changed, value) {
+			return
+		}
+	}
+	if x.NameExpired != nil {
+		value := protoreflect.ValueOfMessage(x.NameExpired.ProtoReflect())
+		if !f(fd_TaskCondition_name_expired, value) {
+			return
+		}
+	}
+	if x.ScriptView != nil {
+		value := protoreflect.ValueOfMessage(x.ScriptView.ProtoReflect())
+		if !f(fd_TaskCondition_script_view, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_TaskCondition_gas_limit, value) {
+			return
+		}
+	}
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_TaskCondition_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TaskCondition) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCondition.balance_below":
+		return x.BalanceBelow != nil
+	case "dysonprotocol.crontask.v1.TaskCondition.storage_changed":
+		return x.StorageChanged != nil
+	case "dysonprotocol.crontask.v1.TaskCondition.name_expired":
+		return x.NameExpired != nil
+	case "dysonprotocol.crontask.v1.TaskCondition.script_view":
+		return x.ScriptView != nil
+	case "dysonprotocol.crontask.v1.TaskCondition.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "dysonprotocol.crontask.v1.TaskCondition.deposit":
+		return x.Deposit != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCondition) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCondition.balance_below":
+		x.BalanceBelow = nil
+	case "dysonprotocol.crontask.v1.TaskCondition.storage_changed":
+		x.StorageChanged = nil
+	case "dysonprotocol.crontask.v1.TaskCondition.name_expired":
+		x.NameExpired = nil
+	case "dysonprotocol.crontask.v1.TaskCondition.script_view":
+		x.ScriptView = nil
+	case "dysonprotocol.crontask.v1.TaskCondition.gas_limit":
+		x.GasLimit = uint64(0)
+	case "dysonprotocol.crontask.v1.TaskCondition.deposit":
+		x.Deposit = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TaskCondition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCondition.balance_below":
+		value := x.BalanceBelow
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.storage_changed":
+		value := x.StorageChanged
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.name_expired":
+		value := x.NameExpired
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.script_view":
+		value := x.ScriptView
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.TaskCondition.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCondition does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCondition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCondition.balance_below":
+		x.BalanceBelow = value.Message().Interface().(*BalanceBelowCondition)
+	case "dysonprotocol.crontask.v1.TaskCondition.storage_changed":
+		x.StorageChanged = value.Message().Interface().(*StorageChangedCondition)
+	case "dysonprotocol.crontask.v1.TaskCondition.name_expired":
+		x.NameExpired = value.Message().Interface().(*NameExpiredCondition)
+	case "dysonprotocol.crontask.v1.TaskCondition.script_view":
+		x.ScriptView = value.Message().Interface().(*ScriptViewCondition)
+	case "dysonprotocol.crontask.v1.TaskCondition.gas_limit":
+		x.GasLimit = value.Uint()
+	case "dysonprotocol.crontask.v1.TaskCondition.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCondition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCondition.balance_below":
+		if x.BalanceBelow == nil {
+			x.BalanceBelow = new(BalanceBelowCondition)
+		}
+		return protoreflect.ValueOfMessage(x.BalanceBelow.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.storage_changed":
+		if x.StorageChanged == nil {
+			x.StorageChanged = new(StorageChangedCondition)
+		}
+		return protoreflect.ValueOfMessage(x.StorageChanged.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.name_expired":
+		if x.NameExpired == nil {
+			x.NameExpired = new(NameExpiredCondition)
+		}
+		return protoreflect.ValueOfMessage(x.NameExpired.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.script_view":
+		if x.ScriptView == nil {
+			x.ScriptView = new(ScriptViewCondition)
+		}
+		return protoreflect.ValueOfMessage(x.ScriptView.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message dysonprotocol.crontask.v1.TaskCondition is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TaskCondition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskCondition.balance_below":
+		m := new(BalanceBelowCondition)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.storage_changed":
+		m := new(StorageChangedCondition)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.name_expired":
+		m := new(NameExpiredCondition)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.script_view":
+		m := new(ScriptViewCondition)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskCondition.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.TaskCondition.deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TaskCondition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.TaskCondition", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TaskCondition) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskCondition) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TaskCondition) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TaskCondition) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TaskCondition)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BalanceBelow != nil {
+			l = options.Size(x.BalanceBelow)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StorageChanged != nil {
+			l = options.Size(x.StorageChanged)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NameExpired != nil {
+			l = options.Size(x.NameExpired)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ScriptView != nil {
+			l = options.Size(x.ScriptView)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TaskCondition)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.ScriptView != nil {
+			encoded, err := options.Marshal(x.ScriptView)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.NameExpired != nil {
+			encoded, err := options.Marshal(x.NameExpired)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.StorageChanged != nil {
+			encoded, err := options.Marshal(x.StorageChanged)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.BalanceBelow != nil {
+			encoded, err := options.Marshal(x.BalanceBelow)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TaskCondition)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskCondition: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BalanceBelow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.BalanceBelow == nil {
+					x.BalanceBelow = &BalanceBelowCondition{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BalanceBelow); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StorageChanged", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StorageChanged == nil {
+					x.StorageChanged = &StorageChangedCondition{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StorageChanged); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NameExpired", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NameExpired == nil {
+					x.NameExpired = &NameExpiredCondition{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NameExpired); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScriptView", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ScriptView == nil {
+					x.ScriptView = &ScriptViewCondition{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ScriptView); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BalanceBelowCondition         protoreflect.MessageDescriptor
+	fd_BalanceBelowCondition_address protoreflect.FieldDescriptor
+	fd_BalanceBelowCondition_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_BalanceBelowCondition = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("BalanceBelowCondition")
+	fd_BalanceBelowCondition_address = md_BalanceBelowCondition.Fields().ByName("address")
+	fd_BalanceBelowCondition_amount = md_BalanceBelowCondition.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_BalanceBelowCondition)(nil)
+
+type fastReflection_BalanceBelowCondition BalanceBelowCondition
+
+func (x *BalanceBelowCondition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BalanceBelowCondition)(x)
+}
+
+func (x *BalanceBelowCondition) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BalanceBelowCondition_messageType fastReflection_BalanceBelowCondition_messageType
+var _ protoreflect.MessageType = fastReflection_BalanceBelowCondition_messageType{}
+
+type fastReflection_BalanceBelowCondition_messageType struct{}
+
+func (x fastReflection_BalanceBelowCondition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BalanceBelowCondition)(nil)
+}
+func (x fastReflection_BalanceBelowCondition_messageType) New() protoreflect.Message {
+	return new(fastReflection_BalanceBelowCondition)
+}
+func (x fastReflection_BalanceBelowCondition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceBelowCondition
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BalanceBelowCondition) Descriptor() protoreflect.MessageDescriptor {
+	return md_BalanceBelowCondition
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BalanceBelowCondition) Type() protoreflect.MessageType {
+	return _fastReflection_BalanceBelowCondition_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BalanceBelowCondition) New() protoreflect.Message {
+	return new(fastReflection_BalanceBelowCondition)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BalanceBelowCondition) Interface() protoreflect.ProtoMessage {
+	return (*BalanceBelowCondition)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BalanceBelowCondition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_BalanceBelowCondition_address, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_BalanceBelowCondition_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BalanceBelowCondition) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.address":
+		return x.Address != ""
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BalanceBelowCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BalanceBelowCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceBelowCondition) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.address":
+		x.Address = ""
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BalanceBelowCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BalanceBelowCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BalanceBelowCondition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BalanceBelowCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BalanceBelowCondition does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceBelowCondition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.address":
+		x.Address = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BalanceBelowCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BalanceBelowCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceBelowCondition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.address":
+		panic(fmt.Errorf("field address of message dysonprotocol.crontask.v1.BalanceBelowCondition is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BalanceBelowCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BalanceBelowCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BalanceBelowCondition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.address":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.BalanceBelowCondition.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BalanceBelowCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BalanceBelowCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BalanceBelowCondition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.BalanceBelowCondition", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BalanceBelowCondition) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BalanceBelowCondition) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BalanceBelowCondition) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BalanceBelowCondition) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BalanceBelowCondition)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BalanceBelowCondition)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BalanceBelowCondition)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceBelowCondition: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BalanceBelowCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StorageChangedCondition                protoreflect.MessageDescriptor
+	fd_StorageChangedCondition_owner          protoreflect.FieldDescriptor
+	fd_StorageChangedCondition_index          protoreflect.FieldDescriptor
+	fd_StorageChangedCondition_updated_height protoreflect.FieldDescriptor
+	fd_StorageChangedCondition_data_hash      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_StorageChangedCondition = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("StorageChangedCondition")
+	fd_StorageChangedCondition_owner = md_StorageChangedCondition.Fields().ByName("owner")
+	fd_StorageChangedCondition_index = md_StorageChangedCondition.Fields().ByName("index")
+	fd_StorageChangedCondition_updated_height = md_StorageChangedCondition.Fields().ByName("updated_height")
+	fd_StorageChangedCondition_data_hash = md_StorageChangedCondition.Fields().ByName("data_hash")
+}
+
+var _ protoreflect.Message = (*fastReflection_StorageChangedCondition)(nil)
+
+type fastReflection_StorageChangedCondition StorageChangedCondition
+
+func (x *StorageChangedCondition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageChangedCondition)(x)
+}
+
+func (x *StorageChangedCondition) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StorageChangedCondition_messageType fastReflection_StorageChangedCondition_messageType
+var _ protoreflect.MessageType = fastReflection_StorageChangedCondition_messageType{}
+
+type fastReflection_StorageChangedCondition_messageType struct{}
+
+func (x fastReflection_StorageChangedCondition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageChangedCondition)(nil)
+}
+func (x fastReflection_StorageChangedCondition_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageChangedCondition)
+}
+func (x fastReflection_StorageChangedCondition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageChangedCondition
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StorageChangedCondition) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageChangedCondition
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StorageChangedCondition) Type() protoreflect.MessageType {
+	return _fastReflection_StorageChangedCondition_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StorageChangedCondition) New() protoreflect.Message {
+	return new(fastReflection_StorageChangedCondition)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StorageChangedCondition) Interface() protoreflect.ProtoMessage {
+	return (*StorageChangedCondition)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StorageChangedCondition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_StorageChangedCondition_owner, value) {
+			return
+		}
+	}
+	if x.Index != "" {
+		value := protoreflect.ValueOfString(x.Index)
+		if !f(fd_StorageChangedCondition_index, value) {
+			return
+		}
+	}
+	if x.UpdatedHeight != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.UpdatedHeight)
+		if !f(fd_StorageChangedCondition_updated_height, value) {
+			return
+		}
+	}
+	if len(x.DataHash) != 0 {
+		value := protoreflect.ValueOfBytes(x.DataHash)
+		if !f(fd_StorageChangedCondition_data_hash, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StorageChangedCondition) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.owner":
+		return x.Owner != ""
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.index":
+		return x.Index != ""
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.updated_height":
+		return x.UpdatedHeight != uint64(0)
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.data_hash":
+		return len(x.DataHash) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StorageChangedCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StorageChangedCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChangedCondition) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.owner":
+		x.Owner = ""
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.index":
+		x.Index = ""
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.updated_height":
+		x.UpdatedHeight = uint64(0)
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.data_hash":
+		x.DataHash = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StorageChangedCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StorageChangedCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StorageChangedCondition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.index":
+		value := x.Index
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.updated_height":
+		value := x.UpdatedHeight
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.data_hash":
+		value := x.DataHash
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StorageChangedCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StorageChangedCondition does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChangedCondition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.owner":
+		x.Owner = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.index":
+		x.Index = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.updated_height":
+		x.UpdatedHeight = value.Uint()
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.data_hash":
+		x.DataHash = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StorageChangedCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StorageChangedCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChangedCondition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.owner":
+		panic(fmt.Errorf("field owner of message dysonprotocol.crontask.v1.StorageChangedCondition is not mutable"))
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.index":
+		panic(fmt.Errorf("field index of message dysonprotocol.crontask.v1.StorageChangedCondition is not mutable"))
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.updated_height":
+		panic(fmt.Errorf("field updated_height of message dysonprotocol.crontask.v1.StorageChangedCondition is not mutable"))
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.data_hash":
+		panic(fmt.Errorf("field data_hash of message dysonprotocol.crontask.v1.StorageChangedCondition is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StorageChangedCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StorageChangedCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StorageChangedCondition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.owner":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.index":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.updated_height":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.StorageChangedCondition.data_hash":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StorageChangedCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StorageChangedCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StorageChangedCondition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.StorageChangedCondition", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StorageChangedCondition) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageChangedCondition) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StorageChangedCondition) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StorageChangedCondition) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StorageChangedCondition)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Index)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.UpdatedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedHeight))
+		}
+		l = len(x.DataHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StorageChangedCondition)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DataHash) > 0 {
+			i -= len(x.DataHash)
+			copy(dAtA[i:], x.DataHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DataHash)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.UpdatedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Index) > 0 {
+			i -= len(x.Index)
+			copy(dAtA[i:], x.Index)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Index)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StorageChangedCondition)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageChangedCondition: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageChangedCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Index = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+				}
+				x.UpdatedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedHeight |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DataHash = append(x.DataHash[:0], dAtA[iNdEx:postIndex]...)
+				if x.DataHash == nil {
+					x.DataHash = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_NameExpiredCondition      protoreflect.MessageDescriptor
+	fd_NameExpiredCondition_name protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_NameExpiredCondition = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("NameExpiredCondition")
+	fd_NameExpiredCondition_name = md_NameExpiredCondition.Fields().ByName("name")
+}
+
+var _ protoreflect.Message = (*fastReflection_NameExpiredCondition)(nil)
+
+type fastReflection_NameExpiredCondition NameExpiredCondition
+
+func (x *NameExpiredCondition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_NameExpiredCondition)(x)
+}
+
+func (x *NameExpiredCondition) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_NameExpiredCondition_messageType fastReflection_NameExpiredCondition_messageType
+var _ protoreflect.MessageType = fastReflection_NameExpiredCondition_messageType{}
+
+type fastReflection_NameExpiredCondition_messageType struct{}
+
+func (x fastReflection_NameExpiredCondition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_NameExpiredCondition)(nil)
+}
+func (x fastReflection_NameExpiredCondition_messageType) New() protoreflect.Message {
+	return new(fastReflection_NameExpiredCondition)
+}
+func (x fastReflection_NameExpiredCondition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameExpiredCondition
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_NameExpiredCondition) Descriptor() protoreflect.MessageDescriptor {
+	return md_NameExpiredCondition
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_NameExpiredCondition) Type() protoreflect.MessageType {
+	return _fastReflection_NameExpiredCondition_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_NameExpiredCondition) New() protoreflect.Message {
+	return new(fastReflection_NameExpiredCondition)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_NameExpiredCondition) Interface() protoreflect.ProtoMessage {
+	return (*NameExpiredCondition)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_NameExpiredCondition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_NameExpiredCondition_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_NameExpiredCondition) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.NameExpiredCondition.name":
+		return x.Name != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.NameExpiredCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.NameExpiredCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameExpiredCondition) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.NameExpiredCondition.name":
+		x.Name = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.NameExpiredCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.NameExpiredCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_NameExpiredCondition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.NameExpiredCondition.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.NameExpiredCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.NameExpiredCondition does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameExpiredCondition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.NameExpiredCondition.name":
+		x.Name = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.NameExpiredCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.NameExpiredCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameExpiredCondition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.NameExpiredCondition.name":
+		panic(fmt.Errorf("field name of message dysonprotocol.crontask.v1.NameExpiredCondition is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.NameExpiredCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.NameExpiredCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_NameExpiredCondition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.NameExpiredCondition.name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.NameExpiredCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.NameExpiredCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_NameExpiredCondition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.NameExpiredCondition", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_NameExpiredCondition) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_NameExpiredCondition) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_NameExpiredCondition) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_NameExpiredCondition) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*NameExpiredCondition)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*NameExpiredCondition)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*NameExpiredCondition)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameExpiredCondition: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: NameExpiredCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ScriptViewCondition                protoreflect.MessageDescriptor
+	fd_ScriptViewCondition_script_address protoreflect.FieldDescriptor
+	fd_ScriptViewCondition_function_name  protoreflect.FieldDescriptor
+	fd_ScriptViewCondition_args           protoreflect.FieldDescriptor
+	fd_ScriptViewCondition_kwargs         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_ScriptViewCondition = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("ScriptViewCondition")
+	fd_ScriptViewCondition_script_address = md_ScriptViewCondition.Fields().ByName("script_address")
+	fd_ScriptViewCondition_function_name = md_ScriptViewCondition.Fields().ByName("function_name")
+	fd_ScriptViewCondition_args = md_ScriptViewCondition.Fields().ByName("args")
+	fd_ScriptViewCondition_kwargs = md_ScriptViewCondition.Fields().ByName("kwargs")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScriptViewCondition)(nil)
+
+type fastReflection_ScriptViewCondition ScriptViewCondition
+
+func (x *ScriptViewCondition) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScriptViewCondition)(x)
+}
+
+func (x *ScriptViewCondition) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScriptViewCondition_messageType fastReflection_ScriptViewCondition_messageType
+var _ protoreflect.MessageType = fastReflection_ScriptViewCondition_messageType{}
+
+type fastReflection_ScriptViewCondition_messageType struct{}
+
+func (x fastReflection_ScriptViewCondition_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScriptViewCondition)(nil)
+}
+func (x fastReflection_ScriptViewCondition_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScriptViewCondition)
+}
+func (x fastReflection_ScriptViewCondition_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScriptViewCondition
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScriptViewCondition) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScriptViewCondition
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScriptViewCondition) Type() protoreflect.MessageType {
+	return _fastReflection_ScriptViewCondition_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScriptViewCondition) New() protoreflect.Message {
+	return new(fastReflection_ScriptViewCondition)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScriptViewCondition) Interface() protoreflect.ProtoMessage {
+	return (*ScriptViewCondition)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScriptViewCondition) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScriptAddress != "" {
+		value := protoreflect.ValueOfString(x.ScriptAddress)
+		if !f(fd_ScriptViewCondition_script_address, value) {
+			return
+		}
+	}
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_ScriptViewCondition_function_name, value) {
+			return
+		}
+	}
+	if x.Args != "" {
+		value := protoreflect.ValueOfString(x.Args)
+		if !f(fd_ScriptViewCondition_args, value) {
+			return
+		}
+	}
+	if x.Kwargs != "" {
+		value := protoreflect.ValueOfString(x.Kwargs)
+		if !f(fd_ScriptViewCondition_kwargs, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScriptViewCondition) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.script_address":
+		return x.ScriptAddress != ""
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.function_name":
+		return x.FunctionName != ""
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.args":
+		return x.Args != ""
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.kwargs":
+		return x.Kwargs != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ScriptViewCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ScriptViewCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptViewCondition) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.script_address":
+		x.ScriptAddress = ""
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.function_name":
+		x.FunctionName = ""
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.args":
+		x.Args = ""
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.kwargs":
+		x.Kwargs = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ScriptViewCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ScriptViewCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScriptViewCondition) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.script_address":
+		value := x.ScriptAddress
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.args":
+		value := x.Args
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.kwargs":
+		value := x.Kwargs
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ScriptViewCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ScriptViewCondition does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptViewCondition) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.script_address":
+		x.ScriptAddress = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.function_name":
+		x.FunctionName = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.args":
+		x.Args = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.kwargs":
+		x.Kwargs = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ScriptViewCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ScriptViewCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptViewCondition) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.script_address":
+		panic(fmt.Errorf("field script_address of message dysonprotocol.crontask.v1.ScriptViewCondition is not mutable"))
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.function_name":
+		panic(fmt.Errorf("field function_name of message dysonprotocol.crontask.v1.ScriptViewCondition is not mutable"))
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.args":
+		panic(fmt.Errorf("field args of message dysonprotocol.crontask.v1.ScriptViewCondition is not mutable"))
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.kwargs":
+		panic(fmt.Errorf("field kwargs of message dysonprotocol.crontask.v1.ScriptViewCondition is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ScriptViewCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ScriptViewCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScriptViewCondition) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.script_address":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.function_name":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.args":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.ScriptViewCondition.kwargs":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ScriptViewCondition"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ScriptViewCondition does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScriptViewCondition) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.ScriptViewCondition", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScriptViewCondition) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptViewCondition) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScriptViewCondition) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScriptViewCondition) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScriptViewCondition)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ScriptAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FunctionName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Args)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Kwargs)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScriptViewCondition)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Kwargs) > 0 {
+			i -= len(x.Kwargs)
+			copy(dAtA[i:], x.Kwargs)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kwargs)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Args) > 0 {
+			i -= len(x.Args)
+			copy(dAtA[i:], x.Args)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ScriptAddress) > 0 {
+			i -= len(x.ScriptAddress)
+			copy(dAtA[i:], x.ScriptAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScriptAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScriptViewCondition)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScriptViewCondition: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScriptViewCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScriptAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Args = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kwargs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kwargs = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_TaskRun                       protoreflect.MessageDescriptor
+	fd_TaskRun_occurrence            protoreflect.FieldDescriptor
+	fd_TaskRun_scheduled_timestamp   protoreflect.FieldDescriptor
+	fd_TaskRun_execution_timestamp   protoreflect.FieldDescriptor
+	fd_TaskRun_status                protoreflect.FieldDescriptor
+	fd_TaskRun_gas_consumed          protoreflect.FieldDescriptor
+	fd_TaskRun_fee                   protoreflect.FieldDescriptor
+	fd_TaskRun_error_log             protoreflect.FieldDescriptor
+	fd_TaskRun_execution_height      protoreflect.FieldDescriptor
+	fd_TaskRun_callback_gas_consumed protoreflect.FieldDescriptor
+	fd_TaskRun_callback_error        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_TaskRun = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("TaskRun")
+	fd_TaskRun_occurrence = md_TaskRun.Fields().ByName("occurrence")
+	fd_TaskRun_scheduled_timestamp = md_TaskRun.Fields().ByName("scheduled_timestamp")
+	fd_TaskRun_execution_timestamp = md_TaskRun.Fields().ByName("execution_timestamp")
+	fd_TaskRun_status = md_TaskRun.Fields().ByName("status")
+	fd_TaskRun_gas_consumed = md_TaskRun.Fields().ByName("gas_consumed")
+	fd_TaskRun_fee = md_TaskRun.Fields().ByName("fee")
+	fd_TaskRun_error_log = md_TaskRun.Fields().ByName("error_log")
+	fd_TaskRun_execution_height = md_TaskRun.Fields().ByName("execution_height")
+	fd_TaskRun_callback_gas_consumed = md_TaskRun.Fields().ByName("callback_gas_consumed")
+	fd_TaskRun_callback_error = md_TaskRun.Fields().ByName("callback_error")
+}
+
+var _ protoreflect.Message = (*fastReflection_TaskRun)(nil)
+
+type fastReflection_TaskRun TaskRun
+
+func (x *TaskRun) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TaskRun)(x)
+}
+
+func (x *TaskRun) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_TaskRun_messageType fastReflection_TaskRun_messageType
+var _ protoreflect.MessageType = fastReflection_TaskRun_messageType{}
+
+type fastReflection_TaskRun_messageType struct{}
+
+func (x fastReflection_TaskRun_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TaskRun)(nil)
+}
+func (x fastReflection_TaskRun_messageType) New() protoreflect.Message {
+	return new(fastReflection_TaskRun)
+}
+func (x fastReflection_TaskRun_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskRun
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_TaskRun) Descriptor() protoreflect.MessageDescriptor {
+	return md_TaskRun
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_TaskRun) Type() protoreflect.MessageType {
+	return _fastReflection_TaskRun_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_TaskRun) New() protoreflect.Message {
+	return new(fastReflection_TaskRun)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_TaskRun) Interface() protoreflect.ProtoMessage {
+	return (*TaskRun)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_TaskRun) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Occurrence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Occurrence)
+		if !f(fd_TaskRun_occurrence, value) {
+			return
+		}
+	}
+	if x.ScheduledTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ScheduledTimestamp)
+		if !f(fd_TaskRun_scheduled_timestamp, value) {
+			return
+		}
+	}
+	if x.ExecutionTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionTimestamp)
+		if !f(fd_TaskRun_execution_timestamp, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_TaskRun_status, value) {
+			return
+		}
+	}
+	if x.GasConsumed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasConsumed)
+		if !f(fd_TaskRun_gas_consumed, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_TaskRun_fee, value) {
+			return
+		}
+	}
+	if x.ErrorLog != "" {
+		value := protoreflect.ValueOfString(x.ErrorLog)
+		if !f(fd_TaskRun_error_log, value) {
+			return
+		}
+	}
+	if x.ExecutionHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExecutionHeight)
+		if !f(fd_TaskRun_execution_height, value) {
+			return
+		}
+	}
+	if x.CallbackGasConsumed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CallbackGasConsumed)
+		if !f(fd_TaskRun_callback_gas_consumed, value) {
+			return
+		}
+	}
+	if x.CallbackError != "" {
+		value := protoreflect.ValueOfString(x.CallbackError)
+		if !f(fd_TaskRun_callback_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_TaskRun) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskRun.occurrence":
+		return x.Occurrence != uint64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.scheduled_timestamp":
+		return x.ScheduledTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.execution_timestamp":
+		return x.ExecutionTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.status":
+		return x.Status != ""
+	case "dysonprotocol.crontask.v1.TaskRun.gas_consumed":
+		return x.GasConsumed != uint64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.fee":
+		return x.Fee != nil
+	case "dysonprotocol.crontask.v1.TaskRun.error_log":
+		return x.ErrorLog != ""
+	case "dysonprotocol.crontask.v1.TaskRun.execution_height":
+		return x.ExecutionHeight != int64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.callback_gas_consumed":
+		return x.CallbackGasConsumed != uint64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.callback_error":
+		return x.CallbackError != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskRun"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskRun) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskRun.occurrence":
+		x.Occurrence = uint64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.scheduled_timestamp":
+		x.ScheduledTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.execution_timestamp":
+		x.ExecutionTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.status":
+		x.Status = ""
+	case "dysonprotocol.crontask.v1.TaskRun.gas_consumed":
+		x.GasConsumed = uint64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.fee":
+		x.Fee = nil
+	case "dysonprotocol.crontask.v1.TaskRun.error_log":
+		x.ErrorLog = ""
+	case "dysonprotocol.crontask.v1.TaskRun.execution_height":
+		x.ExecutionHeight = int64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.callback_gas_consumed":
+		x.CallbackGasConsumed = uint64(0)
+	case "dysonprotocol.crontask.v1.TaskRun.callback_error":
+		x.CallbackError = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskRun"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_TaskRun) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.TaskRun.occurrence":
+		value := x.Occurrence
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.TaskRun.scheduled_timestamp":
+		value := x.ScheduledTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.TaskRun.execution_timestamp":
+		value := x.ExecutionTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.TaskRun.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.TaskRun.gas_consumed":
+		value := x.GasConsumed
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.TaskRun.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskRun.error_log":
+		value := x.ErrorLog
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.TaskRun.execution_height":
+		value := x.ExecutionHeight
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.TaskRun.callback_gas_consumed":
+		value := x.CallbackGasConsumed
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.TaskRun.callback_error":
+		value := x.CallbackError
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskRun"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskRun does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskRun) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskRun.occurrence":
+		x.Occurrence = value.Uint()
+	case "dysonprotocol.crontask.v1.TaskRun.scheduled_timestamp":
+		x.ScheduledTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.TaskRun.execution_timestamp":
+		x.ExecutionTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.TaskRun.status":
+		x.Status = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.TaskRun.gas_consumed":
+		x.GasConsumed = value.Uint()
+	case "dysonprotocol.crontask.v1.TaskRun.fee":
+		x.Fee = value.Message().Interface().(*v1beta1.Coin)
+	case "dysonprotocol.crontask.v1.TaskRun.error_log":
+		x.ErrorLog = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.TaskRun.execution_height":
+		x.ExecutionHeight = value.Int()
+	case "dysonprotocol.crontask.v1.TaskRun.callback_gas_consumed":
+		x.CallbackGasConsumed = value.Uint()
+	case "dysonprotocol.crontask.v1.TaskRun.callback_error":
+		x.CallbackError = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskRun"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskRun) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskRun.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskRun.occurrence":
+		panic(fmt.Errorf("field occurrence of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.scheduled_timestamp":
+		panic(fmt.Errorf("field scheduled_timestamp of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.execution_timestamp":
+		panic(fmt.Errorf("field execution_timestamp of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.status":
+		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.gas_consumed":
+		panic(fmt.Errorf("field gas_consumed of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.error_log":
+		panic(fmt.Errorf("field error_log of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.execution_height":
+		panic(fmt.Errorf("field execution_height of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.callback_gas_consumed":
+		panic(fmt.Errorf("field callback_gas_consumed of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	case "dysonprotocol.crontask.v1.TaskRun.callback_error":
+		panic(fmt.Errorf("field callback_error of message dysonprotocol.crontask.v1.TaskRun is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskRun"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_TaskRun) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.TaskRun.occurrence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.TaskRun.scheduled_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.TaskRun.execution_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.TaskRun.status":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.TaskRun.gas_consumed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.TaskRun.fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.TaskRun.error_log":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.TaskRun.execution_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.TaskRun.callback_gas_consumed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.TaskRun.callback_error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.TaskRun"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.TaskRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_TaskRun) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.TaskRun", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_TaskRun) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_TaskRun) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_TaskRun) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_TaskRun) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*TaskRun)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Occurrence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Occurrence))
+		}
+		if x.ScheduledTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.ScheduledTimestamp))
+		}
+		if x.ExecutionTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionTimestamp))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasConsumed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasConsumed))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ErrorLog)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionHeight))
+		}
+		if x.CallbackGasConsumed != 0 {
+			n += 1 + runtime.Sov(uint64(x.CallbackGasConsumed))
+		}
+		l = len(x.CallbackError)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*TaskRun)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CallbackError) > 0 {
+			i -= len(x.CallbackError)
+			copy(dAtA[i:], x.CallbackError)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CallbackError)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.CallbackGasConsumed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CallbackGasConsumed))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ExecutionHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.ErrorLog) > 0 {
+			i -= len(x.ErrorLog)
+			copy(dAtA[i:], x.ErrorLog)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorLog)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.GasConsumed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasConsumed))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ExecutionTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionTimestamp))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ScheduledTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ScheduledTimestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Occurrence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Occurrence))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*TaskRun)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskRun: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TaskRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Occurrence", wireType)
+				}
+				x.Occurrence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Occurrence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimestamp", wireType)
+				}
+				x.ScheduledTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ScheduledTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionTimestamp", wireType)
+				}
+				x.ExecutionTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+				}
+				x.GasConsumed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasConsumed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ErrorLog = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+				}
+				x.ExecutionHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallbackGasConsumed", wireType)
+				}
+				x.CallbackGasConsumed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CallbackGasConsumed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CallbackError", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CallbackError = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Params                         protoreflect.MessageDescriptor
+	fd_Params_block_gas_limit         protoreflect.FieldDescriptor
+	fd_Params_expiry_limit            protoreflect.FieldDescriptor
+	fd_Params_max_scheduled_time      protoreflect.FieldDescriptor
+	fd_Params_clean_up_time           protoreflect.FieldDescriptor
+	fd_Params_creator_block_gas_limit protoreflect.FieldDescriptor
+	fd_Params_aging_interval          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	md_Params = File_dysonprotocol_crontask_v1_crontask_proto.Messages().ByName("Params")
+	fd_Params_block_gas_limit = md_Params.Fields().ByName("block_gas_limit")
+	fd_Params_expiry_limit = md_Params.Fields().ByName("expiry_limit")
+	fd_Params_max_scheduled_time = md_Params.Fields().ByName("max_scheduled_time")
+	fd_Params_clean_up_time = md_Params.Fields().ByName("clean_up_time")
+	fd_Params_creator_block_gas_limit = md_Params.Fields().ByName("creator_block_gas_limit")
+	fd_Params_aging_interval = md_Params.Fields().ByName("aging_interval")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
 // Range iterates over every populated field in an undefined order,
 // calling f for each field descriptor and value encountered.
 // Range returns immediately if f returns false.
@@ -1425,634 +9130,1591 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
-	if x.ExpiryLimit != int64(0) {
-		value := protoreflect.ValueOfInt64(x.ExpiryLimit)
-		if !f(fd_Params_expiry_limit, value) {
-			return
-		}
+	if x.ExpiryLimit != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryLimit)
+		if !f(fd_Params_expiry_limit, value) {
+			return
+		}
+	}
+	if x.MaxScheduledTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxScheduledTime)
+		if !f(fd_Params_max_scheduled_time, value) {
+			return
+		}
+	}
+	if x.CleanUpTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CleanUpTime)
+		if !f(fd_Params_clean_up_time, value) {
+			return
+		}
+	}
+	if x.CreatorBlockGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CreatorBlockGasLimit)
+		if !f(fd_Params_creator_block_gas_limit, value) {
+			return
+		}
+	}
+	if x.AgingInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AgingInterval)
+		if !f(fd_Params_aging_interval, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
+		return x.BlockGasLimit != uint64(0)
+	case "dysonprotocol.crontask.v1.Params.expiry_limit":
+		return x.ExpiryLimit != int64(0)
+	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
+		return x.MaxScheduledTime != int64(0)
+	case "dysonprotocol.crontask.v1.Params.clean_up_time":
+		return x.CleanUpTime != int64(0)
+	case "dysonprotocol.crontask.v1.Params.creator_block_gas_limit":
+		return x.CreatorBlockGasLimit != uint64(0)
+	case "dysonprotocol.crontask.v1.Params.aging_interval":
+		return x.AgingInterval != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
+		x.BlockGasLimit = uint64(0)
+	case "dysonprotocol.crontask.v1.Params.expiry_limit":
+		x.ExpiryLimit = int64(0)
+	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
+		x.MaxScheduledTime = int64(0)
+	case "dysonprotocol.crontask.v1.Params.clean_up_time":
+		x.CleanUpTime = int64(0)
+	case "dysonprotocol.crontask.v1.Params.creator_block_gas_limit":
+		x.CreatorBlockGasLimit = uint64(0)
+	case "dysonprotocol.crontask.v1.Params.aging_interval":
+		x.AgingInterval = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
+		value := x.BlockGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.Params.expiry_limit":
+		value := x.ExpiryLimit
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
+		value := x.MaxScheduledTime
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Params.clean_up_time":
+		value := x.CleanUpTime
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.Params.creator_block_gas_limit":
+		value := x.CreatorBlockGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.Params.aging_interval":
+		value := x.AgingInterval
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
+		x.BlockGasLimit = value.Uint()
+	case "dysonprotocol.crontask.v1.Params.expiry_limit":
+		x.ExpiryLimit = value.Int()
+	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
+		x.MaxScheduledTime = value.Int()
+	case "dysonprotocol.crontask.v1.Params.clean_up_time":
+		x.CleanUpTime = value.Int()
+	case "dysonprotocol.crontask.v1.Params.creator_block_gas_limit":
+		x.CreatorBlockGasLimit = value.Uint()
+	case "dysonprotocol.crontask.v1.Params.aging_interval":
+		x.AgingInterval = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
+		panic(fmt.Errorf("field block_gas_limit of message dysonprotocol.crontask.v1.Params is not mutable"))
+	case "dysonprotocol.crontask.v1.Params.expiry_limit":
+		panic(fmt.Errorf("field expiry_limit of message dysonprotocol.crontask.v1.Params is not mutable"))
+	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
+		panic(fmt.Errorf("field max_scheduled_time of message dysonprotocol.crontask.v1.Params is not mutable"))
+	case "dysonprotocol.crontask.v1.Params.clean_up_time":
+		panic(fmt.Errorf("field clean_up_time of message dysonprotocol.crontask.v1.Params is not mutable"))
+	case "dysonprotocol.crontask.v1.Params.creator_block_gas_limit":
+		panic(fmt.Errorf("field creator_block_gas_limit of message dysonprotocol.crontask.v1.Params is not mutable"))
+	case "dysonprotocol.crontask.v1.Params.aging_interval":
+		panic(fmt.Errorf("field aging_interval of message dysonprotocol.crontask.v1.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.Params.expiry_limit":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Params.clean_up_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.Params.creator_block_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.Params.aging_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.BlockGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockGasLimit))
+		}
+		if x.ExpiryLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryLimit))
+		}
+		if x.MaxScheduledTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxScheduledTime))
+		}
+		if x.CleanUpTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.CleanUpTime))
+		}
+		if x.CreatorBlockGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreatorBlockGasLimit))
+		}
+		if x.AgingInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.AgingInterval))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AgingInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AgingInterval))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CreatorBlockGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreatorBlockGasLimit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CleanUpTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CleanUpTime))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxScheduledTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxScheduledTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.ExpiryLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryLimit))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.BlockGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockGasLimit))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+				}
+				x.BlockGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryLimit", wireType)
+				}
+				x.ExpiryLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryLimit |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledTime", wireType)
+				}
+				x.MaxScheduledTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxScheduledTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CleanUpTime", wireType)
+				}
+				x.CleanUpTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CleanUpTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreatorBlockGasLimit", wireType)
+				}
+				x.CreatorBlockGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreatorBlockGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AgingInterval", wireType)
+				}
+				x.AgingInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AgingInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: dysonprotocol/crontask/v1/crontask.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Task represents a scheduled task in the crontask system
+type Task struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier for the task
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Address of the creator of the task
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Unix timestamp when the task is scheduled to execute
+	ScheduledTimestamp int64 `protobuf:"varint,3,opt,name=scheduled_timestamp,json=scheduledTimestamp,proto3" json:"scheduled_timestamp,omitempty"`
+	// Unix timestamp after which the task will expire if not executed
+	ExpiryTimestamp int64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+	// Maximum gas limit for the task execution
+	TaskGasLimit uint64 `protobuf:"varint,5,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
+	// Gas price for the task execution which is calculated automatically
+	// from task_gas_fee / task_gas_limit
+	TaskGasPrice *v1beta1.Coin `protobuf:"bytes,6,opt,name=task_gas_price,json=taskGasPrice,proto3" json:"task_gas_price,omitempty"`
+	// Gas fee for the task execution
+	TaskGasFee *v1beta1.Coin `protobuf:"bytes,7,opt,name=task_gas_fee,json=taskGasFee,proto3" json:"task_gas_fee,omitempty"`
+	// Messages to execute as part of the task
+	Msgs []*anypb.Any `protobuf:"bytes,8,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// Results of message execution
+	MsgResults []*anypb.Any `protobuf:"bytes,9,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty"`
+	// Current status of the task: Scheduled, Pending, Done, Failed, Expired
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Block timestamp when the task was created
+	CreationTime int64 `protobuf:"varint,11,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// Error message if task execution failed
+	ErrorLog string `protobuf:"bytes,12,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
+	// Actual gas consumed during task execution
+	TaskGasConsumed uint64 `protobuf:"varint,13,opt,name=task_gas_consumed,json=taskGasConsumed,proto3" json:"task_gas_consumed,omitempty"`
+	// Block timestamp when the task was executed (only for DONE / FAILED)
+	ExecutionTimestamp int64 `protobuf:"varint,14,opt,name=execution_timestamp,json=executionTimestamp,proto3" json:"execution_timestamp,omitempty"`
+	// Standard 5-field cron expression (UTC) of a recurring task
+	CronExpression string `protobuf:"bytes,15,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// Fixed interval in seconds between the runs of a recurring task
+	IntervalSeconds int64 `protobuf:"varint,16,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Maximum number of runs of a recurring task, 0 for unlimited
+	MaxOccurrences uint64 `protobuf:"varint,17,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`
+	// Unix timestamp after which a recurring task is not rescheduled, 0 for none
+	EndTimestamp int64 `protobuf:"varint,18,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Number of runs executed or missed so far
+	Occurrences uint64 `protobuf:"varint,19,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	// Most recent runs of the task, oldest first
+	RunHistory []*TaskRun `protobuf:"bytes,20,rep,name=run_history,json=runHistory,proto3" json:"run_history,omitempty"`
+	// Fees held in the module account for the remaining runs of the task
+	Escrow *v1beta1.Coin `protobuf:"bytes,21,opt,name=escrow,proto3" json:"escrow,omitempty"`
+	// Condition that must hold for the task to run. The task only runs between
+	// scheduled_timestamp and expiry_timestamp.
+	Condition *TaskCondition `protobuf:"bytes,22,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Number of times the condition was evaluated
+	ConditionChecks uint64 `protobuf:"varint,23,opt,name=condition_checks,json=conditionChecks,proto3" json:"condition_checks,omitempty"`
+	// Tasks that must finish with the required outcome before the task runs
+	Dependencies []*TaskDependency `protobuf:"bytes,24,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
+	// Optional ID grouping the tasks of a workflow of the creator
+	WorkflowId string `protobuf:"bytes,25,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Number of blocks in which the pending task was deferred for lack of gas
+	Deferrals uint64 `protobuf:"varint,26,opt,name=deferrals,proto3" json:"deferrals,omitempty"`
+	// Phase of the block in which the task runs: BEGIN_BLOCK (default) before
+	// the transactions of the block, END_BLOCK after them
+	ExecutionPhase string `protobuf:"bytes,27,opt,name=execution_phase,json=executionPhase,proto3" json:"execution_phase,omitempty"`
+	// Block height at or after which the task runs, in addition to its
+	// scheduled timestamp, 0 if the task is only scheduled by time
+	ScheduledHeight int64 `protobuf:"varint,28,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+	// Block height when the task was last executed
+	ExecutionHeight int64 `protobuf:"varint,29,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// Policy retrying the failed attempts of a run, none if empty
+	RetryPolicy *RetryPolicy `protobuf:"bytes,30,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Number of failed attempts of the current run
+	Attempts uint32 `protobuf:"varint,31,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Errors of the most recent failed attempts
+	AttemptLog []*TaskAttempt `protobuf:"bytes,32,rep,name=attempt_log,json=attemptLog,proto3" json:"attempt_log,omitempty"`
+	// Unix timestamp of the next attempt of a failed run, 0 when the task is
+	// not waiting for a retry
+	NextAttemptTimestamp int64 `protobuf:"varint,33,opt,name=next_attempt_timestamp,json=nextAttemptTimestamp,proto3" json:"next_attempt_timestamp,omitempty"`
+	// Script function called after every run of the task finishes, none if
+	// empty
+	Callback *TaskCallback `protobuf:"bytes,34,opt,name=callback,proto3" json:"callback,omitempty"`
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *Task) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *Task) GetScheduledTimestamp() int64 {
+	if x != nil {
+		return x.ScheduledTimestamp
+	}
+	return 0
+}
+
+func (x *Task) GetExpiryTimestamp() int64 {
+	if x != nil {
+		return x.ExpiryTimestamp
+	}
+	return 0
+}
+
+func (x *Task) GetTaskGasLimit() uint64 {
+	if x != nil {
+		return x.TaskGasLimit
+	}
+	return 0
+}
+
+func (x *Task) GetTaskGasPrice() *v1beta1.Coin {
+	if x != nil {
+		return x.TaskGasPrice
+	}
+	return nil
+}
+
+func (x *Task) GetTaskGasFee() *v1beta1.Coin {
+	if x != nil {
+		return x.TaskGasFee
+	}
+	return nil
+}
+
+func (x *Task) GetMsgs() []*anypb.Any {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *Task) GetMsgResults() []*anypb.Any {
+	if x != nil {
+		return x.MsgResults
+	}
+	return nil
+}
+
+func (x *Task) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Task) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *Task) GetErrorLog() string {
+	if x != nil {
+		return x.ErrorLog
+	}
+	return ""
+}
+
+func (x *Task) GetTaskGasConsumed() uint64 {
+	if x != nil {
+		return x.TaskGasConsumed
+	}
+	return 0
+}
+
+func (x *Task) GetExecutionTimestamp() int64 {
+	if x != nil {
+		return x.ExecutionTimestamp
+	}
+	return 0
+}
+
+func (x *Task) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *Task) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *Task) GetMaxOccurrences() uint64 {
+	if x != nil {
+		return x.MaxOccurrences
+	}
+	return 0
+}
+
+func (x *Task) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *Task) GetOccurrences() uint64 {
+	if x != nil {
+		return x.Occurrences
+	}
+	return 0
+}
+
+func (x *Task) GetRunHistory() []*TaskRun {
+	if x != nil {
+		return x.RunHistory
+	}
+	return nil
+}
+
+func (x *Task) GetEscrow() *v1beta1.Coin {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+func (x *Task) GetCondition() *TaskCondition {
+	if x != nil {
+		return x.Condition
+	}
+	return nil
+}
+
+func (x *Task) GetConditionChecks() uint64 {
+	if x != nil {
+		return x.ConditionChecks
+	}
+	return 0
+}
+
+func (x *Task) GetDependencies() []*TaskDependency {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *Task) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *Task) GetDeferrals() uint64 {
+	if x != nil {
+		return x.Deferrals
+	}
+	return 0
+}
+
+func (x *Task) GetExecutionPhase() string {
+	if x != nil {
+		return x.ExecutionPhase
+	}
+	return ""
+}
+
+func (x *Task) GetScheduledHeight() int64 {
+	if x != nil {
+		return x.ScheduledHeight
+	}
+	return 0
+}
+
+func (x *Task) GetExecutionHeight() int64 {
+	if x != nil {
+		return x.ExecutionHeight
+	}
+	return 0
+}
+
+func (x *Task) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Task) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Task) GetAttemptLog() []*TaskAttempt {
+	if x != nil {
+		return x.AttemptLog
+	}
+	return nil
+}
+
+func (x *Task) GetNextAttemptTimestamp() int64 {
+	if x != nil {
+		return x.NextAttemptTimestamp
+	}
+	return 0
+}
+
+func (x *Task) GetCallback() *TaskCallback {
+	if x != nil {
+		return x.Callback
+	}
+	return nil
+}
+
+// TaskCallback calls a script function after a run of a task is DONE, FAILED
+// or EXPIRED. The function is executed by the task creator with the task_id,
+// status, results, gas_used and error_log of the run as kwargs, on its own gas
+// limit charged to the task escrow. A failing callback is recorded in the run
+// and does not revert the run.
+type TaskCallback struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	FunctionName  string `protobuf:"bytes,2,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// Maximum gas of the callback, 200000 by default
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (x *TaskCallback) Reset() {
+	*x = TaskCallback{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskCallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskCallback) ProtoMessage() {}
+
+// Deprecated: Use TaskCallback.ProtoReflect.Descriptor instead.
+func (*TaskCallback) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TaskCallback) GetScriptAddress() string {
+	if x != nil {
+		return x.ScriptAddress
+	}
+	return ""
+}
+
+func (x *TaskCallback) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *TaskCallback) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+// RetryPolicy retries a run whose messages failed until it succeeds, the
+// attempts are exhausted or the run expires
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Maximum number of attempts of a run, including the first one
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Backoff between attempts: FIXED (default) or EXPONENTIAL
+	Backoff string `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// Delay in seconds before the first retry. An EXPONENTIAL backoff doubles
+	// it after every failed attempt.
+	BackoffSeconds int64 `protobuf:"varint,3,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoff_seconds,omitempty"`
+	// Maximum delay in seconds between attempts, 0 for no maximum
+	MaxBackoffSeconds int64 `protobuf:"varint,4,opt,name=max_backoff_seconds,json=maxBackoffSeconds,proto3" json:"max_backoff_seconds,omitempty"`
+	// Errors that are retried, as "codespace" or "codespace:code" (e.g.
+	// "sdk:5" for insufficient funds). Every error is retried if empty.
+	RetryableErrors []string `protobuf:"bytes,5,rep,name=retryable_errors,json=retryableErrors,proto3" json:"retryable_errors,omitempty"`
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoff() string {
+	if x != nil {
+		return x.Backoff
+	}
+	return ""
+}
+
+func (x *RetryPolicy) GetBackoffSeconds() int64 {
+	if x != nil {
+		return x.BackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaxBackoffSeconds() int64 {
+	if x != nil {
+		return x.MaxBackoffSeconds
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryableErrors() []string {
+	if x != nil {
+		return x.RetryableErrors
+	}
+	return nil
+}
+
+// TaskAttempt is a failed attempt of a run of a task
+type TaskAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 1-based number of the attempt within its run
+	Attempt uint32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Block timestamp of the attempt
+	ExecutionTimestamp int64 `protobuf:"varint,2,opt,name=execution_timestamp,json=executionTimestamp,proto3" json:"execution_timestamp,omitempty"`
+	// Block height of the attempt
+	ExecutionHeight int64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// Codespace and code of the error
+	Codespace string `protobuf:"bytes,4,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,5,opt,name=code,proto3" json:"code,omitempty"`
+	// Error message of the attempt
+	ErrorLog string `protobuf:"bytes,6,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
+	// Whether another attempt was scheduled
+	Retried bool `protobuf:"varint,7,opt,name=retried,proto3" json:"retried,omitempty"`
+}
+
+func (x *TaskAttempt) Reset() {
+	*x = TaskAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskAttempt) ProtoMessage() {}
+
+// Deprecated: Use TaskAttempt.ProtoReflect.Descriptor instead.
+func (*TaskAttempt) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TaskAttempt) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *TaskAttempt) GetExecutionTimestamp() int64 {
+	if x != nil {
+		return x.ExecutionTimestamp
+	}
+	return 0
+}
+
+func (x *TaskAttempt) GetExecutionHeight() int64 {
+	if x != nil {
+		return x.ExecutionHeight
+	}
+	return 0
+}
+
+func (x *TaskAttempt) GetCodespace() string {
+	if x != nil {
+		return x.Codespace
+	}
+	return ""
+}
+
+func (x *TaskAttempt) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *TaskAttempt) GetErrorLog() string {
+	if x != nil {
+		return x.ErrorLog
+	}
+	return ""
+}
+
+func (x *TaskAttempt) GetRetried() bool {
+	if x != nil {
+		return x.Retried
+	}
+	return false
+}
+
+// TaskDependency is an edge from a parent task to a dependent task
+type TaskDependency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ID of the parent task
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Outcome of the parent required to run the dependent task: SUCCESS when
+	// the parent is DONE, FAILURE when it FAILED or EXPIRED
+	Outcome string `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *TaskDependency) Reset() {
+	*x = TaskDependency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskDependency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskDependency) ProtoMessage() {}
+
+// Deprecated: Use TaskDependency.ProtoReflect.Descriptor instead.
+func (*TaskDependency) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TaskDependency) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
 	}
-	if x.MaxScheduledTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.MaxScheduledTime)
-		if !f(fd_Params_max_scheduled_time, value) {
-			return
-		}
+	return 0
+}
+
+func (x *TaskDependency) GetOutcome() string {
+	if x != nil {
+		return x.Outcome
 	}
-	if x.CleanUpTime != int64(0) {
-		value := protoreflect.ValueOfInt64(x.CleanUpTime)
-		if !f(fd_Params_clean_up_time, value) {
-			return
-		}
+	return ""
+}
+
+// TaskCondition makes a task run once a condition on the chain state holds.
+// Exactly one of the conditions must be set. Every evaluation is charged to
+// the task escrow at the task gas price.
+type TaskCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Runs when the balance of an account drops below an amount
+	BalanceBelow *BalanceBelowCondition `protobuf:"bytes,1,opt,name=balance_below,json=balanceBelow,proto3" json:"balance_below,omitempty"`
+	// Runs when a storage entry is set, changed or deleted
+	StorageChanged *StorageChangedCondition `protobuf:"bytes,2,opt,name=storage_changed,json=storageChanged,proto3" json:"storage_changed,omitempty"`
+	// Runs when the valuation of a name expires
+	NameExpired *NameExpiredCondition `protobuf:"bytes,3,opt,name=name_expired,json=nameExpired,proto3" json:"name_expired,omitempty"`
+	// Runs when a script function returns true
+	ScriptView *ScriptViewCondition `protobuf:"bytes,4,opt,name=script_view,json=scriptView,proto3" json:"script_view,omitempty"`
+	// Gas limit of a single evaluation, defaults to 100000
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Amount added to the task escrow at creation to pay for the evaluations
+	Deposit *v1beta1.Coin `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *TaskCondition) Reset() {
+	*x = TaskCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
-		return x.BlockGasLimit != uint64(0)
-	case "dysonprotocol.crontask.v1.Params.expiry_limit":
-		return x.ExpiryLimit != int64(0)
-	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
-		return x.MaxScheduledTime != int64(0)
-	case "dysonprotocol.crontask.v1.Params.clean_up_time":
-		return x.CleanUpTime != int64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
-		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+func (x *TaskCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskCondition) ProtoMessage() {}
+
+// Deprecated: Use TaskCondition.ProtoReflect.Descriptor instead.
+func (*TaskCondition) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TaskCondition) GetBalanceBelow() *BalanceBelowCondition {
+	if x != nil {
+		return x.BalanceBelow
 	}
+	return nil
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
-		x.BlockGasLimit = uint64(0)
-	case "dysonprotocol.crontask.v1.Params.expiry_limit":
-		x.ExpiryLimit = int64(0)
-	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
-		x.MaxScheduledTime = int64(0)
-	case "dysonprotocol.crontask.v1.Params.clean_up_time":
-		x.CleanUpTime = int64(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
-		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+func (x *TaskCondition) GetStorageChanged() *StorageChangedCondition {
+	if x != nil {
+		return x.StorageChanged
 	}
+	return nil
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
-		value := x.BlockGasLimit
-		return protoreflect.ValueOfUint64(value)
-	case "dysonprotocol.crontask.v1.Params.expiry_limit":
-		value := x.ExpiryLimit
-		return protoreflect.ValueOfInt64(value)
-	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
-		value := x.MaxScheduledTime
-		return protoreflect.ValueOfInt64(value)
-	case "dysonprotocol.crontask.v1.Params.clean_up_time":
-		value := x.CleanUpTime
-		return protoreflect.ValueOfInt64(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
-		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", descriptor.FullName()))
+func (x *TaskCondition) GetNameExpired() *NameExpiredCondition {
+	if x != nil {
+		return x.NameExpired
 	}
+	return nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
-		x.BlockGasLimit = value.Uint()
-	case "dysonprotocol.crontask.v1.Params.expiry_limit":
-		x.ExpiryLimit = value.Int()
-	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
-		x.MaxScheduledTime = value.Int()
-	case "dysonprotocol.crontask.v1.Params.clean_up_time":
-		x.CleanUpTime = value.Int()
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
-		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+func (x *TaskCondition) GetScriptView() *ScriptViewCondition {
+	if x != nil {
+		return x.ScriptView
 	}
+	return nil
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
-		panic(fmt.Errorf("field block_gas_limit of message dysonprotocol.crontask.v1.Params is not mutable"))
-	case "dysonprotocol.crontask.v1.Params.expiry_limit":
-		panic(fmt.Errorf("field expiry_limit of message dysonprotocol.crontask.v1.Params is not mutable"))
-	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
-		panic(fmt.Errorf("field max_scheduled_time of message dysonprotocol.crontask.v1.Params is not mutable"))
-	case "dysonprotocol.crontask.v1.Params.clean_up_time":
-		panic(fmt.Errorf("field clean_up_time of message dysonprotocol.crontask.v1.Params is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
-		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+func (x *TaskCondition) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
 	}
+	return 0
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.Params.block_gas_limit":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "dysonprotocol.crontask.v1.Params.expiry_limit":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "dysonprotocol.crontask.v1.Params.max_scheduled_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	case "dysonprotocol.crontask.v1.Params.clean_up_time":
-		return protoreflect.ValueOfInt64(int64(0))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.Params"))
-		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.Params does not contain field %s", fd.FullName()))
+func (x *TaskCondition) GetDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
 	}
+	return nil
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.Params", d.FullName()))
+// BalanceBelowCondition holds when the balance of address in the denom of
+// amount is lower than amount
+type BalanceBelowCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *BalanceBelowCondition) Reset() {
+	*x = BalanceBelowCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	panic("unreachable")
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *BalanceBelowCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (*BalanceBelowCondition) ProtoMessage() {}
+
+// Deprecated: Use BalanceBelowCondition.ProtoReflect.Descriptor instead.
+func (*BalanceBelowCondition) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BalanceBelowCondition) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *BalanceBelowCondition) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// StorageChangedCondition holds when the storage entry (owner, index) differs
+// from the entry seen when the task was scheduled
+type StorageChangedCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Last update height of the entry when the task was scheduled, 0 if missing
+	UpdatedHeight uint64 `protobuf:"varint,3,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// SHA-256 of the entry data when the task was scheduled, empty if missing
+	DataHash []byte `protobuf:"bytes,4,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+}
+
+func (x *StorageChangedCondition) Reset() {
+	*x = StorageChangedCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageChangedCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
-	return x != nil
+func (*StorageChangedCondition) ProtoMessage() {}
+
+// Deprecated: Use StorageChangedCondition.ProtoReflect.Descriptor instead.
+func (*StorageChangedCondition) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{7}
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.BlockGasLimit != 0 {
-			n += 1 + runtime.Sov(uint64(x.BlockGasLimit))
-		}
-		if x.ExpiryLimit != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExpiryLimit))
-		}
-		if x.MaxScheduledTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.MaxScheduledTime))
-		}
-		if x.CleanUpTime != 0 {
-			n += 1 + runtime.Sov(uint64(x.CleanUpTime))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *StorageChangedCondition) GetOwner() string {
+	if x != nil {
+		return x.Owner
 	}
+	return ""
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.CleanUpTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.CleanUpTime))
-			i--
-			dAtA[i] = 0x20
-		}
-		if x.MaxScheduledTime != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxScheduledTime))
-			i--
-			dAtA[i] = 0x18
-		}
-		if x.ExpiryLimit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryLimit))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.BlockGasLimit != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockGasLimit))
-			i--
-			dAtA[i] = 0x8
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
+func (x *StorageChangedCondition) GetIndex() string {
+	if x != nil {
+		return x.Index
 	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
-				}
-				x.BlockGasLimit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.BlockGasLimit |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryLimit", wireType)
-				}
-				x.ExpiryLimit = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.ExpiryLimit |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxScheduledTime", wireType)
-				}
-				x.MaxScheduledTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.MaxScheduledTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CleanUpTime", wireType)
-				}
-				x.CleanUpTime = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.CleanUpTime |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-				}
-				iNdEx += skippy
-			}
-		}
+	return ""
+}
 
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+func (x *StorageChangedCondition) GetUpdatedHeight() uint64 {
+	if x != nil {
+		return x.UpdatedHeight
 	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+	return 0
+}
+
+func (x *StorageChangedCondition) GetDataHash() []byte {
+	if x != nil {
+		return x.DataHash
 	}
+	return nil
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: dysonprotocol/crontask/v1/crontask.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Task represents a scheduled task in the crontask system
-type Task struct {
+// NameExpiredCondition holds when the valuation of a nameservice name has
+// expired
+type NameExpiredCondition struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique identifier for the task
-	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	// Address of the creator of the task
-	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
-	// Unix timestamp when the task is scheduled to execute
-	ScheduledTimestamp int64 `protobuf:"varint,3,opt,name=scheduled_timestamp,json=scheduledTimestamp,proto3" json:"scheduled_timestamp,omitempty"`
-	// Unix timestamp after which the task will expire if not executed
-	ExpiryTimestamp int64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
-	// Maximum gas limit for the task execution
-	TaskGasLimit uint64 `protobuf:"varint,5,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
-	// Gas price for the task execution which is calculated automatically
-	// from task_gas_fee / task_gas_limit
-	TaskGasPrice *v1beta1.Coin `protobuf:"bytes,6,opt,name=task_gas_price,json=taskGasPrice,proto3" json:"task_gas_price,omitempty"`
-	// Gas fee for the task execution
-	TaskGasFee *v1beta1.Coin `protobuf:"bytes,7,opt,name=task_gas_fee,json=taskGasFee,proto3" json:"task_gas_fee,omitempty"`
-	// Messages to execute as part of the task
-	Msgs []*anypb.Any `protobuf:"bytes,8,rep,name=msgs,proto3" json:"msgs,omitempty"`
-	// Results of message execution
-	MsgResults []*anypb.Any `protobuf:"bytes,9,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty"`
-	// Current status of the task: Scheduled, Pending, Done, Failed, Expired
-	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
-	// Block timestamp when the task was created
-	CreationTime int64 `protobuf:"varint,11,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	// Error message if task execution failed
-	ErrorLog string `protobuf:"bytes,12,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
-	// Actual gas consumed during task execution
-	TaskGasConsumed uint64 `protobuf:"varint,13,opt,name=task_gas_consumed,json=taskGasConsumed,proto3" json:"task_gas_consumed,omitempty"`
-	// Block timestamp when the task was executed (only for DONE / FAILED)
-	ExecutionTimestamp int64 `protobuf:"varint,14,opt,name=execution_timestamp,json=executionTimestamp,proto3" json:"execution_timestamp,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
+func (x *NameExpiredCondition) Reset() {
+	*x = NameExpiredCondition{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[0]
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Task) String() string {
+func (x *NameExpiredCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*NameExpiredCondition) ProtoMessage() {}
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use NameExpiredCondition.ProtoReflect.Descriptor instead.
+func (*NameExpiredCondition) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Task) GetTaskId() uint64 {
+func (x *NameExpiredCondition) GetName() string {
 	if x != nil {
-		return x.TaskId
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *Task) GetCreator() string {
+// ScriptViewCondition holds when a script function returns true. The function
+// is executed by the task creator and its state changes are discarded.
+type ScriptViewCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	FunctionName  string `protobuf:"bytes,2,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// JSON encoded positional arguments
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// JSON encoded keyword arguments
+	Kwargs string `protobuf:"bytes,4,opt,name=kwargs,proto3" json:"kwargs,omitempty"`
+}
+
+func (x *ScriptViewCondition) Reset() {
+	*x = ScriptViewCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScriptViewCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScriptViewCondition) ProtoMessage() {}
+
+// Deprecated: Use ScriptViewCondition.ProtoReflect.Descriptor instead.
+func (*ScriptViewCondition) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ScriptViewCondition) GetScriptAddress() string {
 	if x != nil {
-		return x.Creator
+		return x.ScriptAddress
 	}
 	return ""
 }
 
-func (x *Task) GetScheduledTimestamp() int64 {
+func (x *ScriptViewCondition) GetFunctionName() string {
 	if x != nil {
-		return x.ScheduledTimestamp
+		return x.FunctionName
 	}
-	return 0
+	return ""
 }
 
-func (x *Task) GetExpiryTimestamp() int64 {
+func (x *ScriptViewCondition) GetArgs() string {
 	if x != nil {
-		return x.ExpiryTimestamp
+		return x.Args
 	}
-	return 0
+	return ""
 }
 
-func (x *Task) GetTaskGasLimit() uint64 {
+func (x *ScriptViewCondition) GetKwargs() string {
 	if x != nil {
-		return x.TaskGasLimit
+		return x.Kwargs
 	}
-	return 0
+	return ""
 }
 
-func (x *Task) GetTaskGasPrice() *v1beta1.Coin {
-	if x != nil {
-		return x.TaskGasPrice
+// TaskRun records the outcome of a single run of a task
+type TaskRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 1-based number of the run
+	Occurrence uint64 `protobuf:"varint,1,opt,name=occurrence,proto3" json:"occurrence,omitempty"`
+	// Unix timestamp the run was scheduled at
+	ScheduledTimestamp int64 `protobuf:"varint,2,opt,name=scheduled_timestamp,json=scheduledTimestamp,proto3" json:"scheduled_timestamp,omitempty"`
+	// Block timestamp when the run was executed or expired
+	ExecutionTimestamp int64 `protobuf:"varint,3,opt,name=execution_timestamp,json=executionTimestamp,proto3" json:"execution_timestamp,omitempty"`
+	// Status of the run: Done, Failed or Expired
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Gas consumed by the run
+	GasConsumed uint64 `protobuf:"varint,5,opt,name=gas_consumed,json=gasConsumed,proto3" json:"gas_consumed,omitempty"`
+	// Fee charged for the run, the gas consumed at the task gas price
+	Fee *v1beta1.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Error message if the run failed
+	ErrorLog string `protobuf:"bytes,7,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
+	// Block height when the run was executed or expired
+	ExecutionHeight int64 `protobuf:"varint,8,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// Gas consumed by the callback of the run
+	CallbackGasConsumed uint64 `protobuf:"varint,9,opt,name=callback_gas_consumed,json=callbackGasConsumed,proto3" json:"callback_gas_consumed,omitempty"`
+	// Error of the callback of the run, empty if it succeeded or none is set
+	CallbackError string `protobuf:"bytes,10,opt,name=callback_error,json=callbackError,proto3" json:"callback_error,omitempty"`
+}
+
+func (x *TaskRun) Reset() {
+	*x = TaskRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Task) GetTaskGasFee() *v1beta1.Coin {
+func (x *TaskRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskRun) ProtoMessage() {}
+
+// Deprecated: Use TaskRun.ProtoReflect.Descriptor instead.
+func (*TaskRun) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TaskRun) GetOccurrence() uint64 {
 	if x != nil {
-		return x.TaskGasFee
+		return x.Occurrence
 	}
-	return nil
+	return 0
 }
 
-func (x *Task) GetMsgs() []*anypb.Any {
+func (x *TaskRun) GetScheduledTimestamp() int64 {
 	if x != nil {
-		return x.Msgs
+		return x.ScheduledTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *Task) GetMsgResults() []*anypb.Any {
+func (x *TaskRun) GetExecutionTimestamp() int64 {
 	if x != nil {
-		return x.MsgResults
+		return x.ExecutionTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (x *Task) GetStatus() string {
+func (x *TaskRun) GetStatus() string {
 	if x != nil {
 		return x.Status
 	}
 	return ""
 }
 
-func (x *Task) GetCreationTime() int64 {
+func (x *TaskRun) GetGasConsumed() uint64 {
 	if x != nil {
-		return x.CreationTime
+		return x.GasConsumed
 	}
 	return 0
 }
 
-func (x *Task) GetErrorLog() string {
+func (x *TaskRun) GetFee() *v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *TaskRun) GetErrorLog() string {
 	if x != nil {
 		return x.ErrorLog
 	}
 	return ""
 }
 
-func (x *Task) GetTaskGasConsumed() uint64 {
+func (x *TaskRun) GetExecutionHeight() int64 {
 	if x != nil {
-		return x.TaskGasConsumed
+		return x.ExecutionHeight
 	}
 	return 0
 }
 
-func (x *Task) GetExecutionTimestamp() int64 {
+func (x *TaskRun) GetCallbackGasConsumed() uint64 {
 	if x != nil {
-		return x.ExecutionTimestamp
+		return x.CallbackGasConsumed
 	}
 	return 0
 }
 
+func (x *TaskRun) GetCallbackError() string {
+	if x != nil {
+		return x.CallbackError
+	}
+	return ""
+}
+
 // Params defines the parameters for the crontask module
 type Params struct {
 	state         protoimpl.MessageState
@@ -2067,12 +10729,18 @@ type Params struct {
 	MaxScheduledTime int64 `protobuf:"varint,3,opt,name=max_scheduled_time,json=maxScheduledTime,proto3" json:"max_scheduled_time,omitempty"`
 	// Retention period for completed/failed/expired tasks (seconds)
 	CleanUpTime int64 `protobuf:"varint,4,opt,name=clean_up_time,json=cleanUpTime,proto3" json:"clean_up_time,omitempty"`
+	// Maximum gas the tasks of a single creator may use per block, 0 for no
+	// quota
+	CreatorBlockGasLimit uint64 `protobuf:"varint,5,opt,name=creator_block_gas_limit,json=creatorBlockGasLimit,proto3" json:"creator_block_gas_limit,omitempty"`
+	// Seconds a pending task has to wait for its priority to grow by its gas
+	// price, 0 to order pending tasks by gas price only
+	AgingInterval int64 `protobuf:"varint,6,opt,name=aging_interval,json=agingInterval,proto3" json:"aging_interval,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[1]
+		mi := &file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2086,7 +10754,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{1}
+	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Params) GetBlockGasLimit() uint64 {
@@ -2117,6 +10785,20 @@ func (x *Params) GetCleanUpTime() int64 {
 	return 0
 }
 
+func (x *Params) GetCreatorBlockGasLimit() uint64 {
+	if x != nil {
+		return x.CreatorBlockGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetAgingInterval() int64 {
+	if x != nil {
+		return x.AgingInterval
+	}
+	return 0
+}
+
 var File_dysonprotocol_crontask_v1_crontask_proto protoreflect.FileDescriptor
 
 var file_dysonprotocol_crontask_v1_crontask_proto_rawDesc = []byte{
@@ -2135,7 +10817,7 @@ var file_dysonprotocol_crontask_v1_crontask_proto_rawDesc = []byte{
 	0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x05,
+	0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x0d,
 	0x0a, 0x04, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
 	0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -2176,20 +10858,216 @@ var file_dysonprotocol_crontask_v1_crontask_proto_rawDesc = []byte{
 	0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x65,
 	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0xa5, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x75,
-	0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c,
-	0x65, 0x61, 0x6e, 0x55, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x64, 0x79, 0x73,
-	0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78,
-	0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x72, 0x6f, 0x6e,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x0b, 0x72, 0x75, 0x6e, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x64, 0x79, 0x73,
+	0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74,
+	0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x72, 0x75, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x3c, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72, 0x6f, 0x77, 0x12, 0x46,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61,
+	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x12, 0x58, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65,
+	0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e,
+	0x63, 0x79, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x64,
+	0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x72, 0x61, 0x6c, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68,
+	0x61, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x29,
+	0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x52, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x18,
+	0x20, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x4c, 0x6f, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x43, 0x0a, 0x08, 0x63, 0x61,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x64,
+	0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x08, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x22,
+	0x77, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12,
+	0x25, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67,
+	0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xce, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e,
+	0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x6d, 0x61, 0x78,
+	0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x29,
+	0x0a, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x61,
+	0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0xec, 0x01, 0x0a, 0x0b, 0x54, 0x61,
+	0x73, 0x6b, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x0e, 0x54, 0x61, 0x73, 0x6b,
+	0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61,
+	0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x61, 0x73,
+	0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x22, 0xc5, 0x03,
+	0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x55, 0x0a, 0x0d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x77,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x43,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x12, 0x5b, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x12, 0x52, 0x0a, 0x0c, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x64, 0x79, 0x73, 0x6f,
+	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6e, 0x61, 0x6d, 0x65,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x0b, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x64,
+	0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56,
+	0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x42, 0x65, 0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0xa3, 0x01, 0x0a, 0x17, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x48, 0x61, 0x73, 0x68, 0x22, 0x2a, 0x0a, 0x14, 0x4e, 0x61, 0x6d, 0x65, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x13, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x56, 0x69,
+	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6b,
+	0x77, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x77, 0x61,
+	0x72, 0x67, 0x73, 0x22, 0xa1, 0x03, 0x0a, 0x07, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x75, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x2f, 0x0a, 0x13, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x61, 0x73,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x03,
+	0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6c, 0x6f,
+	0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x6f,
+	0x67, 0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x75, 0x6d, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x63, 0x61, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x83, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a,
+	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x55, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x35, 0x0a, 0x17, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x42, 0x24, 0x5a,
+	0x22, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2204,23 +11082,47 @@ func file_dysonprotocol_crontask_v1_crontask_proto_rawDescGZIP() []byte {
 	return file_dysonprotocol_crontask_v1_crontask_proto_rawDescData
 }
 
-var file_dysonprotocol_crontask_v1_crontask_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_dysonprotocol_crontask_v1_crontask_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_dysonprotocol_crontask_v1_crontask_proto_goTypes = []interface{}{
-	(*Task)(nil),         // 0: dysonprotocol.crontask.v1.Task
-	(*Params)(nil),       // 1: dysonprotocol.crontask.v1.Params
-	(*v1beta1.Coin)(nil), // 2: cosmos.base.v1beta1.Coin
-	(*anypb.Any)(nil),    // 3: google.protobuf.Any
+	(*Task)(nil),                    // 0: dysonprotocol.crontask.v1.Task
+	(*TaskCallback)(nil),            // 1: dysonprotocol.crontask.v1.TaskCallback
+	(*RetryPolicy)(nil),             // 2: dysonprotocol.crontask.v1.RetryPolicy
+	(*TaskAttempt)(nil),             // 3: dysonprotocol.crontask.v1.TaskAttempt
+	(*TaskDependency)(nil),          // 4: dysonprotocol.crontask.v1.TaskDependency
+	(*TaskCondition)(nil),           // 5: dysonprotocol.crontask.v1.TaskCondition
+	(*BalanceBelowCondition)(nil),   // 6: dysonprotocol.crontask.v1.BalanceBelowCondition
+	(*StorageChangedCondition)(nil), // 7: dysonprotocol.crontask.v1.StorageChangedCondition
+	(*NameExpiredCondition)(nil),    // 8: dysonprotocol.crontask.v1.NameExpiredCondition
+	(*ScriptViewCondition)(nil),     // 9: dysonprotocol.crontask.v1.ScriptViewCondition
+	(*TaskRun)(nil),                 // 10: dysonprotocol.crontask.v1.TaskRun
+	(*Params)(nil),                  // 11: dysonprotocol.crontask.v1.Params
+	(*v1beta1.Coin)(nil),            // 12: cosmos.base.v1beta1.Coin
+	(*anypb.Any)(nil),               // 13: google.protobuf.Any
 }
 var file_dysonprotocol_crontask_v1_crontask_proto_depIdxs = []int32{
-	2, // 0: dysonprotocol.crontask.v1.Task.task_gas_price:type_name -> cosmos.base.v1beta1.Coin
-	2, // 1: dysonprotocol.crontask.v1.Task.task_gas_fee:type_name -> cosmos.base.v1beta1.Coin
-	3, // 2: dysonprotocol.crontask.v1.Task.msgs:type_name -> google.protobuf.Any
-	3, // 3: dysonprotocol.crontask.v1.Task.msg_results:type_name -> google.protobuf.Any
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	12, // 0: dysonprotocol.crontask.v1.Task.task_gas_price:type_name -> cosmos.base.v1beta1.Coin
+	12, // 1: dysonprotocol.crontask.v1.Task.task_gas_fee:type_name -> cosmos.base.v1beta1.Coin
+	13, // 2: dysonprotocol.crontask.v1.Task.msgs:type_name -> google.protobuf.Any
+	13, // 3: dysonprotocol.crontask.v1.Task.msg_results:type_name -> google.protobuf.Any
+	10, // 4: dysonprotocol.crontask.v1.Task.run_history:type_name -> dysonprotocol.crontask.v1.TaskRun
+	12, // 5: dysonprotocol.crontask.v1.Task.escrow:type_name -> cosmos.base.v1beta1.Coin
+	5,  // 6: dysonprotocol.crontask.v1.Task.condition:type_name -> dysonprotocol.crontask.v1.TaskCondition
+	4,  // 7: dysonprotocol.crontask.v1.Task.dependencies:type_name -> dysonprotocol.crontask.v1.TaskDependency
+	2,  // 8: dysonprotocol.crontask.v1.Task.retry_policy:type_name -> dysonprotocol.crontask.v1.RetryPolicy
+	3,  // 9: dysonprotocol.crontask.v1.Task.attempt_log:type_name -> dysonprotocol.crontask.v1.TaskAttempt
+	1,  // 10: dysonprotocol.crontask.v1.Task.callback:type_name -> dysonprotocol.crontask.v1.TaskCallback
+	6,  // 11: dysonprotocol.crontask.v1.TaskCondition.balance_below:type_name -> dysonprotocol.crontask.v1.BalanceBelowCondition
+	7,  // 12: dysonprotocol.crontask.v1.TaskCondition.storage_changed:type_name -> dysonprotocol.crontask.v1.StorageChangedCondition
+	8,  // 13: dysonprotocol.crontask.v1.TaskCondition.name_expired:type_name -> dysonprotocol.crontask.v1.NameExpiredCondition
+	9,  // 14: dysonprotocol.crontask.v1.TaskCondition.script_view:type_name -> dysonprotocol.crontask.v1.ScriptViewCondition
+	12, // 15: dysonprotocol.crontask.v1.TaskCondition.deposit:type_name -> cosmos.base.v1beta1.Coin
+	12, // 16: dysonprotocol.crontask.v1.BalanceBelowCondition.amount:type_name -> cosmos.base.v1beta1.Coin
+	12, // 17: dysonprotocol.crontask.v1.TaskRun.fee:type_name -> cosmos.base.v1beta1.Coin
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_dysonprotocol_crontask_v1_crontask_proto_init() }
@@ -2242,6 +11144,126 @@ func file_dysonprotocol_crontask_v1_crontask_proto_init() {
 			}
 		}
 		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskCallback); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskDependency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceBelowCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageChangedCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NameExpiredCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScriptViewCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_crontask_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -2260,7 +11282,7 @@ func file_dysonprotocol_crontask_v1_crontask_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dysonprotocol_crontask_v1_crontask_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
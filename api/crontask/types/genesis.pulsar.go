@@ -63,11 +63,64 @@ func (x *_GenesisState_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*PayoutSchedule
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayoutSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayoutSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(PayoutSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(PayoutSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState              protoreflect.MessageDescriptor
-	fd_GenesisState_tasks        protoreflect.FieldDescriptor
-	fd_GenesisState_next_task_id protoreflect.FieldDescriptor
-	fd_GenesisState_params       protoreflect.FieldDescriptor
+	md_GenesisState                  protoreflect.MessageDescriptor
+	fd_GenesisState_tasks            protoreflect.FieldDescriptor
+	fd_GenesisState_next_task_id     protoreflect.FieldDescriptor
+	fd_GenesisState_params           protoreflect.FieldDescriptor
+	fd_GenesisState_payout_schedules protoreflect.FieldDescriptor
+	fd_GenesisState_next_payout_id   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -76,6 +129,8 @@ func init() {
 	fd_GenesisState_tasks = md_GenesisState.Fields().ByName("tasks")
 	fd_GenesisState_next_task_id = md_GenesisState.Fields().ByName("next_task_id")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_payout_schedules = md_GenesisState.Fields().ByName("payout_schedules")
+	fd_GenesisState_next_payout_id = md_GenesisState.Fields().ByName("next_payout_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -161,6 +216,18 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PayoutSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.PayoutSchedules})
+		if !f(fd_GenesisState_payout_schedules, value) {
+			return
+		}
+	}
+	if x.NextPayoutId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextPayoutId)
+		if !f(fd_GenesisState_next_payout_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -182,6 +249,10 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.NextTaskId != uint64(0)
 	case "dysonprotocol.crontask.v1.GenesisState.params":
 		return x.Params != nil
+	case "dysonprotocol.crontask.v1.GenesisState.payout_schedules":
+		return len(x.PayoutSchedules) != 0
+	case "dysonprotocol.crontask.v1.GenesisState.next_payout_id":
+		return x.NextPayoutId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.GenesisState"))
@@ -204,6 +275,10 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.NextTaskId = uint64(0)
 	case "dysonprotocol.crontask.v1.GenesisState.params":
 		x.Params = nil
+	case "dysonprotocol.crontask.v1.GenesisState.payout_schedules":
+		x.PayoutSchedules = nil
+	case "dysonprotocol.crontask.v1.GenesisState.next_payout_id":
+		x.NextPayoutId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.GenesisState"))
@@ -232,6 +307,15 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "dysonprotocol.crontask.v1.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.GenesisState.payout_schedules":
+		if len(x.PayoutSchedules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.PayoutSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.GenesisState.next_payout_id":
+		value := x.NextPayoutId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.GenesisState"))
@@ -260,6 +344,12 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.NextTaskId = value.Uint()
 	case "dysonprotocol.crontask.v1.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "dysonprotocol.crontask.v1.GenesisState.payout_schedules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.PayoutSchedules = *clv.list
+	case "dysonprotocol.crontask.v1.GenesisState.next_payout_id":
+		x.NextPayoutId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.GenesisState"))
@@ -291,8 +381,16 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "dysonprotocol.crontask.v1.GenesisState.payout_schedules":
+		if x.PayoutSchedules == nil {
+			x.PayoutSchedules = []*PayoutSchedule{}
+		}
+		value := &_GenesisState_4_list{list: &x.PayoutSchedules}
+		return protoreflect.ValueOfList(value)
 	case "dysonprotocol.crontask.v1.GenesisState.next_task_id":
 		panic(fmt.Errorf("field next_task_id of message dysonprotocol.crontask.v1.GenesisState is not mutable"))
+	case "dysonprotocol.crontask.v1.GenesisState.next_payout_id":
+		panic(fmt.Errorf("field next_payout_id of message dysonprotocol.crontask.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.GenesisState"))
@@ -314,6 +412,11 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "dysonprotocol.crontask.v1.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.GenesisState.payout_schedules":
+		list := []*PayoutSchedule{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "dysonprotocol.crontask.v1.GenesisState.next_payout_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.GenesisState"))
@@ -396,6 +499,15 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.PayoutSchedules) > 0 {
+			for _, e := range x.PayoutSchedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextPayoutId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextPayoutId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -425,6 +537,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NextPayoutId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextPayoutId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.PayoutSchedules) > 0 {
+			for iNdEx := len(x.PayoutSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PayoutSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -598,6 +731,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayoutSchedules = append(x.PayoutSchedules, &PayoutSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PayoutSchedules[len(x.PayoutSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextPayoutId", wireType)
+				}
+				x.NextPayoutId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextPayoutId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -658,6 +844,10 @@ type GenesisState struct {
 	NextTaskId uint64 `protobuf:"varint,2,opt,name=next_task_id,json=nextTaskId,proto3" json:"next_task_id,omitempty"`
 	// Module parameters
 	Params *Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	// List of payout schedules to initialize with
+	PayoutSchedules []*PayoutSchedule `protobuf:"bytes,4,rep,name=payout_schedules,json=payoutSchedules,proto3" json:"payout_schedules,omitempty"`
+	// The sequence for the next payout schedule ID
+	NextPayoutId uint64 `protobuf:"varint,5,opt,name=next_payout_id,json=nextPayoutId,proto3" json:"next_payout_id,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -701,6 +891,20 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetPayoutSchedules() []*PayoutSchedule {
+	if x != nil {
+		return x.PayoutSchedules
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextPayoutId() uint64 {
+	if x != nil {
+		return x.NextPayoutId
+	}
+	return 0
+}
+
 var File_dysonprotocol_crontask_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_dysonprotocol_crontask_v1_genesis_proto_rawDesc = []byte{
@@ -710,21 +914,31 @@ var file_dysonprotocol_crontask_v1_genesis_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73,
 	0x6b, 0x2e, 0x76, 0x31, 0x1a, 0x28, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
-	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2,
-	0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x35, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
-	0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74,
-	0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65,
-	0x78, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x24, 0x5a, 0x22, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74,
-	0x61, 0x73, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26,
+	0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72,
+	0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x02, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x20,
+	0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x10, 0x70,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x0f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x42, 0x24, 0x5a, 0x22, 0x64, 0x79, 0x73, 0x6f, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x63,
+	0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -741,18 +955,20 @@ func file_dysonprotocol_crontask_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_dysonprotocol_crontask_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_dysonprotocol_crontask_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: dysonprotocol.crontask.v1.GenesisState
-	(*Task)(nil),         // 1: dysonprotocol.crontask.v1.Task
-	(*Params)(nil),       // 2: dysonprotocol.crontask.v1.Params
+	(*GenesisState)(nil),   // 0: dysonprotocol.crontask.v1.GenesisState
+	(*Task)(nil),           // 1: dysonprotocol.crontask.v1.Task
+	(*Params)(nil),         // 2: dysonprotocol.crontask.v1.Params
+	(*PayoutSchedule)(nil), // 3: dysonprotocol.crontask.v1.PayoutSchedule
 }
 var file_dysonprotocol_crontask_v1_genesis_proto_depIdxs = []int32{
 	1, // 0: dysonprotocol.crontask.v1.GenesisState.tasks:type_name -> dysonprotocol.crontask.v1.Task
 	2, // 1: dysonprotocol.crontask.v1.GenesisState.params:type_name -> dysonprotocol.crontask.v1.Params
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: dysonprotocol.crontask.v1.GenesisState.payout_schedules:type_name -> dysonprotocol.crontask.v1.PayoutSchedule
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_dysonprotocol_crontask_v1_genesis_proto_init() }
@@ -761,6 +977,7 @@ func file_dysonprotocol_crontask_v1_genesis_proto_init() {
 		return
 	}
 	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	file_dysonprotocol_crontask_v1_payout_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_dysonprotocol_crontask_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
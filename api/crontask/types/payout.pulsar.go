@@ -0,0 +1,2217 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package types
+
+import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_PayoutSchedule_3_list)(nil)
+
+type _PayoutSchedule_3_list struct {
+	list *[]*PayoutRecipient
+}
+
+func (x *_PayoutSchedule_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PayoutSchedule_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PayoutSchedule_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayoutRecipient)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PayoutSchedule_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayoutRecipient)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PayoutSchedule_3_list) AppendMutable() protoreflect.Value {
+	v := new(PayoutRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutSchedule_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PayoutSchedule_3_list) NewElement() protoreflect.Value {
+	v := new(PayoutRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutSchedule_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PayoutSchedule_9_list)(nil)
+
+type _PayoutSchedule_9_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_PayoutSchedule_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PayoutSchedule_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PayoutSchedule_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PayoutSchedule_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PayoutSchedule_9_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutSchedule_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PayoutSchedule_9_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutSchedule_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PayoutSchedule                        protoreflect.MessageDescriptor
+	fd_PayoutSchedule_payout_id              protoreflect.FieldDescriptor
+	fd_PayoutSchedule_creator                protoreflect.FieldDescriptor
+	fd_PayoutSchedule_recipients             protoreflect.FieldDescriptor
+	fd_PayoutSchedule_start_timestamp        protoreflect.FieldDescriptor
+	fd_PayoutSchedule_interval_seconds       protoreflect.FieldDescriptor
+	fd_PayoutSchedule_total_releases         protoreflect.FieldDescriptor
+	fd_PayoutSchedule_releases_done          protoreflect.FieldDescriptor
+	fd_PayoutSchedule_next_release_timestamp protoreflect.FieldDescriptor
+	fd_PayoutSchedule_escrow                 protoreflect.FieldDescriptor
+	fd_PayoutSchedule_status                 protoreflect.FieldDescriptor
+	fd_PayoutSchedule_creation_time          protoreflect.FieldDescriptor
+	fd_PayoutSchedule_end_time               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_payout_proto_init()
+	md_PayoutSchedule = File_dysonprotocol_crontask_v1_payout_proto.Messages().ByName("PayoutSchedule")
+	fd_PayoutSchedule_payout_id = md_PayoutSchedule.Fields().ByName("payout_id")
+	fd_PayoutSchedule_creator = md_PayoutSchedule.Fields().ByName("creator")
+	fd_PayoutSchedule_recipients = md_PayoutSchedule.Fields().ByName("recipients")
+	fd_PayoutSchedule_start_timestamp = md_PayoutSchedule.Fields().ByName("start_timestamp")
+	fd_PayoutSchedule_interval_seconds = md_PayoutSchedule.Fields().ByName("interval_seconds")
+	fd_PayoutSchedule_total_releases = md_PayoutSchedule.Fields().ByName("total_releases")
+	fd_PayoutSchedule_releases_done = md_PayoutSchedule.Fields().ByName("releases_done")
+	fd_PayoutSchedule_next_release_timestamp = md_PayoutSchedule.Fields().ByName("next_release_timestamp")
+	fd_PayoutSchedule_escrow = md_PayoutSchedule.Fields().ByName("escrow")
+	fd_PayoutSchedule_status = md_PayoutSchedule.Fields().ByName("status")
+	fd_PayoutSchedule_creation_time = md_PayoutSchedule.Fields().ByName("creation_time")
+	fd_PayoutSchedule_end_time = md_PayoutSchedule.Fields().ByName("end_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_PayoutSchedule)(nil)
+
+type fastReflection_PayoutSchedule PayoutSchedule
+
+func (x *PayoutSchedule) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PayoutSchedule)(x)
+}
+
+func (x *PayoutSchedule) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_payout_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PayoutSchedule_messageType fastReflection_PayoutSchedule_messageType
+var _ protoreflect.MessageType = fastReflection_PayoutSchedule_messageType{}
+
+type fastReflection_PayoutSchedule_messageType struct{}
+
+func (x fastReflection_PayoutSchedule_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PayoutSchedule)(nil)
+}
+func (x fastReflection_PayoutSchedule_messageType) New() protoreflect.Message {
+	return new(fastReflection_PayoutSchedule)
+}
+func (x fastReflection_PayoutSchedule_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PayoutSchedule
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PayoutSchedule) Descriptor() protoreflect.MessageDescriptor {
+	return md_PayoutSchedule
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PayoutSchedule) Type() protoreflect.MessageType {
+	return _fastReflection_PayoutSchedule_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PayoutSchedule) New() protoreflect.Message {
+	return new(fastReflection_PayoutSchedule)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PayoutSchedule) Interface() protoreflect.ProtoMessage {
+	return (*PayoutSchedule)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PayoutSchedule) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PayoutId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PayoutId)
+		if !f(fd_PayoutSchedule_payout_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_PayoutSchedule_creator, value) {
+			return
+		}
+	}
+	if len(x.Recipients) != 0 {
+		value := protoreflect.ValueOfList(&_PayoutSchedule_3_list{list: &x.Recipients})
+		if !f(fd_PayoutSchedule_recipients, value) {
+			return
+		}
+	}
+	if x.StartTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartTimestamp)
+		if !f(fd_PayoutSchedule_start_timestamp, value) {
+			return
+		}
+	}
+	if x.IntervalSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.IntervalSeconds)
+		if !f(fd_PayoutSchedule_interval_seconds, value) {
+			return
+		}
+	}
+	if x.TotalReleases != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalReleases)
+		if !f(fd_PayoutSchedule_total_releases, value) {
+			return
+		}
+	}
+	if x.ReleasesDone != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReleasesDone)
+		if !f(fd_PayoutSchedule_releases_done, value) {
+			return
+		}
+	}
+	if x.NextReleaseTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextReleaseTimestamp)
+		if !f(fd_PayoutSchedule_next_release_timestamp, value) {
+			return
+		}
+	}
+	if len(x.Escrow) != 0 {
+		value := protoreflect.ValueOfList(&_PayoutSchedule_9_list{list: &x.Escrow})
+		if !f(fd_PayoutSchedule_escrow, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_PayoutSchedule_status, value) {
+			return
+		}
+	}
+	if x.CreationTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CreationTime)
+		if !f(fd_PayoutSchedule_creation_time, value) {
+			return
+		}
+	}
+	if x.EndTime != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndTime)
+		if !f(fd_PayoutSchedule_end_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PayoutSchedule) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutSchedule.payout_id":
+		return x.PayoutId != uint64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creator":
+		return x.Creator != ""
+	case "dysonprotocol.crontask.v1.PayoutSchedule.recipients":
+		return len(x.Recipients) != 0
+	case "dysonprotocol.crontask.v1.PayoutSchedule.start_timestamp":
+		return x.StartTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.interval_seconds":
+		return x.IntervalSeconds != int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.total_releases":
+		return x.TotalReleases != uint64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.releases_done":
+		return x.ReleasesDone != uint64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.next_release_timestamp":
+		return x.NextReleaseTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.escrow":
+		return len(x.Escrow) != 0
+	case "dysonprotocol.crontask.v1.PayoutSchedule.status":
+		return x.Status != ""
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creation_time":
+		return x.CreationTime != int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.end_time":
+		return x.EndTime != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutSchedule"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutSchedule) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutSchedule.payout_id":
+		x.PayoutId = uint64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creator":
+		x.Creator = ""
+	case "dysonprotocol.crontask.v1.PayoutSchedule.recipients":
+		x.Recipients = nil
+	case "dysonprotocol.crontask.v1.PayoutSchedule.start_timestamp":
+		x.StartTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.interval_seconds":
+		x.IntervalSeconds = int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.total_releases":
+		x.TotalReleases = uint64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.releases_done":
+		x.ReleasesDone = uint64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.next_release_timestamp":
+		x.NextReleaseTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.escrow":
+		x.Escrow = nil
+	case "dysonprotocol.crontask.v1.PayoutSchedule.status":
+		x.Status = ""
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creation_time":
+		x.CreationTime = int64(0)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.end_time":
+		x.EndTime = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutSchedule"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PayoutSchedule) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutSchedule.payout_id":
+		value := x.PayoutId
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.recipients":
+		if len(x.Recipients) == 0 {
+			return protoreflect.ValueOfList(&_PayoutSchedule_3_list{})
+		}
+		listValue := &_PayoutSchedule_3_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.start_timestamp":
+		value := x.StartTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.interval_seconds":
+		value := x.IntervalSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.total_releases":
+		value := x.TotalReleases
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.releases_done":
+		value := x.ReleasesDone
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.next_release_timestamp":
+		value := x.NextReleaseTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.escrow":
+		if len(x.Escrow) == 0 {
+			return protoreflect.ValueOfList(&_PayoutSchedule_9_list{})
+		}
+		listValue := &_PayoutSchedule_9_list{list: &x.Escrow}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creation_time":
+		value := x.CreationTime
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutSchedule"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutSchedule does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutSchedule) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutSchedule.payout_id":
+		x.PayoutId = value.Uint()
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creator":
+		x.Creator = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.recipients":
+		lv := value.List()
+		clv := lv.(*_PayoutSchedule_3_list)
+		x.Recipients = *clv.list
+	case "dysonprotocol.crontask.v1.PayoutSchedule.start_timestamp":
+		x.StartTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.PayoutSchedule.interval_seconds":
+		x.IntervalSeconds = value.Int()
+	case "dysonprotocol.crontask.v1.PayoutSchedule.total_releases":
+		x.TotalReleases = value.Uint()
+	case "dysonprotocol.crontask.v1.PayoutSchedule.releases_done":
+		x.ReleasesDone = value.Uint()
+	case "dysonprotocol.crontask.v1.PayoutSchedule.next_release_timestamp":
+		x.NextReleaseTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.PayoutSchedule.escrow":
+		lv := value.List()
+		clv := lv.(*_PayoutSchedule_9_list)
+		x.Escrow = *clv.list
+	case "dysonprotocol.crontask.v1.PayoutSchedule.status":
+		x.Status = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creation_time":
+		x.CreationTime = value.Int()
+	case "dysonprotocol.crontask.v1.PayoutSchedule.end_time":
+		x.EndTime = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutSchedule"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutSchedule) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutSchedule.recipients":
+		if x.Recipients == nil {
+			x.Recipients = []*PayoutRecipient{}
+		}
+		value := &_PayoutSchedule_3_list{list: &x.Recipients}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.escrow":
+		if x.Escrow == nil {
+			x.Escrow = []*v1beta1.Coin{}
+		}
+		value := &_PayoutSchedule_9_list{list: &x.Escrow}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.PayoutSchedule.payout_id":
+		panic(fmt.Errorf("field payout_id of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creator":
+		panic(fmt.Errorf("field creator of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.start_timestamp":
+		panic(fmt.Errorf("field start_timestamp of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.interval_seconds":
+		panic(fmt.Errorf("field interval_seconds of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.total_releases":
+		panic(fmt.Errorf("field total_releases of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.releases_done":
+		panic(fmt.Errorf("field releases_done of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.next_release_timestamp":
+		panic(fmt.Errorf("field next_release_timestamp of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.status":
+		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creation_time":
+		panic(fmt.Errorf("field creation_time of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.end_time":
+		panic(fmt.Errorf("field end_time of message dysonprotocol.crontask.v1.PayoutSchedule is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutSchedule"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PayoutSchedule) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutSchedule.payout_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creator":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.PayoutSchedule.recipients":
+		list := []*PayoutRecipient{}
+		return protoreflect.ValueOfList(&_PayoutSchedule_3_list{list: &list})
+	case "dysonprotocol.crontask.v1.PayoutSchedule.start_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.interval_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.total_releases":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.releases_done":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.next_release_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.escrow":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_PayoutSchedule_9_list{list: &list})
+	case "dysonprotocol.crontask.v1.PayoutSchedule.status":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.PayoutSchedule.creation_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.PayoutSchedule.end_time":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutSchedule"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutSchedule does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PayoutSchedule) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.PayoutSchedule", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PayoutSchedule) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutSchedule) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PayoutSchedule) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PayoutSchedule) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PayoutSchedule)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PayoutId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PayoutId))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Recipients) > 0 {
+			for _, e := range x.Recipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartTimestamp))
+		}
+		if x.IntervalSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.IntervalSeconds))
+		}
+		if x.TotalReleases != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalReleases))
+		}
+		if x.ReleasesDone != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReleasesDone))
+		}
+		if x.NextReleaseTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextReleaseTimestamp))
+		}
+		if len(x.Escrow) > 0 {
+			for _, e := range x.Escrow {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CreationTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.CreationTime))
+		}
+		if x.EndTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndTime))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PayoutSchedule)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EndTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndTime))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.CreationTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CreationTime))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Escrow) > 0 {
+			for iNdEx := len(x.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Escrow[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.NextReleaseTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextReleaseTimestamp))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.ReleasesDone != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReleasesDone))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.TotalReleases != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalReleases))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.IntervalSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.IntervalSeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.StartTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartTimestamp))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Recipients) > 0 {
+			for iNdEx := len(x.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Recipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.PayoutId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PayoutId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PayoutSchedule)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PayoutSchedule: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PayoutSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutId", wireType)
+				}
+				x.PayoutId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PayoutId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipients = append(x.Recipients, &PayoutRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Recipients[len(x.Recipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+				}
+				x.StartTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+				}
+				x.IntervalSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.IntervalSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalReleases", wireType)
+				}
+				x.TotalReleases = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalReleases |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReleasesDone", wireType)
+				}
+				x.ReleasesDone = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReleasesDone |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextReleaseTimestamp", wireType)
+				}
+				x.NextReleaseTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextReleaseTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Escrow = append(x.Escrow, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Escrow[len(x.Escrow)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				}
+				x.CreationTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CreationTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				x.EndTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndTime |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PayoutRecipient_2_list)(nil)
+
+type _PayoutRecipient_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_PayoutRecipient_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PayoutRecipient_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PayoutRecipient_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PayoutRecipient_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PayoutRecipient_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutRecipient_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PayoutRecipient_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutRecipient_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_PayoutRecipient_3_list)(nil)
+
+type _PayoutRecipient_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_PayoutRecipient_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PayoutRecipient_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_PayoutRecipient_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PayoutRecipient_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PayoutRecipient_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutRecipient_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PayoutRecipient_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_PayoutRecipient_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PayoutRecipient          protoreflect.MessageDescriptor
+	fd_PayoutRecipient_address  protoreflect.FieldDescriptor
+	fd_PayoutRecipient_amount   protoreflect.FieldDescriptor
+	fd_PayoutRecipient_released protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_payout_proto_init()
+	md_PayoutRecipient = File_dysonprotocol_crontask_v1_payout_proto.Messages().ByName("PayoutRecipient")
+	fd_PayoutRecipient_address = md_PayoutRecipient.Fields().ByName("address")
+	fd_PayoutRecipient_amount = md_PayoutRecipient.Fields().ByName("amount")
+	fd_PayoutRecipient_released = md_PayoutRecipient.Fields().ByName("released")
+}
+
+var _ protoreflect.Message = (*fastReflection_PayoutRecipient)(nil)
+
+type fastReflection_PayoutRecipient PayoutRecipient
+
+func (x *PayoutRecipient) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PayoutRecipient)(x)
+}
+
+func (x *PayoutRecipient) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_payout_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PayoutRecipient_messageType fastReflection_PayoutRecipient_messageType
+var _ protoreflect.MessageType = fastReflection_PayoutRecipient_messageType{}
+
+type fastReflection_PayoutRecipient_messageType struct{}
+
+func (x fastReflection_PayoutRecipient_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PayoutRecipient)(nil)
+}
+func (x fastReflection_PayoutRecipient_messageType) New() protoreflect.Message {
+	return new(fastReflection_PayoutRecipient)
+}
+func (x fastReflection_PayoutRecipient_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PayoutRecipient
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PayoutRecipient) Descriptor() protoreflect.MessageDescriptor {
+	return md_PayoutRecipient
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PayoutRecipient) Type() protoreflect.MessageType {
+	return _fastReflection_PayoutRecipient_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PayoutRecipient) New() protoreflect.Message {
+	return new(fastReflection_PayoutRecipient)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PayoutRecipient) Interface() protoreflect.ProtoMessage {
+	return (*PayoutRecipient)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PayoutRecipient) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PayoutRecipient_address, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_PayoutRecipient_2_list{list: &x.Amount})
+		if !f(fd_PayoutRecipient_amount, value) {
+			return
+		}
+	}
+	if len(x.Released) != 0 {
+		value := protoreflect.ValueOfList(&_PayoutRecipient_3_list{list: &x.Released})
+		if !f(fd_PayoutRecipient_released, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PayoutRecipient) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutRecipient.address":
+		return x.Address != ""
+	case "dysonprotocol.crontask.v1.PayoutRecipient.amount":
+		return len(x.Amount) != 0
+	case "dysonprotocol.crontask.v1.PayoutRecipient.released":
+		return len(x.Released) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutRecipient"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutRecipient) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutRecipient.address":
+		x.Address = ""
+	case "dysonprotocol.crontask.v1.PayoutRecipient.amount":
+		x.Amount = nil
+	case "dysonprotocol.crontask.v1.PayoutRecipient.released":
+		x.Released = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutRecipient"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PayoutRecipient) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutRecipient.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.PayoutRecipient.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_PayoutRecipient_2_list{})
+		}
+		listValue := &_PayoutRecipient_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.PayoutRecipient.released":
+		if len(x.Released) == 0 {
+			return protoreflect.ValueOfList(&_PayoutRecipient_3_list{})
+		}
+		listValue := &_PayoutRecipient_3_list{list: &x.Released}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutRecipient"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutRecipient does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutRecipient) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutRecipient.address":
+		x.Address = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.PayoutRecipient.amount":
+		lv := value.List()
+		clv := lv.(*_PayoutRecipient_2_list)
+		x.Amount = *clv.list
+	case "dysonprotocol.crontask.v1.PayoutRecipient.released":
+		lv := value.List()
+		clv := lv.(*_PayoutRecipient_3_list)
+		x.Released = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutRecipient"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutRecipient) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutRecipient.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_PayoutRecipient_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.PayoutRecipient.released":
+		if x.Released == nil {
+			x.Released = []*v1beta1.Coin{}
+		}
+		value := &_PayoutRecipient_3_list{list: &x.Released}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.PayoutRecipient.address":
+		panic(fmt.Errorf("field address of message dysonprotocol.crontask.v1.PayoutRecipient is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutRecipient"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PayoutRecipient) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.PayoutRecipient.address":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.PayoutRecipient.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_PayoutRecipient_2_list{list: &list})
+	case "dysonprotocol.crontask.v1.PayoutRecipient.released":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_PayoutRecipient_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.PayoutRecipient"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.PayoutRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PayoutRecipient) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.PayoutRecipient", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PayoutRecipient) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PayoutRecipient) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PayoutRecipient) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PayoutRecipient) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PayoutRecipient)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Released) > 0 {
+			for _, e := range x.Released {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PayoutRecipient)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Released) > 0 {
+			for iNdEx := len(x.Released) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Released[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PayoutRecipient)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PayoutRecipient: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PayoutRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Released = append(x.Released, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Released[len(x.Released)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: dysonprotocol/crontask/v1/payout.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PayoutSchedule releases funds locked in the module account to one or more
+// recipients at a fixed interval, vesting-style. Release i (0-based) is due at
+// start_timestamp + i * interval_seconds.
+type PayoutSchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique identifier for the payout schedule
+	PayoutId uint64 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	// Address of the creator that funded the payout schedule
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Recipients and the amount each of them receives at every release
+	Recipients []*PayoutRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	// Unix timestamp of the first release
+	StartTimestamp int64 `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Seconds between two releases, 0 for a single release time-lock
+	IntervalSeconds int64 `protobuf:"varint,5,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Total number of releases
+	TotalReleases uint64 `protobuf:"varint,6,opt,name=total_releases,json=totalReleases,proto3" json:"total_releases,omitempty"`
+	// Number of releases paid out so far
+	ReleasesDone uint64 `protobuf:"varint,7,opt,name=releases_done,json=releasesDone,proto3" json:"releases_done,omitempty"`
+	// Unix timestamp of the next release, 0 once the schedule is finished
+	NextReleaseTimestamp int64 `protobuf:"varint,8,opt,name=next_release_timestamp,json=nextReleaseTimestamp,proto3" json:"next_release_timestamp,omitempty"`
+	// Funds still held in the module account for the remaining releases
+	Escrow []*v1beta1.Coin `protobuf:"bytes,9,rep,name=escrow,proto3" json:"escrow,omitempty"`
+	// Status of the schedule: ACTIVE, COMPLETED or CANCELLED
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Block timestamp when the schedule was created
+	CreationTime int64 `protobuf:"varint,11,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// Block timestamp when the schedule was completed or cancelled
+	EndTime int64 `protobuf:"varint,12,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *PayoutSchedule) Reset() {
+	*x = PayoutSchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_payout_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutSchedule) ProtoMessage() {}
+
+// Deprecated: Use PayoutSchedule.ProtoReflect.Descriptor instead.
+func (*PayoutSchedule) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_payout_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PayoutSchedule) GetPayoutId() uint64 {
+	if x != nil {
+		return x.PayoutId
+	}
+	return 0
+}
+
+func (x *PayoutSchedule) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *PayoutSchedule) GetRecipients() []*PayoutRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *PayoutSchedule) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *PayoutSchedule) GetIntervalSeconds() int64 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *PayoutSchedule) GetTotalReleases() uint64 {
+	if x != nil {
+		return x.TotalReleases
+	}
+	return 0
+}
+
+func (x *PayoutSchedule) GetReleasesDone() uint64 {
+	if x != nil {
+		return x.ReleasesDone
+	}
+	return 0
+}
+
+func (x *PayoutSchedule) GetNextReleaseTimestamp() int64 {
+	if x != nil {
+		return x.NextReleaseTimestamp
+	}
+	return 0
+}
+
+func (x *PayoutSchedule) GetEscrow() []*v1beta1.Coin {
+	if x != nil {
+		return x.Escrow
+	}
+	return nil
+}
+
+func (x *PayoutSchedule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PayoutSchedule) GetCreationTime() int64 {
+	if x != nil {
+		return x.CreationTime
+	}
+	return 0
+}
+
+func (x *PayoutSchedule) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// PayoutRecipient is a recipient of a payout schedule
+type PayoutRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Amount released to the recipient at every release
+	Amount []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Total amount released to the recipient so far
+	Released []*v1beta1.Coin `protobuf:"bytes,3,rep,name=released,proto3" json:"released,omitempty"`
+}
+
+func (x *PayoutRecipient) Reset() {
+	*x = PayoutRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_payout_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayoutRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayoutRecipient) ProtoMessage() {}
+
+// Deprecated: Use PayoutRecipient.ProtoReflect.Descriptor instead.
+func (*PayoutRecipient) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_payout_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PayoutRecipient) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PayoutRecipient) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *PayoutRecipient) GetReleased() []*v1beta1.Coin {
+	if x != nil {
+		return x.Released
+	}
+	return nil
+}
+
+var File_dysonprotocol_crontask_v1_payout_proto protoreflect.FileDescriptor
+
+var file_dysonprotocol_crontask_v1_payout_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x19, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd0, 0x04, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x55, 0x0a, 0x0a, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x5f, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x73, 0x44, 0x6f,
+	0x6e, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x14, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x68, 0x0a, 0x06, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
+	0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x65, 0x73, 0x63, 0x72,
+	0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9d, 0x02, 0x0a, 0x0f, 0x50,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x6c, 0x0a, 0x08,
+	0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
+	0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01,
+	0x52, 0x08, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x64, 0x42, 0x24, 0x5a, 0x22, 0x64, 0x79,
+	0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x78, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dysonprotocol_crontask_v1_payout_proto_rawDescOnce sync.Once
+	file_dysonprotocol_crontask_v1_payout_proto_rawDescData = file_dysonprotocol_crontask_v1_payout_proto_rawDesc
+)
+
+func file_dysonprotocol_crontask_v1_payout_proto_rawDescGZIP() []byte {
+	file_dysonprotocol_crontask_v1_payout_proto_rawDescOnce.Do(func() {
+		file_dysonprotocol_crontask_v1_payout_proto_rawDescData = protoimpl.X.CompressGZIP(file_dysonprotocol_crontask_v1_payout_proto_rawDescData)
+	})
+	return file_dysonprotocol_crontask_v1_payout_proto_rawDescData
+}
+
+var file_dysonprotocol_crontask_v1_payout_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_dysonprotocol_crontask_v1_payout_proto_goTypes = []interface{}{
+	(*PayoutSchedule)(nil),  // 0: dysonprotocol.crontask.v1.PayoutSchedule
+	(*PayoutRecipient)(nil), // 1: dysonprotocol.crontask.v1.PayoutRecipient
+	(*v1beta1.Coin)(nil),    // 2: cosmos.base.v1beta1.Coin
+}
+var file_dysonprotocol_crontask_v1_payout_proto_depIdxs = []int32{
+	1, // 0: dysonprotocol.crontask.v1.PayoutSchedule.recipients:type_name -> dysonprotocol.crontask.v1.PayoutRecipient
+	2, // 1: dysonprotocol.crontask.v1.PayoutSchedule.escrow:type_name -> cosmos.base.v1beta1.Coin
+	2, // 2: dysonprotocol.crontask.v1.PayoutRecipient.amount:type_name -> cosmos.base.v1beta1.Coin
+	2, // 3: dysonprotocol.crontask.v1.PayoutRecipient.released:type_name -> cosmos.base.v1beta1.Coin
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_dysonprotocol_crontask_v1_payout_proto_init() }
+func file_dysonprotocol_crontask_v1_payout_proto_init() {
+	if File_dysonprotocol_crontask_v1_payout_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dysonprotocol_crontask_v1_payout_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutSchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_payout_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PayoutRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dysonprotocol_crontask_v1_payout_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dysonprotocol_crontask_v1_payout_proto_goTypes,
+		DependencyIndexes: file_dysonprotocol_crontask_v1_payout_proto_depIdxs,
+		MessageInfos:      file_dysonprotocol_crontask_v1_payout_proto_msgTypes,
+	}.Build()
+	File_dysonprotocol_crontask_v1_payout_proto = out.File
+	file_dysonprotocol_crontask_v1_payout_proto_rawDesc = nil
+	file_dysonprotocol_crontask_v1_payout_proto_goTypes = nil
+	file_dysonprotocol_crontask_v1_payout_proto_depIdxs = nil
+}
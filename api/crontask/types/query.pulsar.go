@@ -3,7 +3,7 @@ package types
 
 import (
 	v1beta1 "cosmossdk.io/api/cosmos/base/query/v1beta1"
-	_ "cosmossdk.io/api/cosmos/base/v1beta1"
+	v1beta11 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -1357,27 +1358,29 @@ func (x *fastReflection_QueryTasksByAddressRequest) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryTasksByStatusTimestampRequest            protoreflect.MessageDescriptor
-	fd_QueryTasksByStatusTimestampRequest_status     protoreflect.FieldDescriptor
-	fd_QueryTasksByStatusTimestampRequest_pagination protoreflect.FieldDescriptor
+	md_QueryTasksByWorkflowRequest             protoreflect.MessageDescriptor
+	fd_QueryTasksByWorkflowRequest_creator     protoreflect.FieldDescriptor
+	fd_QueryTasksByWorkflowRequest_workflow_id protoreflect.FieldDescriptor
+	fd_QueryTasksByWorkflowRequest_pagination  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dysonprotocol_crontask_v1_query_proto_init()
-	md_QueryTasksByStatusTimestampRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksByStatusTimestampRequest")
-	fd_QueryTasksByStatusTimestampRequest_status = md_QueryTasksByStatusTimestampRequest.Fields().ByName("status")
-	fd_QueryTasksByStatusTimestampRequest_pagination = md_QueryTasksByStatusTimestampRequest.Fields().ByName("pagination")
+	md_QueryTasksByWorkflowRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksByWorkflowRequest")
+	fd_QueryTasksByWorkflowRequest_creator = md_QueryTasksByWorkflowRequest.Fields().ByName("creator")
+	fd_QueryTasksByWorkflowRequest_workflow_id = md_QueryTasksByWorkflowRequest.Fields().ByName("workflow_id")
+	fd_QueryTasksByWorkflowRequest_pagination = md_QueryTasksByWorkflowRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTasksByStatusTimestampRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTasksByWorkflowRequest)(nil)
 
-type fastReflection_QueryTasksByStatusTimestampRequest QueryTasksByStatusTimestampRequest
+type fastReflection_QueryTasksByWorkflowRequest QueryTasksByWorkflowRequest
 
-func (x *QueryTasksByStatusTimestampRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTasksByStatusTimestampRequest)(x)
+func (x *QueryTasksByWorkflowRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTasksByWorkflowRequest)(x)
 }
 
-func (x *QueryTasksByStatusTimestampRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTasksByWorkflowRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1389,43 +1392,43 @@ func (x *QueryTasksByStatusTimestampRequest) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTasksByStatusTimestampRequest_messageType fastReflection_QueryTasksByStatusTimestampRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTasksByStatusTimestampRequest_messageType{}
+var _fastReflection_QueryTasksByWorkflowRequest_messageType fastReflection_QueryTasksByWorkflowRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTasksByWorkflowRequest_messageType{}
 
-type fastReflection_QueryTasksByStatusTimestampRequest_messageType struct{}
+type fastReflection_QueryTasksByWorkflowRequest_messageType struct{}
 
-func (x fastReflection_QueryTasksByStatusTimestampRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTasksByStatusTimestampRequest)(nil)
+func (x fastReflection_QueryTasksByWorkflowRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTasksByWorkflowRequest)(nil)
 }
-func (x fastReflection_QueryTasksByStatusTimestampRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTasksByStatusTimestampRequest)
+func (x fastReflection_QueryTasksByWorkflowRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByWorkflowRequest)
 }
-func (x fastReflection_QueryTasksByStatusTimestampRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTasksByStatusTimestampRequest
+func (x fastReflection_QueryTasksByWorkflowRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByWorkflowRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTasksByStatusTimestampRequest
+func (x *fastReflection_QueryTasksByWorkflowRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByWorkflowRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTasksByStatusTimestampRequest_messageType
+func (x *fastReflection_QueryTasksByWorkflowRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTasksByWorkflowRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTasksByStatusTimestampRequest)
+func (x *fastReflection_QueryTasksByWorkflowRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByWorkflowRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTasksByStatusTimestampRequest)(x)
+func (x *fastReflection_QueryTasksByWorkflowRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTasksByWorkflowRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1433,16 +1436,22 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Status != "" {
-		value := protoreflect.ValueOfString(x.Status)
-		if !f(fd_QueryTasksByStatusTimestampRequest_status, value) {
+func (x *fastReflection_QueryTasksByWorkflowRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_QueryTasksByWorkflowRequest_creator, value) {
+			return
+		}
+	}
+	if x.WorkflowId != "" {
+		value := protoreflect.ValueOfString(x.WorkflowId)
+		if !f(fd_QueryTasksByWorkflowRequest_workflow_id, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTasksByStatusTimestampRequest_pagination, value) {
+		if !f(fd_QueryTasksByWorkflowRequest_pagination, value) {
 			return
 		}
 	}
@@ -1459,17 +1468,19 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTasksByWorkflowRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
-		return x.Status != ""
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.creator":
+		return x.Creator != ""
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.workflow_id":
+		return x.WorkflowId != ""
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1479,17 +1490,19 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTasksByWorkflowRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
-		x.Status = ""
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.creator":
+		x.Creator = ""
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.workflow_id":
+		x.WorkflowId = ""
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1499,19 +1512,22 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByWorkflowRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
-		value := x.Status
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.creator":
+		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.workflow_id":
+		value := x.WorkflowId
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1525,17 +1541,19 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTasksByWorkflowRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
-		x.Status = value.Interface().(string)
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.workflow_id":
+		x.WorkflowId = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1549,48 +1567,52 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByWorkflowRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
-		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.creator":
+		panic(fmt.Errorf("field creator of message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.workflow_id":
+		panic(fmt.Errorf("field workflow_id of message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByWorkflowRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.creator":
 		return protoreflect.ValueOfString("")
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.workflow_id":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTasksByWorkflowRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1598,7 +1620,7 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTasksByWorkflowRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1609,7 +1631,7 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTasksByWorkflowRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1621,7 +1643,7 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) IsValid() bool {
+func (x *fastReflection_QueryTasksByWorkflowRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -1631,9 +1653,9 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTasksByWorkflowRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTasksByStatusTimestampRequest)
+		x := input.Message.Interface().(*QueryTasksByWorkflowRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1645,7 +1667,11 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *prot
 		var n int
 		var l int
 		_ = l
-		l = len(x.Status)
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.WorkflowId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1663,7 +1689,7 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTasksByStatusTimestampRequest)
+		x := input.Message.Interface().(*QueryTasksByWorkflowRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1696,10 +1722,17 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *prot
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Status) > 0 {
-			i -= len(x.Status)
-			copy(dAtA[i:], x.Status)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+		if len(x.WorkflowId) > 0 {
+			i -= len(x.WorkflowId)
+			copy(dAtA[i:], x.WorkflowId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WorkflowId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1714,7 +1747,7 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTasksByStatusTimestampRequest)
+		x := input.Message.Interface().(*QueryTasksByWorkflowRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1746,15 +1779,15 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByWorkflowRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1782,7 +1815,39 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *prot
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Status = string(dAtA[iNdEx:postIndex])
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WorkflowId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
@@ -1856,27 +1921,27 @@ func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *prot
 }
 
 var (
-	md_QueryTasksByStatusGasPriceRequest            protoreflect.MessageDescriptor
-	fd_QueryTasksByStatusGasPriceRequest_status     protoreflect.FieldDescriptor
-	fd_QueryTasksByStatusGasPriceRequest_pagination protoreflect.FieldDescriptor
+	md_QueryTasksByStatusTimestampRequest            protoreflect.MessageDescriptor
+	fd_QueryTasksByStatusTimestampRequest_status     protoreflect.FieldDescriptor
+	fd_QueryTasksByStatusTimestampRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dysonprotocol_crontask_v1_query_proto_init()
-	md_QueryTasksByStatusGasPriceRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksByStatusGasPriceRequest")
-	fd_QueryTasksByStatusGasPriceRequest_status = md_QueryTasksByStatusGasPriceRequest.Fields().ByName("status")
-	fd_QueryTasksByStatusGasPriceRequest_pagination = md_QueryTasksByStatusGasPriceRequest.Fields().ByName("pagination")
+	md_QueryTasksByStatusTimestampRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksByStatusTimestampRequest")
+	fd_QueryTasksByStatusTimestampRequest_status = md_QueryTasksByStatusTimestampRequest.Fields().ByName("status")
+	fd_QueryTasksByStatusTimestampRequest_pagination = md_QueryTasksByStatusTimestampRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTasksByStatusGasPriceRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTasksByStatusTimestampRequest)(nil)
 
-type fastReflection_QueryTasksByStatusGasPriceRequest QueryTasksByStatusGasPriceRequest
+type fastReflection_QueryTasksByStatusTimestampRequest QueryTasksByStatusTimestampRequest
 
-func (x *QueryTasksByStatusGasPriceRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTasksByStatusGasPriceRequest)(x)
+func (x *QueryTasksByStatusTimestampRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTasksByStatusTimestampRequest)(x)
 }
 
-func (x *QueryTasksByStatusGasPriceRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTasksByStatusTimestampRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1888,43 +1953,43 @@ func (x *QueryTasksByStatusGasPriceRequest) slowProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTasksByStatusGasPriceRequest_messageType fastReflection_QueryTasksByStatusGasPriceRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTasksByStatusGasPriceRequest_messageType{}
+var _fastReflection_QueryTasksByStatusTimestampRequest_messageType fastReflection_QueryTasksByStatusTimestampRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTasksByStatusTimestampRequest_messageType{}
 
-type fastReflection_QueryTasksByStatusGasPriceRequest_messageType struct{}
+type fastReflection_QueryTasksByStatusTimestampRequest_messageType struct{}
 
-func (x fastReflection_QueryTasksByStatusGasPriceRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTasksByStatusGasPriceRequest)(nil)
+func (x fastReflection_QueryTasksByStatusTimestampRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTasksByStatusTimestampRequest)(nil)
 }
-func (x fastReflection_QueryTasksByStatusGasPriceRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTasksByStatusGasPriceRequest)
+func (x fastReflection_QueryTasksByStatusTimestampRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByStatusTimestampRequest)
 }
-func (x fastReflection_QueryTasksByStatusGasPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTasksByStatusGasPriceRequest
+func (x fastReflection_QueryTasksByStatusTimestampRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByStatusTimestampRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTasksByStatusGasPriceRequest
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByStatusTimestampRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTasksByStatusGasPriceRequest_messageType
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTasksByStatusTimestampRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTasksByStatusGasPriceRequest)
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByStatusTimestampRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTasksByStatusGasPriceRequest)(x)
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTasksByStatusTimestampRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1932,16 +1997,16 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Interface() protorefl
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Status != "" {
 		value := protoreflect.ValueOfString(x.Status)
-		if !f(fd_QueryTasksByStatusGasPriceRequest_status, value) {
+		if !f(fd_QueryTasksByStatusTimestampRequest_status, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTasksByStatusGasPriceRequest_pagination, value) {
+		if !f(fd_QueryTasksByStatusTimestampRequest_pagination, value) {
 			return
 		}
 	}
@@ -1958,17 +2023,17 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Range(f func(protoref
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
 		return x.Status != ""
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1978,17 +2043,17 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Has(fd protoreflect.F
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
 		x.Status = ""
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1998,19 +2063,19 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Clear(fd protoreflect
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
 		value := x.Status
 		return protoreflect.ValueOfString(value)
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2024,17 +2089,17 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Get(descriptor protor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
 		x.Status = value.Interface().(string)
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2048,48 +2113,48 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Set(fd protoreflect.F
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
 		if x.Pagination == nil {
 			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
-		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
+		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.status":
 		return protoreflect.ValueOfString("")
-	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination":
 		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2097,7 +2162,7 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) WhichOneof(d protoref
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2108,7 +2173,7 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) GetUnknown() protoref
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2120,7 +2185,7 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) SetUnknown(fields pro
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) IsValid() bool {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2130,9 +2195,9 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTasksByStatusGasPriceRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTasksByStatusTimestampRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTasksByStatusGasPriceRequest)
+		x := input.Message.Interface().(*QueryTasksByStatusTimestampRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2162,7 +2227,7 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) ProtoMethods() *proto
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTasksByStatusGasPriceRequest)
+		x := input.Message.Interface().(*QueryTasksByStatusTimestampRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2213,7 +2278,7 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) ProtoMethods() *proto
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTasksByStatusGasPriceRequest)
+		x := input.Message.Interface().(*QueryTasksByStatusTimestampRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2245,10 +2310,10 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) ProtoMethods() *proto
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2354,79 +2419,28 @@ func (x *fastReflection_QueryTasksByStatusGasPriceRequest) ProtoMethods() *proto
 	}
 }
 
-var _ protoreflect.List = (*_QueryTasksResponse_1_list)(nil)
-
-type _QueryTasksResponse_1_list struct {
-	list *[]*Task
-}
-
-func (x *_QueryTasksResponse_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_QueryTasksResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
-}
-
-func (x *_QueryTasksResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Task)
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_QueryTasksResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*Task)
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_QueryTasksResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(Task)
-	*x.list = append(*x.list, v)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTasksResponse_1_list) Truncate(n int) {
-	for i := n; i < len(*x.list); i++ {
-		(*x.list)[i] = nil
-	}
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_QueryTasksResponse_1_list) NewElement() protoreflect.Value {
-	v := new(Task)
-	return protoreflect.ValueOfMessage(v.ProtoReflect())
-}
-
-func (x *_QueryTasksResponse_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_QueryTasksResponse            protoreflect.MessageDescriptor
-	fd_QueryTasksResponse_tasks      protoreflect.FieldDescriptor
-	fd_QueryTasksResponse_pagination protoreflect.FieldDescriptor
+	md_QueryTasksByStatusGasPriceRequest            protoreflect.MessageDescriptor
+	fd_QueryTasksByStatusGasPriceRequest_status     protoreflect.FieldDescriptor
+	fd_QueryTasksByStatusGasPriceRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dysonprotocol_crontask_v1_query_proto_init()
-	md_QueryTasksResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksResponse")
-	fd_QueryTasksResponse_tasks = md_QueryTasksResponse.Fields().ByName("tasks")
-	fd_QueryTasksResponse_pagination = md_QueryTasksResponse.Fields().ByName("pagination")
+	md_QueryTasksByStatusGasPriceRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksByStatusGasPriceRequest")
+	fd_QueryTasksByStatusGasPriceRequest_status = md_QueryTasksByStatusGasPriceRequest.Fields().ByName("status")
+	fd_QueryTasksByStatusGasPriceRequest_pagination = md_QueryTasksByStatusGasPriceRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTasksResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTasksByStatusGasPriceRequest)(nil)
 
-type fastReflection_QueryTasksResponse QueryTasksResponse
+type fastReflection_QueryTasksByStatusGasPriceRequest QueryTasksByStatusGasPriceRequest
 
-func (x *QueryTasksResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTasksResponse)(x)
+func (x *QueryTasksByStatusGasPriceRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTasksByStatusGasPriceRequest)(x)
 }
 
-func (x *QueryTasksResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryTasksByStatusGasPriceRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2438,43 +2452,43 @@ func (x *QueryTasksResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTasksResponse_messageType fastReflection_QueryTasksResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTasksResponse_messageType{}
+var _fastReflection_QueryTasksByStatusGasPriceRequest_messageType fastReflection_QueryTasksByStatusGasPriceRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTasksByStatusGasPriceRequest_messageType{}
 
-type fastReflection_QueryTasksResponse_messageType struct{}
+type fastReflection_QueryTasksByStatusGasPriceRequest_messageType struct{}
 
-func (x fastReflection_QueryTasksResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTasksResponse)(nil)
+func (x fastReflection_QueryTasksByStatusGasPriceRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTasksByStatusGasPriceRequest)(nil)
 }
-func (x fastReflection_QueryTasksResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTasksResponse)
+func (x fastReflection_QueryTasksByStatusGasPriceRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByStatusGasPriceRequest)
 }
-func (x fastReflection_QueryTasksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTasksResponse
+func (x fastReflection_QueryTasksByStatusGasPriceRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByStatusGasPriceRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTasksResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTasksResponse
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByStatusGasPriceRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTasksResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTasksResponse_messageType
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTasksByStatusGasPriceRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTasksResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTasksResponse)
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByStatusGasPriceRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTasksResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTasksResponse)(x)
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTasksByStatusGasPriceRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2482,16 +2496,16 @@ func (x *fastReflection_QueryTasksResponse) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTasksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Tasks) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTasksResponse_1_list{list: &x.Tasks})
-		if !f(fd_QueryTasksResponse_tasks, value) {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_QueryTasksByStatusGasPriceRequest_status, value) {
 			return
 		}
 	}
 	if x.Pagination != nil {
 		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTasksResponse_pagination, value) {
+		if !f(fd_QueryTasksByStatusGasPriceRequest_pagination, value) {
 			return
 		}
 	}
@@ -2508,17 +2522,17 @@ func (x *fastReflection_QueryTasksResponse) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTasksResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
-		return len(x.Tasks) != 0
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+		return x.Status != ""
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
 		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2528,17 +2542,17 @@ func (x *fastReflection_QueryTasksResponse) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
-		x.Tasks = nil
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+		x.Status = ""
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
 		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2548,22 +2562,19 @@ func (x *fastReflection_QueryTasksResponse) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTasksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
-		if len(x.Tasks) == 0 {
-			return protoreflect.ValueOfList(&_QueryTasksResponse_1_list{})
-		}
-		listValue := &_QueryTasksResponse_1_list{list: &x.Tasks}
-		return protoreflect.ValueOfList(listValue)
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2577,19 +2588,17 @@ func (x *fastReflection_QueryTasksResponse) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
-		lv := value.List()
-		clv := lv.(*_QueryTasksResponse_1_list)
-		x.Tasks = *clv.list
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+		x.Status = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2603,53 +2612,48 @@ func (x *fastReflection_QueryTasksResponse) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
-		if x.Tasks == nil {
-			x.Tasks = []*Task{}
-		}
-		value := &_QueryTasksResponse_1_list{list: &x.Tasks}
-		return protoreflect.ValueOfList(value)
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
 		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+			x.Pagination = new(v1beta1.PageRequest)
 		}
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTasksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
-		list := []*Task{}
-		return protoreflect.ValueOfList(&_QueryTasksResponse_1_list{list: &list})
-	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
-		m := new(v1beta1.PageResponse)
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.status":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination":
+		m := new(v1beta1.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTasksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2657,7 +2661,7 @@ func (x *fastReflection_QueryTasksResponse) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTasksResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2668,7 +2672,7 @@ func (x *fastReflection_QueryTasksResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTasksResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2680,7 +2684,7 @@ func (x *fastReflection_QueryTasksResponse) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTasksResponse) IsValid() bool {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -2690,9 +2694,9 @@ func (x *fastReflection_QueryTasksResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTasksByStatusGasPriceRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTasksResponse)
+		x := input.Message.Interface().(*QueryTasksByStatusGasPriceRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2704,11 +2708,9 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Tasks) > 0 {
-			for _, e := range x.Tasks {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.Pagination != nil {
 			l = options.Size(x.Pagination)
@@ -2724,7 +2726,7 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTasksResponse)
+		x := input.Message.Interface().(*QueryTasksByStatusGasPriceRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2755,23 +2757,14 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
-		if len(x.Tasks) > 0 {
-			for iNdEx := len(x.Tasks) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Tasks[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0xa
-			}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2784,7 +2777,7 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTasksResponse)
+		x := input.Message.Interface().(*QueryTasksByStatusGasPriceRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2816,17 +2809,17 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2836,27 +2829,25 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Tasks = append(x.Tasks, &Task{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tasks[len(x.Tasks)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Status = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 				}
@@ -2886,7 +2877,7 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
 				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
+					x.Pagination = &v1beta1.PageRequest{}
 				}
 				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
@@ -2927,24 +2918,79 @@ func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_QueryTasksResponse_1_list)(nil)
+
+type _QueryTasksResponse_1_list struct {
+	list *[]*Task
+}
+
+func (x *_QueryTasksResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTasksResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTasksResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Task)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTasksResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Task)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTasksResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(Task)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTasksResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTasksResponse_1_list) NewElement() protoreflect.Value {
+	v := new(Task)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTasksResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryParamsRequest protoreflect.MessageDescriptor
+	md_QueryTasksResponse            protoreflect.MessageDescriptor
+	fd_QueryTasksResponse_tasks      protoreflect.FieldDescriptor
+	fd_QueryTasksResponse_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dysonprotocol_crontask_v1_query_proto_init()
-	md_QueryParamsRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryParamsRequest")
+	md_QueryTasksResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksResponse")
+	fd_QueryTasksResponse_tasks = md_QueryTasksResponse.Fields().ByName("tasks")
+	fd_QueryTasksResponse_pagination = md_QueryTasksResponse.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTasksResponse)(nil)
 
-type fastReflection_QueryParamsRequest QueryParamsRequest
+type fastReflection_QueryTasksResponse QueryTasksResponse
 
-func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(x)
+func (x *QueryTasksResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTasksResponse)(x)
 }
 
-func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTasksResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2956,43 +3002,43 @@ func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
+var _fastReflection_QueryTasksResponse_messageType fastReflection_QueryTasksResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTasksResponse_messageType{}
 
-type fastReflection_QueryParamsRequest_messageType struct{}
+type fastReflection_QueryTasksResponse_messageType struct{}
 
-func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsRequest)(nil)
+func (x fastReflection_QueryTasksResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTasksResponse)(nil)
 }
-func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x fastReflection_QueryTasksResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksResponse)
 }
-func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x fastReflection_QueryTasksResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsRequest
+func (x *fastReflection_QueryTasksResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsRequest_messageType
+func (x *fastReflection_QueryTasksResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTasksResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsRequest)
+func (x *fastReflection_QueryTasksResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsRequest)(x)
+func (x *fastReflection_QueryTasksResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTasksResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3000,7 +3046,19 @@ func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryTasksResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Tasks) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTasksResponse_1_list{list: &x.Tasks})
+		if !f(fd_QueryTasksResponse_tasks, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTasksResponse_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3014,13 +3072,17 @@ func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTasksResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
+		return len(x.Tasks) != 0
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3030,13 +3092,17 @@ func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTasksResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
+		x.Tasks = nil
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3046,13 +3112,22 @@ func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
+		if len(x.Tasks) == 0 {
+			return protoreflect.ValueOfList(&_QueryTasksResponse_1_list{})
+		}
+		listValue := &_QueryTasksResponse_1_list{list: &x.Tasks}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3066,13 +3141,19 @@ func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryTasksResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
+		lv := value.List()
+		clv := lv.(*_QueryTasksResponse_1_list)
+		x.Tasks = *clv.list
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3086,36 +3167,53 @@ func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
+		if x.Tasks == nil {
+			x.Tasks = []*Task{}
+		}
+		value := &_QueryTasksResponse_1_list{list: &x.Tasks}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryTasksResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.tasks":
+		list := []*Task{}
+		return protoreflect.ValueOfList(&_QueryTasksResponse_1_list{list: &list})
+	case "dysonprotocol.crontask.v1.QueryTasksResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryTasksResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryParamsRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3123,7 +3221,7 @@ func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryTasksResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3134,7 +3232,7 @@ func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryTasksResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3146,7 +3244,7 @@ func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsRequest) IsValid() bool {
+func (x *fastReflection_QueryTasksResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3156,9 +3254,9 @@ func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryTasksResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryTasksResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3170,6 +3268,16 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if len(x.Tasks) > 0 {
+			for _, e := range x.Tasks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3180,7 +3288,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryTasksResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3199,6 +3307,36 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Tasks) > 0 {
+			for iNdEx := len(x.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Tasks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3210,7 +3348,7 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsRequest)
+		x := input.Message.Interface().(*QueryTasksResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3242,12 +3380,82 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tasks = append(x.Tasks, &Task{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Tasks[len(x.Tasks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3284,25 +3492,23 @@ func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_QueryParamsResponse        protoreflect.MessageDescriptor
-	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
+	md_QueryParamsRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_dysonprotocol_crontask_v1_query_proto_init()
-	md_QueryParamsResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryParamsResponse")
-	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
+	md_QueryParamsRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryParamsRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsRequest)(nil)
 
-type fastReflection_QueryParamsResponse QueryParamsResponse
+type fastReflection_QueryParamsRequest QueryParamsRequest
 
-func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(x)
+func (x *QueryParamsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(x)
 }
 
-func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3314,43 +3520,43 @@ func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
+var _fastReflection_QueryParamsRequest_messageType fastReflection_QueryParamsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsRequest_messageType{}
 
-type fastReflection_QueryParamsResponse_messageType struct{}
+type fastReflection_QueryParamsRequest_messageType struct{}
 
-func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryParamsResponse)(nil)
+func (x fastReflection_QueryParamsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsRequest)(nil)
 }
-func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x fastReflection_QueryParamsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
-func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x fastReflection_QueryParamsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryParamsResponse
+func (x *fastReflection_QueryParamsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryParamsResponse_messageType
+func (x *fastReflection_QueryParamsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryParamsResponse)
+func (x *fastReflection_QueryParamsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryParamsResponse)(x)
+func (x *fastReflection_QueryParamsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3358,13 +3564,7 @@ func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_QueryParamsResponse_params, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryParamsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -3378,15 +3578,13 @@ func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
-		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3396,15 +3594,13 @@ func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
-		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3414,16 +3610,13 @@ func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3437,15 +3630,13 @@ func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
-		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3459,44 +3650,36 @@ func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsRequest"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryParamsResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryParamsRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3504,7 +3687,7 @@ func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3515,7 +3698,7 @@ func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3527,7 +3710,7 @@ func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryParamsResponse) IsValid() bool {
+func (x *fastReflection_QueryParamsRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3537,9 +3720,9 @@ func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3551,10 +3734,6 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3565,7 +3744,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3584,20 +3763,6 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3609,7 +3774,7 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryParamsResponse)
+		x := input.Message.Interface().(*QueryParamsRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3641,48 +3806,12 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3719,25 +3848,25 @@ func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QueryAllTasksRequest            protoreflect.MessageDescriptor
-	fd_QueryAllTasksRequest_pagination protoreflect.FieldDescriptor
+	md_QueryParamsResponse        protoreflect.MessageDescriptor
+	fd_QueryParamsResponse_params protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dysonprotocol_crontask_v1_query_proto_init()
-	md_QueryAllTasksRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryAllTasksRequest")
-	fd_QueryAllTasksRequest_pagination = md_QueryAllTasksRequest.Fields().ByName("pagination")
+	md_QueryParamsResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryParamsResponse")
+	fd_QueryParamsResponse_params = md_QueryParamsResponse.Fields().ByName("params")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryAllTasksRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryParamsResponse)(nil)
 
-type fastReflection_QueryAllTasksRequest QueryAllTasksRequest
+type fastReflection_QueryParamsResponse QueryParamsResponse
 
-func (x *QueryAllTasksRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryAllTasksRequest)(x)
+func (x *QueryParamsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(x)
 }
 
-func (x *QueryAllTasksRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryParamsResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3749,43 +3878,43 @@ func (x *QueryAllTasksRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryAllTasksRequest_messageType fastReflection_QueryAllTasksRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryAllTasksRequest_messageType{}
+var _fastReflection_QueryParamsResponse_messageType fastReflection_QueryParamsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryParamsResponse_messageType{}
 
-type fastReflection_QueryAllTasksRequest_messageType struct{}
+type fastReflection_QueryParamsResponse_messageType struct{}
 
-func (x fastReflection_QueryAllTasksRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryAllTasksRequest)(nil)
+func (x fastReflection_QueryParamsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryParamsResponse)(nil)
 }
-func (x fastReflection_QueryAllTasksRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryAllTasksRequest)
+func (x fastReflection_QueryParamsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
-func (x fastReflection_QueryAllTasksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllTasksRequest
+func (x fastReflection_QueryParamsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryAllTasksRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryAllTasksRequest
+func (x *fastReflection_QueryParamsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryParamsResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryAllTasksRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryAllTasksRequest_messageType
+func (x *fastReflection_QueryParamsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryParamsResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryAllTasksRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryAllTasksRequest)
+func (x *fastReflection_QueryParamsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryParamsResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryAllTasksRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryAllTasksRequest)(x)
+func (x *fastReflection_QueryParamsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryParamsResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3793,10 +3922,10 @@ func (x *fastReflection_QueryAllTasksRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryAllTasksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryAllTasksRequest_pagination, value) {
+func (x *fastReflection_QueryParamsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_QueryParamsResponse_params, value) {
 			return
 		}
 	}
@@ -3813,15 +3942,15 @@ func (x *fastReflection_QueryAllTasksRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryAllTasksRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryParamsResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
-		return x.Pagination != nil
+	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
+		return x.Params != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3831,15 +3960,15 @@ func (x *fastReflection_QueryAllTasksRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllTasksRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryParamsResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
-		x.Pagination = nil
+	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
+		x.Params = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3849,16 +3978,16 @@ func (x *fastReflection_QueryAllTasksRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryAllTasksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
-		value := x.Pagination
+	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
+		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3872,15 +4001,15 @@ func (x *fastReflection_QueryAllTasksRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllTasksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryParamsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
+		x.Params = value.Message().Interface().(*Params)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3894,44 +4023,44 @@ func (x *fastReflection_QueryAllTasksRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllTasksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
+	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
+		if x.Params == nil {
+			x.Params = new(Params)
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryAllTasksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryParamsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
-		m := new(v1beta1.PageRequest)
+	case "dysonprotocol.crontask.v1.QueryParamsResponse.params":
+		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryParamsResponse"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryParamsResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryAllTasksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryParamsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryAllTasksRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryParamsResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3939,7 +4068,7 @@ func (x *fastReflection_QueryAllTasksRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryAllTasksRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryParamsResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3950,7 +4079,7 @@ func (x *fastReflection_QueryAllTasksRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryAllTasksRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryParamsResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3962,7 +4091,7 @@ func (x *fastReflection_QueryAllTasksRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryAllTasksRequest) IsValid() bool {
+func (x *fastReflection_QueryParamsResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -3972,9 +4101,9 @@ func (x *fastReflection_QueryAllTasksRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryParamsResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryAllTasksRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3986,8 +4115,8 @@ func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
+		if x.Params != nil {
+			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -4000,7 +4129,7 @@ func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllTasksRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4019,8 +4148,8 @@ func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4044,7 +4173,7 @@ func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryAllTasksRequest)
+		x := input.Message.Interface().(*QueryParamsResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4076,15 +4205,15 @@ func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllTasksRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4111,10 +4240,10 @@ func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
+				if x.Params == nil {
+					x.Params = &Params{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -4153,365 +4282,10582 @@ func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: dysonprotocol/crontask/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryAllTasksRequest            protoreflect.MessageDescriptor
+	fd_QueryAllTasksRequest_pagination protoreflect.FieldDescriptor
 )
 
-// QueryTaskByIDRequest is the request type for the Query/TaskByID RPC method
-type QueryTaskByIDRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryAllTasksRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryAllTasksRequest")
+	fd_QueryAllTasksRequest_pagination = md_QueryAllTasksRequest.Fields().ByName("pagination")
 }
 
-func (x *QueryTaskByIDRequest) Reset() {
-	*x = QueryTaskByIDRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
+var _ protoreflect.Message = (*fastReflection_QueryAllTasksRequest)(nil)
 
-func (x *QueryTaskByIDRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type fastReflection_QueryAllTasksRequest QueryAllTasksRequest
+
+func (x *QueryAllTasksRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryAllTasksRequest)(x)
+}
+
+func (x *QueryAllTasksRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryAllTasksRequest_messageType fastReflection_QueryAllTasksRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryAllTasksRequest_messageType{}
+
+type fastReflection_QueryAllTasksRequest_messageType struct{}
+
+func (x fastReflection_QueryAllTasksRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryAllTasksRequest)(nil)
+}
+func (x fastReflection_QueryAllTasksRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryAllTasksRequest)
+}
+func (x fastReflection_QueryAllTasksRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllTasksRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryAllTasksRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryAllTasksRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryAllTasksRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryAllTasksRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryAllTasksRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryAllTasksRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryAllTasksRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryAllTasksRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryAllTasksRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryAllTasksRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryAllTasksRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllTasksRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryAllTasksRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllTasksRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllTasksRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryAllTasksRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryAllTasksRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryAllTasksRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryAllTasksRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryAllTasksRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryAllTasksRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryAllTasksRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryAllTasksRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryAllTasksRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryAllTasksRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllTasksRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryAllTasksRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllTasksRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryAllTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTasksByFilterRequest                protoreflect.MessageDescriptor
+	fd_QueryTasksByFilterRequest_creator        protoreflect.FieldDescriptor
+	fd_QueryTasksByFilterRequest_status         protoreflect.FieldDescriptor
+	fd_QueryTasksByFilterRequest_from_timestamp protoreflect.FieldDescriptor
+	fd_QueryTasksByFilterRequest_to_timestamp   protoreflect.FieldDescriptor
+	fd_QueryTasksByFilterRequest_pagination     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryTasksByFilterRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTasksByFilterRequest")
+	fd_QueryTasksByFilterRequest_creator = md_QueryTasksByFilterRequest.Fields().ByName("creator")
+	fd_QueryTasksByFilterRequest_status = md_QueryTasksByFilterRequest.Fields().ByName("status")
+	fd_QueryTasksByFilterRequest_from_timestamp = md_QueryTasksByFilterRequest.Fields().ByName("from_timestamp")
+	fd_QueryTasksByFilterRequest_to_timestamp = md_QueryTasksByFilterRequest.Fields().ByName("to_timestamp")
+	fd_QueryTasksByFilterRequest_pagination = md_QueryTasksByFilterRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTasksByFilterRequest)(nil)
+
+type fastReflection_QueryTasksByFilterRequest QueryTasksByFilterRequest
+
+func (x *QueryTasksByFilterRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTasksByFilterRequest)(x)
+}
+
+func (x *QueryTasksByFilterRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTasksByFilterRequest_messageType fastReflection_QueryTasksByFilterRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTasksByFilterRequest_messageType{}
+
+type fastReflection_QueryTasksByFilterRequest_messageType struct{}
+
+func (x fastReflection_QueryTasksByFilterRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTasksByFilterRequest)(nil)
+}
+func (x fastReflection_QueryTasksByFilterRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByFilterRequest)
+}
+func (x fastReflection_QueryTasksByFilterRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByFilterRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTasksByFilterRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTasksByFilterRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTasksByFilterRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTasksByFilterRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTasksByFilterRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTasksByFilterRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTasksByFilterRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTasksByFilterRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTasksByFilterRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_QueryTasksByFilterRequest_creator, value) {
+			return
+		}
+	}
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_QueryTasksByFilterRequest_status, value) {
+			return
+		}
+	}
+	if x.FromTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FromTimestamp)
+		if !f(fd_QueryTasksByFilterRequest_from_timestamp, value) {
+			return
+		}
+	}
+	if x.ToTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ToTimestamp)
+		if !f(fd_QueryTasksByFilterRequest_to_timestamp, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTasksByFilterRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTasksByFilterRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.creator":
+		return x.Creator != ""
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.status":
+		return x.Status != ""
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.from_timestamp":
+		return x.FromTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.to_timestamp":
+		return x.ToTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByFilterRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByFilterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTasksByFilterRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.creator":
+		x.Creator = ""
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.status":
+		x.Status = ""
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.from_timestamp":
+		x.FromTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.to_timestamp":
+		x.ToTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByFilterRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByFilterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTasksByFilterRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.from_timestamp":
+		value := x.FromTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.to_timestamp":
+		value := x.ToTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByFilterRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByFilterRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTasksByFilterRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.status":
+		x.Status = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.from_timestamp":
+		x.FromTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.to_timestamp":
+		x.ToTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByFilterRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByFilterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTasksByFilterRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.creator":
+		panic(fmt.Errorf("field creator of message dysonprotocol.crontask.v1.QueryTasksByFilterRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.status":
+		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.QueryTasksByFilterRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.from_timestamp":
+		panic(fmt.Errorf("field from_timestamp of message dysonprotocol.crontask.v1.QueryTasksByFilterRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.to_timestamp":
+		panic(fmt.Errorf("field to_timestamp of message dysonprotocol.crontask.v1.QueryTasksByFilterRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByFilterRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByFilterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTasksByFilterRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.status":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.from_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.to_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.QueryTasksByFilterRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTasksByFilterRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTasksByFilterRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTasksByFilterRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTasksByFilterRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTasksByFilterRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTasksByFilterRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTasksByFilterRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTasksByFilterRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTasksByFilterRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FromTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromTimestamp))
+		}
+		if x.ToTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToTimestamp))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTasksByFilterRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.ToTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToTimestamp))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.FromTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromTimestamp))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTasksByFilterRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByFilterRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTasksByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+				}
+				x.FromTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+				}
+				x.ToTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryScheduleForecastRequest                protoreflect.MessageDescriptor
+	fd_QueryScheduleForecastRequest_from_timestamp protoreflect.FieldDescriptor
+	fd_QueryScheduleForecastRequest_window_seconds protoreflect.FieldDescriptor
+	fd_QueryScheduleForecastRequest_bucket_seconds protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryScheduleForecastRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryScheduleForecastRequest")
+	fd_QueryScheduleForecastRequest_from_timestamp = md_QueryScheduleForecastRequest.Fields().ByName("from_timestamp")
+	fd_QueryScheduleForecastRequest_window_seconds = md_QueryScheduleForecastRequest.Fields().ByName("window_seconds")
+	fd_QueryScheduleForecastRequest_bucket_seconds = md_QueryScheduleForecastRequest.Fields().ByName("bucket_seconds")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryScheduleForecastRequest)(nil)
+
+type fastReflection_QueryScheduleForecastRequest QueryScheduleForecastRequest
+
+func (x *QueryScheduleForecastRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryScheduleForecastRequest)(x)
+}
+
+func (x *QueryScheduleForecastRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryScheduleForecastRequest_messageType fastReflection_QueryScheduleForecastRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryScheduleForecastRequest_messageType{}
+
+type fastReflection_QueryScheduleForecastRequest_messageType struct{}
+
+func (x fastReflection_QueryScheduleForecastRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryScheduleForecastRequest)(nil)
+}
+func (x fastReflection_QueryScheduleForecastRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryScheduleForecastRequest)
+}
+func (x fastReflection_QueryScheduleForecastRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryScheduleForecastRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryScheduleForecastRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryScheduleForecastRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryScheduleForecastRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryScheduleForecastRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryScheduleForecastRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryScheduleForecastRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryScheduleForecastRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryScheduleForecastRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryScheduleForecastRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FromTimestamp)
+		if !f(fd_QueryScheduleForecastRequest_from_timestamp, value) {
+			return
+		}
+	}
+	if x.WindowSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WindowSeconds)
+		if !f(fd_QueryScheduleForecastRequest_window_seconds, value) {
+			return
+		}
+	}
+	if x.BucketSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BucketSeconds)
+		if !f(fd_QueryScheduleForecastRequest_bucket_seconds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryScheduleForecastRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.from_timestamp":
+		return x.FromTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.window_seconds":
+		return x.WindowSeconds != int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.bucket_seconds":
+		return x.BucketSeconds != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.from_timestamp":
+		x.FromTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.window_seconds":
+		x.WindowSeconds = int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.bucket_seconds":
+		x.BucketSeconds = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryScheduleForecastRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.from_timestamp":
+		value := x.FromTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.window_seconds":
+		value := x.WindowSeconds
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.bucket_seconds":
+		value := x.BucketSeconds
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.from_timestamp":
+		x.FromTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.window_seconds":
+		x.WindowSeconds = value.Int()
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.bucket_seconds":
+		x.BucketSeconds = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.from_timestamp":
+		panic(fmt.Errorf("field from_timestamp of message dysonprotocol.crontask.v1.QueryScheduleForecastRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.window_seconds":
+		panic(fmt.Errorf("field window_seconds of message dysonprotocol.crontask.v1.QueryScheduleForecastRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.bucket_seconds":
+		panic(fmt.Errorf("field bucket_seconds of message dysonprotocol.crontask.v1.QueryScheduleForecastRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryScheduleForecastRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.from_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.window_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastRequest.bucket_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryScheduleForecastRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryScheduleForecastRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryScheduleForecastRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryScheduleForecastRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryScheduleForecastRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryScheduleForecastRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FromTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromTimestamp))
+		}
+		if x.WindowSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowSeconds))
+		}
+		if x.BucketSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.BucketSeconds))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryScheduleForecastRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BucketSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BucketSeconds))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.WindowSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowSeconds))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.FromTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromTimestamp))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryScheduleForecastRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryScheduleForecastRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryScheduleForecastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+				}
+				x.FromTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+				}
+				x.WindowSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BucketSeconds", wireType)
+				}
+				x.BucketSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BucketSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForecastBucket                 protoreflect.MessageDescriptor
+	fd_ForecastBucket_start_timestamp protoreflect.FieldDescriptor
+	fd_ForecastBucket_end_timestamp   protoreflect.FieldDescriptor
+	fd_ForecastBucket_runs            protoreflect.FieldDescriptor
+	fd_ForecastBucket_gas_limit       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_ForecastBucket = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("ForecastBucket")
+	fd_ForecastBucket_start_timestamp = md_ForecastBucket.Fields().ByName("start_timestamp")
+	fd_ForecastBucket_end_timestamp = md_ForecastBucket.Fields().ByName("end_timestamp")
+	fd_ForecastBucket_runs = md_ForecastBucket.Fields().ByName("runs")
+	fd_ForecastBucket_gas_limit = md_ForecastBucket.Fields().ByName("gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForecastBucket)(nil)
+
+type fastReflection_ForecastBucket ForecastBucket
+
+func (x *ForecastBucket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForecastBucket)(x)
+}
+
+func (x *ForecastBucket) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForecastBucket_messageType fastReflection_ForecastBucket_messageType
+var _ protoreflect.MessageType = fastReflection_ForecastBucket_messageType{}
+
+type fastReflection_ForecastBucket_messageType struct{}
+
+func (x fastReflection_ForecastBucket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForecastBucket)(nil)
+}
+func (x fastReflection_ForecastBucket_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForecastBucket)
+}
+func (x fastReflection_ForecastBucket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForecastBucket
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForecastBucket) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForecastBucket
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForecastBucket) Type() protoreflect.MessageType {
+	return _fastReflection_ForecastBucket_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForecastBucket) New() protoreflect.Message {
+	return new(fastReflection_ForecastBucket)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForecastBucket) Interface() protoreflect.ProtoMessage {
+	return (*ForecastBucket)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForecastBucket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StartTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartTimestamp)
+		if !f(fd_ForecastBucket_start_timestamp, value) {
+			return
+		}
+	}
+	if x.EndTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndTimestamp)
+		if !f(fd_ForecastBucket_end_timestamp, value) {
+			return
+		}
+	}
+	if x.Runs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Runs)
+		if !f(fd_ForecastBucket_runs, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_ForecastBucket_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForecastBucket) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ForecastBucket.start_timestamp":
+		return x.StartTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.ForecastBucket.end_timestamp":
+		return x.EndTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.ForecastBucket.runs":
+		return x.Runs != uint64(0)
+	case "dysonprotocol.crontask.v1.ForecastBucket.gas_limit":
+		return x.GasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ForecastBucket"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ForecastBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForecastBucket) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ForecastBucket.start_timestamp":
+		x.StartTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.ForecastBucket.end_timestamp":
+		x.EndTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.ForecastBucket.runs":
+		x.Runs = uint64(0)
+	case "dysonprotocol.crontask.v1.ForecastBucket.gas_limit":
+		x.GasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ForecastBucket"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ForecastBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForecastBucket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.ForecastBucket.start_timestamp":
+		value := x.StartTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.ForecastBucket.end_timestamp":
+		value := x.EndTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.ForecastBucket.runs":
+		value := x.Runs
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.ForecastBucket.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ForecastBucket"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ForecastBucket does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForecastBucket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ForecastBucket.start_timestamp":
+		x.StartTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.ForecastBucket.end_timestamp":
+		x.EndTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.ForecastBucket.runs":
+		x.Runs = value.Uint()
+	case "dysonprotocol.crontask.v1.ForecastBucket.gas_limit":
+		x.GasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ForecastBucket"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ForecastBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForecastBucket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ForecastBucket.start_timestamp":
+		panic(fmt.Errorf("field start_timestamp of message dysonprotocol.crontask.v1.ForecastBucket is not mutable"))
+	case "dysonprotocol.crontask.v1.ForecastBucket.end_timestamp":
+		panic(fmt.Errorf("field end_timestamp of message dysonprotocol.crontask.v1.ForecastBucket is not mutable"))
+	case "dysonprotocol.crontask.v1.ForecastBucket.runs":
+		panic(fmt.Errorf("field runs of message dysonprotocol.crontask.v1.ForecastBucket is not mutable"))
+	case "dysonprotocol.crontask.v1.ForecastBucket.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message dysonprotocol.crontask.v1.ForecastBucket is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ForecastBucket"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ForecastBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForecastBucket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.ForecastBucket.start_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.ForecastBucket.end_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.ForecastBucket.runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.ForecastBucket.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.ForecastBucket"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.ForecastBucket does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForecastBucket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.ForecastBucket", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForecastBucket) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForecastBucket) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForecastBucket) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForecastBucket) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForecastBucket)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StartTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartTimestamp))
+		}
+		if x.EndTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndTimestamp))
+		}
+		if x.Runs != 0 {
+			n += 1 + runtime.Sov(uint64(x.Runs))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForecastBucket)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Runs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Runs))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.EndTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndTimestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.StartTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartTimestamp))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForecastBucket)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForecastBucket: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForecastBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+				}
+				x.StartTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+				}
+				x.EndTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+				}
+				x.Runs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Runs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryScheduleForecastResponse_3_list)(nil)
+
+type _QueryScheduleForecastResponse_3_list struct {
+	list *[]*ForecastBucket
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForecastBucket)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ForecastBucket)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(ForecastBucket)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) NewElement() protoreflect.Value {
+	v := new(ForecastBucket)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryScheduleForecastResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryScheduleForecastResponse                 protoreflect.MessageDescriptor
+	fd_QueryScheduleForecastResponse_from_timestamp  protoreflect.FieldDescriptor
+	fd_QueryScheduleForecastResponse_to_timestamp    protoreflect.FieldDescriptor
+	fd_QueryScheduleForecastResponse_buckets         protoreflect.FieldDescriptor
+	fd_QueryScheduleForecastResponse_total_runs      protoreflect.FieldDescriptor
+	fd_QueryScheduleForecastResponse_total_gas_limit protoreflect.FieldDescriptor
+	fd_QueryScheduleForecastResponse_truncated       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryScheduleForecastResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryScheduleForecastResponse")
+	fd_QueryScheduleForecastResponse_from_timestamp = md_QueryScheduleForecastResponse.Fields().ByName("from_timestamp")
+	fd_QueryScheduleForecastResponse_to_timestamp = md_QueryScheduleForecastResponse.Fields().ByName("to_timestamp")
+	fd_QueryScheduleForecastResponse_buckets = md_QueryScheduleForecastResponse.Fields().ByName("buckets")
+	fd_QueryScheduleForecastResponse_total_runs = md_QueryScheduleForecastResponse.Fields().ByName("total_runs")
+	fd_QueryScheduleForecastResponse_total_gas_limit = md_QueryScheduleForecastResponse.Fields().ByName("total_gas_limit")
+	fd_QueryScheduleForecastResponse_truncated = md_QueryScheduleForecastResponse.Fields().ByName("truncated")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryScheduleForecastResponse)(nil)
+
+type fastReflection_QueryScheduleForecastResponse QueryScheduleForecastResponse
+
+func (x *QueryScheduleForecastResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryScheduleForecastResponse)(x)
+}
+
+func (x *QueryScheduleForecastResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryScheduleForecastResponse_messageType fastReflection_QueryScheduleForecastResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryScheduleForecastResponse_messageType{}
+
+type fastReflection_QueryScheduleForecastResponse_messageType struct{}
+
+func (x fastReflection_QueryScheduleForecastResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryScheduleForecastResponse)(nil)
+}
+func (x fastReflection_QueryScheduleForecastResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryScheduleForecastResponse)
+}
+func (x fastReflection_QueryScheduleForecastResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryScheduleForecastResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryScheduleForecastResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryScheduleForecastResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryScheduleForecastResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryScheduleForecastResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryScheduleForecastResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryScheduleForecastResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryScheduleForecastResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryScheduleForecastResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryScheduleForecastResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FromTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FromTimestamp)
+		if !f(fd_QueryScheduleForecastResponse_from_timestamp, value) {
+			return
+		}
+	}
+	if x.ToTimestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ToTimestamp)
+		if !f(fd_QueryScheduleForecastResponse_to_timestamp, value) {
+			return
+		}
+	}
+	if len(x.Buckets) != 0 {
+		value := protoreflect.ValueOfList(&_QueryScheduleForecastResponse_3_list{list: &x.Buckets})
+		if !f(fd_QueryScheduleForecastResponse_buckets, value) {
+			return
+		}
+	}
+	if x.TotalRuns != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalRuns)
+		if !f(fd_QueryScheduleForecastResponse_total_runs, value) {
+			return
+		}
+	}
+	if x.TotalGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalGasLimit)
+		if !f(fd_QueryScheduleForecastResponse_total_gas_limit, value) {
+			return
+		}
+	}
+	if x.Truncated != false {
+		value := protoreflect.ValueOfBool(x.Truncated)
+		if !f(fd_QueryScheduleForecastResponse_truncated, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryScheduleForecastResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.from_timestamp":
+		return x.FromTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.to_timestamp":
+		return x.ToTimestamp != int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.buckets":
+		return len(x.Buckets) != 0
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_runs":
+		return x.TotalRuns != uint64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_gas_limit":
+		return x.TotalGasLimit != uint64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.truncated":
+		return x.Truncated != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.from_timestamp":
+		x.FromTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.to_timestamp":
+		x.ToTimestamp = int64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.buckets":
+		x.Buckets = nil
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_runs":
+		x.TotalRuns = uint64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_gas_limit":
+		x.TotalGasLimit = uint64(0)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.truncated":
+		x.Truncated = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryScheduleForecastResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.from_timestamp":
+		value := x.FromTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.to_timestamp":
+		value := x.ToTimestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.buckets":
+		if len(x.Buckets) == 0 {
+			return protoreflect.ValueOfList(&_QueryScheduleForecastResponse_3_list{})
+		}
+		listValue := &_QueryScheduleForecastResponse_3_list{list: &x.Buckets}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_runs":
+		value := x.TotalRuns
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_gas_limit":
+		value := x.TotalGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.truncated":
+		value := x.Truncated
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.from_timestamp":
+		x.FromTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.to_timestamp":
+		x.ToTimestamp = value.Int()
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.buckets":
+		lv := value.List()
+		clv := lv.(*_QueryScheduleForecastResponse_3_list)
+		x.Buckets = *clv.list
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_runs":
+		x.TotalRuns = value.Uint()
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_gas_limit":
+		x.TotalGasLimit = value.Uint()
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.truncated":
+		x.Truncated = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.buckets":
+		if x.Buckets == nil {
+			x.Buckets = []*ForecastBucket{}
+		}
+		value := &_QueryScheduleForecastResponse_3_list{list: &x.Buckets}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.from_timestamp":
+		panic(fmt.Errorf("field from_timestamp of message dysonprotocol.crontask.v1.QueryScheduleForecastResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.to_timestamp":
+		panic(fmt.Errorf("field to_timestamp of message dysonprotocol.crontask.v1.QueryScheduleForecastResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_runs":
+		panic(fmt.Errorf("field total_runs of message dysonprotocol.crontask.v1.QueryScheduleForecastResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_gas_limit":
+		panic(fmt.Errorf("field total_gas_limit of message dysonprotocol.crontask.v1.QueryScheduleForecastResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.truncated":
+		panic(fmt.Errorf("field truncated of message dysonprotocol.crontask.v1.QueryScheduleForecastResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryScheduleForecastResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.from_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.to_timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.buckets":
+		list := []*ForecastBucket{}
+		return protoreflect.ValueOfList(&_QueryScheduleForecastResponse_3_list{list: &list})
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_runs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.total_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QueryScheduleForecastResponse.truncated":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryScheduleForecastResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryScheduleForecastResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryScheduleForecastResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryScheduleForecastResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryScheduleForecastResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryScheduleForecastResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryScheduleForecastResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryScheduleForecastResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryScheduleForecastResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.FromTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromTimestamp))
+		}
+		if x.ToTimestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToTimestamp))
+		}
+		if len(x.Buckets) > 0 {
+			for _, e := range x.Buckets {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalRuns != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalRuns))
+		}
+		if x.TotalGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalGasLimit))
+		}
+		if x.Truncated {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryScheduleForecastResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Truncated {
+			i--
+			if x.Truncated {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.TotalGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalGasLimit))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.TotalRuns != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalRuns))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Buckets) > 0 {
+			for iNdEx := len(x.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Buckets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.ToTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToTimestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.FromTimestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromTimestamp))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryScheduleForecastResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryScheduleForecastResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryScheduleForecastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+				}
+				x.FromTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+				}
+				x.ToTimestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToTimestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buckets = append(x.Buckets, &ForecastBucket{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Buckets[len(x.Buckets)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalRuns", wireType)
+				}
+				x.TotalRuns = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalRuns |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalGasLimit", wireType)
+				}
+				x.TotalGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Truncated = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTaskStatsRequest                    protoreflect.MessageDescriptor
+	fd_QueryTaskStatsRequest_upcoming_blocks    protoreflect.FieldDescriptor
+	fd_QueryTaskStatsRequest_block_time_seconds protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryTaskStatsRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTaskStatsRequest")
+	fd_QueryTaskStatsRequest_upcoming_blocks = md_QueryTaskStatsRequest.Fields().ByName("upcoming_blocks")
+	fd_QueryTaskStatsRequest_block_time_seconds = md_QueryTaskStatsRequest.Fields().ByName("block_time_seconds")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTaskStatsRequest)(nil)
+
+type fastReflection_QueryTaskStatsRequest QueryTaskStatsRequest
+
+func (x *QueryTaskStatsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTaskStatsRequest)(x)
+}
+
+func (x *QueryTaskStatsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTaskStatsRequest_messageType fastReflection_QueryTaskStatsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTaskStatsRequest_messageType{}
+
+type fastReflection_QueryTaskStatsRequest_messageType struct{}
+
+func (x fastReflection_QueryTaskStatsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTaskStatsRequest)(nil)
+}
+func (x fastReflection_QueryTaskStatsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTaskStatsRequest)
+}
+func (x fastReflection_QueryTaskStatsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTaskStatsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTaskStatsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTaskStatsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTaskStatsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTaskStatsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTaskStatsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTaskStatsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTaskStatsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTaskStatsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTaskStatsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.UpcomingBlocks != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.UpcomingBlocks)
+		if !f(fd_QueryTaskStatsRequest_upcoming_blocks, value) {
+			return
+		}
+	}
+	if x.BlockTimeSeconds != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockTimeSeconds)
+		if !f(fd_QueryTaskStatsRequest_block_time_seconds, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTaskStatsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.upcoming_blocks":
+		return x.UpcomingBlocks != uint32(0)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.block_time_seconds":
+		return x.BlockTimeSeconds != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.upcoming_blocks":
+		x.UpcomingBlocks = uint32(0)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.block_time_seconds":
+		x.BlockTimeSeconds = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTaskStatsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.upcoming_blocks":
+		value := x.UpcomingBlocks
+		return protoreflect.ValueOfUint32(value)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.block_time_seconds":
+		value := x.BlockTimeSeconds
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.upcoming_blocks":
+		x.UpcomingBlocks = uint32(value.Uint())
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.block_time_seconds":
+		x.BlockTimeSeconds = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.upcoming_blocks":
+		panic(fmt.Errorf("field upcoming_blocks of message dysonprotocol.crontask.v1.QueryTaskStatsRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.block_time_seconds":
+		panic(fmt.Errorf("field block_time_seconds of message dysonprotocol.crontask.v1.QueryTaskStatsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTaskStatsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.upcoming_blocks":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dysonprotocol.crontask.v1.QueryTaskStatsRequest.block_time_seconds":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTaskStatsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTaskStatsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTaskStatsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTaskStatsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTaskStatsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTaskStatsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.UpcomingBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpcomingBlocks))
+		}
+		if x.BlockTimeSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockTimeSeconds))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTaskStatsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockTimeSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockTimeSeconds))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.UpcomingBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpcomingBlocks))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTaskStatsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTaskStatsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTaskStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpcomingBlocks", wireType)
+				}
+				x.UpcomingBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpcomingBlocks |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockTimeSeconds", wireType)
+				}
+				x.BlockTimeSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockTimeSeconds |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StatusStats           protoreflect.MessageDescriptor
+	fd_StatusStats_status    protoreflect.FieldDescriptor
+	fd_StatusStats_count     protoreflect.FieldDescriptor
+	fd_StatusStats_gas_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_StatusStats = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("StatusStats")
+	fd_StatusStats_status = md_StatusStats.Fields().ByName("status")
+	fd_StatusStats_count = md_StatusStats.Fields().ByName("count")
+	fd_StatusStats_gas_limit = md_StatusStats.Fields().ByName("gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_StatusStats)(nil)
+
+type fastReflection_StatusStats StatusStats
+
+func (x *StatusStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StatusStats)(x)
+}
+
+func (x *StatusStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StatusStats_messageType fastReflection_StatusStats_messageType
+var _ protoreflect.MessageType = fastReflection_StatusStats_messageType{}
+
+type fastReflection_StatusStats_messageType struct{}
+
+func (x fastReflection_StatusStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StatusStats)(nil)
+}
+func (x fastReflection_StatusStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_StatusStats)
+}
+func (x fastReflection_StatusStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StatusStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StatusStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_StatusStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StatusStats) Type() protoreflect.MessageType {
+	return _fastReflection_StatusStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StatusStats) New() protoreflect.Message {
+	return new(fastReflection_StatusStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StatusStats) Interface() protoreflect.ProtoMessage {
+	return (*StatusStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StatusStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Status != "" {
+		value := protoreflect.ValueOfString(x.Status)
+		if !f(fd_StatusStats_status, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_StatusStats_count, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_StatusStats_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StatusStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StatusStats.status":
+		return x.Status != ""
+	case "dysonprotocol.crontask.v1.StatusStats.count":
+		return x.Count != uint64(0)
+	case "dysonprotocol.crontask.v1.StatusStats.gas_limit":
+		return x.GasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StatusStats"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StatusStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StatusStats.status":
+		x.Status = ""
+	case "dysonprotocol.crontask.v1.StatusStats.count":
+		x.Count = uint64(0)
+	case "dysonprotocol.crontask.v1.StatusStats.gas_limit":
+		x.GasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StatusStats"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StatusStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StatusStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.StatusStats.status":
+		value := x.Status
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.StatusStats.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.StatusStats.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StatusStats"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StatusStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StatusStats.status":
+		x.Status = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.StatusStats.count":
+		x.Count = value.Uint()
+	case "dysonprotocol.crontask.v1.StatusStats.gas_limit":
+		x.GasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StatusStats"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StatusStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StatusStats.status":
+		panic(fmt.Errorf("field status of message dysonprotocol.crontask.v1.StatusStats is not mutable"))
+	case "dysonprotocol.crontask.v1.StatusStats.count":
+		panic(fmt.Errorf("field count of message dysonprotocol.crontask.v1.StatusStats is not mutable"))
+	case "dysonprotocol.crontask.v1.StatusStats.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message dysonprotocol.crontask.v1.StatusStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StatusStats"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StatusStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StatusStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.StatusStats.status":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.StatusStats.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.StatusStats.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.StatusStats"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.StatusStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StatusStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.StatusStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StatusStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StatusStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StatusStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StatusStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StatusStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Status)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StatusStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Status) > 0 {
+			i -= len(x.Status)
+			copy(dAtA[i:], x.Status)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Status)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StatusStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StatusStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StatusStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Status = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BlockGasEstimate           protoreflect.MessageDescriptor
+	fd_BlockGasEstimate_height    protoreflect.FieldDescriptor
+	fd_BlockGasEstimate_timestamp protoreflect.FieldDescriptor
+	fd_BlockGasEstimate_tasks     protoreflect.FieldDescriptor
+	fd_BlockGasEstimate_gas_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_BlockGasEstimate = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("BlockGasEstimate")
+	fd_BlockGasEstimate_height = md_BlockGasEstimate.Fields().ByName("height")
+	fd_BlockGasEstimate_timestamp = md_BlockGasEstimate.Fields().ByName("timestamp")
+	fd_BlockGasEstimate_tasks = md_BlockGasEstimate.Fields().ByName("tasks")
+	fd_BlockGasEstimate_gas_limit = md_BlockGasEstimate.Fields().ByName("gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_BlockGasEstimate)(nil)
+
+type fastReflection_BlockGasEstimate BlockGasEstimate
+
+func (x *BlockGasEstimate) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BlockGasEstimate)(x)
+}
+
+func (x *BlockGasEstimate) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BlockGasEstimate_messageType fastReflection_BlockGasEstimate_messageType
+var _ protoreflect.MessageType = fastReflection_BlockGasEstimate_messageType{}
+
+type fastReflection_BlockGasEstimate_messageType struct{}
+
+func (x fastReflection_BlockGasEstimate_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BlockGasEstimate)(nil)
+}
+func (x fastReflection_BlockGasEstimate_messageType) New() protoreflect.Message {
+	return new(fastReflection_BlockGasEstimate)
+}
+func (x fastReflection_BlockGasEstimate_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockGasEstimate
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BlockGasEstimate) Descriptor() protoreflect.MessageDescriptor {
+	return md_BlockGasEstimate
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BlockGasEstimate) Type() protoreflect.MessageType {
+	return _fastReflection_BlockGasEstimate_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BlockGasEstimate) New() protoreflect.Message {
+	return new(fastReflection_BlockGasEstimate)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BlockGasEstimate) Interface() protoreflect.ProtoMessage {
+	return (*BlockGasEstimate)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BlockGasEstimate) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_BlockGasEstimate_height, value) {
+			return
+		}
+	}
+	if x.Timestamp != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Timestamp)
+		if !f(fd_BlockGasEstimate_timestamp, value) {
+			return
+		}
+	}
+	if x.Tasks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Tasks)
+		if !f(fd_BlockGasEstimate_tasks, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_BlockGasEstimate_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BlockGasEstimate) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.height":
+		return x.Height != int64(0)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.timestamp":
+		return x.Timestamp != int64(0)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.tasks":
+		return x.Tasks != uint64(0)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.gas_limit":
+		return x.GasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BlockGasEstimate"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BlockGasEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockGasEstimate) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.height":
+		x.Height = int64(0)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.timestamp":
+		x.Timestamp = int64(0)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.tasks":
+		x.Tasks = uint64(0)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.gas_limit":
+		x.GasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BlockGasEstimate"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BlockGasEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BlockGasEstimate) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.tasks":
+		value := x.Tasks
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BlockGasEstimate"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BlockGasEstimate does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockGasEstimate) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.height":
+		x.Height = value.Int()
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.timestamp":
+		x.Timestamp = value.Int()
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.tasks":
+		x.Tasks = value.Uint()
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.gas_limit":
+		x.GasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BlockGasEstimate"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BlockGasEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockGasEstimate) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.height":
+		panic(fmt.Errorf("field height of message dysonprotocol.crontask.v1.BlockGasEstimate is not mutable"))
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.timestamp":
+		panic(fmt.Errorf("field timestamp of message dysonprotocol.crontask.v1.BlockGasEstimate is not mutable"))
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.tasks":
+		panic(fmt.Errorf("field tasks of message dysonprotocol.crontask.v1.BlockGasEstimate is not mutable"))
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message dysonprotocol.crontask.v1.BlockGasEstimate is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BlockGasEstimate"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BlockGasEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BlockGasEstimate) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.timestamp":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.tasks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.BlockGasEstimate.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.BlockGasEstimate"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.BlockGasEstimate does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BlockGasEstimate) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.BlockGasEstimate", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BlockGasEstimate) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BlockGasEstimate) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BlockGasEstimate) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BlockGasEstimate) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BlockGasEstimate)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if x.Tasks != 0 {
+			n += 1 + runtime.Sov(uint64(x.Tasks))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BlockGasEstimate)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Tasks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Tasks))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BlockGasEstimate)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockGasEstimate: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BlockGasEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				}
+				x.Tasks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Tasks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTaskStatsResponse_1_list)(nil)
+
+type _QueryTaskStatsResponse_1_list struct {
+	list *[]*StatusStats
+}
+
+func (x *_QueryTaskStatsResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTaskStatsResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTaskStatsResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StatusStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTaskStatsResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StatusStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTaskStatsResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(StatusStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTaskStatsResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTaskStatsResponse_1_list) NewElement() protoreflect.Value {
+	v := new(StatusStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTaskStatsResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryTaskStatsResponse_3_list)(nil)
+
+type _QueryTaskStatsResponse_3_list struct {
+	list *[]*BlockGasEstimate
+}
+
+func (x *_QueryTaskStatsResponse_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTaskStatsResponse_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTaskStatsResponse_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockGasEstimate)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTaskStatsResponse_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BlockGasEstimate)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTaskStatsResponse_3_list) AppendMutable() protoreflect.Value {
+	v := new(BlockGasEstimate)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTaskStatsResponse_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTaskStatsResponse_3_list) NewElement() protoreflect.Value {
+	v := new(BlockGasEstimate)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTaskStatsResponse_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTaskStatsResponse                 protoreflect.MessageDescriptor
+	fd_QueryTaskStatsResponse_statuses        protoreflect.FieldDescriptor
+	fd_QueryTaskStatsResponse_total_tasks     protoreflect.FieldDescriptor
+	fd_QueryTaskStatsResponse_upcoming_blocks protoreflect.FieldDescriptor
+	fd_QueryTaskStatsResponse_block_gas_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryTaskStatsResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryTaskStatsResponse")
+	fd_QueryTaskStatsResponse_statuses = md_QueryTaskStatsResponse.Fields().ByName("statuses")
+	fd_QueryTaskStatsResponse_total_tasks = md_QueryTaskStatsResponse.Fields().ByName("total_tasks")
+	fd_QueryTaskStatsResponse_upcoming_blocks = md_QueryTaskStatsResponse.Fields().ByName("upcoming_blocks")
+	fd_QueryTaskStatsResponse_block_gas_limit = md_QueryTaskStatsResponse.Fields().ByName("block_gas_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTaskStatsResponse)(nil)
+
+type fastReflection_QueryTaskStatsResponse QueryTaskStatsResponse
+
+func (x *QueryTaskStatsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTaskStatsResponse)(x)
+}
+
+func (x *QueryTaskStatsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTaskStatsResponse_messageType fastReflection_QueryTaskStatsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTaskStatsResponse_messageType{}
+
+type fastReflection_QueryTaskStatsResponse_messageType struct{}
+
+func (x fastReflection_QueryTaskStatsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTaskStatsResponse)(nil)
+}
+func (x fastReflection_QueryTaskStatsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTaskStatsResponse)
+}
+func (x fastReflection_QueryTaskStatsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTaskStatsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTaskStatsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTaskStatsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTaskStatsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTaskStatsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTaskStatsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTaskStatsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTaskStatsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTaskStatsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTaskStatsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Statuses) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTaskStatsResponse_1_list{list: &x.Statuses})
+		if !f(fd_QueryTaskStatsResponse_statuses, value) {
+			return
+		}
+	}
+	if x.TotalTasks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TotalTasks)
+		if !f(fd_QueryTaskStatsResponse_total_tasks, value) {
+			return
+		}
+	}
+	if len(x.UpcomingBlocks) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTaskStatsResponse_3_list{list: &x.UpcomingBlocks})
+		if !f(fd_QueryTaskStatsResponse_upcoming_blocks, value) {
+			return
+		}
+	}
+	if x.BlockGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BlockGasLimit)
+		if !f(fd_QueryTaskStatsResponse_block_gas_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTaskStatsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.statuses":
+		return len(x.Statuses) != 0
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.total_tasks":
+		return x.TotalTasks != uint64(0)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.upcoming_blocks":
+		return len(x.UpcomingBlocks) != 0
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.block_gas_limit":
+		return x.BlockGasLimit != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.statuses":
+		x.Statuses = nil
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.total_tasks":
+		x.TotalTasks = uint64(0)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.upcoming_blocks":
+		x.UpcomingBlocks = nil
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.block_gas_limit":
+		x.BlockGasLimit = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTaskStatsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.statuses":
+		if len(x.Statuses) == 0 {
+			return protoreflect.ValueOfList(&_QueryTaskStatsResponse_1_list{})
+		}
+		listValue := &_QueryTaskStatsResponse_1_list{list: &x.Statuses}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.total_tasks":
+		value := x.TotalTasks
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.upcoming_blocks":
+		if len(x.UpcomingBlocks) == 0 {
+			return protoreflect.ValueOfList(&_QueryTaskStatsResponse_3_list{})
+		}
+		listValue := &_QueryTaskStatsResponse_3_list{list: &x.UpcomingBlocks}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.block_gas_limit":
+		value := x.BlockGasLimit
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.statuses":
+		lv := value.List()
+		clv := lv.(*_QueryTaskStatsResponse_1_list)
+		x.Statuses = *clv.list
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.total_tasks":
+		x.TotalTasks = value.Uint()
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.upcoming_blocks":
+		lv := value.List()
+		clv := lv.(*_QueryTaskStatsResponse_3_list)
+		x.UpcomingBlocks = *clv.list
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.block_gas_limit":
+		x.BlockGasLimit = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.statuses":
+		if x.Statuses == nil {
+			x.Statuses = []*StatusStats{}
+		}
+		value := &_QueryTaskStatsResponse_1_list{list: &x.Statuses}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.upcoming_blocks":
+		if x.UpcomingBlocks == nil {
+			x.UpcomingBlocks = []*BlockGasEstimate{}
+		}
+		value := &_QueryTaskStatsResponse_3_list{list: &x.UpcomingBlocks}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.total_tasks":
+		panic(fmt.Errorf("field total_tasks of message dysonprotocol.crontask.v1.QueryTaskStatsResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.block_gas_limit":
+		panic(fmt.Errorf("field block_gas_limit of message dysonprotocol.crontask.v1.QueryTaskStatsResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTaskStatsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.statuses":
+		list := []*StatusStats{}
+		return protoreflect.ValueOfList(&_QueryTaskStatsResponse_1_list{list: &list})
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.total_tasks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.upcoming_blocks":
+		list := []*BlockGasEstimate{}
+		return protoreflect.ValueOfList(&_QueryTaskStatsResponse_3_list{list: &list})
+	case "dysonprotocol.crontask.v1.QueryTaskStatsResponse.block_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryTaskStatsResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryTaskStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTaskStatsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryTaskStatsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTaskStatsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTaskStatsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTaskStatsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTaskStatsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTaskStatsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Statuses) > 0 {
+			for _, e := range x.Statuses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TotalTasks != 0 {
+			n += 1 + runtime.Sov(uint64(x.TotalTasks))
+		}
+		if len(x.UpcomingBlocks) > 0 {
+			for _, e := range x.UpcomingBlocks {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.BlockGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockGasLimit))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTaskStatsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockGasLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.UpcomingBlocks) > 0 {
+			for iNdEx := len(x.UpcomingBlocks) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UpcomingBlocks[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.TotalTasks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TotalTasks))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Statuses) > 0 {
+			for iNdEx := len(x.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Statuses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTaskStatsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTaskStatsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTaskStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Statuses = append(x.Statuses, &StatusStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Statuses[len(x.Statuses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalTasks", wireType)
+				}
+				x.TotalTasks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TotalTasks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpcomingBlocks", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UpcomingBlocks = append(x.UpcomingBlocks, &BlockGasEstimate{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UpcomingBlocks[len(x.UpcomingBlocks)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+				}
+				x.BlockGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateTaskRequest_2_list)(nil)
+
+type _QuerySimulateTaskRequest_2_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateTaskRequest_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateTaskRequest                 protoreflect.MessageDescriptor
+	fd_QuerySimulateTaskRequest_creator         protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskRequest_msgs            protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskRequest_task_gas_limit  protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskRequest_execution_phase protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QuerySimulateTaskRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QuerySimulateTaskRequest")
+	fd_QuerySimulateTaskRequest_creator = md_QuerySimulateTaskRequest.Fields().ByName("creator")
+	fd_QuerySimulateTaskRequest_msgs = md_QuerySimulateTaskRequest.Fields().ByName("msgs")
+	fd_QuerySimulateTaskRequest_task_gas_limit = md_QuerySimulateTaskRequest.Fields().ByName("task_gas_limit")
+	fd_QuerySimulateTaskRequest_execution_phase = md_QuerySimulateTaskRequest.Fields().ByName("execution_phase")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateTaskRequest)(nil)
+
+type fastReflection_QuerySimulateTaskRequest QuerySimulateTaskRequest
+
+func (x *QuerySimulateTaskRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateTaskRequest)(x)
+}
+
+func (x *QuerySimulateTaskRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateTaskRequest_messageType fastReflection_QuerySimulateTaskRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateTaskRequest_messageType{}
+
+type fastReflection_QuerySimulateTaskRequest_messageType struct{}
+
+func (x fastReflection_QuerySimulateTaskRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateTaskRequest)(nil)
+}
+func (x fastReflection_QuerySimulateTaskRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateTaskRequest)
+}
+func (x fastReflection_QuerySimulateTaskRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateTaskRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateTaskRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateTaskRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateTaskRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateTaskRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateTaskRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateTaskRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateTaskRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateTaskRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateTaskRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_QuerySimulateTaskRequest_creator, value) {
+			return
+		}
+	}
+	if len(x.Msgs) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateTaskRequest_2_list{list: &x.Msgs})
+		if !f(fd_QuerySimulateTaskRequest_msgs, value) {
+			return
+		}
+	}
+	if x.TaskGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TaskGasLimit)
+		if !f(fd_QuerySimulateTaskRequest_task_gas_limit, value) {
+			return
+		}
+	}
+	if x.ExecutionPhase != "" {
+		value := protoreflect.ValueOfString(x.ExecutionPhase)
+		if !f(fd_QuerySimulateTaskRequest_execution_phase, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateTaskRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.creator":
+		return x.Creator != ""
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.msgs":
+		return len(x.Msgs) != 0
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.task_gas_limit":
+		return x.TaskGasLimit != uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.execution_phase":
+		return x.ExecutionPhase != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.creator":
+		x.Creator = ""
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.msgs":
+		x.Msgs = nil
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.task_gas_limit":
+		x.TaskGasLimit = uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.execution_phase":
+		x.ExecutionPhase = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateTaskRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.msgs":
+		if len(x.Msgs) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateTaskRequest_2_list{})
+		}
+		listValue := &_QuerySimulateTaskRequest_2_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.task_gas_limit":
+		value := x.TaskGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.execution_phase":
+		value := x.ExecutionPhase
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.msgs":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateTaskRequest_2_list)
+		x.Msgs = *clv.list
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.task_gas_limit":
+		x.TaskGasLimit = value.Uint()
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.execution_phase":
+		x.ExecutionPhase = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.msgs":
+		if x.Msgs == nil {
+			x.Msgs = []*anypb.Any{}
+		}
+		value := &_QuerySimulateTaskRequest_2_list{list: &x.Msgs}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.creator":
+		panic(fmt.Errorf("field creator of message dysonprotocol.crontask.v1.QuerySimulateTaskRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.task_gas_limit":
+		panic(fmt.Errorf("field task_gas_limit of message dysonprotocol.crontask.v1.QuerySimulateTaskRequest is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.execution_phase":
+		panic(fmt.Errorf("field execution_phase of message dysonprotocol.crontask.v1.QuerySimulateTaskRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateTaskRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.msgs":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_QuerySimulateTaskRequest_2_list{list: &list})
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.task_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskRequest.execution_phase":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateTaskRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QuerySimulateTaskRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateTaskRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateTaskRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateTaskRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateTaskRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Msgs) > 0 {
+			for _, e := range x.Msgs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.TaskGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.TaskGasLimit))
+		}
+		l = len(x.ExecutionPhase)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateTaskRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ExecutionPhase) > 0 {
+			i -= len(x.ExecutionPhase)
+			copy(dAtA[i:], x.ExecutionPhase)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ExecutionPhase)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.TaskGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TaskGasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Msgs) > 0 {
+			for iNdEx := len(x.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Msgs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateTaskRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateTaskRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Msgs = append(x.Msgs, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Msgs[len(x.Msgs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+				}
+				x.TaskGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TaskGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionPhase", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionPhase = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QuerySimulateTaskResponse_4_list)(nil)
+
+type _QuerySimulateTaskResponse_4_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QuerySimulateTaskResponse_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QuerySimulateTaskResponse                  protoreflect.MessageDescriptor
+	fd_QuerySimulateTaskResponse_success          protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_gas_used         protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_task_gas_limit   protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_msg_results      protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_error_log        protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_codespace        protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_code             protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_queue_depth      protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_queued_gas       protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_min_task_gas_fee protoreflect.FieldDescriptor
+	fd_QuerySimulateTaskResponse_min_gas_price    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QuerySimulateTaskResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QuerySimulateTaskResponse")
+	fd_QuerySimulateTaskResponse_success = md_QuerySimulateTaskResponse.Fields().ByName("success")
+	fd_QuerySimulateTaskResponse_gas_used = md_QuerySimulateTaskResponse.Fields().ByName("gas_used")
+	fd_QuerySimulateTaskResponse_task_gas_limit = md_QuerySimulateTaskResponse.Fields().ByName("task_gas_limit")
+	fd_QuerySimulateTaskResponse_msg_results = md_QuerySimulateTaskResponse.Fields().ByName("msg_results")
+	fd_QuerySimulateTaskResponse_error_log = md_QuerySimulateTaskResponse.Fields().ByName("error_log")
+	fd_QuerySimulateTaskResponse_codespace = md_QuerySimulateTaskResponse.Fields().ByName("codespace")
+	fd_QuerySimulateTaskResponse_code = md_QuerySimulateTaskResponse.Fields().ByName("code")
+	fd_QuerySimulateTaskResponse_queue_depth = md_QuerySimulateTaskResponse.Fields().ByName("queue_depth")
+	fd_QuerySimulateTaskResponse_queued_gas = md_QuerySimulateTaskResponse.Fields().ByName("queued_gas")
+	fd_QuerySimulateTaskResponse_min_task_gas_fee = md_QuerySimulateTaskResponse.Fields().ByName("min_task_gas_fee")
+	fd_QuerySimulateTaskResponse_min_gas_price = md_QuerySimulateTaskResponse.Fields().ByName("min_gas_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySimulateTaskResponse)(nil)
+
+type fastReflection_QuerySimulateTaskResponse QuerySimulateTaskResponse
+
+func (x *QuerySimulateTaskResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySimulateTaskResponse)(x)
+}
+
+func (x *QuerySimulateTaskResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySimulateTaskResponse_messageType fastReflection_QuerySimulateTaskResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySimulateTaskResponse_messageType{}
+
+type fastReflection_QuerySimulateTaskResponse_messageType struct{}
+
+func (x fastReflection_QuerySimulateTaskResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySimulateTaskResponse)(nil)
+}
+func (x fastReflection_QuerySimulateTaskResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateTaskResponse)
+}
+func (x fastReflection_QuerySimulateTaskResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateTaskResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySimulateTaskResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySimulateTaskResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySimulateTaskResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySimulateTaskResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySimulateTaskResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySimulateTaskResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySimulateTaskResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySimulateTaskResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySimulateTaskResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_QuerySimulateTaskResponse_success, value) {
+			return
+		}
+	}
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_QuerySimulateTaskResponse_gas_used, value) {
+			return
+		}
+	}
+	if x.TaskGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.TaskGasLimit)
+		if !f(fd_QuerySimulateTaskResponse_task_gas_limit, value) {
+			return
+		}
+	}
+	if len(x.MsgResults) != 0 {
+		value := protoreflect.ValueOfList(&_QuerySimulateTaskResponse_4_list{list: &x.MsgResults})
+		if !f(fd_QuerySimulateTaskResponse_msg_results, value) {
+			return
+		}
+	}
+	if x.ErrorLog != "" {
+		value := protoreflect.ValueOfString(x.ErrorLog)
+		if !f(fd_QuerySimulateTaskResponse_error_log, value) {
+			return
+		}
+	}
+	if x.Codespace != "" {
+		value := protoreflect.ValueOfString(x.Codespace)
+		if !f(fd_QuerySimulateTaskResponse_codespace, value) {
+			return
+		}
+	}
+	if x.Code != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Code)
+		if !f(fd_QuerySimulateTaskResponse_code, value) {
+			return
+		}
+	}
+	if x.QueueDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QueueDepth)
+		if !f(fd_QuerySimulateTaskResponse_queue_depth, value) {
+			return
+		}
+	}
+	if x.QueuedGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QueuedGas)
+		if !f(fd_QuerySimulateTaskResponse_queued_gas, value) {
+			return
+		}
+	}
+	if x.MinTaskGasFee != nil {
+		value := protoreflect.ValueOfMessage(x.MinTaskGasFee.ProtoReflect())
+		if !f(fd_QuerySimulateTaskResponse_min_task_gas_fee, value) {
+			return
+		}
+	}
+	if x.MinGasPrice != nil {
+		value := protoreflect.ValueOfMessage(x.MinGasPrice.ProtoReflect())
+		if !f(fd_QuerySimulateTaskResponse_min_gas_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySimulateTaskResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.success":
+		return x.Success != false
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.gas_used":
+		return x.GasUsed != uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.task_gas_limit":
+		return x.TaskGasLimit != uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.msg_results":
+		return len(x.MsgResults) != 0
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.error_log":
+		return x.ErrorLog != ""
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.codespace":
+		return x.Codespace != ""
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.code":
+		return x.Code != uint32(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queue_depth":
+		return x.QueueDepth != uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queued_gas":
+		return x.QueuedGas != uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_task_gas_fee":
+		return x.MinTaskGasFee != nil
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_gas_price":
+		return x.MinGasPrice != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.success":
+		x.Success = false
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.gas_used":
+		x.GasUsed = uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.task_gas_limit":
+		x.TaskGasLimit = uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.msg_results":
+		x.MsgResults = nil
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.error_log":
+		x.ErrorLog = ""
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.codespace":
+		x.Codespace = ""
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.code":
+		x.Code = uint32(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queue_depth":
+		x.QueueDepth = uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queued_gas":
+		x.QueuedGas = uint64(0)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_task_gas_fee":
+		x.MinTaskGasFee = nil
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_gas_price":
+		x.MinGasPrice = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySimulateTaskResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.task_gas_limit":
+		value := x.TaskGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.msg_results":
+		if len(x.MsgResults) == 0 {
+			return protoreflect.ValueOfList(&_QuerySimulateTaskResponse_4_list{})
+		}
+		listValue := &_QuerySimulateTaskResponse_4_list{list: &x.MsgResults}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.error_log":
+		value := x.ErrorLog
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.codespace":
+		value := x.Codespace
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.code":
+		value := x.Code
+		return protoreflect.ValueOfUint32(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queue_depth":
+		value := x.QueueDepth
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queued_gas":
+		value := x.QueuedGas
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_task_gas_fee":
+		value := x.MinTaskGasFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_gas_price":
+		value := x.MinGasPrice
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.success":
+		x.Success = value.Bool()
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.gas_used":
+		x.GasUsed = value.Uint()
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.task_gas_limit":
+		x.TaskGasLimit = value.Uint()
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.msg_results":
+		lv := value.List()
+		clv := lv.(*_QuerySimulateTaskResponse_4_list)
+		x.MsgResults = *clv.list
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.error_log":
+		x.ErrorLog = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.codespace":
+		x.Codespace = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.code":
+		x.Code = uint32(value.Uint())
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queue_depth":
+		x.QueueDepth = value.Uint()
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queued_gas":
+		x.QueuedGas = value.Uint()
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_task_gas_fee":
+		x.MinTaskGasFee = value.Message().Interface().(*v1beta11.Coin)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_gas_price":
+		x.MinGasPrice = value.Message().Interface().(*v1beta11.DecCoin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.msg_results":
+		if x.MsgResults == nil {
+			x.MsgResults = []*anypb.Any{}
+		}
+		value := &_QuerySimulateTaskResponse_4_list{list: &x.MsgResults}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_task_gas_fee":
+		if x.MinTaskGasFee == nil {
+			x.MinTaskGasFee = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MinTaskGasFee.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_gas_price":
+		if x.MinGasPrice == nil {
+			x.MinGasPrice = new(v1beta11.DecCoin)
+		}
+		return protoreflect.ValueOfMessage(x.MinGasPrice.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.success":
+		panic(fmt.Errorf("field success of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.gas_used":
+		panic(fmt.Errorf("field gas_used of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.task_gas_limit":
+		panic(fmt.Errorf("field task_gas_limit of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.error_log":
+		panic(fmt.Errorf("field error_log of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.codespace":
+		panic(fmt.Errorf("field codespace of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.code":
+		panic(fmt.Errorf("field code of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queue_depth":
+		panic(fmt.Errorf("field queue_depth of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queued_gas":
+		panic(fmt.Errorf("field queued_gas of message dysonprotocol.crontask.v1.QuerySimulateTaskResponse is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySimulateTaskResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.success":
+		return protoreflect.ValueOfBool(false)
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.task_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.msg_results":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_QuerySimulateTaskResponse_4_list{list: &list})
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.error_log":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.codespace":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queue_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.queued_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_task_gas_fee":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_gas_price":
+		m := new(v1beta11.DecCoin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QuerySimulateTaskResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QuerySimulateTaskResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySimulateTaskResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QuerySimulateTaskResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySimulateTaskResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySimulateTaskResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySimulateTaskResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySimulateTaskResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySimulateTaskResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Success {
+			n += 2
+		}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		if x.TaskGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.TaskGasLimit))
+		}
+		if len(x.MsgResults) > 0 {
+			for _, e := range x.MsgResults {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.ErrorLog)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Codespace)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Code != 0 {
+			n += 1 + runtime.Sov(uint64(x.Code))
+		}
+		if x.QueueDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.QueueDepth))
+		}
+		if x.QueuedGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.QueuedGas))
+		}
+		if x.MinTaskGasFee != nil {
+			l = options.Size(x.MinTaskGasFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinGasPrice != nil {
+			l = options.Size(x.MinGasPrice)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateTaskResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.MinGasPrice != nil {
+			encoded, err := options.Marshal(x.MinGasPrice)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.MinTaskGasFee != nil {
+			encoded, err := options.Marshal(x.MinTaskGasFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.QueuedGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueuedGas))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.QueueDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QueueDepth))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Code != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Code))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Codespace) > 0 {
+			i -= len(x.Codespace)
+			copy(dAtA[i:], x.Codespace)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Codespace)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.ErrorLog) > 0 {
+			i -= len(x.ErrorLog)
+			copy(dAtA[i:], x.ErrorLog)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ErrorLog)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.MsgResults) > 0 {
+			for iNdEx := len(x.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgResults[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.TaskGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TaskGasLimit))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Success {
+			i--
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySimulateTaskResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateTaskResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySimulateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Success = bool(v != 0)
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+				}
+				x.TaskGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TaskGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgResults = append(x.MsgResults, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResults[len(x.MsgResults)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ErrorLog = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Codespace = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				}
+				x.Code = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Code |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueueDepth", wireType)
+				}
+				x.QueueDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueueDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedGas", wireType)
+				}
+				x.QueuedGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QueuedGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTaskGasFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinTaskGasFee == nil {
+					x.MinTaskGasFee = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinTaskGasFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinGasPrice == nil {
+					x.MinGasPrice = &v1beta11.DecCoin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinGasPrice); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPayoutScheduleRequest           protoreflect.MessageDescriptor
+	fd_QueryPayoutScheduleRequest_payout_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryPayoutScheduleRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryPayoutScheduleRequest")
+	fd_QueryPayoutScheduleRequest_payout_id = md_QueryPayoutScheduleRequest.Fields().ByName("payout_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPayoutScheduleRequest)(nil)
+
+type fastReflection_QueryPayoutScheduleRequest QueryPayoutScheduleRequest
+
+func (x *QueryPayoutScheduleRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPayoutScheduleRequest)(x)
+}
+
+func (x *QueryPayoutScheduleRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPayoutScheduleRequest_messageType fastReflection_QueryPayoutScheduleRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPayoutScheduleRequest_messageType{}
+
+type fastReflection_QueryPayoutScheduleRequest_messageType struct{}
+
+func (x fastReflection_QueryPayoutScheduleRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPayoutScheduleRequest)(nil)
+}
+func (x fastReflection_QueryPayoutScheduleRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutScheduleRequest)
+}
+func (x fastReflection_QueryPayoutScheduleRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutScheduleRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPayoutScheduleRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutScheduleRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPayoutScheduleRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPayoutScheduleRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPayoutScheduleRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutScheduleRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPayoutScheduleRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPayoutScheduleRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPayoutScheduleRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PayoutId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PayoutId)
+		if !f(fd_QueryPayoutScheduleRequest_payout_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPayoutScheduleRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleRequest.payout_id":
+		return x.PayoutId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleRequest.payout_id":
+		x.PayoutId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPayoutScheduleRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleRequest.payout_id":
+		value := x.PayoutId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleRequest.payout_id":
+		x.PayoutId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleRequest.payout_id":
+		panic(fmt.Errorf("field payout_id of message dysonprotocol.crontask.v1.QueryPayoutScheduleRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPayoutScheduleRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleRequest.payout_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPayoutScheduleRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryPayoutScheduleRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPayoutScheduleRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPayoutScheduleRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPayoutScheduleRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPayoutScheduleRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PayoutId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PayoutId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutScheduleRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PayoutId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PayoutId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutScheduleRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutScheduleRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutId", wireType)
+				}
+				x.PayoutId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PayoutId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPayoutScheduleResponse                 protoreflect.MessageDescriptor
+	fd_QueryPayoutScheduleResponse_payout_schedule protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryPayoutScheduleResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryPayoutScheduleResponse")
+	fd_QueryPayoutScheduleResponse_payout_schedule = md_QueryPayoutScheduleResponse.Fields().ByName("payout_schedule")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPayoutScheduleResponse)(nil)
+
+type fastReflection_QueryPayoutScheduleResponse QueryPayoutScheduleResponse
+
+func (x *QueryPayoutScheduleResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPayoutScheduleResponse)(x)
+}
+
+func (x *QueryPayoutScheduleResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPayoutScheduleResponse_messageType fastReflection_QueryPayoutScheduleResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPayoutScheduleResponse_messageType{}
+
+type fastReflection_QueryPayoutScheduleResponse_messageType struct{}
+
+func (x fastReflection_QueryPayoutScheduleResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPayoutScheduleResponse)(nil)
+}
+func (x fastReflection_QueryPayoutScheduleResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutScheduleResponse)
+}
+func (x fastReflection_QueryPayoutScheduleResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutScheduleResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPayoutScheduleResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutScheduleResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPayoutScheduleResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPayoutScheduleResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPayoutScheduleResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutScheduleResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPayoutScheduleResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPayoutScheduleResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPayoutScheduleResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PayoutSchedule != nil {
+		value := protoreflect.ValueOfMessage(x.PayoutSchedule.ProtoReflect())
+		if !f(fd_QueryPayoutScheduleResponse_payout_schedule, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPayoutScheduleResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleResponse.payout_schedule":
+		return x.PayoutSchedule != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleResponse.payout_schedule":
+		x.PayoutSchedule = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPayoutScheduleResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleResponse.payout_schedule":
+		value := x.PayoutSchedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleResponse.payout_schedule":
+		x.PayoutSchedule = value.Message().Interface().(*PayoutSchedule)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleResponse.payout_schedule":
+		if x.PayoutSchedule == nil {
+			x.PayoutSchedule = new(PayoutSchedule)
+		}
+		return protoreflect.ValueOfMessage(x.PayoutSchedule.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPayoutScheduleResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutScheduleResponse.payout_schedule":
+		m := new(PayoutSchedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutScheduleResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPayoutScheduleResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryPayoutScheduleResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPayoutScheduleResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutScheduleResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPayoutScheduleResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPayoutScheduleResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPayoutScheduleResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.PayoutSchedule != nil {
+			l = options.Size(x.PayoutSchedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutScheduleResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.PayoutSchedule != nil {
+			encoded, err := options.Marshal(x.PayoutSchedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutScheduleResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutScheduleResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PayoutSchedule == nil {
+					x.PayoutSchedule = &PayoutSchedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PayoutSchedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPayoutSchedulesByRecipientRequest            protoreflect.MessageDescriptor
+	fd_QueryPayoutSchedulesByRecipientRequest_recipient  protoreflect.FieldDescriptor
+	fd_QueryPayoutSchedulesByRecipientRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryPayoutSchedulesByRecipientRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryPayoutSchedulesByRecipientRequest")
+	fd_QueryPayoutSchedulesByRecipientRequest_recipient = md_QueryPayoutSchedulesByRecipientRequest.Fields().ByName("recipient")
+	fd_QueryPayoutSchedulesByRecipientRequest_pagination = md_QueryPayoutSchedulesByRecipientRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPayoutSchedulesByRecipientRequest)(nil)
+
+type fastReflection_QueryPayoutSchedulesByRecipientRequest QueryPayoutSchedulesByRecipientRequest
+
+func (x *QueryPayoutSchedulesByRecipientRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPayoutSchedulesByRecipientRequest)(x)
+}
+
+func (x *QueryPayoutSchedulesByRecipientRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType{}
+
+type fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType struct{}
+
+func (x fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPayoutSchedulesByRecipientRequest)(nil)
+}
+func (x fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutSchedulesByRecipientRequest)
+}
+func (x fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutSchedulesByRecipientRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutSchedulesByRecipientRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPayoutSchedulesByRecipientRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutSchedulesByRecipientRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPayoutSchedulesByRecipientRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_QueryPayoutSchedulesByRecipientRequest_recipient, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPayoutSchedulesByRecipientRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.recipient":
+		return x.Recipient != ""
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.recipient":
+		x.Recipient = ""
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.recipient":
+		x.Recipient = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.recipient":
+		panic(fmt.Errorf("field recipient of message dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.recipient":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPayoutSchedulesByRecipientRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPayoutSchedulesByRecipientRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutSchedulesByRecipientRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutSchedulesByRecipientRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutSchedulesByRecipientRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutSchedulesByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryPayoutSchedulesByCreatorRequest            protoreflect.MessageDescriptor
+	fd_QueryPayoutSchedulesByCreatorRequest_creator    protoreflect.FieldDescriptor
+	fd_QueryPayoutSchedulesByCreatorRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryPayoutSchedulesByCreatorRequest = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryPayoutSchedulesByCreatorRequest")
+	fd_QueryPayoutSchedulesByCreatorRequest_creator = md_QueryPayoutSchedulesByCreatorRequest.Fields().ByName("creator")
+	fd_QueryPayoutSchedulesByCreatorRequest_pagination = md_QueryPayoutSchedulesByCreatorRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPayoutSchedulesByCreatorRequest)(nil)
+
+type fastReflection_QueryPayoutSchedulesByCreatorRequest QueryPayoutSchedulesByCreatorRequest
+
+func (x *QueryPayoutSchedulesByCreatorRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPayoutSchedulesByCreatorRequest)(x)
+}
+
+func (x *QueryPayoutSchedulesByCreatorRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType{}
+
+type fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType struct{}
+
+func (x fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPayoutSchedulesByCreatorRequest)(nil)
+}
+func (x fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutSchedulesByCreatorRequest)
+}
+func (x fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutSchedulesByCreatorRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutSchedulesByCreatorRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPayoutSchedulesByCreatorRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutSchedulesByCreatorRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryPayoutSchedulesByCreatorRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_QueryPayoutSchedulesByCreatorRequest_creator, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPayoutSchedulesByCreatorRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.creator":
+		return x.Creator != ""
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.creator":
+		x.Creator = ""
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.creator":
+		panic(fmt.Errorf("field creator of message dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPayoutSchedulesByCreatorRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPayoutSchedulesByCreatorRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutSchedulesByCreatorRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutSchedulesByCreatorRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutSchedulesByCreatorRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutSchedulesByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryPayoutSchedulesResponse_1_list)(nil)
+
+type _QueryPayoutSchedulesResponse_1_list struct {
+	list *[]*PayoutSchedule
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayoutSchedule)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PayoutSchedule)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(PayoutSchedule)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(PayoutSchedule)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryPayoutSchedulesResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryPayoutSchedulesResponse                  protoreflect.MessageDescriptor
+	fd_QueryPayoutSchedulesResponse_payout_schedules protoreflect.FieldDescriptor
+	fd_QueryPayoutSchedulesResponse_pagination       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_crontask_v1_query_proto_init()
+	md_QueryPayoutSchedulesResponse = File_dysonprotocol_crontask_v1_query_proto.Messages().ByName("QueryPayoutSchedulesResponse")
+	fd_QueryPayoutSchedulesResponse_payout_schedules = md_QueryPayoutSchedulesResponse.Fields().ByName("payout_schedules")
+	fd_QueryPayoutSchedulesResponse_pagination = md_QueryPayoutSchedulesResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryPayoutSchedulesResponse)(nil)
+
+type fastReflection_QueryPayoutSchedulesResponse QueryPayoutSchedulesResponse
+
+func (x *QueryPayoutSchedulesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryPayoutSchedulesResponse)(x)
+}
+
+func (x *QueryPayoutSchedulesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryPayoutSchedulesResponse_messageType fastReflection_QueryPayoutSchedulesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryPayoutSchedulesResponse_messageType{}
+
+type fastReflection_QueryPayoutSchedulesResponse_messageType struct{}
+
+func (x fastReflection_QueryPayoutSchedulesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryPayoutSchedulesResponse)(nil)
+}
+func (x fastReflection_QueryPayoutSchedulesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutSchedulesResponse)
+}
+func (x fastReflection_QueryPayoutSchedulesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutSchedulesResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryPayoutSchedulesResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryPayoutSchedulesResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryPayoutSchedulesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryPayoutSchedulesResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryPayoutSchedulesResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PayoutSchedules) != 0 {
+		value := protoreflect.ValueOfList(&_QueryPayoutSchedulesResponse_1_list{list: &x.PayoutSchedules})
+		if !f(fd_QueryPayoutSchedulesResponse_payout_schedules, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryPayoutSchedulesResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.payout_schedules":
+		return len(x.PayoutSchedules) != 0
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.payout_schedules":
+		x.PayoutSchedules = nil
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.payout_schedules":
+		if len(x.PayoutSchedules) == 0 {
+			return protoreflect.ValueOfList(&_QueryPayoutSchedulesResponse_1_list{})
+		}
+		listValue := &_QueryPayoutSchedulesResponse_1_list{list: &x.PayoutSchedules}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.payout_schedules":
+		lv := value.List()
+		clv := lv.(*_QueryPayoutSchedulesResponse_1_list)
+		x.PayoutSchedules = *clv.list
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.payout_schedules":
+		if x.PayoutSchedules == nil {
+			x.PayoutSchedules = []*PayoutSchedule{}
+		}
+		value := &_QueryPayoutSchedulesResponse_1_list{list: &x.PayoutSchedules}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryPayoutSchedulesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.payout_schedules":
+		list := []*PayoutSchedule{}
+		return protoreflect.ValueOfList(&_QueryPayoutSchedulesResponse_1_list{list: &list})
+	case "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryPayoutSchedulesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryPayoutSchedulesResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryPayoutSchedulesResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryPayoutSchedulesResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryPayoutSchedulesResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryPayoutSchedulesResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.PayoutSchedules) > 0 {
+			for _, e := range x.PayoutSchedules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutSchedulesResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PayoutSchedules) > 0 {
+			for iNdEx := len(x.PayoutSchedules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PayoutSchedules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryPayoutSchedulesResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutSchedulesResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryPayoutSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PayoutSchedules = append(x.PayoutSchedules, &PayoutSchedule{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PayoutSchedules[len(x.PayoutSchedules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: dysonprotocol/crontask/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryTaskByIDRequest is the request type for the Query/TaskByID RPC method
+type QueryTaskByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (x *QueryTaskByIDRequest) Reset() {
+	*x = QueryTaskByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTaskByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
 func (*QueryTaskByIDRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTaskByIDRequest.ProtoReflect.Descriptor instead.
-func (*QueryTaskByIDRequest) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use QueryTaskByIDRequest.ProtoReflect.Descriptor instead.
+func (*QueryTaskByIDRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryTaskByIDRequest) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+// QueryTaskByIDResponse is the response type for the Query/TaskByID RPC method
+type QueryTaskByIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+}
+
+func (x *QueryTaskByIDResponse) Reset() {
+	*x = QueryTaskByIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTaskByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTaskByIDResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTaskByIDResponse.ProtoReflect.Descriptor instead.
+func (*QueryTaskByIDResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryTaskByIDResponse) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+// QueryTasksByAddressRequest is the request type for the Query/TasksByAddress
+// RPC method
+type QueryTasksByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTasksByAddressRequest) Reset() {
+	*x = QueryTasksByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTasksByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTasksByAddressRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTasksByAddressRequest.ProtoReflect.Descriptor instead.
+func (*QueryTasksByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryTasksByAddressRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *QueryTasksByAddressRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTasksByWorkflowRequest is the request type for the Query/TasksByWorkflow
+// RPC method
+type QueryTasksByWorkflowRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator    string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	WorkflowId string               `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTasksByWorkflowRequest) Reset() {
+	*x = QueryTasksByWorkflowRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTasksByWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTasksByWorkflowRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTasksByWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*QueryTasksByWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryTasksByWorkflowRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *QueryTasksByWorkflowRequest) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *QueryTasksByWorkflowRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTasksByStatusTimestampRequest is the request type for the
+// Query/TasksByStatusTimestamp RPC method
+type QueryTasksByStatusTimestampRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTasksByStatusTimestampRequest) Reset() {
+	*x = QueryTasksByStatusTimestampRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTasksByStatusTimestampRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTasksByStatusTimestampRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTasksByStatusTimestampRequest.ProtoReflect.Descriptor instead.
+func (*QueryTasksByStatusTimestampRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryTasksByStatusTimestampRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueryTasksByStatusTimestampRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTasksByStatusGasPriceRequest is the request type for the
+// Query/TasksByStatusGasPrice RPC method
+type QueryTasksByStatusGasPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTasksByStatusGasPriceRequest) Reset() {
+	*x = QueryTasksByStatusGasPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTasksByStatusGasPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTasksByStatusGasPriceRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTasksByStatusGasPriceRequest.ProtoReflect.Descriptor instead.
+func (*QueryTasksByStatusGasPriceRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryTasksByStatusGasPriceRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueryTasksByStatusGasPriceRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTasksResponse is the response type for task queries with multiple
+// results
+type QueryTasksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Explicit json tag without omitempty to ensure empty arrays are included in
+	// marshalled output so clients can reliably expect the "tasks" key.
+	Tasks      []*Task               `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTasksResponse) Reset() {
+	*x = QueryTasksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTasksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTasksResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryTasksResponse.ProtoReflect.Descriptor instead.
+func (*QueryTasksResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryTasksResponse) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *QueryTasksResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+// QueryParamsResponse is the response type for the Query/Params RPC method
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type QueryAllTasksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllTasksRequest) Reset() {
+	*x = QueryAllTasksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllTasksRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllTasksRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllTasksRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryAllTasksRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryTasksByFilterRequest is the request type for the Query/TasksByFilter RPC
+// method. Empty fields do not filter.
+type QueryTasksByFilterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// from_timestamp is the earliest due time (Unix seconds), inclusive
+	FromTimestamp int64 `protobuf:"varint,3,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	// to_timestamp is the latest due time (Unix seconds), inclusive. Zero means no
+	// upper bound.
+	ToTimestamp int64                `protobuf:"varint,4,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	Pagination  *v1beta1.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryTasksByFilterRequest) Reset() {
+	*x = QueryTasksByFilterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTasksByFilterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryTasksByFilterRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTasksByFilterRequest.ProtoReflect.Descriptor instead.
+func (*QueryTasksByFilterRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryTasksByFilterRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *QueryTasksByFilterRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QueryTasksByFilterRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *QueryTasksByFilterRequest) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *QueryTasksByFilterRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryScheduleForecastRequest is the request type for the
+// Query/ScheduleForecast RPC method
+type QueryScheduleForecastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// from_timestamp is the start of the window (Unix seconds), the block time
+	// when zero
+	FromTimestamp int64 `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	// window_seconds is the length of the window, one hour when zero
+	WindowSeconds int64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// bucket_seconds is the length of a bucket, one minute when zero
+	BucketSeconds int64 `protobuf:"varint,3,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
+}
+
+func (x *QueryScheduleForecastRequest) Reset() {
+	*x = QueryScheduleForecastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryScheduleForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryScheduleForecastRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryScheduleForecastRequest.ProtoReflect.Descriptor instead.
+func (*QueryScheduleForecastRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryScheduleForecastRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *QueryScheduleForecastRequest) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *QueryScheduleForecastRequest) GetBucketSeconds() int64 {
+	if x != nil {
+		return x.BucketSeconds
+	}
+	return 0
+}
+
+// ForecastBucket holds the task runs due in a time bucket
+type ForecastBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTimestamp int64 `protobuf:"varint,1,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// end_timestamp is exclusive
+	EndTimestamp int64  `protobuf:"varint,2,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	Runs         uint64 `protobuf:"varint,3,opt,name=runs,proto3" json:"runs,omitempty"`
+	GasLimit     uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (x *ForecastBucket) Reset() {
+	*x = ForecastBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForecastBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForecastBucket) ProtoMessage() {}
+
+// Deprecated: Use ForecastBucket.ProtoReflect.Descriptor instead.
+func (*ForecastBucket) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ForecastBucket) GetStartTimestamp() int64 {
+	if x != nil {
+		return x.StartTimestamp
+	}
+	return 0
+}
+
+func (x *ForecastBucket) GetEndTimestamp() int64 {
+	if x != nil {
+		return x.EndTimestamp
+	}
+	return 0
+}
+
+func (x *ForecastBucket) GetRuns() uint64 {
+	if x != nil {
+		return x.Runs
+	}
+	return 0
+}
+
+func (x *ForecastBucket) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+// QueryScheduleForecastResponse is the response type for the
+// Query/ScheduleForecast RPC method
+type QueryScheduleForecastResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp int64             `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64             `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	Buckets       []*ForecastBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalRuns     uint64            `protobuf:"varint,4,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	TotalGasLimit uint64            `protobuf:"varint,5,opt,name=total_gas_limit,json=totalGasLimit,proto3" json:"total_gas_limit,omitempty"`
+	// truncated is set when the runs of a recurring task were cut at
+	// MaxForecastRunsPerTask
+	Truncated bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *QueryScheduleForecastResponse) Reset() {
+	*x = QueryScheduleForecastResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryScheduleForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryScheduleForecastResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryScheduleForecastResponse.ProtoReflect.Descriptor instead.
+func (*QueryScheduleForecastResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *QueryScheduleForecastResponse) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *QueryScheduleForecastResponse) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *QueryScheduleForecastResponse) GetBuckets() []*ForecastBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *QueryScheduleForecastResponse) GetTotalRuns() uint64 {
+	if x != nil {
+		return x.TotalRuns
+	}
+	return 0
+}
+
+func (x *QueryScheduleForecastResponse) GetTotalGasLimit() uint64 {
+	if x != nil {
+		return x.TotalGasLimit
+	}
+	return 0
+}
+
+func (x *QueryScheduleForecastResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// QueryTaskStatsRequest is the request type for the Query/TaskStats RPC method
+type QueryTaskStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// upcoming_blocks is the number of upcoming blocks to estimate, 10 when zero
+	UpcomingBlocks uint32 `protobuf:"varint,1,opt,name=upcoming_blocks,json=upcomingBlocks,proto3" json:"upcoming_blocks,omitempty"`
+	// block_time_seconds is the expected time between blocks, 5 when zero
+	BlockTimeSeconds int64 `protobuf:"varint,2,opt,name=block_time_seconds,json=blockTimeSeconds,proto3" json:"block_time_seconds,omitempty"`
+}
+
+func (x *QueryTaskStatsRequest) Reset() {
+	*x = QueryTaskStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryTaskStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *QueryTaskByIDRequest) GetTaskId() uint64 {
+func (*QueryTaskStatsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryTaskStatsRequest.ProtoReflect.Descriptor instead.
+func (*QueryTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *QueryTaskStatsRequest) GetUpcomingBlocks() uint32 {
 	if x != nil {
-		return x.TaskId
+		return x.UpcomingBlocks
 	}
 	return 0
 }
 
-// QueryTaskByIDResponse is the response type for the Query/TaskByID RPC method
-type QueryTaskByIDResponse struct {
+func (x *QueryTaskStatsRequest) GetBlockTimeSeconds() int64 {
+	if x != nil {
+		return x.BlockTimeSeconds
+	}
+	return 0
+}
+
+// StatusStats holds the number of tasks with a status and their gas limit
+type StatusStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count    uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (x *QueryTaskByIDResponse) Reset() {
-	*x = QueryTaskByIDResponse{}
+func (x *StatusStats) Reset() {
+	*x = StatusStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[1]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTaskByIDResponse) String() string {
+func (x *StatusStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTaskByIDResponse) ProtoMessage() {}
+func (*StatusStats) ProtoMessage() {}
 
-// Deprecated: Use QueryTaskByIDResponse.ProtoReflect.Descriptor instead.
-func (*QueryTaskByIDResponse) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use StatusStats.ProtoReflect.Descriptor instead.
+func (*StatusStats) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryTaskByIDResponse) GetTask() *Task {
+func (x *StatusStats) GetStatus() string {
 	if x != nil {
-		return x.Task
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-// QueryTasksByAddressRequest is the request type for the Query/TasksByAddress
-// RPC method
-type QueryTasksByAddressRequest struct {
+func (x *StatusStats) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StatusStats) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+// BlockGasEstimate holds the tasks expected to run in an upcoming block
+type BlockGasEstimate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator    string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Tasks     uint64 `protobuf:"varint,3,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	GasLimit  uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (x *QueryTasksByAddressRequest) Reset() {
-	*x = QueryTasksByAddressRequest{}
+func (x *BlockGasEstimate) Reset() {
+	*x = BlockGasEstimate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[2]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTasksByAddressRequest) String() string {
+func (x *BlockGasEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTasksByAddressRequest) ProtoMessage() {}
+func (*BlockGasEstimate) ProtoMessage() {}
 
-// Deprecated: Use QueryTasksByAddressRequest.ProtoReflect.Descriptor instead.
-func (*QueryTasksByAddressRequest) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BlockGasEstimate.ProtoReflect.Descriptor instead.
+func (*BlockGasEstimate) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryTasksByAddressRequest) GetCreator() string {
+func (x *BlockGasEstimate) GetHeight() int64 {
 	if x != nil {
-		return x.Creator
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *QueryTasksByAddressRequest) GetPagination() *v1beta1.PageRequest {
+func (x *BlockGasEstimate) GetTimestamp() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-// QueryTasksByStatusTimestampRequest is the request type for the
-// Query/TasksByStatusTimestamp RPC method
-type QueryTasksByStatusTimestampRequest struct {
+func (x *BlockGasEstimate) GetTasks() uint64 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *BlockGasEstimate) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+// QueryTaskStatsResponse is the response type for the Query/TaskStats RPC method
+type QueryTaskStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Statuses       []*StatusStats      `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
+	TotalTasks     uint64              `protobuf:"varint,2,opt,name=total_tasks,json=totalTasks,proto3" json:"total_tasks,omitempty"`
+	UpcomingBlocks []*BlockGasEstimate `protobuf:"bytes,3,rep,name=upcoming_blocks,json=upcomingBlocks,proto3" json:"upcoming_blocks,omitempty"`
+	BlockGasLimit  uint64              `protobuf:"varint,4,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
 }
 
-func (x *QueryTasksByStatusTimestampRequest) Reset() {
-	*x = QueryTasksByStatusTimestampRequest{}
+func (x *QueryTaskStatsResponse) Reset() {
+	*x = QueryTaskStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[3]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTasksByStatusTimestampRequest) String() string {
+func (x *QueryTaskStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTasksByStatusTimestampRequest) ProtoMessage() {}
+func (*QueryTaskStatsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryTasksByStatusTimestampRequest.ProtoReflect.Descriptor instead.
-func (*QueryTasksByStatusTimestampRequest) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryTaskStatsResponse.ProtoReflect.Descriptor instead.
+func (*QueryTaskStatsResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryTasksByStatusTimestampRequest) GetStatus() string {
+func (x *QueryTaskStatsResponse) GetStatuses() []*StatusStats {
 	if x != nil {
-		return x.Status
+		return x.Statuses
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryTasksByStatusTimestampRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryTaskStatsResponse) GetTotalTasks() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.TotalTasks
+	}
+	return 0
+}
+
+func (x *QueryTaskStatsResponse) GetUpcomingBlocks() []*BlockGasEstimate {
+	if x != nil {
+		return x.UpcomingBlocks
 	}
 	return nil
 }
 
-// QueryTasksByStatusGasPriceRequest is the request type for the
-// Query/TasksByStatusGasPrice RPC method
-type QueryTasksByStatusGasPriceRequest struct {
+func (x *QueryTaskStatsResponse) GetBlockGasLimit() uint64 {
+	if x != nil {
+		return x.BlockGasLimit
+	}
+	return 0
+}
+
+// QuerySimulateTaskRequest is the request type for the Query/SimulateTask RPC
+// method
+type QuerySimulateTaskRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status     string               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Creator string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Msgs    []*anypb.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// task_gas_limit is the gas limit of the task, the largest gas limit allowed
+	// when zero
+	TaskGasLimit uint64 `protobuf:"varint,3,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
+	// execution_phase is the phase of the block in which the task runs,
+	// BEGIN_BLOCK when empty
+	ExecutionPhase string `protobuf:"bytes,4,opt,name=execution_phase,json=executionPhase,proto3" json:"execution_phase,omitempty"`
 }
 
-func (x *QueryTasksByStatusGasPriceRequest) Reset() {
-	*x = QueryTasksByStatusGasPriceRequest{}
+func (x *QuerySimulateTaskRequest) Reset() {
+	*x = QuerySimulateTaskRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[4]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTasksByStatusGasPriceRequest) String() string {
+func (x *QuerySimulateTaskRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTasksByStatusGasPriceRequest) ProtoMessage() {}
+func (*QuerySimulateTaskRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTasksByStatusGasPriceRequest.ProtoReflect.Descriptor instead.
-func (*QueryTasksByStatusGasPriceRequest) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use QuerySimulateTaskRequest.ProtoReflect.Descriptor instead.
+func (*QuerySimulateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryTasksByStatusGasPriceRequest) GetStatus() string {
+func (x *QuerySimulateTaskRequest) GetCreator() string {
 	if x != nil {
-		return x.Status
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *QueryTasksByStatusGasPriceRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QuerySimulateTaskRequest) GetMsgs() []*anypb.Any {
 	if x != nil {
-		return x.Pagination
+		return x.Msgs
 	}
 	return nil
 }
 
-// QueryTasksResponse is the response type for task queries with multiple
-// results
-type QueryTasksResponse struct {
+func (x *QuerySimulateTaskRequest) GetTaskGasLimit() uint64 {
+	if x != nil {
+		return x.TaskGasLimit
+	}
+	return 0
+}
+
+func (x *QuerySimulateTaskRequest) GetExecutionPhase() string {
+	if x != nil {
+		return x.ExecutionPhase
+	}
+	return ""
+}
+
+// QuerySimulateTaskResponse is the response type for the Query/SimulateTask RPC
+// method
+type QuerySimulateTaskResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Explicit json tag without omitempty to ensure empty arrays are included in
-	// marshalled output so clients can reliably expect the "tasks" key.
-	Tasks      []*Task               `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
-	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// success is set when every message ran without error
+	Success      bool         `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GasUsed      uint64       `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	TaskGasLimit uint64       `protobuf:"varint,3,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
+	MsgResults   []*anypb.Any `protobuf:"bytes,4,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty"`
+	ErrorLog     string       `protobuf:"bytes,5,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
+	Codespace    string       `protobuf:"bytes,6,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code         uint32       `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	// queue_depth is the number of tasks competing for the next block in the
+	// phase and queued_gas their gas limit
+	QueueDepth uint64 `protobuf:"varint,8,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	QueuedGas  uint64 `protobuf:"varint,9,opt,name=queued_gas,json=queuedGas,proto3" json:"queued_gas,omitempty"`
+	// min_task_gas_fee is the smallest fee for which the task would run in the
+	// next block, assuming the tasks ahead use their whole gas limit, and
+	// min_gas_price the gas price it sets
+	MinTaskGasFee *v1beta11.Coin    `protobuf:"bytes,10,opt,name=min_task_gas_fee,json=minTaskGasFee,proto3" json:"min_task_gas_fee,omitempty"`
+	MinGasPrice   *v1beta11.DecCoin `protobuf:"bytes,11,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price,omitempty"`
+}
+
+func (x *QuerySimulateTaskResponse) Reset() {
+	*x = QuerySimulateTaskResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *QueryTasksResponse) Reset() {
-	*x = QueryTasksResponse{}
+func (x *QuerySimulateTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuerySimulateTaskResponse) ProtoMessage() {}
+
+// Deprecated: Use QuerySimulateTaskResponse.ProtoReflect.Descriptor instead.
+func (*QuerySimulateTaskResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QuerySimulateTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *QuerySimulateTaskResponse) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *QuerySimulateTaskResponse) GetTaskGasLimit() uint64 {
+	if x != nil {
+		return x.TaskGasLimit
+	}
+	return 0
+}
+
+func (x *QuerySimulateTaskResponse) GetMsgResults() []*anypb.Any {
+	if x != nil {
+		return x.MsgResults
+	}
+	return nil
+}
+
+func (x *QuerySimulateTaskResponse) GetErrorLog() string {
+	if x != nil {
+		return x.ErrorLog
+	}
+	return ""
+}
+
+func (x *QuerySimulateTaskResponse) GetCodespace() string {
+	if x != nil {
+		return x.Codespace
+	}
+	return ""
+}
+
+func (x *QuerySimulateTaskResponse) GetCode() uint32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *QuerySimulateTaskResponse) GetQueueDepth() uint64 {
+	if x != nil {
+		return x.QueueDepth
+	}
+	return 0
+}
+
+func (x *QuerySimulateTaskResponse) GetQueuedGas() uint64 {
+	if x != nil {
+		return x.QueuedGas
+	}
+	return 0
+}
+
+func (x *QuerySimulateTaskResponse) GetMinTaskGasFee() *v1beta11.Coin {
+	if x != nil {
+		return x.MinTaskGasFee
+	}
+	return nil
+}
+
+func (x *QuerySimulateTaskResponse) GetMinGasPrice() *v1beta11.DecCoin {
+	if x != nil {
+		return x.MinGasPrice
+	}
+	return nil
+}
+
+// QueryPayoutScheduleRequest is the request type for the Query/PayoutSchedule
+// RPC method
+type QueryPayoutScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayoutId uint64 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+}
+
+func (x *QueryPayoutScheduleRequest) Reset() {
+	*x = QueryPayoutScheduleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[5]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryTasksResponse) String() string {
+func (x *QueryPayoutScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryTasksResponse) ProtoMessage() {}
+func (*QueryPayoutScheduleRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryTasksResponse.ProtoReflect.Descriptor instead.
-func (*QueryTasksResponse) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryPayoutScheduleRequest.ProtoReflect.Descriptor instead.
+func (*QueryPayoutScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryTasksResponse) GetTasks() []*Task {
+func (x *QueryPayoutScheduleRequest) GetPayoutId() uint64 {
 	if x != nil {
-		return x.Tasks
+		return x.PayoutId
 	}
-	return nil
+	return 0
 }
 
-func (x *QueryTasksResponse) GetPagination() *v1beta1.PageResponse {
+// QueryPayoutScheduleResponse is the response type for the
+// Query/PayoutSchedule RPC method
+type QueryPayoutScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayoutSchedule *PayoutSchedule `protobuf:"bytes,1,opt,name=payout_schedule,json=payoutSchedule,proto3" json:"payout_schedule,omitempty"`
+}
+
+func (x *QueryPayoutScheduleResponse) Reset() {
+	*x = QueryPayoutScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryPayoutScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPayoutScheduleResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryPayoutScheduleResponse.ProtoReflect.Descriptor instead.
+func (*QueryPayoutScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryPayoutScheduleResponse) GetPayoutSchedule() *PayoutSchedule {
 	if x != nil {
-		return x.Pagination
+		return x.PayoutSchedule
 	}
 	return nil
 }
 
-// QueryParamsRequest is the request type for the Query/Params RPC method
-type QueryParamsRequest struct {
+// QueryPayoutSchedulesByRecipientRequest is the request type for the
+// Query/PayoutSchedulesByRecipient RPC method
+type QueryPayoutSchedulesByRecipientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Recipient  string               `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryPayoutSchedulesByRecipientRequest) Reset() {
+	*x = QueryPayoutSchedulesByRecipientRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[6]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryPayoutSchedulesByRecipientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryPayoutSchedulesByRecipientRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryPayoutSchedulesByRecipientRequest.ProtoReflect.Descriptor instead.
+func (*QueryPayoutSchedulesByRecipientRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryPayoutSchedulesByRecipientRequest) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{6}
+func (x *QueryPayoutSchedulesByRecipientRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
 }
 
-// QueryParamsResponse is the response type for the Query/Params RPC method
-type QueryParamsResponse struct {
+// QueryPayoutSchedulesByCreatorRequest is the request type for the
+// Query/PayoutSchedulesByCreator RPC method
+type QueryPayoutSchedulesByCreatorRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Creator    string               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryPayoutSchedulesByCreatorRequest) Reset() {
+	*x = QueryPayoutSchedulesByCreatorRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[7]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryPayoutSchedulesByCreatorRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryPayoutSchedulesByCreatorRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryPayoutSchedulesByCreatorRequest.ProtoReflect.Descriptor instead.
+func (*QueryPayoutSchedulesByCreatorRequest) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryPayoutSchedulesByCreatorRequest) GetCreator() string {
 	if x != nil {
-		return x.Params
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *QueryPayoutSchedulesByCreatorRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type QueryAllTasksRequest struct {
+// QueryPayoutSchedulesResponse is the response type for payout schedule
+// queries with multiple results, ordered by ID
+type QueryPayoutSchedulesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta1.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	PayoutSchedules []*PayoutSchedule     `protobuf:"bytes,1,rep,name=payout_schedules,json=payoutSchedules,proto3" json:"payout_schedules,omitempty"`
+	Pagination      *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllTasksRequest) Reset() {
-	*x = QueryAllTasksRequest{}
+func (x *QueryPayoutSchedulesResponse) Reset() {
+	*x = QueryPayoutSchedulesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[8]
+		mi := &file_dysonprotocol_crontask_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllTasksRequest) String() string {
+func (x *QueryPayoutSchedulesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllTasksRequest) ProtoMessage() {}
+func (*QueryPayoutSchedulesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryAllTasksRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllTasksRequest) Descriptor() ([]byte, []int) {
-	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use QueryPayoutSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*QueryPayoutSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *QueryAllTasksRequest) GetPagination() *v1beta1.PageRequest {
+func (x *QueryPayoutSchedulesResponse) GetPayoutSchedules() []*PayoutSchedule {
+	if x != nil {
+		return x.PayoutSchedules
+	}
+	return nil
+}
+
+func (x *QueryPayoutSchedulesResponse) GetPagination() *v1beta1.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
@@ -4536,23 +14882,39 @@ var file_dysonprotocol_crontask_v1_query_proto_rawDesc = []byte{
 	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f,
 	0x76, 0x31, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
-	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
-	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2f, 0x0a, 0x14, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x15, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x98, 0x01, 0x0a, 0x1a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x6f, 0x1a, 0x26, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2f, 0x0a,
+	0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x22, 0x4c,
+	0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x04, 0x74, 0x61, 0x73, 0x6b, 0x22, 0x98, 0x01, 0x0a,
+	0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xba, 0x01, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
 	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
 	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
@@ -4595,75 +14957,344 @@ var file_dysonprotocol_crontask_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
 	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x32, 0x97, 0x08, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xa1, 0x01, 0x0a, 0x08, 0x54,
-	0x61, 0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2f, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b,
-	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49,
-	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x74,
-	0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xb2,
-	0x01, 0x0a, 0x0e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x35, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73,
-	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x12,
-	0x32, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
-	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b,
-	0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x7d, 0x12, 0xc0, 0x01, 0x0a, 0x16, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d,
-	0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
-	0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72,
-	0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
-	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x38, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31,
-	0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x7b, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x7d, 0x12, 0xc5, 0x01, 0x0a, 0x15, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x12, 0x3c, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47,
-	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
-	0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
-	0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3f, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76,
-	0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2f, 0x7b,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x7d, 0x2f, 0x62, 0x79, 0x5f, 0x67, 0x61, 0x73, 0x12, 0x94,
-	0x01, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x41, 0x6c, 0x6c, 0x12, 0x2f, 0x2e, 0x64, 0x79,
+	0x22, 0xf9, 0x01, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42,
+	0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x93, 0x01, 0x0a,
+	0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0x8f, 0x01, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23,
+	0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x72, 0x75, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a,
+	0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x49, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0x6e, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x75, 0x70, 0x63,
+	0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0x58, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7b, 0x0a, 0x10, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x5a, 0x0a,
+	0x0f, 0x75, 0x70, 0x63, 0x6f, 0x6d, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e, 0x75, 0x70, 0x63, 0x6f, 0x6d,
+	0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xc7, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x28, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0e,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x78, 0x65,
+	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x22, 0xd8, 0x03, 0x0a, 0x19,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x47, 0x61, 0x73, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x0b, 0x6d, 0x73, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x0a, 0x6d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x4c, 0x6f, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x64, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x47, 0x61, 0x73, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x69,
+	0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x54,
+	0x61, 0x73, 0x6b, 0x47, 0x61, 0x73, 0x46, 0x65, 0x65, 0x12, 0x4b, 0x0a, 0x0d, 0x6d, 0x69, 0x6e,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x39, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x49,
+	0x64, 0x22, 0x71, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x52, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x64, 0x79, 0x73, 0x6f,
+	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x22, 0xa8, 0x01, 0x0a, 0x26, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x36, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0xa2, 0x01, 0x0a, 0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x46, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbd, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x10, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x0f, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x32, 0xf6, 0x13, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0xa1,
+	0x01, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x12, 0x2f, 0x2e, 0x64, 0x79,
 	0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e,
-	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64,
+	0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73,
+	0x6b, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x64,
+	0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f,
+	0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61,
+	0x73, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x7b, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x7d, 0x12, 0xb2, 0x01, 0x0a, 0x0e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64,
 	0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f,
 	0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61,
-	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x34, 0x12, 0x32, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f,
-	0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x92, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x12, 0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0xc0, 0x01, 0x0a, 0x16, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x3d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x38, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x12, 0x30, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73,
+	0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x7d, 0x12, 0xc5, 0x01, 0x0a, 0x15, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x3f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x39, 0x12, 0x37, 0x2f, 0x64, 0x79, 0x73, 0x6f,
+	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61,
+	0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2f, 0x7b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x7d, 0x2f, 0x62, 0x79, 0x5f, 0x67,
+	0x61, 0x73, 0x12, 0x94, 0x01, 0x0a, 0x08, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x41, 0x6c, 0x6c, 0x12,
+	0x2f, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
 	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
+	0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b,
+	0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x12, 0xcb, 0x01, 0x0a, 0x0f, 0x54, 0x61,
+	0x73, 0x6b, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x36, 0x2e,
+	0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72,
+	0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x42, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x4b, 0x12, 0x49, 0x2f, 0x64,
+	0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f,
+	0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x7b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x7d,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x7b, 0x77, 0x6f, 0x72, 0x6b, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x42, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x64, 0x79, 0x73, 0x6f,
+	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61,
+	0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x73,
+	0x42, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e,
 	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
+	0x79, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f,
+	0x76, 0x31, 0x2f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0xb2, 0x01, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x37, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f,
+	0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e,
+	0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72,
+	0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x65, 0x63, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12,
+	0x23, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x6f, 0x72, 0x65,
+	0x63, 0x61, 0x73, 0x74, 0x12, 0x9a, 0x01, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x30, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x12,
+	0x20, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0xa9, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x33, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73,
+	0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x12, 0xb6, 0x01,
+	0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x12, 0x35, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x35, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x24, 0x5a, 0x22, 0x64, 0x79,
-	0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x78, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x2f, 0x7b, 0x70, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xda, 0x01, 0x0a, 0x1a, 0x50, 0x61, 0x79, 0x6f, 0x75,
+	0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x40, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3a, 0x12, 0x38, 0x2f, 0x64, 0x79, 0x73, 0x6f,
+	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61,
+	0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x7d, 0x12, 0xd2, 0x01, 0x0a, 0x18, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x3f, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x42, 0x79, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x36, 0x12, 0x34, 0x2f, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x61, 0x79, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x7b,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x7d, 0x12, 0x92, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x12, 0x2d, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x2e, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x64, 0x79, 0x73,
+	0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74,
+	0x61, 0x73, 0x6b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x24, 0x5a,
+	0x22, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x63, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x73, 0x6b, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4678,48 +15309,98 @@ func file_dysonprotocol_crontask_v1_query_proto_rawDescGZIP() []byte {
 	return file_dysonprotocol_crontask_v1_query_proto_rawDescData
 }
 
-var file_dysonprotocol_crontask_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_dysonprotocol_crontask_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_dysonprotocol_crontask_v1_query_proto_goTypes = []interface{}{
-	(*QueryTaskByIDRequest)(nil),               // 0: dysonprotocol.crontask.v1.QueryTaskByIDRequest
-	(*QueryTaskByIDResponse)(nil),              // 1: dysonprotocol.crontask.v1.QueryTaskByIDResponse
-	(*QueryTasksByAddressRequest)(nil),         // 2: dysonprotocol.crontask.v1.QueryTasksByAddressRequest
-	(*QueryTasksByStatusTimestampRequest)(nil), // 3: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest
-	(*QueryTasksByStatusGasPriceRequest)(nil),  // 4: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest
-	(*QueryTasksResponse)(nil),                 // 5: dysonprotocol.crontask.v1.QueryTasksResponse
-	(*QueryParamsRequest)(nil),                 // 6: dysonprotocol.crontask.v1.QueryParamsRequest
-	(*QueryParamsResponse)(nil),                // 7: dysonprotocol.crontask.v1.QueryParamsResponse
-	(*QueryAllTasksRequest)(nil),               // 8: dysonprotocol.crontask.v1.QueryAllTasksRequest
-	(*Task)(nil),                               // 9: dysonprotocol.crontask.v1.Task
-	(*v1beta1.PageRequest)(nil),                // 10: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta1.PageResponse)(nil),               // 11: cosmos.base.query.v1beta1.PageResponse
-	(*Params)(nil),                             // 12: dysonprotocol.crontask.v1.Params
+	(*QueryTaskByIDRequest)(nil),                   // 0: dysonprotocol.crontask.v1.QueryTaskByIDRequest
+	(*QueryTaskByIDResponse)(nil),                  // 1: dysonprotocol.crontask.v1.QueryTaskByIDResponse
+	(*QueryTasksByAddressRequest)(nil),             // 2: dysonprotocol.crontask.v1.QueryTasksByAddressRequest
+	(*QueryTasksByWorkflowRequest)(nil),            // 3: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest
+	(*QueryTasksByStatusTimestampRequest)(nil),     // 4: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest
+	(*QueryTasksByStatusGasPriceRequest)(nil),      // 5: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest
+	(*QueryTasksResponse)(nil),                     // 6: dysonprotocol.crontask.v1.QueryTasksResponse
+	(*QueryParamsRequest)(nil),                     // 7: dysonprotocol.crontask.v1.QueryParamsRequest
+	(*QueryParamsResponse)(nil),                    // 8: dysonprotocol.crontask.v1.QueryParamsResponse
+	(*QueryAllTasksRequest)(nil),                   // 9: dysonprotocol.crontask.v1.QueryAllTasksRequest
+	(*QueryTasksByFilterRequest)(nil),              // 10: dysonprotocol.crontask.v1.QueryTasksByFilterRequest
+	(*QueryScheduleForecastRequest)(nil),           // 11: dysonprotocol.crontask.v1.QueryScheduleForecastRequest
+	(*ForecastBucket)(nil),                         // 12: dysonprotocol.crontask.v1.ForecastBucket
+	(*QueryScheduleForecastResponse)(nil),          // 13: dysonprotocol.crontask.v1.QueryScheduleForecastResponse
+	(*QueryTaskStatsRequest)(nil),                  // 14: dysonprotocol.crontask.v1.QueryTaskStatsRequest
+	(*StatusStats)(nil),                            // 15: dysonprotocol.crontask.v1.StatusStats
+	(*BlockGasEstimate)(nil),                       // 16: dysonprotocol.crontask.v1.BlockGasEstimate
+	(*QueryTaskStatsResponse)(nil),                 // 17: dysonprotocol.crontask.v1.QueryTaskStatsResponse
+	(*QuerySimulateTaskRequest)(nil),               // 18: dysonprotocol.crontask.v1.QuerySimulateTaskRequest
+	(*QuerySimulateTaskResponse)(nil),              // 19: dysonprotocol.crontask.v1.QuerySimulateTaskResponse
+	(*QueryPayoutScheduleRequest)(nil),             // 20: dysonprotocol.crontask.v1.QueryPayoutScheduleRequest
+	(*QueryPayoutScheduleResponse)(nil),            // 21: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse
+	(*QueryPayoutSchedulesByRecipientRequest)(nil), // 22: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest
+	(*QueryPayoutSchedulesByCreatorRequest)(nil),   // 23: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest
+	(*QueryPayoutSchedulesResponse)(nil),           // 24: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse
+	(*Task)(nil),                                   // 25: dysonprotocol.crontask.v1.Task
+	(*v1beta1.PageRequest)(nil),                    // 26: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta1.PageResponse)(nil),                   // 27: cosmos.base.query.v1beta1.PageResponse
+	(*Params)(nil),                                 // 28: dysonprotocol.crontask.v1.Params
+	(*anypb.Any)(nil),                              // 29: google.protobuf.Any
+	(*v1beta11.Coin)(nil),                          // 30: cosmos.base.v1beta1.Coin
+	(*v1beta11.DecCoin)(nil),                       // 31: cosmos.base.v1beta1.DecCoin
+	(*PayoutSchedule)(nil),                         // 32: dysonprotocol.crontask.v1.PayoutSchedule
 }
 var file_dysonprotocol_crontask_v1_query_proto_depIdxs = []int32{
-	9,  // 0: dysonprotocol.crontask.v1.QueryTaskByIDResponse.task:type_name -> dysonprotocol.crontask.v1.Task
-	10, // 1: dysonprotocol.crontask.v1.QueryTasksByAddressRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	10, // 2: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	10, // 3: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	9,  // 4: dysonprotocol.crontask.v1.QueryTasksResponse.tasks:type_name -> dysonprotocol.crontask.v1.Task
-	11, // 5: dysonprotocol.crontask.v1.QueryTasksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	12, // 6: dysonprotocol.crontask.v1.QueryParamsResponse.params:type_name -> dysonprotocol.crontask.v1.Params
-	10, // 7: dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	0,  // 8: dysonprotocol.crontask.v1.Query.TaskByID:input_type -> dysonprotocol.crontask.v1.QueryTaskByIDRequest
-	2,  // 9: dysonprotocol.crontask.v1.Query.TasksByAddress:input_type -> dysonprotocol.crontask.v1.QueryTasksByAddressRequest
-	3,  // 10: dysonprotocol.crontask.v1.Query.TasksByStatusTimestamp:input_type -> dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest
-	4,  // 11: dysonprotocol.crontask.v1.Query.TasksByStatusGasPrice:input_type -> dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest
-	8,  // 12: dysonprotocol.crontask.v1.Query.TasksAll:input_type -> dysonprotocol.crontask.v1.QueryAllTasksRequest
-	6,  // 13: dysonprotocol.crontask.v1.Query.Params:input_type -> dysonprotocol.crontask.v1.QueryParamsRequest
-	1,  // 14: dysonprotocol.crontask.v1.Query.TaskByID:output_type -> dysonprotocol.crontask.v1.QueryTaskByIDResponse
-	5,  // 15: dysonprotocol.crontask.v1.Query.TasksByAddress:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
-	5,  // 16: dysonprotocol.crontask.v1.Query.TasksByStatusTimestamp:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
-	5,  // 17: dysonprotocol.crontask.v1.Query.TasksByStatusGasPrice:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
-	5,  // 18: dysonprotocol.crontask.v1.Query.TasksAll:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
-	7,  // 19: dysonprotocol.crontask.v1.Query.Params:output_type -> dysonprotocol.crontask.v1.QueryParamsResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	25, // 0: dysonprotocol.crontask.v1.QueryTaskByIDResponse.task:type_name -> dysonprotocol.crontask.v1.Task
+	26, // 1: dysonprotocol.crontask.v1.QueryTasksByAddressRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	26, // 2: dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	26, // 3: dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	26, // 4: dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	25, // 5: dysonprotocol.crontask.v1.QueryTasksResponse.tasks:type_name -> dysonprotocol.crontask.v1.Task
+	27, // 6: dysonprotocol.crontask.v1.QueryTasksResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 7: dysonprotocol.crontask.v1.QueryParamsResponse.params:type_name -> dysonprotocol.crontask.v1.Params
+	26, // 8: dysonprotocol.crontask.v1.QueryAllTasksRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	26, // 9: dysonprotocol.crontask.v1.QueryTasksByFilterRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	12, // 10: dysonprotocol.crontask.v1.QueryScheduleForecastResponse.buckets:type_name -> dysonprotocol.crontask.v1.ForecastBucket
+	15, // 11: dysonprotocol.crontask.v1.QueryTaskStatsResponse.statuses:type_name -> dysonprotocol.crontask.v1.StatusStats
+	16, // 12: dysonprotocol.crontask.v1.QueryTaskStatsResponse.upcoming_blocks:type_name -> dysonprotocol.crontask.v1.BlockGasEstimate
+	29, // 13: dysonprotocol.crontask.v1.QuerySimulateTaskRequest.msgs:type_name -> google.protobuf.Any
+	29, // 14: dysonprotocol.crontask.v1.QuerySimulateTaskResponse.msg_results:type_name -> google.protobuf.Any
+	30, // 15: dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_task_gas_fee:type_name -> cosmos.base.v1beta1.Coin
+	31, // 16: dysonprotocol.crontask.v1.QuerySimulateTaskResponse.min_gas_price:type_name -> cosmos.base.v1beta1.DecCoin
+	32, // 17: dysonprotocol.crontask.v1.QueryPayoutScheduleResponse.payout_schedule:type_name -> dysonprotocol.crontask.v1.PayoutSchedule
+	26, // 18: dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	26, // 19: dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	32, // 20: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.payout_schedules:type_name -> dysonprotocol.crontask.v1.PayoutSchedule
+	27, // 21: dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 22: dysonprotocol.crontask.v1.Query.TaskByID:input_type -> dysonprotocol.crontask.v1.QueryTaskByIDRequest
+	2,  // 23: dysonprotocol.crontask.v1.Query.TasksByAddress:input_type -> dysonprotocol.crontask.v1.QueryTasksByAddressRequest
+	4,  // 24: dysonprotocol.crontask.v1.Query.TasksByStatusTimestamp:input_type -> dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest
+	5,  // 25: dysonprotocol.crontask.v1.Query.TasksByStatusGasPrice:input_type -> dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest
+	9,  // 26: dysonprotocol.crontask.v1.Query.TasksAll:input_type -> dysonprotocol.crontask.v1.QueryAllTasksRequest
+	3,  // 27: dysonprotocol.crontask.v1.Query.TasksByWorkflow:input_type -> dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest
+	10, // 28: dysonprotocol.crontask.v1.Query.TasksByFilter:input_type -> dysonprotocol.crontask.v1.QueryTasksByFilterRequest
+	11, // 29: dysonprotocol.crontask.v1.Query.ScheduleForecast:input_type -> dysonprotocol.crontask.v1.QueryScheduleForecastRequest
+	14, // 30: dysonprotocol.crontask.v1.Query.TaskStats:input_type -> dysonprotocol.crontask.v1.QueryTaskStatsRequest
+	18, // 31: dysonprotocol.crontask.v1.Query.SimulateTask:input_type -> dysonprotocol.crontask.v1.QuerySimulateTaskRequest
+	20, // 32: dysonprotocol.crontask.v1.Query.PayoutSchedule:input_type -> dysonprotocol.crontask.v1.QueryPayoutScheduleRequest
+	22, // 33: dysonprotocol.crontask.v1.Query.PayoutSchedulesByRecipient:input_type -> dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest
+	23, // 34: dysonprotocol.crontask.v1.Query.PayoutSchedulesByCreator:input_type -> dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest
+	7,  // 35: dysonprotocol.crontask.v1.Query.Params:input_type -> dysonprotocol.crontask.v1.QueryParamsRequest
+	1,  // 36: dysonprotocol.crontask.v1.Query.TaskByID:output_type -> dysonprotocol.crontask.v1.QueryTaskByIDResponse
+	6,  // 37: dysonprotocol.crontask.v1.Query.TasksByAddress:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
+	6,  // 38: dysonprotocol.crontask.v1.Query.TasksByStatusTimestamp:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
+	6,  // 39: dysonprotocol.crontask.v1.Query.TasksByStatusGasPrice:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
+	6,  // 40: dysonprotocol.crontask.v1.Query.TasksAll:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
+	6,  // 41: dysonprotocol.crontask.v1.Query.TasksByWorkflow:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
+	6,  // 42: dysonprotocol.crontask.v1.Query.TasksByFilter:output_type -> dysonprotocol.crontask.v1.QueryTasksResponse
+	13, // 43: dysonprotocol.crontask.v1.Query.ScheduleForecast:output_type -> dysonprotocol.crontask.v1.QueryScheduleForecastResponse
+	17, // 44: dysonprotocol.crontask.v1.Query.TaskStats:output_type -> dysonprotocol.crontask.v1.QueryTaskStatsResponse
+	19, // 45: dysonprotocol.crontask.v1.Query.SimulateTask:output_type -> dysonprotocol.crontask.v1.QuerySimulateTaskResponse
+	21, // 46: dysonprotocol.crontask.v1.Query.PayoutSchedule:output_type -> dysonprotocol.crontask.v1.QueryPayoutScheduleResponse
+	24, // 47: dysonprotocol.crontask.v1.Query.PayoutSchedulesByRecipient:output_type -> dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse
+	24, // 48: dysonprotocol.crontask.v1.Query.PayoutSchedulesByCreator:output_type -> dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse
+	8,  // 49: dysonprotocol.crontask.v1.Query.Params:output_type -> dysonprotocol.crontask.v1.QueryParamsResponse
+	36, // [36:50] is the sub-list for method output_type
+	22, // [22:36] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_dysonprotocol_crontask_v1_query_proto_init() }
@@ -4728,6 +15409,7 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 		return
 	}
 	file_dysonprotocol_crontask_v1_crontask_proto_init()
+	file_dysonprotocol_crontask_v1_payout_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_dysonprotocol_crontask_v1_query_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryTaskByIDRequest); i {
@@ -4766,7 +15448,7 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 			}
 		}
 		file_dysonprotocol_crontask_v1_query_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTasksByStatusTimestampRequest); i {
+			switch v := v.(*QueryTasksByWorkflowRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4778,7 +15460,7 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 			}
 		}
 		file_dysonprotocol_crontask_v1_query_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTasksByStatusGasPriceRequest); i {
+			switch v := v.(*QueryTasksByStatusTimestampRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4790,7 +15472,7 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 			}
 		}
 		file_dysonprotocol_crontask_v1_query_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTasksResponse); i {
+			switch v := v.(*QueryTasksByStatusGasPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4802,7 +15484,7 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 			}
 		}
 		file_dysonprotocol_crontask_v1_query_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsRequest); i {
+			switch v := v.(*QueryTasksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4814,7 +15496,7 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 			}
 		}
 		file_dysonprotocol_crontask_v1_query_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryParamsResponse); i {
+			switch v := v.(*QueryParamsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4826,6 +15508,18 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 			}
 		}
 		file_dysonprotocol_crontask_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryParamsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueryAllTasksRequest); i {
 			case 0:
 				return &v.state
@@ -4837,6 +15531,186 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTasksByFilterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryScheduleForecastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForecastBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryScheduleForecastResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTaskStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockGasEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTaskStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateTaskRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySimulateTaskResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPayoutScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPayoutScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPayoutSchedulesByRecipientRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPayoutSchedulesByCreatorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_dysonprotocol_crontask_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryPayoutSchedulesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -4844,7 +15718,7 @@ func file_dysonprotocol_crontask_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dysonprotocol_crontask_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,12 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_TaskByID_FullMethodName               = "/dysonprotocol.crontask.v1.Query/TaskByID"
-	Query_TasksByAddress_FullMethodName         = "/dysonprotocol.crontask.v1.Query/TasksByAddress"
-	Query_TasksByStatusTimestamp_FullMethodName = "/dysonprotocol.crontask.v1.Query/TasksByStatusTimestamp"
-	Query_TasksByStatusGasPrice_FullMethodName  = "/dysonprotocol.crontask.v1.Query/TasksByStatusGasPrice"
-	Query_TasksAll_FullMethodName               = "/dysonprotocol.crontask.v1.Query/TasksAll"
-	Query_Params_FullMethodName                 = "/dysonprotocol.crontask.v1.Query/Params"
+	Query_TaskByID_FullMethodName                   = "/dysonprotocol.crontask.v1.Query/TaskByID"
+	Query_TasksByAddress_FullMethodName             = "/dysonprotocol.crontask.v1.Query/TasksByAddress"
+	Query_TasksByStatusTimestamp_FullMethodName     = "/dysonprotocol.crontask.v1.Query/TasksByStatusTimestamp"
+	Query_TasksByStatusGasPrice_FullMethodName      = "/dysonprotocol.crontask.v1.Query/TasksByStatusGasPrice"
+	Query_TasksAll_FullMethodName                   = "/dysonprotocol.crontask.v1.Query/TasksAll"
+	Query_TasksByWorkflow_FullMethodName            = "/dysonprotocol.crontask.v1.Query/TasksByWorkflow"
+	Query_TasksByFilter_FullMethodName              = "/dysonprotocol.crontask.v1.Query/TasksByFilter"
+	Query_ScheduleForecast_FullMethodName           = "/dysonprotocol.crontask.v1.Query/ScheduleForecast"
+	Query_TaskStats_FullMethodName                  = "/dysonprotocol.crontask.v1.Query/TaskStats"
+	Query_SimulateTask_FullMethodName               = "/dysonprotocol.crontask.v1.Query/SimulateTask"
+	Query_PayoutSchedule_FullMethodName             = "/dysonprotocol.crontask.v1.Query/PayoutSchedule"
+	Query_PayoutSchedulesByRecipient_FullMethodName = "/dysonprotocol.crontask.v1.Query/PayoutSchedulesByRecipient"
+	Query_PayoutSchedulesByCreator_FullMethodName   = "/dysonprotocol.crontask.v1.Query/PayoutSchedulesByCreator"
+	Query_Params_FullMethodName                     = "/dysonprotocol.crontask.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +53,27 @@ type QueryClient interface {
 	TasksByStatusGasPrice(ctx context.Context, in *QueryTasksByStatusGasPriceRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
 	// TasksAll returns all tasks ordered by ID
 	TasksAll(ctx context.Context, in *QueryAllTasksRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// TasksByWorkflow returns the tasks of a workflow ordered by ID
+	TasksByWorkflow(ctx context.Context, in *QueryTasksByWorkflowRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// TasksByFilter returns the tasks matching a creator, a status and a due time range,
+	// ordered by due time when a status is given and by ID otherwise
+	TasksByFilter(ctx context.Context, in *QueryTasksByFilterRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// ScheduleForecast returns the runs and gas of the scheduled and pending tasks in
+	// time buckets of an upcoming window
+	ScheduleForecast(ctx context.Context, in *QueryScheduleForecastRequest, opts ...grpc.CallOption) (*QueryScheduleForecastResponse, error)
+	// TaskStats returns the number of tasks per status and the gas scheduled in the
+	// upcoming blocks
+	TaskStats(ctx context.Context, in *QueryTaskStatsRequest, opts ...grpc.CallOption) (*QueryTaskStatsResponse, error)
+	// SimulateTask runs the messages of a proposed task against the current state
+	// without saving it, and returns the gas it uses and the fee it needs to run in
+	// the next block at the current queue depth
+	SimulateTask(ctx context.Context, in *QuerySimulateTaskRequest, opts ...grpc.CallOption) (*QuerySimulateTaskResponse, error)
+	// PayoutSchedule returns a payout schedule by ID
+	PayoutSchedule(ctx context.Context, in *QueryPayoutScheduleRequest, opts ...grpc.CallOption) (*QueryPayoutScheduleResponse, error)
+	// PayoutSchedulesByRecipient returns the payout schedules paying an address
+	PayoutSchedulesByRecipient(ctx context.Context, in *QueryPayoutSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error)
+	// PayoutSchedulesByCreator returns the payout schedules funded by an address
+	PayoutSchedulesByCreator(ctx context.Context, in *QueryPayoutSchedulesByCreatorRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error)
 	// Params returns the module parameters
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
@@ -107,6 +136,86 @@ func (c *queryClient) TasksAll(ctx context.Context, in *QueryAllTasksRequest, op
 	return out, nil
 }
 
+func (c *queryClient) TasksByWorkflow(ctx context.Context, in *QueryTasksByWorkflowRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, Query_TasksByWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByFilter(ctx context.Context, in *QueryTasksByFilterRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, Query_TasksByFilter_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduleForecast(ctx context.Context, in *QueryScheduleForecastRequest, opts ...grpc.CallOption) (*QueryScheduleForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryScheduleForecastResponse)
+	err := c.cc.Invoke(ctx, Query_ScheduleForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaskStats(ctx context.Context, in *QueryTaskStatsRequest, opts ...grpc.CallOption) (*QueryTaskStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTaskStatsResponse)
+	err := c.cc.Invoke(ctx, Query_TaskStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateTask(ctx context.Context, in *QuerySimulateTaskRequest, opts ...grpc.CallOption) (*QuerySimulateTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySimulateTaskResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutSchedule(ctx context.Context, in *QueryPayoutScheduleRequest, opts ...grpc.CallOption) (*QueryPayoutScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPayoutScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_PayoutSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutSchedulesByRecipient(ctx context.Context, in *QueryPayoutSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPayoutSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_PayoutSchedulesByRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutSchedulesByCreator(ctx context.Context, in *QueryPayoutSchedulesByCreatorRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPayoutSchedulesResponse)
+	err := c.cc.Invoke(ctx, Query_PayoutSchedulesByCreator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryParamsResponse)
@@ -135,6 +244,27 @@ type QueryServer interface {
 	TasksByStatusGasPrice(context.Context, *QueryTasksByStatusGasPriceRequest) (*QueryTasksResponse, error)
 	// TasksAll returns all tasks ordered by ID
 	TasksAll(context.Context, *QueryAllTasksRequest) (*QueryTasksResponse, error)
+	// TasksByWorkflow returns the tasks of a workflow ordered by ID
+	TasksByWorkflow(context.Context, *QueryTasksByWorkflowRequest) (*QueryTasksResponse, error)
+	// TasksByFilter returns the tasks matching a creator, a status and a due time range,
+	// ordered by due time when a status is given and by ID otherwise
+	TasksByFilter(context.Context, *QueryTasksByFilterRequest) (*QueryTasksResponse, error)
+	// ScheduleForecast returns the runs and gas of the scheduled and pending tasks in
+	// time buckets of an upcoming window
+	ScheduleForecast(context.Context, *QueryScheduleForecastRequest) (*QueryScheduleForecastResponse, error)
+	// TaskStats returns the number of tasks per status and the gas scheduled in the
+	// upcoming blocks
+	TaskStats(context.Context, *QueryTaskStatsRequest) (*QueryTaskStatsResponse, error)
+	// SimulateTask runs the messages of a proposed task against the current state
+	// without saving it, and returns the gas it uses and the fee it needs to run in
+	// the next block at the current queue depth
+	SimulateTask(context.Context, *QuerySimulateTaskRequest) (*QuerySimulateTaskResponse, error)
+	// PayoutSchedule returns a payout schedule by ID
+	PayoutSchedule(context.Context, *QueryPayoutScheduleRequest) (*QueryPayoutScheduleResponse, error)
+	// PayoutSchedulesByRecipient returns the payout schedules paying an address
+	PayoutSchedulesByRecipient(context.Context, *QueryPayoutSchedulesByRecipientRequest) (*QueryPayoutSchedulesResponse, error)
+	// PayoutSchedulesByCreator returns the payout schedules funded by an address
+	PayoutSchedulesByCreator(context.Context, *QueryPayoutSchedulesByCreatorRequest) (*QueryPayoutSchedulesResponse, error)
 	// Params returns the module parameters
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -162,6 +292,30 @@ func (UnimplementedQueryServer) TasksByStatusGasPrice(context.Context, *QueryTas
 func (UnimplementedQueryServer) TasksAll(context.Context, *QueryAllTasksRequest) (*QueryTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TasksAll not implemented")
 }
+func (UnimplementedQueryServer) TasksByWorkflow(context.Context, *QueryTasksByWorkflowRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByWorkflow not implemented")
+}
+func (UnimplementedQueryServer) TasksByFilter(context.Context, *QueryTasksByFilterRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByFilter not implemented")
+}
+func (UnimplementedQueryServer) ScheduleForecast(context.Context, *QueryScheduleForecastRequest) (*QueryScheduleForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleForecast not implemented")
+}
+func (UnimplementedQueryServer) TaskStats(context.Context, *QueryTaskStatsRequest) (*QueryTaskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskStats not implemented")
+}
+func (UnimplementedQueryServer) SimulateTask(context.Context, *QuerySimulateTaskRequest) (*QuerySimulateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTask not implemented")
+}
+func (UnimplementedQueryServer) PayoutSchedule(context.Context, *QueryPayoutScheduleRequest) (*QueryPayoutScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutSchedule not implemented")
+}
+func (UnimplementedQueryServer) PayoutSchedulesByRecipient(context.Context, *QueryPayoutSchedulesByRecipientRequest) (*QueryPayoutSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutSchedulesByRecipient not implemented")
+}
+func (UnimplementedQueryServer) PayoutSchedulesByCreator(context.Context, *QueryPayoutSchedulesByCreatorRequest) (*QueryPayoutSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutSchedulesByCreator not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -276,6 +430,150 @@ func _Query_TasksAll_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TasksByWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TasksByWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByWorkflow(ctx, req.(*QueryTasksByWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TasksByFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByFilter(ctx, req.(*QueryTasksByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduleForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduleForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ScheduleForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduleForecast(ctx, req.(*QueryScheduleForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TaskStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaskStats(ctx, req.(*QueryTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTask(ctx, req.(*QuerySimulateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PayoutSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutSchedule(ctx, req.(*QueryPayoutScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutSchedulesByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutSchedulesByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutSchedulesByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PayoutSchedulesByRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutSchedulesByRecipient(ctx, req.(*QueryPayoutSchedulesByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutSchedulesByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutSchedulesByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutSchedulesByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PayoutSchedulesByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutSchedulesByCreator(ctx, req.(*QueryPayoutSchedulesByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -321,6 +619,38 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TasksAll",
 			Handler:    _Query_TasksAll_Handler,
 		},
+		{
+			MethodName: "TasksByWorkflow",
+			Handler:    _Query_TasksByWorkflow_Handler,
+		},
+		{
+			MethodName: "TasksByFilter",
+			Handler:    _Query_TasksByFilter_Handler,
+		},
+		{
+			MethodName: "ScheduleForecast",
+			Handler:    _Query_ScheduleForecast_Handler,
+		},
+		{
+			MethodName: "TaskStats",
+			Handler:    _Query_TaskStats_Handler,
+		},
+		{
+			MethodName: "SimulateTask",
+			Handler:    _Query_SimulateTask_Handler,
+		},
+		{
+			MethodName: "PayoutSchedule",
+			Handler:    _Query_PayoutSchedule_Handler,
+		},
+		{
+			MethodName: "PayoutSchedulesByRecipient",
+			Handler:    _Query_PayoutSchedulesByRecipient_Handler,
+		},
+		{
+			MethodName: "PayoutSchedulesByCreator",
+			Handler:    _Query_PayoutSchedulesByCreator_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
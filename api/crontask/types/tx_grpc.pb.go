@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_CreateTask_FullMethodName   = "/dysonprotocol.crontask.v1.Msg/CreateTask"
-	Msg_DeleteTask_FullMethodName   = "/dysonprotocol.crontask.v1.Msg/DeleteTask"
-	Msg_UpdateParams_FullMethodName = "/dysonprotocol.crontask.v1.Msg/UpdateParams"
+	Msg_CreateTask_FullMethodName           = "/dysonprotocol.crontask.v1.Msg/CreateTask"
+	Msg_DeleteTask_FullMethodName           = "/dysonprotocol.crontask.v1.Msg/DeleteTask"
+	Msg_UpdateTask_FullMethodName           = "/dysonprotocol.crontask.v1.Msg/UpdateTask"
+	Msg_TopUpTask_FullMethodName            = "/dysonprotocol.crontask.v1.Msg/TopUpTask"
+	Msg_CancelWorkflow_FullMethodName       = "/dysonprotocol.crontask.v1.Msg/CancelWorkflow"
+	Msg_CreatePayoutSchedule_FullMethodName = "/dysonprotocol.crontask.v1.Msg/CreatePayoutSchedule"
+	Msg_CancelPayoutSchedule_FullMethodName = "/dysonprotocol.crontask.v1.Msg/CancelPayoutSchedule"
+	Msg_UpdateParams_FullMethodName         = "/dysonprotocol.crontask.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -34,6 +39,18 @@ type MsgClient interface {
 	CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error)
 	// DeleteTask deletes a scheduled task
 	DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error)
+	// UpdateTask changes the schedule, gas and messages of a scheduled task
+	UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error)
+	// TopUpTask adds funds to the fee escrow of a recurring or conditional task
+	TopUpTask(ctx context.Context, in *MsgTopUpTask, opts ...grpc.CallOption) (*MsgTopUpTaskResponse, error)
+	// CancelWorkflow cancels every scheduled or pending task of a workflow
+	CancelWorkflow(ctx context.Context, in *MsgCancelWorkflow, opts ...grpc.CallOption) (*MsgCancelWorkflowResponse, error)
+	// CreatePayoutSchedule locks funds in escrow and releases them to
+	// recipients on a schedule
+	CreatePayoutSchedule(ctx context.Context, in *MsgCreatePayoutSchedule, opts ...grpc.CallOption) (*MsgCreatePayoutScheduleResponse, error)
+	// CancelPayoutSchedule stops a payout schedule, pays the recipients the
+	// share vested so far and refunds the rest to the creator
+	CancelPayoutSchedule(ctx context.Context, in *MsgCancelPayoutSchedule, opts ...grpc.CallOption) (*MsgCancelPayoutScheduleResponse, error)
 	// UpdateParams updates the parameters of the x/crontask module
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
@@ -66,6 +83,56 @@ func (c *msgClient) DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateTaskResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpTask(ctx context.Context, in *MsgTopUpTask, opts ...grpc.CallOption) (*MsgTopUpTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgTopUpTaskResponse)
+	err := c.cc.Invoke(ctx, Msg_TopUpTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelWorkflow(ctx context.Context, in *MsgCancelWorkflow, opts ...grpc.CallOption) (*MsgCancelWorkflowResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelWorkflowResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelWorkflow_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePayoutSchedule(ctx context.Context, in *MsgCreatePayoutSchedule, opts ...grpc.CallOption) (*MsgCreatePayoutScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCreatePayoutScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CreatePayoutSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPayoutSchedule(ctx context.Context, in *MsgCancelPayoutSchedule, opts ...grpc.CallOption) (*MsgCancelPayoutScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgCancelPayoutScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelPayoutSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MsgUpdateParamsResponse)
@@ -86,6 +153,18 @@ type MsgServer interface {
 	CreateTask(context.Context, *MsgCreateTask) (*MsgCreateTaskResponse, error)
 	// DeleteTask deletes a scheduled task
 	DeleteTask(context.Context, *MsgDeleteTask) (*MsgDeleteTaskResponse, error)
+	// UpdateTask changes the schedule, gas and messages of a scheduled task
+	UpdateTask(context.Context, *MsgUpdateTask) (*MsgUpdateTaskResponse, error)
+	// TopUpTask adds funds to the fee escrow of a recurring or conditional task
+	TopUpTask(context.Context, *MsgTopUpTask) (*MsgTopUpTaskResponse, error)
+	// CancelWorkflow cancels every scheduled or pending task of a workflow
+	CancelWorkflow(context.Context, *MsgCancelWorkflow) (*MsgCancelWorkflowResponse, error)
+	// CreatePayoutSchedule locks funds in escrow and releases them to
+	// recipients on a schedule
+	CreatePayoutSchedule(context.Context, *MsgCreatePayoutSchedule) (*MsgCreatePayoutScheduleResponse, error)
+	// CancelPayoutSchedule stops a payout schedule, pays the recipients the
+	// share vested so far and refunds the rest to the creator
+	CancelPayoutSchedule(context.Context, *MsgCancelPayoutSchedule) (*MsgCancelPayoutScheduleResponse, error)
 	// UpdateParams updates the parameters of the x/crontask module
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
@@ -104,6 +183,21 @@ func (UnimplementedMsgServer) CreateTask(context.Context, *MsgCreateTask) (*MsgC
 func (UnimplementedMsgServer) DeleteTask(context.Context, *MsgDeleteTask) (*MsgDeleteTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedMsgServer) UpdateTask(context.Context, *MsgUpdateTask) (*MsgUpdateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (UnimplementedMsgServer) TopUpTask(context.Context, *MsgTopUpTask) (*MsgTopUpTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpTask not implemented")
+}
+func (UnimplementedMsgServer) CancelWorkflow(context.Context, *MsgCancelWorkflow) (*MsgCancelWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflow not implemented")
+}
+func (UnimplementedMsgServer) CreatePayoutSchedule(context.Context, *MsgCreatePayoutSchedule) (*MsgCreatePayoutScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayoutSchedule not implemented")
+}
+func (UnimplementedMsgServer) CancelPayoutSchedule(context.Context, *MsgCancelPayoutSchedule) (*MsgCancelPayoutScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayoutSchedule not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -164,6 +258,96 @@ func _Msg_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTask(ctx, req.(*MsgUpdateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TopUpTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpTask(ctx, req.(*MsgTopUpTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWorkflow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelWorkflow_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWorkflow(ctx, req.(*MsgCancelWorkflow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePayoutSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreatePayoutSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePayoutSchedule(ctx, req.(*MsgCreatePayoutSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPayoutSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelPayoutSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPayoutSchedule(ctx, req.(*MsgCancelPayoutSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -197,6 +381,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _Msg_DeleteTask_Handler,
 		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _Msg_UpdateTask_Handler,
+		},
+		{
+			MethodName: "TopUpTask",
+			Handler:    _Msg_TopUpTask_Handler,
+		},
+		{
+			MethodName: "CancelWorkflow",
+			Handler:    _Msg_CancelWorkflow_Handler,
+		},
+		{
+			MethodName: "CreatePayoutSchedule",
+			Handler:    _Msg_CreatePayoutSchedule_Handler,
+		},
+		{
+			MethodName: "CancelPayoutSchedule",
+			Handler:    _Msg_CancelPayoutSchedule_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -2,10 +2,12 @@
 package types
 
 import (
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -60,29 +62,1147 @@ func (x *_ScriptExecAuthorization_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_ScriptExecAuthorization_4_list)(nil)
+
+type _ScriptExecAuthorization_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ScriptExecAuthorization_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScriptExecAuthorization_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScriptExecAuthorization_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScriptExecAuthorization_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScriptExecAuthorization_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScriptExecAuthorization_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScriptExecAuthorization_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScriptExecAuthorization_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ScriptExecAuthorization_6_list)(nil)
+
+type _ScriptExecAuthorization_6_list struct {
+	list *[]*ArgumentConstraint
+}
+
+func (x *_ScriptExecAuthorization_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ScriptExecAuthorization_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ScriptExecAuthorization_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ArgumentConstraint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ScriptExecAuthorization_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ArgumentConstraint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ScriptExecAuthorization_6_list) AppendMutable() protoreflect.Value {
+	v := new(ArgumentConstraint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScriptExecAuthorization_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ScriptExecAuthorization_6_list) NewElement() protoreflect.Value {
+	v := new(ArgumentConstraint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ScriptExecAuthorization_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ScriptExecAuthorization                            protoreflect.MessageDescriptor
+	fd_ScriptExecAuthorization_script_address             protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_function_names             protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_max_calls                  protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_max_funds                  protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_expiry_height              protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_argument_constraints       protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_max_calls_per_window       protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_window_blocks              protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_window_start               protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_window_calls               protoreflect.FieldDescriptor
+	fd_ScriptExecAuthorization_restrict_attached_messages protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_script_v1_authz_proto_init()
+	md_ScriptExecAuthorization = File_dysonprotocol_script_v1_authz_proto.Messages().ByName("ScriptExecAuthorization")
+	fd_ScriptExecAuthorization_script_address = md_ScriptExecAuthorization.Fields().ByName("script_address")
+	fd_ScriptExecAuthorization_function_names = md_ScriptExecAuthorization.Fields().ByName("function_names")
+	fd_ScriptExecAuthorization_max_calls = md_ScriptExecAuthorization.Fields().ByName("max_calls")
+	fd_ScriptExecAuthorization_max_funds = md_ScriptExecAuthorization.Fields().ByName("max_funds")
+	fd_ScriptExecAuthorization_expiry_height = md_ScriptExecAuthorization.Fields().ByName("expiry_height")
+	fd_ScriptExecAuthorization_argument_constraints = md_ScriptExecAuthorization.Fields().ByName("argument_constraints")
+	fd_ScriptExecAuthorization_max_calls_per_window = md_ScriptExecAuthorization.Fields().ByName("max_calls_per_window")
+	fd_ScriptExecAuthorization_window_blocks = md_ScriptExecAuthorization.Fields().ByName("window_blocks")
+	fd_ScriptExecAuthorization_window_start = md_ScriptExecAuthorization.Fields().ByName("window_start")
+	fd_ScriptExecAuthorization_window_calls = md_ScriptExecAuthorization.Fields().ByName("window_calls")
+	fd_ScriptExecAuthorization_restrict_attached_messages = md_ScriptExecAuthorization.Fields().ByName("restrict_attached_messages")
+}
+
+var _ protoreflect.Message = (*fastReflection_ScriptExecAuthorization)(nil)
+
+type fastReflection_ScriptExecAuthorization ScriptExecAuthorization
+
+func (x *ScriptExecAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ScriptExecAuthorization)(x)
+}
+
+func (x *ScriptExecAuthorization) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_script_v1_authz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ScriptExecAuthorization_messageType fastReflection_ScriptExecAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_ScriptExecAuthorization_messageType{}
+
+type fastReflection_ScriptExecAuthorization_messageType struct{}
+
+func (x fastReflection_ScriptExecAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ScriptExecAuthorization)(nil)
+}
+func (x fastReflection_ScriptExecAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_ScriptExecAuthorization)
+}
+func (x fastReflection_ScriptExecAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScriptExecAuthorization
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ScriptExecAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_ScriptExecAuthorization
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ScriptExecAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_ScriptExecAuthorization_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ScriptExecAuthorization) New() protoreflect.Message {
+	return new(fastReflection_ScriptExecAuthorization)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ScriptExecAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*ScriptExecAuthorization)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ScriptExecAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ScriptAddress != "" {
+		value := protoreflect.ValueOfString(x.ScriptAddress)
+		if !f(fd_ScriptExecAuthorization_script_address, value) {
+			return
+		}
+	}
+	if len(x.FunctionNames) != 0 {
+		value := protoreflect.ValueOfList(&_ScriptExecAuthorization_2_list{list: &x.FunctionNames})
+		if !f(fd_ScriptExecAuthorization_function_names, value) {
+			return
+		}
+	}
+	if x.MaxCalls != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCalls)
+		if !f(fd_ScriptExecAuthorization_max_calls, value) {
+			return
+		}
+	}
+	if len(x.MaxFunds) != 0 {
+		value := protoreflect.ValueOfList(&_ScriptExecAuthorization_4_list{list: &x.MaxFunds})
+		if !f(fd_ScriptExecAuthorization_max_funds, value) {
+			return
+		}
+	}
+	if x.ExpiryHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiryHeight)
+		if !f(fd_ScriptExecAuthorization_expiry_height, value) {
+			return
+		}
+	}
+	if len(x.ArgumentConstraints) != 0 {
+		value := protoreflect.ValueOfList(&_ScriptExecAuthorization_6_list{list: &x.ArgumentConstraints})
+		if !f(fd_ScriptExecAuthorization_argument_constraints, value) {
+			return
+		}
+	}
+	if x.MaxCallsPerWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCallsPerWindow)
+		if !f(fd_ScriptExecAuthorization_max_calls_per_window, value) {
+			return
+		}
+	}
+	if x.WindowBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WindowBlocks)
+		if !f(fd_ScriptExecAuthorization_window_blocks, value) {
+			return
+		}
+	}
+	if x.WindowStart != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WindowStart)
+		if !f(fd_ScriptExecAuthorization_window_start, value) {
+			return
+		}
+	}
+	if x.WindowCalls != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowCalls)
+		if !f(fd_ScriptExecAuthorization_window_calls, value) {
+			return
+		}
+	}
+	if x.RestrictAttachedMessages != false {
+		value := protoreflect.ValueOfBool(x.RestrictAttachedMessages)
+		if !f(fd_ScriptExecAuthorization_restrict_attached_messages, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ScriptExecAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
+		return x.ScriptAddress != ""
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
+		return len(x.FunctionNames) != 0
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls":
+		return x.MaxCalls != uint64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_funds":
+		return len(x.MaxFunds) != 0
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.expiry_height":
+		return x.ExpiryHeight != int64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.argument_constraints":
+		return len(x.ArgumentConstraints) != 0
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls_per_window":
+		return x.MaxCallsPerWindow != uint64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_blocks":
+		return x.WindowBlocks != int64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_start":
+		return x.WindowStart != int64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_calls":
+		return x.WindowCalls != uint64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.restrict_attached_messages":
+		return x.RestrictAttachedMessages != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptExecAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
+		x.ScriptAddress = ""
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
+		x.FunctionNames = nil
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls":
+		x.MaxCalls = uint64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_funds":
+		x.MaxFunds = nil
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.expiry_height":
+		x.ExpiryHeight = int64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.argument_constraints":
+		x.ArgumentConstraints = nil
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls_per_window":
+		x.MaxCallsPerWindow = uint64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_blocks":
+		x.WindowBlocks = int64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_start":
+		x.WindowStart = int64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_calls":
+		x.WindowCalls = uint64(0)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.restrict_attached_messages":
+		x.RestrictAttachedMessages = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ScriptExecAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
+		value := x.ScriptAddress
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
+		if len(x.FunctionNames) == 0 {
+			return protoreflect.ValueOfList(&_ScriptExecAuthorization_2_list{})
+		}
+		listValue := &_ScriptExecAuthorization_2_list{list: &x.FunctionNames}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls":
+		value := x.MaxCalls
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_funds":
+		if len(x.MaxFunds) == 0 {
+			return protoreflect.ValueOfList(&_ScriptExecAuthorization_4_list{})
+		}
+		listValue := &_ScriptExecAuthorization_4_list{list: &x.MaxFunds}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.expiry_height":
+		value := x.ExpiryHeight
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.argument_constraints":
+		if len(x.ArgumentConstraints) == 0 {
+			return protoreflect.ValueOfList(&_ScriptExecAuthorization_6_list{})
+		}
+		listValue := &_ScriptExecAuthorization_6_list{list: &x.ArgumentConstraints}
+		return protoreflect.ValueOfList(listValue)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls_per_window":
+		value := x.MaxCallsPerWindow
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_blocks":
+		value := x.WindowBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_start":
+		value := x.WindowStart
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_calls":
+		value := x.WindowCalls
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.restrict_attached_messages":
+		value := x.RestrictAttachedMessages
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptExecAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
+		x.ScriptAddress = value.Interface().(string)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
+		lv := value.List()
+		clv := lv.(*_ScriptExecAuthorization_2_list)
+		x.FunctionNames = *clv.list
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls":
+		x.MaxCalls = value.Uint()
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_funds":
+		lv := value.List()
+		clv := lv.(*_ScriptExecAuthorization_4_list)
+		x.MaxFunds = *clv.list
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.expiry_height":
+		x.ExpiryHeight = value.Int()
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.argument_constraints":
+		lv := value.List()
+		clv := lv.(*_ScriptExecAuthorization_6_list)
+		x.ArgumentConstraints = *clv.list
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls_per_window":
+		x.MaxCallsPerWindow = value.Uint()
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_blocks":
+		x.WindowBlocks = value.Int()
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_start":
+		x.WindowStart = value.Int()
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_calls":
+		x.WindowCalls = value.Uint()
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.restrict_attached_messages":
+		x.RestrictAttachedMessages = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptExecAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
+		if x.FunctionNames == nil {
+			x.FunctionNames = []string{}
+		}
+		value := &_ScriptExecAuthorization_2_list{list: &x.FunctionNames}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_funds":
+		if x.MaxFunds == nil {
+			x.MaxFunds = []*v1beta1.Coin{}
+		}
+		value := &_ScriptExecAuthorization_4_list{list: &x.MaxFunds}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.argument_constraints":
+		if x.ArgumentConstraints == nil {
+			x.ArgumentConstraints = []*ArgumentConstraint{}
+		}
+		value := &_ScriptExecAuthorization_6_list{list: &x.ArgumentConstraints}
+		return protoreflect.ValueOfList(value)
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
+		panic(fmt.Errorf("field script_address of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls":
+		panic(fmt.Errorf("field max_calls of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.expiry_height":
+		panic(fmt.Errorf("field expiry_height of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls_per_window":
+		panic(fmt.Errorf("field max_calls_per_window of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_blocks":
+		panic(fmt.Errorf("field window_blocks of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_start":
+		panic(fmt.Errorf("field window_start of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_calls":
+		panic(fmt.Errorf("field window_calls of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.restrict_attached_messages":
+		panic(fmt.Errorf("field restrict_attached_messages of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ScriptExecAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ScriptExecAuthorization_2_list{list: &list})
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_funds":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ScriptExecAuthorization_4_list{list: &list})
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.expiry_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.argument_constraints":
+		list := []*ArgumentConstraint{}
+		return protoreflect.ValueOfList(&_ScriptExecAuthorization_6_list{list: &list})
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.max_calls_per_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_start":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.window_calls":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.script.v1.ScriptExecAuthorization.restrict_attached_messages":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ScriptExecAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.script.v1.ScriptExecAuthorization", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ScriptExecAuthorization) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ScriptExecAuthorization) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ScriptExecAuthorization) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ScriptExecAuthorization)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ScriptAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FunctionNames) > 0 {
+			for _, s := range x.FunctionNames {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxCalls != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCalls))
+		}
+		if len(x.MaxFunds) > 0 {
+			for _, e := range x.MaxFunds {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ExpiryHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryHeight))
+		}
+		if len(x.ArgumentConstraints) > 0 {
+			for _, e := range x.ArgumentConstraints {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxCallsPerWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCallsPerWindow))
+		}
+		if x.WindowBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowBlocks))
+		}
+		if x.WindowStart != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowStart))
+		}
+		if x.WindowCalls != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowCalls))
+		}
+		if x.RestrictAttachedMessages {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ScriptExecAuthorization)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RestrictAttachedMessages {
+			i--
+			if x.RestrictAttachedMessages {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.WindowCalls != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowCalls))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.WindowStart != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowStart))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.WindowBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.MaxCallsPerWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCallsPerWindow))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.ArgumentConstraints) > 0 {
+			for iNdEx := len(x.ArgumentConstraints) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ArgumentConstraints[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.ExpiryHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryHeight))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MaxFunds) > 0 {
+			for iNdEx := len(x.MaxFunds) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MaxFunds[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MaxCalls != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCalls))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.FunctionNames) > 0 {
+			for iNdEx := len(x.FunctionNames) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.FunctionNames[iNdEx])
+				copy(dAtA[i:], x.FunctionNames[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionNames[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.ScriptAddress) > 0 {
+			i -= len(x.ScriptAddress)
+			copy(dAtA[i:], x.ScriptAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScriptAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ScriptExecAuthorization)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScriptExecAuthorization: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScriptExecAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ScriptAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionNames", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionNames = append(x.FunctionNames, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCalls", wireType)
+				}
+				x.MaxCalls = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCalls |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFunds", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxFunds = append(x.MaxFunds, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxFunds[len(x.MaxFunds)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+				}
+				x.ExpiryHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArgumentConstraints", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ArgumentConstraints = append(x.ArgumentConstraints, &ArgumentConstraint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ArgumentConstraints[len(x.ArgumentConstraints)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCallsPerWindow", wireType)
+				}
+				x.MaxCallsPerWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCallsPerWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+				}
+				x.WindowBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+				}
+				x.WindowStart = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowStart |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowCalls", wireType)
+				}
+				x.WindowCalls = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowCalls |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RestrictAttachedMessages", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RestrictAttachedMessages = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_ScriptExecAuthorization                protoreflect.MessageDescriptor
-	fd_ScriptExecAuthorization_script_address protoreflect.FieldDescriptor
-	fd_ScriptExecAuthorization_function_names protoreflect.FieldDescriptor
+	md_ArgumentConstraint                protoreflect.MessageDescriptor
+	fd_ArgumentConstraint_function_name  protoreflect.FieldDescriptor
+	fd_ArgumentConstraint_args_pattern   protoreflect.FieldDescriptor
+	fd_ArgumentConstraint_kwargs_pattern protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_dysonprotocol_script_v1_authz_proto_init()
-	md_ScriptExecAuthorization = File_dysonprotocol_script_v1_authz_proto.Messages().ByName("ScriptExecAuthorization")
-	fd_ScriptExecAuthorization_script_address = md_ScriptExecAuthorization.Fields().ByName("script_address")
-	fd_ScriptExecAuthorization_function_names = md_ScriptExecAuthorization.Fields().ByName("function_names")
+	md_ArgumentConstraint = File_dysonprotocol_script_v1_authz_proto.Messages().ByName("ArgumentConstraint")
+	fd_ArgumentConstraint_function_name = md_ArgumentConstraint.Fields().ByName("function_name")
+	fd_ArgumentConstraint_args_pattern = md_ArgumentConstraint.Fields().ByName("args_pattern")
+	fd_ArgumentConstraint_kwargs_pattern = md_ArgumentConstraint.Fields().ByName("kwargs_pattern")
 }
 
-var _ protoreflect.Message = (*fastReflection_ScriptExecAuthorization)(nil)
+var _ protoreflect.Message = (*fastReflection_ArgumentConstraint)(nil)
 
-type fastReflection_ScriptExecAuthorization ScriptExecAuthorization
+type fastReflection_ArgumentConstraint ArgumentConstraint
 
-func (x *ScriptExecAuthorization) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_ScriptExecAuthorization)(x)
+func (x *ArgumentConstraint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ArgumentConstraint)(x)
 }
 
-func (x *ScriptExecAuthorization) slowProtoReflect() protoreflect.Message {
-	mi := &file_dysonprotocol_script_v1_authz_proto_msgTypes[0]
+func (x *ArgumentConstraint) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_script_v1_authz_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -93,43 +1213,43 @@ func (x *ScriptExecAuthorization) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_ScriptExecAuthorization_messageType fastReflection_ScriptExecAuthorization_messageType
-var _ protoreflect.MessageType = fastReflection_ScriptExecAuthorization_messageType{}
+var _fastReflection_ArgumentConstraint_messageType fastReflection_ArgumentConstraint_messageType
+var _ protoreflect.MessageType = fastReflection_ArgumentConstraint_messageType{}
 
-type fastReflection_ScriptExecAuthorization_messageType struct{}
+type fastReflection_ArgumentConstraint_messageType struct{}
 
-func (x fastReflection_ScriptExecAuthorization_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_ScriptExecAuthorization)(nil)
+func (x fastReflection_ArgumentConstraint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ArgumentConstraint)(nil)
 }
-func (x fastReflection_ScriptExecAuthorization_messageType) New() protoreflect.Message {
-	return new(fastReflection_ScriptExecAuthorization)
+func (x fastReflection_ArgumentConstraint_messageType) New() protoreflect.Message {
+	return new(fastReflection_ArgumentConstraint)
 }
-func (x fastReflection_ScriptExecAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_ScriptExecAuthorization
+func (x fastReflection_ArgumentConstraint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ArgumentConstraint
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_ScriptExecAuthorization) Descriptor() protoreflect.MessageDescriptor {
-	return md_ScriptExecAuthorization
+func (x *fastReflection_ArgumentConstraint) Descriptor() protoreflect.MessageDescriptor {
+	return md_ArgumentConstraint
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_ScriptExecAuthorization) Type() protoreflect.MessageType {
-	return _fastReflection_ScriptExecAuthorization_messageType
+func (x *fastReflection_ArgumentConstraint) Type() protoreflect.MessageType {
+	return _fastReflection_ArgumentConstraint_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_ScriptExecAuthorization) New() protoreflect.Message {
-	return new(fastReflection_ScriptExecAuthorization)
+func (x *fastReflection_ArgumentConstraint) New() protoreflect.Message {
+	return new(fastReflection_ArgumentConstraint)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_ScriptExecAuthorization) Interface() protoreflect.ProtoMessage {
-	return (*ScriptExecAuthorization)(x)
+func (x *fastReflection_ArgumentConstraint) Interface() protoreflect.ProtoMessage {
+	return (*ArgumentConstraint)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -137,16 +1257,22 @@ func (x *fastReflection_ScriptExecAuthorization) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_ScriptExecAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ScriptAddress != "" {
-		value := protoreflect.ValueOfString(x.ScriptAddress)
-		if !f(fd_ScriptExecAuthorization_script_address, value) {
+func (x *fastReflection_ArgumentConstraint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_ArgumentConstraint_function_name, value) {
 			return
 		}
 	}
-	if len(x.FunctionNames) != 0 {
-		value := protoreflect.ValueOfList(&_ScriptExecAuthorization_2_list{list: &x.FunctionNames})
-		if !f(fd_ScriptExecAuthorization_function_names, value) {
+	if x.ArgsPattern != "" {
+		value := protoreflect.ValueOfString(x.ArgsPattern)
+		if !f(fd_ArgumentConstraint_args_pattern, value) {
+			return
+		}
+	}
+	if x.KwargsPattern != "" {
+		value := protoreflect.ValueOfString(x.KwargsPattern)
+		if !f(fd_ArgumentConstraint_kwargs_pattern, value) {
 			return
 		}
 	}
@@ -163,17 +1289,19 @@ func (x *fastReflection_ScriptExecAuthorization) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_ScriptExecAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ArgumentConstraint) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
-		return x.ScriptAddress != ""
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
-		return len(x.FunctionNames) != 0
+	case "dysonprotocol.script.v1.ArgumentConstraint.function_name":
+		return x.FunctionName != ""
+	case "dysonprotocol.script.v1.ArgumentConstraint.args_pattern":
+		return x.ArgsPattern != ""
+	case "dysonprotocol.script.v1.ArgumentConstraint.kwargs_pattern":
+		return x.KwargsPattern != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ArgumentConstraint"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -183,17 +1311,19 @@ func (x *fastReflection_ScriptExecAuthorization) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ScriptExecAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ArgumentConstraint) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
-		x.ScriptAddress = ""
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
-		x.FunctionNames = nil
+	case "dysonprotocol.script.v1.ArgumentConstraint.function_name":
+		x.FunctionName = ""
+	case "dysonprotocol.script.v1.ArgumentConstraint.args_pattern":
+		x.ArgsPattern = ""
+	case "dysonprotocol.script.v1.ArgumentConstraint.kwargs_pattern":
+		x.KwargsPattern = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ArgumentConstraint"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -203,22 +1333,22 @@ func (x *fastReflection_ScriptExecAuthorization) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_ScriptExecAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ArgumentConstraint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
-		value := x.ScriptAddress
+	case "dysonprotocol.script.v1.ArgumentConstraint.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.script.v1.ArgumentConstraint.args_pattern":
+		value := x.ArgsPattern
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.script.v1.ArgumentConstraint.kwargs_pattern":
+		value := x.KwargsPattern
 		return protoreflect.ValueOfString(value)
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
-		if len(x.FunctionNames) == 0 {
-			return protoreflect.ValueOfList(&_ScriptExecAuthorization_2_list{})
-		}
-		listValue := &_ScriptExecAuthorization_2_list{list: &x.FunctionNames}
-		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ArgumentConstraint"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ArgumentConstraint does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -232,19 +1362,19 @@ func (x *fastReflection_ScriptExecAuthorization) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ScriptExecAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ArgumentConstraint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
-		x.ScriptAddress = value.Interface().(string)
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
-		lv := value.List()
-		clv := lv.(*_ScriptExecAuthorization_2_list)
-		x.FunctionNames = *clv.list
+	case "dysonprotocol.script.v1.ArgumentConstraint.function_name":
+		x.FunctionName = value.Interface().(string)
+	case "dysonprotocol.script.v1.ArgumentConstraint.args_pattern":
+		x.ArgsPattern = value.Interface().(string)
+	case "dysonprotocol.script.v1.ArgumentConstraint.kwargs_pattern":
+		x.KwargsPattern = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ArgumentConstraint"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -258,49 +1388,48 @@ func (x *fastReflection_ScriptExecAuthorization) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ScriptExecAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ArgumentConstraint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
-		if x.FunctionNames == nil {
-			x.FunctionNames = []string{}
-		}
-		value := &_ScriptExecAuthorization_2_list{list: &x.FunctionNames}
-		return protoreflect.ValueOfList(value)
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
-		panic(fmt.Errorf("field script_address of message dysonprotocol.script.v1.ScriptExecAuthorization is not mutable"))
+	case "dysonprotocol.script.v1.ArgumentConstraint.function_name":
+		panic(fmt.Errorf("field function_name of message dysonprotocol.script.v1.ArgumentConstraint is not mutable"))
+	case "dysonprotocol.script.v1.ArgumentConstraint.args_pattern":
+		panic(fmt.Errorf("field args_pattern of message dysonprotocol.script.v1.ArgumentConstraint is not mutable"))
+	case "dysonprotocol.script.v1.ArgumentConstraint.kwargs_pattern":
+		panic(fmt.Errorf("field kwargs_pattern of message dysonprotocol.script.v1.ArgumentConstraint is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ArgumentConstraint"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_ScriptExecAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ArgumentConstraint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.script_address":
+	case "dysonprotocol.script.v1.ArgumentConstraint.function_name":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.script.v1.ArgumentConstraint.args_pattern":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.script.v1.ArgumentConstraint.kwargs_pattern":
 		return protoreflect.ValueOfString("")
-	case "dysonprotocol.script.v1.ScriptExecAuthorization.function_names":
-		list := []string{}
-		return protoreflect.ValueOfList(&_ScriptExecAuthorization_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ScriptExecAuthorization"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.ArgumentConstraint"))
 		}
-		panic(fmt.Errorf("message dysonprotocol.script.v1.ScriptExecAuthorization does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message dysonprotocol.script.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_ScriptExecAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ArgumentConstraint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.script.v1.ScriptExecAuthorization", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.script.v1.ArgumentConstraint", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -308,7 +1437,7 @@ func (x *fastReflection_ScriptExecAuthorization) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_ScriptExecAuthorization) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ArgumentConstraint) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -319,7 +1448,7 @@ func (x *fastReflection_ScriptExecAuthorization) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_ScriptExecAuthorization) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ArgumentConstraint) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -331,7 +1460,7 @@ func (x *fastReflection_ScriptExecAuthorization) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_ScriptExecAuthorization) IsValid() bool {
+func (x *fastReflection_ArgumentConstraint) IsValid() bool {
 	return x != nil
 }
 
@@ -341,9 +1470,9 @@ func (x *fastReflection_ScriptExecAuthorization) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ArgumentConstraint) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*ScriptExecAuthorization)
+		x := input.Message.Interface().(*ArgumentConstraint)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -355,15 +1484,17 @@ func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.ScriptAddress)
+		l = len(x.FunctionName)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if len(x.FunctionNames) > 0 {
-			for _, s := range x.FunctionNames {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.ArgsPattern)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KwargsPattern)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -375,7 +1506,7 @@ func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*ScriptExecAuthorization)
+		x := input.Message.Interface().(*ArgumentConstraint)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -394,19 +1525,24 @@ func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.FunctionNames) > 0 {
-			for iNdEx := len(x.FunctionNames) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.FunctionNames[iNdEx])
-				copy(dAtA[i:], x.FunctionNames[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionNames[iNdEx])))
-				i--
-				dAtA[i] = 0x12
-			}
+		if len(x.KwargsPattern) > 0 {
+			i -= len(x.KwargsPattern)
+			copy(dAtA[i:], x.KwargsPattern)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KwargsPattern)))
+			i--
+			dAtA[i] = 0x1a
 		}
-		if len(x.ScriptAddress) > 0 {
-			i -= len(x.ScriptAddress)
-			copy(dAtA[i:], x.ScriptAddress)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ScriptAddress)))
+		if len(x.ArgsPattern) > 0 {
+			i -= len(x.ArgsPattern)
+			copy(dAtA[i:], x.ArgsPattern)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ArgsPattern)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -421,7 +1557,7 @@ func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*ScriptExecAuthorization)
+		x := input.Message.Interface().(*ArgumentConstraint)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -453,15 +1589,15 @@ func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScriptExecAuthorization: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ArgumentConstraint: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ScriptExecAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ArgumentConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -489,11 +1625,11 @@ func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ScriptAddress = string(dAtA[iNdEx:postIndex])
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionNames", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArgsPattern", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -521,7 +1657,39 @@ func (x *fastReflection_ScriptExecAuthorization) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.FunctionNames = append(x.FunctionNames, string(dAtA[iNdEx:postIndex]))
+				x.ArgsPattern = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KwargsPattern", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KwargsPattern = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -572,7 +1740,7 @@ const (
 )
 
 // ScriptExecAuthorization allows the grantee to execute specific scripts and
-// functions.
+// functions. The optional limits make it suitable for short-lived session keys.
 type ScriptExecAuthorization struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -581,8 +1749,38 @@ type ScriptExecAuthorization struct {
 	// script_address is the address of the script that can be executed
 	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
 	// function_names is a list of function names that can be called on this
-	// script if empty, only the script itself can be run without a function call
+	// script if empty, only the script itself can be run without a function call.
+	// When set, running the script without a function call or with extra code is
+	// not allowed.
 	FunctionNames []string `protobuf:"bytes,2,rep,name=function_names,json=functionNames,proto3" json:"function_names,omitempty"`
+	// max_calls is the number of executions left. It is decremented on every
+	// execution and the grant is deleted when it reaches zero. Zero means no
+	// limit.
+	MaxCalls uint64 `protobuf:"varint,3,opt,name=max_calls,json=maxCalls,proto3" json:"max_calls,omitempty"`
+	// max_funds is the remaining amount the attached messages of executions may
+	// send when restrict_attached_messages is set. The amounts of attached bank
+	// sends are subtracted from it. When empty no funds can be sent.
+	MaxFunds []*v1beta1.Coin `protobuf:"bytes,4,rep,name=max_funds,json=maxFunds,proto3" json:"max_funds,omitempty"`
+	// expiry_height is the block height from which the grant is no longer
+	// accepted and is deleted. Zero means no expiry height.
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// argument_constraints restrict the arguments of specific functions.
+	ArgumentConstraints []*ArgumentConstraint `protobuf:"bytes,6,rep,name=argument_constraints,json=argumentConstraints,proto3" json:"argument_constraints,omitempty"`
+	// max_calls_per_window is the maximum number of executions within a window
+	// of window_blocks blocks. Zero means no rate limit.
+	MaxCallsPerWindow uint64 `protobuf:"varint,7,opt,name=max_calls_per_window,json=maxCallsPerWindow,proto3" json:"max_calls_per_window,omitempty"`
+	// window_blocks is the length in blocks of the rate limit window.
+	WindowBlocks int64 `protobuf:"varint,8,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// window_start is the block height the current rate limit window started
+	// at. It is maintained by the module.
+	WindowStart int64 `protobuf:"varint,9,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// window_calls is the number of executions in the current rate limit
+	// window. It is maintained by the module.
+	WindowCalls uint64 `protobuf:"varint,10,opt,name=window_calls,json=windowCalls,proto3" json:"window_calls,omitempty"`
+	// restrict_attached_messages only allows bank MsgSend and MsgMultiSend
+	// attached messages, bounded by max_funds. When false attached messages are
+	// not restricted.
+	RestrictAttachedMessages bool `protobuf:"varint,11,opt,name=restrict_attached_messages,json=restrictAttachedMessages,proto3" json:"restrict_attached_messages,omitempty"`
 }
 
 func (x *ScriptExecAuthorization) Reset() {
@@ -619,6 +1817,127 @@ func (x *ScriptExecAuthorization) GetFunctionNames() []string {
 	return nil
 }
 
+func (x *ScriptExecAuthorization) GetMaxCalls() uint64 {
+	if x != nil {
+		return x.MaxCalls
+	}
+	return 0
+}
+
+func (x *ScriptExecAuthorization) GetMaxFunds() []*v1beta1.Coin {
+	if x != nil {
+		return x.MaxFunds
+	}
+	return nil
+}
+
+func (x *ScriptExecAuthorization) GetExpiryHeight() int64 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+func (x *ScriptExecAuthorization) GetArgumentConstraints() []*ArgumentConstraint {
+	if x != nil {
+		return x.ArgumentConstraints
+	}
+	return nil
+}
+
+func (x *ScriptExecAuthorization) GetMaxCallsPerWindow() uint64 {
+	if x != nil {
+		return x.MaxCallsPerWindow
+	}
+	return 0
+}
+
+func (x *ScriptExecAuthorization) GetWindowBlocks() int64 {
+	if x != nil {
+		return x.WindowBlocks
+	}
+	return 0
+}
+
+func (x *ScriptExecAuthorization) GetWindowStart() int64 {
+	if x != nil {
+		return x.WindowStart
+	}
+	return 0
+}
+
+func (x *ScriptExecAuthorization) GetWindowCalls() uint64 {
+	if x != nil {
+		return x.WindowCalls
+	}
+	return 0
+}
+
+func (x *ScriptExecAuthorization) GetRestrictAttachedMessages() bool {
+	if x != nil {
+		return x.RestrictAttachedMessages
+	}
+	return false
+}
+
+// ArgumentConstraint restricts the arguments of a function call. Patterns are
+// RE2 regular expressions that must match the whole JSON encoded value.
+type ArgumentConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// function_name is the constrained function.
+	FunctionName string `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// args_pattern must match the JSON list of positional arguments. Empty
+	// means unconstrained.
+	ArgsPattern string `protobuf:"bytes,2,opt,name=args_pattern,json=argsPattern,proto3" json:"args_pattern,omitempty"`
+	// kwargs_pattern must match the JSON dict of keyword arguments. Empty means
+	// unconstrained.
+	KwargsPattern string `protobuf:"bytes,3,opt,name=kwargs_pattern,json=kwargsPattern,proto3" json:"kwargs_pattern,omitempty"`
+}
+
+func (x *ArgumentConstraint) Reset() {
+	*x = ArgumentConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_script_v1_authz_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArgumentConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgumentConstraint) ProtoMessage() {}
+
+// Deprecated: Use ArgumentConstraint.ProtoReflect.Descriptor instead.
+func (*ArgumentConstraint) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_script_v1_authz_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArgumentConstraint) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *ArgumentConstraint) GetArgsPattern() string {
+	if x != nil {
+		return x.ArgsPattern
+	}
+	return ""
+}
+
+func (x *ArgumentConstraint) GetKwargsPattern() string {
+	if x != nil {
+		return x.KwargsPattern
+	}
+	return ""
+}
+
 var File_dysonprotocol_script_v1_authz_proto protoreflect.FileDescriptor
 
 var file_dysonprotocol_script_v1_authz_proto_rawDesc = []byte{
@@ -627,25 +1946,69 @@ var file_dysonprotocol_script_v1_authz_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe5, 0x01, 0x0a,
-	0x17, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x45, 0x78, 0x65, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0d, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x75, 0x6e,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73,
-	0x3a, 0x62, 0xca, 0xb4, 0x2d, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0xd2, 0xb4, 0x2d, 0x11, 0x64, 0x79, 0x73, 0x6f,
-	0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x20, 0x32, 0x2e, 0x30, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x22, 0x64, 0x79, 0x73, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2f, 0x53, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x45, 0x78, 0x65, 0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x22, 0x5a, 0x20, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f,
+	0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xe7, 0x05, 0x0a, 0x17, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x45, 0x78, 0x65,
+	0x63, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f,
+	0x0a, 0x0e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x0d, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x61,
+	0x6c, 0x6c, 0x73, 0x12, 0x7e, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x75, 0x6e, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x42, 0x46, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x73, 0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63,
+	0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x46, 0x75,
+	0x6e, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x64, 0x0a, 0x14, 0x61, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x61, 0x72, 0x67, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2f,
+	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61,
+	0x78, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x23, 0x0a, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18,
+	0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x3a, 0x62, 0xca, 0xb4, 0x2d, 0x22, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0xd2, 0xb4, 0x2d, 0x11, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x20, 0x32, 0x2e, 0x30, 0x8a, 0xe7, 0xb0, 0x2a, 0x22, 0x64, 0x79, 0x73, 0x2f, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x2f, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x45, 0x78, 0x65, 0x63, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x83, 0x01, 0x0a,
+	0x12, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61,
+	0x69, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x72, 0x67, 0x73,
+	0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x72, 0x67, 0x73, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6b,
+	0x77, 0x61, 0x72, 0x67, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x77, 0x61, 0x72, 0x67, 0x73, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x42, 0x22, 0x5a, 0x20, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -660,16 +2023,20 @@ func file_dysonprotocol_script_v1_authz_proto_rawDescGZIP() []byte {
 	return file_dysonprotocol_script_v1_authz_proto_rawDescData
 }
 
-var file_dysonprotocol_script_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_dysonprotocol_script_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_dysonprotocol_script_v1_authz_proto_goTypes = []interface{}{
 	(*ScriptExecAuthorization)(nil), // 0: dysonprotocol.script.v1.ScriptExecAuthorization
+	(*ArgumentConstraint)(nil),      // 1: dysonprotocol.script.v1.ArgumentConstraint
+	(*v1beta1.Coin)(nil),            // 2: cosmos.base.v1beta1.Coin
 }
 var file_dysonprotocol_script_v1_authz_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: dysonprotocol.script.v1.ScriptExecAuthorization.max_funds:type_name -> cosmos.base.v1beta1.Coin
+	1, // 1: dysonprotocol.script.v1.ScriptExecAuthorization.argument_constraints:type_name -> dysonprotocol.script.v1.ArgumentConstraint
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_dysonprotocol_script_v1_authz_proto_init() }
@@ -690,6 +2057,18 @@ func file_dysonprotocol_script_v1_authz_proto_init() {
 				return nil
 			}
 		}
+		file_dysonprotocol_script_v1_authz_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgumentConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -697,7 +2076,7 @@ func file_dysonprotocol_script_v1_authz_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_dysonprotocol_script_v1_authz_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -17,6 +17,13 @@ var (
 	md_Params                                  protoreflect.MessageDescriptor
 	fd_Params_max_relative_historical_blocks   protoreflect.FieldDescriptor
 	fd_Params_absolute_historical_block_cutoff protoreflect.FieldDescriptor
+	fd_Params_snapshot_interval                protoreflect.FieldDescriptor
+	fd_Params_snapshot_retention               protoreflect.FieldDescriptor
+	fd_Params_max_snapshot_queries             protoreflect.FieldDescriptor
+	fd_Params_max_snapshot_queries_per_owner   protoreflect.FieldDescriptor
+	fd_Params_snapshot_query_gas_limit         protoreflect.FieldDescriptor
+	fd_Params_historical_query_base_gas        protoreflect.FieldDescriptor
+	fd_Params_historical_query_gas_per_byte    protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -24,6 +31,13 @@ func init() {
 	md_Params = File_dysonprotocol_script_v1_params_proto.Messages().ByName("Params")
 	fd_Params_max_relative_historical_blocks = md_Params.Fields().ByName("max_relative_historical_blocks")
 	fd_Params_absolute_historical_block_cutoff = md_Params.Fields().ByName("absolute_historical_block_cutoff")
+	fd_Params_snapshot_interval = md_Params.Fields().ByName("snapshot_interval")
+	fd_Params_snapshot_retention = md_Params.Fields().ByName("snapshot_retention")
+	fd_Params_max_snapshot_queries = md_Params.Fields().ByName("max_snapshot_queries")
+	fd_Params_max_snapshot_queries_per_owner = md_Params.Fields().ByName("max_snapshot_queries_per_owner")
+	fd_Params_snapshot_query_gas_limit = md_Params.Fields().ByName("snapshot_query_gas_limit")
+	fd_Params_historical_query_base_gas = md_Params.Fields().ByName("historical_query_base_gas")
+	fd_Params_historical_query_gas_per_byte = md_Params.Fields().ByName("historical_query_gas_per_byte")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -103,6 +117,48 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.SnapshotInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SnapshotInterval)
+		if !f(fd_Params_snapshot_interval, value) {
+			return
+		}
+	}
+	if x.SnapshotRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SnapshotRetention)
+		if !f(fd_Params_snapshot_retention, value) {
+			return
+		}
+	}
+	if x.MaxSnapshotQueries != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSnapshotQueries)
+		if !f(fd_Params_max_snapshot_queries, value) {
+			return
+		}
+	}
+	if x.MaxSnapshotQueriesPerOwner != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSnapshotQueriesPerOwner)
+		if !f(fd_Params_max_snapshot_queries_per_owner, value) {
+			return
+		}
+	}
+	if x.SnapshotQueryGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SnapshotQueryGasLimit)
+		if !f(fd_Params_snapshot_query_gas_limit, value) {
+			return
+		}
+	}
+	if x.HistoricalQueryBaseGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HistoricalQueryBaseGas)
+		if !f(fd_Params_historical_query_base_gas, value) {
+			return
+		}
+	}
+	if x.HistoricalQueryGasPerByte != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.HistoricalQueryGasPerByte)
+		if !f(fd_Params_historical_query_gas_per_byte, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -122,6 +178,20 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxRelativeHistoricalBlocks != int64(0)
 	case "dysonprotocol.script.v1.Params.absolute_historical_block_cutoff":
 		return x.AbsoluteHistoricalBlockCutoff != int64(0)
+	case "dysonprotocol.script.v1.Params.snapshot_interval":
+		return x.SnapshotInterval != int64(0)
+	case "dysonprotocol.script.v1.Params.snapshot_retention":
+		return x.SnapshotRetention != int64(0)
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries":
+		return x.MaxSnapshotQueries != uint64(0)
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries_per_owner":
+		return x.MaxSnapshotQueriesPerOwner != uint64(0)
+	case "dysonprotocol.script.v1.Params.snapshot_query_gas_limit":
+		return x.SnapshotQueryGasLimit != uint64(0)
+	case "dysonprotocol.script.v1.Params.historical_query_base_gas":
+		return x.HistoricalQueryBaseGas != uint64(0)
+	case "dysonprotocol.script.v1.Params.historical_query_gas_per_byte":
+		return x.HistoricalQueryGasPerByte != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.Params"))
@@ -142,6 +212,20 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxRelativeHistoricalBlocks = int64(0)
 	case "dysonprotocol.script.v1.Params.absolute_historical_block_cutoff":
 		x.AbsoluteHistoricalBlockCutoff = int64(0)
+	case "dysonprotocol.script.v1.Params.snapshot_interval":
+		x.SnapshotInterval = int64(0)
+	case "dysonprotocol.script.v1.Params.snapshot_retention":
+		x.SnapshotRetention = int64(0)
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries":
+		x.MaxSnapshotQueries = uint64(0)
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries_per_owner":
+		x.MaxSnapshotQueriesPerOwner = uint64(0)
+	case "dysonprotocol.script.v1.Params.snapshot_query_gas_limit":
+		x.SnapshotQueryGasLimit = uint64(0)
+	case "dysonprotocol.script.v1.Params.historical_query_base_gas":
+		x.HistoricalQueryBaseGas = uint64(0)
+	case "dysonprotocol.script.v1.Params.historical_query_gas_per_byte":
+		x.HistoricalQueryGasPerByte = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.Params"))
@@ -164,6 +248,27 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "dysonprotocol.script.v1.Params.absolute_historical_block_cutoff":
 		value := x.AbsoluteHistoricalBlockCutoff
 		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.script.v1.Params.snapshot_interval":
+		value := x.SnapshotInterval
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.script.v1.Params.snapshot_retention":
+		value := x.SnapshotRetention
+		return protoreflect.ValueOfInt64(value)
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries":
+		value := x.MaxSnapshotQueries
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries_per_owner":
+		value := x.MaxSnapshotQueriesPerOwner
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.script.v1.Params.snapshot_query_gas_limit":
+		value := x.SnapshotQueryGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.script.v1.Params.historical_query_base_gas":
+		value := x.HistoricalQueryBaseGas
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.script.v1.Params.historical_query_gas_per_byte":
+		value := x.HistoricalQueryGasPerByte
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.Params"))
@@ -188,6 +293,20 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxRelativeHistoricalBlocks = value.Int()
 	case "dysonprotocol.script.v1.Params.absolute_historical_block_cutoff":
 		x.AbsoluteHistoricalBlockCutoff = value.Int()
+	case "dysonprotocol.script.v1.Params.snapshot_interval":
+		x.SnapshotInterval = value.Int()
+	case "dysonprotocol.script.v1.Params.snapshot_retention":
+		x.SnapshotRetention = value.Int()
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries":
+		x.MaxSnapshotQueries = value.Uint()
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries_per_owner":
+		x.MaxSnapshotQueriesPerOwner = value.Uint()
+	case "dysonprotocol.script.v1.Params.snapshot_query_gas_limit":
+		x.SnapshotQueryGasLimit = value.Uint()
+	case "dysonprotocol.script.v1.Params.historical_query_base_gas":
+		x.HistoricalQueryBaseGas = value.Uint()
+	case "dysonprotocol.script.v1.Params.historical_query_gas_per_byte":
+		x.HistoricalQueryGasPerByte = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.Params"))
@@ -212,6 +331,20 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_relative_historical_blocks of message dysonprotocol.script.v1.Params is not mutable"))
 	case "dysonprotocol.script.v1.Params.absolute_historical_block_cutoff":
 		panic(fmt.Errorf("field absolute_historical_block_cutoff of message dysonprotocol.script.v1.Params is not mutable"))
+	case "dysonprotocol.script.v1.Params.snapshot_interval":
+		panic(fmt.Errorf("field snapshot_interval of message dysonprotocol.script.v1.Params is not mutable"))
+	case "dysonprotocol.script.v1.Params.snapshot_retention":
+		panic(fmt.Errorf("field snapshot_retention of message dysonprotocol.script.v1.Params is not mutable"))
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries":
+		panic(fmt.Errorf("field max_snapshot_queries of message dysonprotocol.script.v1.Params is not mutable"))
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries_per_owner":
+		panic(fmt.Errorf("field max_snapshot_queries_per_owner of message dysonprotocol.script.v1.Params is not mutable"))
+	case "dysonprotocol.script.v1.Params.snapshot_query_gas_limit":
+		panic(fmt.Errorf("field snapshot_query_gas_limit of message dysonprotocol.script.v1.Params is not mutable"))
+	case "dysonprotocol.script.v1.Params.historical_query_base_gas":
+		panic(fmt.Errorf("field historical_query_base_gas of message dysonprotocol.script.v1.Params is not mutable"))
+	case "dysonprotocol.script.v1.Params.historical_query_gas_per_byte":
+		panic(fmt.Errorf("field historical_query_gas_per_byte of message dysonprotocol.script.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.Params"))
@@ -229,6 +362,20 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfInt64(int64(0))
 	case "dysonprotocol.script.v1.Params.absolute_historical_block_cutoff":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.script.v1.Params.snapshot_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.script.v1.Params.snapshot_retention":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.script.v1.Params.max_snapshot_queries_per_owner":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.script.v1.Params.snapshot_query_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.script.v1.Params.historical_query_base_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.script.v1.Params.historical_query_gas_per_byte":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.script.v1.Params"))
@@ -304,6 +451,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.AbsoluteHistoricalBlockCutoff != 0 {
 			n += 1 + runtime.Sov(uint64(x.AbsoluteHistoricalBlockCutoff))
 		}
+		if x.SnapshotInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.SnapshotInterval))
+		}
+		if x.SnapshotRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.SnapshotRetention))
+		}
+		if x.MaxSnapshotQueries != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSnapshotQueries))
+		}
+		if x.MaxSnapshotQueriesPerOwner != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSnapshotQueriesPerOwner))
+		}
+		if x.SnapshotQueryGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.SnapshotQueryGasLimit))
+		}
+		if x.HistoricalQueryBaseGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistoricalQueryBaseGas))
+		}
+		if x.HistoricalQueryGasPerByte != 0 {
+			n += 1 + runtime.Sov(uint64(x.HistoricalQueryGasPerByte))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -333,6 +501,41 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.HistoricalQueryGasPerByte != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoricalQueryGasPerByte))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.HistoricalQueryBaseGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.HistoricalQueryBaseGas))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.SnapshotQueryGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SnapshotQueryGasLimit))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxSnapshotQueriesPerOwner != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSnapshotQueriesPerOwner))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxSnapshotQueries != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSnapshotQueries))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.SnapshotRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SnapshotRetention))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SnapshotInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SnapshotInterval))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.AbsoluteHistoricalBlockCutoff != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.AbsoluteHistoricalBlockCutoff))
 			i--
@@ -430,6 +633,139 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+				}
+				x.SnapshotInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SnapshotInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+				}
+				x.SnapshotRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SnapshotRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotQueries", wireType)
+				}
+				x.MaxSnapshotQueries = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSnapshotQueries |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotQueriesPerOwner", wireType)
+				}
+				x.MaxSnapshotQueriesPerOwner = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSnapshotQueriesPerOwner |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SnapshotQueryGasLimit", wireType)
+				}
+				x.SnapshotQueryGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SnapshotQueryGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoricalQueryBaseGas", wireType)
+				}
+				x.HistoricalQueryBaseGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoricalQueryBaseGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HistoricalQueryGasPerByte", wireType)
+				}
+				x.HistoricalQueryGasPerByte = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.HistoricalQueryGasPerByte |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -498,6 +834,28 @@ type Params struct {
 	// height will be max(current_height - max_relative_historical_blocks,
 	// absolute_historical_block_cutoff).
 	AbsoluteHistoricalBlockCutoff int64 `protobuf:"varint,2,opt,name=absolute_historical_block_cutoff,json=absoluteHistoricalBlockCutoff,proto3" json:"absolute_historical_block_cutoff,omitempty"`
+	// snapshot_interval is the number of blocks between snapshots of registered
+	// snapshot queries. Snapshots are taken in EndBlock when the block height is
+	// a multiple of this value. Zero disables snapshots.
+	SnapshotInterval int64 `protobuf:"varint,3,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty"`
+	// snapshot_retention is the number of most recent snapshots kept for each
+	// snapshot query. Older snapshots are pruned when a new one is taken.
+	SnapshotRetention int64 `protobuf:"varint,4,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty"`
+	// max_snapshot_queries is the maximum number of snapshot queries registered
+	// across all owners. It bounds the work done in EndBlock.
+	MaxSnapshotQueries uint64 `protobuf:"varint,5,opt,name=max_snapshot_queries,json=maxSnapshotQueries,proto3" json:"max_snapshot_queries,omitempty"`
+	// max_snapshot_queries_per_owner is the maximum number of snapshot queries a
+	// single owner can register.
+	MaxSnapshotQueriesPerOwner uint64 `protobuf:"varint,6,opt,name=max_snapshot_queries_per_owner,json=maxSnapshotQueriesPerOwner,proto3" json:"max_snapshot_queries_per_owner,omitempty"`
+	// snapshot_query_gas_limit is the gas limit for a single snapshot query
+	// executed in EndBlock. It is also charged to the registering transaction.
+	SnapshotQueryGasLimit uint64 `protobuf:"varint,7,opt,name=snapshot_query_gas_limit,json=snapshotQueryGasLimit,proto3" json:"snapshot_query_gas_limit,omitempty"`
+	// historical_query_base_gas is the flat gas charged for every snapshot
+	// lookup.
+	HistoricalQueryBaseGas uint64 `protobuf:"varint,8,opt,name=historical_query_base_gas,json=historicalQueryBaseGas,proto3" json:"historical_query_base_gas,omitempty"`
+	// historical_query_gas_per_byte is the gas charged per byte of a snapshot
+	// lookup's JSON response.
+	HistoricalQueryGasPerByte uint64 `protobuf:"varint,9,opt,name=historical_query_gas_per_byte,json=historicalQueryGasPerByte,proto3" json:"historical_query_gas_per_byte,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -534,6 +892,55 @@ func (x *Params) GetAbsoluteHistoricalBlockCutoff() int64 {
 	return 0
 }
 
+func (x *Params) GetSnapshotInterval() int64 {
+	if x != nil {
+		return x.SnapshotInterval
+	}
+	return 0
+}
+
+func (x *Params) GetSnapshotRetention() int64 {
+	if x != nil {
+		return x.SnapshotRetention
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSnapshotQueries() uint64 {
+	if x != nil {
+		return x.MaxSnapshotQueries
+	}
+	return 0
+}
+
+func (x *Params) GetMaxSnapshotQueriesPerOwner() uint64 {
+	if x != nil {
+		return x.MaxSnapshotQueriesPerOwner
+	}
+	return 0
+}
+
+func (x *Params) GetSnapshotQueryGasLimit() uint64 {
+	if x != nil {
+		return x.SnapshotQueryGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetHistoricalQueryBaseGas() uint64 {
+	if x != nil {
+		return x.HistoricalQueryBaseGas
+	}
+	return 0
+}
+
+func (x *Params) GetHistoricalQueryGasPerByte() uint64 {
+	if x != nil {
+		return x.HistoricalQueryGasPerByte
+	}
+	return 0
+}
+
 var File_dysonprotocol_script_v1_params_proto protoreflect.FileDescriptor
 
 var file_dysonprotocol_script_v1_params_proto_rawDesc = []byte{
@@ -542,7 +949,7 @@ var file_dysonprotocol_script_v1_params_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2e, 0x76, 0x31, 0x1a,
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x06, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x6e, 0x0a, 0x1e, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
 	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
 	0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25, 0x79, 0x61,
@@ -557,10 +964,50 @@ var file_dysonprotocol_script_v1_params_proto_rawDesc = []byte{
 	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f,
 	0x63, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x22, 0x52, 0x1d, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74,
 	0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x42, 0x22, 0x5a, 0x20, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x43, 0x75, 0x74, 0x6f, 0x66, 0x66, 0x12, 0x49, 0x0a, 0x11, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x52,
+	0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x4c, 0x0a, 0x12, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x1d, 0xf2,
+	0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x52, 0x11, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x51, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f,
+	0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2,
+	0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0x52, 0x12,
+	0x6d, 0x61, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x6d, 0x0a, 0x1e, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x29, 0xf2, 0xde, 0x1f, 0x25,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x22, 0x52, 0x1a, 0x6d, 0x61, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x69, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x5c, 0x0a, 0x18, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x23, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x15, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x5f, 0x0a, 0x19, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x24, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x22, 0x52, 0x16, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x69, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73,
+	0x12, 0x6a, 0x0a, 0x1d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x28, 0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x22, 0x52, 0x19, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x42, 0x22, 0x5a, 0x20,
+	0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x78, 0x2f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -21,12 +21,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_ScriptInfo_FullMethodName  = "/dysonprotocol.script.v1.Query/ScriptInfo"
-	Query_EncodeJson_FullMethodName  = "/dysonprotocol.script.v1.Query/EncodeJson"
-	Query_DecodeBytes_FullMethodName = "/dysonprotocol.script.v1.Query/DecodeBytes"
-	Query_VerifyTx_FullMethodName    = "/dysonprotocol.script.v1.Query/VerifyTx"
-	Query_Params_FullMethodName      = "/dysonprotocol.script.v1.Query/Params"
-	Query_Web_FullMethodName         = "/dysonprotocol.script.v1.Query/Web"
+	Query_ScriptInfo_FullMethodName      = "/dysonprotocol.script.v1.Query/ScriptInfo"
+	Query_EncodeJson_FullMethodName      = "/dysonprotocol.script.v1.Query/EncodeJson"
+	Query_DecodeBytes_FullMethodName     = "/dysonprotocol.script.v1.Query/DecodeBytes"
+	Query_VerifyTx_FullMethodName        = "/dysonprotocol.script.v1.Query/VerifyTx"
+	Query_Params_FullMethodName          = "/dysonprotocol.script.v1.Query/Params"
+	Query_Snapshot_FullMethodName        = "/dysonprotocol.script.v1.Query/Snapshot"
+	Query_SnapshotQueries_FullMethodName = "/dysonprotocol.script.v1.Query/SnapshotQueries"
+	Query_GasSponsorship_FullMethodName  = "/dysonprotocol.script.v1.Query/GasSponsorship"
+	Query_Web_FullMethodName             = "/dysonprotocol.script.v1.Query/Web"
 )
 
 // QueryClient is the client API for Query service.
@@ -45,6 +48,14 @@ type QueryClient interface {
 	VerifyTx(ctx context.Context, in *QueryVerifyTxRequest, opts ...grpc.CallOption) (*QueryVerifyTxResponse, error)
 	// Params queries the parameters of the script module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Snapshot returns the most recent snapshot of a snapshot query taken at or
+	// before the given height.
+	Snapshot(ctx context.Context, in *QuerySnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error)
+	// SnapshotQueries lists the snapshot queries registered by an owner.
+	SnapshotQueries(ctx context.Context, in *QuerySnapshotQueriesRequest, opts ...grpc.CallOption) (*QuerySnapshotQueriesResponse, error)
+	// GasSponsorship queries the gas sponsorship policy of a script and
+	// optionally the rate limit usage of a user.
+	GasSponsorship(ctx context.Context, in *QueryGasSponsorshipRequest, opts ...grpc.CallOption) (*QueryGasSponsorshipResponse, error)
 	// Queries the WSGI web application function of a script.
 	Web(ctx context.Context, in *WebRequest, opts ...grpc.CallOption) (*WebResponse, error)
 }
@@ -107,6 +118,36 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Snapshot(ctx context.Context, in *QuerySnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySnapshotResponse)
+	err := c.cc.Invoke(ctx, Query_Snapshot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SnapshotQueries(ctx context.Context, in *QuerySnapshotQueriesRequest, opts ...grpc.CallOption) (*QuerySnapshotQueriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuerySnapshotQueriesResponse)
+	err := c.cc.Invoke(ctx, Query_SnapshotQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasSponsorship(ctx context.Context, in *QueryGasSponsorshipRequest, opts ...grpc.CallOption) (*QueryGasSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Query_GasSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Web(ctx context.Context, in *WebRequest, opts ...grpc.CallOption) (*WebResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(WebResponse)
@@ -133,6 +174,14 @@ type QueryServer interface {
 	VerifyTx(context.Context, *QueryVerifyTxRequest) (*QueryVerifyTxResponse, error)
 	// Params queries the parameters of the script module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Snapshot returns the most recent snapshot of a snapshot query taken at or
+	// before the given height.
+	Snapshot(context.Context, *QuerySnapshotRequest) (*QuerySnapshotResponse, error)
+	// SnapshotQueries lists the snapshot queries registered by an owner.
+	SnapshotQueries(context.Context, *QuerySnapshotQueriesRequest) (*QuerySnapshotQueriesResponse, error)
+	// GasSponsorship queries the gas sponsorship policy of a script and
+	// optionally the rate limit usage of a user.
+	GasSponsorship(context.Context, *QueryGasSponsorshipRequest) (*QueryGasSponsorshipResponse, error)
 	// Queries the WSGI web application function of a script.
 	Web(context.Context, *WebRequest) (*WebResponse, error)
 	mustEmbedUnimplementedQueryServer()
@@ -160,6 +209,15 @@ func (UnimplementedQueryServer) VerifyTx(context.Context, *QueryVerifyTxRequest)
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) Snapshot(context.Context, *QuerySnapshotRequest) (*QuerySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (UnimplementedQueryServer) SnapshotQueries(context.Context, *QuerySnapshotQueriesRequest) (*QuerySnapshotQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotQueries not implemented")
+}
+func (UnimplementedQueryServer) GasSponsorship(context.Context, *QueryGasSponsorshipRequest) (*QueryGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasSponsorship not implemented")
+}
 func (UnimplementedQueryServer) Web(context.Context, *WebRequest) (*WebResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Web not implemented")
 }
@@ -274,6 +332,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Snapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Snapshot(ctx, req.(*QuerySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SnapshotQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySnapshotQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SnapshotQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SnapshotQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SnapshotQueries(ctx, req.(*QuerySnapshotQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GasSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasSponsorship(ctx, req.(*QueryGasSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Web_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WebRequest)
 	if err := dec(in); err != nil {
@@ -319,6 +431,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _Query_Snapshot_Handler,
+		},
+		{
+			MethodName: "SnapshotQueries",
+			Handler:    _Query_SnapshotQueries_Handler,
+		},
+		{
+			MethodName: "GasSponsorship",
+			Handler:    _Query_GasSponsorship_Handler,
+		},
 		{
 			MethodName: "Web",
 			Handler:    _Query_Web_Handler,
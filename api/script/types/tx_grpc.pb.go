@@ -21,10 +21,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_UpdateScript_FullMethodName    = "/dysonprotocol.script.v1.Msg/UpdateScript"
-	Msg_ExecScript_FullMethodName      = "/dysonprotocol.script.v1.Msg/ExecScript"
-	Msg_CreateNewScript_FullMethodName = "/dysonprotocol.script.v1.Msg/CreateNewScript"
-	Msg_UpdateParams_FullMethodName    = "/dysonprotocol.script.v1.Msg/UpdateParams"
+	Msg_UpdateScript_FullMethodName          = "/dysonprotocol.script.v1.Msg/UpdateScript"
+	Msg_ExecScript_FullMethodName            = "/dysonprotocol.script.v1.Msg/ExecScript"
+	Msg_CreateNewScript_FullMethodName       = "/dysonprotocol.script.v1.Msg/CreateNewScript"
+	Msg_UpdateParams_FullMethodName          = "/dysonprotocol.script.v1.Msg/UpdateParams"
+	Msg_RegisterSnapshotQuery_FullMethodName = "/dysonprotocol.script.v1.Msg/RegisterSnapshotQuery"
+	Msg_DeleteSnapshotQuery_FullMethodName   = "/dysonprotocol.script.v1.Msg/DeleteSnapshotQuery"
+	Msg_SetGasSponsorship_FullMethodName     = "/dysonprotocol.script.v1.Msg/SetGasSponsorship"
+	Msg_DeleteGasSponsorship_FullMethodName  = "/dysonprotocol.script.v1.Msg/DeleteGasSponsorship"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,6 +48,16 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/script
 	// module parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterSnapshotQuery registers or replaces a query whose result is
+	// recorded on-chain every snapshot_interval blocks.
+	RegisterSnapshotQuery(ctx context.Context, in *MsgRegisterSnapshotQuery, opts ...grpc.CallOption) (*MsgRegisterSnapshotQueryResponse, error)
+	// DeleteSnapshotQuery removes a snapshot query and all of its snapshots.
+	DeleteSnapshotQuery(ctx context.Context, in *MsgDeleteSnapshotQuery, opts ...grpc.CallOption) (*MsgDeleteSnapshotQueryResponse, error)
+	// SetGasSponsorship creates or replaces the gas sponsorship policy of a
+	// script.
+	SetGasSponsorship(ctx context.Context, in *MsgSetGasSponsorship, opts ...grpc.CallOption) (*MsgSetGasSponsorshipResponse, error)
+	// DeleteGasSponsorship stops a script from sponsoring gas.
+	DeleteGasSponsorship(ctx context.Context, in *MsgDeleteGasSponsorship, opts ...grpc.CallOption) (*MsgDeleteGasSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -94,6 +108,46 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterSnapshotQuery(ctx context.Context, in *MsgRegisterSnapshotQuery, opts ...grpc.CallOption) (*MsgRegisterSnapshotQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgRegisterSnapshotQueryResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterSnapshotQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteSnapshotQuery(ctx context.Context, in *MsgDeleteSnapshotQuery, opts ...grpc.CallOption) (*MsgDeleteSnapshotQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDeleteSnapshotQueryResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteSnapshotQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGasSponsorship(ctx context.Context, in *MsgSetGasSponsorship, opts ...grpc.CallOption) (*MsgSetGasSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_SetGasSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteGasSponsorship(ctx context.Context, in *MsgDeleteGasSponsorship, opts ...grpc.CallOption) (*MsgDeleteGasSponsorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgDeleteGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteGasSponsorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -111,6 +165,16 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/script
 	// module parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterSnapshotQuery registers or replaces a query whose result is
+	// recorded on-chain every snapshot_interval blocks.
+	RegisterSnapshotQuery(context.Context, *MsgRegisterSnapshotQuery) (*MsgRegisterSnapshotQueryResponse, error)
+	// DeleteSnapshotQuery removes a snapshot query and all of its snapshots.
+	DeleteSnapshotQuery(context.Context, *MsgDeleteSnapshotQuery) (*MsgDeleteSnapshotQueryResponse, error)
+	// SetGasSponsorship creates or replaces the gas sponsorship policy of a
+	// script.
+	SetGasSponsorship(context.Context, *MsgSetGasSponsorship) (*MsgSetGasSponsorshipResponse, error)
+	// DeleteGasSponsorship stops a script from sponsoring gas.
+	DeleteGasSponsorship(context.Context, *MsgDeleteGasSponsorship) (*MsgDeleteGasSponsorshipResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -133,6 +197,18 @@ func (UnimplementedMsgServer) CreateNewScript(context.Context, *MsgCreateNewScri
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RegisterSnapshotQuery(context.Context, *MsgRegisterSnapshotQuery) (*MsgRegisterSnapshotQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSnapshotQuery not implemented")
+}
+func (UnimplementedMsgServer) DeleteSnapshotQuery(context.Context, *MsgDeleteSnapshotQuery) (*MsgDeleteSnapshotQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshotQuery not implemented")
+}
+func (UnimplementedMsgServer) SetGasSponsorship(context.Context, *MsgSetGasSponsorship) (*MsgSetGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGasSponsorship not implemented")
+}
+func (UnimplementedMsgServer) DeleteGasSponsorship(context.Context, *MsgDeleteGasSponsorship) (*MsgDeleteGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGasSponsorship not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -226,6 +302,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RegisterSnapshotQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSnapshotQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSnapshotQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterSnapshotQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSnapshotQuery(ctx, req.(*MsgRegisterSnapshotQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteSnapshotQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSnapshotQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSnapshotQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteSnapshotQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSnapshotQuery(ctx, req.(*MsgDeleteSnapshotQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGasSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetGasSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGasSponsorship(ctx, req.(*MsgSetGasSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteGasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteGasSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteGasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteGasSponsorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteGasSponsorship(ctx, req.(*MsgDeleteGasSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -249,6 +397,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RegisterSnapshotQuery",
+			Handler:    _Msg_RegisterSnapshotQuery_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshotQuery",
+			Handler:    _Msg_DeleteSnapshotQuery_Handler,
+		},
+		{
+			MethodName: "SetGasSponsorship",
+			Handler:    _Msg_SetGasSponsorship_Handler,
+		},
+		{
+			MethodName: "DeleteGasSponsorship",
+			Handler:    _Msg_DeleteGasSponsorship_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dysonprotocol/script/v1/tx.proto",
@@ -0,0 +1,712 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_StorageWriteAuthorization              protoreflect.MessageDescriptor
+	fd_StorageWriteAuthorization_index_prefix protoreflect.FieldDescriptor
+	fd_StorageWriteAuthorization_max_bytes    protoreflect.FieldDescriptor
+	fd_StorageWriteAuthorization_json_schema  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_dysonprotocol_storage_v1_authz_proto_init()
+	md_StorageWriteAuthorization = File_dysonprotocol_storage_v1_authz_proto.Messages().ByName("StorageWriteAuthorization")
+	fd_StorageWriteAuthorization_index_prefix = md_StorageWriteAuthorization.Fields().ByName("index_prefix")
+	fd_StorageWriteAuthorization_max_bytes = md_StorageWriteAuthorization.Fields().ByName("max_bytes")
+	fd_StorageWriteAuthorization_json_schema = md_StorageWriteAuthorization.Fields().ByName("json_schema")
+}
+
+var _ protoreflect.Message = (*fastReflection_StorageWriteAuthorization)(nil)
+
+type fastReflection_StorageWriteAuthorization StorageWriteAuthorization
+
+func (x *StorageWriteAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StorageWriteAuthorization)(x)
+}
+
+func (x *StorageWriteAuthorization) slowProtoReflect() protoreflect.Message {
+	mi := &file_dysonprotocol_storage_v1_authz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StorageWriteAuthorization_messageType fastReflection_StorageWriteAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_StorageWriteAuthorization_messageType{}
+
+type fastReflection_StorageWriteAuthorization_messageType struct{}
+
+func (x fastReflection_StorageWriteAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StorageWriteAuthorization)(nil)
+}
+func (x fastReflection_StorageWriteAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_StorageWriteAuthorization)
+}
+func (x fastReflection_StorageWriteAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageWriteAuthorization
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StorageWriteAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_StorageWriteAuthorization
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StorageWriteAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_StorageWriteAuthorization_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StorageWriteAuthorization) New() protoreflect.Message {
+	return new(fastReflection_StorageWriteAuthorization)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StorageWriteAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*StorageWriteAuthorization)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StorageWriteAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.IndexPrefix != "" {
+		value := protoreflect.ValueOfString(x.IndexPrefix)
+		if !f(fd_StorageWriteAuthorization_index_prefix, value) {
+			return
+		}
+	}
+	if x.MaxBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBytes)
+		if !f(fd_StorageWriteAuthorization_max_bytes, value) {
+			return
+		}
+	}
+	if x.JsonSchema != "" {
+		value := protoreflect.ValueOfString(x.JsonSchema)
+		if !f(fd_StorageWriteAuthorization_json_schema, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StorageWriteAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.index_prefix":
+		return x.IndexPrefix != ""
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.max_bytes":
+		return x.MaxBytes != uint64(0)
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.json_schema":
+		return x.JsonSchema != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.storage.v1.StorageWriteAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.storage.v1.StorageWriteAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageWriteAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.index_prefix":
+		x.IndexPrefix = ""
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.max_bytes":
+		x.MaxBytes = uint64(0)
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.json_schema":
+		x.JsonSchema = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.storage.v1.StorageWriteAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.storage.v1.StorageWriteAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StorageWriteAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.index_prefix":
+		value := x.IndexPrefix
+		return protoreflect.ValueOfString(value)
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.max_bytes":
+		value := x.MaxBytes
+		return protoreflect.ValueOfUint64(value)
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.json_schema":
+		value := x.JsonSchema
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.storage.v1.StorageWriteAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.storage.v1.StorageWriteAuthorization does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageWriteAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.index_prefix":
+		x.IndexPrefix = value.Interface().(string)
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.max_bytes":
+		x.MaxBytes = value.Uint()
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.json_schema":
+		x.JsonSchema = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.storage.v1.StorageWriteAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.storage.v1.StorageWriteAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageWriteAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.index_prefix":
+		panic(fmt.Errorf("field index_prefix of message dysonprotocol.storage.v1.StorageWriteAuthorization is not mutable"))
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.max_bytes":
+		panic(fmt.Errorf("field max_bytes of message dysonprotocol.storage.v1.StorageWriteAuthorization is not mutable"))
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.json_schema":
+		panic(fmt.Errorf("field json_schema of message dysonprotocol.storage.v1.StorageWriteAuthorization is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.storage.v1.StorageWriteAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.storage.v1.StorageWriteAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StorageWriteAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.index_prefix":
+		return protoreflect.ValueOfString("")
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.max_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "dysonprotocol.storage.v1.StorageWriteAuthorization.json_schema":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: dysonprotocol.storage.v1.StorageWriteAuthorization"))
+		}
+		panic(fmt.Errorf("message dysonprotocol.storage.v1.StorageWriteAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StorageWriteAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in dysonprotocol.storage.v1.StorageWriteAuthorization", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StorageWriteAuthorization) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StorageWriteAuthorization) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StorageWriteAuthorization) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StorageWriteAuthorization) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StorageWriteAuthorization)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.IndexPrefix)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBytes))
+		}
+		l = len(x.JsonSchema)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StorageWriteAuthorization)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.JsonSchema) > 0 {
+			i -= len(x.JsonSchema)
+			copy(dAtA[i:], x.JsonSchema)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.JsonSchema)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.MaxBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBytes))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.IndexPrefix) > 0 {
+			i -= len(x.IndexPrefix)
+			copy(dAtA[i:], x.IndexPrefix)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IndexPrefix)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StorageWriteAuthorization)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageWriteAuthorization: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StorageWriteAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IndexPrefix", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IndexPrefix = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBytes", wireType)
+				}
+				x.MaxBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.JsonSchema = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: dysonprotocol/storage/v1/authz.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StorageWriteAuthorization allows the grantee to set the storage entries of
+// the granter within an index prefix, for example to share a DWApp namespace
+// with a team.
+type StorageWriteAuthorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// index_prefix is the prefix of the indexes the grantee can set. Empty means
+	// every index.
+	IndexPrefix string `protobuf:"bytes,1,opt,name=index_prefix,json=indexPrefix,proto3" json:"index_prefix,omitempty"`
+	// max_bytes is the number of bytes left the grantee can write, counted as
+	// the index and content size of every set entry. It is decremented on every
+	// write and the grant is deleted when it reaches zero. Zero means no limit.
+	MaxBytes uint64 `protobuf:"varint,2,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"`
+	// json_schema is an optional JSON schema the data of the set entries must
+	// match. Blobs cannot be set when it is not empty.
+	JsonSchema string `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+}
+
+func (x *StorageWriteAuthorization) Reset() {
+	*x = StorageWriteAuthorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_dysonprotocol_storage_v1_authz_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StorageWriteAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorageWriteAuthorization) ProtoMessage() {}
+
+// Deprecated: Use StorageWriteAuthorization.ProtoReflect.Descriptor instead.
+func (*StorageWriteAuthorization) Descriptor() ([]byte, []int) {
+	return file_dysonprotocol_storage_v1_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StorageWriteAuthorization) GetIndexPrefix() string {
+	if x != nil {
+		return x.IndexPrefix
+	}
+	return ""
+}
+
+func (x *StorageWriteAuthorization) GetMaxBytes() uint64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *StorageWriteAuthorization) GetJsonSchema() string {
+	if x != nil {
+		return x.JsonSchema
+	}
+	return ""
+}
+
+var File_dysonprotocol_storage_v1_authz_proto protoreflect.FileDescriptor
+
+var file_dysonprotocol_storage_v1_authz_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xce,
+	0x01, 0x0a, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x3a, 0x50, 0xca,
+	0xb4, 0x2d, 0x22, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x25, 0x64, 0x79, 0x73, 0x2f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x57, 0x72, 0x69,
+	0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x23, 0x5a, 0x21, 0x64, 0x79, 0x73, 0x6f, 0x6e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x78, 0x2f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_dysonprotocol_storage_v1_authz_proto_rawDescOnce sync.Once
+	file_dysonprotocol_storage_v1_authz_proto_rawDescData = file_dysonprotocol_storage_v1_authz_proto_rawDesc
+)
+
+func file_dysonprotocol_storage_v1_authz_proto_rawDescGZIP() []byte {
+	file_dysonprotocol_storage_v1_authz_proto_rawDescOnce.Do(func() {
+		file_dysonprotocol_storage_v1_authz_proto_rawDescData = protoimpl.X.CompressGZIP(file_dysonprotocol_storage_v1_authz_proto_rawDescData)
+	})
+	return file_dysonprotocol_storage_v1_authz_proto_rawDescData
+}
+
+var file_dysonprotocol_storage_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_dysonprotocol_storage_v1_authz_proto_goTypes = []interface{}{
+	(*StorageWriteAuthorization)(nil), // 0: dysonprotocol.storage.v1.StorageWriteAuthorization
+}
+var file_dysonprotocol_storage_v1_authz_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_dysonprotocol_storage_v1_authz_proto_init() }
+func file_dysonprotocol_storage_v1_authz_proto_init() {
+	if File_dysonprotocol_storage_v1_authz_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_dysonprotocol_storage_v1_authz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StorageWriteAuthorization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_dysonprotocol_storage_v1_authz_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_dysonprotocol_storage_v1_authz_proto_goTypes,
+		DependencyIndexes: file_dysonprotocol_storage_v1_authz_proto_depIdxs,
+		MessageInfos:      file_dysonprotocol_storage_v1_authz_proto_msgTypes,
+	}.Build()
+	File_dysonprotocol_storage_v1_authz_proto = out.File
+	file_dysonprotocol_storage_v1_authz_proto_rawDesc = nil
+	file_dysonprotocol_storage_v1_authz_proto_goTypes = nil
+	file_dysonprotocol_storage_v1_authz_proto_depIdxs = nil
+}
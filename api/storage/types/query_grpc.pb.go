@@ -19,8 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_StorageGet_FullMethodName  = "/dysonprotocol.storage.v1.Query/StorageGet"
-	Query_StorageList_FullMethodName = "/dysonprotocol.storage.v1.Query/StorageList"
+	Query_StorageGet_FullMethodName              = "/dysonprotocol.storage.v1.Query/StorageGet"
+	Query_StorageList_FullMethodName             = "/dysonprotocol.storage.v1.Query/StorageList"
+	Query_StorageUsage_FullMethodName            = "/dysonprotocol.storage.v1.Query/StorageUsage"
+	Query_StorageUpload_FullMethodName           = "/dysonprotocol.storage.v1.Query/StorageUpload"
+	Query_StorageHistory_FullMethodName          = "/dysonprotocol.storage.v1.Query/StorageHistory"
+	Query_StorageSecondaryIndexes_FullMethodName = "/dysonprotocol.storage.v1.Query/StorageSecondaryIndexes"
+	Query_StorageFind_FullMethodName             = "/dysonprotocol.storage.v1.Query/StorageFind"
+	Query_Params_FullMethodName                  = "/dysonprotocol.storage.v1.Query/Params"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +39,21 @@ type QueryClient interface {
 	// filter and extract to filter and extract the data and iterate over the data
 	// efficiently.
 	StorageList(ctx context.Context, in *QueryStorageListRequest, opts ...grpc.CallOption) (*QueryStorageListResponse, error)
+	// Returns the bytes used, the number of entries and the deposit held for an
+	// owner.
+	StorageUsage(ctx context.Context, in *QueryStorageUsageRequest, opts ...grpc.CallOption) (*QueryStorageUsageResponse, error)
+	// Returns a chunked upload in progress and the chunks it is missing.
+	StorageUpload(ctx context.Context, in *QueryStorageUploadRequest, opts ...grpc.CallOption) (*QueryStorageUploadResponse, error)
+	// Returns the retained previous versions of a storage entry in version
+	// order, newest first with a reverse pagination.
+	StorageHistory(ctx context.Context, in *QueryStorageHistoryRequest, opts ...grpc.CallOption) (*QueryStorageHistoryResponse, error)
+	// Returns the secondary indexes of an owner.
+	StorageSecondaryIndexes(ctx context.Context, in *QueryStorageSecondaryIndexesRequest, opts ...grpc.CallOption) (*QueryStorageSecondaryIndexesResponse, error)
+	// Finds the entries of an owner through a secondary index, by the exact
+	// value or a range of values at its path, in value order.
+	StorageFind(ctx context.Context, in *QueryStorageFindRequest, opts ...grpc.CallOption) (*QueryStorageFindResponse, error)
+	// Params queries the parameters of the storage module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -63,6 +84,66 @@ func (c *queryClient) StorageList(ctx context.Context, in *QueryStorageListReque
 	return out, nil
 }
 
+func (c *queryClient) StorageUsage(ctx context.Context, in *QueryStorageUsageRequest, opts ...grpc.CallOption) (*QueryStorageUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStorageUsageResponse)
+	err := c.cc.Invoke(ctx, Query_StorageUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageUpload(ctx context.Context, in *QueryStorageUploadRequest, opts ...grpc.CallOption) (*QueryStorageUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStorageUploadResponse)
+	err := c.cc.Invoke(ctx, Query_StorageUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageHistory(ctx context.Context, in *QueryStorageHistoryRequest, opts ...grpc.CallOption) (*QueryStorageHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStorageHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_StorageHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageSecondaryIndexes(ctx context.Context, in *QueryStorageSecondaryIndexesRequest, opts ...grpc.CallOption) (*QueryStorageSecondaryIndexesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStorageSecondaryIndexesResponse)
+	err := c.cc.Invoke(ctx, Query_StorageSecondaryIndexes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageFind(ctx context.Context, in *QueryStorageFindRequest, opts ...grpc.CallOption) (*QueryStorageFindResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryStorageFindResponse)
+	err := c.cc.Invoke(ctx, Query_StorageFind_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -73,6 +154,21 @@ type QueryServer interface {
 	// filter and extract to filter and extract the data and iterate over the data
 	// efficiently.
 	StorageList(context.Context, *QueryStorageListRequest) (*QueryStorageListResponse, error)
+	// Returns the bytes used, the number of entries and the deposit held for an
+	// owner.
+	StorageUsage(context.Context, *QueryStorageUsageRequest) (*QueryStorageUsageResponse, error)
+	// Returns a chunked upload in progress and the chunks it is missing.
+	StorageUpload(context.Context, *QueryStorageUploadRequest) (*QueryStorageUploadResponse, error)
+	// Returns the retained previous versions of a storage entry in version
+	// order, newest first with a reverse pagination.
+	StorageHistory(context.Context, *QueryStorageHistoryRequest) (*QueryStorageHistoryResponse, error)
+	// Returns the secondary indexes of an owner.
+	StorageSecondaryIndexes(context.Context, *QueryStorageSecondaryIndexesRequest) (*QueryStorageSecondaryIndexesResponse, error)
+	// Finds the entries of an owner through a secondary index, by the exact
+	// value or a range of values at its path, in value order.
+	StorageFind(context.Context, *QueryStorageFindRequest) (*QueryStorageFindResponse, error)
+	// Params queries the parameters of the storage module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -89,6 +185,24 @@ func (UnimplementedQueryServer) StorageGet(context.Context, *QueryStorageGetRequ
 func (UnimplementedQueryServer) StorageList(context.Context, *QueryStorageListRequest) (*QueryStorageListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageList not implemented")
 }
+func (UnimplementedQueryServer) StorageUsage(context.Context, *QueryStorageUsageRequest) (*QueryStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUsage not implemented")
+}
+func (UnimplementedQueryServer) StorageUpload(context.Context, *QueryStorageUploadRequest) (*QueryStorageUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUpload not implemented")
+}
+func (UnimplementedQueryServer) StorageHistory(context.Context, *QueryStorageHistoryRequest) (*QueryStorageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageHistory not implemented")
+}
+func (UnimplementedQueryServer) StorageSecondaryIndexes(context.Context, *QueryStorageSecondaryIndexesRequest) (*QueryStorageSecondaryIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageSecondaryIndexes not implemented")
+}
+func (UnimplementedQueryServer) StorageFind(context.Context, *QueryStorageFindRequest) (*QueryStorageFindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageFind not implemented")
+}
+func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -146,6 +260,114 @@ func _Query_StorageList_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageUsage(ctx, req.(*QueryStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageUpload(ctx, req.(*QueryStorageUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageHistory(ctx, req.(*QueryStorageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageSecondaryIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageSecondaryIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageSecondaryIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageSecondaryIndexes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageSecondaryIndexes(ctx, req.(*QueryStorageSecondaryIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageFindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StorageFind_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageFind(ctx, req.(*QueryStorageFindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Params_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +383,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StorageList",
 			Handler:    _Query_StorageList_Handler,
 		},
+		{
+			MethodName: "StorageUsage",
+			Handler:    _Query_StorageUsage_Handler,
+		},
+		{
+			MethodName: "StorageUpload",
+			Handler:    _Query_StorageUpload_Handler,
+		},
+		{
+			MethodName: "StorageHistory",
+			Handler:    _Query_StorageHistory_Handler,
+		},
+		{
+			MethodName: "StorageSecondaryIndexes",
+			Handler:    _Query_StorageSecondaryIndexes_Handler,
+		},
+		{
+			MethodName: "StorageFind",
+			Handler:    _Query_StorageFind_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dysonprotocol/storage/v1/query.proto",
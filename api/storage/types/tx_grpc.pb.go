@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_StorageSet_FullMethodName    = "/dysonprotocol.storage.v1.Msg/StorageSet"
-	Msg_StorageDelete_FullMethodName = "/dysonprotocol.storage.v1.Msg/StorageDelete"
+	Msg_StorageSet_FullMethodName                  = "/dysonprotocol.storage.v1.Msg/StorageSet"
+	Msg_StorageDelete_FullMethodName               = "/dysonprotocol.storage.v1.Msg/StorageDelete"
+	Msg_StorageUploadBegin_FullMethodName          = "/dysonprotocol.storage.v1.Msg/StorageUploadBegin"
+	Msg_StorageUploadChunk_FullMethodName          = "/dysonprotocol.storage.v1.Msg/StorageUploadChunk"
+	Msg_StorageUploadCommit_FullMethodName         = "/dysonprotocol.storage.v1.Msg/StorageUploadCommit"
+	Msg_StorageUploadCancel_FullMethodName         = "/dysonprotocol.storage.v1.Msg/StorageUploadCancel"
+	Msg_StorageCreateSecondaryIndex_FullMethodName = "/dysonprotocol.storage.v1.Msg/StorageCreateSecondaryIndex"
+	Msg_StorageDeleteSecondaryIndex_FullMethodName = "/dysonprotocol.storage.v1.Msg/StorageDeleteSecondaryIndex"
+	Msg_UpdateParams_FullMethodName                = "/dysonprotocol.storage.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -33,6 +40,22 @@ type MsgClient interface {
 	StorageSet(ctx context.Context, in *MsgStorageSet, opts ...grpc.CallOption) (*MsgStorageSetResponse, error)
 	// Deletes storage entries. Only the owner can delete.
 	StorageDelete(ctx context.Context, in *MsgStorageDelete, opts ...grpc.CallOption) (*MsgStorageDeleteResponse, error)
+	// Begins a chunked upload of a large entry from its manifest.
+	StorageUploadBegin(ctx context.Context, in *MsgStorageUploadBegin, opts ...grpc.CallOption) (*MsgStorageUploadBeginResponse, error)
+	// Uploads one chunk of a chunked upload.
+	StorageUploadChunk(ctx context.Context, in *MsgStorageUploadChunk, opts ...grpc.CallOption) (*MsgStorageUploadChunkResponse, error)
+	// Commits a chunked upload once all its chunks are uploaded.
+	StorageUploadCommit(ctx context.Context, in *MsgStorageUploadCommit, opts ...grpc.CallOption) (*MsgStorageUploadCommitResponse, error)
+	// Cancels a chunked upload and refunds its deposit.
+	StorageUploadCancel(ctx context.Context, in *MsgStorageUploadCancel, opts ...grpc.CallOption) (*MsgStorageUploadCancelResponse, error)
+	// Creates a secondary index of the entries of the owner, indexing its
+	// existing entries.
+	StorageCreateSecondaryIndex(ctx context.Context, in *MsgStorageCreateSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageCreateSecondaryIndexResponse, error)
+	// Deletes a secondary index of the owner.
+	StorageDeleteSecondaryIndex(ctx context.Context, in *MsgStorageDeleteSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageDeleteSecondaryIndexResponse, error)
+	// UpdateParams defines a governance operation for updating the x/storage
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -63,6 +86,76 @@ func (c *msgClient) StorageDelete(ctx context.Context, in *MsgStorageDelete, opt
 	return out, nil
 }
 
+func (c *msgClient) StorageUploadBegin(ctx context.Context, in *MsgStorageUploadBegin, opts ...grpc.CallOption) (*MsgStorageUploadBeginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStorageUploadBeginResponse)
+	err := c.cc.Invoke(ctx, Msg_StorageUploadBegin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageUploadChunk(ctx context.Context, in *MsgStorageUploadChunk, opts ...grpc.CallOption) (*MsgStorageUploadChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStorageUploadChunkResponse)
+	err := c.cc.Invoke(ctx, Msg_StorageUploadChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageUploadCommit(ctx context.Context, in *MsgStorageUploadCommit, opts ...grpc.CallOption) (*MsgStorageUploadCommitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStorageUploadCommitResponse)
+	err := c.cc.Invoke(ctx, Msg_StorageUploadCommit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageUploadCancel(ctx context.Context, in *MsgStorageUploadCancel, opts ...grpc.CallOption) (*MsgStorageUploadCancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStorageUploadCancelResponse)
+	err := c.cc.Invoke(ctx, Msg_StorageUploadCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageCreateSecondaryIndex(ctx context.Context, in *MsgStorageCreateSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageCreateSecondaryIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStorageCreateSecondaryIndexResponse)
+	err := c.cc.Invoke(ctx, Msg_StorageCreateSecondaryIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageDeleteSecondaryIndex(ctx context.Context, in *MsgStorageDeleteSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageDeleteSecondaryIndexResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgStorageDeleteSecondaryIndexResponse)
+	err := c.cc.Invoke(ctx, Msg_StorageDeleteSecondaryIndex_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -73,6 +166,22 @@ type MsgServer interface {
 	StorageSet(context.Context, *MsgStorageSet) (*MsgStorageSetResponse, error)
 	// Deletes storage entries. Only the owner can delete.
 	StorageDelete(context.Context, *MsgStorageDelete) (*MsgStorageDeleteResponse, error)
+	// Begins a chunked upload of a large entry from its manifest.
+	StorageUploadBegin(context.Context, *MsgStorageUploadBegin) (*MsgStorageUploadBeginResponse, error)
+	// Uploads one chunk of a chunked upload.
+	StorageUploadChunk(context.Context, *MsgStorageUploadChunk) (*MsgStorageUploadChunkResponse, error)
+	// Commits a chunked upload once all its chunks are uploaded.
+	StorageUploadCommit(context.Context, *MsgStorageUploadCommit) (*MsgStorageUploadCommitResponse, error)
+	// Cancels a chunked upload and refunds its deposit.
+	StorageUploadCancel(context.Context, *MsgStorageUploadCancel) (*MsgStorageUploadCancelResponse, error)
+	// Creates a secondary index of the entries of the owner, indexing its
+	// existing entries.
+	StorageCreateSecondaryIndex(context.Context, *MsgStorageCreateSecondaryIndex) (*MsgStorageCreateSecondaryIndexResponse, error)
+	// Deletes a secondary index of the owner.
+	StorageDeleteSecondaryIndex(context.Context, *MsgStorageDeleteSecondaryIndex) (*MsgStorageDeleteSecondaryIndexResponse, error)
+	// UpdateParams defines a governance operation for updating the x/storage
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -89,6 +198,27 @@ func (UnimplementedMsgServer) StorageSet(context.Context, *MsgStorageSet) (*MsgS
 func (UnimplementedMsgServer) StorageDelete(context.Context, *MsgStorageDelete) (*MsgStorageDeleteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StorageDelete not implemented")
 }
+func (UnimplementedMsgServer) StorageUploadBegin(context.Context, *MsgStorageUploadBegin) (*MsgStorageUploadBeginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadBegin not implemented")
+}
+func (UnimplementedMsgServer) StorageUploadChunk(context.Context, *MsgStorageUploadChunk) (*MsgStorageUploadChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadChunk not implemented")
+}
+func (UnimplementedMsgServer) StorageUploadCommit(context.Context, *MsgStorageUploadCommit) (*MsgStorageUploadCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadCommit not implemented")
+}
+func (UnimplementedMsgServer) StorageUploadCancel(context.Context, *MsgStorageUploadCancel) (*MsgStorageUploadCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadCancel not implemented")
+}
+func (UnimplementedMsgServer) StorageCreateSecondaryIndex(context.Context, *MsgStorageCreateSecondaryIndex) (*MsgStorageCreateSecondaryIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageCreateSecondaryIndex not implemented")
+}
+func (UnimplementedMsgServer) StorageDeleteSecondaryIndex(context.Context, *MsgStorageDeleteSecondaryIndex) (*MsgStorageDeleteSecondaryIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageDeleteSecondaryIndex not implemented")
+}
+func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -146,6 +276,132 @@ func _Msg_StorageDelete_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StorageUploadBegin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadBegin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadBegin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StorageUploadBegin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadBegin(ctx, req.(*MsgStorageUploadBegin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageUploadChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StorageUploadChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadChunk(ctx, req.(*MsgStorageUploadChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageUploadCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadCommit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StorageUploadCommit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadCommit(ctx, req.(*MsgStorageUploadCommit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageUploadCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StorageUploadCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadCancel(ctx, req.(*MsgStorageUploadCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageCreateSecondaryIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageCreateSecondaryIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageCreateSecondaryIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StorageCreateSecondaryIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageCreateSecondaryIndex(ctx, req.(*MsgStorageCreateSecondaryIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageDeleteSecondaryIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageDeleteSecondaryIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageDeleteSecondaryIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_StorageDeleteSecondaryIndex_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageDeleteSecondaryIndex(ctx, req.(*MsgStorageDeleteSecondaryIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -161,6 +417,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StorageDelete",
 			Handler:    _Msg_StorageDelete_Handler,
 		},
+		{
+			MethodName: "StorageUploadBegin",
+			Handler:    _Msg_StorageUploadBegin_Handler,
+		},
+		{
+			MethodName: "StorageUploadChunk",
+			Handler:    _Msg_StorageUploadChunk_Handler,
+		},
+		{
+			MethodName: "StorageUploadCommit",
+			Handler:    _Msg_StorageUploadCommit_Handler,
+		},
+		{
+			MethodName: "StorageUploadCancel",
+			Handler:    _Msg_StorageUploadCancel_Handler,
+		},
+		{
+			MethodName: "StorageCreateSecondaryIndex",
+			Handler:    _Msg_StorageCreateSecondaryIndex_Handler,
+		},
+		{
+			MethodName: "StorageDeleteSecondaryIndex",
+			Handler:    _Msg_StorageDeleteSecondaryIndex_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dysonprotocol/storage/v1/tx.proto",
@@ -682,23 +682,28 @@ func NewDysApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.StorageKeeper = storagekeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[storagev1.StoreKey]),
+		appCodec,
+		app.AccountKeeper,
+		app.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		storagev1.DefaultConfig(),
+	)
+
 	app.CrontaskKeeper = crontaskkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[crontaskv1.StoreKey]),
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.AuthzKeeper,
+		app.StorageKeeper,
+		app.NameserviceKeeper,
 		app.MsgServiceRouter(),
 		*crontaskv1.DefaultConfig(),
 		logger,
 	)
 
-	app.StorageKeeper = storagekeeper.NewKeeper(
-		runtime.NewKVStoreService(keys[storagev1.StoreKey]),
-		appCodec,
-		app.AccountKeeper,
-		storagev1.DefaultConfig(),
-	)
-
 	/****  Module Options ****/
 
 	// NOTE: Any module instantiated in the module manager that is later modified
@@ -728,7 +733,7 @@ func NewDysApp(
 		nameservicemodule.NewAppModule(appCodec, app.NameserviceKeeper, app.interfaceRegistry),
 		scriptmodule.NewAppModule(appCodec, app.ScriptKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		storagemodule.NewAppModule(appCodec, app.StorageKeeper, app.AccountKeeper, app.interfaceRegistry),
-		crontaskmodule.NewAppModule(appCodec, app.CrontaskKeeper, app.AccountKeeper, app.interfaceRegistry),
+		crontaskmodule.NewAppModule(appCodec, app.CrontaskKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
@@ -947,6 +952,7 @@ func (app *DysApp) setAnteHandler(txConfig client.TxConfig) {
 				},
 			},
 			&app.CircuitKeeper,
+			app.ScriptKeeper,
 		},
 	)
 	if err != nil {
@@ -289,6 +289,7 @@ func (app *DysApp) setAnteHandler(txConfig client.TxConfig) {
 		HandlerOptions{
 			handlerOpts,
 			&app.CircuitKeeper,
+			app.ScriptKeeper,
 		},
 	)
 	if err != nil {
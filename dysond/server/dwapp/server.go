@@ -170,6 +170,7 @@ func New(
 		"pattern", srv.config.ScriptAddressOrNamePattern)
 
 	srv.router.Handle("/", NewDefaultHandler(clientCtx, srv.config.ScriptAddressOrNamePattern))
+	srv.router.Handle(StoragePathPrefix, NewStorageHandler(clientCtx, srv.config.ScriptAddressOrNamePattern))
 	// Pass the server to APIHandler
 	APIHandler(srv.router, srv)
 
@@ -0,0 +1,191 @@
+package dwapp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"cosmossdk.io/x/nft"
+
+	nameservicekeeper "dysonprotocol.com/x/nameservice/keeper"
+	storagetypes "dysonprotocol.com/x/storage/types"
+)
+
+const (
+	// StoragePathPrefix is the path under which the storage entries of the host owner are served
+	StoragePathPrefix = "/_/storage/"
+
+	// storageReadSize is the size in bytes of the ranges read from the chain while streaming an entry
+	storageReadSize = 64 * 1024
+)
+
+// NewStorageHandler returns a handler streaming the storage entries of the address or name of
+// the host. GET /_/storage/<index> serves the entry at <index>, with its content type, and
+// supports range and conditional requests.
+func NewStorageHandler(clientCtx client.Context, ScriptAddressOrNamePattern string) http.Handler {
+	return &StorageHandler{
+		clientCtx:             clientCtx,
+		scriptAddressOrNameRe: regexp.MustCompile(ScriptAddressOrNamePattern),
+	}
+}
+
+type StorageHandler struct {
+	clientCtx             client.Context
+	scriptAddressOrNameRe *regexp.Regexp
+}
+
+func (h *StorageHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// the first group matched by the pattern is the address or the name
+	addressOrName := ""
+	match := h.scriptAddressOrNameRe.FindStringSubmatch(req.Host)
+	for i := 1; i < len(match) && addressOrName == ""; i++ {
+		addressOrName = match[i]
+	}
+	if addressOrName == "" {
+		http.Error(w, fmt.Sprintf("No address found for host: `%s`", req.Host), http.StatusNotFound)
+		return
+	}
+
+	owner, err := h.resolveOwner(req.Context(), addressOrName)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	index := strings.TrimPrefix(req.URL.Path, StoragePathPrefix)
+	reader, entry, err := newStorageReader(req.Context(), h.clientCtx, owner, index)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Error querying storage: %v", err), http.StatusNotFound)
+		return
+	}
+
+	if entry.ContentType != "" {
+		w.Header().Set("Content-Type", entry.ContentType)
+	}
+	w.Header().Set("ETag", fmt.Sprintf("%q", entry.Hash))
+
+	modTime, _ := time.Parse(time.RFC3339, entry.UpdatedTimestamp)
+	http.ServeContent(w, req, index, modTime, reader)
+}
+
+// resolveOwner returns the address of a bech32 address or of the destination of a .dys name
+func (h *StorageHandler) resolveOwner(ctx context.Context, addressOrName string) (string, error) {
+	if !strings.HasSuffix(addressOrName, ".dys") {
+		return addressOrName, nil
+	}
+
+	resp := &nft.QueryNFTResponse{}
+	err := h.clientCtx.Invoke(ctx, "/cosmos.nft.v1beta1.Query/NFT", &nft.QueryNFTRequest{
+		ClassId: nameservicekeeper.NamesClassID,
+		Id:      addressOrName,
+	}, resp)
+	if err != nil || resp.Nft == nil || resp.Nft.Uri == "" {
+		return "", fmt.Errorf("name not found: %s", addressOrName)
+	}
+	return resp.Nft.Uri, nil
+}
+
+// storageReader is an io.ReadSeeker over the content of a storage entry. The content is read
+// from the chain in ranges, all at the height of the first read so that they are consistent.
+type storageReader struct {
+	ctx       context.Context
+	clientCtx client.Context
+	owner     string
+	index     string
+	height    string
+	size      int64
+	offset    int64
+}
+
+// newStorageReader queries the metadata of a storage entry and returns a reader over its content
+func newStorageReader(ctx context.Context, clientCtx client.Context, owner, index string) (*storageReader, *storagetypes.Storage, error) {
+	r := &storageReader{ctx: ctx, clientCtx: clientCtx, owner: owner, index: index}
+
+	// A one byte range returns the entry metadata and size without its content
+	var header metadata.MD
+	resp, err := r.query(0, 1, grpc.Header(&header))
+	if err != nil {
+		return nil, nil, err
+	}
+	if heights := header.Get(grpctypes.GRPCBlockHeightHeader); len(heights) > 0 {
+		r.height = heights[0]
+	}
+	r.size = int64(resp.Size_)
+	return r, resp.Entry, nil
+}
+
+// query returns a range of the content of the entry
+func (r *storageReader) query(offset, length uint64, opts ...grpc.CallOption) (*storagetypes.QueryStorageGetResponse, error) {
+	ctx := r.ctx
+	if r.height != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, r.height)
+	}
+
+	resp := &storagetypes.QueryStorageGetResponse{}
+	err := r.clientCtx.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageGet", &storagetypes.QueryStorageGetRequest{
+		Owner:  r.owner,
+		Index:  r.index,
+		Offset: offset,
+		Length: length,
+	}, resp, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Entry == nil {
+		return nil, errors.New("storage entry not found")
+	}
+	return resp, nil
+}
+
+// Read implements io.Reader
+func (r *storageReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+
+	length := min(int64(len(p)), r.size-r.offset, storageReadSize)
+	resp, err := r.query(uint64(r.offset), uint64(length))
+	if err != nil {
+		return 0, err
+	}
+
+	n := copy(p, resp.Entry.Content())
+	if n == 0 {
+		return 0, io.ErrUnexpectedEOF
+	}
+	r.offset += int64(n)
+	return n, nil
+}
+
+// Seek implements io.Seeker
+func (r *storageReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.offset
+	case io.SeekEnd:
+		offset += r.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	r.offset = offset
+	return offset, nil
+}
@@ -48,7 +48,13 @@ func RegisterDysonServer(clientCtx client.Context, rtr *mux.Router, config confi
 		// Middleware to check path condition explicitly
 		rtr.Use(func(next http.Handler) http.Handler {
 			dwappHandler := dwapp.NewDefaultHandler(clientCtx, patternString)
+			storageHandler := dwapp.NewStorageHandler(clientCtx, patternString)
 			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if strings.HasPrefix(r.URL.Path, dwapp.StoragePathPrefix) {
+					// Stream the storage entries of the host owner
+					storageHandler.ServeHTTP(w, r)
+					return
+				}
 				if !(strings.HasPrefix(r.URL.Path, "/dysonprotocol/") ||
 					strings.HasPrefix(r.URL.Path, "/cosmos/") ||
 					strings.HasPrefix(r.URL.Path, "/ibc/") ||
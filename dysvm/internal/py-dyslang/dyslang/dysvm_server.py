@@ -601,6 +601,33 @@ def build_sandbox(
         return resp['result']
         
     
+    @allow_dys_func
+    def _msg_batch(msgs, mode="atomic", gas_limit=0):
+        """
+        Dispatch several messages as a batch and report the outcome of each one.
+
+        In "atomic" mode the state changes are kept only if every message succeeds;
+        execution stops at the first failure. In "best_effort" mode every message is
+        tried and the state changes of the successful ones are kept.
+
+        A failing message does not raise, check the per-message results instead.
+
+        :param msgs: A list of message dictionaries, each with an "@type" field
+        :param mode: "atomic" or "best_effort"
+        :param gas_limit: Gas limit shared by all messages, 0 uses the remaining gas
+        :returns: A dict with mode, success, gas_used and results, where each result has
+            index, type_url, success, executed, response, error and gas_used
+        """
+        resp = _chain(
+            "MsgBatch",
+            json_msgs=[json.dumps(msg) for msg in msgs],
+            mode=mode,
+            gas_limit=gas_limit,
+        )
+        if resp.get('exception'):
+            raise Exception(resp['exception'])
+        return resp['result']
+
     @allow_dys_func
     def _query(params, query_height=None):
         """
@@ -616,6 +643,89 @@ def build_sandbox(
         return resp['result']
         
 
+    @allow_dys_func
+    def interchain_query(
+        queries,
+        function_name,
+        connection_id="connection-0",
+        kwargs=None,
+        args=None,
+        relative_timeout=600_000_000_000,
+    ):
+        """
+        Sends an asynchronous interchain query through this script's interchain account.
+
+        The queries run on the host chain inside a single MsgModuleQuerySafe. Once the
+        acknowledgement is relayed back, `function_name` on this script is called with
+        `*args`, `**kwargs` and a `beta_ibc_callback_data_v1` kwarg whose `query_results`
+        lists each query's `path`, `request` and decoded `response`, and whose
+        `proof_height` is the host chain height the responses were read at.
+
+        The interchain account must already be registered with proto3json encoding.
+
+        :param queries: list of query requests with an "@type", e.g.
+            {"@type": "/cosmos.bank.v1beta1.QueryAllBalancesRequest", "address": ...},
+            or dicts of the form {"path": ..., "request": {...}} for non-standard paths
+        :param function_name: the function on this script that receives the results
+        :param connection_id: the IBC connection of the interchain account
+        :param relative_timeout: packet timeout in nanoseconds
+        :returns: dict with the interchain account "host_address" and the "tx_result" of MsgSendTx
+        """
+        ica_info = _query({
+            "@type": "/ibc.applications.interchain_accounts.controller.v1.QueryInterchainAccountRequest",
+            "owner": get_script_address(),
+            "connection_id": connection_id,
+        })
+        host_address = ica_info["address"]
+
+        requests_list = []
+        for query in queries:
+            if "path" in query:
+                path = query["path"]
+                request = query["request"]
+            else:
+                request = query
+                package, _, name = request["@type"].lstrip("/").rpartition(".")
+                if not (name.startswith("Query") and name.endswith("Request")):
+                    raise ValueError(
+                        f"cannot derive a query path from {request['@type']}, pass 'path' explicitly"
+                    )
+                path = f"/{package}.Query/{name[len('Query'):-len('Request')]}"
+            encoded = _query({
+                "@type": "/dysonprotocol.script.v1.QueryEncodeJsonRequest",
+                "json": json.dumps(request),
+            })
+            requests_list.append({"path": path, "data": encoded["bytes"]})
+
+        module_query_safe = {
+            "@type": "/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe",
+            "signer": host_address,
+            "requests": requests_list,
+        }
+
+        tx_result = _msg({
+            "@type": "/ibc.applications.interchain_accounts.controller.v1.MsgSendTx",
+            "owner": get_script_address(),
+            "connection_id": connection_id,
+            "packet_data": {
+                "type": "TYPE_EXECUTE_TX",
+                "data": base64.b64encode(
+                    json.dumps({"messages": [module_query_safe]}).encode()
+                ).decode(),
+                "memo": json.dumps({
+                    "src_callback": {
+                        "address": get_script_address(),
+                        "function_name": function_name,
+                        "kwargs": kwargs or {},
+                        "args": args or [],
+                    }
+                }),
+            },
+            "relative_timeout": relative_timeout,
+        })
+
+        return {"host_address": host_address, "tx_result": tx_result}
+
     @allow_dys_func
     def deprecated_chain(method, **params):
         """
@@ -646,7 +756,9 @@ def build_sandbox(
         "list_functions": list_functions,
         "list_modules": list_modules,
         "_msg": _msg,
+        "_msg_batch": _msg_batch,
         "_query": _query,
+        "interchain_query": interchain_query,
         "_chain": deprecated_chain,
     }
 
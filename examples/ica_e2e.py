@@ -1,4 +1,4 @@
-from dys import _msg, _query, get_script_address, emit_event, get_executor_address, get_block_info
+from dys import _msg, _query, interchain_query, get_script_address, emit_event, get_executor_address, get_block_info
 import json
 import base64
 
@@ -147,7 +147,9 @@ def request_query_balance(connection_id="connection-0"):
     """
     Query balance of the registered ICA address on the host chain.
     
-    Sends a MsgSendTx + MsgModuleQuerySafe + cosmos.bank.v1beta1.Query/AllBalances
+    Uses dys.interchain_query to send a MsgModuleQuerySafe with
+    cosmos.bank.v1beta1.Query/AllBalances; the result is delivered to ibc_callback
+    together with the host chain height it was read at.
     
     Args:
         connection_id: The IBC connection ID to use
@@ -167,51 +169,25 @@ def request_query_balance(connection_id="connection-0"):
         
         print(f"Starting balance query for {target_address}")
         
-        # Construct the request to query all balances
-        balance_query_encoded = encode_json({
-            "@type": "/cosmos.bank.v1beta1.QueryAllBalancesRequest",
-            "address": target_address,
-        })
-        
-        # Construct the ModuleQuerySafe message
-        module_query_safe = {
-            "@type": "/ibc.applications.interchain_accounts.host.v1.MsgModuleQuerySafe",
-            "signer": target_address,
-            "requests": [
-                {
-                    "path": "/cosmos.bank.v1beta1.Query/AllBalances",
-                    "data": balance_query_encoded["bytes"],
-                }
-            ]
-        }
-        
         # Get block info for sequence tracking
         block_info = get_block_info()
         sequence = block_info["height"]
         
-        # Send the ICA transaction with callback
-        tx_result = _msg({
-            "@type": "/ibc.applications.interchain_accounts.controller.v1.MsgSendTx",
-            "owner": get_script_address(),
-            "connection_id": connection_id,
-            "packet_data": {
-                "type": "TYPE_EXECUTE_TX",
-                "data": base64.b64encode(json.dumps({"messages": [module_query_safe]}).encode()).decode(),
-                "memo": json.dumps({
-                    "src_callback": {
-                        "address": get_script_address(), 
-                        "function_name": "ibc_callback", 
-                        "kwargs": {
-                            "topic": "balance_query",
-                            "sequence": sequence,
-                            "target_address": target_address
-                        },
-                        "args": []
-                    }
-                })
+        # Send the interchain query with callback
+        icq_result = interchain_query(
+            [{
+                "@type": "/cosmos.bank.v1beta1.QueryAllBalancesRequest",
+                "address": target_address,
+            }],
+            "ibc_callback",
+            connection_id=connection_id,
+            kwargs={
+                "topic": "balance_query",
+                "sequence": sequence,
+                "target_address": target_address
             },
-            "relative_timeout": 10000000000  # 10 seconds in nanoseconds
-        })
+            relative_timeout=10000000000  # 10 seconds in nanoseconds
+        )
         
         print(f"Balance query sent for {target_address}, sequence: {sequence}")
         emit_event("balance_query_requested", str(sequence))
@@ -220,7 +196,7 @@ def request_query_balance(connection_id="connection-0"):
             "status": "success",
             "sequence": sequence,
             "target_address": target_address,
-            "tx_result": tx_result
+            "tx_result": icq_result["tx_result"]
         }
         
     except Exception as e:
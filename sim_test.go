@@ -27,12 +27,15 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
 )
 
 var FlagEnableStreamingValue bool
@@ -43,6 +46,33 @@ func init() {
 	flag.BoolVar(&FlagEnableStreamingValue, "EnableStreaming", false, "Enable streaming service")
 }
 
+// simBondDenom is the bond denom of the simulated chain. Simulation accounts are funded in the
+// bond denom, dys is the bond denom of the chain and the only denom accepted for crontask fees.
+const simBondDenom = crontasktypes.FeeDenom
+
+// bondDenomSimulation sets the bond denom of the randomized genesis states. It comes first in
+// the genesis simulation manager as the modules read the denom from the shared state.
+type bondDenomSimulation struct {
+	denom string
+}
+
+func (b bondDenomSimulation) GenerateGenesisState(simState *module.SimulationState) {
+	simState.BondDenom = b.denom
+}
+
+func (bondDenomSimulation) RegisterStoreDecoder(simtypes.StoreDecoderRegistry) {}
+
+func (bondDenomSimulation) WeightedOperations(module.SimulationState) []simtypes.WeightedOperation {
+	return nil
+}
+
+// genesisSimulationManager returns the simulation manager of the app generating its genesis
+// states in simBondDenom
+func genesisSimulationManager(app *SimApp) *module.SimulationManager {
+	modules := append([]module.AppModuleSimulation{bondDenomSimulation{denom: simBondDenom}}, app.SimulationManager().Modules...)
+	return module.NewSimulationManager(modules...)
+}
+
 // interBlockCacheOpt returns a BaseApp option function that sets the persistent
 // inter-block write-through cache.
 func interBlockCacheOpt() func(*baseapp.BaseApp) {
@@ -56,7 +86,7 @@ func TestFullAppSimulation(t *testing.T) {
 func setupStateFactory(app *SimApp) simtestutil.SimStateFactory {
 	return simtestutil.SimStateFactory{
 		Codec:         app.AppCodec(),
-		AppStateFn:    simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		AppStateFn:    simtestutil.AppStateFn(app.AppCodec(), genesisSimulationManager(app), app.DefaultGenesis()),
 		BlockedAddr:   BlockedAddresses(),
 		AccountSource: app.AccountKeeper,
 		BalanceSource: app.BankKeeper,
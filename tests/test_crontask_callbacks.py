@@ -0,0 +1,138 @@
+import json
+from test_script import get_script_address_from_create_result
+from tests.utils import task_id_from_result, wait_for_task_status
+
+CALLBACK_SCRIPT = '''
+import json
+from dys import get_script_address, _msg
+
+def on_finished(task_id, status, results, gas_used, error_log):
+    _msg({
+        "@type": "/dysonprotocol.storage.v1.MsgStorageSet",
+        "owner": get_script_address(),
+        "index": "callback-" + str(task_id),
+        "data": json.dumps({"status": status, "results": len(results), "gas_used": gas_used, "error_log": error_log})
+    })
+
+def on_finished_failing(**kwargs):
+    raise Exception("callback failed on purpose")
+'''
+
+
+def _create_callback_script(dysond_bin, name):
+    result = dysond_bin("tx", "script", "create-new-script", "--code", CALLBACK_SCRIPT, "--from", name)
+    assert result["code"] == 0, f"Failed to create callback script: {result}"
+    return get_script_address_from_create_result(result)
+
+
+def _create_task_with_callback(dysond_bin, name, address, amount, callback):
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": address,
+        "to_address": address,
+        "amount": [{"denom": "dys", "amount": str(amount)}]
+    }
+    result = dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", "+5s",
+        "--expiry-timestamp", "+1h",
+        "--task-gas-limit", "200000",
+        "--task-gas-fee", "200000dys",
+        "--callback", json.dumps(callback),
+        "--msgs", json.dumps(msg_obj),
+        "--from", name,
+    )
+    assert result["code"] == 0, f"Task creation failed: {result['raw_log']}"
+    return task_id_from_result(result)
+
+
+def _callback_record(dysond_bin, script_address, task_id):
+    result = dysond_bin("query", "storage", "get", script_address, "--index", f"callback-{task_id}")
+    return json.loads(result["entry"]["data"])
+
+
+def test_callback_receives_run_outcome(chainnet, generate_account):
+    """The callback is called with the outcome of a finished run, on its own gas"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=2000000)
+    script_address = _create_callback_script(dysond_bin, alice_name)
+
+    callback = {"script_address": script_address, "function_name": "on_finished", "gas_limit": "300000"}
+    done_id = _create_task_with_callback(dysond_bin, alice_name, alice_address, 1, callback)
+    failed_id = _create_task_with_callback(dysond_bin, alice_name, alice_address, 100000000, callback)
+
+    done = wait_for_task_status(dysond_bin, done_id)
+    assert done["status"] == "DONE", f"Task should run: {done}"
+    run = done["run_history"][-1]
+    assert int(run["callback_gas_consumed"]) > 0 and not run.get("callback_error"), run
+    record = _callback_record(dysond_bin, script_address, done_id)
+    assert record["status"] == "DONE" and record["results"] == 1 and record["error_log"] == ""
+
+    failed = wait_for_task_status(dysond_bin, failed_id)
+    assert failed["status"] == "FAILED", f"Task should fail: {failed}"
+    record = _callback_record(dysond_bin, script_address, failed_id)
+    assert record["status"] == "FAILED" and "insufficient funds" in record["error_log"]
+
+
+def test_failing_callback_keeps_run_effects(chainnet, generate_account):
+    """A failing callback is recorded in the run and does not revert the task"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [_, bob_address] = generate_account('bob', faucet_amount=0)
+    script_address = _create_callback_script(dysond_bin, alice_name)
+
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": bob_address,
+        "amount": [{"denom": "dys", "amount": "123"}]
+    }
+    result = dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", "+5s",
+        "--expiry-timestamp", "+1h",
+        "--task-gas-limit", "200000",
+        "--task-gas-fee", "200000dys",
+        "--callback", json.dumps({"script_address": script_address, "function_name": "on_finished_failing"}),
+        "--msgs", json.dumps(msg_obj),
+        "--from", alice_name,
+    )
+    assert result["code"] == 0, f"Task creation failed: {result['raw_log']}"
+    task_id = task_id_from_result(result)
+
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Task should stay done: {task}"
+    assert "callback failed on purpose" in task["run_history"][-1]["callback_error"]
+    balances = dysond_bin("query", "bank", "balances", bob_address)["balances"]
+    assert {"denom": "dys", "amount": "123"} in balances, f"Task effects should be kept: {balances}"
+
+
+def test_callback_validation(chainnet, generate_account):
+    """A callback needs a script function and a gas limit within the maximum"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": alice_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+
+    def create(callback):
+        return dysond_bin(
+            "tx", "crontask", "create-task",
+            "--scheduled-timestamp", "+1h",
+            "--expiry-timestamp", "+2h",
+            "--task-gas-limit", "200000",
+            "--task-gas-fee", "200dys",
+            "--callback", json.dumps(callback),
+            "--msgs", json.dumps(msg_obj),
+            "--from", alice_name,
+        )
+
+    result = create({"script_address": alice_address})
+    assert result["code"] != 0 and "callback function name cannot be empty" in result["raw_log"], result
+
+    result = create({"script_address": alice_address, "function_name": "f", "gas_limit": "2000000"})
+    assert result["code"] != 0 and "exceeds the maximum" in result["raw_log"], result
@@ -6,7 +6,7 @@ import subprocess
 from typing import Dict, List
 import uuid
 from tests.conftest import faucet
-from tests.utils import poll_until_condition
+from tests.utils import poll_until_condition, dys_balance, task_id_from_result, wait_for_task_status
 
 # Constants
 TASK_SCHEDULED_DELAY = 3  # seconds delay for scheduled tasks
@@ -568,4 +568,151 @@ def test_done_tasks_are_cleaned_up(chainnet, generate_account, faucet, update_cr
             
     print("Polling until task is deleted by cleanup logic…")
     poll_until_condition(_task_deleted, timeout=10, poll_interval=0.5,
-                         error_message="Task was not cleaned up within expected time window") 
\ No newline at end of file
+                         error_message="Task was not cleaned up within expected time window") 
+
+def _create_task_from_other_signer(dysond_bin, creator_name, signer_address, recipient_address, delay=TASK_SCHEDULED_DELAY):
+    """Create a task whose message is signed by signer_address instead of the creator"""
+    now = int(datetime.datetime.now().timestamp())
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": signer_address,
+        "to_address": recipient_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+    return dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", str(now + delay),
+        "--expiry-timestamp", str(now + 86400),
+        "--task-gas-limit", str(GAS_LIMIT),
+        "--task-gas-fee", f"{GAS_FEE}dys",
+        "--msgs", json.dumps(msg_obj),
+        "--from", creator_name, "--keyring-backend", "test", "--yes"
+    )
+
+
+def test_create_task_rejects_foreign_signer(chainnet, generate_account, faucet):
+    """A task cannot contain messages signed by another account without a grant"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    [bob_name, bob_address] = generate_account('bob')
+    faucet(alice_address, amount=100)
+    faucet(bob_address, amount=100)
+
+    result = _create_task_from_other_signer(dysond_bin, alice_name, bob_address, alice_address)
+    assert result["code"] != 0, f"Task spending bob's funds should be rejected: {result}"
+    assert "not the task creator" in result["raw_log"], f"Unexpected error: {result['raw_log']}"
+
+    tasks = dysond_bin("query", "crontask", "tasks-by-address", "--creator", alice_address).get("tasks", [])
+    assert tasks == [], f"No task should have been stored: {tasks}"
+
+
+def test_task_with_granted_signer(chainnet, generate_account, faucet):
+    """A task may contain messages of another signer that granted the creator, until it revokes"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    [bob_name, bob_address] = generate_account('bob')
+    faucet(alice_address, amount=100)
+    faucet(bob_address, amount=100)
+
+    dysond_bin(
+        "tx", "authz", "grant", alice_address, "send",
+        "--spend-limit", "10dys",
+        "--from", bob_name, "--keyring-backend", "test", "--yes"
+    )
+
+    # The grant allows the task and is used when it executes
+    task_id = task_id_from_result(_create_task_from_other_signer(dysond_bin, alice_name, bob_address, alice_address))
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Granted task should succeed: {task.get('error_log')}"
+
+    grants = dysond_bin("query", "authz", "grants", bob_address, alice_address)["grants"]
+    assert grants[0]["authorization"]["value"]["spend_limit"] == [{"denom": "dys", "amount": "9"}], f"Grant was not used: {grants}"
+
+    # Revoking the grant before execution makes the task fail instead of spending bob's funds
+    task_id = task_id_from_result(_create_task_from_other_signer(dysond_bin, alice_name, bob_address, alice_address, delay=TASK_SCHEDULED_DELAY * 3))
+    dysond_bin(
+        "tx", "authz", "revoke", alice_address, "/cosmos.bank.v1beta1.MsgSend",
+        "--from", bob_name, "--keyring-backend", "test", "--yes"
+    )
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "FAILED", f"Task should fail after the grant is revoked: {task}"
+    assert "not the task creator" in task["error_log"], f"Unexpected error log: {task['error_log']}"
+
+
+def test_recurring_interval_task(chainnet, generate_account, faucet):
+    """An interval task runs until its max occurrences, is charged per run and keeps its run history"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    faucet(alice_address, amount=100)
+    now = int(datetime.datetime.now().timestamp())
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": alice_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+    balance_before = dys_balance(dysond_bin, alice_address)
+    create_result = dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", str(now + TASK_SCHEDULED_DELAY * 2),
+        "--expiry-timestamp", "+60s",
+        "--interval-seconds", "2",
+        "--max-occurrences", "3",
+        "--task-gas-limit", str(GAS_LIMIT),
+        "--task-gas-fee", f"{GAS_FEE}dys",
+        "--msgs", json.dumps(msg_obj),
+        "--from", alice_name, "--keyring-backend", "test", "--yes"
+    )
+    task_id = task_id_from_result(create_result)
+
+    task = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+    assert task["interval_seconds"] == "2", f"Interval not stored: {task}"
+    assert task["status"] == "SCHEDULED"
+
+    def _finished():
+        t = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+        return t if t["status"] != "SCHEDULED" and t["status"] != "PENDING" else None
+    task = poll_until_condition(_finished, timeout=TASK_TIMEOUT * 2,
+                                error_message=f"Recurring task {task_id} did not finish")
+
+    assert task["status"] == "DONE", f"Last run should succeed: {task}"
+    assert task["occurrences"] == "3", f"Task should run 3 times: {task}"
+    runs = task["run_history"]
+    assert [r["occurrence"] for r in runs] == ["1", "2", "3"], f"Unexpected run history: {runs}"
+    assert all(r["status"] == "DONE" for r in runs), f"All runs should succeed: {runs}"
+    scheduled = [int(r["scheduled_timestamp"]) for r in runs]
+    assert all((b - a) % 2 == 0 and b > a for a, b in zip(scheduled, scheduled[1:])), f"Runs not aligned to interval: {scheduled}"
+
+    # Each run is charged from the escrow, the self-send itself nets zero
+    assert task["escrow"]["amount"] == "0", f"Escrow should be used up: {task}"
+    assert balance_before - dys_balance(dysond_bin, alice_address) == 3 * GAS_FEE
+
+
+def test_recurring_task_validation(chainnet, generate_account, faucet):
+    """Invalid recurrence settings are rejected"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    faucet(alice_address, amount=100)
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": alice_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+    base_args = [
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", "+5s",
+        "--task-gas-limit", str(GAS_LIMIT),
+        "--task-gas-fee", f"{GAS_FEE}dys",
+        "--msgs", json.dumps(msg_obj),
+        "--from", alice_name, "--keyring-backend", "test", "--yes",
+    ]
+    cases = [
+        (["--cron-expression", "61 * * * *"], "minute value"),
+        (["--cron-expression", "@hourly", "--interval-seconds", "60"], "both a cron expression and an interval"),
+        (["--max-occurrences", "2"], "require a cron expression or an interval"),
+    ]
+    for extra_args, expected in cases:
+        result = dysond_bin(*base_args, *extra_args)
+        assert result["code"] != 0, f"{extra_args} should be rejected: {result}"
+        assert expected in result["raw_log"], f"Unexpected error for {extra_args}: {result['raw_log']}"
@@ -0,0 +1,92 @@
+import json
+import time
+from test_crontask_cli import TASK_TIMEOUT
+from tests.utils import get_task, task_id_from_result, wait_for_task_status
+
+
+def _create_conditional_task(dysond_bin, name, address, condition, expiry="+1h"):
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": address,
+        "to_address": address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+    return dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", "+0s",
+        "--expiry-timestamp", expiry,
+        "--condition", json.dumps(condition),
+        "--task-gas-limit", "200000",
+        "--task-gas-fee", "200dys",
+        "--msgs", json.dumps(msg_obj),
+        "--from", name,
+    )
+
+
+def test_balance_below_condition(chainnet, generate_account):
+    """A task with a balance condition waits until the balance drops below the amount"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    [bob_name, bob_address] = generate_account('bob', faucet_amount=1000)
+    [_, carol_address] = generate_account('carol', faucet_amount=0)
+
+    condition = {
+        "balance_below": {"address": bob_address, "amount": {"denom": "dys", "amount": "500"}},
+        "gas_limit": "50000",
+        "deposit": {"denom": "dys", "amount": "500"},
+    }
+    create_result = _create_conditional_task(dysond_bin, alice_name, alice_address, condition)
+    assert create_result["code"] == 0, f"Task creation failed: {create_result['raw_log']}"
+    task_id = task_id_from_result(create_result)
+
+    # The condition does not hold yet, the task keeps waiting and pays for the checks
+    time.sleep(TASK_TIMEOUT / 2)
+    task = get_task(dysond_bin, task_id)
+    assert task["status"] == "SCHEDULED", f"Task should wait for its condition: {task}"
+    assert int(task["condition_checks"]) > 0, f"Condition should have been checked: {task}"
+    assert int(task["escrow"]["amount"]) < 700, f"Condition checks should be charged: {task}"
+
+    send_result = dysond_bin("tx", "bank", "send", bob_name, carol_address, "600dys")
+    assert send_result["code"] == 0, f"Send failed: {send_result['raw_log']}"
+
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Task should run once the balance drops: {task}"
+
+
+def test_storage_changed_condition(chainnet, generate_account):
+    """A task with a storage condition runs once the entry changes after the task was created"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    set_result = dysond_bin("tx", "storage", "set", "--from", alice_name, "--index", "watched", "--data", "before")
+    assert set_result["code"] == 0, f"Storage set failed: {set_result['raw_log']}"
+
+    condition = {
+        "storage_changed": {"owner": alice_address, "index": "watched"},
+        "deposit": {"denom": "dys", "amount": "500"},
+    }
+    create_result = _create_conditional_task(dysond_bin, alice_name, alice_address, condition)
+    assert create_result["code"] == 0, f"Task creation failed: {create_result['raw_log']}"
+    task_id = task_id_from_result(create_result)
+
+    task = get_task(dysond_bin, task_id)
+    assert task["condition"]["storage_changed"]["updated_height"] != "0", f"Baseline not captured: {task}"
+
+    time.sleep(TASK_TIMEOUT / 2)
+    assert get_task(dysond_bin, task_id)["status"] == "SCHEDULED"
+
+    set_result = dysond_bin("tx", "storage", "set", "--from", alice_name, "--index", "watched", "--data", "after")
+    assert set_result["code"] == 0, f"Storage set failed: {set_result['raw_log']}"
+
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Task should run once the entry changes: {task}"
+
+
+def test_condition_validation(chainnet, generate_account):
+    """Exactly one condition must be set"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    create_result = _create_conditional_task(dysond_bin, alice_name, alice_address, {"gas_limit": "1000"})
+    assert create_result["code"] != 0, f"A condition without a trigger should be rejected: {create_result}"
+    assert "exactly one condition must be set" in create_result["raw_log"]
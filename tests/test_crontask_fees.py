@@ -4,18 +4,7 @@ import time
 import datetime
 from test_crontask_cli import TASK_SCHEDULED_DELAY, TASK_TIMEOUT
 from typing import Dict, Any, List
-from tests.utils import poll_until_condition
-
-# Function to get blockchain time
-def get_blockchain_time(dysond_bin):
-    """Get the current blockchain time from the node status."""
-    status = dysond_bin("status")
-    latest_block_time = status.get("SyncInfo", {}).get("latest_block_time", "")
-    if latest_block_time:
-        # Parse the time (format: 2023-10-01T12:34:56.789Z)
-        dt = datetime.datetime.strptime(latest_block_time, "%Y-%m-%dT%H:%M:%S.%fZ")
-        return int(dt.timestamp())
-    return int(time.time())  # Fallback if parsing fails
+from tests.utils import poll_until_condition, create_self_send_task, dys_balance, get_blockchain_time
 
 # Test for successful fee deduction
 def test_fee_deduction_success(chainnet, generate_account):
@@ -121,7 +110,7 @@ def test_fee_deduction_success(chainnet, generate_account):
     print(f"Successfully verified fee deduction: {balance_diff} dys")
 
 
-# Test for insufficient funds during task execution
+# Test for insufficient funds when the task fee is escrowed
 def test_fee_deduction_insufficient_funds(chainnet, generate_account):
     dysond_bin = chainnet[0]
     [alice_name, alice_address] = generate_account('alice')
@@ -137,7 +126,6 @@ def test_fee_deduction_insufficient_funds(chainnet, generate_account):
     gas_limit = 200000
     gas_fee = max(2, int(gas_limit * 0.0000001))  # Set fee higher than balance (at least 2 dys)
     
-    # Create a task that should fail during execution due to insufficient funds
     msg_obj = {
         "@type": "/cosmos.bank.v1beta1.MsgSend",
         "from_address": poor_address,
@@ -145,7 +133,7 @@ def test_fee_deduction_insufficient_funds(chainnet, generate_account):
         "amount": [{"denom": "dys", "amount": "10"}]
     }
     
-    # Create the task using execute_tx_and_wait directly
+    # The fee is escrowed when the task is created, so creation fails right away
     create_result = dysond_bin(
         "tx", "crontask", "create-task",
         "--scheduled-timestamp", str(scheduled_time),
@@ -155,38 +143,75 @@ def test_fee_deduction_insufficient_funds(chainnet, generate_account):
         "--msgs", json.dumps(msg_obj),
         "--from", poor_name, 
     )
-    print(f"Task creation succeeded with result: {json.dumps(create_result, indent=2)}")
-    assert create_result["code"] == 0, f"Task creation failed unexpectedly: {create_result['raw_log']}"
-    
-    # Extract the task ID from the events
-    task_id = None
-    for event in create_result.get("events", []):
-        if event.get("type") == "dysonprotocol.crontask.v1.EventTaskCreated":
-            for attr in event.get("attributes", []):
-                if attr.get("key") == "task_id":
-                    task_id = json.loads(attr.get("value"))
-                    break
-    assert task_id is not None, "Failed to extract task ID from creation result"
-    print(f"Created task with ID: {task_id}")
-    
-    # Define a check function for polling task status
-    def check_task_failed():
-        task_result = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))
-        task = task_result.get("task", {})
-        if task.get("status") == "FAILED":
-            error_log = task.get("error_log", "")
-            assert "failed to deduct gas fee" in error_log or "insufficient funds" in error_log.lower(), \
-                f"Task failed but not due to insufficient funds: {error_log}"
-            print(f"Task {task_id} failed as expected due to insufficient funds: {error_log}")
-            return True
-        elif task.get("status") == "DONE":
-            assert False, f"Task {task_id} executed successfully when it should have failed due to insufficient funds"
-        return False
-    
-    # Poll until task status is updated to FAILED
-    poll_until_condition(
-        check_task_failed,
-        timeout=TASK_TIMEOUT,
-        error_message="Task did not fail as expected within timeout"
-    )
-    print("Successfully verified task execution fails with insufficient funds") 
\ No newline at end of file
+    assert create_result["code"] != 0, f"Task creation should fail without funds for the escrow: {create_result}"
+    assert "failed to escrow task fee" in create_result["raw_log"], f"Unexpected error: {create_result['raw_log']}"
+    assert "insufficient funds" in create_result["raw_log"], f"Unexpected error: {create_result['raw_log']}"
+    print("Successfully verified task creation fails with insufficient funds")
+
+
+def test_fee_escrow_charges_gas_used(chainnet, generate_account):
+    """The fee is escrowed at creation, only the gas consumed is charged and the rest is refunded"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    gas_fee = 200000  # gas price of 1dys
+
+    balance_before = dys_balance(dysond_bin, alice_address)
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, gas_fee)
+    task = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+    assert task["escrow"] == {"denom": "dys", "amount": str(gas_fee)}, f"Fee not escrowed: {task}"
+    assert dys_balance(dysond_bin, alice_address) == balance_before - gas_fee
+
+    def check_task_done():
+        t = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+        assert t["status"] != "FAILED", f"Task failed unexpectedly: {t['error_log']}"
+        return t if t["status"] == "DONE" else None
+    task = poll_until_condition(check_task_done, timeout=TASK_TIMEOUT,
+                                error_message="Task was not executed within timeout")
+
+    charged = int(task["run_history"][-1]["fee"]["amount"])
+    assert charged == int(task["task_gas_consumed"]), f"Charge should equal the gas consumed at 1dys: {task}"
+    assert 0 < charged < gas_fee, f"Unused gas should be refunded: {task}"
+    assert task["escrow"]["amount"] == "0", f"Escrow should be refunded: {task}"
+    assert dys_balance(dysond_bin, alice_address) == balance_before - charged
+
+
+def test_fee_escrow_refunded_on_delete(chainnet, generate_account):
+    """Deleting a task refunds its full escrow"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    balance_before = dys_balance(dysond_bin, alice_address)
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, 50, delay=3600)
+    assert dys_balance(dysond_bin, alice_address) == balance_before - 50
+
+    delete_result = dysond_bin("tx", "crontask", "delete-task", "--task-id", str(task_id), "--from", alice_name)
+    assert delete_result["code"] == 0, f"Delete failed: {delete_result['raw_log']}"
+    assert any(e["type"] == "dysonprotocol.crontask.v1.EventTaskRefunded" for e in delete_result["events"])
+    assert dys_balance(dysond_bin, alice_address) == balance_before
+
+
+def test_top_up_recurring_task(chainnet, generate_account):
+    """Bounded recurring tasks escrow every run, unbounded ones are topped up"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, 10, delay=3600,
+                                     extra_args=("--interval-seconds", "60", "--max-occurrences", "4"))
+    task = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+    assert task["escrow"]["amount"] == "40", f"Every run should be escrowed: {task}"
+
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, 10, delay=3600,
+                                     extra_args=("--interval-seconds", "60"))
+    task = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+    assert task["escrow"]["amount"] == "10", f"A single run should be escrowed: {task}"
+
+    top_up_result = dysond_bin("tx", "crontask", "top-up-task", "--task-id", str(task_id), "--amount", "25dys", "--from", alice_name)
+    assert top_up_result["code"] == 0, f"Top up failed: {top_up_result['raw_log']}"
+    task = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+    assert task["escrow"]["amount"] == "35", f"Top up not added to the escrow: {task}"
+
+    # One-shot tasks escrow their maximum fee already
+    one_shot_id = create_self_send_task(dysond_bin, alice_name, alice_address, 10, delay=3600)
+    top_up_result = dysond_bin("tx", "crontask", "top-up-task", "--task-id", str(one_shot_id), "--amount", "5dys", "--from", alice_name)
+    assert top_up_result["code"] != 0, f"Top up of a one-shot task should fail: {top_up_result}"
+    assert "only recurring and conditional tasks" in top_up_result["raw_log"]
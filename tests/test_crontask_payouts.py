@@ -0,0 +1,121 @@
+import json
+from tests.utils import poll_until_condition, dys_balance
+
+
+def _recipient(address, amount):
+    return json.dumps({"address": address, "amount": [{"denom": "dys", "amount": str(amount)}]})
+
+
+def _get_payout(dysond_bin, payout_id):
+    return dysond_bin("query", "crontask", "payout-schedule", "--payout-id", str(payout_id))["payout_schedule"]
+
+
+def _create_payout(dysond_bin, name, recipients, *args):
+    result = dysond_bin("tx", "crontask", "create-payout-schedule",
+                        *[arg for r in recipients for arg in ("--recipients", r)],
+                        *args, "--from", name)
+    assert result["code"] == 0, f"Payout creation failed: {result['raw_log']}"
+    for event in result.get("events", []):
+        if event.get("type") == "dysonprotocol.crontask.v1.EventPayoutCreated":
+            for attr in event.get("attributes", []):
+                if attr.get("key") == "payout_id":
+                    return json.loads(attr.get("value"))
+    assert False, "Failed to extract payout ID"
+
+
+def _released(payout, address):
+    recipient = next(r for r in payout["recipients"] if r["address"] == address)
+    return sum(int(c["amount"]) for c in recipient.get("released", []))
+
+
+def test_payout_schedule_releases(chainnet, generate_account):
+    """The escrow is locked at creation and released to every recipient on schedule"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob')
+    [carol_name, carol_address] = generate_account('carol')
+
+    alice_before = dys_balance(dysond_bin, alice_address)
+    bob_before = dys_balance(dysond_bin, bob_address)
+    carol_before = dys_balance(dysond_bin, carol_address)
+
+    payout_id = _create_payout(dysond_bin, alice_name,
+                               [_recipient(bob_address, 100), _recipient(carol_address, 50)],
+                               "--start-timestamp", "+2s", "--interval-seconds", "2", "--total-releases", "3")
+    payout = _get_payout(dysond_bin, payout_id)
+    assert payout["status"] == "ACTIVE", payout
+    assert payout["escrow"] == [{"denom": "dys", "amount": "450"}], payout
+    assert dys_balance(dysond_bin, alice_address) == alice_before - 450
+
+    def check_completed():
+        p = _get_payout(dysond_bin, payout_id)
+        return p if p["status"] == "COMPLETED" else None
+
+    payout = poll_until_condition(check_completed, timeout=60, error_message="Payout schedule did not complete")
+    assert int(payout["releases_done"]) == 3, payout
+    assert not payout.get("escrow"), payout
+    assert _released(payout, bob_address) == 300 and _released(payout, carol_address) == 150, payout
+    assert dys_balance(dysond_bin, bob_address) == bob_before + 300
+    assert dys_balance(dysond_bin, carol_address) == carol_before + 150
+
+    for address in (bob_address, carol_address):
+        result = dysond_bin("query", "crontask", "payout-schedules-by-recipient", "--recipient", address)
+        assert payout_id in [int(p["payout_id"]) for p in result["payout_schedules"]], result
+    result = dysond_bin("query", "crontask", "payout-schedules-by-creator", "--creator", alice_address)
+    assert [int(p["payout_id"]) for p in result["payout_schedules"]] == [payout_id], result
+
+    result = dysond_bin("tx", "crontask", "cancel-payout-schedule", "--payout-id", str(payout_id), "--from", alice_name)
+    assert result["code"] != 0 and "cannot be cancelled" in result["raw_log"], result
+
+
+def test_cancel_payout_schedule(chainnet, generate_account):
+    """Cancelling pays the vested share of the next release and refunds the rest to the creator"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob')
+
+    alice_before = dys_balance(dysond_bin, alice_address)
+    bob_before = dys_balance(dysond_bin, bob_address)
+
+    # Monthly releases of 1000dys for a year
+    payout_id = _create_payout(dysond_bin, alice_name, [_recipient(bob_address, 1000)],
+                               "--interval-seconds", "2592000", "--total-releases", "12")
+    payout = _get_payout(dysond_bin, payout_id)
+    assert int(payout["next_release_timestamp"]) == int(payout["creation_time"]) + 2592000, payout
+
+    result = dysond_bin("tx", "crontask", "cancel-payout-schedule", "--payout-id", str(payout_id), "--from", bob_name)
+    assert result["code"] != 0 and "only the creator" in result["raw_log"], result
+
+    result = dysond_bin("tx", "crontask", "cancel-payout-schedule", "--payout-id", str(payout_id), "--from", alice_name)
+    assert result["code"] == 0, f"Cancel failed: {result['raw_log']}"
+
+    payout = _get_payout(dysond_bin, payout_id)
+    assert payout["status"] == "CANCELLED", payout
+    assert not payout.get("escrow"), payout
+    # Only seconds of the first month have vested
+    vested = _released(payout, bob_address)
+    assert vested < 1000, payout
+    assert dys_balance(dysond_bin, bob_address) == bob_before + vested
+    assert dys_balance(dysond_bin, alice_address) == alice_before - vested
+
+
+def test_payout_schedule_validation(chainnet, generate_account):
+    """Invalid payout schedules are rejected"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob')
+
+    cases = [
+        (([_recipient(bob_address, 10)], "--total-releases", "3"), "interval is required"),
+        (([_recipient(bob_address, 10), _recipient(bob_address, 10)], "--interval-seconds", "60",
+          "--total-releases", "2"), "duplicate recipient"),
+        (([_recipient(bob_address, 10)], "--interval-seconds", "60", "--total-releases", "0"), "total releases"),
+        (([_recipient(bob_address, 10)], "--start-timestamp", "1", "--total-releases", "1"), "start timestamp"),
+        (([_recipient(bob_address, 10 ** 12)], "--interval-seconds", "60", "--total-releases", "1"),
+         "insufficient funds"),
+    ]
+    for (recipients, *args), expected in cases:
+        result = dysond_bin("tx", "crontask", "create-payout-schedule",
+                            *[arg for r in recipients for arg in ("--recipients", r)],
+                            *args, "--from", alice_name)
+        assert result["code"] != 0 and expected in result["raw_log"], (expected, result)
@@ -0,0 +1,65 @@
+import json
+from tests.utils import create_self_send_task, wait_for_task_status
+
+
+def _latest_height(dysond_bin):
+    return int(dysond_bin("status")["SyncInfo"]["latest_block_height"])
+
+
+def test_end_block_task_runs_in_its_block(chainnet, generate_account):
+    """An END_BLOCK task due now runs at the end of the block that included it"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, 200000, delay=0,
+                                     extra_args=("--execution-phase", "END_BLOCK"))
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Task should run: {task}"
+    assert task["execution_phase"] == "END_BLOCK"
+    assert int(task["execution_height"]) > 0, f"Execution height not recorded: {task}"
+
+
+def test_scheduled_height_task(chainnet, generate_account):
+    """A task scheduled at a block height does not run before that height"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    target_height = _latest_height(dysond_bin) + 5
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, 200000, delay=0,
+                                     extra_args=("--scheduled-height", str(target_height)))
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Task should run: {task}"
+    assert int(task["execution_height"]) >= target_height, f"Task ran before its height: {task}"
+
+
+def test_phase_validation(chainnet, generate_account):
+    """The phase must be known and a height cannot be in the past or combined with recurrence"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": alice_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+
+    def create(*args):
+        return dysond_bin(
+            "tx", "crontask", "create-task",
+            "--expiry-timestamp", "+1h",
+            "--task-gas-limit", "200000",
+            "--task-gas-fee", "50dys",
+            "--msgs", json.dumps(msg_obj),
+            *args,
+            "--from", alice_name,
+        )
+
+    result = create("--scheduled-timestamp", "+1m", "--execution-phase", "MIDDLE_BLOCK")
+    assert result["code"] != 0 and "invalid execution phase" in result["raw_log"], result
+
+    result = create("--scheduled-height", "1")
+    assert result["code"] != 0 and "scheduled height must be at least" in result["raw_log"], result
+
+    result = create("--scheduled-height", str(_latest_height(dysond_bin) + 100), "--interval-seconds", "60")
+    assert result["code"] != 0 and "cannot have a cron expression or an interval" in result["raw_log"], result
@@ -0,0 +1,73 @@
+from tests.utils import create_self_send_task, get_blockchain_time
+
+
+def _task_ids(result):
+    return [int(t["task_id"]) for t in result["tasks"]]
+
+
+def test_tasks_by_filter(chainnet, generate_account):
+    """Tasks can be filtered by creator, status and due time range"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob', faucet_amount=1000000)
+
+    soon = create_self_send_task(dysond_bin, alice_name, alice_address, 200000, delay=600)
+    later = create_self_send_task(dysond_bin, alice_name, alice_address, 200000, delay=1800)
+    bobs = create_self_send_task(dysond_bin, bob_name, bob_address, 200000, delay=600)
+    now = get_blockchain_time(dysond_bin)
+
+    def query(*args):
+        return _task_ids(dysond_bin("query", "crontask", "tasks-by-filter", *args, "--page-limit", "1000"))
+
+    ids = query("--creator", alice_address, "--status", "SCHEDULED")
+    assert ids == [soon, later], f"Alice's scheduled tasks should be ordered by due time: {ids}"
+
+    ids = query("--creator", alice_address, "--status", "SCHEDULED", "--to-timestamp", str(now + 1200))
+    assert ids == [soon], f"Only the task due in the range should match: {ids}"
+
+    ids = query("--creator", alice_address, "--from-timestamp", str(now + 1200))
+    assert ids == [later], f"Creator and time range should combine: {ids}"
+
+    ids = query("--status", "SCHEDULED", "--from-timestamp", str(now), "--to-timestamp", str(now + 1200))
+    assert soon in ids and bobs in ids and later not in ids, ids
+
+    ids = query("--creator", bob_address)
+    assert ids == [bobs], ids
+
+    page = dysond_bin("query", "crontask", "tasks-by-filter", "--creator", alice_address,
+                      "--page-limit", "1", "--page-count-total")
+    assert _task_ids(page) == [soon] and page["pagination"]["total"] == "2", page
+
+    result = dysond_bin("query", "crontask", "tasks-by-filter", "--status", "WAITING")
+    assert "invalid status" in str(result), result
+
+
+def test_schedule_forecast_and_stats(chainnet, generate_account):
+    """The forecast counts the runs of recurring tasks and the stats count the tasks per status"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    # Runs every 10 minutes, six times at most
+    create_self_send_task(dysond_bin, alice_name, alice_address, 200000, delay=300,
+                           extra_args=("--interval-seconds", "600", "--max-occurrences", "6"))
+    now = get_blockchain_time(dysond_bin)
+
+    forecast = dysond_bin("query", "crontask", "schedule-forecast", "--from-timestamp", str(now),
+                          "--window-seconds", "3600", "--bucket-seconds", "600")
+    buckets = forecast["buckets"]
+    assert len(buckets) == 6, forecast
+    assert int(buckets[0]["start_timestamp"]) == now and int(buckets[-1]["end_timestamp"]) == now + 3600
+    # The interval task runs once in every bucket of the window
+    assert all(int(b.get("runs", 0)) >= 1 for b in buckets), forecast
+    assert int(forecast["total_gas_limit"]) >= 6 * 200000, forecast
+
+    result = dysond_bin("query", "crontask", "schedule-forecast", "--window-seconds", "86400", "--bucket-seconds", "1")
+    assert "maximum" in str(result), result
+
+    stats = dysond_bin("query", "crontask", "task-stats", "--upcoming-blocks", "3")
+    statuses = {s["status"]: s for s in stats["statuses"]}
+    assert set(statuses) == {"SCHEDULED", "PENDING", "DONE", "FAILED", "EXPIRED", "CANCELLED"}, stats
+    assert int(statuses["SCHEDULED"].get("count", 0)) >= 1, stats
+    assert sum(int(s.get("count", 0)) for s in stats["statuses"]) == int(stats["total_tasks"])
+    assert len(stats["upcoming_blocks"]) == 3, stats
+    assert int(stats["block_gas_limit"]) > 0, stats
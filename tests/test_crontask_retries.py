@@ -0,0 +1,80 @@
+import json
+import time
+from test_crontask_cli import TASK_TIMEOUT
+from tests.utils import get_task, task_id_from_result, wait_for_task_status
+
+
+def _create_send_task(dysond_bin, name, address, amount, retry_policy):
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": address,
+        "to_address": address,
+        "amount": [{"denom": "dys", "amount": str(amount)}]
+    }
+    return dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", "+5s",
+        "--expiry-timestamp", "+1h",
+        "--task-gas-limit", "200000",
+        "--task-gas-fee", "200000dys",
+        "--retry-policy", json.dumps(retry_policy),
+        "--msgs", json.dumps(msg_obj),
+        "--from", name,
+    )
+
+
+def test_retry_until_success(chainnet, generate_account, faucet):
+    """A transient failure is retried with backoff and the task succeeds once the cause is gone"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    # Four attempts are escrowed, leaving too little to send 1000000dys
+    retry_policy = {"max_attempts": 4, "backoff": "FIXED", "backoff_seconds": "3", "retryable_errors": ["sdk:5"]}
+    create_result = _create_send_task(dysond_bin, alice_name, alice_address, 1000000, retry_policy)
+    assert create_result["code"] == 0, f"Task creation failed: {create_result['raw_log']}"
+    task_id = task_id_from_result(create_result)
+    assert get_task(dysond_bin, task_id)["escrow"]["amount"] == str(4 * 200000)
+
+    time.sleep(TASK_TIMEOUT / 2)
+    task = get_task(dysond_bin, task_id)
+    assert task["status"] in ("SCHEDULED", "PENDING"), f"Task should wait for a retry: {task}"
+    assert int(task["attempts"]) >= 1, f"Failed attempt not counted: {task}"
+    attempt = task["attempt_log"][0]
+    assert attempt["codespace"] == "sdk" and attempt["code"] == 5 and attempt["retried"], attempt
+
+    faucet(alice_address, amount=2000000)
+
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Task should succeed after a retry: {task}"
+    assert len(task["attempt_log"]) >= 1
+
+
+def test_non_retryable_error_fails(chainnet, generate_account):
+    """An error that is not retryable fails the task at the first attempt"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    retry_policy = {"max_attempts": 3, "backoff_seconds": "1", "retryable_errors": ["wasm"]}
+    create_result = _create_send_task(dysond_bin, alice_name, alice_address, 100000000, retry_policy)
+    assert create_result["code"] == 0, f"Task creation failed: {create_result['raw_log']}"
+    task_id = task_id_from_result(create_result)
+
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "FAILED", f"Task should fail: {task}"
+    assert len(task["attempt_log"]) == 1 and not task["attempt_log"][0].get("retried", False), task
+    assert task["escrow"]["amount"] == "0", f"Escrow should be refunded: {task}"
+
+
+def test_retry_policy_validation(chainnet, generate_account):
+    """The retry policy must have a valid number of attempts, backoff and retryable errors"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    result = _create_send_task(dysond_bin, alice_name, alice_address, 1, {"max_attempts": 11})
+    assert result["code"] != 0 and "max attempts must be between 1 and 10" in result["raw_log"], result
+
+    result = _create_send_task(dysond_bin, alice_name, alice_address, 1, {"max_attempts": 2, "backoff": "LINEAR"})
+    assert result["code"] != 0 and "invalid backoff" in result["raw_log"], result
+
+    result = _create_send_task(dysond_bin, alice_name, alice_address, 1, {"max_attempts": 2, "retryable_errors": ["sdk:x"]})
+    assert result["code"] != 0 and "invalid code of retryable error" in result["raw_log"], result
@@ -0,0 +1,84 @@
+import json
+import pytest
+from tests.utils import poll_until_condition, create_self_send_task, get_blockchain_time, wait_for_task_status
+
+
+def _set_crontask_params(dysond_bin, **changes):
+    """Update crontask params with MsgUpdateParams and return the previous params"""
+    current = dysond_bin("query", "crontask", "params")["params"]
+    new_params = dict(current)
+    new_params.update({k: str(v) for k, v in changes.items()})
+
+    alice_address = dysond_bin("keys", "show", "alice")["address"]
+    tx = dysond_bin(
+        "tx", "crontask", "update-params",
+        "--authority", alice_address,
+        "--params", json.dumps(new_params),
+        "--from", "alice",
+    )
+    assert tx.get("code", 1) == 0, f"update-params failed: {tx}"
+
+    def _updated():
+        params = dysond_bin("query", "crontask", "params")["params"]
+        return all(str(params.get(k, "0")) == str(v) for k, v in changes.items())
+    poll_until_condition(_updated, timeout=10, poll_interval=0.5,
+                         error_message=f"crontask params did not update to {changes}")
+    return current
+
+
+@pytest.fixture
+def creator_quota(chainnet):
+    """Limit every creator to a single 200000 gas task per block"""
+    dysond_bin = chainnet[0]
+    previous = _set_crontask_params(dysond_bin, creator_block_gas_limit=200000)
+    yield 200000
+    _set_crontask_params(dysond_bin, creator_block_gas_limit=previous.get("creator_block_gas_limit", "0"))
+
+
+def test_creator_quota_defers_tasks(chainnet, generate_account, creator_quota):
+    """Tasks of a creator over its per-block quota are deferred to the next blocks, not dropped"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    # Both tasks are due in the same block
+    due = get_blockchain_time(dysond_bin) + 8
+    task_ids = [
+        create_self_send_task(dysond_bin, alice_name, alice_address, 200000,
+                               extra_args=("--scheduled-timestamp", str(due)))
+        for _ in range(2)
+    ]
+
+    tasks = [wait_for_task_status(dysond_bin, task_id) for task_id in task_ids]
+    assert all(t["status"] == "DONE" for t in tasks), f"Deferred tasks should still run: {tasks}"
+    assert sum(int(t.get("deferrals", "0")) for t in tasks) >= 1, f"One task should have been deferred: {tasks}"
+
+
+def test_task_over_creator_quota_rejected(chainnet, generate_account, creator_quota):
+    """A task that can never fit in the quota of its creator is rejected at creation"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": alice_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+    result = dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", "+1h",
+        "--expiry-timestamp", "+2h",
+        "--task-gas-limit", str(creator_quota + 1),
+        "--task-gas-fee", "100dys",
+        "--msgs", json.dumps(msg_obj),
+        "--from", alice_name,
+    )
+    assert result["code"] != 0, f"Task over the creator quota should be rejected: {result}"
+    assert "exceeds the creator block gas limit" in result["raw_log"]
+
+
+def test_scheduling_params(chainnet):
+    """The fair scheduling params are exposed"""
+    params = chainnet[0]("query", "crontask", "params")["params"]
+    assert int(params.get("aging_interval", "0")) >= 0
+    assert int(params.get("creator_block_gas_limit", "0")) <= int(params["block_gas_limit"])
@@ -0,0 +1,60 @@
+import json
+from tests.utils import dys_balance
+
+
+def _send_msg(from_address, to_address, amount):
+    return json.dumps({
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": from_address,
+        "to_address": to_address,
+        "amount": [{"denom": "dys", "amount": str(amount)}]
+    })
+
+
+def test_simulate_task_success(chainnet, generate_account):
+    """A dry-run returns the gas used and the minimum fee without changing any balance"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob', faucet_amount=1000000)
+
+    balance_before = dys_balance(dysond_bin, bob_address)
+    result = dysond_bin("query", "crontask", "simulate-task", "--creator", alice_address,
+                        "--msgs", _send_msg(alice_address, bob_address, 1000),
+                        "--task-gas-limit", "200000")
+    assert result.get("success") is True, result
+    assert 0 < int(result["gas_used"]) <= 200000, result
+    assert result["task_gas_limit"] == "200000", result
+    assert len(result["msg_results"]) == 1, result
+    assert result["min_task_gas_fee"]["denom"] == "dys" and int(result["min_task_gas_fee"]["amount"]) >= 1, result
+    assert result["min_gas_price"]["denom"] == "dys", result
+    assert dys_balance(dysond_bin, bob_address) == balance_before, "A dry-run must not write state"
+
+    # Without a gas limit the largest one allowed is simulated
+    result = dysond_bin("query", "crontask", "simulate-task", "--creator", alice_address,
+                        "--msgs", _send_msg(alice_address, alice_address, 1))
+    params = dysond_bin("query", "crontask", "params")["params"]
+    assert int(result["task_gas_limit"]) <= int(params["block_gas_limit"]), result
+
+
+def test_simulate_task_failure(chainnet, generate_account):
+    """A failing message is reported with its error instead of failing the query"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob', faucet_amount=1000000)
+
+    result = dysond_bin("query", "crontask", "simulate-task", "--creator", alice_address,
+                        "--msgs", _send_msg(alice_address, bob_address, 10 ** 15),
+                        "--task-gas-limit", "200000")
+    assert not result.get("success", False), result
+    assert "insufficient funds" in result["error_log"], result
+    assert result["codespace"] == "sdk" and int(result["code"]) > 0, result
+
+    # The creator must sign every message
+    result = dysond_bin("query", "crontask", "simulate-task", "--creator", alice_address,
+                        "--msgs", _send_msg(bob_address, alice_address, 1))
+    assert "not the task creator" in str(result), result
+
+    result = dysond_bin("query", "crontask", "simulate-task", "--creator", alice_address,
+                        "--msgs", _send_msg(alice_address, bob_address, 1),
+                        "--execution-phase", "MID_BLOCK")
+    assert "phase" in str(result), result
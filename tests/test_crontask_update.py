@@ -0,0 +1,71 @@
+import json
+from tests.utils import create_self_send_task, dys_balance, get_task, wait_for_task_status
+
+
+def test_update_task_reschedules_and_adjusts_escrow(chainnet, generate_account):
+    """Updating a scheduled task keeps its ID, moves its schedule and adjusts the escrow"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, 200000, delay=3600)
+    task = get_task(dysond_bin, task_id)
+    window = int(task["expiry_timestamp"]) - int(task["scheduled_timestamp"])
+    balance_before = dys_balance(dysond_bin, alice_address)
+
+    # Raising the fee escrows the difference
+    result = dysond_bin("tx", "crontask", "update-task", "--task-id", str(task_id),
+                        "--task-gas-fee", "400000dys", "--from", alice_name)
+    assert result["code"] == 0, f"Update failed: {result['raw_log']}"
+    updated_events = [e for e in result["events"] if e["type"] == "dysonprotocol.crontask.v1.EventTaskUpdated"]
+    assert len(updated_events) == 1
+    task = get_task(dysond_bin, task_id)
+    assert task["escrow"]["amount"] == "400000", f"Escrow not adjusted: {task}"
+    assert task["task_gas_price"]["amount"] == "2"
+    assert dys_balance(dysond_bin, alice_address) == balance_before - 200000
+
+    # The gas price index follows the new price
+    by_price = dysond_bin("query", "crontask", "tasks-by-status-gas-price", "--status", "SCHEDULED", "--page-limit", "1000")
+    assert any(int(t["task_id"]) == task_id and t["task_gas_price"]["amount"] == "2" for t in by_price["tasks"])
+
+    # Lowering the fee and moving the task closer keeps the expiry window and refunds the difference
+    result = dysond_bin("tx", "crontask", "update-task", "--task-id", str(task_id),
+                        "--scheduled-timestamp", "+5s", "--task-gas-fee", "200000dys", "--from", alice_name)
+    assert result["code"] == 0, f"Update failed: {result['raw_log']}"
+    task = get_task(dysond_bin, task_id)
+    assert int(task["expiry_timestamp"]) - int(task["scheduled_timestamp"]) == window
+    assert task["escrow"]["amount"] == "200000"
+    assert dys_balance(dysond_bin, alice_address) == balance_before
+
+    task = wait_for_task_status(dysond_bin, task_id)
+    assert task["status"] == "DONE", f"Rescheduled task should run: {task}"
+
+    # A finished task cannot be updated
+    result = dysond_bin("tx", "crontask", "update-task", "--task-id", str(task_id),
+                        "--scheduled-timestamp", "+1h", "--from", alice_name)
+    assert result["code"] != 0 and "cannot be updated" in result["raw_log"], result
+
+
+def test_update_task_authorization(chainnet, generate_account):
+    """Only the creator can update a task, and the new messages must be signed by the creator"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    [bob_name, bob_address] = generate_account('bob')
+
+    task_id = create_self_send_task(dysond_bin, alice_name, alice_address, 50, delay=3600)
+
+    result = dysond_bin("tx", "crontask", "update-task", "--task-id", str(task_id),
+                        "--scheduled-timestamp", "+2h", "--from", bob_name)
+    assert result["code"] != 0 and "only the creator can update a task" in result["raw_log"], result
+
+    foreign_msg = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": bob_address,
+        "to_address": alice_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+    result = dysond_bin("tx", "crontask", "update-task", "--task-id", str(task_id),
+                        "--msgs", json.dumps(foreign_msg), "--from", alice_name)
+    assert result["code"] != 0, f"Messages signed by another account should be rejected: {result}"
+
+    result = dysond_bin("tx", "crontask", "update-task", "--task-id", str(task_id), "--from", alice_name)
+    assert result["code"] != 0 and "nothing to update" in result["raw_log"], result
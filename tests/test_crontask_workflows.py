@@ -0,0 +1,102 @@
+import json
+import uuid
+from tests.utils import create_self_send_task, dys_balance, get_task, wait_for_task_status
+
+
+def _dependency(task_id, outcome):
+    return json.dumps({"task_id": str(task_id), "outcome": outcome})
+
+
+def test_workflow_dependencies(chainnet, generate_account):
+    """Dependent tasks run after their parent with the required outcome, the others are cancelled"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    workflow_id = f"wf-{uuid.uuid4().hex[:8]}"
+
+    parent_id = create_self_send_task(dysond_bin, alice_name, alice_address, 200,
+                                       extra_args=("--workflow-id", workflow_id))
+    on_success_id = create_self_send_task(dysond_bin, alice_name, alice_address, 200, extra_args=(
+        "--workflow-id", workflow_id, "--dependencies", _dependency(parent_id, "SUCCESS")))
+    on_failure_id = create_self_send_task(dysond_bin, alice_name, alice_address, 200, extra_args=(
+        "--workflow-id", workflow_id, "--dependencies", _dependency(parent_id, "FAILURE")))
+    # A grandchild waiting for the cancelled branch is cancelled as well
+    grandchild_id = create_self_send_task(dysond_bin, alice_name, alice_address, 200, extra_args=(
+        "--workflow-id", workflow_id, "--dependencies", _dependency(on_failure_id, "SUCCESS")))
+
+    assert wait_for_task_status(dysond_bin, parent_id)["status"] == "DONE"
+    on_success = wait_for_task_status(dysond_bin, on_success_id)
+    assert on_success["status"] == "DONE", f"On-success task should run: {on_success}"
+
+    on_failure = wait_for_task_status(dysond_bin, on_failure_id, statuses=("DONE", "FAILED", "CANCELLED"))
+    assert on_failure["status"] == "CANCELLED", f"On-failure task should be cancelled: {on_failure}"
+    assert on_failure["escrow"]["amount"] == "0", f"Escrow should be refunded: {on_failure}"
+    grandchild = wait_for_task_status(dysond_bin, grandchild_id, statuses=("DONE", "FAILED", "CANCELLED"))
+    assert grandchild["status"] == "CANCELLED", f"Grandchild should be cancelled: {grandchild}"
+
+    result = dysond_bin("query", "crontask", "tasks-by-workflow",
+                        "--creator", alice_address, "--workflow-id", workflow_id)
+    assert [int(t["task_id"]) for t in result["tasks"]] == [parent_id, on_success_id, on_failure_id, grandchild_id]
+
+
+def test_cancel_workflow(chainnet, generate_account):
+    """Cancelling a workflow cancels its unfinished tasks and refunds their escrow"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    workflow_id = f"wf-{uuid.uuid4().hex[:8]}"
+
+    balance_before = dys_balance(dysond_bin, alice_address)
+    first_id = create_self_send_task(dysond_bin, alice_name, alice_address, 50, delay=3600,
+                                      extra_args=("--workflow-id", workflow_id))
+    second_id = create_self_send_task(dysond_bin, alice_name, alice_address, 50, delay=3600, extra_args=(
+        "--workflow-id", workflow_id, "--dependencies", _dependency(first_id, "SUCCESS")))
+    assert dys_balance(dysond_bin, alice_address) == balance_before - 100
+
+    cancel_result = dysond_bin("tx", "crontask", "cancel-workflow", "--workflow-id", workflow_id, "--from", alice_name)
+    assert cancel_result["code"] == 0, f"Cancel failed: {cancel_result['raw_log']}"
+    cancelled_events = [e for e in cancel_result["events"] if e["type"] == "dysonprotocol.crontask.v1.EventTaskCancelled"]
+    assert len(cancelled_events) == 2
+
+    for task_id in (first_id, second_id):
+        task = get_task(dysond_bin, task_id)
+        assert task["status"] == "CANCELLED", f"Task should be cancelled: {task}"
+        assert task["error_log"] == f"workflow {workflow_id} cancelled"
+    assert dys_balance(dysond_bin, alice_address) == balance_before
+
+    # Another creator cannot see or cancel the workflow
+    [bob_name, _] = generate_account('bob')
+    cancel_result = dysond_bin("tx", "crontask", "cancel-workflow", "--workflow-id", workflow_id, "--from", bob_name)
+    assert cancel_result["code"] != 0
+    assert "has no tasks" in cancel_result["raw_log"]
+
+
+def test_dependency_validation(chainnet, generate_account):
+    """Dependencies must reference existing tasks with a valid outcome"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    parent_id = create_self_send_task(dysond_bin, alice_name, alice_address, 50, delay=3600)
+
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": alice_address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+
+    def create(dependency):
+        return dysond_bin(
+            "tx", "crontask", "create-task",
+            "--scheduled-timestamp", "+1h",
+            "--expiry-timestamp", "+2h",
+            "--task-gas-limit", "200000",
+            "--task-gas-fee", "50dys",
+            "--msgs", json.dumps(msg_obj),
+            "--dependencies", dependency,
+            "--from", alice_name,
+        )
+
+    result = create(_dependency(999999999, "SUCCESS"))
+    assert result["code"] != 0 and "task not found" in result["raw_log"], result
+
+    result = create(_dependency(parent_id, "DONE"))
+    assert result["code"] != 0 and "invalid outcome" in result["raw_log"], result
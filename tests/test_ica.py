@@ -175,7 +175,27 @@ def test_ica_complete_e2e_workflow(ibc_setup, generate_account, faucet):
     callback_result = dysond_bin("tx", "script", "exec", "--script-address", alice_address, "--function-name", "get_callback", "--args", json.dumps(callback_args), "--from", alice_name, "--gas", "500000")
     print(f"✅ Callback verification result: {callback_result}")
     assert callback_result.get("code", 1) == 0, "Failed to get balance callback"
-    
+
+    callback_data = None
+    for event in callback_result.get("events", []):
+        if event.get("type") == "dysonprotocol.script.v1.EventExecScript":
+            for attr in event.get("attributes", []):
+                if attr.get("key") == "response":
+                    response_data = json.loads(attr.get("value"))
+                    result_data = json.loads(response_data.get("result", "{}"))
+                    callback_data = result_data.get("result", {}).get("data")
+    assert callback_data, "Balance callback data should be stored"
+
+    ibc_data = callback_data["kwargs"]["beta_ibc_callback_data_v1"]
+    print(f"✅ Interchain query callback data: {ibc_data}")
+    assert ibc_data["error"] == "", f"Interchain query failed: {ibc_data['error']}"
+    assert int(ibc_data["proof_height"]) > 0, "Interchain query result should include the host proof height"
+    assert len(ibc_data["query_results"]) == 1, "Expected one interchain query result"
+    query_result = ibc_data["query_results"][0]
+    assert query_result["path"] == "/cosmos.bank.v1beta1.Query/AllBalances"
+    assert query_result["request"]["address"] == callback_data["target_address"]
+    assert "balances" in query_result["response"], f"Unexpected query response: {query_result}"
+
     # 9. Withdraw funds back to controller
     withdraw_args = ["ibc/3B2294AF63D402DF9B10DA43CEC03677D9041297A1031AB1AFC789C492280D79", "1000"]
     print(f"💸 Withdrawing funds with args: {withdraw_args}")
@@ -208,16 +208,19 @@ def test_script_governance_param_update_and_storage_history(chainnet, generate_a
     assert gov_address, "Could not get governance module address"
     print(f"Using governance module address: {gov_address}")
     
+    # Start from the current params so unrelated params keep their values
+    current_params = dysond_bin("query", "script", "params")["params"]
+    new_params = dict(current_params)
+    new_params["max_relative_historical_blocks"] = "1000"  # Update from default to 1000
+    new_params["absolute_historical_block_cutoff"] = "1"   # Keep default cutoff
+
     # Create proposal JSON file
     proposal_data = {
         "messages": [
             {
                 "@type": "/dysonprotocol.script.v1.MsgUpdateParams",
                 "authority": gov_address,
-                "params": {
-                    "maxRelativeHistoricalBlocks": "1000",  # Update from default to 1000
-                    "absoluteHistoricalBlockCutoff": "1"    # Keep default cutoff
-                }
+                "params": new_params
             }
         ],
         "metadata": "ipfs://CID",
@@ -397,44 +400,264 @@ def query_heights(heights):
     
     assert script_result is not None, "Could not find script execution result in transaction events"
     
-    # Step 5: Verify that the return data is correct
+    # Step 5: Verify that every historical read is rejected, they are only served from snapshots
     print("Verifying query results...")
-    
-    # Parse the result
-    try:
-        query_results = script_result
-        print(f"Query results: {query_results}")
-        
-        # Verify each result
-        assert len(query_results) == 3, f"Expected 3 results, got {len(query_results)}"
-        
-        for i, (stored_height, result_data) in enumerate(zip(stored_heights, query_results)):
-            assert result_data is not None, f"Result {i+1} should not be None"
-            assert "@type" in result_data, f"Result {i+1} should contain '@type'"
-            assert result_data["@type"] == "/dysonprotocol.storage.v1.QueryStorageGetResponse", f"Result {i+1} should be a QueryStorageGetResponse"
-            assert "entry" in result_data, f"Result {i+1} should contain 'entry'"
-            assert "data" in result_data["entry"], f"Result {i+1} entry should contain 'data'"
-            
-            # Parse the JSON data from the entry
-            data_str = result_data["entry"]["data"]
-            data = json.loads(data_str)
-            assert "block_height" in data, f"Result {i+1} data should contain 'block_height'"
-            
-            # The stored height should match what we expect (within a reasonable range due to timing)
-            result_height = data["block_height"]
-            assert abs(result_height - stored_height) <= 2, \
-                f"Result {i+1}: expected height ~{stored_height}, got {result_height}"
-            
-            print(f"✓ Verified result {i+1}: stored at height {result_height}")
-        
-        print("✓ All storage history data verified successfully!")
-        
-    except (json.JSONDecodeError, TypeError) as e:
-        assert False, f"Failed to parse script result: {e}"
-    
+    assert len(script_result) == 3, f"Expected 3 results, got {len(script_result)}"
+    for i, result_data in enumerate(script_result):
+        assert "error" in result_data, f"Result {i+1} should be an error: {result_data}"
+        assert "use a snapshot query" in result_data["error"], f"Result {i+1} has an unexpected error: {result_data}"
+        print(f"✓ Historical read {i+1} rejected")
+
     print("Test completed successfully!")
 
 
+def test_script_snapshot_queries(chainnet, generate_account):
+    """
+    Snapshot queries record a query result on-chain every snapshot_interval blocks,
+    so scripts can read historical values without depending on node pruning.
+    """
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    snapshot_script_code = '''
+import json
+from dys import get_script_address, _msg, _query
+
+def store(value):
+    _msg({
+        "@type": "/dysonprotocol.storage.v1.MsgStorageSet",
+        "owner": get_script_address(),
+        "index": "snapshot_value",
+        "data": json.dumps({"value": value})
+    })
+
+def register():
+    _msg({
+        "@type": "/dysonprotocol.script.v1.MsgRegisterSnapshotQuery",
+        "owner": get_script_address(),
+        "name": "value",
+        "json_query": json.dumps({
+            "@type": "/dysonprotocol.storage.v1.QueryStorageGetRequest",
+            "owner": get_script_address(),
+            "index": "snapshot_value"
+        })
+    })
+
+def read(height):
+    return _query({
+        "@type": "/dysonprotocol.script.v1.QuerySnapshotRequest",
+        "owner": get_script_address(),
+        "name": "value",
+        "height": height
+    })
+'''
+
+    result = dysond_bin("tx", "script", "create-new-script", "--code", snapshot_script_code, "--from", alice_name)
+    assert result["code"] == 0, f"Failed to create snapshot script: {result}"
+    script_address = get_script_address_from_create_result(result)
+
+    params = dysond_bin("query", "script", "params")["params"]
+    interval = int(params["snapshot_interval"])
+    assert interval > 0, f"snapshots should be enabled by default: {params}"
+
+    def exec_fn(function_name, args):
+        result = dysond_bin("tx", "script", "exec", "--script-address", script_address, "--function-name", function_name, "--args", json.dumps(args), "--from", alice_name)
+        assert result["code"] == 0, f"Failed to execute {function_name}: {result}"
+        for event in result.get("events", []):
+            if event.get("type") == "dysonprotocol.script.v1.EventExecScript":
+                for attr in event.get("attributes", []):
+                    if attr.get("key") == "response":
+                        response_data = json.loads(attr.get("value"))
+                        return json.loads(response_data.get("result", "{}")).get("result")
+        return None
+
+    exec_fn("store", ["first"])
+    exec_fn("register", [])
+
+    queries = dysond_bin("query", "script", "snapshot-queries", script_address)
+    assert [q["name"] for q in queries["snapshot_queries"]] == ["value"], f"Unexpected snapshot queries: {queries}"
+
+    def snapshot_value(height=None):
+        args = ["query", "script", "snapshot", script_address, "value"]
+        if height:
+            args += ["--height", str(height)]
+        result = dysond_bin(*args)
+        if isinstance(result, str) or "snapshot" not in result:
+            return None, None
+        snapshot = result["snapshot"]
+        response = json.loads(snapshot["json_response"])
+        return int(snapshot["height"]), json.loads(response["entry"]["data"])["value"]
+
+    def _snapshot_of(expected):
+        return lambda: snapshot_value()[1] == expected
+
+    poll_until_condition(_snapshot_of("first"), timeout=interval * 3, poll_interval=1,
+                         error_message="first snapshot was not taken")
+    first_height, _ = snapshot_value()
+
+    exec_fn("store", ["second"])
+    poll_until_condition(_snapshot_of("second"), timeout=interval * 3, poll_interval=1,
+                         error_message="second snapshot was not taken")
+
+    # The older snapshot is still served for heights before the update
+    assert snapshot_value(first_height) == (first_height, "first")
+
+    # Scripts read the same snapshot deterministically through _query
+    script_result = exec_fn("read", [first_height])
+    assert int(script_result["snapshot"]["height"]) == first_height
+    assert json.loads(json.loads(script_result["snapshot"]["json_response"])["entry"]["data"])["value"] == "first"
+
+
+def test_script_msg_batch(chainnet, generate_account):
+    """
+    _msg_batch runs several messages either all-or-nothing ("atomic") or keeping
+    every message that succeeds ("best_effort"), and reports each outcome.
+    """
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    batch_script_code = f'''
+import json
+from dys import get_script_address, _msg_batch
+
+def batch(prefix, mode):
+    def store(owner, index):
+        return {{
+            "@type": "/dysonprotocol.storage.v1.MsgStorageSet",
+            "owner": owner,
+            "index": prefix + "_" + index,
+            "data": json.dumps({{"index": index}})
+        }}
+
+    return _msg_batch([
+        store(get_script_address(), "a"),
+        # the script is not allowed to sign for alice, so this message fails
+        store("{alice_address}", "b"),
+        store(get_script_address(), "c"),
+    ], mode=mode)
+'''
+
+    result = dysond_bin("tx", "script", "create-new-script", "--code", batch_script_code, "--from", alice_name)
+    assert result["code"] == 0, f"Failed to create batch script: {result}"
+    script_address = get_script_address_from_create_result(result)
+
+    def exec_batch(prefix, mode):
+        result = dysond_bin("tx", "script", "exec", "--script-address", script_address, "--function-name", "batch", "--args", json.dumps([prefix, mode]), "--from", alice_name)
+        assert result["code"] == 0, f"Failed to execute batch: {result}"
+        batch_result = None
+        outcome_events = []
+        indexed_storage_events = []
+        for event in result.get("events", []):
+            attrs = {attr.get("key"): attr.get("value") for attr in event.get("attributes", [])}
+            if event.get("type") == "dysonprotocol.script.v1.EventExecScript":
+                response_data = json.loads(attrs["response"])
+                batch_result = json.loads(response_data.get("result", "{}")).get("result")
+            elif event.get("type") == "dysonprotocol.script.v1.EventScriptBatchMsg":
+                outcome_events.append(attrs)
+            elif "batch_msg_index" in attrs and event.get("type").startswith("dysonprotocol.storage"):
+                indexed_storage_events.append(attrs["batch_msg_index"])
+        return batch_result, outcome_events, indexed_storage_events
+
+    def stored(index):
+        get_result = dysond_bin("query", "storage", "get", script_address, "--index", index)
+        return not isinstance(get_result, str)
+
+    # Atomic: the failure of the second message discards the first and skips the third
+    batch_result, outcome_events, indexed_storage_events = exec_batch("atomic", "atomic")
+    assert batch_result["success"] is False
+    assert [r["executed"] for r in batch_result["results"]] == [True, True, False]
+    assert [r["success"] for r in batch_result["results"]] == [False, False, False]
+    assert "rolled back" in batch_result["results"][0]["error"]
+    assert batch_result["results"][1]["error"]
+    assert len(outcome_events) == 3
+    assert indexed_storage_events == []
+    assert not stored("atomic_a")
+    assert not stored("atomic_c")
+
+    # Best effort: the first and third messages are kept
+    batch_result, outcome_events, indexed_storage_events = exec_batch("best", "best_effort")
+    assert batch_result["success"] is False
+    assert [r["success"] for r in batch_result["results"]] == [True, False, True]
+    assert [r["type_url"] for r in batch_result["results"]] == ["/dysonprotocol.storage.v1.MsgStorageSet"] * 3
+    assert [json.loads(e["success"]) for e in outcome_events] == [True, False, True]
+    assert sorted(set(indexed_storage_events)) == ["0", "2"]
+    assert stored("best_a")
+    assert not stored("best_b")
+    assert stored("best_c")
+
+
+def test_script_gas_sponsorship(chainnet, generate_account, faucet):
+    """
+    A script with a gas sponsorship pays the fees of eligible MsgExec calls to it,
+    so users without dys can call it.
+    """
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    # bob holds no dys at all
+    [bob_name, bob_address] = generate_account('bob', faucet_amount=0)
+
+    sponsor_script_code = '''
+from dys import get_script_address, _msg
+
+def sponsor(spend_limit):
+    _msg({
+        "@type": "/dysonprotocol.script.v1.MsgSetGasSponsorship",
+        "script_address": get_script_address(),
+        "sponsorship": {
+            "script_address": get_script_address(),
+            "spend_limit": [{"denom": "dys", "amount": str(spend_limit)}],
+            "allowed_functions": ["ping"],
+            "max_txs_per_user": "1",
+            "window_blocks": "1000"
+        }
+    })
+
+def ping():
+    return "pong"
+
+def not_sponsored():
+    return "pong"
+'''
+
+    result = dysond_bin("tx", "script", "create-new-script", "--code", sponsor_script_code, "--from", alice_name)
+    assert result["code"] == 0, f"Failed to create sponsor script: {result}"
+    script_address = get_script_address_from_create_result(result)
+    faucet(script_address, amount=1000)
+
+    result = dysond_bin("tx", "script", "exec", "--script-address", script_address, "--function-name", "sponsor", "--args", json.dumps([500]), "--from", alice_name)
+    assert result["code"] == 0, f"Failed to set gas sponsorship: {result}"
+
+    sponsorship = dysond_bin("query", "script", "gas-sponsorship", script_address)["sponsorship"]
+    assert sponsorship["allowed_functions"] == ["ping"]
+    assert sponsorship["spend_limit"] == [{"denom": "dys", "amount": "500"}]
+
+    def balance(address):
+        balances = dysond_bin("query", "bank", "balances", address)["balances"]
+        return next((int(c["amount"]) for c in balances if c["denom"] == "dys"), 0)
+
+    script_balance_before = balance(script_address)
+
+    # bob cannot pay, the script pays the fee of the sponsored call
+    result = dysond_bin("tx", "script", "exec", "--script-address", script_address, "--function-name", "ping", "--fees", "100dys", "--from", bob_name)
+    assert result["code"] == 0, f"Sponsored call failed: {result}"
+    assert any(e["type"] == "dysonprotocol.script.v1.EventGasSponsored" for e in result["events"])
+    assert balance(bob_address) == 0
+    assert balance(script_address) == script_balance_before - 100
+
+    sponsorship = dysond_bin("query", "script", "gas-sponsorship", script_address, "--user", bob_address)
+    assert sponsorship["sponsorship"]["spend_limit"] == [{"denom": "dys", "amount": "400"}]
+    assert int(sponsorship["usage"]["tx_count"]) == 1
+
+    # functions outside the policy, extra code redefining a sponsored function and calls over the
+    # rate limit are paid by the signer
+    with pytest.raises(Exception, match="insufficient"):
+        dysond_bin("tx", "script", "exec", "--script-address", script_address, "--function-name", "not_sponsored", "--fees", "100dys", "--from", bob_name)
+    with pytest.raises(Exception, match="insufficient"):
+        dysond_bin("tx", "script", "exec", "--script-address", script_address, "--function-name", "ping", "--extra-code", "def ping():\n    return 'overridden'", "--fees", "100dys", "--from", bob_name)
+    with pytest.raises(Exception, match="insufficient"):
+        dysond_bin("tx", "script", "exec", "--script-address", script_address, "--function-name", "ping", "--fees", "100dys", "--from", bob_name)
+
+
 def get_script_address_from_create_result(create_result):
     """Helper function to extract script address from create script transaction result"""
     for event in create_result.get("events", []):
@@ -177,7 +177,7 @@ def get_info():
     assert "not authorized" in exec_result.get("raw_log", ""), "Expected unauthorized error"
     print("✓ Bob correctly failed to execute disallowed function 'multiply'")
     
-    # Test 4: Bob tries to execute without function name (direct script execution) - should succeed
+    # Test 4: Bob tries to execute without function name (direct script execution) - should fail
     exec_msg["function_name"] = ""
     exec_msg["args"] = "[]"
     
@@ -190,8 +190,9 @@ def get_info():
             "--from", bob_name
         )
     
-    assert exec_result["code"] == 0, f"Expected direct script execution to succeed: {exec_result}"
-    print("✓ Bob successfully executed script directly (direct execution is always allowed)")
+    assert exec_result["code"] != 0, "Expected direct script execution to fail with a function whitelist"
+    assert "direct script execution not authorized" in exec_result.get("raw_log", "")
+    print("✓ Bob correctly failed to execute script directly (only the listed functions are allowed)")
 
 
 def test_exec_authorization_empty_function_list(chainnet, generate_account, faucet):
@@ -489,4 +490,106 @@ def test_exec_authorization_wrong_script_address(chainnet, generate_account, fau
     
     assert exec_result["code"] != 0, "Expected execution to fail for wrong script address"
     assert "script address mismatch" in exec_result.get("raw_log", ""), "Expected script address mismatch error"
-    print("✓ ScriptExecAuthorization correctly rejected execution on wrong script address") 
\ No newline at end of file
+    print("✓ ScriptExecAuthorization correctly rejected execution on wrong script address") 
+
+def test_exec_authorization_session_key(chainnet, generate_account, faucet):
+    """
+    Test a session key grant: a limited number of calls to one function with
+    constrained arguments and no attached fund transfers.
+    """
+    dysond_bin = chainnet[0]
+
+    [alice_name, alice_address] = generate_account('alice')
+    [bob_name, bob_address] = generate_account('bob')
+
+    script_code = """
+def move(direction):
+    return direction
+"""
+    create_result = dysond_bin(
+        "tx", "script", "create-new-script",
+        "--code", script_code,
+        "--from", alice_name
+    )
+    script_address = None
+    for event in create_result["events"]:
+        if event["type"] == "dysonprotocol.script.v1.EventCreateNewScript":
+            for attr in event["attributes"]:
+                if attr["key"] == "script_address":
+                    script_address = json.loads(attr["value"])
+    assert script_address, "Script address not found in transaction events"
+
+    expiration = (datetime.now(timezone.utc) + timedelta(hours=1)).strftime("%Y-%m-%dT%H:%M:%SZ")
+    grant_result = dysond_bin(
+        "tx", "script", "grant-exec", bob_address,
+        "--script-address", script_address,
+        "--function-names", "move",
+        "--restrict-attached-messages",
+        "--max-calls", "2",
+        "--max-calls-per-window", "5",
+        "--window-blocks", "100",
+        "--args-pattern", 'move=\\["(up|down|left|right)"\\]',
+        "--expiration", expiration,
+        "--from", alice_name
+    )
+    assert grant_result["code"] == 0, f"Failed to grant authorization: {grant_result}"
+
+    def exec_as_bob(args, attached_messages=None, extra_code=""):
+        exec_msg = {
+            "@type": "/dysonprotocol.script.v1.MsgExec",
+            "executor_address": alice_address,
+            "script_address": script_address,
+            "extra_code": extra_code,
+            "function_name": "move",
+            "args": json.dumps(args),
+            "kwargs": "{}",
+            "attached_messages": attached_messages or [],
+        }
+        with tempfile.NamedTemporaryFile(mode='w', suffix='.json', delete=True) as tx_file:
+            json.dump({"body": {"messages": [exec_msg]}}, tx_file)
+            tx_file.flush()
+            return dysond_bin("tx", "authz", "exec", tx_file.name, "--from", bob_name)
+
+    def get_grant():
+        grants = dysond_bin("query", "authz", "grants", alice_address, bob_address)
+        if isinstance(grants, str):
+            return None
+        for grant in grants.get("grants", []):
+            if grant["authorization"].get("type") == "/dysonprotocol.script.v1.ScriptExecAuthorization":
+                return grant["authorization"]["value"]
+        return None
+
+    # Arguments outside the pattern are rejected
+    exec_result = exec_as_bob(["teleport"])
+    assert exec_result["code"] != 0, "Expected execution with disallowed arguments to fail"
+    assert "does not match" in exec_result.get("raw_log", "")
+
+    # Extra code redefining the whitelisted function is rejected
+    exec_result = exec_as_bob(["up"], extra_code="def move(*args, **kwargs):\n    return 'overridden'")
+    assert exec_result["code"] != 0, "Expected execution with extra code to fail"
+    assert "extra code not authorized" in exec_result.get("raw_log", "")
+
+    # Attached fund transfers are rejected as the grant is restricted without max funds
+    send_msg = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": alice_address,
+        "to_address": bob_address,
+        "amount": [{"denom": "dys", "amount": "1"}],
+    }
+    exec_result = exec_as_bob(["up"], attached_messages=[send_msg])
+    assert exec_result["code"] != 0, "Expected execution with attached funds to fail"
+
+    # The first call decrements the remaining calls
+    exec_result = exec_as_bob(["up"])
+    assert exec_result["code"] == 0, f"Failed to execute move: {exec_result}"
+    grant = get_grant()
+    assert int(grant["max_calls"]) == 1
+    assert int(grant["window_calls"]) == 1
+
+    # The last call deletes the grant
+    exec_result = exec_as_bob(["left"])
+    assert exec_result["code"] == 0, f"Failed to execute move: {exec_result}"
+    assert get_grant() is None
+
+    exec_result = exec_as_bob(["down"])
+    assert exec_result["code"] != 0, "Expected execution after the last call to fail"
@@ -0,0 +1,94 @@
+import json
+import tempfile
+
+
+def _exec_set(dysond_bin, grantee_name, owner, index, data):
+    """Set an entry of owner through the authz grant of grantee"""
+    tx_body = {
+        "body": {
+            "messages": [
+                {
+                    "@type": "/dysonprotocol.storage.v1.MsgStorageSet",
+                    "owner": owner,
+                    "index": index,
+                    "data": data,
+                }
+            ]
+        }
+    }
+    with tempfile.NamedTemporaryFile(mode='w', suffix='.json', delete=True) as tx_file:
+        json.dump(tx_body, tx_file)
+        tx_file.flush()
+        return dysond_bin("tx", "authz", "exec", tx_file.name, "--from", grantee_name)
+
+
+def _write_grant(dysond_bin, granter, grantee):
+    grants = dysond_bin("query", "authz", "grants", granter, grantee, "/dysonprotocol.storage.v1.MsgStorageSet")
+    if isinstance(grants, str):
+        return None
+    for grant in grants.get("grants", []):
+        auth = grant.get("authorization", {})
+        if auth.get("type") == "/dysonprotocol.storage.v1.StorageWriteAuthorization":
+            return auth["value"]
+    return None
+
+
+def test_storage_write_authorization(chainnet, generate_account):
+    """A grantee can set the entries of the granter within the prefix, bytes and schema of its grant"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    [bob_name, bob_address] = generate_account('bob')
+
+    schema = {
+        "type": "object",
+        "required": ["title"],
+        "properties": {"title": {"type": "string", "maxLength": 20}},
+    }
+    result = dysond_bin("tx", "storage", "grant-write", bob_address, "--index-prefix", "posts/",
+                        "--max-bytes", "60", "--json-schema", json.dumps(schema), "--from", alice_name)
+    assert result["code"] == 0, f"Failed to grant: {result}"
+
+    grant = _write_grant(dysond_bin, alice_address, bob_address)
+    assert grant is not None, "StorageWriteAuthorization grant not found"
+    assert grant["index_prefix"] == "posts/" and grant["max_bytes"] == "60", grant
+
+    # 7 bytes of index and 15 bytes of data
+    result = _exec_set(dysond_bin, bob_name, alice_address, "posts/1", '{"title": "hi"}')
+    assert result["code"] == 0, f"Authorized set failed: {result['raw_log']}"
+    entry = dysond_bin("query", "storage", "get", alice_address, "--index", "posts/1")["entry"]
+    assert entry["owner"] == alice_address and entry["data"] == '{"title": "hi"}', entry
+    assert _write_grant(dysond_bin, alice_address, bob_address)["max_bytes"] == "38"
+
+    result = _exec_set(dysond_bin, bob_name, alice_address, "config", '{"title": "hi"}')
+    assert result["code"] != 0 and "outside of the authorized prefix" in result["raw_log"], result
+
+    result = _exec_set(dysond_bin, bob_name, alice_address, "posts/2", '{"body": "no title"}')
+    assert result["code"] != 0 and "does not match the json schema" in result["raw_log"], result
+
+    result = _exec_set(dysond_bin, bob_name, alice_address, "posts/2", "not json")
+    assert result["code"] != 0 and "does not match the json schema" in result["raw_log"], result
+
+    result = _exec_set(dysond_bin, bob_name, alice_address, "posts/2", json.dumps({"title": "x" * 30}))
+    assert result["code"] != 0 and "does not match the json schema" in result["raw_log"], result
+
+    result = _exec_set(dysond_bin, bob_name, alice_address, "posts/2", json.dumps({"title": "x" * 20}))
+    assert result["code"] != 0 and "exceeds the 38 bytes left" in result["raw_log"], result
+
+    # Using up the bytes left deletes the grant: 7 bytes of index and 31 bytes of data
+    result = _exec_set(dysond_bin, bob_name, alice_address, "posts/2", json.dumps({"title": "x" * 18}))
+    assert result["code"] == 0, f"Authorized set failed: {result['raw_log']}"
+    assert _write_grant(dysond_bin, alice_address, bob_address) is None
+
+    result = _exec_set(dysond_bin, bob_name, alice_address, "posts/3", '{"title": "hi"}')
+    assert result["code"] != 0, result
+
+
+def test_storage_write_authorization_rejects_unsupported_schema(chainnet, generate_account):
+    """Schemas with keywords that are not supported cannot be granted"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+    [bob_name, bob_address] = generate_account('bob')
+
+    result = dysond_bin("tx", "storage", "grant-write", bob_address, "--json-schema", '{"$ref": "#/defs/post"}',
+                        "--from", alice_name)
+    assert "unsupported keyword $ref" in str(result), result
@@ -0,0 +1,155 @@
+import base64
+import hashlib
+import os
+import tempfile
+import requests
+from tests.utils import dys_balance, update_storage_params
+
+
+def _content_hash(content):
+    return "sha256-" + base64.b64encode(hashlib.sha256(content).digest()).decode()
+
+
+def _write_file(content, suffix=".bin"):
+    f = tempfile.NamedTemporaryFile(mode='wb', suffix=suffix, delete=False)
+    f.write(content)
+    f.close()
+    return f.name
+
+
+def test_storage_blob_set_and_range_get(chainnet, generate_account):
+    """Binary blobs keep their bytes and content type and can be read by range"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    content = bytes(range(256))
+    path = _write_file(content)
+    try:
+        result = dysond_bin("tx", "storage", "set", "--index", "static/bytes.bin", "--data-path", path,
+                            "--blob", "--content-type", "application/octet-stream", "--from", alice_name)
+        assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+    finally:
+        os.remove(path)
+
+    response = dysond_bin("query", "storage", "get", alice_address, "--index", "static/bytes.bin")
+    entry = response["entry"]
+    assert base64.b64decode(entry["blob"]) == content
+    assert entry.get("data", "") == ""
+    assert entry["content_type"] == "application/octet-stream"
+    assert entry["hash"] == _content_hash(content)
+    assert response["size"] == "256"
+
+    response = dysond_bin("query", "storage", "get", alice_address, "--index", "static/bytes.bin",
+                          "--offset", "250", "--length", "10")
+    assert base64.b64decode(response["entry"]["blob"]) == content[250:]
+    assert response["size"] == "256"
+
+    response = dysond_bin("query", "storage", "get", alice_address, "--index", "static/bytes.bin", "--offset", "300")
+    assert isinstance(response, str) and "offset" in response, response
+
+
+def test_storage_chunked_upload(chainnet, generate_account, api_address):
+    """A file uploaded in chunks is written to its entry on commit and streamed by the dwapp server"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    content = os.urandom(250)
+    path = _write_file(content, suffix=".png")
+    index = "static/image.png"
+    upload_args = ["--index", index, "--file", path, "--chunk-size", "100", "--from", alice_name]
+    try:
+        result = dysond_bin("tx", "storage", "upload-begin", *upload_args)
+        assert result["code"] == 0, f"Upload begin failed: {result['raw_log']}"
+
+        result = dysond_bin("tx", "storage", "upload-begin", *upload_args)
+        assert result["code"] != 0 and "already in progress" in result["raw_log"], result
+
+        response = dysond_bin("query", "storage", "upload", alice_address, "--index", index)
+        upload = response["upload"]
+        assert upload["size"] == "250" and upload["content_type"] == "image/png", upload
+        assert upload["hash"] == _content_hash(content)
+        assert response["missing_chunks"] == [0, 1, 2], response
+
+        # Chunks are uploaded in any order and the entry is unchanged until the commit
+        for chunk in ["2", "0"]:
+            result = dysond_bin("tx", "storage", "upload-chunk", *upload_args, "--chunk", chunk)
+            assert result["code"] == 0, f"Upload chunk failed: {result['raw_log']}"
+
+        result = dysond_bin("tx", "storage", "upload-commit", "--index", index, "--from", alice_name)
+        assert result["code"] != 0 and "missing 1 chunks" in result["raw_log"], result
+        assert isinstance(dysond_bin("query", "storage", "get", alice_address, "--index", index), str)
+
+        # A chunk of another file does not match the manifest
+        other_path = _write_file(os.urandom(250))
+        try:
+            result = dysond_bin("tx", "storage", "upload-chunk", "--index", index, "--file", other_path,
+                                "--chunk-size", "100", "--chunk", "1", "--from", alice_name)
+            assert result["code"] != 0 and "does not match its manifest hash" in result["raw_log"], result
+        finally:
+            os.remove(other_path)
+
+        result = dysond_bin("tx", "storage", "upload-chunk", *upload_args, "--chunk", "1")
+        assert result["code"] == 0, f"Upload chunk failed: {result['raw_log']}"
+
+        result = dysond_bin("tx", "storage", "upload-commit", "--index", index, "--from", alice_name)
+        assert result["code"] == 0, f"Upload commit failed: {result['raw_log']}"
+    finally:
+        os.remove(path)
+
+    entry = dysond_bin("query", "storage", "get", alice_address, "--index", index)["entry"]
+    assert base64.b64decode(entry["blob"]) == content
+    assert entry["content_type"] == "image/png"
+    assert isinstance(dysond_bin("query", "storage", "upload", alice_address, "--index", index), str)
+
+    api_url = f"http://localhost:{api_address['port']}/_/storage/{index}"
+    headers = {"Host": f"{alice_address}.localhost"}
+    response = requests.get(api_url, timeout=5, headers=headers)
+    assert response.status_code == 200, response.text
+    assert response.content == content
+    assert response.headers["Content-Type"] == "image/png"
+    assert response.headers["ETag"] == f'"{entry["hash"]}"'
+
+    response = requests.get(api_url, timeout=5, headers={**headers, "Range": "bytes=100-149"})
+    assert response.status_code == 206, response.text
+    assert response.content == content[100:150]
+
+    response = requests.get(api_url, timeout=5, headers={**headers, "If-None-Match": f'"{entry["hash"]}"'})
+    assert response.status_code == 304
+
+
+def test_storage_upload_cancel_refunds_deposit(chainnet, generate_account):
+    """Cancelling an upload drops its chunks and refunds its deposit"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob', faucet_amount=10000)
+
+    validator = dysond_bin("query", "staking", "validators")["validators"][0]["operator_address"]
+    result = dysond_bin("tx", "staking", "delegate", validator, "20000dys", "--from", alice_name)
+    assert result["code"] == 0, f"Failed to delegate: {result}"
+
+    original_params = dysond_bin("query", "storage", "params")["params"]
+    params = dict(original_params)
+    params["deposit_per_byte"] = {"denom": "dys", "amount": "1"}
+    update_storage_params(dysond_bin, alice_name, params)
+
+    path = _write_file(os.urandom(300))
+    try:
+        bob_before = dys_balance(dysond_bin, bob_address)
+        upload_args = ["--index", "big", "--file", path, "--chunk-size", "100", "--from", bob_name]
+
+        # 3 bytes of index and 300 bytes of content
+        result = dysond_bin("tx", "storage", "upload-begin", *upload_args)
+        assert result["code"] == 0, f"Upload begin failed: {result['raw_log']}"
+        assert dys_balance(dysond_bin, bob_address) == bob_before - 303
+
+        result = dysond_bin("tx", "storage", "upload-chunk", *upload_args, "--chunk", "0")
+        assert result["code"] == 0, f"Upload chunk failed: {result['raw_log']}"
+
+        result = dysond_bin("tx", "storage", "upload-cancel", "--index", "big", "--from", bob_name)
+        assert result["code"] == 0, f"Upload cancel failed: {result['raw_log']}"
+        assert dys_balance(dysond_bin, bob_address) == bob_before
+        assert isinstance(dysond_bin("query", "storage", "upload", bob_address, "--index", "big"), str)
+        assert isinstance(dysond_bin("query", "storage", "get", bob_address, "--index", "big"), str)
+    finally:
+        os.remove(path)
+        update_storage_params(dysond_bin, alice_name, original_params)
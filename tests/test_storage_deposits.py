@@ -0,0 +1,79 @@
+import json
+from tests.utils import dys_balance, storage_set, update_storage_params
+
+
+def _usage(dysond_bin, address):
+    usage = dysond_bin("query", "storage", "usage", address)["usage"]
+    deposit = sum(int(c["amount"]) for c in usage.get("deposit", []) if c["denom"] == "dys")
+    return int(usage.get("bytes", 0)), int(usage.get("entries", 0)), deposit
+
+
+def test_storage_deposits_and_quotas(chainnet, generate_account):
+    """Entries lock a per-byte deposit refunded on shrink and delete, within the size and owner quotas"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice', faucet_amount=1000000)
+    [bob_name, bob_address] = generate_account('bob', faucet_amount=10000)
+
+    validator = dysond_bin("query", "staking", "validators")["validators"][0]["operator_address"]
+    result = dysond_bin("tx", "staking", "delegate", validator, "20000dys", "--from", alice_name)
+    assert result["code"] == 0, f"Failed to delegate: {result}"
+
+    original_params = dysond_bin("query", "storage", "params")["params"]
+    params = dict(original_params)
+    params["deposit_per_byte"] = {"denom": "dys", "amount": "2"}
+    params["max_entry_size"] = "64"
+    params["max_bytes_per_owner"] = "128"
+    params["max_entries_per_owner"] = "3"
+    update_storage_params(dysond_bin, alice_name, params)
+
+    try:
+        bob_before = dys_balance(dysond_bin, bob_address)
+
+        # 2 bytes of index and 10 bytes of data
+        result = storage_set(dysond_bin, bob_name, "k1", "0123456789")
+        assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+        assert _usage(dysond_bin, bob_address) == (12, 1, 24)
+        assert dys_balance(dysond_bin, bob_address) == bob_before - 24
+        entry = dysond_bin("query", "storage", "get", bob_address, "--index", "k1")["entry"]
+        assert entry["deposit"] == [{"denom": "dys", "amount": "24"}], entry
+
+        # Shrinking the entry refunds the difference
+        result = storage_set(dysond_bin, bob_name, "k1", "0123")
+        assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+        assert _usage(dysond_bin, bob_address) == (6, 1, 12)
+        assert dys_balance(dysond_bin, bob_address) == bob_before - 12
+
+        result = storage_set(dysond_bin, bob_name, "k2", "x" * 63)
+        assert result["code"] != 0 and "entry too large" in result["raw_log"], result
+
+        result = storage_set(dysond_bin, bob_name, "k2", "x" * 60)
+        assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+        result = storage_set(dysond_bin, bob_name, "k3", "x" * 60)
+        assert result["code"] != 0 and "storage quota exceeded" in result["raw_log"], result
+
+        result = storage_set(dysond_bin, bob_name, "k3", "x")
+        assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+        result = storage_set(dysond_bin, bob_name, "k4", "x")
+        assert result["code"] != 0 and "storage quota exceeded" in result["raw_log"], result
+        assert _usage(dysond_bin, bob_address) == (71, 3, 142)
+
+        # Deleting the entries refunds the whole deposit
+        result = dysond_bin("tx", "storage", "delete", "--indexes", "k1,k2,k3", "--from", bob_name)
+        assert result["code"] == 0, f"Delete failed: {result['raw_log']}"
+        assert _usage(dysond_bin, bob_address) == (0, 0, 0)
+        assert dys_balance(dysond_bin, bob_address) == bob_before
+    finally:
+        update_storage_params(dysond_bin, alice_name, original_params)
+
+
+def test_storage_params_update_requires_authority(chainnet, generate_account):
+    """Only the gov module account can update the storage params"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    params = dysond_bin("query", "storage", "params")["params"]
+    assert params["max_entry_size"] == "1048576", params
+    assert params["deposit_per_byte"] == {"denom": "dys", "amount": "0"}, params
+
+    result = dysond_bin("tx", "storage", "update-params", "--params", json.dumps(params), "--from", alice_name)
+    assert "invalid authority" in str(result), result
@@ -0,0 +1,46 @@
+import time
+from tests.utils import poll_until_condition, storage_set
+
+
+def test_storage_entry_expiry(chainnet, generate_account):
+    """Entries with an expiry are deleted in the blocks after it, while the others are kept"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    result = storage_set(dysond_bin, alice_name, "sessions/1", '{"user": "alice"}', "--ttl", "5s")
+    assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+    result = storage_set(dysond_bin, alice_name, "profile", "kept")
+    assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+
+    entry = dysond_bin("query", "storage", "get", alice_address, "--index", "sessions/1")["entry"]
+    assert int(entry["expires_at"]) > 0, entry
+
+    def expired():
+        result = dysond_bin("query", "storage", "get", alice_address, "--index", "sessions/1")
+        return isinstance(result, str) and "doesn't exist" in result
+
+    poll_until_condition(expired, timeout=60, poll_interval=2, error_message="Entry did not expire")
+
+    entry = dysond_bin("query", "storage", "get", alice_address, "--index", "profile")["entry"]
+    assert entry["data"] == "kept", entry
+    usage = dysond_bin("query", "storage", "usage", alice_address)["usage"]
+    assert usage["entries"] == "1", usage
+
+
+def test_storage_expiry_updates(chainnet, generate_account):
+    """Every write sets the expiry of the entry, which must be in the future"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    result = storage_set(dysond_bin, alice_name, "cache", "v1", "--expires-at", "1")
+    assert "is not in the future" in str(result), result
+
+    result = storage_set(dysond_bin, alice_name, "cache", "v1", "--ttl", "5s")
+    assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+
+    # Writing the entry again without an expiry keeps it
+    result = storage_set(dysond_bin, alice_name, "cache", "v2")
+    assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+    time.sleep(10)
+    entry = dysond_bin("query", "storage", "get", alice_address, "--index", "cache")["entry"]
+    assert entry["data"] == "v2" and entry.get("expires_at", "0") == "0", entry
@@ -0,0 +1,72 @@
+from tests.utils import storage_set
+
+
+def _get(dysond_bin, address, index):
+    return dysond_bin("query", "storage", "get", address, "--index", index)["entry"]
+
+
+def test_storage_conditional_writes(chainnet, generate_account):
+    """Writes can require the entry not to exist or to still have the hash that was read"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    result = storage_set(dysond_bin, alice_name, "doc", "v1", "--if-not-exists")
+    assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+    entry = _get(dysond_bin, alice_address, "doc")
+    assert entry["version"] == "1", entry
+
+    result = storage_set(dysond_bin, alice_name, "doc", "again", "--if-not-exists")
+    assert result["code"] != 0 and "already exists" in result["raw_log"], result
+
+    # Two writers read the same version, only the first update succeeds
+    read_hash = entry["hash"]
+    result = storage_set(dysond_bin, alice_name, "doc", "v2 from writer 1", "--expected-hash", read_hash)
+    assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+    result = storage_set(dysond_bin, alice_name, "doc", "v2 from writer 2", "--expected-hash", read_hash)
+    assert result["code"] != 0 and "storage precondition failed" in result["raw_log"], result
+
+    entry = _get(dysond_bin, alice_address, "doc")
+    assert entry["data"] == "v2 from writer 1" and entry["version"] == "2", entry
+
+    result = storage_set(dysond_bin, alice_name, "missing", "v1", "--expected-hash", read_hash)
+    assert result["code"] != 0 and "does not exist" in result["raw_log"], result
+
+
+def test_storage_version_history(chainnet, generate_account):
+    """Entries retain their last versions, counted in the owner usage, until the retention is dropped"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    for data in ["v1", "v2", "v3", "v4"]:
+        result = storage_set(dysond_bin, alice_name, "doc", data, "--keep-versions", "2")
+        assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+
+    history = dysond_bin("query", "storage", "history", alice_address, "--index", "doc")
+    assert [v["data"] for v in history["versions"]] == ["v2", "v3"], history
+    assert [v["version"] for v in history["versions"]] == ["2", "3"], history
+
+    history = dysond_bin("query", "storage", "history", alice_address, "--index", "doc", "--reverse", "--limit", "1")
+    assert [v["data"] for v in history["versions"]] == ["v3"], history
+
+    # 3 bytes of index and 2 bytes of data for the entry and each retained version
+    entry = _get(dysond_bin, alice_address, "doc")
+    assert entry["version"] == "4" and entry["history_size"] == "10", entry
+    usage = dysond_bin("query", "storage", "usage", alice_address)["usage"]
+    assert usage["bytes"] == "15", usage
+
+    # A write without retention prunes the history
+    result = storage_set(dysond_bin, alice_name, "doc", "v5")
+    assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+    history = dysond_bin("query", "storage", "history", alice_address, "--index", "doc")
+    assert history.get("versions", []) == [], history
+    usage = dysond_bin("query", "storage", "usage", alice_address)["usage"]
+    assert usage["bytes"] == "5", usage
+
+    # Deleting the entry deletes its history
+    for data in ["v6", "v7"]:
+        result = storage_set(dysond_bin, alice_name, "doc", data, "--keep-versions", "3")
+        assert result["code"] == 0, f"Set failed: {result['raw_log']}"
+    result = dysond_bin("tx", "storage", "delete", "--indexes", "doc", "--from", alice_name)
+    assert result["code"] == 0, f"Delete failed: {result['raw_log']}"
+    history = dysond_bin("query", "storage", "history", alice_address, "--index", "doc")
+    assert history.get("versions", []) == [], history
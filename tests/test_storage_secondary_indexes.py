@@ -0,0 +1,73 @@
+from tests.utils import storage_set
+
+
+def _find(dysond_bin, address, name, *args):
+    result = dysond_bin("query", "storage", "find", address, "--name", name, *args)
+    return [entry["index"] for entry in result.get("entries", [])]
+
+
+def test_storage_secondary_index_lookups(chainnet, generate_account):
+    """Entries are found by the value at the path of a secondary index, exactly or in a range"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    assert storage_set(dysond_bin, alice_name, "products/1", {"category": "books", "price": 12})["code"] == 0
+    assert storage_set(dysond_bin, alice_name, "products/2", {"category": "games", "price": 30})["code"] == 0
+    assert storage_set(dysond_bin, alice_name, "notes/1", {"category": "books", "price": 15})["code"] == 0
+
+    # The existing entries under the prefix are indexed on creation
+    result = dysond_bin("tx", "storage", "create-secondary-index", "--name", "category", "--path", "$.category",
+                        "--index-prefix", "products/", "--from", alice_name)
+    assert result["code"] == 0, f"Create failed: {result['raw_log']}"
+    result = dysond_bin("tx", "storage", "create-secondary-index", "--name", "price", "--path", "$.price",
+                        "--index-prefix", "products/", "--from", alice_name)
+    assert result["code"] == 0, f"Create failed: {result['raw_log']}"
+
+    indexes = dysond_bin("query", "storage", "secondary-indexes", alice_address)["indexes"]
+    assert [(i["name"], i["path"]) for i in indexes] == [("category", "$.category"), ("price", "$.price")], indexes
+
+    assert _find(dysond_bin, alice_address, "category", "--value", "books") == ["products/1"]
+
+    # Writes keep the indexes up to date
+    assert storage_set(dysond_bin, alice_name, "products/3", {"category": "books", "price": 8.5})["code"] == 0
+    assert storage_set(dysond_bin, alice_name, "products/2", {"category": "books", "price": 20})["code"] == 0
+    assert _find(dysond_bin, alice_address, "category", "--value", "books") == ["products/1", "products/2", "products/3"]
+    assert _find(dysond_bin, alice_address, "category", "--value", '"games"') == []
+
+    # Ranges are ordered by value, min inclusive and max exclusive
+    assert _find(dysond_bin, alice_address, "price", "--min", "8.5", "--max", "20") == ["products/3", "products/1"]
+    assert _find(dysond_bin, alice_address, "price", "--min", "10") == ["products/1", "products/2"]
+    assert _find(dysond_bin, alice_address, "price", "--max", "20", "--reverse") == ["products/1", "products/3"]
+    assert _find(dysond_bin, alice_address, "price", "--limit", "1", "--reverse") == ["products/2"]
+
+    result = dysond_bin("tx", "storage", "delete", "--indexes", "products/1", "--from", alice_name)
+    assert result["code"] == 0, f"Delete failed: {result['raw_log']}"
+    assert _find(dysond_bin, alice_address, "price") == ["products/3", "products/2"]
+
+    result = dysond_bin("tx", "storage", "delete-secondary-index", "--name", "price", "--from", alice_name)
+    assert result["code"] == 0, f"Delete index failed: {result['raw_log']}"
+    result = dysond_bin("query", "storage", "find", alice_address, "--name", "price")
+    assert "doesn't exist" in str(result), result
+
+
+def test_storage_secondary_index_validation(chainnet, generate_account):
+    """Secondary indexes need a valid name and path and a name that is not taken"""
+    dysond_bin = chainnet[0]
+    [alice_name, alice_address] = generate_account('alice')
+
+    result = dysond_bin("tx", "storage", "create-secondary-index", "--name", "tags", "--path", "tags[0]",
+                        "--from", alice_name)
+    assert "invalid secondary index path" in str(result), result
+
+    result = dysond_bin("tx", "storage", "create-secondary-index", "--name", "tags", "--path", "$.tag",
+                        "--from", alice_name)
+    assert result["code"] == 0, f"Create failed: {result['raw_log']}"
+    result = dysond_bin("tx", "storage", "create-secondary-index", "--name", "tags", "--path", "$.other",
+                        "--from", alice_name)
+    assert "already exists" in str(result), result
+
+    result = dysond_bin("tx", "storage", "delete-secondary-index", "--name", "missing", "--from", alice_name)
+    assert "does not exist" in str(result), result
+
+    result = dysond_bin("query", "storage", "find", alice_address, "--name", "tags", "--value", "a", "--min", "b")
+    assert "cannot be combined" in str(result), result
@@ -1,6 +1,9 @@
 """
 Utility functions for testing blockchain operations.
 """
+import datetime
+import json
+import tempfile
 import time
 from typing import Callable, TypeVar
 
@@ -47,3 +50,111 @@ def poll_until_condition(
     
     raise Exception(f"TIMEOUT: {error_message} after {timeout}s")
 
+
+def get_blockchain_time(dysond_bin) -> int:
+    """Get the current blockchain time from the node status."""
+    status = dysond_bin("status")
+    latest_block_time = status.get("SyncInfo", {}).get("latest_block_time", "")
+    if latest_block_time:
+        # Parse the time (format: 2023-10-01T12:34:56.789Z)
+        dt = datetime.datetime.strptime(latest_block_time, "%Y-%m-%dT%H:%M:%S.%fZ")
+        return int(dt.timestamp())
+    return int(time.time())  # Fallback if parsing fails
+
+
+def dys_balance(dysond_bin, address) -> int:
+    """Get the dys balance of an address."""
+    balances = dysond_bin("query", "bank", "balances", address)["balances"]
+    return sum(int(c["amount"]) for c in balances if c["denom"] == "dys")
+
+
+def get_task(dysond_bin, task_id) -> dict:
+    """Get a crontask by ID."""
+    return dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id))["task"]
+
+
+def task_id_from_result(create_result) -> int:
+    """Extract the task ID from the result of a create-task transaction."""
+    for event in create_result.get("events", []):
+        if event.get("type") == "dysonprotocol.crontask.v1.EventTaskCreated":
+            for attr in event.get("attributes", []):
+                if attr.get("key") == "task_id":
+                    return json.loads(attr.get("value"))
+    assert False, f"Failed to extract task ID: {create_result}"
+
+
+def wait_for_task_status(dysond_bin, task_id, statuses=("DONE", "FAILED"), timeout: int = 20) -> dict:
+    """Poll a crontask until it reaches one of statuses and return it."""
+    def _finished():
+        task = dysond_bin("query", "crontask", "task-by-id", "--task-id", str(task_id)).get("task", {})
+        return task if task.get("status") in statuses else None
+    return poll_until_condition(
+        _finished,
+        timeout=timeout,
+        error_message=f"Task {task_id} did not reach {statuses} within timeout"
+    )
+
+
+def create_self_send_task(dysond_bin, name, address, gas_fee, delay: int = 3, extra_args=()) -> int:
+    """Create a crontask sending 1dys from address to itself after delay seconds and return its ID."""
+    now = get_blockchain_time(dysond_bin)
+    msg_obj = {
+        "@type": "/cosmos.bank.v1beta1.MsgSend",
+        "from_address": address,
+        "to_address": address,
+        "amount": [{"denom": "dys", "amount": "1"}]
+    }
+    create_result = dysond_bin(
+        "tx", "crontask", "create-task",
+        "--scheduled-timestamp", str(now + delay),
+        "--expiry-timestamp", "+1h",
+        "--task-gas-limit", "200000",
+        "--task-gas-fee", f"{gas_fee}dys",
+        "--msgs", json.dumps(msg_obj),
+        *extra_args,
+        "--from", name,
+    )
+    assert create_result["code"] == 0, f"Task creation failed: {create_result['raw_log']}"
+    return task_id_from_result(create_result)
+
+
+def storage_set(dysond_bin, name, index, data, *args) -> dict:
+    """Set a storage entry of name and return the transaction result. Data that is not a string
+    is JSON encoded, extra args are passed to the set command."""
+    if not isinstance(data, str):
+        data = json.dumps(data)
+    return dysond_bin("tx", "storage", "set", "--index", index, "--data", data, *args, "--from", name)
+
+
+def update_storage_params(dysond_bin, name, params):
+    """Update the storage params through a gov proposal voted by an account with voting power"""
+    gov_address = dysond_bin("query", "auth", "module-account", "gov")["account"]["value"]["address"]
+    proposal_data = {
+        "messages": [
+            {
+                "@type": "/dysonprotocol.storage.v1.MsgUpdateParams",
+                "authority": gov_address,
+                "params": params
+            }
+        ],
+        "metadata": "ipfs://CID",
+        "deposit": "100000dys",
+        "title": "Update Storage Module Parameters",
+        "summary": "Update the storage deposit and quotas"
+    }
+    with tempfile.NamedTemporaryFile(mode='w', suffix='.json', delete=True) as proposal_file:
+        json.dump(proposal_data, proposal_file)
+        proposal_file.flush()
+        result = dysond_bin("tx", "gov", "submit-proposal", proposal_file.name, "--from", name)
+    assert result["code"] == 0, f"Failed to submit proposal: {result}"
+
+    proposal_id = next(attr["value"] for event in result["events"] if event["type"] == "submit_proposal"
+                       for attr in event["attributes"] if attr["key"] == "proposal_id")
+    result = dysond_bin("tx", "gov", "vote", proposal_id, "yes", "--from", name)
+    assert result["code"] == 0, f"Failed to vote on proposal: {result}"
+
+    def check_passed():
+        result = dysond_bin("query", "gov", "proposal", proposal_id)
+        return not isinstance(result, str) and result["proposal"]["status"] == "PROPOSAL_STATUS_PASSED"
+
+    poll_until_condition(check_passed, timeout=60, poll_interval=2, error_message="Proposal did not pass")
@@ -2,10 +2,11 @@ package keeper
 
 import (
 	"context"
-	"encoding/binary"
+	"errors"
 	"fmt"
 	"runtime/debug"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -24,106 +25,201 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		return fmt.Errorf("failed to get module params: %w", err)
 	}
 
-	// Track total gas consumed in this block
-	var totalGasConsumed uint64 = 0
-
 	// 1. expire overdue SCHEDULED tasks
 	k.checkExpiredTasks(ctx, currentTime)
 
-	// 2. move due SCHEDULED tasks to PENDING
-	k.moveDueTasks(ctx, currentTime)
+	// 2. pay the due releases of the payout schedules, before the tasks that may use them
+	if err := k.releaseDuePayouts(ctx); err != nil {
+		return fmt.Errorf("failed to release payouts: %w", err)
+	}
 
-	// 3. process PENDING tasks ordered by gas price (desc)
-	iter := k.iterateStatusGas(ctx, crontasktypes.TaskStatus_PENDING, true)
-	defer iter.Close()
+	// 3. run the due tasks of the begin block phase
+	if err := k.runDueTasks(ctx, params, crontasktypes.ExecutionPhase_BEGIN_BLOCK); err != nil {
+		return err
+	}
 
-	var pendingIDs []uint64
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		id := binary.BigEndian.Uint64(key[len(key)-8:])
-		pendingIDs = append(pendingIDs, id)
+	// 4. clean up old tasks beyond retention window
+	if err := k.removeOldTasks(ctx, currentTime); err != nil {
+		k.Logger.Error("failed to clean up old tasks", "error", err)
 	}
 
-	// Execute each pending task respecting block gas limit
-	for _, taskId := range pendingIDs {
-		task, err := k.GetTask(ctx, taskId)
-		if err != nil {
-			k.Logger.Error("failed to get pending task", "task_id", taskId, "error", err)
+	return nil
+}
+
+// EndBlocker is called at the end of every block. It runs the due tasks of the end block
+// phase, which see the state changes of the transactions of the block.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get module params: %w", err)
+	}
+
+	if err := k.runDueTasks(ctx, params, crontasktypes.ExecutionPhase_END_BLOCK); err != nil {
+		return err
+	}
+
+	// The gas used in the block is not kept in the state
+	if err := k.BlockGasConsumed.Remove(ctx); err != nil {
+		return fmt.Errorf("failed to clear block gas: %w", err)
+	}
+	return k.CreatorBlockGas.Clear(ctx, nil)
+}
+
+// runDueTasks moves the due SCHEDULED tasks of a phase to PENDING and runs the PENDING tasks
+// of the phase. The block gas limit and the creator quotas are shared by both phases.
+func (k Keeper) runDueTasks(ctx sdk.Context, params crontasktypes.Params, phase string) error {
+	currentTime := ctx.BlockTime().Unix()
+
+	// Track total gas consumed in this block
+	totalGasConsumed, err := k.BlockGasConsumed.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to get block gas: %w", err)
+	}
+
+	// 1. move due SCHEDULED tasks to PENDING, evaluating the conditions of conditional tasks
+	// on the block gas budget
+	if totalGasConsumed < params.BlockGasLimit {
+		totalGasConsumed += k.moveDueTasks(ctx, currentTime, phase, params.BlockGasLimit-totalGasConsumed)
+	}
+
+	// 2. process PENDING tasks by priority, deferring the tasks that do not fit in the block
+	// or in the gas quota of their creator
+	pendingTasks := k.pendingTasksByPriority(ctx, params, currentTime, phase)
+
+	// Execute each pending task respecting block gas limit. The gas of the callbacks of the runs
+	// counts towards the block and creator limits.
+	for _, task := range pendingTasks {
+		taskId := task.TaskId
+		occurrences := task.Occurrences
+
+		// A task that cannot fit in any block would stay pending forever
+		if reason := oversizedReason(task, params); reason != "" {
+			k.failTask(ctx, &task, reason)
+			if err := k.SetTask(ctx, task); err != nil {
+				k.Logger.Error("failed to set oversized task failed", "task_id", taskId, "error", err)
+			}
+			if err := k.addBlockGas(ctx, &totalGasConsumed, task.Creator, callbackGasSince(task, occurrences)); err != nil {
+				return err
+			}
 			continue
 		}
 
-		// Check gas limit
-		if totalGasConsumed+task.TaskGasLimit > params.BlockGasLimit {
-			k.Logger.Info("Stopping task execution - would exceed block gas limit",
-				"total_gas_consumed", totalGasConsumed,
-				"task_gas_limit", task.TaskGasLimit,
-				"block_gas_limit", params.BlockGasLimit,
-				"task_id", taskId)
-			break
+		// Smaller tasks further down the queue may still fit
+		if totalGasConsumed+task.RunGasLimit() > params.BlockGasLimit {
+			k.deferTask(ctx, &task, "block gas limit reached")
+			continue
+		}
+		creatorGasConsumed, err := k.CreatorBlockGas.Get(ctx, task.Creator)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("failed to get creator block gas: %w", err)
+		}
+		if params.CreatorBlockGasLimit > 0 && creatorGasConsumed+task.RunGasLimit() > params.CreatorBlockGasLimit {
+			k.deferTask(ctx, &task, "creator gas quota reached")
+			continue
 		}
 
-		// Collect fee
-		gasFee := sdk.NewCoins(task.TaskGasFee)
 		creatorAddr, err := sdk.AccAddressFromBech32(task.Creator)
 		if err != nil {
-			task.Status = crontasktypes.TaskStatus_FAILED
-			task.ErrorLog = fmt.Sprintf("invalid creator address: %s", err)
+			k.failTask(ctx, &task, fmt.Sprintf("invalid creator address: %s", err))
 			if err := k.SetTask(ctx, task); err != nil {
 				k.Logger.Error("failed to set task failed due to invalid creator address", "task_id", task.TaskId, "error", err)
 			}
+			if err := k.addBlockGas(ctx, &totalGasConsumed, task.Creator, callbackGasSince(task, occurrences)); err != nil {
+				return err
+			}
 			continue
 		}
 
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, "fee_collector", gasFee); err != nil {
-			task.Status = crontasktypes.TaskStatus_FAILED
-			task.ErrorLog = fmt.Sprintf("fee deduction failed: %s", err)
+		// The escrow must cover the maximum fee of the run
+		if err := k.checkEscrow(ctx, creatorAddr, &task); err != nil {
+			// A recurring task that cannot pay for a run is not rescheduled
+			k.failTask(ctx, &task, fmt.Sprintf("fee deduction failed: %s", err))
 			if err := k.SetTask(ctx, task); err != nil {
 				k.Logger.Error("failed to set task failed due to fee deduction failure", "task_id", task.TaskId, "error", err)
 			}
+			if err := k.addBlockGas(ctx, &totalGasConsumed, task.Creator, callbackGasSince(task, occurrences)); err != nil {
+				return err
+			}
 			continue
 		}
 
 		// Execute
-		if err := k.executeTask(ctx, &task); err != nil {
-			k.Logger.Error("execution error", "task_id", taskId, "error", err)
+		execErr := k.executeTask(ctx, &task)
+		if execErr != nil {
+			k.Logger.Error("execution error", "task_id", taskId, "error", execErr)
 		}
 
-		totalGasConsumed += task.TaskGasConsumed
+		if err := k.addBlockGas(ctx, &totalGasConsumed, task.Creator, task.TaskGasConsumed); err != nil {
+			return err
+		}
 		k.Logger.Info("Task executed", "task_id", taskId, "gas_used", task.TaskGasConsumed)
 
+		// Charge the gas consumed from the escrow
+		fee, err := k.chargeRun(ctx, &task)
+		if err != nil {
+			k.Logger.Error("failed to charge task fee", "task_id", taskId, "error", err)
+		}
+
+		// A failed attempt is retried later in the window of the run, within the retry policy
+		if execErr != nil && k.scheduleRetry(ctx, &task, execErr) {
+			if err := k.SetTask(ctx, task); err != nil {
+				k.Logger.Error("failed to set task retry", "task_id", taskId, "error", err)
+			}
+			continue
+		}
+
+		// Record the run and schedule the next one of a recurring task
+		if err := k.completeRun(ctx, &task, fee, true); err != nil {
+			k.Logger.Error("failed to reschedule task", "task_id", taskId, "error", err)
+		}
+		if err := k.addBlockGas(ctx, &totalGasConsumed, task.Creator, callbackGasSince(task, occurrences)); err != nil {
+			return err
+		}
+
+		// The unused escrow of a finished task is refunded
+		if task.Status != crontasktypes.TaskStatus_SCHEDULED {
+			if err := k.refundEscrow(ctx, &task); err != nil {
+				k.Logger.Error("failed to refund task escrow", "task_id", taskId, "error", err)
+			}
+		}
+
 		// Set the task data
 		if err := k.SetTask(ctx, task); err != nil {
 			k.Logger.Error("failed to set task", "task_id", taskId, "error", err)
 		}
 	}
 
-	// 4. clean up old tasks beyond retention window
-	if err := k.removeOldTasks(ctx, currentTime); err != nil {
-		k.Logger.Error("failed to clean up old tasks", "error", err)
+	return k.BlockGasConsumed.Set(ctx, totalGasConsumed)
+}
+
+// addBlockGas adds gas used by a task to the gas consumed in the block and by its creator
+func (k Keeper) addBlockGas(ctx context.Context, totalGasConsumed *uint64, creator string, gas uint64) error {
+	if gas == 0 {
+		return nil
 	}
+	*totalGasConsumed += gas
 
+	creatorGasConsumed, err := k.CreatorBlockGas.Get(ctx, creator)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("failed to get creator block gas: %w", err)
+	}
+	if err := k.CreatorBlockGas.Set(ctx, creator, creatorGasConsumed+gas); err != nil {
+		return fmt.Errorf("failed to set creator block gas: %w", err)
+	}
 	return nil
 }
 
-// checkExpiredTasks finds and marks expired tasks that haven't been executed yet
+// checkExpiredTasks finds and marks expired tasks that haven't been executed yet.
+// A recurring task records the missed run and is scheduled for its next run.
 func (k Keeper) checkExpiredTasks(ctx context.Context, currentTime int64) {
-	iter := k.iterateStatusTimestamp(ctx, crontasktypes.TaskStatus_SCHEDULED, false)
-	defer iter.Close()
-
-	statusPrefix := append(indexStatusTsPrefix, []byte(crontasktypes.TaskStatus_SCHEDULED)...)
-
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		if len(key) < len(statusPrefix)+8+8 {
-			continue
-		}
-		expTimestamp := int64(binary.BigEndian.Uint64(key[len(statusPrefix) : len(statusPrefix)+8]))
-		if expTimestamp > currentTime {
-			// further tasks are scheduled in future
-			break
-		}
-		id := binary.BigEndian.Uint64(key[len(key)-8:])
+	// Collect the IDs first as rescheduled tasks are written back to the same index
+	dueIDs, err := k.taskIDsDueBy(ctx, crontasktypes.TaskStatus_SCHEDULED, currentTime)
+	if err != nil {
+		k.Logger.Error("failed to iterate scheduled tasks", "error", err)
+		return
+	}
 
+	for _, id := range dueIDs {
 		task, err := k.GetTask(ctx, id)
 		if err != nil {
 			k.Logger.Error("failed to load task", "id", id, "err", err)
@@ -133,6 +229,14 @@ func (k Keeper) checkExpiredTasks(ctx context.Context, currentTime int64) {
 		if task.ExpiryTimestamp <= currentTime {
 			task.Status = crontasktypes.TaskStatus_EXPIRED
 			task.ErrorLog = "Task expired before execution"
+			if err := k.completeRun(ctx, &task, sdk.NewInt64Coin(task.TaskGasFee.Denom, 0), true); err != nil {
+				k.Logger.Error("failed to reschedule expired task", "task_id", task.TaskId, "error", err)
+			}
+			if task.Status != crontasktypes.TaskStatus_SCHEDULED {
+				if err := k.refundEscrow(ctx, &task); err != nil {
+					k.Logger.Error("failed to refund task escrow", "task_id", task.TaskId, "error", err)
+				}
+			}
 			if err := k.SetTask(ctx, task); err != nil {
 				k.Logger.Error("failed to set task expired", "task_id", task.TaskId, "error", err)
 			}
@@ -140,33 +244,72 @@ func (k Keeper) checkExpiredTasks(ctx context.Context, currentTime int64) {
 	}
 }
 
-// moveDueTasks moves tasks from SCHEDULED to PENDING when their scheduled time has arrived
-func (k Keeper) moveDueTasks(ctx context.Context, currentTime int64) {
-	iter := k.iterateStatusTimestamp(ctx, crontasktypes.TaskStatus_SCHEDULED, false)
-	defer iter.Close()
+// moveDueTasks moves the tasks of a phase from SCHEDULED to PENDING when their scheduled time
+// and block height have arrived.
+// A dependent task only moves once its parents finished with the required outcomes and a
+// conditional task once its condition holds. Conditions are evaluated while the
+// gas they consumed stays within gasBudget, the rest wait for the next block. It returns the
+// gas consumed by condition evaluations.
+func (k Keeper) moveDueTasks(ctx context.Context, currentTime int64, phase string, gasBudget uint64) uint64 {
+	// Collect the IDs first as tasks that are not ready stay in the same index
+	dueIDs, err := k.taskIDsDueBy(ctx, crontasktypes.TaskStatus_SCHEDULED, currentTime)
+	if err != nil {
+		k.Logger.Error("failed to iterate scheduled tasks", "error", err)
+		return 0
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
 
-	statusPrefix := append(indexStatusTsPrefix, []byte(crontasktypes.TaskStatus_SCHEDULED)...)
+	var gasUsed uint64
+	for _, id := range dueIDs {
+		task, err := k.GetTask(ctx, id)
+		if err != nil {
+			k.Logger.Error("failed to load task", "id", id, "err", err)
+			continue
+		}
 
-	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
-		if len(key) < len(statusPrefix)+8+8 {
+		// The task runs in the other phase of the block or waits for its block height
+		if task.Phase() != phase || task.ScheduledHeight > height {
 			continue
 		}
-		scheduledTime := int64(binary.BigEndian.Uint64(key[len(statusPrefix) : len(statusPrefix)+8]))
-		if scheduledTime > currentTime {
-			break
+
+		// A dependent task waits for its parents, it is cancelled when one of them finished
+		// with another outcome than the one required
+		if len(task.Dependencies) > 0 {
+			ready, reason := k.resolveDependencies(ctx, task)
+			if reason != "" {
+				if err := k.cancelTask(ctx, &task, reason); err != nil {
+					k.Logger.Error("failed to cancel task", "task_id", id, "error", err)
+				}
+				_ = k.SetTask(ctx, task)
+				continue
+			}
+			if !ready {
+				continue
+			}
 		}
-		id := binary.BigEndian.Uint64(key[len(key)-8:])
 
-		task, err := k.GetTask(ctx, id)
-		if err != nil {
-			k.Logger.Error("failed to load task", "id", id, "err", err)
+		if !task.IsConditional() {
+			task.Status = crontasktypes.TaskStatus_PENDING
+			_ = k.SetTask(ctx, task)
+			continue
+		}
+
+		if gasUsed+task.Condition.GasLimitOrDefault() > gasBudget {
+			k.Logger.Info("Deferring condition check - would exceed block gas limit",
+				"task_id", id,
+				"gas_used", gasUsed,
+				"block_gas_limit", gasBudget)
 			continue
 		}
 
-		task.Status = crontasktypes.TaskStatus_PENDING
-		_ = k.SetTask(ctx, task)
+		gasUsed += k.checkCondition(ctx, &task)
+		if err := k.SetTask(ctx, task); err != nil {
+			k.Logger.Error("failed to set task after condition check", "task_id", id, "error", err)
+		}
 	}
+
+	return gasUsed
 }
 
 // executeTask executes a task and updates its status based on the result
@@ -195,6 +338,7 @@ func (k Keeper) executeTask(ctx context.Context, task *crontasktypes.Task) error
 		// Update task status to failed
 		task.Status = crontasktypes.TaskStatus_FAILED
 		task.ExecutionTimestamp = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+		task.ExecutionHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
 
 		// Log the failure details
 		k.Logger.Info("Task execution failed",
@@ -206,6 +350,7 @@ func (k Keeper) executeTask(ctx context.Context, task *crontasktypes.Task) error
 		// Update task status to done and write changes
 		task.Status = crontasktypes.TaskStatus_DONE
 		task.ExecutionTimestamp = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+		task.ExecutionHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
 		write() // Write changes to parent context
 
 		// Get result count for logging
@@ -236,12 +381,18 @@ func (k Keeper) executeMsgs(ctx context.Context, task *crontasktypes.Task) error
 		return fmt.Errorf("failed to unpack messages: %w", err)
 	}
 
+	creatorAddr, err := sdk.AccAddressFromBech32(task.Creator)
+	if err != nil {
+		task.ErrorLog = fmt.Sprintf("invalid creator address: %s", err.Error())
+		return fmt.Errorf("invalid creator address: %w", err)
+	}
+
 	// Create a slice to collect successful results
 	var results []sdk.Msg
 
 	for i, msg := range msgs {
 		// Safely invoke the message
-		resultMsg, err := k.safeInvokeMsg(ctx, msg)
+		resultMsg, err := k.safeInvokeMsg(ctx, creatorAddr, msg)
 		if err != nil {
 			// Set error in the task
 			task.ErrorLog = fmt.Sprintf("message at index %d failed: %s", i, err.Error())
@@ -275,8 +426,8 @@ func (k Keeper) executeMsgs(ctx context.Context, task *crontasktypes.Task) error
 	return nil
 }
 
-// safeInvokeMsg safely executes a message, catching any panics that might occur
-func (k Keeper) safeInvokeMsg(ctx context.Context, msg sdk.Msg) (result sdk.Msg, err error) {
+// safeInvokeMsg safely executes a message on behalf of the task creator, catching any panics that might occur
+func (k Keeper) safeInvokeMsg(ctx context.Context, creator sdk.AccAddress, msg sdk.Msg) (result sdk.Msg, err error) {
 	// Use defer-recover pattern to catch panics
 	defer func() {
 		if r := recover(); r != nil {
@@ -311,6 +462,11 @@ func (k Keeper) safeInvokeMsg(ctx context.Context, msg sdk.Msg) (result sdk.Msg,
 		"msg_type", msgType,
 		"msg_router_is_nil", k.MsgRouterService == nil)
 
+	// Grants may have been revoked since the task was created, so the signers are checked again
+	if err := k.authorizeMsgSigners(ctx, creator, msg, true); err != nil {
+		return nil, err
+	}
+
 	// Invoke the message handler - use `HandleDeliver` method
 	handler := k.MsgRouterService.Handler(msg)
 	if handler == nil {
@@ -333,7 +489,7 @@ func (k Keeper) safeInvokeMsg(ctx context.Context, msg sdk.Msg) (result sdk.Msg,
 	return result, nil
 }
 
-// removeOldTasks deletes terminal-state tasks (DONE, FAILED, EXPIRED) whose
+// removeOldTasks deletes terminal-state tasks (DONE, FAILED, EXPIRED, CANCELLED) whose
 // execution/expiry timestamps are older than the configured CleanUpTime.
 func (k Keeper) removeOldTasks(ctx context.Context, currentTime int64) error {
 	params, err := k.GetParams(ctx)
@@ -353,27 +509,15 @@ func (k Keeper) removeOldTasks(ctx context.Context, currentTime int64) error {
 		crontasktypes.TaskStatus_DONE,
 		crontasktypes.TaskStatus_FAILED,
 		crontasktypes.TaskStatus_EXPIRED,
+		crontasktypes.TaskStatus_CANCELLED,
 	}
 
 	for _, status := range statuses {
-		iter := k.iterateStatusTimestamp(ctx, status, false) // oldest → newest
-		var deleteIDs []uint64
-		statusPrefix := append(indexStatusTsPrefix, []byte(status)...)
-
-		for ; iter.Valid(); iter.Next() {
-			key := iter.Key()
-			if len(key) < len(statusPrefix)+8+8 {
-				continue
-			}
-			ts := int64(binary.BigEndian.Uint64(key[len(statusPrefix) : len(statusPrefix)+8]))
-			if ts > cutoff {
-				// newer tasks; stop scanning further for this status
-				break
-			}
-			id := binary.BigEndian.Uint64(key[len(key)-8:])
-			deleteIDs = append(deleteIDs, id)
+		// oldest → newest up to the cutoff
+		deleteIDs, err := k.taskIDsDueBy(ctx, status, cutoff)
+		if err != nil {
+			return err
 		}
-		iter.Close()
 
 		for _, id := range deleteIDs {
 			if err := k.RemoveTask(ctx, id); err != nil {
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+	scripttypes "dysonprotocol.com/x/script/types"
+)
+
+// runCallback calls the callback of a task after its last recorded run finished and records
+// the outcome in the run. The callback runs on its own gas meter in a cache context that is
+// only written when it succeeds, so a failing callback never reverts the run. The gas consumed
+// is charged to the task escrow. The task is not saved.
+func (k Keeper) runCallback(ctx context.Context, task *crontasktypes.Task) {
+	if task.Callback == nil || len(task.RunHistory) == 0 {
+		return
+	}
+	run := &task.RunHistory[len(task.RunHistory)-1]
+
+	gasUsed, err := k.executeCallback(ctx, task, *run)
+	run.CallbackGasConsumed = gasUsed
+	if err != nil {
+		run.CallbackError = err.Error()
+		k.Logger.Info("Task callback failed", "task_id", task.TaskId, "gas_used", gasUsed, "error", err)
+	} else {
+		k.Logger.Info("Task callback executed", "task_id", task.TaskId, "gas_used", gasUsed)
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&crontasktypes.EventTaskCallback{
+		TaskId:      task.TaskId,
+		Creator:     task.Creator,
+		Status:      run.Status,
+		GasConsumed: gasUsed,
+		Error:       run.CallbackError,
+	}); err != nil {
+		k.Logger.Error("failed to emit task callback event", "task_id", task.TaskId, "error", err)
+	}
+}
+
+// executeCallback executes the callback script function for a run and charges its gas to the
+// task escrow. It returns the gas consumed.
+func (k Keeper) executeCallback(ctx context.Context, task *crontasktypes.Task, run crontasktypes.TaskRun) (uint64, error) {
+	gasLimit := task.Callback.GasLimitOrDefault()
+
+	// The escrow must cover a full call
+	if cost := task.GasCost(gasLimit); task.Escrow.Denom != "" && task.Escrow.Amount.LT(cost.Amount) {
+		return 0, fmt.Errorf("escrow %s does not cover the callback gas fee %s, top up the task", task.Escrow, cost)
+	}
+
+	creatorAddr, err := sdk.AccAddressFromBech32(task.Creator)
+	if err != nil {
+		return 0, fmt.Errorf("invalid creator address: %w", err)
+	}
+
+	kwargs, err := k.callbackKwargs(task, run)
+	if err != nil {
+		return 0, err
+	}
+	msg := &scripttypes.MsgExec{
+		ExecutorAddress: task.Creator,
+		ScriptAddress:   task.Callback.ScriptAddress,
+		FunctionName:    task.Callback.FunctionName,
+		Kwargs:          kwargs,
+	}
+
+	cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	_, err = k.safeInvokeMsg(cacheCtx, creatorAddr, msg)
+	gasUsed := min(cacheCtx.GasMeter().GasConsumed(), gasLimit)
+	if err == nil {
+		write()
+	}
+
+	if _, chargeErr := k.chargeGas(ctx, task, task.GasCost(gasUsed)); chargeErr != nil {
+		k.Logger.Error("failed to charge task callback", "task_id", task.TaskId, "error", chargeErr)
+	}
+	return gasUsed, err
+}
+
+// callbackKwargs returns the JSON encoded kwargs of the callback of a run: the task_id, the
+// status, the results of the messages of a DONE run, the gas_used and the error_log
+func (k Keeper) callbackKwargs(task *crontasktypes.Task, run crontasktypes.TaskRun) (string, error) {
+	results := make([]json.RawMessage, 0, len(task.MsgResults))
+	if run.Status == crontasktypes.TaskStatus_DONE {
+		for _, result := range task.MsgResults {
+			bz, err := k.cdc.MarshalJSON(result)
+			if err != nil {
+				return "", fmt.Errorf("failed to encode message result: %w", err)
+			}
+			results = append(results, bz)
+		}
+	}
+
+	bz, err := json.Marshal(map[string]any{
+		"task_id":   task.TaskId,
+		"status":    run.Status,
+		"results":   results,
+		"gas_used":  run.GasConsumed,
+		"error_log": run.ErrorLog,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode callback kwargs: %w", err)
+	}
+	return string(bz), nil
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+	nameservicekeeper "dysonprotocol.com/x/nameservice/keeper"
+	scripttypes "dysonprotocol.com/x/script/types"
+	storagetypes "dysonprotocol.com/x/storage/types"
+)
+
+// checkCondition evaluates the condition of a SCHEDULED task inside its time window and moves
+// the task to PENDING when it holds. The evaluation runs on its own gas meter and its state
+// changes are discarded. The gas consumed is charged to the task escrow and returned. The
+// task is not saved.
+func (k Keeper) checkCondition(ctx context.Context, task *crontasktypes.Task) uint64 {
+	gasLimit := task.Condition.GasLimitOrDefault()
+
+	// The escrow must cover a full evaluation and the run that may follow it
+	required := task.TaskGasFee.Add(task.GasCost(gasLimit))
+	if task.Escrow.Denom != "" && task.Escrow.Amount.LT(required.Amount) {
+		k.failTask(ctx, task, fmt.Sprintf(
+			"fee deduction failed: escrow %s does not cover the condition check and task gas fee %s, top up the task",
+			task.Escrow, required,
+		))
+		return 0
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	met, err := k.safeEvaluateCondition(cacheCtx, task)
+	gasUsed := min(cacheCtx.GasMeter().GasConsumed(), gasLimit)
+
+	task.ConditionChecks++
+	if _, chargeErr := k.chargeGas(ctx, task, task.GasCost(gasUsed)); chargeErr != nil {
+		k.Logger.Error("failed to charge condition check", "task_id", task.TaskId, "error", chargeErr)
+	}
+
+	switch {
+	case err != nil:
+		task.ErrorLog = fmt.Sprintf("condition check failed: %s", err)
+		k.Logger.Info("Task condition check failed", "task_id", task.TaskId, "gas_used", gasUsed, "error", err)
+	case met:
+		task.Status = crontasktypes.TaskStatus_PENDING
+		task.ErrorLog = ""
+		k.Logger.Info("Task condition met", "task_id", task.TaskId, "gas_used", gasUsed)
+	}
+
+	return gasUsed
+}
+
+// failTask marks a task as FAILED without running it and refunds its escrow. The task is not saved.
+func (k Keeper) failTask(ctx context.Context, task *crontasktypes.Task, errorLog string) {
+	task.Status = crontasktypes.TaskStatus_FAILED
+	task.ErrorLog = errorLog
+	if err := k.completeRun(ctx, task, sdk.NewInt64Coin(task.TaskGasFee.Denom, 0), false); err != nil {
+		k.Logger.Error("failed to record task run", "task_id", task.TaskId, "error", err)
+	}
+	if err := k.refundEscrow(ctx, task); err != nil {
+		k.Logger.Error("failed to refund task escrow", "task_id", task.TaskId, "error", err)
+	}
+}
+
+// safeEvaluateCondition evaluates the condition of a task, turning panics such as running out
+// of gas into errors
+func (k Keeper) safeEvaluateCondition(ctx sdk.Context, task *crontasktypes.Task) (met bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch p := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = fmt.Errorf("out of gas: %s", p.Descriptor)
+			case storetypes.ErrorGasOverflow:
+				err = fmt.Errorf("gas overflow: %s", p.Descriptor)
+			default:
+				err = fmt.Errorf("condition evaluation panicked: %v", r)
+			}
+		}
+	}()
+
+	return k.evaluateCondition(ctx, task)
+}
+
+// evaluateCondition reports whether the condition of a task holds
+func (k Keeper) evaluateCondition(ctx sdk.Context, task *crontasktypes.Task) (bool, error) {
+	cond := task.Condition
+	switch {
+	case cond.BalanceBelow != nil:
+		addr, err := sdk.AccAddressFromBech32(cond.BalanceBelow.Address)
+		if err != nil {
+			return false, err
+		}
+		balance := k.bankKeeper.GetBalance(ctx, addr, cond.BalanceBelow.Amount.Denom)
+		return balance.Amount.LT(cond.BalanceBelow.Amount.Amount), nil
+
+	case cond.StorageChanged != nil:
+		height, hash, err := k.storageEntryState(ctx, cond.StorageChanged.Owner, cond.StorageChanged.Index)
+		if err != nil {
+			return false, err
+		}
+		return height != cond.StorageChanged.UpdatedHeight || !bytes.Equal(hash, cond.StorageChanged.DataHash), nil
+
+	case cond.NameExpired != nil:
+		data, err := k.nameKeeper.GetNFTData(ctx, nameservicekeeper.NamesClassID, cond.NameExpired.Name)
+		if err != nil {
+			return false, err
+		}
+		return !data.ValuationExpiry.After(ctx.BlockTime()), nil
+
+	case cond.ScriptView != nil:
+		return k.evaluateScriptView(ctx, task.Creator, cond.ScriptView)
+	}
+
+	return false, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "task condition is empty")
+}
+
+// evaluateScriptView executes the script function of a condition as the task creator and
+// decodes its result, which must be a JSON boolean
+func (k Keeper) evaluateScriptView(ctx sdk.Context, creator string, cond *crontasktypes.ScriptViewCondition) (bool, error) {
+	msg := &scripttypes.MsgExec{
+		ExecutorAddress: creator,
+		ScriptAddress:   cond.ScriptAddress,
+		FunctionName:    cond.FunctionName,
+		Args:            cond.Args,
+		Kwargs:          cond.Kwargs,
+	}
+
+	handler := k.MsgRouterService.Handler(msg)
+	if handler == nil {
+		return false, fmt.Errorf("no handler found for message type: %s", sdk.MsgTypeURL(msg))
+	}
+
+	res, err := handler(ctx, msg)
+	if err != nil {
+		return false, err
+	}
+	if res == nil || len(res.MsgResponses) == 0 {
+		return false, fmt.Errorf("script %s returned no response", cond.ScriptAddress)
+	}
+
+	resp, ok := res.MsgResponses[0].GetCachedValue().(*scripttypes.MsgExecResponse)
+	if !ok {
+		return false, fmt.Errorf("unexpected response from script %s", cond.ScriptAddress)
+	}
+
+	var result bool
+	if err := json.Unmarshal([]byte(resp.Result), &result); err != nil {
+		return false, fmt.Errorf("script function %s must return a boolean, got %q", cond.FunctionName, resp.Result)
+	}
+	return result, nil
+}
+
+// storageEntryState returns the update height and data hash of a storage entry, or zero values
+// when the entry does not exist
+func (k Keeper) storageEntryState(ctx context.Context, owner, index string) (uint64, []byte, error) {
+	resp, err := k.storageKeeper.StorageGet(ctx, &storagetypes.QueryStorageGetRequest{Owner: owner, Index: index})
+	if status.Code(err) == codes.NotFound {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	hash := sha256.Sum256([]byte(resp.Entry.Data))
+	return resp.Entry.UpdatedHeight, hash[:], nil
+}
+
+// captureConditionBaseline records the state a condition compares against, so that a storage
+// condition only fires on changes made after the task was scheduled. The task is not saved.
+func (k Keeper) captureConditionBaseline(ctx context.Context, task *crontasktypes.Task) error {
+	if task.Condition == nil || task.Condition.StorageChanged == nil {
+		return nil
+	}
+
+	cond := task.Condition.StorageChanged
+	height, hash, err := k.storageEntryState(ctx, cond.Owner, cond.Index)
+	if err != nil {
+		return fmt.Errorf("failed to read storage entry: %w", err)
+	}
+	cond.UpdatedHeight = height
+	cond.DataHash = hash
+	return nil
+}
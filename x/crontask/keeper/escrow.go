@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"dysonprotocol.com/x/crontask"
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// escrowFee moves amount from the creator to the module account and adds it to the escrow of the task
+func (k Keeper) escrowFee(ctx context.Context, creator sdk.AccAddress, task *crontasktypes.Task, amount sdk.Coin) error {
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, crontask.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	if task.Escrow.Denom == "" {
+		task.Escrow = sdk.NewInt64Coin(amount.Denom, 0)
+	}
+	task.Escrow = task.Escrow.Add(amount)
+	return nil
+}
+
+// checkEscrow verifies that the escrow of a task covers the maximum fee of its next run.
+// Tasks created before fees were escrowed fund their run from the creator's account.
+func (k Keeper) checkEscrow(ctx context.Context, creator sdk.AccAddress, task *crontasktypes.Task) error {
+	if task.Escrow.Denom == "" {
+		return k.escrowFee(ctx, creator, task, task.TaskGasFee)
+	}
+
+	if task.Escrow.Amount.LT(task.TaskGasFee.Amount) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"escrow %s does not cover the task gas fee %s, top up the task",
+			task.Escrow,
+			task.TaskGasFee,
+		)
+	}
+	return nil
+}
+
+// chargeRun pays the fee of the gas consumed by the last run from the escrow of the task to
+// the fee collector and returns it
+func (k Keeper) chargeRun(ctx context.Context, task *crontasktypes.Task) (sdk.Coin, error) {
+	return k.chargeGas(ctx, task, task.GasCharge(task.TaskGasConsumed))
+}
+
+// chargeGas pays fee from the escrow of the task to the fee collector, limited to the escrow
+// left, and returns the amount paid
+func (k Keeper) chargeGas(ctx context.Context, task *crontasktypes.Task, fee sdk.Coin) (sdk.Coin, error) {
+	if task.Escrow.Denom == "" {
+		return sdk.NewInt64Coin(fee.Denom, 0), nil
+	}
+	if task.Escrow.Amount.LT(fee.Amount) {
+		fee = task.Escrow
+	}
+	if !fee.IsPositive() {
+		return fee, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, crontask.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(fee)); err != nil {
+		return sdk.NewInt64Coin(fee.Denom, 0), err
+	}
+
+	task.Escrow = task.Escrow.Sub(fee)
+	return fee, nil
+}
+
+// refundEscrow returns the remaining escrow of a task to its creator
+func (k Keeper) refundEscrow(ctx context.Context, task *crontasktypes.Task) error {
+	return k.releaseEscrow(ctx, task, task.Escrow)
+}
+
+// releaseEscrow returns refund from the escrow of a task to its creator, limited to the
+// escrow left
+func (k Keeper) releaseEscrow(ctx context.Context, task *crontasktypes.Task, refund sdk.Coin) error {
+	if task.Escrow.Denom == "" || !task.Escrow.IsPositive() || !refund.IsPositive() {
+		return nil
+	}
+	if task.Escrow.Amount.LT(refund.Amount) {
+		refund = task.Escrow
+	}
+
+	creator, err := sdk.AccAddressFromBech32(task.Creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, crontask.ModuleName, creator, sdk.NewCoins(refund)); err != nil {
+		return err
+	}
+	task.Escrow = task.Escrow.Sub(refund)
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&crontasktypes.EventTaskRefunded{
+		TaskId:  task.TaskId,
+		Creator: task.Creator,
+		Amount:  refund.String(),
+	})
+}
@@ -40,6 +40,18 @@ func (k Keeper) InitGenesis(ctx context.Context, genState *crontasktypes.Genesis
 		}
 	}
 
+	// Payout schedule IDs start at 1 like task IDs
+	if err := k.NextPayoutID.Set(ctx, max(genState.NextPayoutId, 1)); err != nil {
+		return err
+	}
+
+	// Import all payout schedules with their recipient index
+	for _, payout := range genState.PayoutSchedules {
+		if err := k.SetPayoutSchedule(ctx, *payout); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -67,10 +79,25 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*crontasktypes.GenesisState,
 		return nil, err // Direct error propagation
 	}
 
+	nextPayoutID, err := k.NextPayoutID.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var payouts []*crontasktypes.PayoutSchedule
+	if err := k.PayoutSchedules.Walk(ctx, nil, func(_ uint64, payout crontasktypes.PayoutSchedule) (bool, error) {
+		payouts = append(payouts, &payout)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	return &crontasktypes.GenesisState{
-		Tasks:      tasks,
-		NextTaskId: nextTaskID,
-		Params:     &params,
+		Tasks:           tasks,
+		NextTaskId:      nextTaskID,
+		Params:          &params,
+		PayoutSchedules: payouts,
+		NextPayoutId:    nextPayoutID,
 	}, nil
 }
 
@@ -81,8 +108,10 @@ func DefaultGenesis() *crontasktypes.GenesisState {
 			BlockGasLimit:    1000000000000000000,
 			ExpiryLimit:      int64(time.Hour * 24 * 7), // 7 days
 			MaxScheduledTime: int64(time.Hour * 24 * 7), // 7 days
+			AgingInterval:    60,
 		},
-		Tasks:      []*crontasktypes.Task{},
-		NextTaskId: 1,
+		Tasks:        []*crontasktypes.Task{},
+		NextTaskId:   1,
+		NextPayoutId: 1,
 	}
 }
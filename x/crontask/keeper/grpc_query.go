@@ -2,12 +2,10 @@ package keeper
 
 import (
 	"context"
-	"encoding/binary"
 	"fmt"
 
-	"cosmossdk.io/store/prefix"
 	crontasktypes "dysonprotocol.com/x/crontask/types"
-	"github.com/cosmos/cosmos-sdk/types/query"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -20,6 +18,7 @@ func isValidStatus(status string) bool {
 		crontasktypes.TaskStatus_DONE,
 		crontasktypes.TaskStatus_FAILED,
 		crontasktypes.TaskStatus_EXPIRED,
+		crontasktypes.TaskStatus_CANCELLED,
 	}
 
 	for _, s := range validStatuses {
@@ -54,18 +53,21 @@ func (q queryServer) TaskByID(ctx context.Context, req *crontasktypes.QueryTaskB
 
 // TasksByAddress returns all tasks created by a specific address
 func (q queryServer) TasksByAddress(ctx context.Context, req *crontasktypes.QueryTasksByAddressRequest) (*crontasktypes.QueryTasksResponse, error) {
-	store := prefix.NewStore(q.k.kvStore(ctx), append(indexAddrPrefix, []byte(req.Creator)...))
+	tasks, pageRes, err := q.k.paginateTasks(ctx, indexPrefix(TasksByCreatorPrefix, req.Creator), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
-	tasks := make([]*crontasktypes.Task, 0)
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
-		id := binary.BigEndian.Uint64(key[len(key)-8:])
-		task, err := q.k.GetTask(ctx, id)
-		if err != nil {
-			return err
-		}
-		tasks = append(tasks, &task)
-		return nil
-	})
+	return &crontasktypes.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
+}
+
+// TasksByWorkflow returns the tasks of a workflow ordered by ID
+func (q queryServer) TasksByWorkflow(ctx context.Context, req *crontasktypes.QueryTasksByWorkflowRequest) (*crontasktypes.QueryTasksResponse, error) {
+	if err := crontasktypes.ValidateWorkflowID(req.WorkflowId); err != nil || req.WorkflowId == "" {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid workflow ID %q", req.WorkflowId))
+	}
+
+	tasks, pageRes, err := q.k.paginateTasks(ctx, indexPrefix(TasksByWorkflowPrefix, req.Creator, req.WorkflowId), req.Pagination, nil)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -75,23 +77,73 @@ func (q queryServer) TasksByAddress(ctx context.Context, req *crontasktypes.Quer
 
 // TasksByStatusTimestamp returns tasks filtered by status and ordered by timestamp
 func (q queryServer) TasksByStatusTimestamp(ctx context.Context, req *crontasktypes.QueryTasksByStatusTimestampRequest) (*crontasktypes.QueryTasksResponse, error) {
-	if req.Pagination == nil {
-		req.Pagination = &query.PageRequest{}
+	tasks, pageRes, err := q.k.paginateTasks(ctx, indexPrefix(TasksByStatusDuePrefix, req.Status), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	prefixBz := append(indexStatusTsPrefix, []byte(req.Status)...)
-	store := prefix.NewStore(q.k.kvStore(ctx), prefixBz)
+	return &crontasktypes.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
+}
 
-	tasks := make([]*crontasktypes.Task, 0)
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
-		id := binary.BigEndian.Uint64(key[len(key)-8:])
-		task, err := q.k.GetTask(ctx, id)
-		if err != nil {
-			return nil
+// TasksByStatusGasPrice returns tasks filtered by status and ordered by gas price
+func (q queryServer) TasksByStatusGasPrice(ctx context.Context, req *crontasktypes.QueryTasksByStatusGasPriceRequest) (*crontasktypes.QueryTasksResponse, error) {
+	tasks, pageRes, err := q.k.paginateTasks(ctx, indexPrefix(TasksByStatusGasPricePrefix, req.Status), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &crontasktypes.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
+}
+
+// TasksAll returns all tasks ordered by task ID (ascending)
+func (q queryServer) TasksAll(ctx context.Context, req *crontasktypes.QueryAllTasksRequest) (*crontasktypes.QueryTasksResponse, error) {
+	tasks, pageRes, err := q.k.paginateTasks(ctx, TasksKey.Bytes(), req.Pagination, nil)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &crontasktypes.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
+}
+
+// TasksByFilter returns the tasks matching a creator, a status and a due time range. It
+// reads the most selective index for the filters that are set.
+func (q queryServer) TasksByFilter(ctx context.Context, req *crontasktypes.QueryTasksByFilterRequest) (*crontasktypes.QueryTasksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Creator != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Creator); err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid creator address: %s", err))
 		}
-		tasks = append(tasks, &task)
-		return nil
-	})
+	}
+	if req.Status != "" && !isValidStatus(req.Status) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid status %q", req.Status))
+	}
+	if req.ToTimestamp != 0 && req.ToTimestamp < req.FromTimestamp {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("to timestamp %d is before from timestamp %d", req.ToTimestamp, req.FromTimestamp))
+	}
+
+	var keyPrefix []byte
+	switch {
+	case req.Creator != "" && req.Status != "":
+		keyPrefix = indexPrefix(TasksByCreatorStatusDuePrefix, req.Creator, req.Status)
+	case req.Status != "":
+		keyPrefix = indexPrefix(TasksByStatusDuePrefix, req.Status)
+	case req.Creator != "":
+		keyPrefix = indexPrefix(TasksByCreatorPrefix, req.Creator)
+	default:
+		keyPrefix = TasksKey.Bytes()
+	}
+
+	filter := func(task crontasktypes.Task) bool {
+		due := task.DueTimestamp()
+		if due < req.FromTimestamp || (req.ToTimestamp != 0 && due > req.ToTimestamp) {
+			return false
+		}
+		return req.Creator == "" || task.Creator == req.Creator
+	}
+
+	tasks, pageRes, err := q.k.paginateTasks(ctx, keyPrefix, req.Pagination, filter)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -99,59 +151,212 @@ func (q queryServer) TasksByStatusTimestamp(ctx context.Context, req *crontaskty
 	return &crontasktypes.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
 }
 
-// TasksByStatusGasPrice returns tasks filtered by status and ordered by gas price
-func (q queryServer) TasksByStatusGasPrice(ctx context.Context, req *crontasktypes.QueryTasksByStatusGasPriceRequest) (*crontasktypes.QueryTasksResponse, error) {
-	if req.Pagination == nil {
-		req.Pagination = &query.PageRequest{}
+// ScheduleForecast returns the runs and gas limit of the scheduled and pending tasks due in
+// the buckets of a window. Overdue and pending tasks are counted in the first bucket.
+func (q queryServer) ScheduleForecast(ctx context.Context, req *crontasktypes.QueryScheduleForecastRequest) (*crontasktypes.QueryScheduleForecastResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	prefixBz := append(indexStatusGasPrefix, []byte(req.Status)...)
-	store := prefix.NewStore(q.k.kvStore(ctx), prefixBz)
+	from := req.FromTimestamp
+	if from == 0 {
+		from = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+	}
+	window := req.WindowSeconds
+	if window == 0 {
+		window = crontasktypes.DefaultForecastWindow
+	}
+	bucket := req.BucketSeconds
+	if bucket == 0 {
+		bucket = crontasktypes.DefaultForecastBucket
+	}
+	if window < 0 || bucket < 0 {
+		return nil, status.Error(codes.InvalidArgument, "window and bucket length cannot be negative")
+	}
+	numBuckets := (window + bucket - 1) / bucket
+	if numBuckets > crontasktypes.MaxForecastBuckets {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("forecast has %d buckets, the maximum is %d", numBuckets, crontasktypes.MaxForecastBuckets))
+	}
+	to := from + window
+
+	res := &crontasktypes.QueryScheduleForecastResponse{
+		FromTimestamp: from,
+		ToTimestamp:   to,
+		Buckets:       make([]crontasktypes.ForecastBucket, numBuckets),
+	}
+	for i := range res.Buckets {
+		res.Buckets[i].StartTimestamp = from + int64(i)*bucket
+		res.Buckets[i].EndTimestamp = min(from+int64(i+1)*bucket, to)
+	}
 
-	tasks := make([]*crontasktypes.Task, 0)
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
-		id := binary.BigEndian.Uint64(key[len(key)-8:])
-		task, err := q.k.GetTask(ctx, id)
+	for _, taskStatus := range []string{crontasktypes.TaskStatus_PENDING, crontasktypes.TaskStatus_SCHEDULED} {
+		ids, err := q.k.taskIDsDueBy(ctx, taskStatus, to-1)
 		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, id := range ids {
+			task, err := q.k.GetTask(ctx, id)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			runs, truncated, err := task.ForecastRuns(from, to, crontasktypes.MaxForecastRunsPerTask)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			res.Truncated = res.Truncated || truncated
+			for _, run := range runs {
+				b := &res.Buckets[(run-from)/bucket]
+				b.Runs++
+				b.GasLimit += task.TaskGasLimit
+				res.TotalRuns++
+				res.TotalGasLimit += task.TaskGasLimit
+			}
+		}
+	}
 
-			return nil
+	return res, nil
+}
+
+// TaskStats returns the number and gas limit of the tasks per status, and the gas limit of
+// the tasks expected to run in each upcoming block. Pending tasks run in the next block and
+// scheduled tasks in the first block at or after their due time and block height.
+func (q queryServer) TaskStats(ctx context.Context, req *crontasktypes.QueryTaskStatsRequest) (*crontasktypes.QueryTaskStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	numBlocks := int64(req.UpcomingBlocks)
+	if numBlocks == 0 {
+		numBlocks = crontasktypes.DefaultStatsBlocks
+	}
+	if numBlocks > crontasktypes.MaxStatsBlocks {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("upcoming blocks %d exceeds the maximum %d", numBlocks, crontasktypes.MaxStatsBlocks))
+	}
+	blockTime := req.BlockTimeSeconds
+	if blockTime == 0 {
+		blockTime = crontasktypes.DefaultBlockTime
+	}
+	if blockTime < 0 {
+		return nil, status.Error(codes.InvalidArgument, "block time cannot be negative")
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height, now := sdkCtx.BlockHeight(), sdkCtx.BlockTime().Unix()
+
+	res := &crontasktypes.QueryTaskStatsResponse{
+		UpcomingBlocks: make([]crontasktypes.BlockGasEstimate, numBlocks),
+		BlockGasLimit:  params.BlockGasLimit,
+	}
+	for i := range res.UpcomingBlocks {
+		res.UpcomingBlocks[i].Height = height + int64(i) + 1
+		res.UpcomingBlocks[i].Timestamp = now + (int64(i)+1)*blockTime
+	}
+
+	stats := make(map[string]*crontasktypes.StatusStats)
+	err = q.k.Tasks.Walk(ctx, nil, func(_ uint64, task crontasktypes.Task) (bool, error) {
+		s, ok := stats[task.Status]
+		if !ok {
+			s = &crontasktypes.StatusStats{Status: task.Status}
+			stats[task.Status] = s
 		}
-		tasks = append(tasks, &task)
-		return nil
+		s.Count++
+		s.GasLimit += task.TaskGasLimit
+		res.TotalTasks++
+
+		if block := upcomingBlock(task, res.UpcomingBlocks); block != nil {
+			block.Tasks++
+			block.GasLimit += task.TaskGasLimit
+		}
+		return false, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &crontasktypes.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
+	for _, taskStatus := range []string{
+		crontasktypes.TaskStatus_SCHEDULED,
+		crontasktypes.TaskStatus_PENDING,
+		crontasktypes.TaskStatus_DONE,
+		crontasktypes.TaskStatus_FAILED,
+		crontasktypes.TaskStatus_EXPIRED,
+		crontasktypes.TaskStatus_CANCELLED,
+	} {
+		s, ok := stats[taskStatus]
+		if !ok {
+			s = &crontasktypes.StatusStats{Status: taskStatus}
+		}
+		res.Statuses = append(res.Statuses, *s)
+	}
+
+	return res, nil
 }
 
-// TasksAll returns all tasks ordered by task ID (ascending)
-func (q queryServer) TasksAll(ctx context.Context, req *crontasktypes.QueryAllTasksRequest) (*crontasktypes.QueryTasksResponse, error) {
-	// The `Tasks` collection is stored under a single-byte prefix 0 (see keeper.TasksKey).
-	// We create a prefixed store so that Paginate only iterates over task entries.
-	store := prefix.NewStore(q.k.kvStore(ctx), []byte{0})
-
-	tasks := make([]*crontasktypes.Task, 0)
-	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
-		// The key layout is: <prefix byte><8-byte big-endian taskID>
-		if len(key) < 8 {
-			return nil
+// upcomingBlock returns the upcoming block in which a task is expected to run, or nil when it
+// does not run in any of them
+func upcomingBlock(task crontasktypes.Task, blocks []crontasktypes.BlockGasEstimate) *crontasktypes.BlockGasEstimate {
+	switch task.Status {
+	case crontasktypes.TaskStatus_PENDING:
+		if len(blocks) > 0 {
+			return &blocks[0]
 		}
-		id := binary.BigEndian.Uint64(key[len(key)-8:])
-		task, err := q.k.GetTask(ctx, id)
-		if err != nil {
-			fmt.Println("error getting task", err)
-			return nil
+	case crontasktypes.TaskStatus_SCHEDULED:
+		for i := range blocks {
+			if blocks[i].Timestamp >= task.DueTimestamp() && blocks[i].Height >= task.ScheduledHeight {
+				return &blocks[i]
+			}
 		}
-		tasks = append(tasks, &task)
-		return nil
-	})
+	}
+	return nil
+}
+
+// PayoutSchedule returns a payout schedule by its ID
+func (q queryServer) PayoutSchedule(ctx context.Context, req *crontasktypes.QueryPayoutScheduleRequest) (*crontasktypes.QueryPayoutScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	payout, err := q.k.GetPayoutSchedule(ctx, req.PayoutId)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("payout schedule with ID %d not found", req.PayoutId))
+	}
+	return &crontasktypes.QueryPayoutScheduleResponse{PayoutSchedule: &payout}, nil
+}
+
+// PayoutSchedulesByRecipient returns the payout schedules paying an address
+func (q queryServer) PayoutSchedulesByRecipient(ctx context.Context, req *crontasktypes.QueryPayoutSchedulesByRecipientRequest) (*crontasktypes.QueryPayoutSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Recipient); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid recipient address: %s", err))
+	}
+
+	payouts, pageRes, err := q.k.paginatePayouts(ctx, indexPrefix(PayoutsByRecipientPrefix, req.Recipient), req.Pagination)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	return &crontasktypes.QueryPayoutSchedulesResponse{PayoutSchedules: payouts, Pagination: pageRes}, nil
+}
 
-	return &crontasktypes.QueryTasksResponse{Tasks: tasks, Pagination: pageRes}, nil
+// PayoutSchedulesByCreator returns the payout schedules funded by an address
+func (q queryServer) PayoutSchedulesByCreator(ctx context.Context, req *crontasktypes.QueryPayoutSchedulesByCreatorRequest) (*crontasktypes.QueryPayoutSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	payouts, pageRes, err := q.k.paginatePayouts(ctx, indexPrefix(PayoutsByCreatorPrefix, req.Creator), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &crontasktypes.QueryPayoutSchedulesResponse{PayoutSchedules: payouts, Pagination: pageRes}, nil
 }
 
 // Params returns the module parameters
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+var (
+	// Task index prefixes
+	TasksByCreatorPrefix          = collections.NewPrefix(3)
+	TasksByStatusDuePrefix        = collections.NewPrefix(4)
+	TasksByStatusGasPricePrefix   = collections.NewPrefix(5)
+	TasksByCreatorStatusDuePrefix = collections.NewPrefix(8)
+	TasksByWorkflowPrefix         = collections.NewPrefix(9)
+)
+
+// TaskIndexes are the secondary indexes of the tasks collection
+type TaskIndexes struct {
+	// ByCreator indexes the tasks by creator
+	ByCreator *indexes.Multi[string, uint64, crontasktypes.Task]
+
+	// ByStatusDue indexes the tasks by status and due timestamp
+	ByStatusDue *indexes.Multi[collections.Pair[string, int64], uint64, crontasktypes.Task]
+
+	// ByStatusGasPrice indexes the tasks by status and gas price
+	ByStatusGasPrice *indexes.Multi[collections.Pair[string, uint64], uint64, crontasktypes.Task]
+
+	// ByCreatorStatusDue indexes the tasks by creator, status and due timestamp
+	ByCreatorStatusDue *indexes.Multi[collections.Triple[string, string, int64], uint64, crontasktypes.Task]
+
+	// ByWorkflow indexes the tasks by creator and workflow ID
+	ByWorkflow *indexes.Multi[collections.Pair[string, string], uint64, crontasktypes.Task]
+}
+
+// IndexesList implements collections.Indexes
+func (i TaskIndexes) IndexesList() []collections.Index[uint64, crontasktypes.Task] {
+	return []collections.Index[uint64, crontasktypes.Task]{
+		i.ByCreator,
+		i.ByStatusDue,
+		i.ByStatusGasPrice,
+		i.ByCreatorStatusDue,
+		i.ByWorkflow,
+	}
+}
+
+// newTaskIndexes creates the secondary indexes of the tasks collection
+func newTaskIndexes(sb *collections.SchemaBuilder) TaskIndexes {
+	return TaskIndexes{
+		ByCreator: indexes.NewMulti(
+			sb, TasksByCreatorPrefix, "tasks_by_creator",
+			collections.StringKey, collections.Uint64Key,
+			creatorIndexKey,
+		),
+		ByStatusDue: indexes.NewMulti(
+			sb, TasksByStatusDuePrefix, "tasks_by_status_due",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key), collections.Uint64Key,
+			statusDueIndexKey,
+		),
+		ByStatusGasPrice: indexes.NewMulti(
+			sb, TasksByStatusGasPricePrefix, "tasks_by_status_gas_price",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.Uint64Key,
+			statusGasPriceIndexKey,
+		),
+		ByCreatorStatusDue: indexes.NewMulti(
+			sb, TasksByCreatorStatusDuePrefix, "tasks_by_creator_status_due",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Int64Key), collections.Uint64Key,
+			creatorStatusDueIndexKey,
+		),
+		ByWorkflow: indexes.NewMulti(
+			sb, TasksByWorkflowPrefix, "tasks_by_workflow",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Key,
+			workflowIndexKey,
+		),
+	}
+}
+
+// creatorIndexKey returns the ByCreator index key of a task
+func creatorIndexKey(_ uint64, t crontasktypes.Task) (string, error) {
+	return t.Creator, nil
+}
+
+// statusDueIndexKey returns the ByStatusDue index key of a task
+func statusDueIndexKey(_ uint64, t crontasktypes.Task) (collections.Pair[string, int64], error) {
+	return collections.Join(t.Status, t.DueTimestamp()), nil
+}
+
+// statusGasPriceIndexKey returns the ByStatusGasPrice index key of a task
+func statusGasPriceIndexKey(_ uint64, t crontasktypes.Task) (collections.Pair[string, uint64], error) {
+	return collections.Join(t.Status, gasPriceKey(t)), nil
+}
+
+// creatorStatusDueIndexKey returns the ByCreatorStatusDue index key of a task
+func creatorStatusDueIndexKey(_ uint64, t crontasktypes.Task) (collections.Triple[string, string, int64], error) {
+	return collections.Join3(t.Creator, t.Status, t.DueTimestamp()), nil
+}
+
+// workflowIndexKey returns the ByWorkflow index key of a task
+func workflowIndexKey(_ uint64, t crontasktypes.Task) (collections.Pair[string, string], error) {
+	return collections.Join(t.Creator, t.WorkflowId), nil
+}
+
+// gasPriceKey returns the gas price amount of a task as an index key
+func gasPriceKey(t crontasktypes.Task) uint64 {
+	if t.TaskGasPrice.Amount.IsNil() || t.TaskGasPrice.Amount.IsNegative() {
+		return 0
+	}
+	if !t.TaskGasPrice.Amount.IsUint64() {
+		return math.MaxUint64
+	}
+	return t.TaskGasPrice.Amount.Uint64()
+}
+
+// statusDueRange returns the range of the ByStatusDue index entries of a status due between
+// from and to, both inclusive
+func statusDueRange(status string, from, to int64) *collections.Range[collections.Pair[collections.Pair[string, int64], uint64]] {
+	return new(collections.Range[collections.Pair[collections.Pair[string, int64], uint64]]).
+		StartInclusive(collections.Join(collections.Join(status, from), uint64(0))).
+		EndInclusive(collections.Join(collections.Join(status, to), uint64(math.MaxUint64)))
+}
+
+// taskIDsDueBy returns the IDs of the tasks with a status that are due at or before a time,
+// ordered by due time. The IDs are collected first so the tasks can be written back to the
+// same index.
+func (k Keeper) taskIDsDueBy(ctx context.Context, status string, until int64) ([]uint64, error) {
+	iter, err := k.Tasks.Indexes.ByStatusDue.Iterate(ctx, statusDueRange(status, math.MinInt64, until))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	return iter.PrimaryKeys()
+}
+
+// indexPrefix returns the store prefix of the entries of an index whose key starts with the
+// given strings. Strings are delimited by 0x00 in index keys so a prefix never matches a
+// longer string.
+func indexPrefix(p collections.Prefix, parts ...string) []byte {
+	key := append([]byte{}, p.Bytes()...)
+	for _, part := range parts {
+		key = append(key, part...)
+		key = append(key, 0)
+	}
+	return key
+}
+
+// paginateTasks paginates the tasks of the index entries, or tasks collection entries, under
+// a store prefix. Every entry key ends with the big-endian task ID. Tasks for which filter
+// returns false are skipped and do not count towards the page.
+func (k Keeper) paginateTasks(
+	ctx context.Context,
+	keyPrefix []byte,
+	pageReq *query.PageRequest,
+	filter func(crontasktypes.Task) bool,
+) ([]*crontasktypes.Task, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+
+	tasks := make([]*crontasktypes.Task, 0)
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key, _ []byte, accumulate bool) (bool, error) {
+		if len(key) < 8 {
+			return false, nil
+		}
+		task, err := k.GetTask(ctx, binary.BigEndian.Uint64(key[len(key)-8:]))
+		if err != nil {
+			return false, err
+		}
+		if filter != nil && !filter(task) {
+			return false, nil
+		}
+		if accumulate {
+			tasks = append(tasks, &task)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return tasks, pageRes, nil
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dysonprotocol.com/x/crontask"
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// RegisterInvariants registers all crontask invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(crontask.ModuleName, "task-indexes", TaskIndexesInvariant(k))
+	ir.RegisterRoute(crontask.ModuleName, "scheduled-not-expired", ScheduledNotExpiredInvariant(k))
+	ir.RegisterRoute(crontask.ModuleName, "payout-escrow", PayoutEscrowInvariant(k))
+}
+
+// AllInvariants runs all invariants of the crontask module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TaskIndexesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = ScheduledNotExpiredInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return PayoutEscrowInvariant(k)(ctx)
+	}
+}
+
+// TaskIndexesInvariant checks that every task has exactly one entry in each secondary index,
+// under the key matching its current creator, status, due time, gas price and workflow
+func TaskIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		tasks := make(map[uint64]crontasktypes.Task)
+		err := k.Tasks.Walk(ctx, nil, func(id uint64, task crontasktypes.Task) (bool, error) {
+			tasks[id] = task
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(crontask.ModuleName, "task-indexes", fmt.Sprintf("failed to walk tasks: %s", err)), true
+		}
+
+		idx := k.Tasks.Indexes
+		var broken []string
+		broken = append(broken, checkTaskIndex(ctx, "by creator", idx.ByCreator, tasks, creatorIndexKey)...)
+		broken = append(broken, checkTaskIndex(ctx, "by status and due time", idx.ByStatusDue, tasks, statusDueIndexKey)...)
+		broken = append(broken, checkTaskIndex(ctx, "by status and gas price", idx.ByStatusGasPrice, tasks, statusGasPriceIndexKey)...)
+		broken = append(broken, checkTaskIndex(ctx, "by creator, status and due time", idx.ByCreatorStatusDue, tasks, creatorStatusDueIndexKey)...)
+		broken = append(broken, checkTaskIndex(ctx, "by workflow", idx.ByWorkflow, tasks, workflowIndexKey)...)
+
+		msg := fmt.Sprintf("%d tasks, %d broken index entries\n", len(tasks), len(broken))
+		for _, b := range broken {
+			msg += "\t" + b + "\n"
+		}
+		return sdk.FormatInvariant(crontask.ModuleName, "task-indexes", msg), len(broken) > 0
+	}
+}
+
+// checkTaskIndex returns the problems of the entries of an index of the tasks: entries of
+// missing tasks, entries under another key than the one of the task and tasks without an entry
+func checkTaskIndex[R any](
+	ctx context.Context,
+	name string,
+	idx *indexes.Multi[R, uint64, crontasktypes.Task],
+	tasks map[uint64]crontasktypes.Task,
+	indexKey func(uint64, crontasktypes.Task) (R, error),
+) []string {
+	var broken []string
+	keyCodec := idx.KeyCodec()
+	indexed := make(map[uint64]bool, len(tasks))
+
+	err := idx.Walk(ctx, nil, func(ref R, id uint64) (bool, error) {
+		task, ok := tasks[id]
+		if !ok {
+			broken = append(broken, fmt.Sprintf("index %s has an entry for missing task %d", name, id))
+			return false, nil
+		}
+		want, err := indexKey(id, task)
+		if err != nil {
+			return true, err
+		}
+		got, expected := keyCodec.Stringify(collections.Join(ref, id)), keyCodec.Stringify(collections.Join(want, id))
+		if got != expected {
+			broken = append(broken, fmt.Sprintf("index %s has task %d under %s, expected %s", name, id, got, expected))
+			return false, nil
+		}
+		indexed[id] = true
+		return false, nil
+	})
+	if err != nil {
+		return append(broken, fmt.Sprintf("failed to walk index %s: %s", name, err))
+	}
+
+	for _, id := range slices.Sorted(maps.Keys(tasks)) {
+		if !indexed[id] {
+			broken = append(broken, fmt.Sprintf("index %s has no entry for task %d", name, id))
+		}
+	}
+	return broken
+}
+
+// ScheduledNotExpiredInvariant checks that no task is still SCHEDULED after its expiry time,
+// as the begin blocker expires them
+func ScheduledNotExpiredInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		currentTime := ctx.BlockTime().Unix()
+
+		ids, err := k.taskIDsDueBy(ctx, crontasktypes.TaskStatus_SCHEDULED, math.MaxInt64)
+		if err != nil {
+			return sdk.FormatInvariant(crontask.ModuleName, "scheduled-not-expired", fmt.Sprintf("failed to iterate scheduled tasks: %s", err)), true
+		}
+
+		var msg string
+		var count int
+		for _, id := range ids {
+			task, err := k.GetTask(ctx, id)
+			if err != nil {
+				return sdk.FormatInvariant(crontask.ModuleName, "scheduled-not-expired", fmt.Sprintf("failed to get task %d: %s", id, err)), true
+			}
+			if task.ExpiryTimestamp <= currentTime {
+				count++
+				msg += fmt.Sprintf("\ttask %d expired at %d but is still scheduled at %d\n", id, task.ExpiryTimestamp, currentTime)
+			}
+		}
+
+		return sdk.FormatInvariant(crontask.ModuleName, "scheduled-not-expired",
+			fmt.Sprintf("%d scheduled tasks past their expiry\n%s", count, msg)), count > 0
+	}
+}
+
+// PayoutEscrowInvariant checks that the escrow of every active payout schedule is exactly the
+// amount of its remaining releases and that finished payout schedules hold no escrow
+func PayoutEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+		err := k.PayoutSchedules.Walk(ctx, nil, func(id uint64, payout crontasktypes.PayoutSchedule) (bool, error) {
+			expected := sdk.NewCoins()
+			if !payout.IsFinished() {
+				expected = payout.ReleaseAmount().MulInt(sdkmath.NewIntFromUint64(payout.TotalReleases - payout.ReleasesDone))
+			}
+			if !payout.Escrow.Equal(expected) {
+				count++
+				msg += fmt.Sprintf("\tpayout schedule %d with status %s escrows %s, expected %s\n", id, payout.Status, payout.Escrow, expected)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return sdk.FormatInvariant(crontask.ModuleName, "payout-escrow", fmt.Sprintf("failed to walk payout schedules: %s", err)), true
+		}
+
+		return sdk.FormatInvariant(crontask.ModuleName, "payout-escrow",
+			fmt.Sprintf("%d payout schedules with a wrong escrow\n%s", count, msg)), count > 0
+	}
+}
@@ -2,17 +2,14 @@ package keeper
 
 import (
 	"context"
-	"encoding/binary"
 	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
-	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/runtime"
 
 	"dysonprotocol.com/x/crontask"
 	crontasktypes "dysonprotocol.com/x/crontask/types"
@@ -28,15 +25,19 @@ var (
 	// ParamsKey is the prefix for the module parameters
 	ParamsKey = collections.NewPrefix(2)
 
-	// Task index prefixes
-	TasksByAddressPrefix         = collections.NewPrefix(3)
-	TasksByStatusTimestampPrefix = collections.NewPrefix(4)
-	TasksByStatusGasPricePrefix  = collections.NewPrefix(5)
-
-	// Manual raw KV index prefixes (single-byte for simplicity)
-	indexAddrPrefix      = []byte{0xA1}
-	indexStatusTsPrefix  = []byte{0xA2}
-	indexStatusGasPrefix = []byte{0xA3}
+	// BlockGasConsumedKey and CreatorBlockGasPrefix hold the gas used by tasks in the
+	// current block, shared by the begin and end block phases. They are cleared at the
+	// end of every block.
+	BlockGasConsumedKey   = collections.NewPrefix(6)
+	CreatorBlockGasPrefix = collections.NewPrefix(7)
+
+	// PayoutSchedulesKey is the prefix for the payout schedules collection, with its
+	// secondary indexes
+	PayoutSchedulesKey       = collections.NewPrefix(10)
+	NextPayoutIDKey          = collections.NewPrefix(11)
+	PayoutsByCreatorPrefix   = collections.NewPrefix(12)
+	PayoutsByStatusDuePrefix = collections.NewPrefix(13)
+	PayoutsByRecipientPrefix = collections.NewPrefix(14)
 )
 
 type Keeper struct {
@@ -44,6 +45,9 @@ type Keeper struct {
 	storeService  store.KVStoreService
 	bankKeeper    crontasktypes.BankKeeper
 	accountKeeper crontasktypes.AccountKeeper
+	authzKeeper   crontasktypes.AuthzKeeper
+	storageKeeper crontasktypes.StorageKeeper
+	nameKeeper    crontasktypes.NameserviceKeeper
 	config        crontask.Config
 
 	// Services from the app's depinject setup
@@ -52,14 +56,30 @@ type Keeper struct {
 
 	Schema collections.Schema
 
-	// Tasks is the primary collection for tasks
-	Tasks collections.Map[uint64, crontasktypes.Task]
+	// Tasks is the primary collection for tasks, with its secondary indexes
+	Tasks *collections.IndexedMap[uint64, crontasktypes.Task, TaskIndexes]
 
 	// NextTaskID is a sequence for task IDs
 	NextTaskID collections.Sequence
 
 	// Params stores module parameters
 	Params collections.Item[crontasktypes.Params]
+
+	// BlockGasConsumed is the gas used by tasks in the current block
+	BlockGasConsumed collections.Item[uint64]
+
+	// CreatorBlockGas is the gas used by the tasks of each creator in the current block
+	CreatorBlockGas collections.Map[string, uint64]
+
+	// PayoutSchedules stores the payout schedules, with their secondary indexes
+	PayoutSchedules *collections.IndexedMap[uint64, crontasktypes.PayoutSchedule, PayoutIndexes]
+
+	// PayoutsByRecipient indexes the payout schedules by recipient. The recipients of a
+	// payout schedule never change.
+	PayoutsByRecipient collections.KeySet[collections.Pair[string, uint64]]
+
+	// NextPayoutID is a sequence for payout schedule IDs
+	NextPayoutID collections.Sequence
 }
 
 // NewKeeper creates a new crontask Keeper instance
@@ -68,6 +88,9 @@ func NewKeeper(
 	storeService store.KVStoreService,
 	accountKeeper crontasktypes.AccountKeeper,
 	bankKeeper crontasktypes.BankKeeper,
+	authzKeeper crontasktypes.AuthzKeeper,
+	storageKeeper crontasktypes.StorageKeeper,
+	nameKeeper crontasktypes.NameserviceKeeper,
 	msgRouter *baseapp.MsgServiceRouter,
 	config crontask.Config,
 	logger log.Logger,
@@ -77,13 +100,14 @@ func NewKeeper(
 
 	sb := collections.NewSchemaBuilder(storeService)
 
-	// plain tasks map
-	tasks := collections.NewMap(
+	// tasks map with its secondary indexes
+	tasks := collections.NewIndexedMap(
 		sb,
 		TasksKey,
 		"tasks",
 		collections.Uint64Key,
 		codec.CollValue[crontasktypes.Task](cdc),
+		newTaskIndexes(sb),
 	)
 
 	nextTaskID := collections.NewSequence(
@@ -100,23 +124,68 @@ func NewKeeper(
 		codec.CollValue[crontasktypes.Params](cdc),
 	)
 
+	blockGasConsumed := collections.NewItem(
+		sb,
+		BlockGasConsumedKey,
+		"block_gas_consumed",
+		collections.Uint64Value,
+	)
+
+	creatorBlockGas := collections.NewMap(
+		sb,
+		CreatorBlockGasPrefix,
+		"creator_block_gas",
+		collections.StringKey,
+		collections.Uint64Value,
+	)
+
+	payoutSchedules := collections.NewIndexedMap(
+		sb,
+		PayoutSchedulesKey,
+		"payout_schedules",
+		collections.Uint64Key,
+		codec.CollValue[crontasktypes.PayoutSchedule](cdc),
+		newPayoutIndexes(sb),
+	)
+
+	payoutsByRecipient := collections.NewKeySet(
+		sb,
+		PayoutsByRecipientPrefix,
+		"payouts_by_recipient",
+		collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+	)
+
+	nextPayoutID := collections.NewSequence(
+		sb,
+		NextPayoutIDKey,
+		"next_payout_id",
+	)
+
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
 	}
 
 	keeper := Keeper{
-		cdc:              cdc,
-		storeService:     storeService,
-		bankKeeper:       bankKeeper,
-		accountKeeper:    accountKeeper,
-		config:           config,
-		Tasks:            tasks,
-		NextTaskID:       nextTaskID,
-		Params:           params,
-		Schema:           schema,
-		Logger:           logger,
-		MsgRouterService: msgRouter,
+		cdc:                cdc,
+		storeService:       storeService,
+		bankKeeper:         bankKeeper,
+		accountKeeper:      accountKeeper,
+		authzKeeper:        authzKeeper,
+		storageKeeper:      storageKeeper,
+		nameKeeper:         nameKeeper,
+		config:             config,
+		Tasks:              tasks,
+		NextTaskID:         nextTaskID,
+		Params:             params,
+		BlockGasConsumed:   blockGasConsumed,
+		CreatorBlockGas:    creatorBlockGas,
+		PayoutSchedules:    payoutSchedules,
+		PayoutsByRecipient: payoutsByRecipient,
+		NextPayoutID:       nextPayoutID,
+		Schema:             schema,
+		Logger:             logger,
+		MsgRouterService:   msgRouter,
 	}
 
 	// Add debug logging about keeper initialization
@@ -133,30 +202,10 @@ func (k Keeper) GetNextTaskID(ctx context.Context) (uint64, error) {
 	return k.NextTaskID.Next(ctx)
 }
 
-// SetTask sets a task in the store
+// SetTask sets a task in the store. The secondary indexes of the previous version of the
+// task are replaced.
 func (k Keeper) SetTask(ctx context.Context, task crontasktypes.Task) error {
-	// If an existing task with the same ID is present, remove its current index
-	// entries before writing the updated task. This guarantees that secondary
-	// indexes are always in sync with the primary record and mirrors the cleanup
-	// logic performed in RemoveTask.
-
-	// Attempt to fetch the previous version of the task. We purposefully ignore
-	// a collections.ErrNotFound error because that simply means this is a brand
-	// new task.
-	if prev, err := k.Tasks.Get(ctx, task.TaskId); err == nil {
-		k.removeIndexes(ctx, prev)
-	} else if !errors.Is(err, collections.ErrNotFound) {
-		// Any other error (e.g. I/O problems) should be reported upstream.
-		return err
-	}
-
-	// Write the new / updated task and create its secondary-index keys.
-	if err := k.Tasks.Set(ctx, task.TaskId, task); err != nil {
-		return err
-	}
-
-	k.addIndexes(ctx, task)
-	return nil
+	return k.Tasks.Set(ctx, task.TaskId, task)
 }
 
 // GetTask gets a task by ID
@@ -164,20 +213,8 @@ func (k Keeper) GetTask(ctx context.Context, id uint64) (crontasktypes.Task, err
 	return k.Tasks.Get(ctx, id)
 }
 
-// DeleteTask deletes a task from the store
+// RemoveTask deletes a task and its secondary indexes from the store
 func (k Keeper) RemoveTask(ctx context.Context, id uint64) error {
-	// Load the task first so we can clean up its secondary indexes. If the task
-	// does not exist we simply propagate the original collections.ErrNotFound
-	// so the caller can decide how to handle it.
-	task, err := k.Tasks.Get(ctx, id)
-	if err != nil {
-		return err
-	}
-
-	// Delete secondary-index keys (address, status+timestamp, status+gasPrice)
-	k.removeIndexes(ctx, task)
-
-	// Finally remove the primary record from the `Tasks` map.
 	return k.Tasks.Remove(ctx, id)
 }
 
@@ -224,81 +261,3 @@ func (k Keeper) GetModuleParams(ctx context.Context) crontasktypes.Params {
 		MaxScheduledTime: k.config.MaxScheduledTime,
 	}
 }
-
-// bigEndian encodes uint64 big-endian
-func bigEndian(u uint64) []byte {
-	var b [8]byte
-	binary.BigEndian.PutUint64(b[:], u)
-	return b[:]
-}
-
-// addIndexes writes secondary-index entries for a task
-func (k Keeper) addIndexes(ctx context.Context, t crontasktypes.Task) {
-	store := k.storeService.OpenKVStore(ctx)
-
-	// address index: prefix | creator | id
-	keyAddr := append(append(indexAddrPrefix, []byte(t.Creator)...), bigEndian(t.TaskId)...)
-	_ = store.Set(keyAddr, []byte{})
-
-	// status+timestamp index
-	tsKey := append(indexStatusTsPrefix, []byte(t.Status)...)
-	tsKey = append(tsKey, bigEndian(uint64(t.ScheduledTimestamp))...)
-	tsKey = append(tsKey, bigEndian(t.TaskId)...)
-	_ = store.Set(tsKey, []byte{})
-
-	// status+gasPrice index
-	gpKey := append(indexStatusGasPrefix, []byte(t.Status)...)
-	gpKey = append(gpKey, bigEndian(uint64(t.TaskGasPrice.Amount.Int64()))...)
-	gpKey = append(gpKey, bigEndian(t.TaskId)...)
-	_ = store.Set(gpKey, []byte{})
-}
-
-// removeIndexes deletes secondary-index entries for a task
-func (k Keeper) removeIndexes(ctx context.Context, t crontasktypes.Task) {
-	store := k.storeService.OpenKVStore(ctx)
-
-	keyAddr := append(append(indexAddrPrefix, []byte(t.Creator)...), bigEndian(t.TaskId)...)
-	_ = store.Delete(keyAddr)
-
-	tsKey := append(indexStatusTsPrefix, []byte(t.Status)...)
-	tsKey = append(tsKey, bigEndian(uint64(t.ScheduledTimestamp))...)
-	tsKey = append(tsKey, bigEndian(t.TaskId)...)
-	_ = store.Delete(tsKey)
-
-	gpKey := append(indexStatusGasPrefix, []byte(t.Status)...)
-	gpKey = append(gpKey, bigEndian(uint64(t.TaskGasPrice.Amount.Int64()))...)
-	gpKey = append(gpKey, bigEndian(t.TaskId)...)
-	_ = store.Delete(gpKey)
-}
-
-// kvStore returns module store adapter for iterator utils
-func (k Keeper) kvStore(ctx context.Context) storetypes.KVStore {
-	return runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
-}
-
-// iterateStatusTimestamp returns iterator over keys for given status, ordered asc/desc
-func (k Keeper) iterateStatusTimestamp(ctx context.Context, status string, reverse bool) storetypes.Iterator {
-	store := k.kvStore(ctx)
-	prefix := append(indexStatusTsPrefix, []byte(status)...)
-	if reverse {
-		return storetypes.KVStoreReversePrefixIterator(store, prefix)
-	}
-	return storetypes.KVStorePrefixIterator(store, prefix)
-}
-
-// iterateStatusGas returns iterator over status+gasPrice index
-func (k Keeper) iterateStatusGas(ctx context.Context, status string, reverse bool) storetypes.Iterator {
-	store := k.kvStore(ctx)
-	prefix := append(indexStatusGasPrefix, []byte(status)...)
-	if reverse {
-		return storetypes.KVStoreReversePrefixIterator(store, prefix)
-	}
-	return storetypes.KVStorePrefixIterator(store, prefix)
-}
-
-// iterateAddress returns iterator over address index
-func (k Keeper) iterateAddress(ctx context.Context, addr string) storetypes.Iterator {
-	store := k.kvStore(ctx)
-	prefix := append(indexAddrPrefix, []byte(addr)...)
-	return storetypes.KVStorePrefixIterator(store, prefix)
-}
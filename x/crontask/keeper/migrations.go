@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// legacyIndexPrefixes are the prefixes of the raw task indexes of consensus version 1: by
+// creator, by status and timestamp, by status and gas price, and by workflow
+var legacyIndexPrefixes = [][]byte{{0xA1}, {0xA2}, {0xA3}, {0xA4}}
+
+// Migrator handles the in-place store migrations of the crontask module
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 replaces the raw task indexes with the indexes of the tasks collection. The raw
+// index entries are deleted and every task is written again to build the new indexes.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	for _, p := range legacyIndexPrefixes {
+		iter := storetypes.KVStorePrefixIterator(store, p)
+		var keys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	var tasks []crontasktypes.Task
+	err := m.keeper.Tasks.Walk(ctx, nil, func(_ uint64, task crontasktypes.Task) (bool, error) {
+		tasks = append(tasks, task)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, task := range tasks {
+		if err := m.keeper.SetTask(ctx, task); err != nil {
+			return err
+		}
+	}
+
+	m.keeper.Logger.Info("migrated crontask indexes", "tasks", len(tasks))
+	return nil
+}
@@ -2,12 +2,14 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	"dysonprotocol.com/x/crontask"
 	crontasktypes "dysonprotocol.com/x/crontask/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -49,34 +51,29 @@ func parseTimestamp(timestampStr string, baseTime time.Time) (time.Time, error)
 	return time.Unix(timestamp, 0).UTC(), nil
 }
 
-// CreateTask creates a new scheduled task
-func (k Keeper) CreateTask(ctx context.Context, msg *crontasktypes.MsgCreateTask) (*crontasktypes.MsgCreateTaskResponse, error) {
-	// Get module parameters
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-
-	params, err := k.GetParams(ctx)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to get module params")
+// firstCronRun returns the first match of a cron expression at or after scheduledTime, or
+// scheduledTime itself when the expression is empty
+func firstCronRun(cronExpression string, scheduledTime time.Time) (time.Time, error) {
+	if cronExpression == "" {
+		return scheduledTime, nil
 	}
-
-	// Validate addresses
-	_, err = sdk.AccAddressFromBech32(msg.Creator)
+	schedule, err := crontasktypes.ParseCronExpression(cronExpression)
 	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", msg.Creator)
+		return time.Time{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
-
-	// Get the current block time
-	currentTime := sdkCtx.BlockTime().UTC().Truncate(time.Second)
-
-	// Parse the scheduled timestamp
-	scheduledTime, err := parseTimestamp(msg.ScheduledTimestamp, currentTime)
-	if err != nil {
-		return nil, err
+	firstRun, ok := schedule.Next(scheduledTime.Add(-time.Second))
+	if !ok {
+		return time.Time{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "cron expression %q never matches", cronExpression)
 	}
+	return firstRun, nil
+}
 
+// validateScheduledTime checks that the scheduled time is not in the past and not further in
+// the future than the module allows
+func validateScheduledTime(params crontasktypes.Params, currentTime, scheduledTime time.Time) error {
 	// Validate timestamp is in the future
 	if currentTime.After(scheduledTime) {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
 			"scheduled time must be in the future, current time: %s, scheduled time: %s",
 			currentTime,
@@ -87,70 +84,179 @@ func (k Keeper) CreateTask(ctx context.Context, msg *crontasktypes.MsgCreateTask
 	// Validate timestamp is within allowed range
 	maxFutureTime := currentTime.Add(time.Second * time.Duration(params.MaxScheduledTime))
 	if scheduledTime.After(maxFutureTime) {
-		return nil, errorsmod.Wrapf(
+		return errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
 			"scheduled time is too far in the future, max allowed is %d seconds from now",
 			params.MaxScheduledTime,
 		)
 	}
+	return nil
+}
 
-	// Parse expiry timestamp, using scheduledTime as the base if it's a relative duration
-	var expiryTime time.Time
-	if msg.ExpiryTimestamp == "" {
+// parseExpiryTime parses the expiry timestamp relative to the scheduled time, defaulting to
+// the expiry limit of the module when it is empty
+func parseExpiryTime(params crontasktypes.Params, expiryTimestamp string, scheduledTime time.Time) (time.Time, error) {
+	if expiryTimestamp == "" {
 		// If not provided, use the default expiry from the scheduled time
-		expiryTime = scheduledTime.Add(time.Second * time.Duration(params.ExpiryLimit))
-	} else {
-		// Parse the provided expiry timestamp
-		expiryTime, err = parseTimestamp(msg.ExpiryTimestamp, scheduledTime)
-		if err != nil {
-			return nil, err
-		}
+		return scheduledTime.Add(time.Second * time.Duration(params.ExpiryLimit)), nil
+	}
 
-		// Ensure expiry time is after scheduled time
-		if expiryTime.Before(scheduledTime) || expiryTime.Equal(scheduledTime) {
-			return nil, errorsmod.Wrapf(
-				sdkerrors.ErrInvalidRequest,
-				"expiry time must be after scheduled time: scheduled %s, expiry %s",
-				scheduledTime,
-				expiryTime,
-			)
-		}
+	// Parse the provided expiry timestamp
+	expiryTime, err := parseTimestamp(expiryTimestamp, scheduledTime)
+	if err != nil {
+		return time.Time{}, err
 	}
 
+	// Ensure expiry time is after scheduled time
+	if !expiryTime.After(scheduledTime) {
+		return time.Time{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"expiry time must be after scheduled time: scheduled %s, expiry %s",
+			scheduledTime,
+			expiryTime,
+		)
+	}
+	return expiryTime, nil
+}
+
+// validateTaskGas checks the gas limit and fee of a task and returns its gas price
+func validateTaskGas(params crontasktypes.Params, gasLimit uint64, gasFee sdk.Coin) (sdk.Coin, error) {
 	// Validate gas limit is reasonable
-	if msg.TaskGasLimit == 0 {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Crontask gas limit must be positive")
+	if gasLimit == 0 {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "Crontask gas limit must be positive")
 	}
-	if msg.TaskGasLimit > params.BlockGasLimit {
-		return nil, errorsmod.Wrapf(
+	if gasLimit > params.BlockGasLimit {
+		return sdk.Coin{}, errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
 			"gas limit must be between 0 and %d, got %d",
 			params.BlockGasLimit,
-			msg.TaskGasLimit,
+			gasLimit,
+		)
+	}
+	if params.CreatorBlockGasLimit > 0 && gasLimit > params.CreatorBlockGasLimit {
+		return sdk.Coin{}, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"gas limit %d exceeds the creator block gas limit %d",
+			gasLimit,
+			params.CreatorBlockGasLimit,
 		)
 	}
 
-	// Validate gas fee and calculate gas price
-	if !msg.TaskGasFee.IsPositive() {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gas fee must be greater than 0")
+	// Validate gas fee
+	if !gasFee.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "gas fee must be greater than 0")
 	}
-	if msg.TaskGasFee.Denom != "dys" {
-		return nil, errorsmod.Wrapf(
+	if gasFee.Denom != crontasktypes.FeeDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(
 			sdkerrors.ErrInvalidRequest,
-			"invalid gas fee denom: [%s], only 'dys' is accepted",
-			msg.TaskGasFee.Denom,
+			"invalid gas fee denom: [%s], only '%s' is accepted",
+			gasFee.Denom,
+			crontasktypes.FeeDenom,
 		)
 	}
 
 	// Calculate gas price from gas fee and limit
-	gasPrice := sdk.NewCoin(
-		msg.TaskGasFee.Denom,
-		msg.TaskGasFee.Amount.Quo(sdkmath.NewInt(int64(msg.TaskGasLimit))),
-	)
+	return sdk.NewCoin(
+		gasFee.Denom,
+		gasFee.Amount.Quo(sdkmath.NewInt(int64(gasLimit))),
+	), nil
+}
+
+// validateTaskMsgs checks that at least one message is provided and that the creator may sign
+// every message
+func (k Keeper) validateTaskMsgs(ctx context.Context, creator sdk.AccAddress, msgs []sdk.Msg, err error) error {
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to unpack messages: %s", err)
+	}
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one message must be provided")
+	}
+	return k.validateMsgSigners(ctx, creator, msgs)
+}
 
-	// Validate at least one message is provided
-	if len(msg.Msgs) == 0 {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "at least one message must be provided")
+// CreateTask creates a new scheduled task
+func (k Keeper) CreateTask(ctx context.Context, msg *crontasktypes.MsgCreateTask) (*crontasktypes.MsgCreateTaskResponse, error) {
+	// Get module parameters
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get module params")
+	}
+
+	// Validate addresses
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", msg.Creator)
+	}
+
+	// Get the current block time
+	currentTime := sdkCtx.BlockTime().UTC().Truncate(time.Second)
+
+	// Parse the scheduled timestamp, a cron task and a task scheduled at a block height start
+	// at the current time by default
+	scheduledTimestamp := msg.ScheduledTimestamp
+	if scheduledTimestamp == "" && (msg.CronExpression != "" || msg.ScheduledHeight > 0) {
+		scheduledTimestamp = "+0s"
+	}
+	scheduledTime, err := parseTimestamp(scheduledTimestamp, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first run of a cron task is the first match at or after the scheduled time
+	scheduledTime, err = firstCronRun(msg.CronExpression, scheduledTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the block height, the end block phase of the current block has not run yet
+	if msg.ScheduledHeight > 0 {
+		minHeight := sdkCtx.BlockHeight() + 1
+		if msg.ExecutionPhase == crontasktypes.ExecutionPhase_END_BLOCK {
+			minHeight = sdkCtx.BlockHeight()
+		}
+		if msg.ScheduledHeight < minHeight {
+			return nil, errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"scheduled height must be at least %d, got %d",
+				minHeight,
+				msg.ScheduledHeight,
+			)
+		}
+	}
+
+	// Validate timestamp is in the allowed range
+	if err := validateScheduledTime(params, currentTime, scheduledTime); err != nil {
+		return nil, err
+	}
+
+	// Parse expiry timestamp, using scheduledTime as the base if it's a relative duration
+	expiryTime, err := parseExpiryTime(params, msg.ExpiryTimestamp, scheduledTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the end time of a recurring task, relative to its first run
+	var endTimestamp int64
+	if msg.EndTimestamp != "" {
+		endTime, err := parseTimestamp(msg.EndTimestamp, scheduledTime)
+		if err != nil {
+			return nil, err
+		}
+		endTimestamp = endTime.Unix()
+	}
+
+	// Validate the gas limit and fee and calculate the gas price
+	gasPrice, err := validateTaskGas(params, msg.TaskGasLimit, msg.TaskGasFee)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the messages
+	msgs, err := msg.GetMessages()
+	if err := k.validateTaskMsgs(ctx, creatorAddr, msgs, err); err != nil {
+		return nil, err
 	}
 
 	// Get the next task ID
@@ -171,6 +277,70 @@ func (k Keeper) CreateTask(ctx context.Context, msg *crontasktypes.MsgCreateTask
 		Msgs:               msg.Msgs,
 		Status:             crontasktypes.TaskStatus_SCHEDULED,
 		CreationTime:       sdkCtx.BlockTime().Unix(),
+		CronExpression:     msg.CronExpression,
+		IntervalSeconds:    msg.IntervalSeconds,
+		MaxOccurrences:     msg.MaxOccurrences,
+		EndTimestamp:       endTimestamp,
+		Condition:          msg.Condition,
+		Dependencies:       msg.Dependencies,
+		WorkflowId:         msg.WorkflowId,
+		ExecutionPhase:     msg.ExecutionPhase,
+		ScheduledHeight:    msg.ScheduledHeight,
+		RetryPolicy:        msg.RetryPolicy,
+		Callback:           msg.Callback,
+	}
+
+	// Validate the recurrence settings
+	if err := task.ValidateSchedule(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Validate the retry policy
+	if task.RetryPolicy != nil {
+		if err := task.RetryPolicy.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	// Validate the callback, a run and its callback must fit in a block
+	if task.Callback != nil {
+		if err := task.Callback.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if reason := oversizedReason(task, params); reason != "" {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, reason)
+		}
+	}
+
+	// Validate the dependencies and the workflow
+	if err := task.ValidateDependencies(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := k.validateParentTasks(ctx, task); err != nil {
+		return nil, err
+	}
+
+	escrow := task.EscrowForRuns()
+	if task.Condition != nil {
+		if err := task.Condition.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := k.captureConditionBaseline(ctx, &task); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+
+		// The deposit pays for the condition checks
+		if deposit := task.Condition.Deposit; deposit.IsPositive() {
+			if deposit.Denom != escrow.Denom {
+				return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "condition deposit denom %s must match the task gas fee denom %s", deposit.Denom, escrow.Denom)
+			}
+			escrow = escrow.Add(deposit)
+		}
+	}
+
+	// Escrow the fees of the task in the module account
+	if err := k.escrowFee(ctx, creatorAddr, &task, escrow); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to escrow task fee")
 	}
 
 	// Save the task
@@ -201,6 +371,145 @@ func (k Keeper) CreateTask(ctx context.Context, msg *crontasktypes.MsgCreateTask
 	}, nil
 }
 
+// UpdateTask changes the schedule, gas limit, gas fee and messages of a scheduled task. The
+// escrow is adjusted to cover the new gas fee of the runs left.
+func (k Keeper) UpdateTask(ctx context.Context, msg *crontasktypes.MsgUpdateTask) (*crontasktypes.MsgUpdateTaskResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", msg.Creator)
+	}
+
+	// Get the task
+	task, err := k.GetTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "task with ID %d not found", msg.TaskId)
+	}
+
+	// Verify that the creator is authorized to update this task
+	if task.Creator != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the creator can update a task")
+	}
+	if task.Status != crontasktypes.TaskStatus_SCHEDULED {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "task with status %s cannot be updated", task.Status)
+	}
+	if msg.ScheduledTimestamp == "" && msg.ExpiryTimestamp == "" && msg.TaskGasLimit == 0 && msg.TaskGasFee == nil && len(msg.Msgs) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "nothing to update")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get module params")
+	}
+	currentTime := sdkCtx.BlockTime().UTC().Truncate(time.Second)
+
+	updated := task
+	scheduledTime := time.Unix(task.ScheduledTimestamp, 0).UTC()
+
+	// A new scheduled time keeps the expiry window of the task unless a new expiry is given
+	if msg.ScheduledTimestamp != "" {
+		scheduledTime, err = parseTimestamp(msg.ScheduledTimestamp, currentTime)
+		if err != nil {
+			return nil, err
+		}
+		scheduledTime, err = firstCronRun(task.CronExpression, scheduledTime)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateScheduledTime(params, currentTime, scheduledTime); err != nil {
+			return nil, err
+		}
+		updated.ScheduledTimestamp = scheduledTime.Unix()
+		updated.ExpiryTimestamp = task.ExpiryTimestamp - task.ScheduledTimestamp + updated.ScheduledTimestamp
+		updated.NextAttemptTimestamp = 0
+	}
+	if msg.ExpiryTimestamp != "" {
+		expiryTime, err := parseExpiryTime(params, msg.ExpiryTimestamp, scheduledTime)
+		if err != nil {
+			return nil, err
+		}
+		// A task that is already due would otherwise stay scheduled past its expiry
+		if !expiryTime.After(currentTime) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry time must be in the future, current time: %s, expiry %s", currentTime, expiryTime)
+		}
+		updated.ExpiryTimestamp = expiryTime.Unix()
+	}
+
+	// Validate the new gas limit and fee and recalculate the gas price
+	if msg.TaskGasLimit > 0 {
+		updated.TaskGasLimit = msg.TaskGasLimit
+	}
+	if msg.TaskGasFee != nil {
+		updated.TaskGasFee = *msg.TaskGasFee
+	}
+	updated.TaskGasPrice, err = validateTaskGas(params, updated.TaskGasLimit, updated.TaskGasFee)
+	if err != nil {
+		return nil, err
+	}
+	if reason := oversizedReason(updated, params); reason != "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, reason)
+	}
+
+	// Validate the new messages
+	if len(msg.Msgs) > 0 {
+		msgs, err := msg.GetMessages()
+		if err := k.validateTaskMsgs(ctx, creatorAddr, msgs, err); err != nil {
+			return nil, err
+		}
+		updated.Msgs = msg.Msgs
+	}
+
+	if err := updated.ValidateSchedule(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Escrow what the stored escrow lacks for the runs left, or refund what it holds beyond them
+	required := task.UpdatedEscrow(updated).Amount
+	held := task.Escrow.Amount
+	if task.Escrow.Denom == "" {
+		held = sdkmath.ZeroInt()
+	}
+	switch {
+	case required.GT(held):
+		if err := k.escrowFee(ctx, creatorAddr, &updated, sdk.NewCoin(updated.TaskGasFee.Denom, required.Sub(held))); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to escrow task fee")
+		}
+	case required.LT(held):
+		if err := k.releaseEscrow(ctx, &updated, sdk.NewCoin(updated.TaskGasFee.Denom, held.Sub(required))); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to refund task escrow")
+		}
+	}
+
+	// SetTask moves the status and gas price index entries of the task
+	if err := k.SetTask(ctx, updated); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to save task")
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		&crontasktypes.EventTaskUpdated{
+			TaskId:             updated.TaskId,
+			Creator:            updated.Creator,
+			ScheduledTimestamp: updated.ScheduledTimestamp,
+			ExpiryTimestamp:    updated.ExpiryTimestamp,
+			TaskGasLimit:       updated.TaskGasLimit,
+			TaskGasFee:         updated.TaskGasFee.String(),
+			Escrow:             updated.Escrow.String(),
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit task updated event")
+	}
+
+	k.Logger.Info("Task updated",
+		"creator", msg.Creator,
+		"task_id", msg.TaskId,
+		"scheduled_time", scheduledTime)
+
+	return &crontasktypes.MsgUpdateTaskResponse{
+		Escrow: updated.Escrow,
+	}, nil
+}
+
 // DeleteTask deletes a scheduled task
 func (k Keeper) DeleteTask(ctx context.Context, msg *crontasktypes.MsgDeleteTask) (*crontasktypes.MsgDeleteTaskResponse, error) {
 	// Get the task
@@ -214,6 +523,11 @@ func (k Keeper) DeleteTask(ctx context.Context, msg *crontasktypes.MsgDeleteTask
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the creator can delete a task")
 	}
 
+	// Refund the unused escrow
+	if err := k.refundEscrow(ctx, &task); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to refund task escrow")
+	}
+
 	// Delete the task
 	err = k.RemoveTask(ctx, msg.TaskId)
 	if err != nil {
@@ -240,6 +554,247 @@ func (k Keeper) DeleteTask(ctx context.Context, msg *crontasktypes.MsgDeleteTask
 	return &crontasktypes.MsgDeleteTaskResponse{}, nil
 }
 
+// TopUpTask adds funds to the fee escrow of a recurring or conditional task
+func (k Keeper) TopUpTask(ctx context.Context, msg *crontasktypes.MsgTopUpTask) (*crontasktypes.MsgTopUpTaskResponse, error) {
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", msg.Creator)
+	}
+
+	// Get the task
+	task, err := k.GetTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "task with ID %d not found", msg.TaskId)
+	}
+
+	// Verify that the creator is authorized to top up this task
+	if task.Creator != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the creator can top up a task")
+	}
+
+	if !task.IsRecurring() && !task.IsConditional() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "only recurring and conditional tasks can be topped up")
+	}
+	if task.Status != crontasktypes.TaskStatus_SCHEDULED && task.Status != crontasktypes.TaskStatus_PENDING {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "task with status %s cannot be topped up", task.Status)
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid top up amount: %s", msg.Amount)
+	}
+	if msg.Amount.Denom != task.TaskGasFee.Denom {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidCoins,
+			"invalid top up denom: [%s], expected [%s]",
+			msg.Amount.Denom,
+			task.TaskGasFee.Denom,
+		)
+	}
+
+	if err := k.escrowFee(ctx, creatorAddr, &task, msg.Amount); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to escrow top up")
+	}
+
+	if err := k.SetTask(ctx, task); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to save task")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		&crontasktypes.EventTaskToppedUp{
+			TaskId:  task.TaskId,
+			Creator: task.Creator,
+			Amount:  msg.Amount.String(),
+			Escrow:  task.Escrow.String(),
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit task topped up event")
+	}
+
+	return &crontasktypes.MsgTopUpTaskResponse{
+		Escrow: task.Escrow,
+	}, nil
+}
+
+// CancelWorkflow cancels every scheduled or pending task of a workflow of the creator and
+// refunds their escrow. Finished tasks are left unchanged.
+func (k Keeper) CancelWorkflow(ctx context.Context, msg *crontasktypes.MsgCancelWorkflow) (*crontasktypes.MsgCancelWorkflowResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", msg.Creator)
+	}
+	if msg.WorkflowId == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "workflow ID cannot be empty")
+	}
+
+	ids := k.getWorkflowTaskIDs(ctx, msg.Creator, msg.WorkflowId)
+	if len(ids) == 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "workflow %s has no tasks", msg.WorkflowId)
+	}
+
+	cancelled := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		task, err := k.GetTask(ctx, id)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get task %d", id)
+		}
+		if crontasktypes.IsTerminalStatus(task.Status) {
+			continue
+		}
+
+		if err := k.cancelTask(ctx, &task, fmt.Sprintf("workflow %s cancelled", msg.WorkflowId)); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to cancel task %d", id)
+		}
+		if err := k.SetTask(ctx, task); err != nil {
+			return nil, errorsmod.Wrap(err, "failed to save task")
+		}
+		cancelled = append(cancelled, id)
+	}
+
+	return &crontasktypes.MsgCancelWorkflowResponse{
+		CancelledTaskIds: cancelled,
+	}, nil
+}
+
+// CreatePayoutSchedule escrows the amount of all the releases of a payout schedule and
+// creates it. The releases are paid in the begin blocker.
+func (k Keeper) CreatePayoutSchedule(ctx context.Context, msg *crontasktypes.MsgCreatePayoutSchedule) (*crontasktypes.MsgCreatePayoutScheduleResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	creatorAddr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", msg.Creator)
+	}
+
+	currentTime := sdkCtx.BlockTime().UTC().Truncate(time.Second)
+
+	// The first release is one interval away by default
+	startTime := currentTime.Add(time.Duration(msg.IntervalSeconds) * time.Second)
+	if msg.StartTimestamp != "" {
+		startTime, err = parseTimestamp(msg.StartTimestamp, currentTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	payout := crontasktypes.PayoutSchedule{
+		Creator:         msg.Creator,
+		Recipients:      make([]crontasktypes.PayoutRecipient, 0, len(msg.Recipients)),
+		StartTimestamp:  startTime.Unix(),
+		IntervalSeconds: msg.IntervalSeconds,
+		TotalReleases:   msg.TotalReleases,
+		Status:          crontasktypes.PayoutStatus_ACTIVE,
+		CreationTime:    currentTime.Unix(),
+	}
+	for _, r := range msg.Recipients {
+		payout.Recipients = append(payout.Recipients, crontasktypes.PayoutRecipient{
+			Address:  r.Address,
+			Amount:   r.Amount,
+			Released: sdk.NewCoins(),
+		})
+	}
+	if err := payout.ValidateSchedule(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !startTime.After(currentTime) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"start timestamp %d must be after the current block time %d",
+			startTime.Unix(),
+			currentTime.Unix(),
+		)
+	}
+
+	// A release to an account that cannot receive funds would never be paid
+	for _, r := range payout.Recipients {
+		if k.bankKeeper.BlockedAddr(sdk.MustAccAddressFromBech32(r.Address)) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", r.Address)
+		}
+	}
+
+	escrow := payout.TotalAmount()
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, crontask.ModuleName, escrow); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to escrow payout")
+	}
+
+	payoutID, err := k.NextPayoutID.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to get next payout ID")
+	}
+	payout.PayoutId = payoutID
+	payout.Escrow = escrow
+	payout.NextReleaseTimestamp = payout.StartTimestamp
+
+	if err := k.SetPayoutSchedule(ctx, payout); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to save payout schedule")
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		&crontasktypes.EventPayoutCreated{
+			PayoutId:       payoutID,
+			Creator:        msg.Creator,
+			Escrow:         escrow.String(),
+			StartTimestamp: payout.StartTimestamp,
+			TotalReleases:  payout.TotalReleases,
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit payout created event")
+	}
+
+	k.Logger.Info("Payout schedule created",
+		"payout_id", payoutID,
+		"creator", msg.Creator,
+		"escrow", escrow.String())
+
+	return &crontasktypes.MsgCreatePayoutScheduleResponse{
+		PayoutId: payoutID,
+		Escrow:   escrow,
+	}, nil
+}
+
+// CancelPayoutSchedule stops an active payout schedule. The recipients are paid the releases
+// that are due and the share of the next release vested so far, the rest of the escrow is
+// refunded to the creator.
+func (k Keeper) CancelPayoutSchedule(ctx context.Context, msg *crontasktypes.MsgCancelPayoutSchedule) (*crontasktypes.MsgCancelPayoutScheduleResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	payout, err := k.GetPayoutSchedule(ctx, msg.PayoutId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "payout schedule with ID %d not found", msg.PayoutId)
+	}
+
+	if payout.Creator != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the creator can cancel a payout schedule")
+	}
+	if payout.IsFinished() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "payout schedule with status %s cannot be cancelled", payout.Status)
+	}
+
+	released, refunded, err := k.cancelPayout(ctx, &payout, sdkCtx.BlockTime().Unix())
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to cancel payout schedule")
+	}
+
+	if err := k.SetPayoutSchedule(ctx, payout); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to save payout schedule")
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		&crontasktypes.EventPayoutCancelled{
+			PayoutId: payout.PayoutId,
+			Creator:  payout.Creator,
+			Released: released.String(),
+			Refunded: refunded.String(),
+		},
+	); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to emit payout cancelled event")
+	}
+
+	return &crontasktypes.MsgCancelPayoutScheduleResponse{
+		Released: released,
+		Refunded: refunded,
+	}, nil
+}
+
 func (k Keeper) UpdateParams(ctx context.Context, msg *crontasktypes.MsgUpdateParams) (*crontasktypes.MsgUpdateParamsResponse, error) {
 	// NOTE: For the lightweight test network we accept any signer; in production
 	// you would enforce the authority check below.
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"dysonprotocol.com/x/crontask"
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// PayoutIndexes are the secondary indexes of the payout schedules collection
+type PayoutIndexes struct {
+	// ByCreator indexes the payout schedules by creator
+	ByCreator *indexes.Multi[string, uint64, crontasktypes.PayoutSchedule]
+
+	// ByStatusDue indexes the payout schedules by status and next release timestamp
+	ByStatusDue *indexes.Multi[collections.Pair[string, int64], uint64, crontasktypes.PayoutSchedule]
+}
+
+// IndexesList implements collections.Indexes
+func (i PayoutIndexes) IndexesList() []collections.Index[uint64, crontasktypes.PayoutSchedule] {
+	return []collections.Index[uint64, crontasktypes.PayoutSchedule]{
+		i.ByCreator,
+		i.ByStatusDue,
+	}
+}
+
+// newPayoutIndexes creates the secondary indexes of the payout schedules collection
+func newPayoutIndexes(sb *collections.SchemaBuilder) PayoutIndexes {
+	return PayoutIndexes{
+		ByCreator: indexes.NewMulti(
+			sb, PayoutsByCreatorPrefix, "payouts_by_creator",
+			collections.StringKey, collections.Uint64Key,
+			func(_ uint64, p crontasktypes.PayoutSchedule) (string, error) {
+				return p.Creator, nil
+			},
+		),
+		ByStatusDue: indexes.NewMulti(
+			sb, PayoutsByStatusDuePrefix, "payouts_by_status_due",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key), collections.Uint64Key,
+			func(_ uint64, p crontasktypes.PayoutSchedule) (collections.Pair[string, int64], error) {
+				return collections.Join(p.Status, p.NextReleaseTimestamp), nil
+			},
+		),
+	}
+}
+
+// SetPayoutSchedule sets a payout schedule in the store and indexes it by recipient
+func (k Keeper) SetPayoutSchedule(ctx context.Context, payout crontasktypes.PayoutSchedule) error {
+	for _, r := range payout.Recipients {
+		if err := k.PayoutsByRecipient.Set(ctx, collections.Join(r.Address, payout.PayoutId)); err != nil {
+			return err
+		}
+	}
+	return k.PayoutSchedules.Set(ctx, payout.PayoutId, payout)
+}
+
+// GetPayoutSchedule gets a payout schedule by ID
+func (k Keeper) GetPayoutSchedule(ctx context.Context, id uint64) (crontasktypes.PayoutSchedule, error) {
+	return k.PayoutSchedules.Get(ctx, id)
+}
+
+// releaseDuePayouts pays the due releases of the active payout schedules, oldest due first,
+// up to MaxPayoutReleasesPerBlock releases. A schedule whose release fails is left unchanged
+// and retried in the next block.
+func (k Keeper) releaseDuePayouts(ctx sdk.Context) error {
+	currentTime := ctx.BlockTime().Unix()
+
+	ids, err := k.payoutIDsDueBy(ctx, currentTime)
+	if err != nil {
+		return err
+	}
+
+	budget := uint64(crontasktypes.MaxPayoutReleasesPerBlock)
+	for _, id := range ids {
+		if budget == 0 {
+			break
+		}
+
+		payout, err := k.GetPayoutSchedule(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get payout schedule %d: %w", id, err)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		released, err := k.releaseDue(cacheCtx, &payout, currentTime, budget)
+		if err == nil {
+			err = k.SetPayoutSchedule(cacheCtx, payout)
+		}
+		if err != nil {
+			k.Logger.Error("failed to release payout", "payout_id", id, "error", err)
+			continue
+		}
+		write()
+		budget -= released
+	}
+	return nil
+}
+
+// payoutIDsDueBy returns the IDs of the active payout schedules with a release due at or
+// before a time, ordered by due time
+func (k Keeper) payoutIDsDueBy(ctx context.Context, until int64) ([]uint64, error) {
+	status := crontasktypes.PayoutStatus_ACTIVE
+	rng := new(collections.Range[collections.Pair[collections.Pair[string, int64], uint64]]).
+		StartInclusive(collections.Join(collections.Join(status, int64(math.MinInt64)), uint64(0))).
+		EndInclusive(collections.Join(collections.Join(status, until), uint64(math.MaxUint64)))
+
+	iter, err := k.PayoutSchedules.Indexes.ByStatusDue.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	return iter.PrimaryKeys()
+}
+
+// releaseDue pays the releases of a payout schedule due at a time, at most limit of them, and
+// returns their number. The schedule is completed after its last release. It is not saved.
+func (k Keeper) releaseDue(ctx context.Context, payout *crontasktypes.PayoutSchedule, now int64, limit uint64) (uint64, error) {
+	var released uint64
+	for !payout.IsFinished() && released < limit && payout.ReleaseTimestamp(payout.ReleasesDone) <= now {
+		if err := k.payRecipients(ctx, payout, func(r crontasktypes.PayoutRecipient) sdk.Coins { return r.Amount }); err != nil {
+			return released, err
+		}
+		payout.ReleasesDone++
+		released++
+
+		if payout.ReleasesDone == payout.TotalReleases {
+			payout.Status = crontasktypes.PayoutStatus_COMPLETED
+			payout.EndTime = now
+		}
+	}
+
+	payout.NextReleaseTimestamp = 0
+	if !payout.IsFinished() {
+		payout.NextReleaseTimestamp = payout.ReleaseTimestamp(payout.ReleasesDone)
+	}
+	return released, nil
+}
+
+// cancelPayout pays the releases of a payout schedule that are due and the vested share of
+// its next release, refunds the rest of the escrow to the creator and returns both amounts.
+// It is not saved.
+func (k Keeper) cancelPayout(ctx context.Context, payout *crontasktypes.PayoutSchedule, now int64) (sdk.Coins, sdk.Coins, error) {
+	escrowed := payout.Escrow
+
+	if _, err := k.releaseDue(ctx, payout, now, math.MaxUint64); err != nil {
+		return nil, nil, err
+	}
+
+	share := payout.VestedShare(now)
+	if share.IsPositive() {
+		err := k.payRecipients(ctx, payout, func(r crontasktypes.PayoutRecipient) sdk.Coins {
+			return crontasktypes.VestedAmount(r.Amount, share)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	refunded := payout.Escrow
+	if !refunded.IsZero() {
+		creator, err := sdk.AccAddressFromBech32(payout.Creator)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, crontask.ModuleName, creator, refunded); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	payout.Escrow = sdk.NewCoins()
+	if !payout.IsFinished() {
+		payout.Status = crontasktypes.PayoutStatus_CANCELLED
+		payout.EndTime = now
+	}
+	payout.NextReleaseTimestamp = 0
+
+	released, _ := escrowed.SafeSub(refunded...)
+	return released, refunded, nil
+}
+
+// payRecipients pays every recipient of a payout schedule its amount from the escrow, as
+// part of the next release
+func (k Keeper) payRecipients(ctx context.Context, payout *crontasktypes.PayoutSchedule, amount func(crontasktypes.PayoutRecipient) sdk.Coins) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	for i := range payout.Recipients {
+		r := &payout.Recipients[i]
+		coins := amount(*r)
+		if coins.IsZero() {
+			continue
+		}
+
+		escrow, negative := payout.Escrow.SafeSub(coins...)
+		if negative {
+			return fmt.Errorf("escrow %s does not cover the release of %s to %s", payout.Escrow, coins, r.Address)
+		}
+		recipient, err := sdk.AccAddressFromBech32(r.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, crontask.ModuleName, recipient, coins); err != nil {
+			return err
+		}
+		payout.Escrow = escrow
+		r.Released = r.Released.Add(coins...)
+
+		if err := sdkCtx.EventManager().EmitTypedEvent(&crontasktypes.EventPayoutReleased{
+			PayoutId:  payout.PayoutId,
+			Recipient: r.Address,
+			Amount:    coins.String(),
+			Release:   payout.ReleasesDone + 1,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paginatePayouts paginates the payout schedules of the index entries under a store prefix.
+// Every entry key ends with the big-endian payout schedule ID.
+func (k Keeper) paginatePayouts(
+	ctx context.Context,
+	keyPrefix []byte,
+	pageReq *query.PageRequest,
+) ([]*crontasktypes.PayoutSchedule, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+
+	payouts := make([]*crontasktypes.PayoutSchedule, 0)
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		if len(key) < 8 {
+			return nil
+		}
+		payout, err := k.GetPayoutSchedule(ctx, binary.BigEndian.Uint64(key[len(key)-8:]))
+		if err != nil {
+			return err
+		}
+		payouts = append(payouts, &payout)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return payouts, pageRes, nil
+}
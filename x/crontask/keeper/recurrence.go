@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// completeRun records the outcome of the current run of a task in its history and calls its
+// callback. When reschedule is set and the task is recurring with runs left, it is scheduled
+// again for its next run with the same expiry window. The task is not saved.
+func (k Keeper) completeRun(ctx context.Context, task *crontasktypes.Task, fee sdk.Coin, reschedule bool) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentTime := sdkCtx.BlockTime().Unix()
+
+	task.RecordRun(crontasktypes.TaskRun{
+		ScheduledTimestamp: task.ScheduledTimestamp,
+		ExecutionTimestamp: currentTime,
+		ExecutionHeight:    sdkCtx.BlockHeight(),
+		Status:             task.Status,
+		GasConsumed:        task.TaskGasConsumed,
+		Fee:                fee,
+		ErrorLog:           task.ErrorLog,
+	})
+
+	// The callback reacts to the outcome of the run
+	k.runCallback(ctx, task)
+
+	// The next run starts with a fresh retry budget
+	task.Attempts = 0
+	task.NextAttemptTimestamp = 0
+
+	if !reschedule {
+		return nil
+	}
+
+	// Runs missed while the chain was halted or the task was pending are skipped
+	after := max(currentTime, task.ScheduledTimestamp)
+	next, ok, err := task.NextRunAfter(after)
+	if err != nil {
+		return fmt.Errorf("failed to compute next run: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+
+	window := task.ExpiryTimestamp - task.ScheduledTimestamp
+	task.ScheduledTimestamp = next
+	task.ExpiryTimestamp = next + window
+	task.Status = crontasktypes.TaskStatus_SCHEDULED
+
+	// A condition of the next run compares against the state at the time it is scheduled
+	if err := k.captureConditionBaseline(ctx, task); err != nil {
+		return err
+	}
+
+	k.Logger.Info("Task rescheduled",
+		"task_id", task.TaskId,
+		"occurrence", task.Occurrences,
+		"next_timestamp", next)
+
+	return sdkCtx.EventManager().EmitTypedEvent(&crontasktypes.EventTaskRescheduled{
+		TaskId:        task.TaskId,
+		Creator:       task.Creator,
+		Occurrence:    task.Occurrences,
+		NextTimestamp: next,
+	})
+}
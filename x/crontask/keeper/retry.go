@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// abciCoder is implemented by the registered errors of the modules
+type abciCoder interface {
+	ABCICode() uint32
+	Codespace() string
+}
+
+// errorCode returns the codespace and code of the registered error wrapped by err
+func errorCode(err error) (string, uint32) {
+	var coder abciCoder
+	if errors.As(err, &coder) {
+		return coder.Codespace(), coder.ABCICode()
+	}
+	return errorsmod.UndefinedCodespace, 1
+}
+
+// scheduleRetry records the failed attempt of a task in its attempt log and, when its retry
+// policy allows another attempt before the run expires, schedules it and emits
+// EventTaskRetried. It reports whether a retry was scheduled. The task is not saved.
+func (k Keeper) scheduleRetry(ctx context.Context, task *crontasktypes.Task, execErr error) bool {
+	if task.RetryPolicy == nil {
+		return false
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentTime := sdkCtx.BlockTime().Unix()
+
+	task.Attempts++
+	codespace, code := errorCode(execErr)
+	next := currentTime + task.RetryPolicy.BackoffAfter(task.Attempts)
+	retry := task.Attempts < task.RetryPolicy.MaxAttempts &&
+		task.RetryPolicy.IsRetryable(codespace, code) &&
+		next < task.ExpiryTimestamp
+
+	task.RecordAttempt(crontasktypes.TaskAttempt{
+		Attempt:            task.Attempts,
+		ExecutionTimestamp: currentTime,
+		ExecutionHeight:    sdkCtx.BlockHeight(),
+		Codespace:          codespace,
+		Code:               code,
+		ErrorLog:           task.ErrorLog,
+		Retried:            retry,
+	})
+	if !retry {
+		if task.Attempts > 1 {
+			task.ErrorLog = fmt.Sprintf("%s (after %d attempts)", task.ErrorLog, task.Attempts)
+		}
+		return false
+	}
+
+	task.Status = crontasktypes.TaskStatus_SCHEDULED
+	task.NextAttemptTimestamp = next
+
+	k.Logger.Info("Task attempt failed, retrying",
+		"task_id", task.TaskId,
+		"attempt", task.Attempts,
+		"next_attempt_timestamp", next,
+		"codespace", codespace,
+		"code", code)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&crontasktypes.EventTaskRetried{
+		TaskId:               task.TaskId,
+		Creator:              task.Creator,
+		Attempt:              task.Attempts,
+		NextAttemptTimestamp: next,
+		Error:                task.ErrorLog,
+	}); err != nil {
+		k.Logger.Error("failed to emit task retried event", "task_id", task.TaskId, "error", err)
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// pendingTasksByPriority returns the PENDING tasks of a phase ordered by priority (desc), with
+// the oldest task first among tasks of equal priority
+func (k Keeper) pendingTasksByPriority(ctx context.Context, params crontasktypes.Params, currentTime int64, phase string) []crontasktypes.Task {
+	pendingIDs, err := k.taskIDsDueBy(ctx, crontasktypes.TaskStatus_PENDING, math.MaxInt64)
+	if err != nil {
+		k.Logger.Error("failed to iterate pending tasks", "error", err)
+		return nil
+	}
+
+	tasks := make([]crontasktypes.Task, 0, len(pendingIDs))
+	for _, id := range pendingIDs {
+		task, err := k.GetTask(ctx, id)
+		if err != nil {
+			k.Logger.Error("failed to get pending task", "task_id", id, "error", err)
+			continue
+		}
+		if task.Phase() != phase {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+
+	sortByPriority(tasks, currentTime, params.AgingInterval)
+	return tasks
+}
+
+// sortByPriority orders tasks by priority (desc), with the oldest task first among tasks of
+// equal priority
+func sortByPriority(tasks []crontasktypes.Task, currentTime, agingInterval int64) {
+	priorities := make(map[uint64]sdkmath.LegacyDec, len(tasks))
+	for _, task := range tasks {
+		priorities[task.TaskId] = task.Priority(currentTime, agingInterval)
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		pi, pj := priorities[tasks[i].TaskId], priorities[tasks[j].TaskId]
+		if !pi.Equal(pj) {
+			return pi.GT(pj)
+		}
+		return tasks[i].TaskId < tasks[j].TaskId
+	})
+}
+
+// oversizedReason returns why a task can never be run with the current parameters, or an
+// empty string when it fits in a block. The gas limit of the callback counts towards the run.
+func oversizedReason(task crontasktypes.Task, params crontasktypes.Params) string {
+	gasLimit := task.RunGasLimit()
+	if gasLimit > params.BlockGasLimit {
+		return fmt.Sprintf("task gas limit %d with its callback exceeds the block gas limit %d", gasLimit, params.BlockGasLimit)
+	}
+	if params.CreatorBlockGasLimit > 0 && gasLimit > params.CreatorBlockGasLimit {
+		return fmt.Sprintf("task gas limit %d with its callback exceeds the creator block gas limit %d", gasLimit, params.CreatorBlockGasLimit)
+	}
+	return ""
+}
+
+// callbackGasSince returns the gas consumed by the callback of the run recorded since the task
+// had occurrences runs, 0 when no run was recorded
+func callbackGasSince(task crontasktypes.Task, occurrences uint64) uint64 {
+	if task.Occurrences == occurrences || len(task.RunHistory) == 0 {
+		return 0
+	}
+	return task.RunHistory[len(task.RunHistory)-1].CallbackGasConsumed
+}
+
+// deferTask leaves a pending task for a later block, counts the deferral and emits
+// EventTaskDeferred
+func (k Keeper) deferTask(ctx context.Context, task *crontasktypes.Task, reason string) {
+	task.Deferrals++
+
+	k.Logger.Info("Deferring task execution",
+		"task_id", task.TaskId,
+		"task_gas_limit", task.TaskGasLimit,
+		"reason", reason,
+		"deferrals", task.Deferrals)
+
+	if err := k.SetTask(ctx, *task); err != nil {
+		k.Logger.Error("failed to set deferred task", "task_id", task.TaskId, "error", err)
+		return
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&crontasktypes.EventTaskDeferred{
+		TaskId:    task.TaskId,
+		Creator:   task.Creator,
+		Reason:    reason,
+		Deferrals: task.Deferrals,
+	}); err != nil {
+		k.Logger.Error("failed to emit task deferred event", "task_id", task.TaskId, "error", err)
+	}
+}
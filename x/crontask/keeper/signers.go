@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// validateMsgSigners checks that every signer of msgs is the task creator, or has granted
+// the creator an authz authorization for the message type. Grants are only looked up,
+// they are not consumed.
+func (k Keeper) validateMsgSigners(ctx context.Context, creator sdk.AccAddress, msgs []sdk.Msg) error {
+	for i, msg := range msgs {
+		if err := k.authorizeMsgSigners(ctx, creator, msg, false); err != nil {
+			return errorsmod.Wrapf(err, "message at index %d", i)
+		}
+	}
+	return nil
+}
+
+// authorizeMsgSigners checks the signers of a single task message against the creator. When
+// accept is set the authorization of every other signer is accepted for msg and updated or
+// deleted, as authz does when it dispatches a MsgExec.
+func (k Keeper) authorizeMsgSigners(ctx context.Context, creator sdk.AccAddress, msg sdk.Msg, accept bool) error {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "failed to get signers of %s: %s", sdk.MsgTypeURL(msg), err)
+	}
+	if len(signers) == 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s has no signers", sdk.MsgTypeURL(msg))
+	}
+
+	msgType := sdk.MsgTypeURL(msg)
+	for _, signer := range signers {
+		granter := sdk.AccAddress(signer)
+		if granter.Equals(creator) {
+			continue
+		}
+
+		authorization, expiration := k.authzKeeper.GetAuthorization(ctx, creator, granter, msgType)
+		if authorization == nil {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrUnauthorized,
+				"%s is signed by %s which is not the task creator and has not granted %s to it",
+				msgType, granter, msgType,
+			)
+		}
+
+		if !accept {
+			continue
+		}
+
+		resp, err := authorization.Accept(ctx, msg)
+		if err != nil {
+			return err
+		}
+		if !resp.Accept {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authorization from %s was not accepted", granter)
+		}
+
+		switch {
+		case resp.Delete:
+			err = k.authzKeeper.DeleteGrant(ctx, creator, granter, msgType)
+		case resp.Updated != nil:
+			err = k.authzKeeper.SaveGrant(ctx, creator, granter, resp.Updated, expiration)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update authorization from %s: %w", granter, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// SimulateTask runs the messages of a proposed task in a cache context that is discarded, on
+// the same path as a scheduled run, and returns the gas they use and the smallest fee for
+// which the task would run in the next block
+func (q queryServer) SimulateTask(ctx context.Context, req *crontasktypes.QuerySimulateTaskRequest) (*crontasktypes.QuerySimulateTaskResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	msgs, err := req.GetMessages()
+	if err := q.k.validateTaskMsgs(ctx, creator, msgs, err); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	task := crontasktypes.Task{
+		Creator:        req.Creator,
+		Msgs:           req.Msgs,
+		TaskGasLimit:   req.TaskGasLimit,
+		ExecutionPhase: req.ExecutionPhase,
+	}
+	if task.TaskGasLimit == 0 {
+		task.TaskGasLimit = params.BlockGasLimit
+		if params.CreatorBlockGasLimit > 0 {
+			task.TaskGasLimit = min(task.TaskGasLimit, params.CreatorBlockGasLimit)
+		}
+	}
+	if err := task.ValidateSchedule(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if reason := oversizedReason(task, params); reason != "" {
+		return nil, status.Error(codes.InvalidArgument, reason)
+	}
+
+	// The state changes of the messages are never written. Scripts can reach this query during
+	// a transaction, so the simulation is limited to the gas the caller has left and the gas it
+	// uses is charged to the caller.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	simulationGasLimit := min(task.TaskGasLimit, sdkCtx.GasMeter().GasRemaining())
+	cacheCtx, _ := sdkCtx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(simulationGasLimit))
+	execErr := q.k.executeMsgs(cacheCtx, &task)
+	gasUsed := cacheCtx.GasMeter().GasConsumedToLimit()
+	sdkCtx.GasMeter().ConsumeGas(gasUsed, "simulate crontask")
+
+	res := &crontasktypes.QuerySimulateTaskResponse{
+		Success:      execErr == nil,
+		GasUsed:      gasUsed,
+		TaskGasLimit: task.TaskGasLimit,
+		MsgResults:   task.MsgResults,
+		ErrorLog:     task.ErrorLog,
+	}
+	if execErr != nil {
+		res.Codespace, res.Code = errorCode(execErr)
+	}
+
+	queue, err := q.k.nextBlockQueue(ctx, params, task.Phase())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res.QueueDepth = uint64(len(queue))
+	for _, t := range queue {
+		res.QueuedGas += t.TaskGasLimit
+	}
+
+	res.MinTaskGasFee = minFeeToRun(queue, params, sdkCtx.BlockTime().Unix(), task.TaskGasLimit)
+	res.MinGasPrice = sdk.NewDecCoinFromDec(
+		crontasktypes.FeeDenom,
+		sdkmath.LegacyNewDecFromInt(res.MinTaskGasFee.Amount).QuoInt64(int64(task.TaskGasLimit)),
+	)
+
+	return res, nil
+}
+
+// nextBlockQueue returns the tasks of a phase competing for the next block ordered by
+// priority: the pending tasks and the scheduled tasks without dependencies that are due
+func (k Keeper) nextBlockQueue(ctx context.Context, params crontasktypes.Params, phase string) ([]crontasktypes.Task, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	currentTime, nextHeight := sdkCtx.BlockTime().Unix(), sdkCtx.BlockHeight()+1
+
+	pendingIDs, err := k.taskIDsDueBy(ctx, crontasktypes.TaskStatus_PENDING, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+	dueIDs, err := k.taskIDsDueBy(ctx, crontasktypes.TaskStatus_SCHEDULED, currentTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var queue []crontasktypes.Task
+	for _, ids := range [][]uint64{pendingIDs, dueIDs} {
+		for _, id := range ids {
+			task, err := k.GetTask(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if task.Phase() != phase || task.ScheduledHeight > nextHeight || len(task.Dependencies) > 0 {
+				continue
+			}
+			queue = append(queue, task)
+		}
+	}
+
+	sortByPriority(queue, currentTime, params.AgingInterval)
+	return queue, nil
+}
+
+// minFeeToRun returns the smallest fee for which a task with the gas limit ranks ahead of
+// enough of the queue to fit in the block, assuming every task ahead uses its whole gas
+// limit. Tasks that do not fit are skipped like the scheduler does.
+func minFeeToRun(queue []crontasktypes.Task, params crontasktypes.Params, currentTime int64, gasLimit uint64) sdk.Coin {
+	// outbid is the last task the new task has to rank ahead of
+	var outbid *crontasktypes.Task
+	var consumed uint64
+	for i := range queue {
+		if consumed+gasLimit > params.BlockGasLimit {
+			outbid = &queue[max(i-1, 0)]
+			break
+		}
+		if consumed+queue[i].TaskGasLimit <= params.BlockGasLimit {
+			consumed += queue[i].TaskGasLimit
+		}
+	}
+	if outbid == nil && len(queue) > 0 && consumed+gasLimit > params.BlockGasLimit {
+		outbid = &queue[len(queue)-1]
+	}
+
+	// A task of equal priority that was created earlier runs first
+	fee := sdkmath.OneInt()
+	if outbid != nil {
+		fee = outbid.Priority(currentTime, params.AgingInterval).MulInt64(int64(gasLimit)).TruncateInt().AddRaw(1)
+	}
+	return sdk.NewCoin(crontasktypes.FeeDenom, fee)
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	crontasktypes "dysonprotocol.com/x/crontask/types"
+)
+
+// validateParentTasks checks that the parents of a new task exist and can still reach the
+// outcome its dependencies require
+func (k Keeper) validateParentTasks(ctx context.Context, task crontasktypes.Task) error {
+	for _, dep := range task.Dependencies {
+		parent, err := k.GetTask(ctx, dep.TaskId)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrNotFound, "dependency on task %d: task not found", dep.TaskId)
+		}
+		if satisfied, final := dep.SatisfiedBy(parent.Status); final && !satisfied {
+			return errorsmod.Wrapf(
+				sdkerrors.ErrInvalidRequest,
+				"dependency on task %d can never be satisfied: it finished with status %s",
+				dep.TaskId, parent.Status,
+			)
+		}
+	}
+	return nil
+}
+
+// resolveDependencies reports whether every parent of a task finished with the required
+// outcome. When a dependency can never be satisfied, because its parent finished with another
+// outcome or was removed, it returns the reason.
+func (k Keeper) resolveDependencies(ctx context.Context, task crontasktypes.Task) (bool, string) {
+	ready := true
+	for _, dep := range task.Dependencies {
+		parent, err := k.GetTask(ctx, dep.TaskId)
+		if err != nil {
+			return false, fmt.Sprintf("dependency on task %d: task not found", dep.TaskId)
+		}
+
+		satisfied, final := dep.SatisfiedBy(parent.Status)
+		if final && !satisfied {
+			return false, fmt.Sprintf("dependency on task %d requires %s but it finished with status %s",
+				dep.TaskId, dep.Outcome, parent.Status)
+		}
+		if !satisfied {
+			ready = false
+		}
+	}
+	return ready, ""
+}
+
+// cancelTask marks a task as CANCELLED, refunds its escrow and emits EventTaskCancelled.
+// The task is not saved.
+func (k Keeper) cancelTask(ctx context.Context, task *crontasktypes.Task, reason string) error {
+	task.Status = crontasktypes.TaskStatus_CANCELLED
+	task.ErrorLog = reason
+
+	if err := k.refundEscrow(ctx, task); err != nil {
+		return fmt.Errorf("failed to refund task escrow: %w", err)
+	}
+
+	k.Logger.Info("Task cancelled", "task_id", task.TaskId, "reason", reason)
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&crontasktypes.EventTaskCancelled{
+		TaskId:     task.TaskId,
+		Creator:    task.Creator,
+		WorkflowId: task.WorkflowId,
+		Reason:     reason,
+	})
+}
+
+// getWorkflowTaskIDs returns the IDs of the tasks of a workflow in ascending order
+func (k Keeper) getWorkflowTaskIDs(ctx context.Context, creator, workflowID string) []uint64 {
+	iter, err := k.Tasks.Indexes.ByWorkflow.MatchExact(ctx, collections.Join(creator, workflowID))
+	if err != nil {
+		k.Logger.Error("failed to iterate workflow tasks", "workflow_id", workflowID, "error", err)
+		return nil
+	}
+	defer iter.Close()
+
+	ids, err := iter.PrimaryKeys()
+	if err != nil {
+		k.Logger.Error("failed to iterate workflow tasks", "workflow_id", workflowID, "error", err)
+		return nil
+	}
+	return ids
+}
@@ -59,12 +59,148 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "TasksByWorkflow",
+					Use:       "tasks-by-workflow --creator <creator-address> --workflow-id <workflow-id>",
+					Short:     "Query the tasks of a workflow",
+					Long:      "Query the tasks of a workflow of a creator ordered by ID",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"creator": {
+							Name:  "creator",
+							Usage: "The creator address of the workflow",
+						},
+						"workflow_id": {
+							Name:  "workflow-id",
+							Usage: "The ID of the workflow",
+						},
+					},
+				},
 				{
 					RpcMethod: "TasksAll",
 					Use:       "tasks-all",
 					Short:     "Query all tasks",
 					Long:      "Query all tasks",
 				},
+				{
+					RpcMethod: "TasksByFilter",
+					Use:       "tasks-by-filter [--creator <creator-address>] [--status <status>] [--from-timestamp <timestamp>] [--to-timestamp <timestamp>]",
+					Short:     "Query tasks by creator, status and due time",
+					Long:      "Query the tasks matching a creator, a status and a due time range. Filters that are not set match every task. Tasks are ordered by due time when a status is given and by ID otherwise.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"creator": {
+							Name:  "creator",
+							Usage: "The creator address to filter tasks by",
+						},
+						"status": {
+							Name:  "status",
+							Usage: "The status to filter tasks by",
+						},
+						"from_timestamp": {
+							Name:  "from-timestamp",
+							Usage: "The earliest due time (Unix seconds), inclusive",
+						},
+						"to_timestamp": {
+							Name:  "to-timestamp",
+							Usage: "The latest due time (Unix seconds), inclusive",
+						},
+					},
+				},
+				{
+					RpcMethod: "ScheduleForecast",
+					Use:       "schedule-forecast [--from-timestamp <timestamp>] [--window-seconds <seconds>] [--bucket-seconds <seconds>]",
+					Short:     "Forecast the runs of scheduled tasks",
+					Long:      "Query the number of runs and the gas limit of the scheduled and pending tasks in time buckets of an upcoming window, including the future runs of recurring tasks",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"from_timestamp": {
+							Name:  "from-timestamp",
+							Usage: "Start of the window (Unix seconds), the block time by default",
+						},
+						"window_seconds": {
+							Name:  "window-seconds",
+							Usage: "Length of the window in seconds, one hour by default",
+						},
+						"bucket_seconds": {
+							Name:  "bucket-seconds",
+							Usage: "Length of a bucket in seconds, one minute by default",
+						},
+					},
+				},
+				{
+					RpcMethod: "TaskStats",
+					Use:       "task-stats [--upcoming-blocks <blocks>] [--block-time-seconds <seconds>]",
+					Short:     "Query task statistics",
+					Long:      "Query the number and gas limit of the tasks per status, and the gas limit of the tasks expected to run in each upcoming block",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"upcoming_blocks": {
+							Name:  "upcoming-blocks",
+							Usage: "Number of upcoming blocks to estimate, 10 by default",
+						},
+						"block_time_seconds": {
+							Name:  "block-time-seconds",
+							Usage: "Expected time between blocks in seconds, 5 by default",
+						},
+					},
+				},
+				{
+					RpcMethod: "SimulateTask",
+					Use:       "simulate-task --creator <creator-address> --msgs <messages> [--task-gas-limit <limit>] [--execution-phase <phase>]",
+					Short:     "Dry-run the messages of a task",
+					Long:      "Run the messages of a proposed task against the current state without writing any change, and return the gas used, the message results or error, and the minimum gas fee for the task to run in the next block at the current queue depth",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"creator": {
+							Name:  "creator",
+							Usage: "The address that would create the task and sign its messages",
+						},
+						"msgs": {
+							Name:  "msgs",
+							Usage: "JSON-encoded messages the task would execute",
+						},
+						"task_gas_limit": {
+							Name:  "task-gas-limit",
+							Usage: "Gas limit of the task, the largest allowed by default",
+						},
+						"execution_phase": {
+							Name:  "execution-phase",
+							Usage: "ABCI phase the task would run in: BEGIN_BLOCK (default) or END_BLOCK",
+						},
+					},
+				},
+				{
+					RpcMethod: "PayoutSchedule",
+					Use:       "payout-schedule --payout-id <payout-id>",
+					Short:     "Query a payout schedule by ID",
+					Long:      "Query a payout schedule with its recipients, the amount released to each of them and the escrow left",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"payout_id": {
+							Name:  "payout-id",
+							Usage: "The ID of the payout schedule to query",
+						},
+					},
+				},
+				{
+					RpcMethod: "PayoutSchedulesByRecipient",
+					Use:       "payout-schedules-by-recipient --recipient <recipient-address>",
+					Short:     "Query the payout schedules paying an address",
+					Long:      "Query the payout schedules that have an address among their recipients, ordered by ID",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"recipient": {
+							Name:  "recipient",
+							Usage: "The recipient address to filter payout schedules by",
+						},
+					},
+				},
+				{
+					RpcMethod: "PayoutSchedulesByCreator",
+					Use:       "payout-schedules-by-creator --creator <creator-address>",
+					Short:     "Query the payout schedules funded by an address",
+					Long:      "Query the payout schedules created by an address, ordered by ID",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"creator": {
+							Name:  "creator",
+							Usage: "The creator address to filter payout schedules by",
+						},
+					},
+				},
 				{
 					RpcMethod: "Params",
 					Use:       "params",
@@ -80,7 +216,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "CreateTask",
 					Use:       "create-task --scheduled-timestamp <timestamp> --expiry-timestamp <timestamp> --task-gas-limit <limit> --task-gas-fee <fee> --msgs <messages>",
 					Short:     "Create a new scheduled task",
-					Long:      "Create a new task to be executed at the specified timestamp",
+					Long:      "Create a new task to be executed at the specified timestamp. A task with a cron expression or an interval runs repeatedly, each run is charged the task gas fee. A task with a condition only runs once the condition holds.",
 					FlagOptions: map[string]*autocliv1.FlagOptions{
 						"scheduled_timestamp": {
 							Name:  "scheduled-timestamp",
@@ -102,6 +238,82 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 							Name:  "msgs",
 							Usage: "JSON-encoded messages to be executed when the task runs",
 						},
+						"cron_expression": {
+							Name:  "cron-expression",
+							Usage: "Standard 5-field cron expression in UTC (e.g. \"0 * * * *\" or @daily) to run the task repeatedly",
+						},
+						"interval_seconds": {
+							Name:  "interval-seconds",
+							Usage: "Run the task repeatedly every given number of seconds starting at the scheduled timestamp",
+						},
+						"max_occurrences": {
+							Name:  "max-occurrences",
+							Usage: "Maximum number of runs of a recurring task (0 for unlimited)",
+						},
+						"end_timestamp": {
+							Name:  "end-timestamp",
+							Usage: "Unix timestamp or +offset from the first run after which a recurring task is not rescheduled",
+						},
+						"dependencies": {
+							Name:  "dependencies",
+							Usage: "JSON-encoded dependency on a parent task, repeat the flag for several parents (e.g. {\"task_id\":\"12\",\"outcome\":\"SUCCESS\"}, outcome SUCCESS or FAILURE)",
+						},
+						"workflow_id": {
+							Name:  "workflow-id",
+							Usage: "Optional ID grouping the task with other tasks of the same workflow",
+						},
+						"execution_phase": {
+							Name:  "execution-phase",
+							Usage: "ABCI phase the task runs in: BEGIN_BLOCK (default) or END_BLOCK, an END_BLOCK task sees the transactions of its block",
+						},
+						"scheduled_height": {
+							Name:  "scheduled-height",
+							Usage: "Optional block height the task waits for in addition to the scheduled timestamp",
+						},
+						"retry_policy": {
+							Name:  "retry-policy",
+							Usage: "JSON-encoded policy retrying failed attempts of a run (e.g. {\"max_attempts\":3,\"backoff\":\"EXPONENTIAL\",\"backoff_seconds\":\"30\",\"retryable_errors\":[\"sdk:5\"]})",
+						},
+						"callback": {
+							Name:  "callback",
+							Usage: "JSON-encoded script function called with the task_id, status, results, gas_used and error_log of every finished run (e.g. {\"script_address\":\"dys1...\",\"function_name\":\"on_done\",\"gas_limit\":\"200000\"})",
+						},
+						"condition": {
+							Name:  "condition",
+							Usage: "JSON-encoded condition that must hold for the task to run between the scheduled and expiry timestamps (e.g. {\"balance_below\":{\"address\":\"dys1...\",\"amount\":{\"denom\":\"dys\",\"amount\":\"100\"}}})",
+						},
+					},
+				},
+				{
+					RpcMethod: "UpdateTask",
+					Use:       "update-task --task-id <task-id>",
+					Short:     "Update a scheduled task",
+					Long:      "Change the scheduled time, expiry, gas limit, gas fee or messages of a scheduled task you have created, keeping its ID and history. Omitted flags are left unchanged. A new scheduled time keeps the expiry window of the task unless a new expiry is given. The escrow is adjusted to the new gas fee.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"task_id": {
+							Name:  "task-id",
+							Usage: "The ID of the task to update",
+						},
+						"scheduled_timestamp": {
+							Name:  "scheduled-timestamp",
+							Usage: "New Unix timestamp or +offset from the current block time when the task should execute",
+						},
+						"expiry_timestamp": {
+							Name:  "expiry-timestamp",
+							Usage: "New Unix timestamp or +offset from the scheduled time after which the task expires",
+						},
+						"task_gas_limit": {
+							Name:  "task-gas-limit",
+							Usage: "New maximum gas limit for the task execution",
+						},
+						"task_gas_fee": {
+							Name:  "task-gas-fee",
+							Usage: "New gas fee for the task execution (format: <amount>dys)",
+						},
+						"msgs": {
+							Name:  "msgs",
+							Usage: "New JSON-encoded messages to be executed when the task runs",
+						},
 					},
 				},
 				{
@@ -116,6 +328,70 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "TopUpTask",
+					Use:       "top-up-task --task-id <task-id> --amount <amount>",
+					Short:     "Add funds to the fee escrow of a recurring or conditional task",
+					Long:      "Add funds to the fee escrow of a recurring or conditional task you have created. Each run and condition check is paid from the escrow and the remainder is refunded when the task ends or is deleted.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"task_id": {
+							Name:  "task-id",
+							Usage: "The ID of the task to top up",
+						},
+						"amount": {
+							Name:  "amount",
+							Usage: "Amount to add to the escrow (format: <amount>dys)",
+						},
+					},
+				},
+				{
+					RpcMethod: "CancelWorkflow",
+					Use:       "cancel-workflow --workflow-id <workflow-id>",
+					Short:     "Cancel the tasks of a workflow",
+					Long:      "Cancel every scheduled or pending task of a workflow you have created and refund their escrow. Tasks depending on a cancelled task are cancelled when they are due.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"workflow_id": {
+							Name:  "workflow-id",
+							Usage: "The ID of the workflow to cancel",
+						},
+					},
+				},
+				{
+					RpcMethod: "CreatePayoutSchedule",
+					Use:       "create-payout-schedule --recipients <recipient> --total-releases <releases> [--interval-seconds <seconds>] [--start-timestamp <timestamp>]",
+					Short:     "Lock funds and release them to recipients on a schedule",
+					Long:      "Escrow the amount of every release for all the releases and pay it to the recipients at the start timestamp and then every interval. The creator can cancel the schedule, paying the recipients the share vested so far and refunding the rest.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"recipients": {
+							Name:  "recipients",
+							Usage: "JSON-encoded recipient and the amount it receives at every release, repeat the flag for several recipients (e.g. {\"address\":\"dys1...\",\"amount\":[{\"denom\":\"dys\",\"amount\":\"100\"}]})",
+						},
+						"start_timestamp": {
+							Name:  "start-timestamp",
+							Usage: "Unix timestamp or +offset from the current block time of the first release, one interval from now by default",
+						},
+						"interval_seconds": {
+							Name:  "interval-seconds",
+							Usage: "Seconds between two releases, 0 for a single release time-lock",
+						},
+						"total_releases": {
+							Name:  "total-releases",
+							Usage: "Total number of releases",
+						},
+					},
+				},
+				{
+					RpcMethod: "CancelPayoutSchedule",
+					Use:       "cancel-payout-schedule --payout-id <payout-id>",
+					Short:     "Cancel a payout schedule",
+					Long:      "Cancel an active payout schedule you have created. The recipients are paid the releases that are due and the share of the next release vested since the previous one, the rest of the escrow is refunded.",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"payout_id": {
+							Name:  "payout-id",
+							Usage: "The ID of the payout schedule to cancel",
+						},
+					},
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Use:       "update-params --authority <address> --params <json>",
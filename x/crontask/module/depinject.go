@@ -9,11 +9,14 @@ import (
 	modulev1 "dysonprotocol.com/api/crontask/module/v1"
 	"dysonprotocol.com/x/crontask"
 	"dysonprotocol.com/x/crontask/keeper"
+	nameservicekeeper "dysonprotocol.com/x/nameservice/keeper"
+	storagekeeper "dysonprotocol.com/x/storage/keeper"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 )
 
 var _ depinject.OnePerModuleType = AppModule{}
@@ -32,15 +35,18 @@ func init() {
 type CrontaskInputs struct {
 	depinject.In
 
-	ModuleKey        depinject.OwnModuleKey
-	Config           *modulev1.Module
-	Cdc              codec.Codec
-	AccountKeeper    authkeeper.AccountKeeper
-	BankKeeper       crontask.BankKeeper
-	StoreService     store.KVStoreService
-	Registry         cdctypes.InterfaceRegistry
-	Logger           log.Logger
-	MsgServiceRouter *baseapp.MsgServiceRouter
+	ModuleKey         depinject.OwnModuleKey
+	Config            *modulev1.Module
+	Cdc               codec.Codec
+	AccountKeeper     authkeeper.AccountKeeper
+	BankKeeper        crontask.BankKeeper
+	AuthzKeeper       authzkeeper.Keeper
+	StorageKeeper     storagekeeper.Keeper
+	NameserviceKeeper nameservicekeeper.Keeper
+	StoreService      store.KVStoreService
+	Registry          cdctypes.InterfaceRegistry
+	Logger            log.Logger
+	MsgServiceRouter  *baseapp.MsgServiceRouter
 }
 
 // CrontaskOutputs is the output for the dep inject
@@ -58,6 +64,9 @@ func ProvideModule(in CrontaskInputs) CrontaskOutputs {
 		in.StoreService,
 		in.AccountKeeper,
 		in.BankKeeper,
+		in.AuthzKeeper,
+		in.StorageKeeper,
+		in.NameserviceKeeper,
 		in.MsgServiceRouter,
 		*crontask.DefaultConfig(),
 		in.Logger,
@@ -67,6 +76,7 @@ func ProvideModule(in CrontaskInputs) CrontaskOutputs {
 		in.Cdc,
 		k,
 		in.AccountKeeper,
+		in.BankKeeper,
 		in.Registry,
 	)
 
@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/core/appmodule"
 	"dysonprotocol.com/x/crontask"
 	"dysonprotocol.com/x/crontask/keeper"
+	"dysonprotocol.com/x/crontask/simulation"
 	"dysonprotocol.com/x/crontask/types"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
@@ -23,13 +24,17 @@ import (
 )
 
 // ConsensusVersion defines the current x/crontask module consensus version.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic     = AppModuleBasic{}
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
+	_ appmodule.HasEndBlocker   = AppModule{}
 	_ appmodule.HasGenesis      = AppModule{}
+
+	_ module.AppModuleSimulation = AppModule{}
+	_ module.HasInvariants       = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the crontask module.
@@ -77,14 +82,16 @@ type AppModule struct {
 
 	keeper        keeper.Keeper
 	accountKeeper crontask.AccountKeeper
+	bankKeeper    crontask.BankKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak crontask.AccountKeeper, registry cdctypes.InterfaceRegistry) AppModule {
+func NewAppModule(cdc codec.Codec, keeper keeper.Keeper, ak crontask.AccountKeeper, bk crontask.BankKeeper, registry cdctypes.InterfaceRegistry) AppModule {
 	return AppModule{
 		cdc:           cdc,
 		keeper:        keeper,
 		accountKeeper: ak,
+		bankKeeper:    bk,
 		registry:      registry,
 	}
 }
@@ -118,19 +125,37 @@ func (am AppModule) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), &am.keeper)
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(crontask.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", crontask.ModuleName, err))
+	}
 }
 
 // ConsensusVersion implements HasConsensusVersion
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
+// RegisterInvariants registers the crontask module invariants
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+// GenerateGenesisState creates a randomized GenState of the crontask module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
 // RegisterStoreDecoder registers a decoder for crontask module's types
 func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
-	// No custom store decoder needed for this module
+	sdr[crontask.StoreKey] = simtypes.NewStoreDecoderFuncFromCollectionsSchema(am.keeper.Schema)
 }
 
 // WeightedOperations returns the all the crontask module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return []simtypes.WeightedOperation{}
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		am.registry, simState.AppParams, simState.TxConfig,
+		am.accountKeeper, am.bankKeeper, am.keeper,
+	)
 }
 
 // BeginBlock implements the appmodule.HasBeginBlocker interface
@@ -138,6 +163,11 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	return am.keeper.BeginBlocker(sdk.UnwrapSDKContext(ctx))
 }
 
+// EndBlock implements the appmodule.HasEndBlocker interface
+func (am AppModule) EndBlock(ctx context.Context) error {
+	return am.keeper.EndBlocker(sdk.UnwrapSDKContext(ctx))
+}
+
 // ValidateGenesis validates the genesis state for the crontask module.
 // It now matches the appmodule.HasGenesis interface.
 func (am AppModule) ValidateGenesis(source appmodule.GenesisSource) error {
@@ -0,0 +1,98 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"dysonprotocol.com/x/crontask"
+	"dysonprotocol.com/x/crontask/types"
+)
+
+// Simulation parameter constants
+const (
+	BlockGasLimit        = "block_gas_limit"
+	CreatorBlockGasLimit = "creator_block_gas_limit"
+	ExpiryLimit          = "expiry_limit"
+	MaxScheduledTime     = "max_scheduled_time"
+	CleanUpTime          = "clean_up_time"
+	AgingInterval        = "aging_interval"
+)
+
+// GenBlockGasLimit randomized BlockGasLimit
+func GenBlockGasLimit(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(19_000_000) + 1_000_000)
+}
+
+// GenCreatorBlockGasLimit randomized CreatorBlockGasLimit, no quota or a share of the block
+// gas limit
+func GenCreatorBlockGasLimit(r *rand.Rand, blockGasLimit uint64) uint64 {
+	if r.Intn(4) == 0 {
+		return 0
+	}
+	return blockGasLimit / uint64(r.Intn(8)+1)
+}
+
+// GenExpiryLimit randomized ExpiryLimit
+func GenExpiryLimit(r *rand.Rand) int64 {
+	return r.Int63n(86400-600) + 600
+}
+
+// GenMaxScheduledTime randomized MaxScheduledTime
+func GenMaxScheduledTime(r *rand.Rand) int64 {
+	return r.Int63n(86400-600) + 600
+}
+
+// GenCleanUpTime randomized CleanUpTime, zero keeps finished tasks
+func GenCleanUpTime(r *rand.Rand) int64 {
+	return r.Int63n(86400)
+}
+
+// GenAgingInterval randomized AgingInterval, zero disables aging
+func GenAgingInterval(r *rand.Rand) int64 {
+	return r.Int63n(300)
+}
+
+// RandomizedGenState generates a random GenesisState for crontask
+func RandomizedGenState(simState *module.SimulationState) {
+	var blockGasLimit uint64
+	simState.AppParams.GetOrGenerate(BlockGasLimit, &blockGasLimit, simState.Rand, func(r *rand.Rand) { blockGasLimit = GenBlockGasLimit(r) })
+
+	var creatorBlockGasLimit uint64
+	simState.AppParams.GetOrGenerate(CreatorBlockGasLimit, &creatorBlockGasLimit, simState.Rand, func(r *rand.Rand) {
+		creatorBlockGasLimit = GenCreatorBlockGasLimit(r, blockGasLimit)
+	})
+
+	var expiryLimit int64
+	simState.AppParams.GetOrGenerate(ExpiryLimit, &expiryLimit, simState.Rand, func(r *rand.Rand) { expiryLimit = GenExpiryLimit(r) })
+
+	var maxScheduledTime int64
+	simState.AppParams.GetOrGenerate(MaxScheduledTime, &maxScheduledTime, simState.Rand, func(r *rand.Rand) { maxScheduledTime = GenMaxScheduledTime(r) })
+
+	var cleanUpTime int64
+	simState.AppParams.GetOrGenerate(CleanUpTime, &cleanUpTime, simState.Rand, func(r *rand.Rand) { cleanUpTime = GenCleanUpTime(r) })
+
+	var agingInterval int64
+	simState.AppParams.GetOrGenerate(AgingInterval, &agingInterval, simState.Rand, func(r *rand.Rand) { agingInterval = GenAgingInterval(r) })
+
+	params := types.Params{
+		BlockGasLimit:        blockGasLimit,
+		CreatorBlockGasLimit: creatorBlockGasLimit,
+		ExpiryLimit:          expiryLimit,
+		MaxScheduledTime:     maxScheduledTime,
+		CleanUpTime:          cleanUpTime,
+		AgingInterval:        agingInterval,
+	}
+
+	genesis := types.NewGenesisState()
+	genesis.Params = &params
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated crontask parameters:\n%s\n", bz)
+	simState.GenState[crontask.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
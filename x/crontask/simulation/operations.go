@@ -0,0 +1,289 @@
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"dysonprotocol.com/x/crontask"
+	"dysonprotocol.com/x/crontask/keeper"
+	"dysonprotocol.com/x/crontask/types"
+)
+
+// crontask message types
+var (
+	TypeMsgCreateTask = sdk.MsgTypeURL(&types.MsgCreateTask{})
+	TypeMsgUpdateTask = sdk.MsgTypeURL(&types.MsgUpdateTask{})
+	TypeMsgDeleteTask = sdk.MsgTypeURL(&types.MsgDeleteTask{})
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateTask = "op_weight_msg_create_task"
+	OpWeightMsgUpdateTask = "op_weight_msg_update_task"
+	OpWeightMsgDeleteTask = "op_weight_msg_delete_task"
+)
+
+// crontask operations weights
+const (
+	WeightCreateTask = 100
+	WeightUpdateTask = 30
+	WeightDeleteTask = 20
+)
+
+// maxSimTaskGasLimit is the largest gas limit of a simulated task, a bank send uses far less
+const maxSimTaskGasLimit = 500_000
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	registry cdctypes.InterfaceRegistry,
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak crontask.AccountKeeper,
+	bk crontask.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateTask int
+		weightMsgUpdateTask int
+		weightMsgDeleteTask int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateTask, &weightMsgCreateTask, nil, func(_ *rand.Rand) {
+		weightMsgCreateTask = WeightCreateTask
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgUpdateTask, &weightMsgUpdateTask, nil, func(_ *rand.Rand) {
+		weightMsgUpdateTask = WeightUpdateTask
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgDeleteTask, &weightMsgDeleteTask, nil, func(_ *rand.Rand) {
+		weightMsgDeleteTask = WeightDeleteTask
+	})
+
+	pCdc := codec.NewProtoCodec(registry)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateTask,
+			SimulateMsgCreateTask(pCdc, txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateTask,
+			SimulateMsgUpdateTask(pCdc, txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgDeleteTask,
+			SimulateMsgDeleteTask(pCdc, txGen, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateTask generates a MsgCreateTask sending a coin to a random account, run once
+// or a few times on an interval, with a random schedule, expiry and gas
+func SimulateMsgCreateTask(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak crontask.AccountKeeper,
+	bk crontask.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		creator, _ := simtypes.RandomAcc(r, accs)
+		recipient, _ := simtypes.RandomAcc(r, accs)
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgCreateTask, "unable to get params"), nil, err
+		}
+
+		gasLimit := min(uint64(maxSimTaskGasLimit), params.BlockGasLimit)
+		if params.CreatorBlockGasLimit > 0 {
+			gasLimit = min(gasLimit, params.CreatorBlockGasLimit)
+		}
+		gasLimit = uint64(r.Int63n(int64(gasLimit))) + 1
+
+		msg := &types.MsgCreateTask{
+			Creator:            creator.Address.String(),
+			ScheduledTimestamp: fmt.Sprintf("+%ds", r.Int63n(min(params.MaxScheduledTime, 600)+1)),
+			ExpiryTimestamp:    fmt.Sprintf("+%ds", r.Int63n(params.ExpiryLimit)+1),
+			TaskGasLimit:       gasLimit,
+		}
+		if r.Intn(4) == 0 {
+			msg.IntervalSeconds = r.Int63n(600) + 1
+			msg.MaxOccurrences = uint64(r.Intn(5) + 1)
+		}
+
+		// The fee of every run and the sent coin of every run must be covered
+		runs := max(msg.MaxOccurrences, 1)
+		spendable := bk.SpendableCoins(ctx, creator.Address).AmountOf(types.FeeDenom)
+		gasPrice := sdkmath.NewInt(r.Int63n(10) + 1)
+		msg.TaskGasFee = sdk.NewCoin(types.FeeDenom, gasPrice.MulRaw(int64(gasLimit)))
+		sent := sdk.NewCoin(types.FeeDenom, sdkmath.OneInt())
+		spent := sdk.NewCoin(types.FeeDenom, msg.TaskGasFee.Amount.Add(sent.Amount).MulRaw(int64(runs)))
+		if spendable.LT(spent.Amount) {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgCreateTask, "creator cannot cover the task fee"), nil, nil
+		}
+
+		if err := msg.SetMessages([]sdk.Msg{banktypes.NewMsgSend(creator.Address, recipient.Address, sdk.NewCoins(sent))}); err != nil {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgCreateTask, "unable to pack task messages"), nil, err
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Cdc:             cdc,
+			Msg:             msg,
+			CoinsSpentInMsg: sdk.NewCoins(spent),
+			Context:         ctx,
+			SimAccount:      creator,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      crontask.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgUpdateTask generates a MsgUpdateTask moving a random scheduled task and changing
+// its gas fee
+func SimulateMsgUpdateTask(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak crontask.AccountKeeper,
+	bk crontask.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		task, creator, err := randomTask(r, ctx, k, accs, types.TaskStatus_SCHEDULED)
+		if err != nil {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgUpdateTask, "unable to get tasks"), nil, err
+		}
+		if task == nil {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgUpdateTask, "no scheduled task of a simulation account"), nil, nil
+		}
+
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgUpdateTask, "unable to get params"), nil, err
+		}
+
+		fee := sdk.NewCoin(types.FeeDenom, sdkmath.NewInt(r.Int63n(10)+1).MulRaw(int64(task.TaskGasLimit)))
+		msg := &types.MsgUpdateTask{
+			Creator:            task.Creator,
+			TaskId:             task.TaskId,
+			ScheduledTimestamp: fmt.Sprintf("+%ds", r.Int63n(min(params.MaxScheduledTime, 600)+1)),
+			TaskGasFee:         &fee,
+		}
+
+		// The escrow is topped up to cover the remaining runs at the new fee
+		spent := sdk.NewCoins()
+		updated := *task
+		updated.TaskGasFee = fee
+		required := task.UpdatedEscrow(updated)
+		switch {
+		case task.Escrow.Denom == "":
+			spent = sdk.NewCoins(required)
+		case required.Amount.GT(task.Escrow.Amount):
+			spent = sdk.NewCoins(required.Sub(task.Escrow))
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Cdc:             cdc,
+			Msg:             msg,
+			CoinsSpentInMsg: spent,
+			Context:         ctx,
+			SimAccount:      creator,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      crontask.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgDeleteTask generates a MsgDeleteTask of a random task
+func SimulateMsgDeleteTask(
+	cdc *codec.ProtoCodec,
+	txCfg client.TxConfig,
+	ak crontask.AccountKeeper,
+	bk crontask.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		task, creator, err := randomTask(r, ctx, k, accs, "")
+		if err != nil {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgDeleteTask, "unable to get tasks"), nil, err
+		}
+		if task == nil {
+			return simtypes.NoOpMsg(crontask.ModuleName, TypeMsgDeleteTask, "no task of a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgDeleteTask{
+			Creator: task.Creator,
+			TaskId:  task.TaskId,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txCfg,
+			Cdc:             cdc,
+			Msg:             msg,
+			CoinsSpentInMsg: sdk.NewCoins(),
+			Context:         ctx,
+			SimAccount:      creator,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      crontask.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randomTask returns a random task created by one of the simulation accounts with the given
+// status, of any status when it is empty, and its creator. It returns a nil task when there
+// is none.
+func randomTask(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, status string) (*types.Task, simtypes.Account, error) {
+	var tasks []types.Task
+	err := k.Tasks.Walk(ctx, nil, func(_ uint64, task types.Task) (bool, error) {
+		if status == "" || task.Status == status {
+			tasks = append(tasks, task)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, simtypes.Account{}, err
+	}
+
+	r.Shuffle(len(tasks), func(i, j int) { tasks[i], tasks[j] = tasks[j], tasks[i] })
+	for i := range tasks {
+		creator, err := sdk.AccAddressFromBech32(tasks[i].Creator)
+		if err != nil {
+			continue
+		}
+		if acc, found := simtypes.FindAccount(accs, creator); found {
+			return &tasks[i], acc, nil
+		}
+	}
+	return nil, simtypes.Account{}, nil
+}
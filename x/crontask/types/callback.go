@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultCallbackGasLimit is the gas limit of a callback when none is set
+	DefaultCallbackGasLimit uint64 = 200000
+
+	// MaxCallbackGasLimit is the maximum gas limit of a callback
+	MaxCallbackGasLimit uint64 = 1000000
+)
+
+// GasLimitOrDefault returns the gas limit of a single call of the callback
+func (c TaskCallback) GasLimitOrDefault() uint64 {
+	if c.GasLimit == 0 {
+		return DefaultCallbackGasLimit
+	}
+	return c.GasLimit
+}
+
+// Validate checks that the callback names a script function and that its gas limit is allowed
+func (c TaskCallback) Validate() error {
+	if c.ScriptAddress == "" {
+		return fmt.Errorf("callback script address cannot be empty")
+	}
+	if c.FunctionName == "" {
+		return fmt.Errorf("callback function name cannot be empty")
+	}
+	if c.GasLimit > MaxCallbackGasLimit {
+		return fmt.Errorf("callback gas limit %d exceeds the maximum %d", c.GasLimit, MaxCallbackGasLimit)
+	}
+	return nil
+}
@@ -18,7 +18,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// Register message implementations
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateTask{},
+		&MsgUpdateTask{},
 		&MsgDeleteTask{},
+		&MsgTopUpTask{},
+		&MsgCancelWorkflow{},
+		&MsgCreatePayoutSchedule{},
+		&MsgCancelPayoutSchedule{},
 		&MsgUpdateParams{},
 	)
 
@@ -27,6 +32,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*gogoprotoany.UnpackInterfacesMessage)(nil),
 		&Task{},
 		&MsgCreateTask{},
+		&MsgUpdateTask{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultConditionGasLimit is the gas limit of a condition evaluation when none is set
+	DefaultConditionGasLimit uint64 = 100000
+
+	// MaxConditionGasLimit is the maximum gas limit of a condition evaluation
+	MaxConditionGasLimit uint64 = 1000000
+)
+
+// IsConditional reports whether the task waits for a condition before running
+func (t Task) IsConditional() bool {
+	return t.Condition != nil
+}
+
+// GasLimitOrDefault returns the gas limit of a single evaluation of the condition
+func (c TaskCondition) GasLimitOrDefault() uint64 {
+	if c.GasLimit == 0 {
+		return DefaultConditionGasLimit
+	}
+	return c.GasLimit
+}
+
+// Validate checks that exactly one condition is set and that it is well formed
+func (c TaskCondition) Validate() error {
+	set := 0
+	for _, isSet := range []bool{c.BalanceBelow != nil, c.StorageChanged != nil, c.NameExpired != nil, c.ScriptView != nil} {
+		if isSet {
+			set++
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one condition must be set, got %d", set)
+	}
+
+	if c.GasLimit > MaxConditionGasLimit {
+		return fmt.Errorf("condition gas limit %d exceeds the maximum of %d", c.GasLimit, MaxConditionGasLimit)
+	}
+	if c.Deposit.Denom != "" || !c.Deposit.Amount.IsNil() {
+		if err := c.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid condition deposit: %w", err)
+		}
+	}
+
+	switch {
+	case c.BalanceBelow != nil:
+		if _, err := sdk.AccAddressFromBech32(c.BalanceBelow.Address); err != nil {
+			return fmt.Errorf("invalid balance condition address: %w", err)
+		}
+		if err := c.BalanceBelow.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid balance condition amount: %w", err)
+		}
+	case c.StorageChanged != nil:
+		if _, err := sdk.AccAddressFromBech32(c.StorageChanged.Owner); err != nil {
+			return fmt.Errorf("invalid storage condition owner: %w", err)
+		}
+		if c.StorageChanged.Index == "" {
+			return fmt.Errorf("storage condition index cannot be empty")
+		}
+	case c.NameExpired != nil:
+		if c.NameExpired.Name == "" {
+			return fmt.Errorf("name condition name cannot be empty")
+		}
+	case c.ScriptView != nil:
+		if c.ScriptView.ScriptAddress == "" {
+			return fmt.Errorf("script condition address cannot be empty")
+		}
+		if c.ScriptView.FunctionName == "" {
+			return fmt.Errorf("script condition function name cannot be empty")
+		}
+		if c.ScriptView.Args != "" && !json.Valid([]byte(c.ScriptView.Args)) {
+			return fmt.Errorf("script condition args must be valid JSON")
+		}
+		if c.ScriptView.Kwargs != "" && !json.Valid([]byte(c.ScriptView.Kwargs)) {
+			return fmt.Errorf("script condition kwargs must be valid JSON")
+		}
+	}
+	return nil
+}
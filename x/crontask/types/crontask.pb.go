@@ -60,6 +60,51 @@ type Task struct {
 	TaskGasConsumed uint64 `protobuf:"varint,13,opt,name=task_gas_consumed,json=taskGasConsumed,proto3" json:"task_gas_consumed,omitempty"`
 	// Block timestamp when the task was executed (only for DONE / FAILED)
 	ExecutionTimestamp int64 `protobuf:"varint,14,opt,name=execution_timestamp,json=executionTimestamp,proto3" json:"execution_timestamp,omitempty"`
+	// Standard 5-field cron expression (UTC) of a recurring task
+	CronExpression string `protobuf:"bytes,15,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// Fixed interval in seconds between the runs of a recurring task
+	IntervalSeconds int64 `protobuf:"varint,16,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Maximum number of runs of a recurring task, 0 for unlimited
+	MaxOccurrences uint64 `protobuf:"varint,17,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`
+	// Unix timestamp after which a recurring task is not rescheduled, 0 for none
+	EndTimestamp int64 `protobuf:"varint,18,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Number of runs executed or missed so far
+	Occurrences uint64 `protobuf:"varint,19,opt,name=occurrences,proto3" json:"occurrences,omitempty"`
+	// Most recent runs of the task, oldest first
+	RunHistory []TaskRun `protobuf:"bytes,20,rep,name=run_history,json=runHistory,proto3" json:"run_history"`
+	// Fees held in the module account for the remaining runs of the task
+	Escrow types.Coin `protobuf:"bytes,21,opt,name=escrow,proto3" json:"escrow"`
+	// Condition that must hold for the task to run. The task only runs between
+	// scheduled_timestamp and expiry_timestamp.
+	Condition *TaskCondition `protobuf:"bytes,22,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Number of times the condition was evaluated
+	ConditionChecks uint64 `protobuf:"varint,23,opt,name=condition_checks,json=conditionChecks,proto3" json:"condition_checks,omitempty"`
+	// Tasks that must finish with the required outcome before the task runs
+	Dependencies []TaskDependency `protobuf:"bytes,24,rep,name=dependencies,proto3" json:"dependencies"`
+	// Optional ID grouping the tasks of a workflow of the creator
+	WorkflowId string `protobuf:"bytes,25,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Number of blocks in which the pending task was deferred for lack of gas
+	Deferrals uint64 `protobuf:"varint,26,opt,name=deferrals,proto3" json:"deferrals,omitempty"`
+	// Phase of the block in which the task runs: BEGIN_BLOCK (default) before
+	// the transactions of the block, END_BLOCK after them
+	ExecutionPhase string `protobuf:"bytes,27,opt,name=execution_phase,json=executionPhase,proto3" json:"execution_phase,omitempty"`
+	// Block height at or after which the task runs, in addition to its
+	// scheduled timestamp, 0 if the task is only scheduled by time
+	ScheduledHeight int64 `protobuf:"varint,28,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+	// Block height when the task was last executed
+	ExecutionHeight int64 `protobuf:"varint,29,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// Policy retrying the failed attempts of a run, none if empty
+	RetryPolicy *RetryPolicy `protobuf:"bytes,30,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Number of failed attempts of the current run
+	Attempts uint32 `protobuf:"varint,31,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Errors of the most recent failed attempts
+	AttemptLog []TaskAttempt `protobuf:"bytes,32,rep,name=attempt_log,json=attemptLog,proto3" json:"attempt_log"`
+	// Unix timestamp of the next attempt of a failed run, 0 when the task is
+	// not waiting for a retry
+	NextAttemptTimestamp int64 `protobuf:"varint,33,opt,name=next_attempt_timestamp,json=nextAttemptTimestamp,proto3" json:"next_attempt_timestamp,omitempty"`
+	// Script function called after every run of the task finishes, none if
+	// empty
+	Callback *TaskCallback `protobuf:"bytes,34,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (m *Task) Reset()         { *m = Task{} }
@@ -193,30 +238,170 @@ func (m *Task) GetExecutionTimestamp() int64 {
 	return 0
 }
 
-// Params defines the parameters for the crontask module
-type Params struct {
-	// Maximum gas allowed for executing tasks per block
-	BlockGasLimit uint64 `protobuf:"varint,1,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
-	// Default expiry limit in seconds (24 hours)
-	ExpiryLimit int64 `protobuf:"varint,2,opt,name=expiry_limit,json=expiryLimit,proto3" json:"expiry_limit,omitempty"`
-	// Maximum allowed scheduled time in seconds from task creation (24 hours)
-	MaxScheduledTime int64 `protobuf:"varint,3,opt,name=max_scheduled_time,json=maxScheduledTime,proto3" json:"max_scheduled_time,omitempty"`
-	// Retention period for completed/failed/expired tasks (seconds)
-	CleanUpTime int64 `protobuf:"varint,4,opt,name=clean_up_time,json=cleanUpTime,proto3" json:"clean_up_time,omitempty"`
+func (m *Task) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
+func (m *Task) GetIntervalSeconds() int64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *Task) GetMaxOccurrences() uint64 {
+	if m != nil {
+		return m.MaxOccurrences
+	}
+	return 0
+}
+
+func (m *Task) GetEndTimestamp() int64 {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return 0
+}
+
+func (m *Task) GetOccurrences() uint64 {
+	if m != nil {
+		return m.Occurrences
+	}
+	return 0
+}
+
+func (m *Task) GetRunHistory() []TaskRun {
+	if m != nil {
+		return m.RunHistory
+	}
+	return nil
+}
+
+func (m *Task) GetEscrow() types.Coin {
+	if m != nil {
+		return m.Escrow
+	}
+	return types.Coin{}
+}
+
+func (m *Task) GetCondition() *TaskCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (m *Task) GetConditionChecks() uint64 {
+	if m != nil {
+		return m.ConditionChecks
+	}
+	return 0
+}
+
+func (m *Task) GetDependencies() []TaskDependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *Task) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *Task) GetDeferrals() uint64 {
+	if m != nil {
+		return m.Deferrals
+	}
+	return 0
+}
+
+func (m *Task) GetExecutionPhase() string {
+	if m != nil {
+		return m.ExecutionPhase
+	}
+	return ""
+}
+
+func (m *Task) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
+func (m *Task) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+func (m *Task) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *Task) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *Task) GetAttemptLog() []TaskAttempt {
+	if m != nil {
+		return m.AttemptLog
+	}
+	return nil
+}
+
+func (m *Task) GetNextAttemptTimestamp() int64 {
+	if m != nil {
+		return m.NextAttemptTimestamp
+	}
+	return 0
+}
+
+func (m *Task) GetCallback() *TaskCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
+}
+
+// TaskCallback calls a script function after a run of a task is DONE, FAILED
+// or EXPIRED. The function is executed by the task creator with the task_id,
+// status, results, gas_used and error_log of the run as kwargs, on its own gas
+// limit charged to the task escrow. A failing callback is recorded in the run
+// and does not revert the run.
+type TaskCallback struct {
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	FunctionName  string `protobuf:"bytes,2,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// Maximum gas of the callback, 200000 by default
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *TaskCallback) Reset()         { *m = TaskCallback{} }
+func (m *TaskCallback) String() string { return proto.CompactTextString(m) }
+func (*TaskCallback) ProtoMessage()    {}
+func (*TaskCallback) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c2a40f3e0e41e1b8, []int{1}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *TaskCallback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TaskCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaskCallback.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -226,366 +411,4467 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *TaskCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCallback.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *TaskCallback) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *TaskCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCallback.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_TaskCallback proto.InternalMessageInfo
 
-func (m *Params) GetBlockGasLimit() uint64 {
+func (m *TaskCallback) GetScriptAddress() string {
 	if m != nil {
-		return m.BlockGasLimit
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *TaskCallback) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+func (m *TaskCallback) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
 	return 0
 }
 
-func (m *Params) GetExpiryLimit() int64 {
+// RetryPolicy retries a run whose messages failed until it succeeds, the
+// attempts are exhausted or the run expires
+type RetryPolicy struct {
+	// Maximum number of attempts of a run, including the first one
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// Backoff between attempts: FIXED (default) or EXPONENTIAL
+	Backoff string `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// Delay in seconds before the first retry. An EXPONENTIAL backoff doubles
+	// it after every failed attempt.
+	BackoffSeconds int64 `protobuf:"varint,3,opt,name=backoff_seconds,json=backoffSeconds,proto3" json:"backoff_seconds,omitempty"`
+	// Maximum delay in seconds between attempts, 0 for no maximum
+	MaxBackoffSeconds int64 `protobuf:"varint,4,opt,name=max_backoff_seconds,json=maxBackoffSeconds,proto3" json:"max_backoff_seconds,omitempty"`
+	// Errors that are retried, as "codespace" or "codespace:code" (e.g.
+	// "sdk:5" for insufficient funds). Every error is retried if empty.
+	RetryableErrors []string `protobuf:"bytes,5,rep,name=retryable_errors,json=retryableErrors,proto3" json:"retryable_errors,omitempty"`
+}
+
+func (m *RetryPolicy) Reset()         { *m = RetryPolicy{} }
+func (m *RetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetryPolicy) ProtoMessage()    {}
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{2}
+}
+func (m *RetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetryPolicy.Merge(m, src)
+}
+func (m *RetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetryPolicy proto.InternalMessageInfo
+
+func (m *RetryPolicy) GetMaxAttempts() uint32 {
 	if m != nil {
-		return m.ExpiryLimit
+		return m.MaxAttempts
 	}
 	return 0
 }
 
-func (m *Params) GetMaxScheduledTime() int64 {
+func (m *RetryPolicy) GetBackoff() string {
 	if m != nil {
-		return m.MaxScheduledTime
+		return m.Backoff
+	}
+	return ""
+}
+
+func (m *RetryPolicy) GetBackoffSeconds() int64 {
+	if m != nil {
+		return m.BackoffSeconds
 	}
 	return 0
 }
 
-func (m *Params) GetCleanUpTime() int64 {
+func (m *RetryPolicy) GetMaxBackoffSeconds() int64 {
 	if m != nil {
-		return m.CleanUpTime
+		return m.MaxBackoffSeconds
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Task)(nil), "dysonprotocol.crontask.v1.Task")
-	proto.RegisterType((*Params)(nil), "dysonprotocol.crontask.v1.Params")
+func (m *RetryPolicy) GetRetryableErrors() []string {
+	if m != nil {
+		return m.RetryableErrors
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("dysonprotocol/crontask/v1/crontask.proto", fileDescriptor_c2a40f3e0e41e1b8)
+// TaskAttempt is a failed attempt of a run of a task
+type TaskAttempt struct {
+	// 1-based number of the attempt within its run
+	Attempt uint32 `protobuf:"varint,1,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// Block timestamp of the attempt
+	ExecutionTimestamp int64 `protobuf:"varint,2,opt,name=execution_timestamp,json=executionTimestamp,proto3" json:"execution_timestamp,omitempty"`
+	// Block height of the attempt
+	ExecutionHeight int64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// Codespace and code of the error
+	Codespace string `protobuf:"bytes,4,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,5,opt,name=code,proto3" json:"code,omitempty"`
+	// Error message of the attempt
+	ErrorLog string `protobuf:"bytes,6,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
+	// Whether another attempt was scheduled
+	Retried bool `protobuf:"varint,7,opt,name=retried,proto3" json:"retried,omitempty"`
 }
 
-var fileDescriptor_c2a40f3e0e41e1b8 = []byte{
-	// 631 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0x34, 0x6d, 0x36, 0x49, 0x7f, 0x96, 0x8a, 0x6e, 0x8b, 0x64, 0x42, 0x40, 0xc8,
-	0x54, 0x60, 0x2b, 0x45, 0xdc, 0xb8, 0xb4, 0x95, 0x8a, 0x40, 0x3d, 0x54, 0x6e, 0xb9, 0x70, 0xb1,
-	0x36, 0xf6, 0x76, 0xb1, 0xea, 0xf5, 0x5a, 0xbb, 0x76, 0x95, 0x1c, 0x79, 0x03, 0x5e, 0x02, 0x89,
-	0x23, 0x07, 0x1e, 0xa2, 0xc7, 0x8a, 0x13, 0x27, 0x84, 0xda, 0x03, 0xaf, 0x81, 0xf6, 0x27, 0x6e,
-	0x0a, 0x12, 0x12, 0x17, 0x6b, 0xe7, 0xfb, 0xbe, 0x19, 0xcf, 0xce, 0x37, 0x5a, 0xe0, 0x25, 0x13,
-	0xc9, 0xf3, 0x42, 0xf0, 0x92, 0xc7, 0x3c, 0x0b, 0x62, 0xc1, 0xf3, 0x12, 0xcb, 0xb3, 0xe0, 0x7c,
-	0x58, 0x9f, 0x7d, 0xcd, 0xc2, 0xcd, 0x5b, 0x4a, 0xbf, 0x66, 0xcf, 0x87, 0x5b, 0xeb, 0x94, 0x53,
-	0xae, 0x99, 0x40, 0x9d, 0x4c, 0xc2, 0xd6, 0x66, 0xcc, 0x25, 0xe3, 0x32, 0x32, 0x84, 0x09, 0x2c,
-	0xe5, 0x9a, 0x28, 0x18, 0x61, 0x49, 0x82, 0xf3, 0xe1, 0x88, 0x94, 0x78, 0x18, 0xc4, 0x3c, 0xcd,
-	0xff, 0xe2, 0xf3, 0xb3, 0x9a, 0x57, 0x81, 0xe5, 0xd7, 0x30, 0x4b, 0x73, 0x1e, 0xe8, 0xaf, 0x85,
-	0x36, 0x6c, 0x0a, 0x93, 0x54, 0x35, 0xcf, 0x24, 0x9d, 0xb6, 0x41, 0x39, 0xa7, 0x19, 0x09, 0x74,
-	0x34, 0xaa, 0x4e, 0x03, 0x9c, 0x4f, 0x0c, 0x35, 0xf8, 0xb0, 0x00, 0x9a, 0x27, 0x58, 0x9e, 0xc1,
-	0x0d, 0xb0, 0xa8, 0xee, 0x12, 0xa5, 0x09, 0x72, 0xfa, 0x8e, 0xd7, 0x0c, 0x5b, 0x2a, 0x7c, 0x9d,
-	0xc0, 0x1d, 0xb0, 0x18, 0x0b, 0x82, 0x4b, 0x2e, 0xd0, 0x5c, 0xdf, 0xf1, 0xda, 0x7b, 0xe8, 0xdb,
-	0xd7, 0x67, 0xeb, 0xf6, 0x2e, 0xbb, 0x49, 0x22, 0x88, 0x94, 0xc7, 0xa5, 0x48, 0x73, 0x1a, 0x4e,
-	0x85, 0x30, 0x00, 0x77, 0x64, 0xfc, 0x9e, 0x24, 0x55, 0x46, 0x92, 0xa8, 0x4c, 0x19, 0x91, 0x25,
-	0x66, 0x05, 0x9a, 0xef, 0x3b, 0xde, 0x7c, 0x08, 0x6b, 0xea, 0x64, 0xca, 0xc0, 0x27, 0x60, 0x95,
-	0x8c, 0x8b, 0x54, 0x4c, 0x66, 0xd4, 0x4d, 0xad, 0x5e, 0x31, 0xf8, 0x8d, 0xf4, 0x11, 0x58, 0xd6,
-	0x8d, 0x52, 0x2c, 0xa3, 0x2c, 0x65, 0x69, 0x89, 0x16, 0x74, 0xbf, 0x5d, 0x85, 0xbe, 0xc2, 0xf2,
-	0x50, 0x61, 0xf0, 0xcd, 0x8c, 0xaa, 0x10, 0x69, 0x4c, 0x50, 0xab, 0xef, 0x78, 0x9d, 0x9d, 0x4d,
-	0xdf, 0x76, 0xae, 0xe6, 0xee, 0xdb, 0xb9, 0xfa, 0xfb, 0x3c, 0xcd, 0xf7, 0xda, 0x17, 0x3f, 0xee,
-	0x37, 0x3e, 0xff, 0xfa, 0xb2, 0xed, 0xd4, 0xb5, 0x8e, 0x54, 0x26, 0x3c, 0x00, 0xdd, 0xba, 0xd6,
-	0x29, 0x21, 0x68, 0xf1, 0x3f, 0x2a, 0x01, 0x5b, 0xe9, 0x80, 0x10, 0xe8, 0x81, 0x26, 0x93, 0x54,
-	0xa2, 0xa5, 0xfe, 0xbc, 0xd7, 0xd9, 0x59, 0xf7, 0x8d, 0x2b, 0xfe, 0xd4, 0x15, 0x7f, 0x37, 0x9f,
-	0x84, 0x5a, 0x01, 0x5f, 0x80, 0x0e, 0x93, 0x34, 0x12, 0x44, 0x56, 0x59, 0x29, 0x51, 0xfb, 0x1f,
-	0x09, 0x80, 0x49, 0x1a, 0x1a, 0x1d, 0xbc, 0x0b, 0x5a, 0xb2, 0xc4, 0x65, 0x25, 0x11, 0x50, 0x4e,
-	0x85, 0x36, 0x82, 0x0f, 0x41, 0x4f, 0x3b, 0x93, 0xf2, 0x5c, 0xcf, 0x17, 0x75, 0xf4, 0x68, 0xbb,
-	0x53, 0x50, 0x0d, 0x17, 0xde, 0x03, 0x6d, 0x22, 0x04, 0x17, 0x51, 0xc6, 0x29, 0xea, 0xea, 0xfc,
-	0x25, 0x0d, 0x1c, 0x72, 0x0a, 0xb7, 0xc1, 0x5a, 0x3d, 0x82, 0x98, 0xe7, 0xb2, 0x62, 0x24, 0x41,
-	0x3d, 0x3d, 0xf7, 0x15, 0x7b, 0xc3, 0x7d, 0x0b, 0x2b, 0xf3, 0xc9, 0x98, 0xc4, 0x55, 0xfd, 0x3b,
-	0x63, 0xe7, 0xb2, 0x31, 0xbf, 0xa6, 0x6a, 0x47, 0x07, 0x9f, 0x1c, 0xd0, 0x3a, 0xc2, 0x02, 0x33,
-	0x09, 0x1f, 0x83, 0x95, 0x51, 0xc6, 0xe3, 0x59, 0x77, 0xcd, 0x36, 0xf6, 0x34, 0x5c, 0xdb, 0xfb,
-	0x00, 0x74, 0xed, 0xbe, 0x18, 0xd1, 0x9c, 0x2e, 0xde, 0x31, 0x98, 0x91, 0x3c, 0x05, 0x90, 0xe1,
-	0x71, 0x74, 0x7b, 0x0f, 0xed, 0x0a, 0xae, 0x32, 0x3c, 0x3e, 0x9e, 0xdd, 0x42, 0x38, 0x00, 0xbd,
-	0x38, 0x23, 0x38, 0x8f, 0xaa, 0xc2, 0x08, 0xcd, 0xf6, 0x75, 0x34, 0xf8, 0xb6, 0x50, 0x9a, 0xbd,
-	0x97, 0x17, 0x57, 0xae, 0x73, 0x79, 0xe5, 0x3a, 0x3f, 0xaf, 0x5c, 0xe7, 0xe3, 0xb5, 0xdb, 0xb8,
-	0xbc, 0x76, 0x1b, 0xdf, 0xaf, 0xdd, 0xc6, 0xbb, 0xc1, 0x1f, 0x0f, 0x03, 0x67, 0xc1, 0xf8, 0xe6,
-	0x21, 0x29, 0x27, 0x05, 0x91, 0xa3, 0x96, 0xa6, 0x9f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x4f,
-	0x4a, 0xd0, 0x92, 0x6f, 0x04, 0x00, 0x00,
+func (m *TaskAttempt) Reset()         { *m = TaskAttempt{} }
+func (m *TaskAttempt) String() string { return proto.CompactTextString(m) }
+func (*TaskAttempt) ProtoMessage()    {}
+func (*TaskAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{3}
+}
+func (m *TaskAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskAttempt.Merge(m, src)
+}
+func (m *TaskAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskAttempt.DiscardUnknown(m)
 }
 
-func (m *Task) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TaskAttempt proto.InternalMessageInfo
+
+func (m *TaskAttempt) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Task) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TaskAttempt) GetExecutionTimestamp() int64 {
+	if m != nil {
+		return m.ExecutionTimestamp
+	}
+	return 0
 }
 
-func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExecutionTimestamp != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.ExecutionTimestamp))
-		i--
-		dAtA[i] = 0x70
+func (m *TaskAttempt) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
 	}
-	if m.TaskGasConsumed != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.TaskGasConsumed))
-		i--
-		dAtA[i] = 0x68
+	return 0
+}
+
+func (m *TaskAttempt) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
 	}
-	if len(m.ErrorLog) > 0 {
-		i -= len(m.ErrorLog)
-		copy(dAtA[i:], m.ErrorLog)
-		i = encodeVarintCrontask(dAtA, i, uint64(len(m.ErrorLog)))
-		i--
-		dAtA[i] = 0x62
+	return ""
+}
+
+func (m *TaskAttempt) GetCode() uint32 {
+	if m != nil {
+		return m.Code
 	}
-	if m.CreationTime != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.CreationTime))
-		i--
-		dAtA[i] = 0x58
+	return 0
+}
+
+func (m *TaskAttempt) GetErrorLog() string {
+	if m != nil {
+		return m.ErrorLog
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+func (m *TaskAttempt) GetRetried() bool {
+	if m != nil {
+		return m.Retried
 	}
-	if len(m.MsgResults) > 0 {
-		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCrontask(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
+	return false
+}
+
+// TaskDependency is an edge from a parent task to a dependent task
+type TaskDependency struct {
+	// ID of the parent task
+	TaskId uint64 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Outcome of the parent required to run the dependent task: SUCCESS when
+	// the parent is DONE, FAILURE when it FAILED or EXPIRED
+	Outcome string `protobuf:"bytes,2,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (m *TaskDependency) Reset()         { *m = TaskDependency{} }
+func (m *TaskDependency) String() string { return proto.CompactTextString(m) }
+func (*TaskDependency) ProtoMessage()    {}
+func (*TaskDependency) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{4}
+}
+func (m *TaskDependency) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskDependency) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskDependency.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Msgs) > 0 {
-		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCrontask(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+}
+func (m *TaskDependency) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskDependency.Merge(m, src)
+}
+func (m *TaskDependency) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskDependency) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskDependency.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskDependency proto.InternalMessageInfo
+
+func (m *TaskDependency) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
 	}
-	{
-		size, err := m.TaskGasFee.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+func (m *TaskDependency) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+// TaskCondition makes a task run once a condition on the chain state holds.
+// Exactly one of the conditions must be set. Every evaluation is charged to
+// the task escrow at the task gas price.
+type TaskCondition struct {
+	// Runs when the balance of an account drops below an amount
+	BalanceBelow *BalanceBelowCondition `protobuf:"bytes,1,opt,name=balance_below,json=balanceBelow,proto3" json:"balance_below,omitempty"`
+	// Runs when a storage entry is set, changed or deleted
+	StorageChanged *StorageChangedCondition `protobuf:"bytes,2,opt,name=storage_changed,json=storageChanged,proto3" json:"storage_changed,omitempty"`
+	// Runs when the valuation of a name expires
+	NameExpired *NameExpiredCondition `protobuf:"bytes,3,opt,name=name_expired,json=nameExpired,proto3" json:"name_expired,omitempty"`
+	// Runs when a script function returns true
+	ScriptView *ScriptViewCondition `protobuf:"bytes,4,opt,name=script_view,json=scriptView,proto3" json:"script_view,omitempty"`
+	// Gas limit of a single evaluation, defaults to 100000
+	GasLimit uint64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Amount added to the task escrow at creation to pay for the evaluations
+	Deposit types.Coin `protobuf:"bytes,6,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *TaskCondition) Reset()         { *m = TaskCondition{} }
+func (m *TaskCondition) String() string { return proto.CompactTextString(m) }
+func (*TaskCondition) ProtoMessage()    {}
+func (*TaskCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{5}
+}
+func (m *TaskCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintCrontask(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.TaskGasPrice.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *TaskCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCondition.Merge(m, src)
+}
+func (m *TaskCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCondition proto.InternalMessageInfo
+
+func (m *TaskCondition) GetBalanceBelow() *BalanceBelowCondition {
+	if m != nil {
+		return m.BalanceBelow
+	}
+	return nil
+}
+
+func (m *TaskCondition) GetStorageChanged() *StorageChangedCondition {
+	if m != nil {
+		return m.StorageChanged
+	}
+	return nil
+}
+
+func (m *TaskCondition) GetNameExpired() *NameExpiredCondition {
+	if m != nil {
+		return m.NameExpired
+	}
+	return nil
+}
+
+func (m *TaskCondition) GetScriptView() *ScriptViewCondition {
+	if m != nil {
+		return m.ScriptView
+	}
+	return nil
+}
+
+func (m *TaskCondition) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *TaskCondition) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+// BalanceBelowCondition holds when the balance of address in the denom of
+// amount is lower than amount
+type BalanceBelowCondition struct {
+	Address string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *BalanceBelowCondition) Reset()         { *m = BalanceBelowCondition{} }
+func (m *BalanceBelowCondition) String() string { return proto.CompactTextString(m) }
+func (*BalanceBelowCondition) ProtoMessage()    {}
+func (*BalanceBelowCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{6}
+}
+func (m *BalanceBelowCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BalanceBelowCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BalanceBelowCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintCrontask(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.TaskGasLimit != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.TaskGasLimit))
-		i--
-		dAtA[i] = 0x28
+		return b[:n], nil
 	}
-	if m.ExpiryTimestamp != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.ExpiryTimestamp))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *BalanceBelowCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BalanceBelowCondition.Merge(m, src)
+}
+func (m *BalanceBelowCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *BalanceBelowCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_BalanceBelowCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BalanceBelowCondition proto.InternalMessageInfo
+
+func (m *BalanceBelowCondition) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.ScheduledTimestamp != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.ScheduledTimestamp))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *BalanceBelowCondition) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x12
+	return types.Coin{}
+}
+
+// StorageChangedCondition holds when the storage entry (owner, index) differs
+// from the entry seen when the task was scheduled
+type StorageChangedCondition struct {
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// Last update height of the entry when the task was scheduled, 0 if missing
+	UpdatedHeight uint64 `protobuf:"varint,3,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// SHA-256 of the entry data when the task was scheduled, empty if missing
+	DataHash []byte `protobuf:"bytes,4,opt,name=data_hash,json=dataHash,proto3" json:"data_hash,omitempty"`
+}
+
+func (m *StorageChangedCondition) Reset()         { *m = StorageChangedCondition{} }
+func (m *StorageChangedCondition) String() string { return proto.CompactTextString(m) }
+func (*StorageChangedCondition) ProtoMessage()    {}
+func (*StorageChangedCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{7}
+}
+func (m *StorageChangedCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageChangedCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageChangedCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.TaskId != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *StorageChangedCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageChangedCondition.Merge(m, src)
+}
+func (m *StorageChangedCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageChangedCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageChangedCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageChangedCondition proto.InternalMessageInfo
+
+func (m *StorageChangedCondition) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StorageChangedCondition) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StorageChangedCondition) GetUpdatedHeight() uint64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CleanUpTime != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.CleanUpTime))
-		i--
-		dAtA[i] = 0x20
+func (m *StorageChangedCondition) GetDataHash() []byte {
+	if m != nil {
+		return m.DataHash
 	}
-	if m.MaxScheduledTime != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.MaxScheduledTime))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+// NameExpiredCondition holds when the valuation of a nameservice name has
+// expired
+type NameExpiredCondition struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *NameExpiredCondition) Reset()         { *m = NameExpiredCondition{} }
+func (m *NameExpiredCondition) String() string { return proto.CompactTextString(m) }
+func (*NameExpiredCondition) ProtoMessage()    {}
+func (*NameExpiredCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{8}
+}
+func (m *NameExpiredCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameExpiredCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameExpiredCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExpiryLimit != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.ExpiryLimit))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *NameExpiredCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameExpiredCondition.Merge(m, src)
+}
+func (m *NameExpiredCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameExpiredCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameExpiredCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameExpiredCondition proto.InternalMessageInfo
+
+func (m *NameExpiredCondition) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.BlockGasLimit != 0 {
-		i = encodeVarintCrontask(dAtA, i, uint64(m.BlockGasLimit))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+// ScriptViewCondition holds when a script function returns true. The function
+// is executed by the task creator and its state changes are discarded.
+type ScriptViewCondition struct {
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	FunctionName  string `protobuf:"bytes,2,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// JSON encoded positional arguments
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	// JSON encoded keyword arguments
+	Kwargs string `protobuf:"bytes,4,opt,name=kwargs,proto3" json:"kwargs,omitempty"`
+}
+
+func (m *ScriptViewCondition) Reset()         { *m = ScriptViewCondition{} }
+func (m *ScriptViewCondition) String() string { return proto.CompactTextString(m) }
+func (*ScriptViewCondition) ProtoMessage()    {}
+func (*ScriptViewCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{9}
+}
+func (m *ScriptViewCondition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScriptViewCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScriptViewCondition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScriptViewCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScriptViewCondition.Merge(m, src)
+}
+func (m *ScriptViewCondition) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScriptViewCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScriptViewCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScriptViewCondition proto.InternalMessageInfo
+
+func (m *ScriptViewCondition) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *ScriptViewCondition) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+func (m *ScriptViewCondition) GetArgs() string {
+	if m != nil {
+		return m.Args
+	}
+	return ""
+}
+
+func (m *ScriptViewCondition) GetKwargs() string {
+	if m != nil {
+		return m.Kwargs
+	}
+	return ""
+}
+
+// TaskRun records the outcome of a single run of a task
+type TaskRun struct {
+	// 1-based number of the run
+	Occurrence uint64 `protobuf:"varint,1,opt,name=occurrence,proto3" json:"occurrence,omitempty"`
+	// Unix timestamp the run was scheduled at
+	ScheduledTimestamp int64 `protobuf:"varint,2,opt,name=scheduled_timestamp,json=scheduledTimestamp,proto3" json:"scheduled_timestamp,omitempty"`
+	// Block timestamp when the run was executed or expired
+	ExecutionTimestamp int64 `protobuf:"varint,3,opt,name=execution_timestamp,json=executionTimestamp,proto3" json:"execution_timestamp,omitempty"`
+	// Status of the run: Done, Failed or Expired
+	Status string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	// Gas consumed by the run
+	GasConsumed uint64 `protobuf:"varint,5,opt,name=gas_consumed,json=gasConsumed,proto3" json:"gas_consumed,omitempty"`
+	// Fee charged for the run, the gas consumed at the task gas price
+	Fee types.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	// Error message if the run failed
+	ErrorLog string `protobuf:"bytes,7,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
+	// Block height when the run was executed or expired
+	ExecutionHeight int64 `protobuf:"varint,8,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	// Gas consumed by the callback of the run
+	CallbackGasConsumed uint64 `protobuf:"varint,9,opt,name=callback_gas_consumed,json=callbackGasConsumed,proto3" json:"callback_gas_consumed,omitempty"`
+	// Error of the callback of the run, empty if it succeeded or none is set
+	CallbackError string `protobuf:"bytes,10,opt,name=callback_error,json=callbackError,proto3" json:"callback_error,omitempty"`
+}
+
+func (m *TaskRun) Reset()         { *m = TaskRun{} }
+func (m *TaskRun) String() string { return proto.CompactTextString(m) }
+func (*TaskRun) ProtoMessage()    {}
+func (*TaskRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{10}
+}
+func (m *TaskRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskRun.Merge(m, src)
+}
+func (m *TaskRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskRun proto.InternalMessageInfo
+
+func (m *TaskRun) GetOccurrence() uint64 {
+	if m != nil {
+		return m.Occurrence
+	}
+	return 0
+}
+
+func (m *TaskRun) GetScheduledTimestamp() int64 {
+	if m != nil {
+		return m.ScheduledTimestamp
+	}
+	return 0
+}
+
+func (m *TaskRun) GetExecutionTimestamp() int64 {
+	if m != nil {
+		return m.ExecutionTimestamp
+	}
+	return 0
+}
+
+func (m *TaskRun) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TaskRun) GetGasConsumed() uint64 {
+	if m != nil {
+		return m.GasConsumed
+	}
+	return 0
+}
+
+func (m *TaskRun) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *TaskRun) GetErrorLog() string {
+	if m != nil {
+		return m.ErrorLog
+	}
+	return ""
+}
+
+func (m *TaskRun) GetExecutionHeight() int64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+func (m *TaskRun) GetCallbackGasConsumed() uint64 {
+	if m != nil {
+		return m.CallbackGasConsumed
+	}
+	return 0
+}
+
+func (m *TaskRun) GetCallbackError() string {
+	if m != nil {
+		return m.CallbackError
+	}
+	return ""
+}
+
+// Params defines the parameters for the crontask module
+type Params struct {
+	// Maximum gas allowed for executing tasks per block
+	BlockGasLimit uint64 `protobuf:"varint,1,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
+	// Default expiry limit in seconds (24 hours)
+	ExpiryLimit int64 `protobuf:"varint,2,opt,name=expiry_limit,json=expiryLimit,proto3" json:"expiry_limit,omitempty"`
+	// Maximum allowed scheduled time in seconds from task creation (24 hours)
+	MaxScheduledTime int64 `protobuf:"varint,3,opt,name=max_scheduled_time,json=maxScheduledTime,proto3" json:"max_scheduled_time,omitempty"`
+	// Retention period for completed/failed/expired tasks (seconds)
+	CleanUpTime int64 `protobuf:"varint,4,opt,name=clean_up_time,json=cleanUpTime,proto3" json:"clean_up_time,omitempty"`
+	// Maximum gas the tasks of a single creator may use per block, 0 for no
+	// quota
+	CreatorBlockGasLimit uint64 `protobuf:"varint,5,opt,name=creator_block_gas_limit,json=creatorBlockGasLimit,proto3" json:"creator_block_gas_limit,omitempty"`
+	// Seconds a pending task has to wait for its priority to grow by its gas
+	// price, 0 to order pending tasks by gas price only
+	AgingInterval int64 `protobuf:"varint,6,opt,name=aging_interval,json=agingInterval,proto3" json:"aging_interval,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a40f3e0e41e1b8, []int{11}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetExpiryLimit() int64 {
+	if m != nil {
+		return m.ExpiryLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxScheduledTime() int64 {
+	if m != nil {
+		return m.MaxScheduledTime
+	}
+	return 0
+}
+
+func (m *Params) GetCleanUpTime() int64 {
+	if m != nil {
+		return m.CleanUpTime
+	}
+	return 0
+}
+
+func (m *Params) GetCreatorBlockGasLimit() uint64 {
+	if m != nil {
+		return m.CreatorBlockGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetAgingInterval() int64 {
+	if m != nil {
+		return m.AgingInterval
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Task)(nil), "dysonprotocol.crontask.v1.Task")
+	proto.RegisterType((*TaskCallback)(nil), "dysonprotocol.crontask.v1.TaskCallback")
+	proto.RegisterType((*RetryPolicy)(nil), "dysonprotocol.crontask.v1.RetryPolicy")
+	proto.RegisterType((*TaskAttempt)(nil), "dysonprotocol.crontask.v1.TaskAttempt")
+	proto.RegisterType((*TaskDependency)(nil), "dysonprotocol.crontask.v1.TaskDependency")
+	proto.RegisterType((*TaskCondition)(nil), "dysonprotocol.crontask.v1.TaskCondition")
+	proto.RegisterType((*BalanceBelowCondition)(nil), "dysonprotocol.crontask.v1.BalanceBelowCondition")
+	proto.RegisterType((*StorageChangedCondition)(nil), "dysonprotocol.crontask.v1.StorageChangedCondition")
+	proto.RegisterType((*NameExpiredCondition)(nil), "dysonprotocol.crontask.v1.NameExpiredCondition")
+	proto.RegisterType((*ScriptViewCondition)(nil), "dysonprotocol.crontask.v1.ScriptViewCondition")
+	proto.RegisterType((*TaskRun)(nil), "dysonprotocol.crontask.v1.TaskRun")
+	proto.RegisterType((*Params)(nil), "dysonprotocol.crontask.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("dysonprotocol/crontask/v1/crontask.proto", fileDescriptor_c2a40f3e0e41e1b8)
+}
+
+var fileDescriptor_c2a40f3e0e41e1b8 = []byte{
+	// 1676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x6f, 0x1c, 0x4b,
+	0x15, 0x4e, 0x67, 0x6c, 0x8f, 0xe7, 0xf4, 0xcc, 0x38, 0x29, 0x3b, 0x71, 0xe5, 0xc1, 0x64, 0xd2,
+	0x90, 0xdc, 0x49, 0x04, 0x33, 0x64, 0xe0, 0xb2, 0xba, 0x42, 0x8a, 0x4d, 0x42, 0x8c, 0xae, 0xb8,
+	0x51, 0xe7, 0x5e, 0x84, 0x60, 0xd1, 0xaa, 0xe9, 0x2e, 0xf7, 0xb4, 0xdc, 0x5d, 0xd5, 0xea, 0xea,
+	0xb1, 0x67, 0xd6, 0xac, 0x58, 0x20, 0xf8, 0x0b, 0x88, 0x0d, 0x4b, 0x16, 0xfc, 0x05, 0xa4, 0xbb,
+	0x42, 0x11, 0x2b, 0x56, 0x08, 0x25, 0x0b, 0x36, 0xfc, 0x08, 0x54, 0x8f, 0x7e, 0x19, 0x8f, 0x8d,
+	0x25, 0x36, 0x56, 0xd7, 0x77, 0x1e, 0x53, 0x75, 0x1e, 0xdf, 0xa9, 0x32, 0x8c, 0x82, 0x95, 0xe0,
+	0x2c, 0xcd, 0x78, 0xce, 0x7d, 0x1e, 0x4f, 0xfc, 0x8c, 0xb3, 0x9c, 0x88, 0x93, 0xc9, 0xe9, 0x8b,
+	0xf2, 0x7b, 0xac, 0xa4, 0xe8, 0x5e, 0x43, 0x73, 0x5c, 0x4a, 0x4f, 0x5f, 0xdc, 0xdf, 0x0b, 0x79,
+	0xc8, 0x95, 0x64, 0x22, 0xbf, 0xb4, 0xc1, 0xfd, 0x7b, 0x3e, 0x17, 0x09, 0x17, 0x9e, 0x16, 0xe8,
+	0x85, 0x11, 0x0d, 0xf4, 0x6a, 0x32, 0x23, 0x82, 0x4e, 0x4e, 0x5f, 0xcc, 0x68, 0x4e, 0x5e, 0x4c,
+	0x7c, 0x1e, 0xb1, 0xff, 0x92, 0xb3, 0x93, 0x52, 0x2e, 0x17, 0x46, 0x7e, 0x9b, 0x24, 0x11, 0xe3,
+	0x13, 0xf5, 0xd7, 0x40, 0xfb, 0xc6, 0x24, 0x11, 0xa1, 0xdc, 0x7c, 0x22, 0xc2, 0x62, 0x1b, 0x21,
+	0xe7, 0x61, 0x4c, 0x27, 0x6a, 0x35, 0x5b, 0x1c, 0x4f, 0x08, 0x5b, 0x69, 0x91, 0xf3, 0xdb, 0x1e,
+	0x6c, 0x7c, 0x49, 0xc4, 0x09, 0xda, 0x87, 0xb6, 0x3c, 0x8b, 0x17, 0x05, 0xd8, 0x1a, 0x5a, 0xa3,
+	0x0d, 0x77, 0x4b, 0x2e, 0x8f, 0x02, 0x34, 0x85, 0xb6, 0x9f, 0x51, 0x92, 0xf3, 0x0c, 0xdf, 0x1c,
+	0x5a, 0xa3, 0xce, 0x01, 0xfe, 0xdb, 0x9f, 0xbf, 0xb3, 0x67, 0xce, 0xf2, 0x32, 0x08, 0x32, 0x2a,
+	0xc4, 0xbb, 0x3c, 0x8b, 0x58, 0xe8, 0x16, 0x8a, 0x68, 0x02, 0xbb, 0xc2, 0x9f, 0xd3, 0x60, 0x11,
+	0xd3, 0xc0, 0xcb, 0xa3, 0x84, 0x8a, 0x9c, 0x24, 0x29, 0x6e, 0x0d, 0xad, 0x51, 0xcb, 0x45, 0xa5,
+	0xe8, 0xcb, 0x42, 0x82, 0x9e, 0xc1, 0x2d, 0xba, 0x4c, 0xa3, 0x6c, 0x55, 0xd3, 0xde, 0x50, 0xda,
+	0x3b, 0x1a, 0xaf, 0x54, 0xbf, 0x05, 0x7d, 0xb5, 0xd1, 0x90, 0x08, 0x2f, 0x8e, 0x92, 0x28, 0xc7,
+	0x9b, 0x6a, 0xbf, 0x5d, 0x89, 0xfe, 0x98, 0x88, 0xcf, 0x25, 0x86, 0x7e, 0x52, 0xd3, 0x4a, 0xb3,
+	0xc8, 0xa7, 0x78, 0x6b, 0x68, 0x8d, 0xec, 0xe9, 0xbd, 0xb1, 0xd9, 0xb9, 0x8c, 0xfb, 0xd8, 0xc4,
+	0x75, 0x7c, 0xc8, 0x23, 0x76, 0xd0, 0xf9, 0xfa, 0x1f, 0x8f, 0x6e, 0xfc, 0xf1, 0x5f, 0x7f, 0x7a,
+	0x6e, 0x95, 0xbe, 0xde, 0x4a, 0x4b, 0xf4, 0x1a, 0xba, 0xa5, 0xaf, 0x63, 0x4a, 0x71, 0xfb, 0x1a,
+	0x9e, 0xc0, 0x78, 0x7a, 0x4d, 0x29, 0x1a, 0xc1, 0x46, 0x22, 0x42, 0x81, 0xb7, 0x87, 0xad, 0x91,
+	0x3d, 0xdd, 0x1b, 0xeb, 0xac, 0x8c, 0x8b, 0xac, 0x8c, 0x5f, 0xb2, 0x95, 0xab, 0x34, 0xd0, 0xa7,
+	0x60, 0x27, 0x22, 0xf4, 0x32, 0x2a, 0x16, 0x71, 0x2e, 0x70, 0xe7, 0x12, 0x03, 0x48, 0x44, 0xe8,
+	0x6a, 0x3d, 0x74, 0x17, 0xb6, 0x44, 0x4e, 0xf2, 0x85, 0xc0, 0x20, 0x33, 0xe5, 0x9a, 0x15, 0xfa,
+	0x26, 0xf4, 0x54, 0x66, 0x22, 0xce, 0x54, 0x7c, 0xb1, 0xad, 0x42, 0xdb, 0x2d, 0x40, 0x19, 0x5c,
+	0xf4, 0x00, 0x3a, 0x34, 0xcb, 0x78, 0xe6, 0xc5, 0x3c, 0xc4, 0x5d, 0x65, 0xbf, 0xad, 0x80, 0xcf,
+	0x79, 0x88, 0x9e, 0xc3, 0xed, 0x32, 0x04, 0x3e, 0x67, 0x62, 0x91, 0xd0, 0x00, 0xf7, 0x54, 0xdc,
+	0x77, 0xcc, 0x09, 0x0f, 0x0d, 0x2c, 0x93, 0x4f, 0x97, 0xd4, 0x5f, 0x94, 0x3f, 0xa7, 0xd3, 0xd9,
+	0xd7, 0xc9, 0x2f, 0x45, 0x55, 0x46, 0x3f, 0x81, 0x1d, 0xd9, 0x4a, 0x1e, 0x5d, 0xa6, 0xb2, 0x98,
+	0x22, 0xce, 0xf0, 0x8e, 0xfa, 0xfd, 0xbe, 0x84, 0x5f, 0x95, 0xa8, 0xac, 0x92, 0x88, 0xe5, 0x34,
+	0x3b, 0x25, 0xb1, 0x27, 0xa8, 0xcf, 0x59, 0x20, 0xf0, 0x2d, 0x5d, 0x25, 0x05, 0xfe, 0x4e, 0xc3,
+	0xd2, 0x67, 0x42, 0x96, 0x1e, 0xf7, 0xfd, 0x45, 0x96, 0x51, 0xe6, 0x53, 0x81, 0x6f, 0xab, 0xed,
+	0xf6, 0x13, 0xb2, 0xfc, 0xa2, 0x42, 0x65, 0x6c, 0x28, 0xab, 0x17, 0x29, 0xd2, 0xb1, 0xa1, 0xac,
+	0x56, 0x9e, 0x43, 0xb0, 0xeb, 0x9e, 0x76, 0x95, 0xa7, 0x3a, 0x84, 0x8e, 0xc0, 0xce, 0x16, 0xcc,
+	0x9b, 0x47, 0x22, 0xe7, 0xd9, 0x0a, 0xef, 0xa9, 0x8c, 0x39, 0xe3, 0xb5, 0x84, 0x31, 0x96, 0x4d,
+	0xe7, 0x2e, 0xd8, 0xc1, 0x86, 0xac, 0x15, 0x17, 0xb2, 0x05, 0x7b, 0xa3, 0x6d, 0xd1, 0x67, 0xb0,
+	0x45, 0x85, 0x9f, 0xf1, 0x33, 0x7c, 0xe7, 0x1a, 0x85, 0x66, 0x6c, 0xd0, 0x6b, 0xe8, 0xc8, 0x08,
+	0x44, 0x32, 0xc4, 0xf8, 0xae, 0x72, 0x30, 0xba, 0x62, 0x1b, 0x87, 0x85, 0xbe, 0x5b, 0x99, 0xca,
+	0x58, 0x97, 0x0b, 0xcf, 0x9f, 0x53, 0xff, 0x44, 0xe0, 0x7d, 0x9d, 0xf0, 0x12, 0x3f, 0x54, 0x30,
+	0xfa, 0x39, 0x74, 0x03, 0x9a, 0x52, 0x16, 0x50, 0xe6, 0x47, 0x54, 0x60, 0xac, 0x0e, 0xff, 0xec,
+	0x8a, 0x5f, 0xfd, 0x51, 0x61, 0xb2, 0x6a, 0x74, 0x5e, 0xdd, 0x13, 0x7a, 0x04, 0xf6, 0x19, 0xcf,
+	0x4e, 0x8e, 0x63, 0x7e, 0x26, 0x89, 0xe9, 0x9e, 0xaa, 0x0a, 0x28, 0xa0, 0xa3, 0x00, 0x3d, 0x84,
+	0x4e, 0x40, 0x8f, 0x69, 0x96, 0x91, 0x58, 0xe0, 0xfb, 0x6a, 0x7b, 0x15, 0x20, 0x8b, 0xa0, 0xaa,
+	0xc4, 0x74, 0x4e, 0x04, 0xc5, 0x0f, 0x74, 0x61, 0x95, 0xf0, 0x5b, 0x89, 0xca, 0xc3, 0x56, 0x7c,
+	0x35, 0xa7, 0x51, 0x38, 0xcf, 0xf1, 0x43, 0x5d, 0x58, 0x25, 0xfe, 0x46, 0xc1, 0x9a, 0xa9, 0x0a,
+	0x9f, 0x46, 0xf5, 0x1b, 0x05, 0x53, 0x19, 0xdc, 0xa8, 0x1e, 0x41, 0x37, 0xa3, 0x79, 0xb6, 0xf2,
+	0x52, 0x1e, 0x47, 0xfe, 0x0a, 0x0f, 0x54, 0x36, 0x9e, 0x5e, 0x12, 0x17, 0x57, 0xaa, 0xbf, 0x55,
+	0xda, 0xae, 0x9d, 0x55, 0x0b, 0x74, 0x1f, 0xb6, 0x49, 0x9e, 0xd3, 0x24, 0xcd, 0x05, 0x7e, 0x34,
+	0xb4, 0x46, 0x3d, 0xb7, 0x5c, 0x23, 0x17, 0x6c, 0xf3, 0xad, 0x5a, 0x77, 0xa8, 0xa2, 0xff, 0xf4,
+	0x8a, 0xe8, 0xbf, 0xd4, 0x16, 0x0d, 0xaa, 0x32, 0x5e, 0x64, 0xbf, 0x7f, 0x1f, 0xee, 0x32, 0xba,
+	0xcc, 0xbd, 0xc2, 0x71, 0xd5, 0x1e, 0x8f, 0xd5, 0x59, 0xf7, 0xa4, 0xd4, 0xf8, 0xa8, 0xda, 0xe4,
+	0x10, 0xb6, 0x7d, 0x12, 0xc7, 0x33, 0xe2, 0x9f, 0x60, 0x47, 0x1d, 0xf6, 0x93, 0xab, 0x4a, 0xcf,
+	0xa8, 0xbb, 0xa5, 0xa1, 0x73, 0x06, 0xdd, 0xba, 0x04, 0x3d, 0x81, 0xbe, 0xf0, 0xb3, 0x28, 0xcd,
+	0x3d, 0xa2, 0x87, 0x8d, 0x9a, 0x4f, 0x1d, 0xb7, 0xa7, 0x51, 0x33, 0x81, 0x64, 0x1f, 0x1f, 0x2f,
+	0x98, 0xaf, 0xd2, 0xc2, 0x48, 0x42, 0xf5, 0xb0, 0x72, 0xbb, 0x05, 0xf8, 0x53, 0xa2, 0x39, 0xae,
+	0x1a, 0x1b, 0x2d, 0x55, 0x2e, 0xdb, 0xa1, 0x19, 0x19, 0xce, 0x5f, 0x2d, 0xb0, 0x6b, 0x09, 0x40,
+	0x8f, 0xa1, 0x2b, 0x29, 0xa4, 0x8c, 0xbb, 0xa5, 0xe2, 0x6e, 0x27, 0x64, 0xf9, 0xb2, 0x08, 0x3d,
+	0x86, 0xb6, 0xdc, 0x23, 0x3f, 0x3e, 0x36, 0x3f, 0x57, 0x2c, 0x65, 0xe9, 0x99, 0xcf, 0x92, 0xa9,
+	0xf4, 0xf4, 0xeb, 0x1b, 0xb8, 0x20, 0xaa, 0x31, 0xec, 0xca, 0x5f, 0x39, 0xaf, 0xac, 0x87, 0xdf,
+	0xed, 0x84, 0x2c, 0x0f, 0x9a, 0xfa, 0xcf, 0xe0, 0x96, 0x2a, 0x0c, 0x32, 0x8b, 0xa9, 0xa7, 0xf8,
+	0x59, 0xe0, 0xcd, 0x61, 0x6b, 0xd4, 0x71, 0x77, 0x4a, 0xfc, 0x95, 0x82, 0x9d, 0x7f, 0x5b, 0x60,
+	0xd7, 0x72, 0x2d, 0x77, 0x6b, 0x0e, 0x63, 0xce, 0x52, 0x2c, 0xd7, 0x51, 0xf6, 0xcd, 0xb5, 0x94,
+	0x7d, 0x51, 0x17, 0xb4, 0x2e, 0xee, 0x82, 0x87, 0x92, 0x90, 0x02, 0x2a, 0x52, 0xe2, 0x53, 0x75,
+	0xac, 0x8e, 0x5b, 0x01, 0x08, 0xc1, 0x86, 0x5c, 0xa8, 0x19, 0xde, 0x73, 0xd5, 0x77, 0x73, 0x12,
+	0x6d, 0x9d, 0x9b, 0x44, 0x18, 0xda, 0xf2, 0x9c, 0x11, 0x0d, 0xd4, 0x1c, 0xde, 0x76, 0x8b, 0xa5,
+	0x73, 0x08, 0xfd, 0x26, 0xaf, 0xac, 0xbf, 0xd3, 0x60, 0x68, 0xf3, 0x45, 0xee, 0xf3, 0xb2, 0x4c,
+	0x8a, 0xa5, 0xf3, 0x97, 0x16, 0xf4, 0x1a, 0x9c, 0x88, 0xbe, 0x82, 0xde, 0x8c, 0xc4, 0x84, 0xf9,
+	0xd4, 0x9b, 0xd1, 0x98, 0x9f, 0x29, 0x57, 0xf6, 0xf4, 0xbb, 0x97, 0x54, 0xf6, 0x81, 0xd6, 0x3f,
+	0x90, 0xea, 0x15, 0xb9, 0x76, 0x67, 0x35, 0x18, 0xfd, 0x12, 0x76, 0x24, 0xdd, 0x93, 0x90, 0x7a,
+	0xfe, 0x9c, 0xb0, 0x90, 0x06, 0x6a, 0x2b, 0xf6, 0x74, 0x7a, 0x89, 0xe3, 0x77, 0xda, 0xe2, 0x50,
+	0x1b, 0x54, 0xae, 0xfb, 0xa2, 0x21, 0x40, 0x2e, 0x74, 0x65, 0x0f, 0x78, 0xea, 0xee, 0x44, 0x03,
+	0x95, 0x1a, 0x7b, 0x3a, 0xb9, 0xc4, 0xb3, 0x6c, 0x8f, 0x57, 0x5a, 0xbb, 0x72, 0x6b, 0xb3, 0x0a,
+	0x45, 0x5f, 0x80, 0x6d, 0xfa, 0xf0, 0x34, 0xa2, 0x67, 0x2a, 0x93, 0xf6, 0x74, 0x7c, 0xd9, 0x66,
+	0x95, 0xf6, 0xcf, 0x22, 0x5a, 0x8b, 0x01, 0x88, 0x12, 0x6c, 0x36, 0xe3, 0x66, 0xb3, 0x19, 0xd1,
+	0x0f, 0xa1, 0x1d, 0xd0, 0x94, 0x8b, 0x28, 0xbf, 0xd6, 0xc5, 0xad, 0x30, 0x72, 0x7e, 0x6d, 0xc1,
+	0x9d, 0x0b, 0xd3, 0x20, 0xef, 0xb3, 0x0d, 0x22, 0xb9, 0xec, 0x3e, 0x6b, 0x14, 0xe5, 0x48, 0x26,
+	0x09, 0x5f, 0xb0, 0xdc, 0xe4, 0xe8, 0x7f, 0x1c, 0xc9, 0xda, 0xc6, 0xf9, 0x83, 0x05, 0xfb, 0x6b,
+	0x32, 0x87, 0xc6, 0xb0, 0xc9, 0xcf, 0x18, 0xcd, 0xae, 0xdc, 0x8b, 0x56, 0x43, 0x7b, 0xb0, 0x19,
+	0xb1, 0x80, 0x2e, 0x4d, 0xdd, 0xea, 0x85, 0xe4, 0xc8, 0x45, 0x1a, 0x90, 0xbc, 0x9a, 0x5e, 0x9a,
+	0xdc, 0x7a, 0x06, 0x35, 0xad, 0xf8, 0x00, 0x3a, 0x01, 0xc9, 0x89, 0x37, 0x27, 0x62, 0xae, 0x12,
+	0xd8, 0x75, 0xb7, 0x25, 0xf0, 0x86, 0x88, 0xb9, 0xf3, 0x1c, 0xf6, 0x2e, 0x2a, 0x02, 0xd9, 0xa1,
+	0x8a, 0x4f, 0x35, 0xeb, 0xaa, 0x6f, 0xe7, 0x37, 0x16, 0xec, 0x5e, 0x90, 0xde, 0xff, 0x2b, 0x57,
+	0x23, 0xd8, 0x20, 0x59, 0xa8, 0x69, 0xb3, 0xe3, 0xaa, 0x6f, 0x79, 0xc1, 0x3d, 0x39, 0x53, 0xa8,
+	0x26, 0x12, 0xb3, 0x72, 0x7e, 0xdf, 0x82, 0xb6, 0xb9, 0x50, 0xa1, 0x01, 0x40, 0x75, 0x31, 0x33,
+	0x7d, 0x5f, 0x43, 0xd6, 0xbd, 0x4d, 0x6e, 0xae, 0x7d, 0x9b, 0xac, 0x21, 0xc7, 0xd6, 0x5a, 0x72,
+	0xac, 0xae, 0xe1, 0x1b, 0x8d, 0x6b, 0xf8, 0x63, 0xe8, 0x36, 0xee, 0xcf, 0xba, 0xe6, 0xed, 0xb0,
+	0x76, 0x77, 0xfe, 0x01, 0xb4, 0xe4, 0x0b, 0xe3, 0x3a, 0x25, 0x2f, 0x0d, 0x9a, 0x94, 0xd9, 0x3e,
+	0x47, 0x99, 0x17, 0x91, 0xf5, 0xf6, 0xc5, 0x64, 0x3d, 0x85, 0x3b, 0xc5, 0x20, 0x6e, 0xde, 0xf5,
+	0x3b, 0x6a, 0xaf, 0xbb, 0x85, 0xb0, 0x7e, 0xdf, 0x7f, 0x02, 0xfd, 0xd2, 0x46, 0xfd, 0xa6, 0x79,
+	0x7d, 0xf4, 0x0a, 0x54, 0x8d, 0x23, 0xe7, 0x57, 0x37, 0x61, 0xeb, 0x2d, 0xc9, 0x48, 0x22, 0xd0,
+	0x53, 0xd8, 0x99, 0xc5, 0xdc, 0xaf, 0xbf, 0xe1, 0x74, 0x9e, 0x7a, 0x0a, 0x2e, 0x1f, 0x71, 0x8f,
+	0xa1, 0x6b, 0x5e, 0x85, 0x5a, 0x49, 0xe7, 0xc8, 0xd6, 0x98, 0x56, 0xf9, 0x36, 0x20, 0x39, 0x3e,
+	0x9b, 0x19, 0x35, 0xb9, 0xb9, 0x95, 0x90, 0xe5, 0xbb, 0x7a, 0x3e, 0x91, 0x03, 0x3d, 0x3f, 0xa6,
+	0x84, 0x79, 0x8b, 0x54, 0x2b, 0xea, 0x31, 0x6b, 0x2b, 0xf0, 0xab, 0x54, 0xe9, 0x7c, 0x0a, 0xfb,
+	0xe6, 0x19, 0xeb, 0x9d, 0xdf, 0xa4, 0x4e, 0xd8, 0x9e, 0x11, 0x1f, 0x34, 0xf6, 0xfa, 0x04, 0xfa,
+	0x24, 0x8c, 0x58, 0xe8, 0x15, 0x2f, 0x11, 0x95, 0xc4, 0x96, 0xdb, 0x53, 0xe8, 0x91, 0x01, 0x0f,
+	0x3e, 0xfb, 0xfa, 0xc3, 0xc0, 0x7a, 0xff, 0x61, 0x60, 0xfd, 0xf3, 0xc3, 0xc0, 0xfa, 0xdd, 0xc7,
+	0xc1, 0x8d, 0xf7, 0x1f, 0x07, 0x37, 0xfe, 0xfe, 0x71, 0x70, 0xe3, 0x17, 0xce, 0x39, 0x26, 0xe5,
+	0xc9, 0x64, 0x59, 0xfd, 0x33, 0x22, 0x5f, 0xa5, 0x54, 0xcc, 0xb6, 0x94, 0xf8, 0x7b, 0xff, 0x09,
+	0x00, 0x00, 0xff, 0xff, 0xe8, 0x14, 0x98, 0x48, 0xb3, 0x10, 0x00, 0x00,
+}
+
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrontask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.NextAttemptTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.NextAttemptTimestamp))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.AttemptLog) > 0 {
+		for iNdEx := len(m.AttemptLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttemptLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrontask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrontask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe8
+	}
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.ExecutionPhase) > 0 {
+		i -= len(m.ExecutionPhase)
+		copy(dAtA[i:], m.ExecutionPhase)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.ExecutionPhase)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.Deferrals != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.Deferrals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrontask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if m.ConditionChecks != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ConditionChecks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Condition != nil {
+		{
+			size, err := m.Condition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrontask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCrontask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.RunHistory) > 0 {
+		for iNdEx := len(m.RunHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrontask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.Occurrences != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.Occurrences))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.EndTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.EndTimestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.MaxOccurrences != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.MaxOccurrences))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.ExecutionTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExecutionTimestamp))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TaskGasConsumed != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.TaskGasConsumed))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.ErrorLog) > 0 {
+		i -= len(m.ErrorLog)
+		copy(dAtA[i:], m.ErrorLog)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.ErrorLog)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.CreationTime != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.CreationTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrontask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrontask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.TaskGasFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCrontask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TaskGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCrontask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.TaskGasLimit != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.TaskGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ScheduledTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ScheduledTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetryableErrors) > 0 {
+		for iNdEx := len(m.RetryableErrors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryableErrors[iNdEx])
+			copy(dAtA[i:], m.RetryableErrors[iNdEx])
+			i = encodeVarintCrontask(dAtA, i, uint64(len(m.RetryableErrors[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.MaxBackoffSeconds != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.MaxBackoffSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BackoffSeconds != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.BackoffSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Backoff) > 0 {
+		i -= len(m.Backoff)
+		copy(dAtA[i:], m.Backoff)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Backoff)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retried {
+		i--
+		if m.Retried {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ErrorLog) > 0 {
+		i -= len(m.ErrorLog)
+		copy(dAtA[i:], m.ErrorLog)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.ErrorLog)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Code != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExecutionTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExecutionTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskDependency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskDependency) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskDependency) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outcome) > 0 {
+		i -= len(m.Outcome)
+		copy(dAtA[i:], m.Outcome)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Outcome)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCrontask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.GasLimit != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ScriptView != nil {
+		{
+			size, err := m.ScriptView.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrontask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NameExpired != nil {
+		{
+			size, err := m.NameExpired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrontask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StorageChanged != nil {
+		{
+			size, err := m.StorageChanged.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrontask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BalanceBelow != nil {
+		{
+			size, err := m.BalanceBelow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrontask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BalanceBelowCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BalanceBelowCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BalanceBelowCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCrontask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageChangedCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageChangedCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageChangedCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataHash) > 0 {
+		i -= len(m.DataHash)
+		copy(dAtA[i:], m.DataHash)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.DataHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameExpiredCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameExpiredCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameExpiredCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScriptViewCondition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScriptViewCondition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScriptViewCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kwargs) > 0 {
+		i -= len(m.Kwargs)
+		copy(dAtA[i:], m.Kwargs)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Kwargs)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Args) > 0 {
+		i -= len(m.Args)
+		copy(dAtA[i:], m.Args)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Args)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackError) > 0 {
+		i -= len(m.CallbackError)
+		copy(dAtA[i:], m.CallbackError)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.CallbackError)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CallbackGasConsumed != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.CallbackGasConsumed))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ErrorLog) > 0 {
+		i -= len(m.ErrorLog)
+		copy(dAtA[i:], m.ErrorLog)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.ErrorLog)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintCrontask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.GasConsumed != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.GasConsumed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintCrontask(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecutionTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExecutionTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduledTimestamp != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ScheduledTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Occurrence != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.Occurrence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AgingInterval != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.AgingInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatorBlockGasLimit != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.CreatorBlockGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CleanUpTime != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.CleanUpTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxScheduledTime != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.MaxScheduledTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiryLimit != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.ExpiryLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintCrontask(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrontask(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrontask(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovCrontask(uint64(m.TaskId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.ScheduledTimestamp != 0 {
+		n += 1 + sovCrontask(uint64(m.ScheduledTimestamp))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovCrontask(uint64(m.ExpiryTimestamp))
+	}
+	if m.TaskGasLimit != 0 {
+		n += 1 + sovCrontask(uint64(m.TaskGasLimit))
+	}
+	l = m.TaskGasPrice.Size()
+	n += 1 + l + sovCrontask(uint64(l))
+	l = m.TaskGasFee.Size()
+	n += 1 + l + sovCrontask(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovCrontask(uint64(l))
+		}
+	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovCrontask(uint64(l))
+		}
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.CreationTime != 0 {
+		n += 1 + sovCrontask(uint64(m.CreationTime))
+	}
+	l = len(m.ErrorLog)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.TaskGasConsumed != 0 {
+		n += 1 + sovCrontask(uint64(m.TaskGasConsumed))
+	}
+	if m.ExecutionTimestamp != 0 {
+		n += 1 + sovCrontask(uint64(m.ExecutionTimestamp))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 2 + sovCrontask(uint64(m.IntervalSeconds))
+	}
+	if m.MaxOccurrences != 0 {
+		n += 2 + sovCrontask(uint64(m.MaxOccurrences))
+	}
+	if m.EndTimestamp != 0 {
+		n += 2 + sovCrontask(uint64(m.EndTimestamp))
+	}
+	if m.Occurrences != 0 {
+		n += 2 + sovCrontask(uint64(m.Occurrences))
+	}
+	if len(m.RunHistory) > 0 {
+		for _, e := range m.RunHistory {
+			l = e.Size()
+			n += 2 + l + sovCrontask(uint64(l))
+		}
+	}
+	l = m.Escrow.Size()
+	n += 2 + l + sovCrontask(uint64(l))
+	if m.Condition != nil {
+		l = m.Condition.Size()
+		n += 2 + l + sovCrontask(uint64(l))
+	}
+	if m.ConditionChecks != 0 {
+		n += 2 + sovCrontask(uint64(m.ConditionChecks))
+	}
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 2 + l + sovCrontask(uint64(l))
+		}
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 2 + l + sovCrontask(uint64(l))
+	}
+	if m.Deferrals != 0 {
+		n += 2 + sovCrontask(uint64(m.Deferrals))
+	}
+	l = len(m.ExecutionPhase)
+	if l > 0 {
+		n += 2 + l + sovCrontask(uint64(l))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 2 + sovCrontask(uint64(m.ScheduledHeight))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 2 + sovCrontask(uint64(m.ExecutionHeight))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovCrontask(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 2 + sovCrontask(uint64(m.Attempts))
+	}
+	if len(m.AttemptLog) > 0 {
+		for _, e := range m.AttemptLog {
+			l = e.Size()
+			n += 2 + l + sovCrontask(uint64(l))
+		}
+	}
+	if m.NextAttemptTimestamp != 0 {
+		n += 2 + sovCrontask(uint64(m.NextAttemptTimestamp))
+	}
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 2 + l + sovCrontask(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCrontask(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *RetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovCrontask(uint64(m.MaxAttempts))
+	}
+	l = len(m.Backoff)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.BackoffSeconds != 0 {
+		n += 1 + sovCrontask(uint64(m.BackoffSeconds))
+	}
+	if m.MaxBackoffSeconds != 0 {
+		n += 1 + sovCrontask(uint64(m.MaxBackoffSeconds))
+	}
+	if len(m.RetryableErrors) > 0 {
+		for _, s := range m.RetryableErrors {
+			l = len(s)
+			n += 1 + l + sovCrontask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attempt != 0 {
+		n += 1 + sovCrontask(uint64(m.Attempt))
+	}
+	if m.ExecutionTimestamp != 0 {
+		n += 1 + sovCrontask(uint64(m.ExecutionTimestamp))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovCrontask(uint64(m.ExecutionHeight))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovCrontask(uint64(m.Code))
+	}
+	l = len(m.ErrorLog)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.Retried {
+		n += 2
+	}
+	return n
+}
+
+func (m *TaskDependency) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovCrontask(uint64(m.TaskId))
+	}
+	l = len(m.Outcome)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BalanceBelow != nil {
+		l = m.BalanceBelow.Size()
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.StorageChanged != nil {
+		l = m.StorageChanged.Size()
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.NameExpired != nil {
+		l = m.NameExpired.Size()
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.ScriptView != nil {
+		l = m.ScriptView.Size()
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovCrontask(uint64(m.GasLimit))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovCrontask(uint64(l))
+	return n
+}
+
+func (m *BalanceBelowCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCrontask(uint64(l))
+	return n
+}
+
+func (m *StorageChangedCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovCrontask(uint64(m.UpdatedHeight))
+	}
+	l = len(m.DataHash)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	return n
+}
+
+func (m *NameExpiredCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	return n
+}
+
+func (m *ScriptViewCondition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	l = len(m.Kwargs)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Occurrence != 0 {
+		n += 1 + sovCrontask(uint64(m.Occurrence))
+	}
+	if m.ScheduledTimestamp != 0 {
+		n += 1 + sovCrontask(uint64(m.ScheduledTimestamp))
+	}
+	if m.ExecutionTimestamp != 0 {
+		n += 1 + sovCrontask(uint64(m.ExecutionTimestamp))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.GasConsumed != 0 {
+		n += 1 + sovCrontask(uint64(m.GasConsumed))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovCrontask(uint64(l))
+	l = len(m.ErrorLog)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovCrontask(uint64(m.ExecutionHeight))
+	}
+	if m.CallbackGasConsumed != 0 {
+		n += 1 + sovCrontask(uint64(m.CallbackGasConsumed))
+	}
+	l = len(m.CallbackError)
+	if l > 0 {
+		n += 1 + l + sovCrontask(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovCrontask(uint64(m.BlockGasLimit))
+	}
+	if m.ExpiryLimit != 0 {
+		n += 1 + sovCrontask(uint64(m.ExpiryLimit))
+	}
+	if m.MaxScheduledTime != 0 {
+		n += 1 + sovCrontask(uint64(m.MaxScheduledTime))
+	}
+	if m.CleanUpTime != 0 {
+		n += 1 + sovCrontask(uint64(m.CleanUpTime))
+	}
+	if m.CreatorBlockGasLimit != 0 {
+		n += 1 + sovCrontask(uint64(m.CreatorBlockGasLimit))
+	}
+	if m.AgingInterval != 0 {
+		n += 1 + sovCrontask(uint64(m.AgingInterval))
+	}
+	return n
+}
+
+func sovCrontask(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrontask(x uint64) (n int) {
+	return sovCrontask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimestamp", wireType)
+			}
+			m.ScheduledTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+			}
+			m.TaskGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaskGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaskGasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, &any.Any{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			m.CreationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorLog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasConsumed", wireType)
+			}
+			m.TaskGasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskGasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTimestamp", wireType)
+			}
+			m.ExecutionTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOccurrences", wireType)
+			}
+			m.MaxOccurrences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOccurrences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			}
+			m.EndTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrences", wireType)
+			}
+			m.Occurrences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Occurrences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunHistory = append(m.RunHistory, TaskRun{})
+			if err := m.RunHistory[len(m.RunHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Condition == nil {
+				m.Condition = &TaskCondition{}
+			}
+			if err := m.Condition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionChecks", wireType)
+			}
+			m.ConditionChecks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionChecks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, TaskDependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deferrals", wireType)
+			}
+			m.Deferrals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deferrals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttemptLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttemptLog = append(m.AttemptLog, TaskAttempt{})
+			if err := m.AttemptLog[len(m.AttemptLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptTimestamp", wireType)
+			}
+			m.NextAttemptTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttemptTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &TaskCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Backoff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffSeconds", wireType)
+			}
+			m.BackoffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffSeconds", wireType)
+			}
+			m.MaxBackoffSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableErrors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryableErrors = append(m.RetryableErrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTimestamp", wireType)
+			}
+			m.ExecutionTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ErrorLog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retried", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retried = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskDependency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskDependency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskDependency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outcome = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBelow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BalanceBelow == nil {
+				m.BalanceBelow = &BalanceBelowCondition{}
+			}
+			if err := m.BalanceBelow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageChanged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageChanged == nil {
+				m.StorageChanged = &StorageChangedCondition{}
+			}
+			if err := m.StorageChanged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NameExpired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NameExpired == nil {
+				m.NameExpired = &NameExpiredCondition{}
+			}
+			if err := m.NameExpired.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptView", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScriptView == nil {
+				m.ScriptView = &ScriptViewCondition{}
+			}
+			if err := m.ScriptView.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BalanceBelowCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BalanceBelowCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BalanceBelowCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageChangedCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageChangedCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageChangedCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataHash = append(m.DataHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataHash == nil {
+				m.DataHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintCrontask(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCrontask(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *Task) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		n += 1 + sovCrontask(uint64(m.TaskId))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovCrontask(uint64(l))
-	}
-	if m.ScheduledTimestamp != 0 {
-		n += 1 + sovCrontask(uint64(m.ScheduledTimestamp))
-	}
-	if m.ExpiryTimestamp != 0 {
-		n += 1 + sovCrontask(uint64(m.ExpiryTimestamp))
-	}
-	if m.TaskGasLimit != 0 {
-		n += 1 + sovCrontask(uint64(m.TaskGasLimit))
-	}
-	l = m.TaskGasPrice.Size()
-	n += 1 + l + sovCrontask(uint64(l))
-	l = m.TaskGasFee.Size()
-	n += 1 + l + sovCrontask(uint64(l))
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovCrontask(uint64(l))
+func (m *NameExpiredCondition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.MsgResults) > 0 {
-		for _, e := range m.MsgResults {
-			l = e.Size()
-			n += 1 + l + sovCrontask(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameExpiredCondition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameExpiredCondition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovCrontask(uint64(l))
-	}
-	if m.CreationTime != 0 {
-		n += 1 + sovCrontask(uint64(m.CreationTime))
-	}
-	l = len(m.ErrorLog)
-	if l > 0 {
-		n += 1 + l + sovCrontask(uint64(l))
-	}
-	if m.TaskGasConsumed != 0 {
-		n += 1 + sovCrontask(uint64(m.TaskGasConsumed))
-	}
-	if m.ExecutionTimestamp != 0 {
-		n += 1 + sovCrontask(uint64(m.ExecutionTimestamp))
-	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockGasLimit != 0 {
-		n += 1 + sovCrontask(uint64(m.BlockGasLimit))
-	}
-	if m.ExpiryLimit != 0 {
-		n += 1 + sovCrontask(uint64(m.ExpiryLimit))
-	}
-	if m.MaxScheduledTime != 0 {
-		n += 1 + sovCrontask(uint64(m.MaxScheduledTime))
-	}
-	if m.CleanUpTime != 0 {
-		n += 1 + sovCrontask(uint64(m.CleanUpTime))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovCrontask(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCrontask(x uint64) (n int) {
-	return sovCrontask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Task) Unmarshal(dAtA []byte) error {
+func (m *ScriptViewCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -608,17 +4894,81 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScriptViewCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScriptViewCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
 			}
-			m.TaskId = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -628,14 +4978,27 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kwargs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -663,13 +5026,63 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Kwargs = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrontask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrontask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrontask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Occurrence", wireType)
 			}
-			m.ScheduledTimestamp = 0
+			m.Occurrence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -679,16 +5092,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ScheduledTimestamp |= int64(b&0x7F) << shift
+				m.Occurrence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimestamp", wireType)
 			}
-			m.ExpiryTimestamp = 0
+			m.ScheduledTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -698,16 +5111,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExpiryTimestamp |= int64(b&0x7F) << shift
+				m.ScheduledTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTimestamp", wireType)
 			}
-			m.TaskGasLimit = 0
+			m.ExecutionTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -717,16 +5130,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskGasLimit |= uint64(b&0x7F) << shift
+				m.ExecutionTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -736,30 +5149,29 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCrontask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCrontask
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TaskGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasFee", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
 			}
-			var msglen int
+			m.GasConsumed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -769,28 +5181,14 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.GasConsumed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCrontask
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCrontask
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TaskGasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -817,16 +5215,15 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msgs = append(m.Msgs, &any.Any{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -836,31 +5233,29 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCrontask
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCrontask
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgResults = append(m.MsgResults, &any.Any{})
-			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ErrorLog = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExecutionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -870,29 +5265,16 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExecutionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCrontask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCrontask
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 11:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackGasConsumed", wireType)
 			}
-			m.CreationTime = 0
+			m.CallbackGasConsumed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCrontask
@@ -902,14 +5284,14 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreationTime |= int64(b&0x7F) << shift
+				m.CallbackGasConsumed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -937,46 +5319,8 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ErrorLog = string(dAtA[iNdEx:postIndex])
+			m.CallbackError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasConsumed", wireType)
-			}
-			m.TaskGasConsumed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCrontask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TaskGasConsumed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionTimestamp", wireType)
-			}
-			m.ExecutionTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCrontask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExecutionTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrontask(dAtA[iNdEx:])
@@ -1103,6 +5447,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorBlockGasLimit", wireType)
+			}
+			m.CreatorBlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatorBlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgingInterval", wireType)
+			}
+			m.AgingInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrontask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AgingInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCrontask(dAtA[iNdEx:])
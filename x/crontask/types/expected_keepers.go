@@ -2,8 +2,13 @@ package types
 
 import (
 	"context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	nameservicetypes "dysonprotocol.com/x/nameservice/types"
+	storagetypes "dysonprotocol.com/x/storage/types"
 )
 
 // AccountKeeper defines the expected account keeper
@@ -15,7 +20,29 @@ type AccountKeeper interface {
 // BankKeeper defines the expected bank keeper
 type BankKeeper interface {
 	HasBalance(ctx context.Context, addr sdk.AccAddress, amt sdk.Coin) bool
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+}
+
+// AuthzKeeper defines the expected authz keeper used to authorize task messages signed by
+// accounts other than the task creator
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+	SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error
+}
+
+// StorageKeeper defines the expected storage keeper used to evaluate storage conditions
+type StorageKeeper interface {
+	StorageGet(ctx context.Context, req *storagetypes.QueryStorageGetRequest) (*storagetypes.QueryStorageGetResponse, error)
+}
+
+// NameserviceKeeper defines the expected nameservice keeper used to evaluate name conditions
+type NameserviceKeeper interface {
+	GetNFTData(ctx context.Context, classId string, nftId string) (nameservicetypes.NFTData, error)
 }
 
 // BranchKeeper defines the expected branch keeper for atomic execution
@@ -0,0 +1,52 @@
+package types
+
+// Defaults and limits of the schedule forecast and task stats queries
+const (
+	// DefaultForecastWindow is the forecast window in seconds when none is given
+	DefaultForecastWindow = 3600
+	// DefaultForecastBucket is the forecast bucket length in seconds when none is given
+	DefaultForecastBucket = 60
+	// MaxForecastBuckets is the maximum number of buckets of a forecast
+	MaxForecastBuckets = 1440
+	// MaxForecastRunsPerTask is the maximum number of runs of a recurring task in a forecast
+	MaxForecastRunsPerTask = 1000
+
+	// DefaultStatsBlocks is the number of upcoming blocks of the task stats when none is given
+	DefaultStatsBlocks = 10
+	// MaxStatsBlocks is the maximum number of upcoming blocks of the task stats
+	MaxStatsBlocks = 1000
+	// DefaultBlockTime is the expected time between blocks in seconds when none is given
+	DefaultBlockTime = 5
+)
+
+// ForecastRuns returns the times of the runs of the task due before to, at most maxRuns of
+// them. A run that is overdue at from is counted at from, and the runs of a recurring task
+// follow it as they would when it ran then. The second return value reports whether runs
+// were cut at maxRuns.
+func (t Task) ForecastRuns(from, to int64, maxRuns int) ([]int64, bool, error) {
+	run := t.DueTimestamp()
+	if run < from {
+		run = from
+	}
+	if run >= to || maxRuns <= 0 {
+		return nil, false, nil
+	}
+
+	runs := []int64{run}
+	for t.IsRecurring() {
+		t.Occurrences++
+		next, ok, err := t.NextRunAfter(run)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok || next >= to {
+			break
+		}
+		if len(runs) >= maxRuns {
+			return runs, true, nil
+		}
+		run = next
+		runs = append(runs, run)
+	}
+	return runs, false, nil
+}
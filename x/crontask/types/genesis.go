@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default genesis state for the module.
@@ -64,6 +65,50 @@ func ValidateGenesis(gs *GenesisState) error {
 		if len(task.Msgs) == 0 {
 			return fmt.Errorf("task must have at least one message")
 		}
+		if task.Escrow.Denom != "" && !task.Escrow.IsValid() {
+			return fmt.Errorf("invalid escrow of task %d: %s", task.TaskId, task.Escrow)
+		}
+		if err := task.ValidateSchedule(); err != nil {
+			return fmt.Errorf("invalid schedule of task %d: %w", task.TaskId, err)
+		}
+		if err := task.ValidateDependencies(); err != nil {
+			return fmt.Errorf("invalid dependencies of task %d: %w", task.TaskId, err)
+		}
+		if task.Condition != nil {
+			if err := task.Condition.Validate(); err != nil {
+				return fmt.Errorf("invalid condition of task %d: %w", task.TaskId, err)
+			}
+		}
+	}
+
+	// Validate payout schedules
+	payoutIDs := make(map[uint64]bool)
+	for _, payout := range gs.PayoutSchedules {
+		if payout.PayoutId == 0 {
+			return fmt.Errorf("payout schedule ID cannot be 0")
+		}
+		if payoutIDs[payout.PayoutId] {
+			return fmt.Errorf("duplicate payout schedule ID: %d", payout.PayoutId)
+		}
+		payoutIDs[payout.PayoutId] = true
+
+		if _, err := sdk.AccAddressFromBech32(payout.Creator); err != nil {
+			return fmt.Errorf("invalid creator of payout schedule %d: %w", payout.PayoutId, err)
+		}
+		if err := payout.ValidateSchedule(); err != nil {
+			return fmt.Errorf("invalid payout schedule %d: %w", payout.PayoutId, err)
+		}
+		switch payout.Status {
+		case PayoutStatus_ACTIVE, PayoutStatus_COMPLETED, PayoutStatus_CANCELLED:
+		default:
+			return fmt.Errorf("invalid status of payout schedule %d: %s", payout.PayoutId, payout.Status)
+		}
+		if !payout.Escrow.IsValid() {
+			return fmt.Errorf("invalid escrow of payout schedule %d: %s", payout.PayoutId, payout.Escrow)
+		}
+		if payout.PayoutId >= gs.NextPayoutId && gs.NextPayoutId > 0 {
+			return fmt.Errorf("payout schedule ID %d is not below the next payout ID %d", payout.PayoutId, gs.NextPayoutId)
+		}
 	}
 
 	return nil
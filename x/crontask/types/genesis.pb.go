@@ -30,6 +30,10 @@ type GenesisState struct {
 	NextTaskId uint64 `protobuf:"varint,2,opt,name=next_task_id,json=nextTaskId,proto3" json:"next_task_id,omitempty"`
 	// Module parameters
 	Params *Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty"`
+	// List of payout schedules to initialize with
+	PayoutSchedules []*PayoutSchedule `protobuf:"bytes,4,rep,name=payout_schedules,json=payoutSchedules,proto3" json:"payout_schedules,omitempty"`
+	// The sequence for the next payout schedule ID
+	NextPayoutId uint64 `protobuf:"varint,5,opt,name=next_payout_id,json=nextPayoutId,proto3" json:"next_payout_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +90,20 @@ func (m *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (m *GenesisState) GetPayoutSchedules() []*PayoutSchedule {
+	if m != nil {
+		return m.PayoutSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextPayoutId() uint64 {
+	if m != nil {
+		return m.NextPayoutId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dysonprotocol.crontask.v1.GenesisState")
 }
@@ -95,21 +113,26 @@ func init() {
 }
 
 var fileDescriptor_97c374ecd13f00f7 = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
+	// 290 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xa9, 0x2c, 0xce,
 	0xcf, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x4f, 0x2e, 0xca, 0xcf, 0x2b, 0x49,
 	0x2c, 0xce, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x4b,
 	0x0a, 0x49, 0xa2, 0x28, 0xd4, 0x83, 0x29, 0xd4, 0x2b, 0x33, 0x94, 0xd2, 0xc0, 0x6d, 0x06, 0x5c,
-	0x19, 0x58, 0x56, 0x69, 0x11, 0x23, 0x17, 0x8f, 0x3b, 0xc4, 0xd8, 0xe0, 0x92, 0xc4, 0x92, 0x54,
-	0x21, 0x53, 0x2e, 0x56, 0x90, 0x74, 0xb1, 0x04, 0xa3, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0xbc, 0x1e,
-	0x4e, 0x5b, 0xf4, 0x42, 0x12, 0x8b, 0xb3, 0x83, 0x20, 0xaa, 0x85, 0x14, 0xb8, 0x78, 0xf2, 0x52,
-	0x2b, 0x4a, 0xe2, 0x41, 0xbc, 0xf8, 0xcc, 0x14, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x2e,
-	0x90, 0x18, 0x48, 0x99, 0x67, 0x8a, 0x90, 0x25, 0x17, 0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1,
-	0x04, 0xb3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x22, 0x1e, 0x93, 0x03, 0xc0, 0x0a, 0x83, 0xa0, 0x1a,
-	0x9c, 0x6c, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x09, 0xcd, 0x8c,
-	0xfc, 0x5c, 0xfd, 0x0a, 0x84, 0x77, 0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xd2, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x70, 0xa1, 0x15, 0x4b, 0x59, 0x01, 0x00, 0x00,
+	0x19, 0x58, 0x56, 0x4a, 0x0d, 0xb7, 0xca, 0x82, 0xc4, 0xca, 0xfc, 0xd2, 0x12, 0x88, 0x3a, 0xa5,
+	0x79, 0x4c, 0x5c, 0x3c, 0xee, 0x10, 0xeb, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x4c, 0xb9, 0x58,
+	0x41, 0x0a, 0x8b, 0x25, 0x18, 0x15, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0xf5, 0x70, 0xba, 0x46, 0x2f,
+	0x24, 0xb1, 0x38, 0x3b, 0x08, 0xa2, 0x5a, 0x48, 0x81, 0x8b, 0x27, 0x2f, 0xb5, 0xa2, 0x24, 0x1e,
+	0xc4, 0x8b, 0xcf, 0x4c, 0x91, 0x60, 0x52, 0x60, 0xd4, 0x60, 0x09, 0xe2, 0x02, 0x89, 0x81, 0x94,
+	0x79, 0xa6, 0x08, 0x59, 0x72, 0xb1, 0x15, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2b, 0x30,
+	0x6a, 0x70, 0x1b, 0x29, 0xe2, 0x31, 0x39, 0x00, 0xac, 0x30, 0x08, 0xaa, 0x41, 0x28, 0x84, 0x4b,
+	0x00, 0xe2, 0xe8, 0xf8, 0xe2, 0xe4, 0x8c, 0xd4, 0x94, 0xd2, 0x9c, 0xd4, 0x62, 0x09, 0x16, 0xb0,
+	0xf3, 0x34, 0xf1, 0x1a, 0x02, 0xd2, 0x12, 0x0c, 0xd5, 0x11, 0xc4, 0x5f, 0x80, 0xc2, 0x2f, 0x16,
+	0x52, 0xe1, 0xe2, 0x03, 0x3b, 0x19, 0x6a, 0x74, 0x66, 0x8a, 0x04, 0x2b, 0xd8, 0xd1, 0x60, 0x8f,
+	0x40, 0x34, 0x7b, 0xa6, 0x38, 0xd9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83,
+	0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43,
+	0x94, 0x12, 0x9a, 0xd5, 0xf9, 0xb9, 0xfa, 0x15, 0x88, 0x80, 0x2e, 0xa9, 0x2c, 0x48, 0x2d, 0x4e,
+	0x62, 0x03, 0x4b, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x50, 0x22, 0x97, 0x72, 0xfd, 0x01,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -132,6 +155,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextPayoutId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextPayoutId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PayoutSchedules) > 0 {
+		for iNdEx := len(m.PayoutSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayoutSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.Params != nil {
 		{
 			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
@@ -196,6 +238,15 @@ func (m *GenesisState) Size() (n int) {
 		l = m.Params.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.PayoutSchedules) > 0 {
+		for _, e := range m.PayoutSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextPayoutId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextPayoutId))
+	}
 	return n
 }
 
@@ -323,6 +374,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayoutSchedules = append(m.PayoutSchedules, &PayoutSchedule{})
+			if err := m.PayoutSchedules[len(m.PayoutSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPayoutId", wireType)
+			}
+			m.NextPayoutId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextPayoutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -11,6 +11,8 @@ import (
 var (
 	_ gogoprotoany.UnpackInterfacesMessage = (*Task)(nil)
 	_ gogoprotoany.UnpackInterfacesMessage = (*MsgCreateTask)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*MsgUpdateTask)(nil)
+	_ gogoprotoany.UnpackInterfacesMessage = (*QuerySimulateTaskRequest)(nil)
 )
 
 // UnpackInterfaces implements the UnpackInterfacesMessage interface for Task
@@ -39,6 +41,32 @@ func (msg MsgCreateTask) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) err
 	return nil
 }
 
+// UnpackInterfaces implements the UnpackInterfacesMessage interface for MsgUpdateTask
+func (msg MsgUpdateTask) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for i, anyMsg := range msg.Msgs {
+		var sdkMsg sdk.Msg
+		err := unpacker.UnpackAny(anyMsg, &sdkMsg)
+		if err != nil {
+			return fmt.Errorf("failed to unpack msg at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements the UnpackInterfacesMessage interface for QuerySimulateTaskRequest
+func (req QuerySimulateTaskRequest) UnpackInterfaces(unpacker gogoprotoany.AnyUnpacker) error {
+	for i, anyMsg := range req.Msgs {
+		var sdkMsg sdk.Msg
+		err := unpacker.UnpackAny(anyMsg, &sdkMsg)
+		if err != nil {
+			return fmt.Errorf("failed to unpack msg at index %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
 // GetMessages unpacks the Msgs into sdk.Msg's
 func (task Task) GetMessages() ([]sdk.Msg, error) {
 	return tx.GetMsgs(task.Msgs, "Task")
@@ -99,3 +127,13 @@ func (msg *MsgCreateTask) SetMessages(msgs []sdk.Msg) error {
 func (msg MsgCreateTask) GetMessages() ([]sdk.Msg, error) {
 	return tx.GetMsgs(msg.Msgs, "MsgCreateTask")
 }
+
+// GetMessages unpacks the Msgs into sdk.Msg's
+func (msg MsgUpdateTask) GetMessages() ([]sdk.Msg, error) {
+	return tx.GetMsgs(msg.Msgs, "MsgUpdateTask")
+}
+
+// GetMessages unpacks the Msgs into sdk.Msg's
+func (req QuerySimulateTaskRequest) GetMessages() ([]sdk.Msg, error) {
+	return tx.GetMsgs(req.Msgs, "QuerySimulateTaskRequest")
+}
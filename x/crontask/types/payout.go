@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PayoutStatus constants define the possible states of a payout schedule
+const (
+	PayoutStatus_ACTIVE    = "ACTIVE"
+	PayoutStatus_COMPLETED = "COMPLETED"
+	PayoutStatus_CANCELLED = "CANCELLED"
+)
+
+// Payout schedule limits
+const (
+	// MaxPayoutRecipients is the maximum number of recipients of a payout schedule
+	MaxPayoutRecipients = 50
+
+	// MaxPayoutReleases is the maximum number of releases of a payout schedule
+	MaxPayoutReleases = 1000
+
+	// MaxPayoutReleasesPerBlock is the maximum number of releases paid in a block, the
+	// releases left are paid in the next blocks
+	MaxPayoutReleasesPerBlock = 200
+)
+
+// ValidateSchedule checks the recipients, amounts and release schedule of a payout schedule
+func (p PayoutSchedule) ValidateSchedule() error {
+	if len(p.Recipients) == 0 {
+		return fmt.Errorf("at least one recipient must be provided")
+	}
+	if len(p.Recipients) > MaxPayoutRecipients {
+		return fmt.Errorf("too many recipients: %d, maximum %d", len(p.Recipients), MaxPayoutRecipients)
+	}
+
+	seen := make(map[string]bool, len(p.Recipients))
+	for _, r := range p.Recipients {
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid recipient address %s: %w", r.Address, err)
+		}
+		if seen[r.Address] {
+			return fmt.Errorf("duplicate recipient %s", r.Address)
+		}
+		seen[r.Address] = true
+
+		if !r.Amount.IsValid() || !r.Amount.IsAllPositive() {
+			return fmt.Errorf("invalid amount of recipient %s: %s", r.Address, r.Amount)
+		}
+	}
+
+	if p.TotalReleases == 0 || p.TotalReleases > MaxPayoutReleases {
+		return fmt.Errorf("total releases must be between 1 and %d", MaxPayoutReleases)
+	}
+	if p.IntervalSeconds < 0 {
+		return fmt.Errorf("interval cannot be negative")
+	}
+	if p.TotalReleases > 1 && p.IntervalSeconds == 0 {
+		return fmt.Errorf("an interval is required for more than one release")
+	}
+	if p.StartTimestamp <= 0 {
+		return fmt.Errorf("start timestamp must be positive")
+	}
+	if p.IntervalSeconds > (math.MaxInt64-p.StartTimestamp)/int64(p.TotalReleases) {
+		return fmt.Errorf("the last release is too far in the future")
+	}
+	return nil
+}
+
+// IsFinished reports whether the payout schedule will not release funds again
+func (p PayoutSchedule) IsFinished() bool {
+	return p.Status != PayoutStatus_ACTIVE
+}
+
+// ReleaseAmount returns the total amount paid to the recipients at every release
+func (p PayoutSchedule) ReleaseAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, r := range p.Recipients {
+		total = total.Add(r.Amount...)
+	}
+	return total
+}
+
+// TotalAmount returns the amount paid by all the releases of the payout schedule
+func (p PayoutSchedule) TotalAmount() sdk.Coins {
+	return p.ReleaseAmount().MulInt(sdkmath.NewIntFromUint64(p.TotalReleases))
+}
+
+// ReleaseTimestamp returns the due time of the 0-based release
+func (p PayoutSchedule) ReleaseTimestamp(release uint64) int64 {
+	return p.StartTimestamp + int64(release)*p.IntervalSeconds
+}
+
+// vestingStart returns the time from which the 0-based release vests linearly until it is
+// due. The first release of a single release schedule only vests when it is due.
+func (p PayoutSchedule) vestingStart(release uint64) int64 {
+	return max(p.CreationTime, p.ReleaseTimestamp(release)-p.IntervalSeconds)
+}
+
+// VestedShare returns the share of the next release vested at a time, from 0 when its
+// vesting period starts to 1 when it is due
+func (p PayoutSchedule) VestedShare(now int64) sdkmath.LegacyDec {
+	if p.IsFinished() || p.ReleasesDone >= p.TotalReleases {
+		return sdkmath.LegacyZeroDec()
+	}
+
+	start, due := p.vestingStart(p.ReleasesDone), p.ReleaseTimestamp(p.ReleasesDone)
+	switch {
+	case now >= due:
+		return sdkmath.LegacyOneDec()
+	case now <= start:
+		return sdkmath.LegacyZeroDec()
+	}
+	return sdkmath.LegacyNewDec(now - start).QuoInt64(due - start)
+}
+
+// VestedAmount returns the share of an amount, rounded down
+func VestedAmount(amount sdk.Coins, share sdkmath.LegacyDec) sdk.Coins {
+	vested := sdk.NewCoins()
+	for _, c := range amount {
+		vested = vested.Add(sdk.NewCoin(c.Denom, share.MulInt(c.Amount).TruncateInt()))
+	}
+	return vested
+}
@@ -0,0 +1,1114 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dysonprotocol/crontask/v1/payout.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PayoutSchedule releases funds locked in the module account to one or more
+// recipients at a fixed interval, vesting-style. Release i (0-based) is due at
+// start_timestamp + i * interval_seconds.
+type PayoutSchedule struct {
+	// The unique identifier for the payout schedule
+	PayoutId uint64 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	// Address of the creator that funded the payout schedule
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Recipients and the amount each of them receives at every release
+	Recipients []PayoutRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
+	// Unix timestamp of the first release
+	StartTimestamp int64 `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Seconds between two releases, 0 for a single release time-lock
+	IntervalSeconds int64 `protobuf:"varint,5,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Total number of releases
+	TotalReleases uint64 `protobuf:"varint,6,opt,name=total_releases,json=totalReleases,proto3" json:"total_releases,omitempty"`
+	// Number of releases paid out so far
+	ReleasesDone uint64 `protobuf:"varint,7,opt,name=releases_done,json=releasesDone,proto3" json:"releases_done,omitempty"`
+	// Unix timestamp of the next release, 0 once the schedule is finished
+	NextReleaseTimestamp int64 `protobuf:"varint,8,opt,name=next_release_timestamp,json=nextReleaseTimestamp,proto3" json:"next_release_timestamp,omitempty"`
+	// Funds still held in the module account for the remaining releases
+	Escrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=escrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow"`
+	// Status of the schedule: ACTIVE, COMPLETED or CANCELLED
+	Status string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	// Block timestamp when the schedule was created
+	CreationTime int64 `protobuf:"varint,11,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	// Block timestamp when the schedule was completed or cancelled
+	EndTime int64 `protobuf:"varint,12,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (m *PayoutSchedule) Reset()         { *m = PayoutSchedule{} }
+func (m *PayoutSchedule) String() string { return proto.CompactTextString(m) }
+func (*PayoutSchedule) ProtoMessage()    {}
+func (*PayoutSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6aab032769cbfe5, []int{0}
+}
+func (m *PayoutSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutSchedule.Merge(m, src)
+}
+func (m *PayoutSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutSchedule proto.InternalMessageInfo
+
+func (m *PayoutSchedule) GetPayoutId() uint64 {
+	if m != nil {
+		return m.PayoutId
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PayoutSchedule) GetRecipients() []PayoutRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *PayoutSchedule) GetStartTimestamp() int64 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetIntervalSeconds() int64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetTotalReleases() uint64 {
+	if m != nil {
+		return m.TotalReleases
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetReleasesDone() uint64 {
+	if m != nil {
+		return m.ReleasesDone
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetNextReleaseTimestamp() int64 {
+	if m != nil {
+		return m.NextReleaseTimestamp
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrow
+	}
+	return nil
+}
+
+func (m *PayoutSchedule) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PayoutSchedule) GetCreationTime() int64 {
+	if m != nil {
+		return m.CreationTime
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+// PayoutRecipient is a recipient of a payout schedule
+type PayoutRecipient struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Amount released to the recipient at every release
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// Total amount released to the recipient so far
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+}
+
+func (m *PayoutRecipient) Reset()         { *m = PayoutRecipient{} }
+func (m *PayoutRecipient) String() string { return proto.CompactTextString(m) }
+func (*PayoutRecipient) ProtoMessage()    {}
+func (*PayoutRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6aab032769cbfe5, []int{1}
+}
+func (m *PayoutRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutRecipient.Merge(m, src)
+}
+func (m *PayoutRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutRecipient proto.InternalMessageInfo
+
+func (m *PayoutRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PayoutRecipient) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *PayoutRecipient) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PayoutSchedule)(nil), "dysonprotocol.crontask.v1.PayoutSchedule")
+	proto.RegisterType((*PayoutRecipient)(nil), "dysonprotocol.crontask.v1.PayoutRecipient")
+}
+
+func init() {
+	proto.RegisterFile("dysonprotocol/crontask/v1/payout.proto", fileDescriptor_f6aab032769cbfe5)
+}
+
+var fileDescriptor_f6aab032769cbfe5 = []byte{
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x8e, 0xd3, 0x3c,
+	0x14, 0x6d, 0xda, 0x7e, 0xfd, 0xf1, 0x74, 0xda, 0x0f, 0xab, 0x1a, 0xb9, 0x83, 0x94, 0xa9, 0x8a,
+	0x80, 0x32, 0xd2, 0x24, 0xea, 0x00, 0x3b, 0x36, 0x14, 0x36, 0xec, 0x50, 0x0a, 0x1b, 0x36, 0x91,
+	0x1b, 0x5b, 0x6d, 0x34, 0x89, 0x6f, 0x15, 0xbb, 0x65, 0xfa, 0x16, 0xbc, 0x00, 0x7b, 0xc4, 0x8a,
+	0x05, 0x0f, 0x31, 0xcb, 0x8a, 0x15, 0x2b, 0x40, 0xed, 0x82, 0xd7, 0x40, 0xb1, 0x1d, 0x66, 0x18,
+	0x09, 0x56, 0xb0, 0x69, 0xe3, 0x73, 0x8e, 0xaf, 0x8f, 0xcf, 0xbd, 0x09, 0xba, 0xc3, 0xd6, 0x12,
+	0xc4, 0x22, 0x03, 0x05, 0x11, 0x24, 0x7e, 0x94, 0x81, 0x50, 0x54, 0x9e, 0xf9, 0xab, 0x91, 0xbf,
+	0xa0, 0x6b, 0x58, 0x2a, 0x4f, 0x73, 0xb8, 0xf7, 0x8b, 0xce, 0x2b, 0x74, 0xde, 0x6a, 0x74, 0xd8,
+	0x9d, 0xc1, 0x0c, 0x34, 0xe3, 0xe7, 0x4f, 0x66, 0xc3, 0x61, 0x2f, 0x02, 0x99, 0x82, 0x0c, 0x0d,
+	0x61, 0x16, 0x96, 0x72, 0xcd, 0xca, 0x9f, 0x52, 0xc9, 0xfd, 0xd5, 0x68, 0xca, 0x15, 0x1d, 0xf9,
+	0x11, 0xc4, 0xc2, 0xf2, 0x37, 0x68, 0x1a, 0x0b, 0xf0, 0xf5, 0xaf, 0x81, 0x06, 0x9b, 0x2a, 0x6a,
+	0x3f, 0xd7, 0x7e, 0x26, 0xd1, 0x9c, 0xb3, 0x65, 0xc2, 0xf1, 0x4d, 0xd4, 0x34, 0x0e, 0xc3, 0x98,
+	0x11, 0xa7, 0xef, 0x0c, 0xab, 0x41, 0xc3, 0x00, 0xcf, 0x18, 0x3e, 0x45, 0xf5, 0x28, 0xe3, 0x54,
+	0x41, 0x46, 0xca, 0x7d, 0x67, 0xd8, 0x1c, 0x93, 0x4f, 0x1f, 0x4f, 0xba, 0xd6, 0xc5, 0x63, 0xc6,
+	0x32, 0x2e, 0xe5, 0x44, 0x65, 0xb1, 0x98, 0x05, 0x85, 0x10, 0xbf, 0x44, 0x28, 0xe3, 0x51, 0xbc,
+	0x88, 0xb9, 0x50, 0x92, 0x54, 0xfa, 0x95, 0xe1, 0xde, 0xe9, 0xb1, 0xf7, 0xdb, 0x7b, 0x7b, 0xc6,
+	0x4f, 0x50, 0x6c, 0x19, 0x37, 0x2f, 0xbe, 0x1c, 0x95, 0xde, 0x7d, 0xff, 0x70, 0xec, 0x04, 0x57,
+	0x0a, 0xe1, 0xbb, 0xa8, 0x23, 0x15, 0xcd, 0x54, 0xa8, 0xe2, 0x94, 0x4b, 0x45, 0xd3, 0x05, 0xa9,
+	0xf6, 0x9d, 0x61, 0x25, 0x68, 0x6b, 0xf8, 0x45, 0x81, 0xe2, 0x7b, 0xe8, 0xff, 0x58, 0x28, 0x9e,
+	0xad, 0x68, 0x12, 0x4a, 0x1e, 0x81, 0x60, 0x92, 0xfc, 0xa7, 0x95, 0x9d, 0x02, 0x9f, 0x18, 0x18,
+	0xdf, 0x46, 0x6d, 0x05, 0x8a, 0x26, 0x61, 0xc6, 0x13, 0x4e, 0x25, 0x97, 0xa4, 0xa6, 0x03, 0xd8,
+	0xd7, 0x68, 0x60, 0x41, 0x7c, 0x0b, 0xed, 0x17, 0x82, 0x90, 0x81, 0xe0, 0xa4, 0xae, 0x55, 0xad,
+	0x02, 0x7c, 0x0a, 0x82, 0xe3, 0x07, 0xe8, 0x40, 0xf0, 0x73, 0x55, 0x94, 0xba, 0x62, 0xb3, 0xa1,
+	0x0f, 0xef, 0xe6, 0xac, 0x2d, 0x79, 0x69, 0x76, 0x8e, 0x6a, 0x5c, 0x46, 0x19, 0xbc, 0x26, 0x4d,
+	0x1d, 0x54, 0xcf, 0xb3, 0xe1, 0xe6, 0x4d, 0xf5, 0x6c, 0x53, 0xbd, 0x27, 0x10, 0x8b, 0xf1, 0xc3,
+	0x3c, 0x97, 0xf7, 0x5f, 0x8f, 0x86, 0xb3, 0x58, 0xcd, 0x97, 0x53, 0x2f, 0x82, 0xd4, 0xce, 0x83,
+	0xfd, 0x3b, 0x91, 0xec, 0xcc, 0x57, 0xeb, 0x05, 0x97, 0x7a, 0x83, 0x34, 0x19, 0xda, 0xfa, 0xf8,
+	0x00, 0xd5, 0xa4, 0xa2, 0x6a, 0x29, 0x09, 0xca, 0x3b, 0x19, 0xd8, 0x55, 0x7e, 0x39, 0xdd, 0xb9,
+	0x18, 0x84, 0xf6, 0x4c, 0xf6, 0xb4, 0xdd, 0x56, 0x01, 0xe6, 0x5e, 0x71, 0x0f, 0x35, 0xb8, 0x60,
+	0x86, 0x6f, 0x69, 0xbe, 0xce, 0x05, 0xcb, 0xa9, 0xc1, 0xdb, 0x32, 0xea, 0x5c, 0x6b, 0x61, 0x3e,
+	0x36, 0xd4, 0x0c, 0x87, 0x9e, 0xa8, 0x3f, 0x8e, 0x8d, 0x15, 0xe6, 0x49, 0xd0, 0x14, 0x96, 0x42,
+	0x91, 0xf2, 0xbf, 0x4a, 0xc2, 0xd4, 0xc7, 0x09, 0x6a, 0xd8, 0x26, 0x31, 0x3b, 0x9e, 0x7f, 0xff,
+	0xac, 0x9f, 0x27, 0x8c, 0x1f, 0x5d, 0x6c, 0x5d, 0x67, 0xb3, 0x75, 0x9d, 0x6f, 0x5b, 0xd7, 0x79,
+	0xb3, 0x73, 0x4b, 0x9b, 0x9d, 0x5b, 0xfa, 0xbc, 0x73, 0x4b, 0xaf, 0x06, 0xd7, 0xde, 0x09, 0x48,
+	0xfd, 0xf3, 0xcb, 0x2f, 0x87, 0x2e, 0x39, 0xad, 0x69, 0xfa, 0xfe, 0x8f, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0xa3, 0xcc, 0xb7, 0x52, 0x60, 0x04, 0x00, 0x00,
+}
+
+func (m *PayoutSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTime != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CreationTime != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.CreationTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintPayout(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.NextReleaseTimestamp != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.NextReleaseTimestamp))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ReleasesDone != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.ReleasesDone))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalReleases != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.TotalReleases))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPayout(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PayoutId != 0 {
+		i = encodeVarintPayout(dAtA, i, uint64(m.PayoutId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayoutRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPayout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPayout(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPayout(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPayout(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PayoutSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PayoutId != 0 {
+		n += 1 + sovPayout(uint64(m.PayoutId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPayout(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovPayout(uint64(l))
+		}
+	}
+	if m.StartTimestamp != 0 {
+		n += 1 + sovPayout(uint64(m.StartTimestamp))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovPayout(uint64(m.IntervalSeconds))
+	}
+	if m.TotalReleases != 0 {
+		n += 1 + sovPayout(uint64(m.TotalReleases))
+	}
+	if m.ReleasesDone != 0 {
+		n += 1 + sovPayout(uint64(m.ReleasesDone))
+	}
+	if m.NextReleaseTimestamp != 0 {
+		n += 1 + sovPayout(uint64(m.NextReleaseTimestamp))
+	}
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovPayout(uint64(l))
+		}
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovPayout(uint64(l))
+	}
+	if m.CreationTime != 0 {
+		n += 1 + sovPayout(uint64(m.CreationTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovPayout(uint64(m.EndTime))
+	}
+	return n
+}
+
+func (m *PayoutRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPayout(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovPayout(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovPayout(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPayout(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPayout(x uint64) (n int) {
+	return sovPayout(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PayoutSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutId", wireType)
+			}
+			m.PayoutId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, PayoutRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			m.StartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReleases", wireType)
+			}
+			m.TotalReleases = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalReleases |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasesDone", wireType)
+			}
+			m.ReleasesDone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleasesDone |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReleaseTimestamp", wireType)
+			}
+			m.NextReleaseTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextReleaseTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationTime", wireType)
+			}
+			m.CreationTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayoutRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPayout
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPayout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPayout(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPayout
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPayout(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPayout
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPayout
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPayout
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPayout
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPayout
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPayout        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPayout          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPayout = fmt.Errorf("proto: unexpected end of group")
+)
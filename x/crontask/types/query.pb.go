@@ -7,12 +7,13 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	any "github.com/cosmos/gogoproto/types/any"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -177,6 +178,68 @@ func (m *QueryTasksByAddressRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+// QueryTasksByWorkflowRequest is the request type for the Query/TasksByWorkflow
+// RPC method
+type QueryTasksByWorkflowRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	WorkflowId string             `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTasksByWorkflowRequest) Reset()         { *m = QueryTasksByWorkflowRequest{} }
+func (m *QueryTasksByWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByWorkflowRequest) ProtoMessage()    {}
+func (*QueryTasksByWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{3}
+}
+func (m *QueryTasksByWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTasksByWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByWorkflowRequest.Merge(m, src)
+}
+func (m *QueryTasksByWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByWorkflowRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTasksByWorkflowRequest proto.InternalMessageInfo
+
+func (m *QueryTasksByWorkflowRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryTasksByWorkflowRequest) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *QueryTasksByWorkflowRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTasksByStatusTimestampRequest is the request type for the
 // Query/TasksByStatusTimestamp RPC method
 type QueryTasksByStatusTimestampRequest struct {
@@ -188,7 +251,7 @@ func (m *QueryTasksByStatusTimestampRequest) Reset()         { *m = QueryTasksBy
 func (m *QueryTasksByStatusTimestampRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTasksByStatusTimestampRequest) ProtoMessage()    {}
 func (*QueryTasksByStatusTimestampRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31d3eefc3a09d7ff, []int{3}
+	return fileDescriptor_31d3eefc3a09d7ff, []int{4}
 }
 func (m *QueryTasksByStatusTimestampRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -242,7 +305,7 @@ func (m *QueryTasksByStatusGasPriceRequest) Reset()         { *m = QueryTasksByS
 func (m *QueryTasksByStatusGasPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTasksByStatusGasPriceRequest) ProtoMessage()    {}
 func (*QueryTasksByStatusGasPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31d3eefc3a09d7ff, []int{4}
+	return fileDescriptor_31d3eefc3a09d7ff, []int{5}
 }
 func (m *QueryTasksByStatusGasPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -298,7 +361,7 @@ func (m *QueryTasksResponse) Reset()         { *m = QueryTasksResponse{} }
 func (m *QueryTasksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTasksResponse) ProtoMessage()    {}
 func (*QueryTasksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31d3eefc3a09d7ff, []int{5}
+	return fileDescriptor_31d3eefc3a09d7ff, []int{6}
 }
 func (m *QueryTasksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +412,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31d3eefc3a09d7ff, []int{6}
+	return fileDescriptor_31d3eefc3a09d7ff, []int{7}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +450,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31d3eefc3a09d7ff, []int{7}
+	return fileDescriptor_31d3eefc3a09d7ff, []int{8}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +494,7 @@ func (m *QueryAllTasksRequest) Reset()         { *m = QueryAllTasksRequest{} }
 func (m *QueryAllTasksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllTasksRequest) ProtoMessage()    {}
 func (*QueryAllTasksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31d3eefc3a09d7ff, []int{8}
+	return fileDescriptor_31d3eefc3a09d7ff, []int{9}
 }
 func (m *QueryAllTasksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,827 +530,5651 @@ func (m *QueryAllTasksRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryTaskByIDRequest)(nil), "dysonprotocol.crontask.v1.QueryTaskByIDRequest")
-	proto.RegisterType((*QueryTaskByIDResponse)(nil), "dysonprotocol.crontask.v1.QueryTaskByIDResponse")
-	proto.RegisterType((*QueryTasksByAddressRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByAddressRequest")
-	proto.RegisterType((*QueryTasksByStatusTimestampRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest")
-	proto.RegisterType((*QueryTasksByStatusGasPriceRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest")
-	proto.RegisterType((*QueryTasksResponse)(nil), "dysonprotocol.crontask.v1.QueryTasksResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "dysonprotocol.crontask.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dysonprotocol.crontask.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAllTasksRequest)(nil), "dysonprotocol.crontask.v1.QueryAllTasksRequest")
+// QueryTasksByFilterRequest is the request type for the Query/TasksByFilter RPC
+// method. Empty fields do not filter.
+type QueryTasksByFilterRequest struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// from_timestamp is the earliest due time (Unix seconds), inclusive
+	FromTimestamp int64 `protobuf:"varint,3,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	// to_timestamp is the latest due time (Unix seconds), inclusive. Zero means no
+	// upper bound.
+	ToTimestamp int64              `protobuf:"varint,4,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dysonprotocol/crontask/v1/query.proto", fileDescriptor_31d3eefc3a09d7ff)
+func (m *QueryTasksByFilterRequest) Reset()         { *m = QueryTasksByFilterRequest{} }
+func (m *QueryTasksByFilterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTasksByFilterRequest) ProtoMessage()    {}
+func (*QueryTasksByFilterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{10}
 }
-
-var fileDescriptor_31d3eefc3a09d7ff = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0x19, 0x7e, 0x14, 0x1c, 0x13, 0x13, 0xc7, 0x82, 0xd0, 0x98, 0x15, 0xd6, 0xa8, 0x48,
-	0x64, 0x87, 0x16, 0x89, 0x62, 0x30, 0x86, 0xc6, 0x48, 0x48, 0x3c, 0xd4, 0xc2, 0xc9, 0x83, 0x64,
-	0xda, 0x4e, 0x36, 0x1b, 0xba, 0x3b, 0xcb, 0xce, 0x40, 0xdc, 0x10, 0x2e, 0xea, 0x1f, 0x60, 0x94,
-	0x44, 0xaf, 0xfe, 0x0b, 0xc6, 0x3f, 0xc0, 0x8b, 0x89, 0x47, 0xa2, 0x17, 0x8f, 0x06, 0xfc, 0x43,
-	0xcc, 0xce, 0xcc, 0x16, 0xb6, 0x94, 0xee, 0x96, 0x83, 0x97, 0x76, 0x67, 0xe6, 0xfb, 0xde, 0xfb,
-	0xbc, 0x37, 0xf3, 0x1e, 0xbc, 0xd9, 0x08, 0x39, 0xf3, 0xfc, 0x80, 0x09, 0x56, 0x67, 0x4d, 0x5c,
-	0x0f, 0x98, 0x27, 0x08, 0xdf, 0xc4, 0x3b, 0x45, 0xbc, 0xb5, 0x4d, 0x83, 0xd0, 0x92, 0x47, 0x68,
-	0x22, 0x21, 0xb3, 0x62, 0x99, 0xb5, 0x53, 0x2c, 0x5c, 0xb3, 0x19, 0xb3, 0x9b, 0x14, 0x13, 0xdf,
-	0xc1, 0xc4, 0xf3, 0x98, 0x20, 0xc2, 0x61, 0x1e, 0x57, 0x86, 0x85, 0x99, 0x3a, 0xe3, 0x2e, 0xe3,
-	0xb8, 0x46, 0x38, 0x55, 0x1e, 0xf1, 0x4e, 0xb1, 0x46, 0x05, 0x29, 0x62, 0x9f, 0xd8, 0x8e, 0x27,
-	0xc5, 0x5a, 0x3b, 0xa1, 0xb4, 0x1b, 0x72, 0x85, 0xd5, 0x42, 0x1f, 0x19, 0x27, 0xdd, 0xc4, 0x0e,
-	0xea, 0xcc, 0x89, 0x4d, 0xa7, 0xcf, 0x4e, 0xa3, 0xc5, 0xaa, 0x94, 0x79, 0x9b, 0xd9, 0x4c, 0x45,
-	0x88, 0xbe, 0xf4, 0xee, 0x65, 0xe2, 0x3a, 0x1e, 0xc3, 0xf2, 0x57, 0x6d, 0x99, 0x18, 0xe6, 0x9f,
-	0x47, 0xbc, 0xeb, 0x84, 0x6f, 0x96, 0xc3, 0xd5, 0x27, 0x55, 0xba, 0xb5, 0x4d, 0xb9, 0x40, 0x57,
-	0xe1, 0x70, 0xe4, 0x6e, 0xc3, 0x69, 0x8c, 0x83, 0x49, 0x30, 0x3d, 0x58, 0xcd, 0x45, 0xcb, 0xd5,
-	0x86, 0xf9, 0x0c, 0x8e, 0xb6, 0x19, 0x70, 0x9f, 0x79, 0x9c, 0xa2, 0x79, 0x38, 0x18, 0x49, 0xa4,
-	0xfc, 0x62, 0xe9, 0xba, 0x75, 0x66, 0x2d, 0xad, 0xc8, 0xb4, 0x2a, 0xc5, 0xe6, 0x27, 0x00, 0x0b,
-	0x2d, 0x77, 0xbc, 0x1c, 0x2e, 0x37, 0x1a, 0x01, 0xe5, 0x3c, 0xa6, 0x28, 0xc1, 0xe1, 0x7a, 0x40,
-	0x89, 0x60, 0x81, 0x74, 0x7b, 0xa1, 0x3c, 0xfe, 0xf3, 0xeb, 0x6c, 0x5e, 0xd7, 0x4c, 0x6b, 0xd7,
-	0x44, 0xe0, 0x78, 0x76, 0x35, 0x16, 0xa2, 0xa7, 0x10, 0x1e, 0xd7, 0x7c, 0xbc, 0x5f, 0xd2, 0xdc,
-	0xb2, 0xb4, 0x4d, 0x54, 0x59, 0x4b, 0x5d, 0xb9, 0xae, 0xaf, 0x55, 0x21, 0x36, 0xd5, 0xf1, 0xaa,
-	0x27, 0x2c, 0xcd, 0xb7, 0x00, 0x9a, 0x27, 0xd1, 0xd6, 0x04, 0x11, 0xdb, 0x7c, 0xdd, 0x71, 0x29,
-	0x17, 0xc4, 0xf5, 0x63, 0xc4, 0x31, 0x98, 0xe3, 0xf2, 0x44, 0x11, 0x56, 0xf5, 0xaa, 0x0d, 0x63,
-	0xe0, 0xdc, 0x18, 0x6f, 0x00, 0x9c, 0x3a, 0x8d, 0xb1, 0x42, 0x78, 0x25, 0x70, 0xea, 0xf4, 0x7f,
-	0x51, 0xec, 0x03, 0x88, 0x8e, 0x29, 0x5a, 0x77, 0xbe, 0x00, 0x87, 0xa2, 0x6b, 0x8c, 0xa2, 0x0e,
-	0x64, 0xb9, 0x74, 0xa5, 0x46, 0x2b, 0x1d, 0xae, 0xe8, 0x76, 0x2a, 0x95, 0x8a, 0x99, 0xc0, 0xca,
-	0x6b, 0xaa, 0x0a, 0x09, 0x88, 0x1b, 0xbf, 0x1a, 0xb3, 0x02, 0xaf, 0x24, 0x76, 0x35, 0xec, 0x22,
-	0xcc, 0xf9, 0x72, 0x47, 0x3f, 0xd1, 0xa9, 0x2e, 0xb4, 0xda, 0x54, 0x1b, 0x98, 0x2f, 0x75, 0x97,
-	0x2c, 0x37, 0x9b, 0xba, 0x00, 0xaa, 0xec, 0xc9, 0xf2, 0x82, 0xf3, 0x96, 0xb7, 0xf4, 0x71, 0x04,
-	0x0e, 0xc9, 0x00, 0xe8, 0x33, 0x80, 0x23, 0x71, 0x6b, 0x21, 0xdc, 0x85, 0xb0, 0x53, 0xd7, 0x16,
-	0xe6, 0xb2, 0x1b, 0xa8, 0xa2, 0x98, 0xa5, 0xd7, 0xbf, 0xfe, 0x7e, 0xe8, 0xbf, 0x8b, 0x66, 0xf0,
-	0xd9, 0xb3, 0x45, 0x5e, 0x1a, 0xde, 0xd5, 0xf3, 0x60, 0x0f, 0x7d, 0x01, 0xf0, 0x52, 0xb2, 0x5f,
-	0xd1, 0x42, 0x96, 0xc0, 0xa7, 0xfa, 0xbb, 0x30, 0x9b, 0xc9, 0xac, 0x05, 0xfb, 0x50, 0xc2, 0xde,
-	0x43, 0xa5, 0x54, 0x58, 0x3d, 0x0c, 0xf0, 0xae, 0xfe, 0xd8, 0x43, 0xdf, 0x00, 0x1c, 0xeb, 0xdc,
-	0xc9, 0xe8, 0x51, 0x46, 0xf8, 0xce, 0x13, 0xa0, 0xd7, 0x24, 0x1e, 0xc8, 0x24, 0x4a, 0x68, 0x2e,
-	0x35, 0x09, 0xd5, 0xc3, 0x78, 0x57, 0xfd, 0xef, 0xa1, 0xef, 0x00, 0x8e, 0x76, 0x9c, 0x02, 0x68,
-	0xa9, 0xa7, 0x0c, 0xda, 0x86, 0x47, 0xaf, 0x09, 0x3c, 0x96, 0x09, 0x2c, 0xa2, 0xfb, 0xbd, 0x26,
-	0x80, 0x6b, 0xe1, 0x86, 0x4d, 0x38, 0xda, 0xd7, 0x6f, 0x9c, 0x2f, 0x37, 0x9b, 0xe9, 0x6f, 0xbc,
-	0xad, 0xe7, 0x7a, 0xa5, 0x9d, 0x96, 0xb4, 0x26, 0x9a, 0x4c, 0xa3, 0x45, 0xef, 0x01, 0xcc, 0xa9,
-	0xbe, 0x47, 0xa9, 0x31, 0x12, 0x03, 0xa7, 0x60, 0x65, 0x95, 0x6b, 0xa6, 0x3b, 0x92, 0xe9, 0x06,
-	0x9a, 0xea, 0xc2, 0xa4, 0x26, 0x4f, 0x79, 0xe9, 0xc7, 0xa1, 0x01, 0x0e, 0x0e, 0x0d, 0xf0, 0xe7,
-	0xd0, 0x00, 0xef, 0x8e, 0x8c, 0xbe, 0x83, 0x23, 0xa3, 0xef, 0xf7, 0x91, 0xd1, 0xf7, 0xc2, 0x6c,
-	0x8b, 0xc9, 0x5c, 0xfc, 0xea, 0xd8, 0x83, 0x08, 0x7d, 0xca, 0x6b, 0x39, 0x79, 0x3c, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0x36, 0xd7, 0xc4, 0x09, 0x00, 0x09, 0x00, 0x00,
+func (m *QueryTasksByFilterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTasksByFilterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTasksByFilterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTasksByFilterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTasksByFilterRequest.Merge(m, src)
+}
+func (m *QueryTasksByFilterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTasksByFilterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTasksByFilterRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTasksByFilterRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// TaskByID returns a task by its ID
-	TaskByID(ctx context.Context, in *QueryTaskByIDRequest, opts ...grpc.CallOption) (*QueryTaskByIDResponse, error)
-	// TasksByAddress returns all tasks created by a specific address
-	TasksByAddress(ctx context.Context, in *QueryTasksByAddressRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
-	// TasksByStatusTimestamp returns tasks filtered by status and ordered by
-	// timestamp
-	TasksByStatusTimestamp(ctx context.Context, in *QueryTasksByStatusTimestampRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
-	// TasksByStatusGasPrice returns tasks filtered by status and ordered by gas
-	// price
-	TasksByStatusGasPrice(ctx context.Context, in *QueryTasksByStatusGasPriceRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
-	// TasksAll returns all tasks ordered by ID
-	TasksAll(ctx context.Context, in *QueryAllTasksRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
-	// Params returns the module parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryTasksByFilterRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTasksByFilterRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTasksByFilterRequest) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
+	}
+	return 0
 }
 
-func (c *queryClient) TaskByID(ctx context.Context, in *QueryTaskByIDRequest, opts ...grpc.CallOption) (*QueryTaskByIDResponse, error) {
-	out := new(QueryTaskByIDResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TaskByID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTasksByFilterRequest) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) TasksByAddress(ctx context.Context, in *QueryTasksByAddressRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
-	out := new(QueryTasksResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTasksByFilterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) TasksByStatusTimestamp(ctx context.Context, in *QueryTasksByStatusTimestampRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
-	out := new(QueryTasksResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByStatusTimestamp", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryScheduleForecastRequest is the request type for the
+// Query/ScheduleForecast RPC method
+type QueryScheduleForecastRequest struct {
+	// from_timestamp is the start of the window (Unix seconds), the block time
+	// when zero
+	FromTimestamp int64 `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	// window_seconds is the length of the window, one hour when zero
+	WindowSeconds int64 `protobuf:"varint,2,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// bucket_seconds is the length of a bucket, one minute when zero
+	BucketSeconds int64 `protobuf:"varint,3,opt,name=bucket_seconds,json=bucketSeconds,proto3" json:"bucket_seconds,omitempty"`
 }
 
-func (c *queryClient) TasksByStatusGasPrice(ctx context.Context, in *QueryTasksByStatusGasPriceRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
-	out := new(QueryTasksResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByStatusGasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleForecastRequest) Reset()         { *m = QueryScheduleForecastRequest{} }
+func (m *QueryScheduleForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleForecastRequest) ProtoMessage()    {}
+func (*QueryScheduleForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{11}
+}
+func (m *QueryScheduleForecastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleForecastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryScheduleForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleForecastRequest.Merge(m, src)
+}
+func (m *QueryScheduleForecastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleForecastRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) TasksAll(ctx context.Context, in *QueryAllTasksRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
-	out := new(QueryTasksResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScheduleForecastRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleForecastRequest) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleForecastRequest) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// TaskByID returns a task by its ID
-	TaskByID(context.Context, *QueryTaskByIDRequest) (*QueryTaskByIDResponse, error)
-	// TasksByAddress returns all tasks created by a specific address
-	TasksByAddress(context.Context, *QueryTasksByAddressRequest) (*QueryTasksResponse, error)
-	// TasksByStatusTimestamp returns tasks filtered by status and ordered by
-	// timestamp
-	TasksByStatusTimestamp(context.Context, *QueryTasksByStatusTimestampRequest) (*QueryTasksResponse, error)
-	// TasksByStatusGasPrice returns tasks filtered by status and ordered by gas
-	// price
-	TasksByStatusGasPrice(context.Context, *QueryTasksByStatusGasPriceRequest) (*QueryTasksResponse, error)
-	// TasksAll returns all tasks ordered by ID
-	TasksAll(context.Context, *QueryAllTasksRequest) (*QueryTasksResponse, error)
-	// Params returns the module parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryScheduleForecastRequest) GetBucketSeconds() int64 {
+	if m != nil {
+		return m.BucketSeconds
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// ForecastBucket holds the task runs due in a time bucket
+type ForecastBucket struct {
+	StartTimestamp int64 `protobuf:"varint,1,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// end_timestamp is exclusive
+	EndTimestamp int64  `protobuf:"varint,2,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	Runs         uint64 `protobuf:"varint,3,opt,name=runs,proto3" json:"runs,omitempty"`
+	GasLimit     uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (*UnimplementedQueryServer) TaskByID(ctx context.Context, req *QueryTaskByIDRequest) (*QueryTaskByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TaskByID not implemented")
+func (m *ForecastBucket) Reset()         { *m = ForecastBucket{} }
+func (m *ForecastBucket) String() string { return proto.CompactTextString(m) }
+func (*ForecastBucket) ProtoMessage()    {}
+func (*ForecastBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{12}
 }
-func (*UnimplementedQueryServer) TasksByAddress(ctx context.Context, req *QueryTasksByAddressRequest) (*QueryTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TasksByAddress not implemented")
+func (m *ForecastBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TasksByStatusTimestamp(ctx context.Context, req *QueryTasksByStatusTimestampRequest) (*QueryTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TasksByStatusTimestamp not implemented")
+func (m *ForecastBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForecastBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) TasksByStatusGasPrice(ctx context.Context, req *QueryTasksByStatusGasPriceRequest) (*QueryTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TasksByStatusGasPrice not implemented")
+func (m *ForecastBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForecastBucket.Merge(m, src)
 }
-func (*UnimplementedQueryServer) TasksAll(ctx context.Context, req *QueryAllTasksRequest) (*QueryTasksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TasksAll not implemented")
+func (m *ForecastBucket) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *ForecastBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForecastBucket.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_ForecastBucket proto.InternalMessageInfo
 
-func _Query_TaskByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTaskByIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TaskByID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Query/TaskByID",
+func (m *ForecastBucket) GetStartTimestamp() int64 {
+	if m != nil {
+		return m.StartTimestamp
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TaskByID(ctx, req.(*QueryTaskByIDRequest))
+	return 0
+}
+
+func (m *ForecastBucket) GetEndTimestamp() int64 {
+	if m != nil {
+		return m.EndTimestamp
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TasksByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTasksByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ForecastBucket) GetRuns() uint64 {
+	if m != nil {
+		return m.Runs
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).TasksByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TasksByAddress(ctx, req.(*QueryTasksByAddressRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TasksByStatusTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTasksByStatusTimestampRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TasksByStatusTimestamp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByStatusTimestamp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TasksByStatusTimestamp(ctx, req.(*QueryTasksByStatusTimestampRequest))
+func (m *ForecastBucket) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_TasksByStatusGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTasksByStatusGasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TasksByStatusGasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByStatusGasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TasksByStatusGasPrice(ctx, req.(*QueryTasksByStatusGasPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryScheduleForecastResponse is the response type for the
+// Query/ScheduleForecast RPC method
+type QueryScheduleForecastResponse struct {
+	FromTimestamp int64            `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64            `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	Buckets       []ForecastBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets"`
+	TotalRuns     uint64           `protobuf:"varint,4,opt,name=total_runs,json=totalRuns,proto3" json:"total_runs,omitempty"`
+	TotalGasLimit uint64           `protobuf:"varint,5,opt,name=total_gas_limit,json=totalGasLimit,proto3" json:"total_gas_limit,omitempty"`
+	// truncated is set when the runs of a recurring task were cut at
+	// MaxForecastRunsPerTask
+	Truncated bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-func _Query_TasksAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllTasksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TasksAll(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksAll",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TasksAll(ctx, req.(*QueryAllTasksRequest))
+func (m *QueryScheduleForecastResponse) Reset()         { *m = QueryScheduleForecastResponse{} }
+func (m *QueryScheduleForecastResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleForecastResponse) ProtoMessage()    {}
+func (*QueryScheduleForecastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{13}
+}
+func (m *QueryScheduleForecastResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleForecastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleForecastResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryScheduleForecastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleForecastResponse.Merge(m, src)
+}
+func (m *QueryScheduleForecastResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleForecastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleForecastResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryScheduleForecastResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleForecastResponse) GetFromTimestamp() int64 {
+	if m != nil {
+		return m.FromTimestamp
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dysonprotocol.crontask.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TaskByID",
-			Handler:    _Query_TaskByID_Handler,
-		},
-		{
-			MethodName: "TasksByAddress",
-			Handler:    _Query_TasksByAddress_Handler,
-		},
-		{
-			MethodName: "TasksByStatusTimestamp",
-			Handler:    _Query_TasksByStatusTimestamp_Handler,
-		},
-		{
-			MethodName: "TasksByStatusGasPrice",
-			Handler:    _Query_TasksByStatusGasPrice_Handler,
-		},
-		{
-			MethodName: "TasksAll",
-			Handler:    _Query_TasksAll_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dysonprotocol/crontask/v1/query.proto",
+func (m *QueryScheduleForecastResponse) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
 }
 
-func (m *QueryTaskByIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleForecastResponse) GetBuckets() []ForecastBucket {
+	if m != nil {
+		return m.Buckets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTaskByIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryScheduleForecastResponse) GetTotalRuns() uint64 {
+	if m != nil {
+		return m.TotalRuns
+	}
+	return 0
 }
 
-func (m *QueryTaskByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryScheduleForecastResponse) GetTotalGasLimit() uint64 {
+	if m != nil {
+		return m.TotalGasLimit
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryTaskByIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleForecastResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryTaskByIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTaskStatsRequest is the request type for the Query/TaskStats RPC method
+type QueryTaskStatsRequest struct {
+	// upcoming_blocks is the number of upcoming blocks to estimate, 10 when zero
+	UpcomingBlocks uint32 `protobuf:"varint,1,opt,name=upcoming_blocks,json=upcomingBlocks,proto3" json:"upcoming_blocks,omitempty"`
+	// block_time_seconds is the expected time between blocks, 5 when zero
+	BlockTimeSeconds int64 `protobuf:"varint,2,opt,name=block_time_seconds,json=blockTimeSeconds,proto3" json:"block_time_seconds,omitempty"`
 }
 
-func (m *QueryTaskByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTaskStatsRequest) Reset()         { *m = QueryTaskStatsRequest{} }
+func (m *QueryTaskStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskStatsRequest) ProtoMessage()    {}
+func (*QueryTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{14}
+}
+func (m *QueryTaskStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTasksByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTaskStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskStatsRequest.Merge(m, src)
 }
-
-func (m *QueryTasksByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTaskStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTasksByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTaskStatsRequest proto.InternalMessageInfo
+
+func (m *QueryTaskStatsRequest) GetUpcomingBlocks() uint32 {
+	if m != nil {
+		return m.UpcomingBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryTasksByStatusTimestampRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTaskStatsRequest) GetBlockTimeSeconds() int64 {
+	if m != nil {
+		return m.BlockTimeSeconds
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTasksByStatusTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// StatusStats holds the number of tasks with a status and their gas limit
+type StatusStats struct {
+	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Count    uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (m *QueryTasksByStatusTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *StatusStats) Reset()         { *m = StatusStats{} }
+func (m *StatusStats) String() string { return proto.CompactTextString(m) }
+func (*StatusStats) ProtoMessage()    {}
+func (*StatusStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{15}
+}
+func (m *StatusStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StatusStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StatusStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTasksByStatusGasPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StatusStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatusStats.Merge(m, src)
 }
-
-func (m *QueryTasksByStatusGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StatusStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *StatusStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatusStats.DiscardUnknown(m)
 }
 
-func (m *QueryTasksByStatusGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_StatusStats proto.InternalMessageInfo
+
+func (m *StatusStats) GetStatus() string {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *StatusStats) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryTasksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StatusStats) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryTasksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BlockGasEstimate holds the tasks expected to run in an upcoming block
+type BlockGasEstimate struct {
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Tasks     uint64 `protobuf:"varint,3,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	GasLimit  uint64 `protobuf:"varint,4,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
 }
 
-func (m *QueryTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tasks) > 0 {
-		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *BlockGasEstimate) Reset()         { *m = BlockGasEstimate{} }
+func (m *BlockGasEstimate) String() string { return proto.CompactTextString(m) }
+func (*BlockGasEstimate) ProtoMessage()    {}
+func (*BlockGasEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{16}
+}
+func (m *BlockGasEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BlockGasEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasEstimate.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockGasEstimate) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BlockGasEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasEstimate.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlockGasEstimate proto.InternalMessageInfo
+
+func (m *BlockGasEstimate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BlockGasEstimate) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *BlockGasEstimate) GetTasks() uint64 {
+	if m != nil {
+		return m.Tasks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryAllTasksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockGasEstimate) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryAllTasksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTaskStatsResponse is the response type for the Query/TaskStats RPC method
+type QueryTaskStatsResponse struct {
+	Statuses       []StatusStats      `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses"`
+	TotalTasks     uint64             `protobuf:"varint,2,opt,name=total_tasks,json=totalTasks,proto3" json:"total_tasks,omitempty"`
+	UpcomingBlocks []BlockGasEstimate `protobuf:"bytes,3,rep,name=upcoming_blocks,json=upcomingBlocks,proto3" json:"upcoming_blocks"`
+	BlockGasLimit  uint64             `protobuf:"varint,4,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty"`
 }
 
-func (m *QueryAllTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTaskStatsResponse) Reset()         { *m = QueryTaskStatsResponse{} }
+func (m *QueryTaskStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskStatsResponse) ProtoMessage()    {}
+func (*QueryTaskStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{17}
+}
+func (m *QueryTaskStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTaskStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskStatsResponse.Merge(m, src)
+}
+func (m *QueryTaskStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskStatsResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryTaskStatsResponse proto.InternalMessageInfo
+
+func (m *QueryTaskStatsResponse) GetStatuses() []StatusStats {
+	if m != nil {
+		return m.Statuses
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryTaskByIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		n += 1 + sovQuery(uint64(m.TaskId))
+
+func (m *QueryTaskStatsResponse) GetTotalTasks() uint64 {
+	if m != nil {
+		return m.TotalTasks
 	}
-	return n
+	return 0
 }
 
-func (m *QueryTaskByIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Task != nil {
-		l = m.Task.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryTaskStatsResponse) GetUpcomingBlocks() []BlockGasEstimate {
+	if m != nil {
+		return m.UpcomingBlocks
 	}
-	return n
+	return nil
 }
 
-func (m *QueryTasksByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTaskStatsResponse) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+// QuerySimulateTaskRequest is the request type for the Query/SimulateTask RPC
+// method
+type QuerySimulateTaskRequest struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Msgs    []*any.Any `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// task_gas_limit is the gas limit of the task, the largest gas limit allowed
+	// when zero
+	TaskGasLimit uint64 `protobuf:"varint,3,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
+	// execution_phase is the phase of the block in which the task runs,
+	// BEGIN_BLOCK when empty
+	ExecutionPhase string `protobuf:"bytes,4,opt,name=execution_phase,json=executionPhase,proto3" json:"execution_phase,omitempty"`
+}
+
+func (m *QuerySimulateTaskRequest) Reset()         { *m = QuerySimulateTaskRequest{} }
+func (m *QuerySimulateTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTaskRequest) ProtoMessage()    {}
+func (*QuerySimulateTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{18}
+}
+func (m *QuerySimulateTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySimulateTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTaskRequest.Merge(m, src)
+}
+func (m *QuerySimulateTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateTaskRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateTaskRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *QueryTasksByStatusTimestampRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateTaskRequest) GetMsgs() []*any.Any {
+	if m != nil {
+		return m.Msgs
 	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QuerySimulateTaskRequest) GetTaskGasLimit() uint64 {
+	if m != nil {
+		return m.TaskGasLimit
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QuerySimulateTaskRequest) GetExecutionPhase() string {
+	if m != nil {
+		return m.ExecutionPhase
 	}
-	return n
+	return ""
 }
 
-func (m *QueryTasksByStatusGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QuerySimulateTaskResponse is the response type for the Query/SimulateTask RPC
+// method
+type QuerySimulateTaskResponse struct {
+	// success is set when every message ran without error
+	Success      bool       `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	GasUsed      uint64     `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	TaskGasLimit uint64     `protobuf:"varint,3,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
+	MsgResults   []*any.Any `protobuf:"bytes,4,rep,name=msg_results,json=msgResults,proto3" json:"msg_results,omitempty"`
+	ErrorLog     string     `protobuf:"bytes,5,opt,name=error_log,json=errorLog,proto3" json:"error_log,omitempty"`
+	Codespace    string     `protobuf:"bytes,6,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code         uint32     `protobuf:"varint,7,opt,name=code,proto3" json:"code,omitempty"`
+	// queue_depth is the number of tasks competing for the next block in the
+	// phase and queued_gas their gas limit
+	QueueDepth uint64 `protobuf:"varint,8,opt,name=queue_depth,json=queueDepth,proto3" json:"queue_depth,omitempty"`
+	QueuedGas  uint64 `protobuf:"varint,9,opt,name=queued_gas,json=queuedGas,proto3" json:"queued_gas,omitempty"`
+	// min_task_gas_fee is the smallest fee for which the task would run in the
+	// next block, assuming the tasks ahead use their whole gas limit, and
+	// min_gas_price the gas price it sets
+	MinTaskGasFee types.Coin    `protobuf:"bytes,10,opt,name=min_task_gas_fee,json=minTaskGasFee,proto3" json:"min_task_gas_fee"`
+	MinGasPrice   types.DecCoin `protobuf:"bytes,11,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+}
+
+func (m *QuerySimulateTaskResponse) Reset()         { *m = QuerySimulateTaskResponse{} }
+func (m *QuerySimulateTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateTaskResponse) ProtoMessage()    {}
+func (*QuerySimulateTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{19}
+}
+func (m *QuerySimulateTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QuerySimulateTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateTaskResponse.Merge(m, src)
+}
+func (m *QuerySimulateTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateTaskResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateTaskResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+func (m *QuerySimulateTaskResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	return n
+	return 0
 }
 
-func (m *QueryTasksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateTaskResponse) GetTaskGasLimit() uint64 {
+	if m != nil {
+		return m.TaskGasLimit
 	}
-	var l int
-	_ = l
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *QuerySimulateTaskResponse) GetMsgResults() []*any.Any {
+	if m != nil {
+		return m.MsgResults
+	}
+	return nil
+}
+
+func (m *QuerySimulateTaskResponse) GetErrorLog() string {
+	if m != nil {
+		return m.ErrorLog
+	}
+	return ""
+}
+
+func (m *QuerySimulateTaskResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *QuerySimulateTaskResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *QuerySimulateTaskResponse) GetQueueDepth() uint64 {
+	if m != nil {
+		return m.QueueDepth
+	}
+	return 0
+}
+
+func (m *QuerySimulateTaskResponse) GetQueuedGas() uint64 {
+	if m != nil {
+		return m.QueuedGas
+	}
+	return 0
+}
+
+func (m *QuerySimulateTaskResponse) GetMinTaskGasFee() types.Coin {
+	if m != nil {
+		return m.MinTaskGasFee
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulateTaskResponse) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+// QueryPayoutScheduleRequest is the request type for the Query/PayoutSchedule
+// RPC method
+type QueryPayoutScheduleRequest struct {
+	PayoutId uint64 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+}
+
+func (m *QueryPayoutScheduleRequest) Reset()         { *m = QueryPayoutScheduleRequest{} }
+func (m *QueryPayoutScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutScheduleRequest) ProtoMessage()    {}
+func (*QueryPayoutScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{20}
+}
+func (m *QueryPayoutScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryPayoutScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutScheduleRequest.Merge(m, src)
+}
+func (m *QueryPayoutScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayoutScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryPayoutScheduleRequest) GetPayoutId() uint64 {
+	if m != nil {
+		return m.PayoutId
 	}
-	return n
+	return 0
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryPayoutScheduleResponse is the response type for the
+// Query/PayoutSchedule RPC method
+type QueryPayoutScheduleResponse struct {
+	PayoutSchedule *PayoutSchedule `protobuf:"bytes,1,opt,name=payout_schedule,json=payoutSchedule,proto3" json:"payout_schedule,omitempty"`
+}
+
+func (m *QueryPayoutScheduleResponse) Reset()         { *m = QueryPayoutScheduleResponse{} }
+func (m *QueryPayoutScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutScheduleResponse) ProtoMessage()    {}
+func (*QueryPayoutScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{21}
+}
+func (m *QueryPayoutScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryPayoutScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutScheduleResponse.Merge(m, src)
+}
+func (m *QueryPayoutScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPayoutScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryPayoutScheduleResponse) GetPayoutSchedule() *PayoutSchedule {
+	if m != nil {
+		return m.PayoutSchedule
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryPayoutSchedulesByRecipientRequest is the request type for the
+// Query/PayoutSchedulesByRecipient RPC method
+type QueryPayoutSchedulesByRecipientRequest struct {
+	Recipient  string             `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPayoutSchedulesByRecipientRequest) Reset() {
+	*m = QueryPayoutSchedulesByRecipientRequest{}
+}
+func (m *QueryPayoutSchedulesByRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutSchedulesByRecipientRequest) ProtoMessage()    {}
+func (*QueryPayoutSchedulesByRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{22}
+}
+func (m *QueryPayoutSchedulesByRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutSchedulesByRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutSchedulesByRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayoutSchedulesByRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutSchedulesByRecipientRequest.Merge(m, src)
+}
+func (m *QueryPayoutSchedulesByRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutSchedulesByRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutSchedulesByRecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayoutSchedulesByRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryPayoutSchedulesByRecipientRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryPayoutSchedulesByRecipientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPayoutSchedulesByCreatorRequest is the request type for the
+// Query/PayoutSchedulesByCreator RPC method
+type QueryPayoutSchedulesByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPayoutSchedulesByCreatorRequest) Reset()         { *m = QueryPayoutSchedulesByCreatorRequest{} }
+func (m *QueryPayoutSchedulesByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutSchedulesByCreatorRequest) ProtoMessage()    {}
+func (*QueryPayoutSchedulesByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{23}
+}
+func (m *QueryPayoutSchedulesByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutSchedulesByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutSchedulesByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayoutSchedulesByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutSchedulesByCreatorRequest.Merge(m, src)
+}
+func (m *QueryPayoutSchedulesByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutSchedulesByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutSchedulesByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayoutSchedulesByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryPayoutSchedulesByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryPayoutSchedulesByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPayoutSchedulesResponse is the response type for payout schedule
+// queries with multiple results, ordered by ID
+type QueryPayoutSchedulesResponse struct {
+	PayoutSchedules []*PayoutSchedule   `protobuf:"bytes,1,rep,name=payout_schedules,json=payoutSchedules,proto3" json:"payout_schedules,omitempty"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPayoutSchedulesResponse) Reset()         { *m = QueryPayoutSchedulesResponse{} }
+func (m *QueryPayoutSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutSchedulesResponse) ProtoMessage()    {}
+func (*QueryPayoutSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31d3eefc3a09d7ff, []int{24}
+}
+func (m *QueryPayoutSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayoutSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutSchedulesResponse.Merge(m, src)
+}
+func (m *QueryPayoutSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayoutSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryPayoutSchedulesResponse) GetPayoutSchedules() []*PayoutSchedule {
+	if m != nil {
+		return m.PayoutSchedules
+	}
+	return nil
+}
+
+func (m *QueryPayoutSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryTaskByIDRequest)(nil), "dysonprotocol.crontask.v1.QueryTaskByIDRequest")
+	proto.RegisterType((*QueryTaskByIDResponse)(nil), "dysonprotocol.crontask.v1.QueryTaskByIDResponse")
+	proto.RegisterType((*QueryTasksByAddressRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByAddressRequest")
+	proto.RegisterType((*QueryTasksByWorkflowRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByWorkflowRequest")
+	proto.RegisterType((*QueryTasksByStatusTimestampRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByStatusTimestampRequest")
+	proto.RegisterType((*QueryTasksByStatusGasPriceRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByStatusGasPriceRequest")
+	proto.RegisterType((*QueryTasksResponse)(nil), "dysonprotocol.crontask.v1.QueryTasksResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "dysonprotocol.crontask.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dysonprotocol.crontask.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAllTasksRequest)(nil), "dysonprotocol.crontask.v1.QueryAllTasksRequest")
+	proto.RegisterType((*QueryTasksByFilterRequest)(nil), "dysonprotocol.crontask.v1.QueryTasksByFilterRequest")
+	proto.RegisterType((*QueryScheduleForecastRequest)(nil), "dysonprotocol.crontask.v1.QueryScheduleForecastRequest")
+	proto.RegisterType((*ForecastBucket)(nil), "dysonprotocol.crontask.v1.ForecastBucket")
+	proto.RegisterType((*QueryScheduleForecastResponse)(nil), "dysonprotocol.crontask.v1.QueryScheduleForecastResponse")
+	proto.RegisterType((*QueryTaskStatsRequest)(nil), "dysonprotocol.crontask.v1.QueryTaskStatsRequest")
+	proto.RegisterType((*StatusStats)(nil), "dysonprotocol.crontask.v1.StatusStats")
+	proto.RegisterType((*BlockGasEstimate)(nil), "dysonprotocol.crontask.v1.BlockGasEstimate")
+	proto.RegisterType((*QueryTaskStatsResponse)(nil), "dysonprotocol.crontask.v1.QueryTaskStatsResponse")
+	proto.RegisterType((*QuerySimulateTaskRequest)(nil), "dysonprotocol.crontask.v1.QuerySimulateTaskRequest")
+	proto.RegisterType((*QuerySimulateTaskResponse)(nil), "dysonprotocol.crontask.v1.QuerySimulateTaskResponse")
+	proto.RegisterType((*QueryPayoutScheduleRequest)(nil), "dysonprotocol.crontask.v1.QueryPayoutScheduleRequest")
+	proto.RegisterType((*QueryPayoutScheduleResponse)(nil), "dysonprotocol.crontask.v1.QueryPayoutScheduleResponse")
+	proto.RegisterType((*QueryPayoutSchedulesByRecipientRequest)(nil), "dysonprotocol.crontask.v1.QueryPayoutSchedulesByRecipientRequest")
+	proto.RegisterType((*QueryPayoutSchedulesByCreatorRequest)(nil), "dysonprotocol.crontask.v1.QueryPayoutSchedulesByCreatorRequest")
+	proto.RegisterType((*QueryPayoutSchedulesResponse)(nil), "dysonprotocol.crontask.v1.QueryPayoutSchedulesResponse")
+}
+
+func init() {
+	proto.RegisterFile("dysonprotocol/crontask/v1/query.proto", fileDescriptor_31d3eefc3a09d7ff)
+}
+
+var fileDescriptor_31d3eefc3a09d7ff = []byte{
+	// 1905 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xd9, 0xe3, 0x8f, 0x79, 0x8e, 0xc7, 0xa6, 0xd6, 0x1b, 0xc6, 0x93, 0xac, 0x93, 0x74,
+	0x36, 0xb6, 0x93, 0xdd, 0x4c, 0xaf, 0x9d, 0xef, 0x28, 0x68, 0xf1, 0x6c, 0x88, 0xb1, 0x36, 0x48,
+	0xde, 0x4e, 0x10, 0x68, 0x0f, 0x8c, 0xda, 0xdd, 0xe5, 0x71, 0xcb, 0xd3, 0x5d, 0x93, 0xae, 0xea,
+	0x64, 0x47, 0x96, 0x2f, 0x80, 0xc4, 0x11, 0xc4, 0xee, 0x01, 0xb8, 0x81, 0x84, 0xb4, 0xdc, 0xd0,
+	0x0a, 0x71, 0x40, 0x42, 0xe2, 0x82, 0x58, 0x89, 0xcb, 0x6a, 0xb9, 0xac, 0x38, 0x20, 0x94, 0x20,
+	0xf1, 0x1f, 0x20, 0x71, 0x43, 0xf5, 0xd1, 0x3d, 0xd3, 0xed, 0xf9, 0x6a, 0x27, 0x42, 0x7b, 0xb1,
+	0xa7, 0x5e, 0xbd, 0x57, 0xf5, 0xfb, 0xbd, 0xf7, 0xaa, 0xea, 0xbd, 0x86, 0x8b, 0x6e, 0x9b, 0xd1,
+	0xa0, 0x15, 0x52, 0x4e, 0x1d, 0xda, 0x34, 0x9d, 0x90, 0x06, 0xdc, 0x66, 0xfb, 0xe6, 0x93, 0x35,
+	0xf3, 0x71, 0x44, 0xc2, 0x76, 0x55, 0x4e, 0xe1, 0xc5, 0x94, 0x5a, 0x35, 0x56, 0xab, 0x3e, 0x59,
+	0xab, 0x9c, 0x69, 0x50, 0xda, 0x68, 0x12, 0xd3, 0x6e, 0x79, 0xa6, 0x1d, 0x04, 0x94, 0xdb, 0xdc,
+	0xa3, 0x01, 0x53, 0x86, 0x95, 0xcb, 0x0e, 0x65, 0x3e, 0x65, 0xe6, 0x8e, 0xcd, 0x88, 0x5a, 0xd1,
+	0x7c, 0xb2, 0xb6, 0x43, 0xb8, 0xbd, 0x66, 0xb6, 0xec, 0x86, 0x17, 0x48, 0x65, 0xad, 0xbb, 0xa8,
+	0x74, 0xeb, 0x72, 0x64, 0xaa, 0x81, 0x9e, 0x5a, 0xea, 0x5e, 0x26, 0x5e, 0xc0, 0xa1, 0x5e, 0x6c,
+	0xba, 0xda, 0x9f, 0x46, 0x82, 0x55, 0x69, 0x2e, 0xf7, 0xd7, 0x6c, 0xd9, 0x6d, 0x1a, 0xf1, 0x18,
+	0x8c, 0xa6, 0x25, 0x47, 0x3b, 0xd1, 0xae, 0x69, 0x07, 0xda, 0x19, 0x95, 0x85, 0x06, 0x6d, 0x50,
+	0x05, 0x52, 0xfc, 0xd2, 0xd2, 0xaf, 0xd8, 0xbe, 0x17, 0x50, 0x53, 0xfe, 0x55, 0x22, 0xc3, 0x84,
+	0x85, 0xf7, 0x04, 0xe5, 0x47, 0x36, 0xdb, 0xaf, 0xb5, 0xb7, 0xee, 0x59, 0xe4, 0x71, 0x44, 0x18,
+	0xc7, 0x5f, 0x85, 0x29, 0xb1, 0x67, 0xdd, 0x73, 0xcb, 0xe8, 0x1c, 0x5a, 0x2d, 0x58, 0x93, 0x62,
+	0xb8, 0xe5, 0x1a, 0x0f, 0xe0, 0xd5, 0x8c, 0x01, 0x6b, 0xd1, 0x80, 0x11, 0x7c, 0x15, 0x0a, 0x42,
+	0x45, 0xaa, 0xcf, 0xac, 0x9f, 0xad, 0xf6, 0x0d, 0x47, 0x55, 0x98, 0x5a, 0x52, 0xd9, 0xf8, 0x19,
+	0x82, 0x4a, 0xb2, 0x1c, 0xab, 0xb5, 0x37, 0x5c, 0x37, 0x24, 0x8c, 0xc5, 0x28, 0xd6, 0x61, 0xca,
+	0x09, 0x89, 0xcd, 0x69, 0x28, 0x97, 0x2d, 0xd6, 0xca, 0x9f, 0xff, 0xee, 0xca, 0x82, 0x76, 0xbb,
+	0xd6, 0x7d, 0xc8, 0x43, 0x2f, 0x68, 0x58, 0xb1, 0x22, 0xbe, 0x0f, 0xd0, 0x09, 0x5b, 0x79, 0x4c,
+	0xa2, 0x59, 0xae, 0x6a, 0x1b, 0x11, 0x9c, 0xaa, 0xca, 0x1a, 0x1d, 0xa2, 0xea, 0xb6, 0xdd, 0x20,
+	0x7a, 0x3f, 0xab, 0xcb, 0xd2, 0xf8, 0x03, 0x82, 0xd3, 0xdd, 0xd0, 0xbe, 0x43, 0xc3, 0xfd, 0xdd,
+	0x26, 0x7d, 0xfa, 0x22, 0xd8, 0xce, 0xc2, 0xcc, 0x53, 0xbd, 0x8c, 0xf0, 0xac, 0x00, 0x57, 0xb4,
+	0x20, 0x16, 0x6d, 0xb9, 0x19, 0xf0, 0xe3, 0xc7, 0x06, 0xff, 0x43, 0x04, 0x46, 0x37, 0xf8, 0x87,
+	0xdc, 0xe6, 0x11, 0x7b, 0xe4, 0xf9, 0x84, 0x71, 0xdb, 0x6f, 0xc5, 0x1c, 0x4e, 0xc1, 0x24, 0x93,
+	0x33, 0x8a, 0x82, 0xa5, 0x47, 0x2f, 0x0d, 0xc6, 0x0f, 0x10, 0x9c, 0x3f, 0x0a, 0x63, 0xd3, 0x66,
+	0xdb, 0xa1, 0xe7, 0x90, 0xff, 0x17, 0x8a, 0x8f, 0x10, 0xe0, 0x0e, 0x8a, 0x24, 0x61, 0xaf, 0xc3,
+	0x84, 0xc8, 0x41, 0xb1, 0xeb, 0xf8, 0x28, 0x19, 0xab, 0xb4, 0xf1, 0x66, 0x8f, 0xfc, 0x5a, 0x19,
+	0x8a, 0x4a, 0xed, 0x99, 0x82, 0xb5, 0xa0, 0x51, 0x6d, 0xdb, 0xa1, 0xed, 0xc7, 0x29, 0x6f, 0x6c,
+	0xc3, 0x2b, 0x29, 0xa9, 0x06, 0x7b, 0x1b, 0x26, 0x5b, 0x52, 0xa2, 0xcf, 0xd7, 0xf9, 0x01, 0x68,
+	0xb5, 0xa9, 0x36, 0x30, 0xbe, 0xa7, 0x8f, 0xf8, 0x46, 0xb3, 0xa9, 0x1d, 0xa0, 0xdc, 0x9e, 0x76,
+	0x2f, 0x3a, 0xb6, 0x7b, 0xff, 0x8b, 0x60, 0xb1, 0x3b, 0xc8, 0xf7, 0xbd, 0x26, 0x27, 0xe1, 0x8b,
+	0x1c, 0x93, 0x4e, 0x42, 0x8c, 0xa5, 0x12, 0xe2, 0x22, 0x94, 0x76, 0x43, 0xea, 0xd7, 0x79, 0x9c,
+	0xc7, 0x32, 0x29, 0xc6, 0xad, 0x59, 0x21, 0x4d, 0x92, 0x1b, 0x9f, 0x87, 0x93, 0x9c, 0x76, 0x29,
+	0x15, 0xa4, 0xd2, 0x0c, 0xa7, 0x1d, 0x95, 0x34, 0xf7, 0x89, 0x63, 0x73, 0xff, 0x10, 0xc1, 0x19,
+	0xc9, 0xfd, 0xa1, 0xb3, 0x47, 0xdc, 0xa8, 0x49, 0xee, 0xd3, 0x90, 0x38, 0x36, 0xe3, 0x31, 0xfd,
+	0xa3, 0x90, 0x51, 0x2f, 0xc8, 0x17, 0xa1, 0xf4, 0xd4, 0x0b, 0x5c, 0xfa, 0xb4, 0xce, 0x88, 0x43,
+	0x03, 0x57, 0x31, 0x1f, 0xb7, 0x66, 0x95, 0xf4, 0xa1, 0x12, 0x0a, 0xb5, 0x9d, 0xc8, 0xd9, 0x27,
+	0x3c, 0x51, 0xd3, 0x0e, 0x50, 0x52, 0xad, 0x66, 0xfc, 0x18, 0x41, 0x29, 0x06, 0x52, 0x93, 0x33,
+	0x78, 0x05, 0xe6, 0x18, 0xb7, 0x43, 0x7e, 0x04, 0x48, 0x49, 0x8a, 0x3b, 0x48, 0x2e, 0xc0, 0x2c,
+	0x09, 0xdc, 0x2e, 0x35, 0x05, 0xe4, 0x24, 0x09, 0xdc, 0x8e, 0x12, 0x86, 0x42, 0x18, 0x05, 0x6a,
+	0xf7, 0x82, 0x25, 0x7f, 0xe3, 0xd3, 0x50, 0x6c, 0xd8, 0xac, 0xde, 0xf4, 0x7c, 0x8f, 0x4b, 0x97,
+	0x17, 0xac, 0xe9, 0x86, 0xcd, 0x1e, 0x88, 0xb1, 0xf1, 0xf3, 0x31, 0x78, 0xad, 0x8f, 0x9f, 0x74,
+	0x82, 0x8f, 0xe8, 0xa8, 0x6c, 0x6c, 0xc7, 0x8e, 0xc6, 0x76, 0x0b, 0xa6, 0x94, 0x3b, 0x04, 0x3e,
+	0x71, 0xb2, 0x2f, 0x0d, 0x38, 0x2b, 0x69, 0x37, 0xd5, 0x0a, 0x9f, 0xfe, 0xe3, 0xec, 0x09, 0x2b,
+	0xb6, 0xc7, 0xaf, 0x01, 0x70, 0xca, 0xed, 0x66, 0x5d, 0xb2, 0x55, 0xa4, 0x8a, 0x52, 0x62, 0x09,
+	0xca, 0xcb, 0x30, 0xa7, 0xa6, 0x3b, 0xc4, 0x27, 0xa4, 0xce, 0xac, 0x14, 0x6f, 0x6a, 0xf6, 0xf8,
+	0x0c, 0x14, 0x79, 0x18, 0x05, 0x8e, 0xcd, 0x89, 0x5b, 0x9e, 0x3c, 0x87, 0x56, 0xa7, 0xad, 0x8e,
+	0xc0, 0x08, 0xba, 0x5e, 0x54, 0x71, 0x41, 0x26, 0x07, 0x74, 0x05, 0xe6, 0xa2, 0x96, 0x43, 0x7d,
+	0x2f, 0x68, 0xd4, 0x77, 0x9a, 0xd4, 0xd9, 0x57, 0x87, 0x7f, 0xd6, 0x2a, 0xc5, 0xe2, 0x9a, 0x94,
+	0xe2, 0x37, 0x01, 0xcb, 0x79, 0xe9, 0x97, 0x4c, 0x06, 0xcd, 0xcb, 0x19, 0xe1, 0x9d, 0x38, 0x3b,
+	0xbe, 0x0b, 0x33, 0xea, 0x1e, 0x96, 0x9b, 0xf5, 0xbd, 0x7d, 0x17, 0x60, 0xc2, 0xa1, 0x51, 0xc0,
+	0xe5, 0x3a, 0x05, 0x4b, 0x0d, 0xd2, 0x51, 0x1e, 0xcf, 0x44, 0xf9, 0x00, 0xe6, 0x25, 0xa2, 0x4d,
+	0x9b, 0x7d, 0x83, 0x71, 0xcf, 0xb7, 0x39, 0x11, 0xcb, 0xef, 0x11, 0xaf, 0xb1, 0xc7, 0x75, 0x3c,
+	0xf5, 0x48, 0xfa, 0x24, 0x13, 0xc5, 0x8e, 0x40, 0x6c, 0xae, 0xee, 0x66, 0xb5, 0x85, 0xbe, 0x7a,
+	0x07, 0xa6, 0xd8, 0x8f, 0xc6, 0xe0, 0x54, 0xd6, 0x8f, 0x3a, 0xb7, 0xbe, 0x09, 0xd3, 0x8a, 0x14,
+	0x89, 0x2f, 0xfb, 0xe5, 0x01, 0x29, 0xd1, 0xe5, 0x1c, 0x9d, 0x0f, 0x89, 0xb5, 0x78, 0xc0, 0x55,
+	0xc4, 0x15, 0x3a, 0xe5, 0x1a, 0x95, 0x23, 0xf2, 0xf6, 0xc3, 0xef, 0x1f, 0x8d, 0x99, 0x4a, 0xc2,
+	0x37, 0x06, 0xec, 0x98, 0x75, 0x9a, 0xde, 0x36, 0x1b, 0xe6, 0x65, 0x98, 0x53, 0x61, 0xce, 0x3a,
+	0x61, 0x76, 0x47, 0x2f, 0xa0, 0x3c, 0xf1, 0x17, 0x04, 0x65, 0x75, 0xd8, 0x3c, 0x3f, 0x6a, 0xda,
+	0x9c, 0xc8, 0xe7, 0xeb, 0x05, 0xee, 0xe3, 0x55, 0x28, 0xf8, 0xac, 0x21, 0xe8, 0x0a, 0x26, 0x0b,
+	0x55, 0x55, 0x77, 0x56, 0xe3, 0xba, 0xb3, 0xba, 0x11, 0xb4, 0x2d, 0xa9, 0x81, 0x5f, 0x87, 0x92,
+	0x2c, 0x1b, 0xb3, 0x39, 0x72, 0x52, 0x48, 0x93, 0xf3, 0xb0, 0x02, 0x73, 0xe4, 0x03, 0xe2, 0x44,
+	0xe2, 0x0a, 0xad, 0xb7, 0xf6, 0x6c, 0x46, 0x24, 0x91, 0xa2, 0x55, 0x4a, 0xc4, 0xdb, 0x42, 0x6a,
+	0x7c, 0x31, 0xae, 0x9f, 0x96, 0x34, 0x13, 0x1d, 0xd6, 0x32, 0x4c, 0xb1, 0xc8, 0x71, 0x08, 0x53,
+	0xa9, 0x3b, 0x6d, 0xc5, 0x43, 0xbc, 0x08, 0x22, 0x2f, 0xea, 0x11, 0x23, 0xae, 0x8e, 0xd1, 0x54,
+	0xc3, 0x66, 0xdf, 0x66, 0xc4, 0x1d, 0x11, 0xe1, 0x75, 0x98, 0xf1, 0x59, 0xa3, 0x1e, 0x12, 0x16,
+	0x35, 0xb9, 0x38, 0xf9, 0xfd, 0x89, 0x83, 0xcf, 0x1a, 0x96, 0xd2, 0x13, 0x09, 0x4a, 0xc2, 0x90,
+	0x86, 0xf5, 0x26, 0x6d, 0xc8, 0xab, 0xa0, 0x68, 0x4d, 0x4b, 0xc1, 0x03, 0xda, 0x10, 0x19, 0xef,
+	0x50, 0x97, 0xb0, 0x96, 0xed, 0x10, 0x79, 0x0b, 0x14, 0xad, 0x8e, 0x40, 0x5c, 0xa9, 0x62, 0x50,
+	0x9e, 0x92, 0x27, 0x5c, 0xfe, 0x16, 0xd9, 0xf6, 0x38, 0x22, 0x11, 0xa9, 0xbb, 0xa4, 0xc5, 0xf7,
+	0xca, 0xd3, 0x2a, 0xdb, 0xa4, 0xe8, 0x9e, 0x90, 0x88, 0xfb, 0x49, 0x8e, 0x5c, 0x41, 0xa7, 0x5c,
+	0x54, 0xf7, 0x93, 0x92, 0x6c, 0xda, 0x0c, 0x7f, 0x0b, 0xe6, 0x7d, 0x2f, 0xa8, 0x27, 0x7c, 0x77,
+	0x09, 0x29, 0x83, 0x7c, 0xeb, 0x16, 0x53, 0x6f, 0x5d, 0xfc, 0xca, 0xbd, 0x43, 0xbd, 0xa0, 0x56,
+	0x14, 0xb9, 0xf7, 0xf1, 0xbf, 0x7f, 0x7b, 0x19, 0x59, 0xb3, 0xbe, 0x17, 0x3c, 0x52, 0x6e, 0xb9,
+	0x4f, 0x08, 0x7e, 0x17, 0x84, 0x40, 0xae, 0xd4, 0x12, 0xf5, 0x5b, 0x79, 0x46, 0xae, 0x75, 0xa6,
+	0xe7, 0x5a, 0xf7, 0x88, 0x93, 0x5d, 0x6e, 0xc6, 0xf7, 0x82, 0xb8, 0xf6, 0x33, 0x6e, 0xeb, 0xc2,
+	0x7f, 0x5b, 0x76, 0x34, 0xf1, 0xb3, 0x10, 0x67, 0xe9, 0x69, 0x28, 0xaa, 0x56, 0xa7, 0xd3, 0x80,
+	0x4c, 0x2b, 0xc1, 0x96, 0x6b, 0x3c, 0xd6, 0x85, 0x79, 0xd6, 0x54, 0xa7, 0x85, 0x05, 0x73, 0xda,
+	0x96, 0xe9, 0x29, 0x5d, 0xdc, 0x5c, 0x1a, 0x58, 0x33, 0xa5, 0xd6, 0x2a, 0xb5, 0x52, 0x63, 0xe3,
+	0x63, 0x04, 0xcb, 0x3d, 0xf6, 0x64, 0xb5, 0xb6, 0x45, 0x1c, 0xaf, 0xe5, 0x91, 0x20, 0x79, 0xf1,
+	0x6f, 0x40, 0x31, 0x8c, 0x65, 0x43, 0x8f, 0x58, 0x47, 0xf5, 0xa5, 0xf5, 0x2d, 0xbf, 0x42, 0xf0,
+	0x7a, 0x6f, 0xa8, 0xef, 0xa8, 0xe3, 0xfc, 0x65, 0x68, 0xae, 0xfe, 0x18, 0xd7, 0x4d, 0x19, 0x90,
+	0x49, 0x10, 0x1f, 0xc1, 0x7c, 0x26, 0x88, 0xf1, 0xd5, 0x9d, 0x23, 0x8a, 0x73, 0xe9, 0x28, 0xbe,
+	0xbc, 0xda, 0x7d, 0xfd, 0x3f, 0xaf, 0xc0, 0x84, 0xc4, 0x8f, 0x7f, 0x89, 0x60, 0x3a, 0xee, 0x85,
+	0xb1, 0x39, 0x00, 0x5b, 0xaf, 0x36, 0xbb, 0xf2, 0xd6, 0xe8, 0x06, 0x0a, 0x85, 0xb1, 0xfe, 0xfd,
+	0xbf, 0xfd, 0xeb, 0xc3, 0xb1, 0x37, 0xf1, 0x65, 0xb3, 0xff, 0x57, 0x02, 0xf9, 0x38, 0x99, 0x07,
+	0xba, 0x81, 0x3f, 0xc4, 0x9f, 0x20, 0x28, 0xa5, 0x1b, 0x6c, 0x7c, 0x7d, 0x94, 0x8d, 0x8f, 0x34,
+	0xe4, 0x95, 0x2b, 0x23, 0x99, 0x25, 0x60, 0xef, 0x48, 0xb0, 0xd7, 0xf0, 0xfa, 0x50, 0xb0, 0x3a,
+	0xc1, 0xcc, 0x03, 0xfd, 0xe3, 0x10, 0xff, 0x09, 0xc1, 0xa9, 0xde, 0xdd, 0x2b, 0xfe, 0xda, 0x88,
+	0xe0, 0x7b, 0x77, 0xbd, 0x79, 0x49, 0xdc, 0x92, 0x24, 0xd6, 0xf1, 0x5b, 0x43, 0x49, 0xa8, 0x32,
+	0xc1, 0x3c, 0x50, 0xff, 0x0f, 0xf1, 0x9f, 0x11, 0xbc, 0xda, 0xb3, 0xf3, 0xc5, 0x77, 0x73, 0x31,
+	0xc8, 0x34, 0xcc, 0x79, 0x09, 0xbc, 0x2d, 0x09, 0xdc, 0xc6, 0x37, 0xf3, 0x12, 0x30, 0x77, 0xda,
+	0xe2, 0xb6, 0xc7, 0x1f, 0xe9, 0x1c, 0x67, 0x1b, 0xcd, 0xe6, 0xf0, 0x1c, 0xcf, 0xf4, 0x99, 0x79,
+	0xd1, 0xae, 0x4a, 0xb4, 0x06, 0x3e, 0x37, 0x0c, 0x2d, 0xfe, 0x2b, 0x82, 0xb9, 0xcc, 0xc7, 0x19,
+	0x7c, 0x63, 0x44, 0xc7, 0x66, 0xbe, 0xe6, 0xe4, 0x05, 0xf9, 0x9e, 0x04, 0xf9, 0x2e, 0xde, 0xca,
+	0x9f, 0xd8, 0x66, 0xfc, 0xb9, 0xc7, 0x3c, 0xe8, 0xfa, 0x16, 0x74, 0x88, 0x7f, 0x8d, 0x60, 0x36,
+	0xd5, 0x41, 0xe3, 0x6b, 0x23, 0x72, 0x49, 0x35, 0xdc, 0x79, 0x99, 0x98, 0x92, 0xc9, 0x25, 0xbc,
+	0x32, 0x94, 0xc9, 0xae, 0x42, 0xf5, 0x09, 0x82, 0xf9, 0x6c, 0x17, 0x87, 0x6f, 0x0e, 0xdb, 0xb4,
+	0x4f, 0x7f, 0x5c, 0xb9, 0x95, 0xdf, 0x50, 0x03, 0x7f, 0x43, 0x02, 0xbf, 0x88, 0x2f, 0x0c, 0x00,
+	0xbe, 0x1b, 0xe3, 0xfb, 0x05, 0x82, 0x62, 0xd2, 0x17, 0xe0, 0x91, 0x6e, 0xdd, 0xee, 0x56, 0xac,
+	0xb2, 0x96, 0xc3, 0x22, 0x47, 0x1e, 0x33, 0x09, 0xe7, 0x37, 0x08, 0x4e, 0x76, 0x17, 0xb8, 0xf8,
+	0xea, 0x50, 0xa7, 0x1c, 0x2d, 0xec, 0x2b, 0xd7, 0xf2, 0x19, 0x69, 0x94, 0x55, 0x89, 0x72, 0xf5,
+	0x0e, 0xba, 0x6c, 0x0c, 0x72, 0x24, 0xd3, 0xb6, 0xf8, 0xf7, 0x08, 0x4a, 0xe9, 0x57, 0x76, 0xf8,
+	0x53, 0xd2, 0xb3, 0xc4, 0xab, 0xdc, 0xc8, 0x6b, 0xa6, 0x11, 0x5f, 0x97, 0x88, 0x4d, 0x7c, 0xc5,
+	0x1c, 0xf6, 0x99, 0xdc, 0x3c, 0x48, 0x6a, 0xc8, 0x43, 0xfc, 0x77, 0x04, 0x95, 0xfe, 0xc5, 0x1b,
+	0xde, 0xc8, 0x87, 0xa6, 0x47, 0xe1, 0x57, 0xb9, 0x99, 0x73, 0x89, 0x84, 0xd1, 0xd7, 0x25, 0xa3,
+	0x3b, 0xf8, 0xd6, 0x50, 0x46, 0xcc, 0x4c, 0xea, 0x45, 0xf3, 0x20, 0xf9, 0x79, 0x88, 0x3f, 0x47,
+	0x50, 0xee, 0x57, 0xee, 0xe1, 0xb7, 0x73, 0x53, 0x4b, 0x17, 0x8a, 0xc7, 0x27, 0x76, 0x57, 0x12,
+	0xbb, 0x81, 0xaf, 0x8d, 0x40, 0xec, 0x68, 0x01, 0xf0, 0x53, 0x04, 0x93, 0xea, 0x53, 0x26, 0xbe,
+	0x32, 0x1c, 0x41, 0xd7, 0x37, 0xd4, 0x4a, 0x75, 0x54, 0x75, 0x8d, 0xf3, 0x92, 0xc4, 0x79, 0x01,
+	0x9f, 0x1f, 0x88, 0x53, 0x98, 0xd4, 0xee, 0x7e, 0xfa, 0x6c, 0x09, 0x7d, 0xf6, 0x6c, 0x09, 0xfd,
+	0xf3, 0xd9, 0x12, 0xfa, 0xc9, 0xf3, 0xa5, 0x13, 0x9f, 0x3d, 0x5f, 0x3a, 0xf1, 0xc5, 0xf3, 0xa5,
+	0x13, 0xef, 0x1b, 0x99, 0x3d, 0xa9, 0x6f, 0x7e, 0xd0, 0x59, 0x81, 0xb7, 0x5b, 0x84, 0xed, 0x4c,
+	0xca, 0xe9, 0xab, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x48, 0x9a, 0xd6, 0xb2, 0xd3, 0x1a, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// TaskByID returns a task by its ID
+	TaskByID(ctx context.Context, in *QueryTaskByIDRequest, opts ...grpc.CallOption) (*QueryTaskByIDResponse, error)
+	// TasksByAddress returns all tasks created by a specific address
+	TasksByAddress(ctx context.Context, in *QueryTasksByAddressRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// TasksByStatusTimestamp returns tasks filtered by status and ordered by
+	// timestamp
+	TasksByStatusTimestamp(ctx context.Context, in *QueryTasksByStatusTimestampRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// TasksByStatusGasPrice returns tasks filtered by status and ordered by gas
+	// price
+	TasksByStatusGasPrice(ctx context.Context, in *QueryTasksByStatusGasPriceRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// TasksAll returns all tasks ordered by ID
+	TasksAll(ctx context.Context, in *QueryAllTasksRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// TasksByWorkflow returns the tasks of a workflow ordered by ID
+	TasksByWorkflow(ctx context.Context, in *QueryTasksByWorkflowRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// TasksByFilter returns the tasks matching a creator, a status and a due time range,
+	// ordered by due time when a status is given and by ID otherwise
+	TasksByFilter(ctx context.Context, in *QueryTasksByFilterRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error)
+	// ScheduleForecast returns the runs and gas of the scheduled and pending tasks in
+	// time buckets of an upcoming window
+	ScheduleForecast(ctx context.Context, in *QueryScheduleForecastRequest, opts ...grpc.CallOption) (*QueryScheduleForecastResponse, error)
+	// TaskStats returns the number of tasks per status and the gas scheduled in the
+	// upcoming blocks
+	TaskStats(ctx context.Context, in *QueryTaskStatsRequest, opts ...grpc.CallOption) (*QueryTaskStatsResponse, error)
+	// SimulateTask runs the messages of a proposed task against the current state
+	// without saving it, and returns the gas it uses and the fee it needs to run in
+	// the next block at the current queue depth
+	SimulateTask(ctx context.Context, in *QuerySimulateTaskRequest, opts ...grpc.CallOption) (*QuerySimulateTaskResponse, error)
+	// PayoutSchedule returns a payout schedule by ID
+	PayoutSchedule(ctx context.Context, in *QueryPayoutScheduleRequest, opts ...grpc.CallOption) (*QueryPayoutScheduleResponse, error)
+	// PayoutSchedulesByRecipient returns the payout schedules paying an address
+	PayoutSchedulesByRecipient(ctx context.Context, in *QueryPayoutSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error)
+	// PayoutSchedulesByCreator returns the payout schedules funded by an address
+	PayoutSchedulesByCreator(ctx context.Context, in *QueryPayoutSchedulesByCreatorRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error)
+	// Params returns the module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TaskByID(ctx context.Context, in *QueryTaskByIDRequest, opts ...grpc.CallOption) (*QueryTaskByIDResponse, error) {
+	out := new(QueryTaskByIDResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TaskByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByAddress(ctx context.Context, in *QueryTasksByAddressRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByStatusTimestamp(ctx context.Context, in *QueryTasksByStatusTimestampRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByStatusTimestamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByStatusGasPrice(ctx context.Context, in *QueryTasksByStatusGasPriceRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByStatusGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksAll(ctx context.Context, in *QueryAllTasksRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByWorkflow(ctx context.Context, in *QueryTasksByWorkflowRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TasksByFilter(ctx context.Context, in *QueryTasksByFilterRequest, opts ...grpc.CallOption) (*QueryTasksResponse, error) {
+	out := new(QueryTasksResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TasksByFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ScheduleForecast(ctx context.Context, in *QueryScheduleForecastRequest, opts ...grpc.CallOption) (*QueryScheduleForecastResponse, error) {
+	out := new(QueryScheduleForecastResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/ScheduleForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaskStats(ctx context.Context, in *QueryTaskStatsRequest, opts ...grpc.CallOption) (*QueryTaskStatsResponse, error) {
+	out := new(QueryTaskStatsResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/TaskStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateTask(ctx context.Context, in *QuerySimulateTaskRequest, opts ...grpc.CallOption) (*QuerySimulateTaskResponse, error) {
+	out := new(QuerySimulateTaskResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/SimulateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutSchedule(ctx context.Context, in *QueryPayoutScheduleRequest, opts ...grpc.CallOption) (*QueryPayoutScheduleResponse, error) {
+	out := new(QueryPayoutScheduleResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/PayoutSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutSchedulesByRecipient(ctx context.Context, in *QueryPayoutSchedulesByRecipientRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error) {
+	out := new(QueryPayoutSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/PayoutSchedulesByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutSchedulesByCreator(ctx context.Context, in *QueryPayoutSchedulesByCreatorRequest, opts ...grpc.CallOption) (*QueryPayoutSchedulesResponse, error) {
+	out := new(QueryPayoutSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/PayoutSchedulesByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// TaskByID returns a task by its ID
+	TaskByID(context.Context, *QueryTaskByIDRequest) (*QueryTaskByIDResponse, error)
+	// TasksByAddress returns all tasks created by a specific address
+	TasksByAddress(context.Context, *QueryTasksByAddressRequest) (*QueryTasksResponse, error)
+	// TasksByStatusTimestamp returns tasks filtered by status and ordered by
+	// timestamp
+	TasksByStatusTimestamp(context.Context, *QueryTasksByStatusTimestampRequest) (*QueryTasksResponse, error)
+	// TasksByStatusGasPrice returns tasks filtered by status and ordered by gas
+	// price
+	TasksByStatusGasPrice(context.Context, *QueryTasksByStatusGasPriceRequest) (*QueryTasksResponse, error)
+	// TasksAll returns all tasks ordered by ID
+	TasksAll(context.Context, *QueryAllTasksRequest) (*QueryTasksResponse, error)
+	// TasksByWorkflow returns the tasks of a workflow ordered by ID
+	TasksByWorkflow(context.Context, *QueryTasksByWorkflowRequest) (*QueryTasksResponse, error)
+	// TasksByFilter returns the tasks matching a creator, a status and a due time range,
+	// ordered by due time when a status is given and by ID otherwise
+	TasksByFilter(context.Context, *QueryTasksByFilterRequest) (*QueryTasksResponse, error)
+	// ScheduleForecast returns the runs and gas of the scheduled and pending tasks in
+	// time buckets of an upcoming window
+	ScheduleForecast(context.Context, *QueryScheduleForecastRequest) (*QueryScheduleForecastResponse, error)
+	// TaskStats returns the number of tasks per status and the gas scheduled in the
+	// upcoming blocks
+	TaskStats(context.Context, *QueryTaskStatsRequest) (*QueryTaskStatsResponse, error)
+	// SimulateTask runs the messages of a proposed task against the current state
+	// without saving it, and returns the gas it uses and the fee it needs to run in
+	// the next block at the current queue depth
+	SimulateTask(context.Context, *QuerySimulateTaskRequest) (*QuerySimulateTaskResponse, error)
+	// PayoutSchedule returns a payout schedule by ID
+	PayoutSchedule(context.Context, *QueryPayoutScheduleRequest) (*QueryPayoutScheduleResponse, error)
+	// PayoutSchedulesByRecipient returns the payout schedules paying an address
+	PayoutSchedulesByRecipient(context.Context, *QueryPayoutSchedulesByRecipientRequest) (*QueryPayoutSchedulesResponse, error)
+	// PayoutSchedulesByCreator returns the payout schedules funded by an address
+	PayoutSchedulesByCreator(context.Context, *QueryPayoutSchedulesByCreatorRequest) (*QueryPayoutSchedulesResponse, error)
+	// Params returns the module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TaskByID(ctx context.Context, req *QueryTaskByIDRequest) (*QueryTaskByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskByID not implemented")
+}
+func (*UnimplementedQueryServer) TasksByAddress(ctx context.Context, req *QueryTasksByAddressRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByAddress not implemented")
+}
+func (*UnimplementedQueryServer) TasksByStatusTimestamp(ctx context.Context, req *QueryTasksByStatusTimestampRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByStatusTimestamp not implemented")
+}
+func (*UnimplementedQueryServer) TasksByStatusGasPrice(ctx context.Context, req *QueryTasksByStatusGasPriceRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByStatusGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) TasksAll(ctx context.Context, req *QueryAllTasksRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksAll not implemented")
+}
+func (*UnimplementedQueryServer) TasksByWorkflow(ctx context.Context, req *QueryTasksByWorkflowRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByWorkflow not implemented")
+}
+func (*UnimplementedQueryServer) TasksByFilter(ctx context.Context, req *QueryTasksByFilterRequest) (*QueryTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TasksByFilter not implemented")
+}
+func (*UnimplementedQueryServer) ScheduleForecast(ctx context.Context, req *QueryScheduleForecastRequest) (*QueryScheduleForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleForecast not implemented")
+}
+func (*UnimplementedQueryServer) TaskStats(ctx context.Context, req *QueryTaskStatsRequest) (*QueryTaskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskStats not implemented")
+}
+func (*UnimplementedQueryServer) SimulateTask(ctx context.Context, req *QuerySimulateTaskRequest) (*QuerySimulateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTask not implemented")
+}
+func (*UnimplementedQueryServer) PayoutSchedule(ctx context.Context, req *QueryPayoutScheduleRequest) (*QueryPayoutScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutSchedule not implemented")
+}
+func (*UnimplementedQueryServer) PayoutSchedulesByRecipient(ctx context.Context, req *QueryPayoutSchedulesByRecipientRequest) (*QueryPayoutSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutSchedulesByRecipient not implemented")
+}
+func (*UnimplementedQueryServer) PayoutSchedulesByCreator(ctx context.Context, req *QueryPayoutSchedulesByCreatorRequest) (*QueryPayoutSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutSchedulesByCreator not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TaskByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaskByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TaskByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaskByID(ctx, req.(*QueryTaskByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByAddress(ctx, req.(*QueryTasksByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByStatusTimestamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByStatusTimestampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByStatusTimestamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByStatusTimestamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByStatusTimestamp(ctx, req.(*QueryTasksByStatusTimestampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByStatusGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByStatusGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByStatusGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByStatusGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByStatusGasPrice(ctx, req.(*QueryTasksByStatusGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksAll(ctx, req.(*QueryAllTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByWorkflow(ctx, req.(*QueryTasksByWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TasksByFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTasksByFilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TasksByFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TasksByFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TasksByFilter(ctx, req.(*QueryTasksByFilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ScheduleForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduleForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/ScheduleForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduleForecast(ctx, req.(*QueryScheduleForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/TaskStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaskStats(ctx, req.(*QueryTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/SimulateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateTask(ctx, req.(*QuerySimulateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/PayoutSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutSchedule(ctx, req.(*QueryPayoutScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutSchedulesByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutSchedulesByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutSchedulesByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/PayoutSchedulesByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutSchedulesByRecipient(ctx, req.(*QueryPayoutSchedulesByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutSchedulesByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutSchedulesByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutSchedulesByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/PayoutSchedulesByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutSchedulesByCreator(ctx, req.(*QueryPayoutSchedulesByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dysonprotocol.crontask.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TaskByID",
+			Handler:    _Query_TaskByID_Handler,
+		},
+		{
+			MethodName: "TasksByAddress",
+			Handler:    _Query_TasksByAddress_Handler,
+		},
+		{
+			MethodName: "TasksByStatusTimestamp",
+			Handler:    _Query_TasksByStatusTimestamp_Handler,
+		},
+		{
+			MethodName: "TasksByStatusGasPrice",
+			Handler:    _Query_TasksByStatusGasPrice_Handler,
+		},
+		{
+			MethodName: "TasksAll",
+			Handler:    _Query_TasksAll_Handler,
+		},
+		{
+			MethodName: "TasksByWorkflow",
+			Handler:    _Query_TasksByWorkflow_Handler,
+		},
+		{
+			MethodName: "TasksByFilter",
+			Handler:    _Query_TasksByFilter_Handler,
+		},
+		{
+			MethodName: "ScheduleForecast",
+			Handler:    _Query_ScheduleForecast_Handler,
+		},
+		{
+			MethodName: "TaskStats",
+			Handler:    _Query_TaskStats_Handler,
+		},
+		{
+			MethodName: "SimulateTask",
+			Handler:    _Query_SimulateTask_Handler,
+		},
+		{
+			MethodName: "PayoutSchedule",
+			Handler:    _Query_PayoutSchedule_Handler,
+		},
+		{
+			MethodName: "PayoutSchedulesByRecipient",
+			Handler:    _Query_PayoutSchedulesByRecipient_Handler,
+		},
+		{
+			MethodName: "PayoutSchedulesByCreator",
+			Handler:    _Query_PayoutSchedulesByCreator_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dysonprotocol/crontask/v1/query.proto",
+}
+
+func (m *QueryTaskByIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskByIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskByIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskByIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskByIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskByIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByStatusTimestampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByStatusTimestampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByStatusTimestampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByStatusGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByStatusGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByStatusGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTasksByFilterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTasksByFilterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTasksByFilterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleForecastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleForecastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleForecastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BucketSeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForecastBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForecastBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForecastBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Runs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Runs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EndTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleForecastResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleForecastResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleForecastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalRuns != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalRuns))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTimeSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.UpcomingBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpcomingBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StatusStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockGasEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockGasEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Tasks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Tasks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.UpcomingBlocks) > 0 {
+		for iNdEx := len(m.UpcomingBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpcomingBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TotalTasks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalTasks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutionPhase) > 0 {
+		i -= len(m.ExecutionPhase)
+		copy(dAtA[i:], m.ExecutionPhase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExecutionPhase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TaskGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size, err := m.MinTaskGasFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.QueuedGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueuedGas))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.QueueDepth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.QueueDepth))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ErrorLog) > 0 {
+		i -= len(m.ErrorLog)
+		copy(dAtA[i:], m.ErrorLog)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ErrorLog)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgResults) > 0 {
+		for iNdEx := len(m.MsgResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.TaskGasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TaskGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayoutId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PayoutId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayoutSchedule != nil {
+		{
+			size, err := m.PayoutSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutSchedulesByRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutSchedulesByRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutSchedulesByRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutSchedulesByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutSchedulesByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutSchedulesByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PayoutSchedules) > 0 {
+		for iNdEx := len(m.PayoutSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PayoutSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryTaskByIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovQuery(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *QueryTaskByIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByStatusTimestampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByStatusGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTasksByFilterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.FromTimestamp))
+	}
+	if m.ToTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.ToTimestamp))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleForecastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.FromTimestamp))
+	}
+	if m.WindowSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.WindowSeconds))
+	}
+	if m.BucketSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.BucketSeconds))
+	}
+	return n
+}
+
+func (m *ForecastBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.StartTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.EndTimestamp))
+	}
+	if m.Runs != 0 {
+		n += 1 + sovQuery(uint64(m.Runs))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryScheduleForecastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.FromTimestamp))
+	}
+	if m.ToTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.ToTimestamp))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalRuns != 0 {
+		n += 1 + sovQuery(uint64(m.TotalRuns))
+	}
+	if m.TotalGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGasLimit))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTaskStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpcomingBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.UpcomingBlocks))
+	}
+	if m.BlockTimeSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTimeSeconds))
+	}
+	return n
+}
+
+func (m *StatusStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *BlockGasEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovQuery(uint64(m.Timestamp))
+	}
+	if m.Tasks != 0 {
+		n += 1 + sovQuery(uint64(m.Tasks))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryTaskStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalTasks != 0 {
+		n += 1 + sovQuery(uint64(m.TotalTasks))
+	}
+	if len(m.UpcomingBlocks) > 0 {
+		for _, e := range m.UpcomingBlocks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.BlockGasLimit))
+	}
+	return n
+}
+
+func (m *QuerySimulateTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TaskGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.TaskGasLimit))
+	}
+	l = len(m.ExecutionPhase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.TaskGasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.TaskGasLimit))
+	}
+	if len(m.MsgResults) > 0 {
+		for _, e := range m.MsgResults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ErrorLog)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	if m.QueueDepth != 0 {
+		n += 1 + sovQuery(uint64(m.QueueDepth))
+	}
+	if m.QueuedGas != 0 {
+		n += 1 + sovQuery(uint64(m.QueuedGas))
+	}
+	l = m.MinTaskGasFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPayoutScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PayoutId != 0 {
+		n += 1 + sovQuery(uint64(m.PayoutId))
+	}
+	return n
+}
+
+func (m *QueryPayoutScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PayoutSchedule != nil {
+		l = m.PayoutSchedule.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutSchedulesByRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutSchedulesByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PayoutSchedules) > 0 {
+		for _, e := range m.PayoutSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryTaskByIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskByIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskByIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskByIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByStatusTimestampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByStatusGasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTasksByFilterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTasksByFilterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTasksByFilterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+			}
+			m.ToTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleForecastRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleForecastRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleForecastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketSeconds", wireType)
+			}
+			m.BucketSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForecastBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForecastBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForecastBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			m.StartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			}
+			m.EndTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Runs", wireType)
+			}
+			m.Runs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Runs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleForecastResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleForecastResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleForecastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+			}
+			m.ToTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, ForecastBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRuns", wireType)
+			}
+			m.TotalRuns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalRuns |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasLimit", wireType)
+			}
+			m.TotalGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingBlocks", wireType)
+			}
+			m.UpcomingBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpcomingBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeSeconds", wireType)
+			}
+			m.BlockTimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockGasEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockGasEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockGasEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			m.Tasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tasks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, StatusStats{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTasks", wireType)
+			}
+			m.TotalTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTasks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpcomingBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpcomingBlocks = append(m.UpcomingBlocks, BlockGasEstimate{})
+			if err := m.UpcomingBlocks[len(m.UpcomingBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+			}
+			m.TaskGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryAllTasksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryTaskByIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1310,17 +6197,17 @@ func (m *QueryTaskByIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaskByIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaskByIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
 			}
-			m.TaskId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1330,64 +6217,241 @@ func (m *QueryTaskByIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+			}
+			m.TaskGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResults = append(m.MsgResults, &any.Any{})
+			if err := m.MsgResults[len(m.MsgResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorLog", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTaskByIDResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ErrorLog = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueDepth", wireType)
+			}
+			m.QueueDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedGas", wireType)
+			}
+			m.QueuedGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTaskGasFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTaskByIDResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTaskByIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.MinTaskGasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1414,10 +6478,7 @@ func (m *QueryTaskByIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &Task{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1442,7 +6503,7 @@ func (m *QueryTaskByIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTasksByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1465,49 +6526,17 @@ func (m *QueryTasksByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTasksByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTasksByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutId", wireType)
 			}
-			var msglen int
+			m.PayoutId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1517,28 +6546,11 @@ func (m *QueryTasksByAddressRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PayoutId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1560,7 +6572,7 @@ func (m *QueryTasksByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTasksByStatusTimestampRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1583,47 +6595,15 @@ func (m *QueryTasksByStatusTimestampRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTasksByStatusTimestampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1650,10 +6630,10 @@ func (m *QueryTasksByStatusTimestampRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if m.PayoutSchedule == nil {
+				m.PayoutSchedule = &PayoutSchedule{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PayoutSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1678,7 +6658,7 @@ func (m *QueryTasksByStatusTimestampRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTasksByStatusGasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutSchedulesByRecipientRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1701,15 +6681,15 @@ func (m *QueryTasksByStatusGasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutSchedulesByRecipientRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTasksByStatusGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutSchedulesByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1737,9 +6717,9 @@ func (m *QueryTasksByStatusGasPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1796,7 +6776,7 @@ func (m *QueryTasksByStatusGasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTasksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutSchedulesByCreatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1819,17 +6799,17 @@ func (m *QueryTasksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTasksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutSchedulesByCreatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutSchedulesByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1839,25 +6819,23 @@ func (m *QueryTasksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &Task{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1889,7 +6867,7 @@ func (m *QueryTasksResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1916,57 +6894,7 @@ func (m *QueryTasksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPayoutSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1989,15 +6917,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPayoutSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPayoutSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2024,64 +6952,12 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PayoutSchedules = append(m.PayoutSchedules, &PayoutSchedule{})
+			if err := m.PayoutSchedules[len(m.PayoutSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllTasksRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTasksRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2111,7 +6987,7 @@ func (m *QueryAllTasksRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Backoff constants define how the delay between the attempts of a run grows
+const (
+	Backoff_FIXED       = "FIXED"
+	Backoff_EXPONENTIAL = "EXPONENTIAL"
+)
+
+const (
+	// MaxRetryAttempts is the maximum number of attempts of a run
+	MaxRetryAttempts = 10
+
+	// MaxRetryableErrors is the maximum number of retryable errors of a retry policy
+	MaxRetryableErrors = 16
+
+	// MaxAttemptLog is the number of failed attempts kept in the attempt log of a task
+	MaxAttemptLog = 10
+)
+
+// Validate checks the retry policy
+func (p RetryPolicy) Validate() error {
+	if p.MaxAttempts < 1 || p.MaxAttempts > MaxRetryAttempts {
+		return fmt.Errorf("max attempts must be between 1 and %d, got %d", MaxRetryAttempts, p.MaxAttempts)
+	}
+	if p.Backoff != "" && p.Backoff != Backoff_FIXED && p.Backoff != Backoff_EXPONENTIAL {
+		return fmt.Errorf("invalid backoff %q, must be %s or %s", p.Backoff, Backoff_FIXED, Backoff_EXPONENTIAL)
+	}
+	if p.BackoffSeconds < 0 {
+		return fmt.Errorf("backoff seconds cannot be negative: %d", p.BackoffSeconds)
+	}
+	if p.MaxBackoffSeconds < 0 {
+		return fmt.Errorf("max backoff seconds cannot be negative: %d", p.MaxBackoffSeconds)
+	}
+
+	if len(p.RetryableErrors) > MaxRetryableErrors {
+		return fmt.Errorf("a retry policy can have at most %d retryable errors, got %d", MaxRetryableErrors, len(p.RetryableErrors))
+	}
+	for _, e := range p.RetryableErrors {
+		if _, _, err := parseRetryableError(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Retries returns the number of attempts of a run after the first one
+func (p *RetryPolicy) Retries() uint64 {
+	if p == nil || p.MaxAttempts == 0 {
+		return 0
+	}
+	return uint64(p.MaxAttempts - 1)
+}
+
+// BackoffAfter returns the delay in seconds before the next attempt of a run that failed
+// attempts times
+func (p RetryPolicy) BackoffAfter(attempts uint32) int64 {
+	delay := p.BackoffSeconds
+	if p.Backoff == Backoff_EXPONENTIAL && attempts > 1 {
+		// MaxRetryAttempts keeps the shift far from overflowing
+		delay <<= min(attempts-1, MaxRetryAttempts)
+	}
+	if p.MaxBackoffSeconds > 0 && delay > p.MaxBackoffSeconds {
+		delay = p.MaxBackoffSeconds
+	}
+	return delay
+}
+
+// IsRetryable reports whether an error with the given codespace and code is retried
+func (p RetryPolicy) IsRetryable(codespace string, code uint32) bool {
+	if len(p.RetryableErrors) == 0 {
+		return true
+	}
+	for _, e := range p.RetryableErrors {
+		space, c, err := parseRetryableError(e)
+		if err != nil || space != codespace {
+			continue
+		}
+		if c == nil || *c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// parseRetryableError parses a retryable error as "codespace" or "codespace:code"
+func parseRetryableError(e string) (string, *uint32, error) {
+	space, codeStr, hasCode := strings.Cut(e, ":")
+	if space == "" {
+		return "", nil, fmt.Errorf("invalid retryable error %q, expected codespace or codespace:code", e)
+	}
+	if !hasCode {
+		return space, nil, nil
+	}
+	code, err := strconv.ParseUint(codeStr, 10, 32)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid code of retryable error %q: %w", e, err)
+	}
+	c := uint32(code)
+	return space, &c, nil
+}
+
+// RecordAttempt adds a failed attempt to the attempt log of the task, keeping only the most
+// recent MaxAttemptLog attempts
+func (t *Task) RecordAttempt(attempt TaskAttempt) {
+	t.AttemptLog = append(t.AttemptLog, attempt)
+	if len(t.AttemptLog) > MaxAttemptLog {
+		t.AttemptLog = t.AttemptLog[len(t.AttemptLog)-MaxAttemptLog:]
+	}
+}
+
+// DueTimestamp returns the time the task is due: the time of its next attempt when a failed
+// run is waiting for a retry, its scheduled time otherwise
+func (t Task) DueTimestamp() int64 {
+	if t.NextAttemptTimestamp > 0 {
+		return t.NextAttemptTimestamp
+	}
+	return t.ScheduledTimestamp
+}
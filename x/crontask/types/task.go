@@ -2,6 +2,10 @@ package types
 
 import (
 	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // TaskStatus constants define the possible states of a task
@@ -11,25 +15,50 @@ const (
 	TaskStatus_DONE      = "DONE"
 	TaskStatus_FAILED    = "FAILED"
 	TaskStatus_EXPIRED   = "EXPIRED"
+	TaskStatus_CANCELLED = "CANCELLED"
 )
 
+// ExecutionPhase constants define the phases of a block in which a task can run
+const (
+	ExecutionPhase_BEGIN_BLOCK = "BEGIN_BLOCK"
+	ExecutionPhase_END_BLOCK   = "END_BLOCK"
+)
+
+// IsTerminalStatus reports whether a task with the status will not run again
+func IsTerminalStatus(status string) bool {
+	switch status {
+	case TaskStatus_DONE, TaskStatus_FAILED, TaskStatus_EXPIRED, TaskStatus_CANCELLED:
+		return true
+	}
+	return false
+}
+
+// FeeDenom is the only denom accepted for task gas fees
+const FeeDenom = "dys"
+
+// MaxRunHistory is the number of most recent runs kept in the history of a task
+const MaxRunHistory = 10
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState() *GenesisState {
 	params := DefaultParams()
 	return &GenesisState{
-		Tasks:      []*Task{},
-		NextTaskId: 1,
-		Params:     &params,
+		Tasks:        []*Task{},
+		NextTaskId:   1,
+		Params:       &params,
+		NextPayoutId: 1,
 	}
 }
 
 // DefaultParams returns default parameters for the crontask module.
 func DefaultParams() Params {
 	return Params{
-		BlockGasLimit:    10000000, // 10M gas limit per block for tasks
-		ExpiryLimit:      86400,    // 24 hours in seconds
-		MaxScheduledTime: 86400,    // 24 hours in seconds
-		CleanUpTime:      86400,    // 24 hours in seconds
+		BlockGasLimit:        10000000, // 10M gas limit per block for tasks
+		ExpiryLimit:          86400,    // 24 hours in seconds
+		MaxScheduledTime:     86400,    // 24 hours in seconds
+		CleanUpTime:          86400,    // 24 hours in seconds
+		CreatorBlockGasLimit: 2500000,  // a quarter of the block gas limit per creator
+		AgingInterval:        60,       // priority grows by the gas price every minute
 	}
 }
 
@@ -51,5 +80,213 @@ func (p Params) Validate() error {
 		return fmt.Errorf("clean up time cannot be negative: %d", p.CleanUpTime)
 	}
 
+	if p.CreatorBlockGasLimit > p.BlockGasLimit {
+		return fmt.Errorf("creator block gas limit %d exceeds the block gas limit %d", p.CreatorBlockGasLimit, p.BlockGasLimit)
+	}
+
+	if p.AgingInterval < 0 {
+		return fmt.Errorf("aging interval cannot be negative: %d", p.AgingInterval)
+	}
+
+	return nil
+}
+
+// IsRecurring reports whether the task runs on a cron expression or a fixed interval
+func (t Task) IsRecurring() bool {
+	return t.CronExpression != "" || t.IntervalSeconds > 0
+}
+
+// Phase returns the phase of the block in which the task runs, BEGIN_BLOCK when it is not set
+func (t Task) Phase() string {
+	if t.ExecutionPhase == "" {
+		return ExecutionPhase_BEGIN_BLOCK
+	}
+	return t.ExecutionPhase
+}
+
+// ValidateSchedule checks the execution phase, block height and recurrence settings of a task
+func (t Task) ValidateSchedule() error {
+	if t.ExecutionPhase != "" && t.ExecutionPhase != ExecutionPhase_BEGIN_BLOCK && t.ExecutionPhase != ExecutionPhase_END_BLOCK {
+		return fmt.Errorf("invalid execution phase %q, must be %s or %s", t.ExecutionPhase, ExecutionPhase_BEGIN_BLOCK, ExecutionPhase_END_BLOCK)
+	}
+	if t.ScheduledHeight < 0 {
+		return fmt.Errorf("scheduled height cannot be negative: %d", t.ScheduledHeight)
+	}
+	if t.ScheduledHeight > 0 && t.IsRecurring() {
+		return fmt.Errorf("a task scheduled at a block height cannot have a cron expression or an interval")
+	}
+
+	if t.IntervalSeconds < 0 {
+		return fmt.Errorf("interval cannot be negative: %d", t.IntervalSeconds)
+	}
+	if t.CronExpression != "" {
+		if t.IntervalSeconds > 0 {
+			return fmt.Errorf("a task cannot have both a cron expression and an interval")
+		}
+		if _, err := ParseCronExpression(t.CronExpression); err != nil {
+			return err
+		}
+	}
+
+	if !t.IsRecurring() {
+		if t.MaxOccurrences > 0 || t.EndTimestamp > 0 {
+			return fmt.Errorf("max occurrences and end time require a cron expression or an interval")
+		}
+		return nil
+	}
+
+	if t.EndTimestamp < 0 {
+		return fmt.Errorf("end timestamp cannot be negative: %d", t.EndTimestamp)
+	}
+	if t.EndTimestamp > 0 && t.EndTimestamp < t.ScheduledTimestamp {
+		return fmt.Errorf("end timestamp %d is before the first run at %d", t.EndTimestamp, t.ScheduledTimestamp)
+	}
 	return nil
 }
+
+// NextRunAfter returns the first run of the task strictly after the given Unix timestamp.
+// It returns false when the task is not recurring, has reached its maximum number of
+// occurrences or its next run would be after its end time.
+func (t Task) NextRunAfter(after int64) (int64, bool, error) {
+	if !t.IsRecurring() {
+		return 0, false, nil
+	}
+	if t.MaxOccurrences > 0 && t.Occurrences >= t.MaxOccurrences {
+		return 0, false, nil
+	}
+
+	var next int64
+	if t.CronExpression != "" {
+		schedule, err := ParseCronExpression(t.CronExpression)
+		if err != nil {
+			return 0, false, err
+		}
+		nextTime, ok := schedule.Next(time.Unix(after, 0))
+		if !ok {
+			return 0, false, nil
+		}
+		next = nextTime.Unix()
+	} else {
+		// Runs stay aligned to the first scheduled time, missed runs are skipped
+		next = t.ScheduledTimestamp
+		if after >= next {
+			next += ((after-next)/t.IntervalSeconds + 1) * t.IntervalSeconds
+		}
+	}
+
+	if t.EndTimestamp > 0 && next > t.EndTimestamp {
+		return 0, false, nil
+	}
+	return next, true, nil
+}
+
+// RecordRun adds a run to the history of the task, keeping only the most recent
+// MaxRunHistory runs, and counts it as an occurrence.
+func (t *Task) RecordRun(run TaskRun) {
+	t.Occurrences++
+	run.Occurrence = t.Occurrences
+	t.RunHistory = append(t.RunHistory, run)
+	if len(t.RunHistory) > MaxRunHistory {
+		t.RunHistory = t.RunHistory[len(t.RunHistory)-MaxRunHistory:]
+	}
+}
+
+// RunGasLimit returns the most gas a run of the task can use in a block, the gas limit of its
+// messages and of its callback
+func (t Task) RunGasLimit() uint64 {
+	if t.Callback == nil {
+		return t.TaskGasLimit
+	}
+	return t.TaskGasLimit + t.Callback.GasLimitOrDefault()
+}
+
+// EscrowForRuns returns the fee escrowed when the task is created: the task gas fee of every
+// run of a bounded recurring task, or of a single run otherwise, of every retry of a run and
+// the gas limit of the callback of every run.
+func (t Task) EscrowForRuns() sdk.Coin {
+	runs := uint64(1)
+	if t.IsRecurring() && t.MaxOccurrences > 0 {
+		runs = t.MaxOccurrences
+	}
+	return t.escrowFor(runs)
+}
+
+// EscrowForRemainingRuns returns the part of EscrowForRuns covering the runs left of a bounded
+// recurring task, or a single run otherwise
+func (t Task) EscrowForRemainingRuns() sdk.Coin {
+	runs := uint64(1)
+	if t.IsRecurring() && t.MaxOccurrences > 0 {
+		runs = 0
+		if t.Occurrences < t.MaxOccurrences {
+			runs = t.MaxOccurrences - t.Occurrences
+		}
+	}
+	return t.escrowFor(runs)
+}
+
+// UpdatedEscrow returns the escrow the task must hold once it is updated to updated: the fee
+// of the runs left of updated, plus what its escrow holds beyond the fee of its own runs left,
+// such as the condition deposit and top ups
+func (t Task) UpdatedEscrow(updated Task) sdk.Coin {
+	escrow := updated.EscrowForRemainingRuns()
+	if t.Escrow.Denom == "" {
+		return escrow
+	}
+	if surplus := t.Escrow.Amount.Sub(t.EscrowForRemainingRuns().Amount); surplus.IsPositive() {
+		escrow = escrow.AddAmount(surplus)
+	}
+	return escrow
+}
+
+// escrowFor returns the maximum fee of the given number of runs, with their retries and
+// callbacks
+func (t Task) escrowFor(runs uint64) sdk.Coin {
+	if runs == 0 {
+		return sdk.NewInt64Coin(t.TaskGasFee.Denom, 0)
+	}
+	attempts := sdkmath.NewIntFromUint64(runs + t.RetryPolicy.Retries())
+	escrow := sdk.NewCoin(t.TaskGasFee.Denom, t.TaskGasFee.Amount.Mul(attempts))
+	if t.Callback != nil {
+		callback := t.GasCost(t.Callback.GasLimitOrDefault())
+		escrow = escrow.Add(sdk.NewCoin(callback.Denom, callback.Amount.Mul(sdkmath.NewIntFromUint64(runs))))
+	}
+	return escrow
+}
+
+// GasCharge returns the fee of a run that consumed gasConsumed, that is gasConsumed times the
+// task gas price. The price is taken at full precision as task_gas_fee / task_gas_limit, so the
+// fee is rounded up and never exceeds the task gas fee.
+func (t Task) GasCharge(gasConsumed uint64) sdk.Coin {
+	if gasConsumed >= t.TaskGasLimit {
+		return t.TaskGasFee
+	}
+	return t.GasCost(gasConsumed)
+}
+
+// GasCost returns gas times the task gas price, rounded up. Unlike GasCharge it is not capped
+// by the task gas fee, so it also prices condition evaluations with their own gas limit.
+func (t Task) GasCost(gas uint64) sdk.Coin {
+	if t.TaskGasLimit == 0 {
+		return sdk.NewInt64Coin(t.TaskGasFee.Denom, 0)
+	}
+	limit := sdkmath.NewIntFromUint64(t.TaskGasLimit)
+	amount := t.TaskGasFee.Amount.Mul(sdkmath.NewIntFromUint64(gas)).Add(limit).SubRaw(1).Quo(limit)
+	return sdk.NewCoin(t.TaskGasFee.Denom, amount)
+}
+
+// Priority returns the scheduling priority of a pending task at the given time: its gas price,
+// taken at full precision as task_gas_fee / task_gas_limit, increased by the gas price for
+// every agingInterval seconds since the task was due. Aging keeps cheap tasks from being
+// starved by a stream of more expensive ones.
+func (t Task) Priority(now, agingInterval int64) sdkmath.LegacyDec {
+	if t.TaskGasLimit == 0 {
+		return sdkmath.LegacyZeroDec()
+	}
+	price := sdkmath.LegacyNewDecFromInt(t.TaskGasFee.Amount).QuoInt64(int64(t.TaskGasLimit))
+	if agingInterval <= 0 || now <= t.ScheduledTimestamp {
+		return price
+	}
+
+	age := sdkmath.LegacyNewDec(now - t.ScheduledTimestamp).QuoInt64(agingInterval)
+	return price.Mul(sdkmath.LegacyOneDec().Add(age))
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -47,10 +48,41 @@ type MsgCreateTask struct {
 	ExpiryTimestamp string `protobuf:"bytes,3,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
 	// Maximum gas limit for the task execution
 	TaskGasLimit uint64 `protobuf:"varint,4,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
-	// Gas fee for the task execution
+	// Maximum gas fee of a single run of the task. It is escrowed in the module
+	// account at creation, max_occurrences times for a bounded recurring task.
 	TaskGasFee types.Coin `protobuf:"bytes,5,opt,name=task_gas_fee,json=taskGasFee,proto3" json:"task_gas_fee"`
 	// Messages to execute as part of the task
 	Msgs []*any.Any `protobuf:"bytes,7,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// Standard 5-field cron expression (UTC) that makes the task recurring.
+	// The first run is the first match at or after scheduled_timestamp, which
+	// defaults to the current block time when empty.
+	CronExpression string `protobuf:"bytes,8,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// Fixed interval in seconds that makes the task recurring, starting at
+	// scheduled_timestamp. Cannot be combined with cron_expression.
+	IntervalSeconds int64 `protobuf:"varint,9,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Maximum number of runs of a recurring task, 0 for unlimited
+	MaxOccurrences uint64 `protobuf:"varint,10,opt,name=max_occurrences,json=maxOccurrences,proto3" json:"max_occurrences,omitempty"`
+	// Time after which a recurring task is not rescheduled. Can be either a
+	// Unix timestamp or a time offset prefixed with "+" relative to the first
+	// run. Empty for none.
+	EndTimestamp string `protobuf:"bytes,11,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+	// Condition that must hold for the task to run. The task is evaluated from
+	// scheduled_timestamp until expiry_timestamp and runs once it holds.
+	Condition *TaskCondition `protobuf:"bytes,12,opt,name=condition,proto3" json:"condition,omitempty"`
+	// Tasks that must finish with the required outcome before the task runs
+	Dependencies []TaskDependency `protobuf:"bytes,13,rep,name=dependencies,proto3" json:"dependencies"`
+	// Optional ID grouping the task with other tasks of the creator
+	WorkflowId string `protobuf:"bytes,14,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	// Phase of the block in which the task runs: BEGIN_BLOCK (default) or
+	// END_BLOCK, which sees the state changes of the transactions of the block
+	ExecutionPhase string `protobuf:"bytes,15,opt,name=execution_phase,json=executionPhase,proto3" json:"execution_phase,omitempty"`
+	// Optional block height at or after which the task runs. The scheduled
+	// timestamp defaults to the current time when it is set.
+	ScheduledHeight int64 `protobuf:"varint,16,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+	// Policy retrying the failed attempts of a run
+	RetryPolicy *RetryPolicy `protobuf:"bytes,17,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	// Script function called after every run of the task finishes
+	Callback *TaskCallback `protobuf:"bytes,18,opt,name=callback,proto3" json:"callback,omitempty"`
 }
 
 func (m *MsgCreateTask) Reset()         { *m = MsgCreateTask{} }
@@ -128,6 +160,83 @@ func (m *MsgCreateTask) GetMsgs() []*any.Any {
 	return nil
 }
 
+func (m *MsgCreateTask) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *MsgCreateTask) GetIntervalSeconds() int64 {
+	if m != nil {
+		return m.IntervalSeconds
+	}
+	return 0
+}
+
+func (m *MsgCreateTask) GetMaxOccurrences() uint64 {
+	if m != nil {
+		return m.MaxOccurrences
+	}
+	return 0
+}
+
+func (m *MsgCreateTask) GetEndTimestamp() string {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return ""
+}
+
+func (m *MsgCreateTask) GetCondition() *TaskCondition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (m *MsgCreateTask) GetDependencies() []TaskDependency {
+	if m != nil {
+		return m.Dependencies
+	}
+	return nil
+}
+
+func (m *MsgCreateTask) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
+	}
+	return ""
+}
+
+func (m *MsgCreateTask) GetExecutionPhase() string {
+	if m != nil {
+		return m.ExecutionPhase
+	}
+	return ""
+}
+
+func (m *MsgCreateTask) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
+func (m *MsgCreateTask) GetRetryPolicy() *RetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *MsgCreateTask) GetCallback() *TaskCallback {
+	if m != nil {
+		return m.Callback
+	}
+	return nil
+}
+
 // MsgCreateTaskResponse defines the response for creating a new task
 type MsgCreateTaskResponse struct {
 	// The ID of the created task
@@ -266,32 +375,40 @@ func (m *MsgDeleteTaskResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteTaskResponse proto.InternalMessageInfo
 
-// ---------------------------------------------------------------------------
-// MsgUpdateParams
-// ---------------------------------------------------------------------------
-// UpdateParams defines a governance operation for updating the x/crontask
-// module parameters. The authority defaults to the x/gov module account.
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless
-	// overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/crontask parameters to update.
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgUpdateTask defines the message for updating a scheduled task. Empty
+// fields are left unchanged.
+type MsgUpdateTask struct {
+	// Address of the creator of the task
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the task to update
+	TaskId uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// New scheduled time, either a Unix timestamp or a time offset prefixed
+	// with "+" relative to the current block time
+	ScheduledTimestamp string `protobuf:"bytes,3,opt,name=scheduled_timestamp,json=scheduledTimestamp,proto3" json:"scheduled_timestamp,omitempty"`
+	// New expiry time, either a Unix timestamp or a time offset prefixed with
+	// "+" relative to the scheduled time
+	ExpiryTimestamp string `protobuf:"bytes,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+	// New maximum gas limit for the task execution
+	TaskGasLimit uint64 `protobuf:"varint,5,opt,name=task_gas_limit,json=taskGasLimit,proto3" json:"task_gas_limit,omitempty"`
+	// New maximum gas fee of a single run of the task. The escrow is adjusted
+	// to the new fee.
+	TaskGasFee *types.Coin `protobuf:"bytes,6,opt,name=task_gas_fee,json=taskGasFee,proto3" json:"task_gas_fee,omitempty"`
+	// New messages to execute as part of the task
+	Msgs []*any.Any `protobuf:"bytes,7,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateTask) Reset()         { *m = MsgUpdateTask{} }
+func (m *MsgUpdateTask) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTask) ProtoMessage()    {}
+func (*MsgUpdateTask) Descriptor() ([]byte, []int) {
 	return fileDescriptor_93dcec8a39e4e8ee, []int{4}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateTask.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -301,49 +418,85 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgUpdateTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTask.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgUpdateTask) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgUpdateTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTask.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateTask proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgUpdateTask) GetCreator() string {
 	if m != nil {
-		return m.Authority
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgUpdateTask) GetTaskId() uint64 {
 	if m != nil {
-		return m.Params
+		return m.TaskId
 	}
-	return Params{}
+	return 0
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+func (m *MsgUpdateTask) GetScheduledTimestamp() string {
+	if m != nil {
+		return m.ScheduledTimestamp
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateTask) GetExpiryTimestamp() string {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return ""
+}
+
+func (m *MsgUpdateTask) GetTaskGasLimit() uint64 {
+	if m != nil {
+		return m.TaskGasLimit
+	}
+	return 0
+}
+
+func (m *MsgUpdateTask) GetTaskGasFee() *types.Coin {
+	if m != nil {
+		return m.TaskGasFee
+	}
+	return nil
+}
+
+func (m *MsgUpdateTask) GetMsgs() []*any.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+// MsgUpdateTaskResponse defines the response for updating a task
+type MsgUpdateTaskResponse struct {
+	// Escrow of the task after the update
+	Escrow types.Coin `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
+}
+
+func (m *MsgUpdateTaskResponse) Reset()         { *m = MsgUpdateTaskResponse{} }
+func (m *MsgUpdateTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTaskResponse) ProtoMessage()    {}
+func (*MsgUpdateTaskResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_93dcec8a39e4e8ee, []int{5}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateTaskResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -353,567 +506,3752 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgUpdateTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTaskResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgUpdateTaskResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgUpdateTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTaskResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateTaskResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateTask)(nil), "dysonprotocol.crontask.v1.MsgCreateTask")
-	proto.RegisterType((*MsgCreateTaskResponse)(nil), "dysonprotocol.crontask.v1.MsgCreateTaskResponse")
-	proto.RegisterType((*MsgDeleteTask)(nil), "dysonprotocol.crontask.v1.MsgDeleteTask")
-	proto.RegisterType((*MsgDeleteTaskResponse)(nil), "dysonprotocol.crontask.v1.MsgDeleteTaskResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "dysonprotocol.crontask.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dysonprotocol.crontask.v1.MsgUpdateParamsResponse")
+func (m *MsgUpdateTaskResponse) GetEscrow() types.Coin {
+	if m != nil {
+		return m.Escrow
+	}
+	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterFile("dysonprotocol/crontask/v1/tx.proto", fileDescriptor_93dcec8a39e4e8ee)
+// MsgTopUpTask defines the message for adding funds to the fee escrow of a
+// recurring or conditional task
+type MsgTopUpTask struct {
+	// Address of the creator of the task
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the task to top up
+	TaskId uint64 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Amount to add to the escrow, in the denom of the task gas fee
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-var fileDescriptor_93dcec8a39e4e8ee = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0xd3, 0xb4, 0x55, 0xaf, 0x81, 0x82, 0x29, 0xca, 0x0f, 0x21, 0x13, 0x2c, 0x06, 0x13,
-	0x09, 0x5f, 0x13, 0x24, 0x86, 0x0a, 0x09, 0x35, 0x45, 0x45, 0x48, 0x54, 0x42, 0xa6, 0x2c, 0x2c,
-	0xd1, 0x25, 0xbe, 0x5e, 0x4c, 0x63, 0x9f, 0xe5, 0x77, 0xad, 0xea, 0x0d, 0x31, 0x32, 0xf1, 0x67,
-	0x30, 0x76, 0x60, 0x63, 0x46, 0xea, 0x58, 0x31, 0x31, 0x21, 0xd4, 0x0e, 0xfd, 0x37, 0xd0, 0x9d,
-	0xed, 0xd8, 0x09, 0xea, 0x0f, 0x89, 0x25, 0xca, 0x7b, 0xdf, 0x77, 0xdf, 0xfb, 0xfc, 0xbd, 0x3b,
-	0x64, 0xba, 0x31, 0xf0, 0x20, 0x8c, 0xb8, 0xe0, 0x43, 0x3e, 0xc6, 0xc3, 0x88, 0x07, 0x82, 0xc0,
-	0x1e, 0x3e, 0xe8, 0x60, 0x71, 0x68, 0xab, 0xbe, 0xde, 0x98, 0xe2, 0xd8, 0x19, 0xc7, 0x3e, 0xe8,
-	0x34, 0x57, 0x19, 0x67, 0x5c, 0x21, 0x58, 0xfe, 0x4b, 0x0e, 0x34, 0x6f, 0x13, 0xdf, 0x0b, 0x38,
-	0x56, 0xbf, 0x69, 0xab, 0x31, 0xe4, 0xe0, 0x73, 0xe8, 0x27, 0xdc, 0xa4, 0x48, 0x21, 0x23, 0xa9,
-	0xf0, 0x80, 0x00, 0xc5, 0x07, 0x9d, 0x01, 0x15, 0xa4, 0x83, 0x87, 0xdc, 0x0b, 0x52, 0xbc, 0x96,
-	0xe2, 0x3e, 0x30, 0x69, 0xcb, 0x07, 0x96, 0x69, 0x32, 0xce, 0xd9, 0x98, 0x62, 0x55, 0x0d, 0xf6,
-	0x77, 0x31, 0x09, 0xe2, 0x14, 0xb2, 0x2e, 0xfe, 0xac, 0x89, 0x7d, 0x85, 0x9a, 0x3f, 0xca, 0xe8,
-	0xc6, 0x36, 0xb0, 0xcd, 0x88, 0x12, 0x41, 0x77, 0x08, 0xec, 0xe9, 0x5d, 0xb4, 0x38, 0x94, 0x15,
-	0x8f, 0xea, 0x5a, 0x4b, 0xb3, 0x96, 0x7a, 0xf5, 0x9f, 0xdf, 0x1e, 0xaf, 0xa6, 0x96, 0x37, 0x5c,
-	0x37, 0xa2, 0x00, 0x6f, 0x45, 0xe4, 0x05, 0xcc, 0xc9, 0x88, 0x3a, 0x46, 0x77, 0x60, 0x38, 0xa2,
-	0xee, 0xfe, 0x98, 0xba, 0x7d, 0xe1, 0xf9, 0x14, 0x04, 0xf1, 0xc3, 0x7a, 0x59, 0x9e, 0x77, 0xf4,
-	0x09, 0xb4, 0x93, 0x21, 0xfa, 0x23, 0x74, 0x8b, 0x1e, 0x86, 0x5e, 0x14, 0x17, 0xd8, 0x73, 0x8a,
-	0xbd, 0x92, 0xf4, 0x73, 0xea, 0x43, 0x74, 0x53, 0xfa, 0xed, 0x33, 0x02, 0xfd, 0xb1, 0xe7, 0x7b,
-	0xa2, 0x5e, 0x69, 0x69, 0x56, 0xc5, 0xa9, 0xca, 0xee, 0x4b, 0x02, 0xaf, 0x65, 0x4f, 0xdf, 0x42,
-	0xd5, 0x09, 0x6b, 0x97, 0xd2, 0xfa, 0x7c, 0x4b, 0xb3, 0x96, 0xbb, 0x0d, 0x3b, 0xf5, 0x2d, 0xc3,
-	0xb5, 0xd3, 0x70, 0xed, 0x4d, 0xee, 0x05, 0xbd, 0xa5, 0xe3, 0xdf, 0xf7, 0x4b, 0x5f, 0xcf, 0x8f,
-	0xda, 0x9a, 0x83, 0x52, 0xa5, 0x2d, 0x4a, 0x75, 0x0b, 0x55, 0x7c, 0x60, 0x50, 0x5f, 0x6c, 0xcd,
-	0x59, 0xcb, 0xdd, 0x55, 0x3b, 0xc9, 0xd8, 0xce, 0x32, 0xb6, 0x37, 0x82, 0xd8, 0x51, 0x8c, 0xf5,
-	0xea, 0xa7, 0xf3, 0xa3, 0x76, 0x96, 0x80, 0xb9, 0x86, 0xee, 0x4e, 0xc5, 0xe8, 0x50, 0x08, 0x79,
-	0x00, 0x54, 0xaf, 0xa1, 0x45, 0x65, 0xcc, 0x73, 0x55, 0x9c, 0x15, 0x67, 0x41, 0x96, 0xaf, 0x5c,
-	0xf3, 0x83, 0x0a, 0xfe, 0x05, 0x1d, 0xd3, 0xff, 0x08, 0xbe, 0xa0, 0x5e, 0x2e, 0xaa, 0xcf, 0xb8,
-	0xab, 0x29, 0x77, 0xf9, 0xac, 0xcc, 0x9d, 0xf9, 0x5d, 0x43, 0x2b, 0xdb, 0xc0, 0xde, 0x85, 0x2e,
-	0x11, 0xf4, 0x0d, 0x89, 0x88, 0x0f, 0xfa, 0x53, 0xb4, 0x44, 0xf6, 0xc5, 0x88, 0x47, 0x9e, 0x88,
-	0xaf, 0x74, 0x92, 0x53, 0xf5, 0xe7, 0x68, 0x21, 0x54, 0x0a, 0xca, 0xca, 0x72, 0xf7, 0x81, 0x7d,
-	0xe1, 0xc3, 0xb1, 0x93, 0x51, 0xbd, 0x8a, 0x5c, 0x82, 0x93, 0x1e, 0x5b, 0xc7, 0xd2, 0x73, 0x2e,
-	0xf8, 0xf9, 0xfc, 0xa8, 0x7d, 0xcf, 0x8d, 0x21, 0xbf, 0xbe, 0x33, 0x4e, 0xcd, 0x06, 0xaa, 0xcd,
-	0xb4, 0xb2, 0x0f, 0xeb, 0x9e, 0x94, 0xd1, 0xdc, 0x36, 0x30, 0x7d, 0x84, 0x50, 0xe1, 0x6e, 0x5b,
-	0x97, 0x58, 0x9a, 0x5a, 0x5f, 0x73, 0xed, 0xba, 0xcc, 0xc9, 0xa2, 0x47, 0x08, 0x15, 0x96, 0x79,
-	0xc5, 0xa4, 0x9c, 0x79, 0xd5, 0xa4, 0x7f, 0x97, 0xa6, 0x07, 0xa8, 0x3a, 0xb5, 0xb0, 0xf6, 0xe5,
-	0x0a, 0x45, 0x6e, 0xb3, 0x7b, 0x7d, 0x6e, 0x36, 0xaf, 0x39, 0xff, 0x51, 0x3e, 0x93, 0xde, 0xb3,
-	0xe3, 0x53, 0x43, 0x3b, 0x39, 0x35, 0xb4, 0x3f, 0xa7, 0x86, 0xf6, 0xe5, 0xcc, 0x28, 0x9d, 0x9c,
-	0x19, 0xa5, 0x5f, 0x67, 0x46, 0xe9, 0xbd, 0x39, 0xa3, 0xc9, 0x7d, 0x7c, 0x98, 0x6f, 0x4d, 0xc4,
-	0x21, 0x85, 0xc1, 0x82, 0x82, 0x9f, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xef, 0xc2, 0x21,
-	0x72, 0x05, 0x00, 0x00,
+func (m *MsgTopUpTask) Reset()         { *m = MsgTopUpTask{} }
+func (m *MsgTopUpTask) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpTask) ProtoMessage()    {}
+func (*MsgTopUpTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{6}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateTask creates a new scheduled task
-	CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error)
-	// DeleteTask deletes a scheduled task
-	DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error)
-	// UpdateParams updates the parameters of the x/crontask module
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgTopUpTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTopUpTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTopUpTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpTask.Merge(m, src)
+}
+func (m *MsgTopUpTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpTask.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error) {
-	out := new(MsgCreateTaskResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/CreateTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTopUpTask proto.InternalMessageInfo
+
+func (m *MsgTopUpTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error) {
-	out := new(MsgDeleteTaskResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/DeleteTask", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTopUpTask) GetTaskId() uint64 {
+	if m != nil {
+		return m.TaskId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTopUpTask) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateTask creates a new scheduled task
-	CreateTask(context.Context, *MsgCreateTask) (*MsgCreateTaskResponse, error)
-	// DeleteTask deletes a scheduled task
-	DeleteTask(context.Context, *MsgDeleteTask) (*MsgDeleteTaskResponse, error)
-	// UpdateParams updates the parameters of the x/crontask module
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+// MsgTopUpTaskResponse defines the response for topping up a task
+type MsgTopUpTaskResponse struct {
+	// Escrow of the task after the top up
+	Escrow types.Coin `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgTopUpTaskResponse) Reset()         { *m = MsgTopUpTaskResponse{} }
+func (m *MsgTopUpTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpTaskResponse) ProtoMessage()    {}
+func (*MsgTopUpTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{7}
 }
-
-func (*UnimplementedMsgServer) CreateTask(ctx context.Context, req *MsgCreateTask) (*MsgCreateTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+func (m *MsgTopUpTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) DeleteTask(ctx context.Context, req *MsgDeleteTask) (*MsgDeleteTaskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+func (m *MsgTopUpTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgTopUpTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpTaskResponse.Merge(m, src)
+}
+func (m *MsgTopUpTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpTaskResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgTopUpTaskResponse proto.InternalMessageInfo
+
+func (m *MsgTopUpTaskResponse) GetEscrow() types.Coin {
+	if m != nil {
+		return m.Escrow
+	}
+	return types.Coin{}
 }
 
-func _Msg_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Msg/CreateTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTask(ctx, req.(*MsgCreateTask))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgCancelWorkflow defines the message for cancelling the tasks of a workflow
+type MsgCancelWorkflow struct {
+	// Address of the creator of the workflow
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the workflow to cancel
+	WorkflowId string `protobuf:"bytes,2,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
 }
 
-func _Msg_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteTask)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Msg/DeleteTask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteTask(ctx, req.(*MsgDeleteTask))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgCancelWorkflow) Reset()         { *m = MsgCancelWorkflow{} }
+func (m *MsgCancelWorkflow) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWorkflow) ProtoMessage()    {}
+func (*MsgCancelWorkflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{8}
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.crontask.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgCancelWorkflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWorkflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWorkflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelWorkflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWorkflow.Merge(m, src)
+}
+func (m *MsgCancelWorkflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWorkflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWorkflow.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dysonprotocol.crontask.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateTask",
-			Handler:    _Msg_CreateTask_Handler,
-		},
-		{
-			MethodName: "DeleteTask",
-			Handler:    _Msg_DeleteTask_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dysonprotocol/crontask/v1/tx.proto",
+var xxx_messageInfo_MsgCancelWorkflow proto.InternalMessageInfo
+
+func (m *MsgCancelWorkflow) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgCreateTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelWorkflow) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelWorkflowResponse defines the response for cancelling a workflow
+type MsgCancelWorkflowResponse struct {
+	// IDs of the tasks that were cancelled
+	CancelledTaskIds []uint64 `protobuf:"varint,1,rep,packed,name=cancelled_task_ids,json=cancelledTaskIds,proto3" json:"cancelled_task_ids,omitempty"`
 }
 
-func (m *MsgCreateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Msgs) > 0 {
-		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	{
-		size, err := m.TaskGasFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelWorkflowResponse) Reset()         { *m = MsgCancelWorkflowResponse{} }
+func (m *MsgCancelWorkflowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelWorkflowResponse) ProtoMessage()    {}
+func (*MsgCancelWorkflowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{9}
+}
+func (m *MsgCancelWorkflowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelWorkflowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelWorkflowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.TaskGasLimit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskGasLimit))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.ExpiryTimestamp) > 0 {
-		i -= len(m.ExpiryTimestamp)
-		copy(dAtA[i:], m.ExpiryTimestamp)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiryTimestamp)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ScheduledTimestamp) > 0 {
-		i -= len(m.ScheduledTimestamp)
-		copy(dAtA[i:], m.ScheduledTimestamp)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduledTimestamp)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelWorkflowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelWorkflowResponse.Merge(m, src)
+}
+func (m *MsgCancelWorkflowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelWorkflowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelWorkflowResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelWorkflowResponse proto.InternalMessageInfo
+
+func (m *MsgCancelWorkflowResponse) GetCancelledTaskIds() []uint64 {
+	if m != nil {
+		return m.CancelledTaskIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreatePayoutSchedule defines the message for creating a payout schedule.
+// The amount of every recipient times total_releases is escrowed at creation.
+type MsgCreatePayoutSchedule struct {
+	// Address of the creator funding the payout schedule
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Recipients and the amount each of them receives at every release. The
+	// released amounts are ignored.
+	Recipients []PayoutRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients"`
+	// Time of the first release, either a Unix timestamp or a time offset
+	// prefixed with "+" relative to the current block time. Defaults to the
+	// current block time plus the interval.
+	StartTimestamp string `protobuf:"bytes,3,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// Seconds between two releases, 0 for a single release
+	IntervalSeconds int64 `protobuf:"varint,4,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Total number of releases
+	TotalReleases uint64 `protobuf:"varint,5,opt,name=total_releases,json=totalReleases,proto3" json:"total_releases,omitempty"`
 }
 
-func (m *MsgCreateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgCreatePayoutSchedule) Reset()         { *m = MsgCreatePayoutSchedule{} }
+func (m *MsgCreatePayoutSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePayoutSchedule) ProtoMessage()    {}
+func (*MsgCreatePayoutSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{10}
+}
+func (m *MsgCreatePayoutSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePayoutSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePayoutSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreatePayoutSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePayoutSchedule.Merge(m, src)
+}
+func (m *MsgCreatePayoutSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePayoutSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePayoutSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreatePayoutSchedule proto.InternalMessageInfo
+
+func (m *MsgCreatePayoutSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteTask) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreatePayoutSchedule) GetRecipients() []PayoutRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
 }
 
-func (m *MsgDeleteTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
-		i--
-		dAtA[i] = 0x10
+func (m *MsgCreatePayoutSchedule) GetStartTimestamp() string {
+	if m != nil {
+		return m.StartTimestamp
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCreatePayoutSchedule) GetIntervalSeconds() int64 {
+	if m != nil {
+		return m.IntervalSeconds
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgDeleteTaskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreatePayoutSchedule) GetTotalReleases() uint64 {
+	if m != nil {
+		return m.TotalReleases
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgDeleteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreatePayoutScheduleResponse defines the response for creating a payout
+// schedule
+type MsgCreatePayoutScheduleResponse struct {
+	// The ID of the created payout schedule
+	PayoutId uint64 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	// Funds escrowed for all the releases
+	Escrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=escrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrow"`
 }
 
-func (m *MsgDeleteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreatePayoutScheduleResponse) Reset()         { *m = MsgCreatePayoutScheduleResponse{} }
+func (m *MsgCreatePayoutScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePayoutScheduleResponse) ProtoMessage()    {}
+func (*MsgCreatePayoutScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{11}
+}
+func (m *MsgCreatePayoutScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePayoutScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePayoutScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePayoutScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePayoutScheduleResponse.Merge(m, src)
+}
+func (m *MsgCreatePayoutScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePayoutScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePayoutScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreatePayoutScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCreatePayoutScheduleResponse) GetPayoutId() uint64 {
+	if m != nil {
+		return m.PayoutId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreatePayoutScheduleResponse) GetEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Escrow
+	}
+	return nil
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// MsgCancelPayoutSchedule defines the message for cancelling a payout schedule
+type MsgCancelPayoutSchedule struct {
+	// Address of the creator of the payout schedule
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// ID of the payout schedule to cancel
+	PayoutId uint64 `protobuf:"varint,2,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+}
+
+func (m *MsgCancelPayoutSchedule) Reset()         { *m = MsgCancelPayoutSchedule{} }
+func (m *MsgCancelPayoutSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPayoutSchedule) ProtoMessage()    {}
+func (*MsgCancelPayoutSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{12}
+}
+func (m *MsgCancelPayoutSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPayoutSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPayoutSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCancelPayoutSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPayoutSchedule.Merge(m, src)
+}
+func (m *MsgCancelPayoutSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPayoutSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPayoutSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelPayoutSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelPayoutSchedule) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelPayoutSchedule) GetPayoutId() uint64 {
+	if m != nil {
+		return m.PayoutId
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelPayoutScheduleResponse defines the response for cancelling a
+// payout schedule
+type MsgCancelPayoutScheduleResponse struct {
+	// Funds paid to the recipients on cancellation: the releases that were due
+	// and the vested share of the current release
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+	// Funds refunded to the creator
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgCancelPayoutScheduleResponse) Reset()         { *m = MsgCancelPayoutScheduleResponse{} }
+func (m *MsgCancelPayoutScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPayoutScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelPayoutScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{13}
 }
-func (m *MsgCreateTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ScheduledTimestamp)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExpiryTimestamp)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.TaskGasLimit != 0 {
-		n += 1 + sovTx(uint64(m.TaskGasLimit))
-	}
-	l = m.TaskGasFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelPayoutScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelPayoutScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelPayoutScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgCancelPayoutScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPayoutScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelPayoutScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelPayoutScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPayoutScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
+var xxx_messageInfo_MsgCancelPayoutScheduleResponse proto.InternalMessageInfo
+
+func (m *MsgCancelPayoutScheduleResponse) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
 	}
-	return n
+	return nil
 }
 
-func (m *MsgDeleteTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelPayoutScheduleResponse) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
 	}
-	if m.TaskId != 0 {
-		n += 1 + sovTx(uint64(m.TaskId))
+	return nil
+}
+
+// ---------------------------------------------------------------------------
+// MsgUpdateParams
+// ---------------------------------------------------------------------------
+// UpdateParams defines a governance operation for updating the x/crontask
+// module parameters. The authority defaults to the x/gov module account.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/crontask parameters to update.
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{14}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteTaskResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93dcec8a39e4e8ee, []int{15}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateTask)(nil), "dysonprotocol.crontask.v1.MsgCreateTask")
+	proto.RegisterType((*MsgCreateTaskResponse)(nil), "dysonprotocol.crontask.v1.MsgCreateTaskResponse")
+	proto.RegisterType((*MsgDeleteTask)(nil), "dysonprotocol.crontask.v1.MsgDeleteTask")
+	proto.RegisterType((*MsgDeleteTaskResponse)(nil), "dysonprotocol.crontask.v1.MsgDeleteTaskResponse")
+	proto.RegisterType((*MsgUpdateTask)(nil), "dysonprotocol.crontask.v1.MsgUpdateTask")
+	proto.RegisterType((*MsgUpdateTaskResponse)(nil), "dysonprotocol.crontask.v1.MsgUpdateTaskResponse")
+	proto.RegisterType((*MsgTopUpTask)(nil), "dysonprotocol.crontask.v1.MsgTopUpTask")
+	proto.RegisterType((*MsgTopUpTaskResponse)(nil), "dysonprotocol.crontask.v1.MsgTopUpTaskResponse")
+	proto.RegisterType((*MsgCancelWorkflow)(nil), "dysonprotocol.crontask.v1.MsgCancelWorkflow")
+	proto.RegisterType((*MsgCancelWorkflowResponse)(nil), "dysonprotocol.crontask.v1.MsgCancelWorkflowResponse")
+	proto.RegisterType((*MsgCreatePayoutSchedule)(nil), "dysonprotocol.crontask.v1.MsgCreatePayoutSchedule")
+	proto.RegisterType((*MsgCreatePayoutScheduleResponse)(nil), "dysonprotocol.crontask.v1.MsgCreatePayoutScheduleResponse")
+	proto.RegisterType((*MsgCancelPayoutSchedule)(nil), "dysonprotocol.crontask.v1.MsgCancelPayoutSchedule")
+	proto.RegisterType((*MsgCancelPayoutScheduleResponse)(nil), "dysonprotocol.crontask.v1.MsgCancelPayoutScheduleResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "dysonprotocol.crontask.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dysonprotocol.crontask.v1.MsgUpdateParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("dysonprotocol/crontask/v1/tx.proto", fileDescriptor_93dcec8a39e4e8ee)
+}
+
+var fileDescriptor_93dcec8a39e4e8ee = []byte{
+	// 1304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0x93, 0x6c, 0xda, 0x4c, 0xd2, 0x74, 0xd7, 0x14, 0xd5, 0x0d, 0x28, 0x0d, 0x01, 0x76,
+	0xb3, 0xd5, 0xae, 0xbd, 0x0d, 0x1f, 0x87, 0x52, 0x09, 0x6d, 0xbb, 0x14, 0x2a, 0x51, 0x51, 0xb9,
+	0xad, 0x40, 0x5c, 0xa2, 0x89, 0x3d, 0x75, 0x4c, 0x6d, 0x8f, 0xe5, 0x99, 0xb4, 0xc9, 0x0d, 0x71,
+	0xe0, 0xc0, 0x01, 0xf1, 0x2f, 0x58, 0x90, 0x90, 0x7a, 0xe0, 0xc6, 0x1f, 0xd8, 0xe3, 0x0a, 0x2e,
+	0x9c, 0x00, 0xb5, 0x87, 0xfe, 0x0a, 0x24, 0x34, 0xe3, 0xaf, 0xd8, 0x4d, 0x93, 0xb4, 0xb4, 0x97,
+	0x36, 0x7e, 0xe7, 0x79, 0xbf, 0x9e, 0xf7, 0x63, 0x06, 0xd4, 0xf5, 0x3e, 0xc1, 0x8e, 0xeb, 0x61,
+	0x8a, 0x35, 0x6c, 0x29, 0x9a, 0x87, 0x1d, 0x0a, 0xc9, 0xa1, 0x72, 0xb4, 0xa2, 0xd0, 0x9e, 0xcc,
+	0xe5, 0xe2, 0x62, 0x02, 0x23, 0x87, 0x18, 0xf9, 0x68, 0xa5, 0x32, 0x6f, 0x60, 0x03, 0xf3, 0x13,
+	0x85, 0xfd, 0xf2, 0x15, 0x2a, 0xf7, 0xa0, 0x6d, 0x3a, 0x58, 0xe1, 0x7f, 0x03, 0xd1, 0xa2, 0x86,
+	0x89, 0x8d, 0x49, 0xcb, 0xc7, 0xfa, 0x1f, 0xc1, 0x51, 0xd5, 0xff, 0x52, 0xda, 0x90, 0x20, 0xe5,
+	0x68, 0xa5, 0x8d, 0x28, 0x5c, 0x51, 0x34, 0x6c, 0x3a, 0xc1, 0xf9, 0x42, 0x70, 0x6e, 0x13, 0x83,
+	0x85, 0x65, 0x13, 0x23, 0xb4, 0x69, 0x60, 0x6c, 0x58, 0x48, 0xe1, 0x5f, 0xed, 0xee, 0x81, 0x02,
+	0x9d, 0x7e, 0x70, 0xd4, 0xb8, 0x3c, 0xad, 0x28, 0x7c, 0x1f, 0x79, 0xff, 0x72, 0xa4, 0x0b, 0xfb,
+	0xb8, 0x4b, 0x7d, 0x5c, 0xfd, 0xfb, 0x69, 0x30, 0xbb, 0x4d, 0x8c, 0x0d, 0x0f, 0x41, 0x8a, 0xf6,
+	0x20, 0x39, 0x14, 0x9b, 0x60, 0x5a, 0x63, 0x5f, 0xd8, 0x93, 0x84, 0x9a, 0xd0, 0x28, 0xac, 0x4b,
+	0xbf, 0xff, 0xfa, 0x78, 0x3e, 0x48, 0xed, 0xa9, 0xae, 0x7b, 0x88, 0x90, 0x5d, 0xea, 0x99, 0x8e,
+	0xa1, 0x86, 0x40, 0x51, 0x01, 0xaf, 0x10, 0xad, 0x83, 0xf4, 0xae, 0x85, 0xf4, 0x16, 0x35, 0x6d,
+	0x44, 0x28, 0xb4, 0x5d, 0x29, 0xc3, 0xf4, 0x55, 0x31, 0x3a, 0xda, 0x0b, 0x4f, 0xc4, 0x87, 0xe0,
+	0x2e, 0xea, 0xb9, 0xa6, 0xd7, 0x1f, 0x40, 0x67, 0x39, 0x7a, 0xce, 0x97, 0xc7, 0xd0, 0xb7, 0x40,
+	0x99, 0x45, 0xde, 0x32, 0x20, 0x69, 0x59, 0xa6, 0x6d, 0x52, 0x29, 0x57, 0x13, 0x1a, 0x39, 0xb5,
+	0xc4, 0xa4, 0x1f, 0x43, 0xf2, 0x29, 0x93, 0x89, 0x9b, 0xa0, 0x14, 0xa1, 0x0e, 0x10, 0x92, 0xee,
+	0xd4, 0x84, 0x46, 0xb1, 0xb9, 0x28, 0x07, 0x71, 0xb3, 0x22, 0xc8, 0x41, 0x11, 0xe4, 0x0d, 0x6c,
+	0x3a, 0xeb, 0x85, 0x17, 0x7f, 0x2d, 0x4d, 0x3d, 0x3f, 0x3f, 0x59, 0x16, 0x54, 0x10, 0x58, 0xda,
+	0x44, 0x48, 0x6c, 0x80, 0x9c, 0x4d, 0x0c, 0x22, 0x4d, 0xd7, 0xb2, 0x8d, 0x62, 0x73, 0x5e, 0xf6,
+	0x6b, 0x21, 0x87, 0xb5, 0x90, 0x9f, 0x3a, 0x7d, 0x95, 0x23, 0xc4, 0x07, 0x60, 0x8e, 0xb1, 0xda,
+	0x42, 0x3d, 0x97, 0x51, 0x62, 0x62, 0x47, 0x9a, 0xe1, 0x19, 0x94, 0x99, 0xf8, 0xa3, 0x48, 0xca,
+	0x72, 0x35, 0x1d, 0x8a, 0xbc, 0x23, 0x68, 0xb5, 0x08, 0xd2, 0xb0, 0xa3, 0x13, 0xa9, 0x50, 0x13,
+	0x1a, 0x59, 0x75, 0x2e, 0x94, 0xef, 0xfa, 0x62, 0x66, 0xd3, 0x86, 0xbd, 0x16, 0xd6, 0xb4, 0xae,
+	0xe7, 0x21, 0x47, 0x43, 0x44, 0x02, 0x3c, 0xd9, 0xb2, 0x0d, 0x7b, 0x9f, 0xc5, 0x52, 0xf1, 0x4d,
+	0x30, 0x8b, 0x9c, 0x41, 0xaa, 0x8b, 0xdc, 0x75, 0x09, 0x39, 0x03, 0x24, 0x6f, 0x82, 0x02, 0x33,
+	0x6b, 0x52, 0x16, 0x5b, 0x89, 0x13, 0xd2, 0x90, 0x2f, 0x6d, 0x7a, 0x99, 0x55, 0x7f, 0x23, 0xc4,
+	0xab, 0xb1, 0xaa, 0xf8, 0x05, 0x28, 0xe9, 0xc8, 0x45, 0x8e, 0x8e, 0x1c, 0xcd, 0x44, 0x44, 0x9a,
+	0xe5, 0xdc, 0x3c, 0x1c, 0x63, 0xea, 0x59, 0xa8, 0xd2, 0x1f, 0xe4, 0x3a, 0x61, 0x49, 0x5c, 0x02,
+	0xc5, 0x63, 0xec, 0x1d, 0x1e, 0x58, 0xf8, 0xb8, 0x65, 0xea, 0x52, 0x99, 0x27, 0x01, 0x42, 0xd1,
+	0x96, 0xce, 0x08, 0x41, 0x3d, 0xa4, 0x75, 0x59, 0x1c, 0x2d, 0xb7, 0x03, 0x09, 0x92, 0xe6, 0x7c,
+	0x92, 0x23, 0xf1, 0x0e, 0x93, 0x32, 0x92, 0xe3, 0x0e, 0xec, 0x20, 0xd3, 0xe8, 0x50, 0xe9, 0xae,
+	0x4f, 0x72, 0x24, 0xff, 0x84, 0x8b, 0xc5, 0x2d, 0x50, 0xf2, 0x10, 0xf5, 0xfa, 0x2d, 0x17, 0x5b,
+	0xa6, 0xd6, 0x97, 0xee, 0x71, 0x66, 0xee, 0x8f, 0x48, 0x47, 0x65, 0xf0, 0x1d, 0x8e, 0x56, 0x8b,
+	0x5e, 0xfc, 0x21, 0x6e, 0x80, 0x19, 0x0d, 0x5a, 0x56, 0x1b, 0x6a, 0x87, 0x92, 0xc8, 0xcd, 0x3c,
+	0x18, 0x47, 0x70, 0x00, 0x57, 0x23, 0xc5, 0xd5, 0xd2, 0x37, 0xe7, 0x27, 0xcb, 0xe1, 0x28, 0xd5,
+	0x9f, 0x80, 0x57, 0x13, 0xf3, 0xa8, 0x22, 0xe2, 0x62, 0x87, 0x20, 0x71, 0x01, 0x4c, 0xf3, 0x0e,
+	0x37, 0x75, 0x3e, 0x97, 0x39, 0x35, 0xcf, 0x3e, 0xb7, 0xf4, 0xfa, 0x57, 0x7c, 0x82, 0x9f, 0x21,
+	0x0b, 0xfd, 0x8f, 0x09, 0x1e, 0xb0, 0x9e, 0x19, 0xb4, 0x9e, 0x8a, 0x6e, 0x81, 0x47, 0x17, 0xfb,
+	0x0a, 0xa3, 0xab, 0xff, 0x91, 0xe1, 0x51, 0xec, 0xbb, 0x3a, 0xbc, 0x85, 0x28, 0x2e, 0x5b, 0x30,
+	0xd9, 0x2b, 0x2d, 0x98, 0xdc, 0xa4, 0x0b, 0xe6, 0xce, 0x90, 0x05, 0xf3, 0x41, 0x6a, 0xc1, 0xe4,
+	0xc7, 0x2c, 0x98, 0xeb, 0x6d, 0x95, 0x14, 0xdd, 0xfb, 0x9c, 0xee, 0x98, 0xd4, 0xa8, 0x19, 0xd6,
+	0x40, 0x1e, 0x11, 0xcd, 0xc3, 0xc7, 0x9c, 0xdb, 0x49, 0x17, 0x5d, 0xa0, 0x53, 0xff, 0x51, 0x00,
+	0xa5, 0x6d, 0x62, 0xec, 0x61, 0x77, 0xdf, 0xbd, 0xf9, 0x5a, 0xad, 0x81, 0x3c, 0xb4, 0x71, 0xd7,
+	0xa1, 0xbc, 0x3c, 0x13, 0xc7, 0xe6, 0xeb, 0xa4, 0x08, 0xd8, 0x03, 0xf3, 0x83, 0x81, 0xde, 0x50,
+	0xfe, 0x47, 0xe0, 0x1e, 0x9b, 0x31, 0xe8, 0x68, 0xc8, 0xfa, 0x3c, 0x58, 0x36, 0xd7, 0xe2, 0x20,
+	0xb5, 0xbf, 0x32, 0xe9, 0xfd, 0x95, 0xca, 0x66, 0x0b, 0x2c, 0x5e, 0xf0, 0x1b, 0xa5, 0xf4, 0x08,
+	0x88, 0x1a, 0x3f, 0xe1, 0x2d, 0xee, 0x33, 0x4b, 0x24, 0xa1, 0x96, 0x6d, 0xe4, 0xd4, 0xbb, 0xd1,
+	0xc9, 0x1e, 0xe7, 0x98, 0xd4, 0x7f, 0xc9, 0x80, 0x85, 0x68, 0x4f, 0xec, 0xf0, 0x1b, 0x7d, 0x37,
+	0x98, 0x82, 0x6b, 0x65, 0xb2, 0x0f, 0x80, 0x87, 0x34, 0xd3, 0x35, 0x91, 0x43, 0x89, 0x94, 0xe1,
+	0x7d, 0xba, 0x3c, 0x62, 0x97, 0xf9, 0x2e, 0xd5, 0x50, 0x25, 0x71, 0x9d, 0xc6, 0x86, 0xd8, 0xfe,
+	0x26, 0x14, 0x7a, 0xf4, 0xc2, 0xcc, 0x96, 0xb9, 0x38, 0x31, 0xaf, 0x17, 0x2e, 0xc9, 0xdc, 0xf0,
+	0x4b, 0xf2, 0x6d, 0x50, 0xa6, 0x98, 0x42, 0xab, 0xe5, 0x21, 0x0b, 0x41, 0x82, 0x48, 0x30, 0xaf,
+	0xb3, 0x5c, 0xaa, 0x06, 0xc2, 0x14, 0xf5, 0xcf, 0x05, 0xb0, 0x74, 0x09, 0x5f, 0x51, 0x05, 0x5e,
+	0x03, 0x05, 0xff, 0x6d, 0x14, 0xef, 0xd8, 0x19, 0x5f, 0xb0, 0xa5, 0x8b, 0x9d, 0xa8, 0xe3, 0x7c,
+	0x72, 0x46, 0x74, 0xdc, 0x7b, 0x8c, 0x8b, 0x9f, 0xff, 0x5e, 0x6a, 0x18, 0x26, 0xed, 0x74, 0xdb,
+	0xb2, 0x86, 0xed, 0xe0, 0x69, 0x18, 0xfc, 0x7b, 0x4c, 0xf4, 0x43, 0x85, 0xf6, 0x5d, 0x44, 0xb8,
+	0x02, 0x49, 0x76, 0x67, 0xcf, 0xaf, 0x2c, 0xaf, 0xf8, 0x0d, 0x54, 0x36, 0x91, 0x55, 0x26, 0x99,
+	0x55, 0x8a, 0xa4, 0x7f, 0x03, 0x92, 0x86, 0xb8, 0x8e, 0x48, 0xb2, 0xc0, 0x4c, 0xc0, 0xbb, 0xce,
+	0x9b, 0xf3, 0x36, 0x98, 0x88, 0x3c, 0xf8, 0xde, 0x0e, 0xba, 0x8e, 0x8e, 0xf4, 0x5b, 0xe3, 0x3d,
+	0xf2, 0x50, 0xff, 0x4d, 0x00, 0x73, 0xd1, 0xbe, 0xdd, 0x81, 0x1e, 0xb4, 0x89, 0xf8, 0x3e, 0x28,
+	0xc0, 0x2e, 0xed, 0x60, 0xcf, 0xa4, 0xfd, 0xb1, 0xa4, 0xc7, 0x50, 0xf1, 0x43, 0x90, 0x77, 0xb9,
+	0x05, 0xce, 0x79, 0xb1, 0xf9, 0xc6, 0xc8, 0x61, 0x62, 0xc0, 0xf5, 0x1c, 0x8b, 0x5f, 0x0d, 0xd4,
+	0x56, 0x15, 0x56, 0x9a, 0xd8, 0xe0, 0x77, 0xe7, 0x27, 0xcb, 0xaf, 0xeb, 0x7d, 0x12, 0x3f, 0xe5,
+	0x53, 0x91, 0xd6, 0x17, 0x79, 0xdf, 0x0c, 0x8a, 0xc2, 0xa2, 0x35, 0x7f, 0x9a, 0x06, 0xd9, 0x6d,
+	0x62, 0x88, 0x1d, 0x00, 0x06, 0x5e, 0xfa, 0xa3, 0x1e, 0x83, 0x89, 0x37, 0x48, 0xe5, 0xc9, 0xa4,
+	0xc8, 0xa8, 0x4d, 0x3a, 0x00, 0x0c, 0xbc, 0x48, 0xc6, 0x78, 0x8a, 0x91, 0xe3, 0x3c, 0x5d, 0x7c,
+	0x79, 0x30, 0x4f, 0x03, 0xaf, 0x8e, 0x31, 0x9e, 0x62, 0xe4, 0x38, 0x4f, 0x43, 0x2e, 0x5d, 0x04,
+	0x0a, 0xf1, 0x95, 0xf9, 0x60, 0xb4, 0x7a, 0x04, 0xac, 0x28, 0x13, 0x02, 0x23, 0x37, 0x14, 0x94,
+	0x53, 0x57, 0xd3, 0xa3, 0x31, 0xf4, 0x27, 0xd0, 0x95, 0x77, 0xaf, 0x82, 0x8e, 0xbc, 0x7e, 0x2b,
+	0x80, 0xf9, 0xe1, 0xb7, 0xc9, 0x24, 0xb5, 0x4f, 0xea, 0x54, 0x56, 0xaf, 0xae, 0x93, 0x0c, 0x64,
+	0xe8, 0xf2, 0x9b, 0x24, 0xaf, 0x2b, 0x06, 0x32, 0x6a, 0xd3, 0x39, 0xa0, 0x94, 0xd8, 0x04, 0xcb,
+	0x93, 0x34, 0x8c, 0x8f, 0xad, 0x34, 0x27, 0xc7, 0x86, 0xfe, 0x2a, 0x77, 0xbe, 0x66, 0xeb, 0x68,
+	0x7d, 0xed, 0xc5, 0x69, 0x55, 0x78, 0x79, 0x5a, 0x15, 0xfe, 0x39, 0xad, 0x0a, 0x3f, 0x9c, 0x55,
+	0xa7, 0x5e, 0x9e, 0x55, 0xa7, 0xfe, 0x3c, 0xab, 0x4e, 0x7d, 0x59, 0x4f, 0xd9, 0xc4, 0xb6, 0xd2,
+	0x8b, 0xd7, 0x01, 0xdf, 0x6b, 0xed, 0x3c, 0x3f, 0x7e, 0xe7, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x24, 0x8d, 0x68, 0x32, 0x01, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateTask creates a new scheduled task
+	CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error)
+	// DeleteTask deletes a scheduled task
+	DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error)
+	// UpdateTask changes the schedule, gas and messages of a scheduled task
+	UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error)
+	// TopUpTask adds funds to the fee escrow of a recurring or conditional task
+	TopUpTask(ctx context.Context, in *MsgTopUpTask, opts ...grpc.CallOption) (*MsgTopUpTaskResponse, error)
+	// CancelWorkflow cancels every scheduled or pending task of a workflow
+	CancelWorkflow(ctx context.Context, in *MsgCancelWorkflow, opts ...grpc.CallOption) (*MsgCancelWorkflowResponse, error)
+	// CreatePayoutSchedule locks funds in escrow and releases them to
+	// recipients on a schedule
+	CreatePayoutSchedule(ctx context.Context, in *MsgCreatePayoutSchedule, opts ...grpc.CallOption) (*MsgCreatePayoutScheduleResponse, error)
+	// CancelPayoutSchedule stops a payout schedule, pays the recipients the
+	// share vested so far and refunds the rest to the creator
+	CancelPayoutSchedule(ctx context.Context, in *MsgCancelPayoutSchedule, opts ...grpc.CallOption) (*MsgCancelPayoutScheduleResponse, error)
+	// UpdateParams updates the parameters of the x/crontask module
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error) {
+	out := new(MsgCreateTaskResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/CreateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteTask(ctx context.Context, in *MsgDeleteTask, opts ...grpc.CallOption) (*MsgDeleteTaskResponse, error) {
+	out := new(MsgDeleteTaskResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/DeleteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTask(ctx context.Context, in *MsgUpdateTask, opts ...grpc.CallOption) (*MsgUpdateTaskResponse, error) {
+	out := new(MsgUpdateTaskResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/UpdateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpTask(ctx context.Context, in *MsgTopUpTask, opts ...grpc.CallOption) (*MsgTopUpTaskResponse, error) {
+	out := new(MsgTopUpTaskResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/TopUpTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelWorkflow(ctx context.Context, in *MsgCancelWorkflow, opts ...grpc.CallOption) (*MsgCancelWorkflowResponse, error) {
+	out := new(MsgCancelWorkflowResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/CancelWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePayoutSchedule(ctx context.Context, in *MsgCreatePayoutSchedule, opts ...grpc.CallOption) (*MsgCreatePayoutScheduleResponse, error) {
+	out := new(MsgCreatePayoutScheduleResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/CreatePayoutSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelPayoutSchedule(ctx context.Context, in *MsgCancelPayoutSchedule, opts ...grpc.CallOption) (*MsgCancelPayoutScheduleResponse, error) {
+	out := new(MsgCancelPayoutScheduleResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/CancelPayoutSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.crontask.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateTask creates a new scheduled task
+	CreateTask(context.Context, *MsgCreateTask) (*MsgCreateTaskResponse, error)
+	// DeleteTask deletes a scheduled task
+	DeleteTask(context.Context, *MsgDeleteTask) (*MsgDeleteTaskResponse, error)
+	// UpdateTask changes the schedule, gas and messages of a scheduled task
+	UpdateTask(context.Context, *MsgUpdateTask) (*MsgUpdateTaskResponse, error)
+	// TopUpTask adds funds to the fee escrow of a recurring or conditional task
+	TopUpTask(context.Context, *MsgTopUpTask) (*MsgTopUpTaskResponse, error)
+	// CancelWorkflow cancels every scheduled or pending task of a workflow
+	CancelWorkflow(context.Context, *MsgCancelWorkflow) (*MsgCancelWorkflowResponse, error)
+	// CreatePayoutSchedule locks funds in escrow and releases them to
+	// recipients on a schedule
+	CreatePayoutSchedule(context.Context, *MsgCreatePayoutSchedule) (*MsgCreatePayoutScheduleResponse, error)
+	// CancelPayoutSchedule stops a payout schedule, pays the recipients the
+	// share vested so far and refunds the rest to the creator
+	CancelPayoutSchedule(context.Context, *MsgCancelPayoutSchedule) (*MsgCancelPayoutScheduleResponse, error)
+	// UpdateParams updates the parameters of the x/crontask module
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateTask(ctx context.Context, req *MsgCreateTask) (*MsgCreateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (*UnimplementedMsgServer) DeleteTask(ctx context.Context, req *MsgDeleteTask) (*MsgDeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTask(ctx context.Context, req *MsgUpdateTask) (*MsgUpdateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTask not implemented")
+}
+func (*UnimplementedMsgServer) TopUpTask(ctx context.Context, req *MsgTopUpTask) (*MsgTopUpTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpTask not implemented")
+}
+func (*UnimplementedMsgServer) CancelWorkflow(ctx context.Context, req *MsgCancelWorkflow) (*MsgCancelWorkflowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflow not implemented")
+}
+func (*UnimplementedMsgServer) CreatePayoutSchedule(ctx context.Context, req *MsgCreatePayoutSchedule) (*MsgCreatePayoutScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayoutSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelPayoutSchedule(ctx context.Context, req *MsgCancelPayoutSchedule) (*MsgCancelPayoutScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPayoutSchedule not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/CreateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTask(ctx, req.(*MsgCreateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/DeleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteTask(ctx, req.(*MsgDeleteTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/UpdateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTask(ctx, req.(*MsgUpdateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/TopUpTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpTask(ctx, req.(*MsgTopUpTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelWorkflow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/CancelWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelWorkflow(ctx, req.(*MsgCancelWorkflow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePayoutSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/CreatePayoutSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePayoutSchedule(ctx, req.(*MsgCreatePayoutSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPayoutSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/CancelPayoutSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPayoutSchedule(ctx, req.(*MsgCancelPayoutSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.crontask.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dysonprotocol.crontask.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTask",
+			Handler:    _Msg_CreateTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _Msg_DeleteTask_Handler,
+		},
+		{
+			MethodName: "UpdateTask",
+			Handler:    _Msg_UpdateTask_Handler,
+		},
+		{
+			MethodName: "TopUpTask",
+			Handler:    _Msg_TopUpTask_Handler,
+		},
+		{
+			MethodName: "CancelWorkflow",
+			Handler:    _Msg_CancelWorkflow_Handler,
+		},
+		{
+			MethodName: "CreatePayoutSchedule",
+			Handler:    _Msg_CreatePayoutSchedule_Handler,
+		},
+		{
+			MethodName: "CancelPayoutSchedule",
+			Handler:    _Msg_CancelPayoutSchedule_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dysonprotocol/crontask/v1/tx.proto",
+}
+
+func (m *MsgCreateTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Callback != nil {
+		{
+			size, err := m.Callback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.ExecutionPhase) > 0 {
+		i -= len(m.ExecutionPhase)
+		copy(dAtA[i:], m.ExecutionPhase)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExecutionPhase)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Dependencies) > 0 {
+		for iNdEx := len(m.Dependencies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dependencies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.Condition != nil {
+		{
+			size, err := m.Condition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.EndTimestamp) > 0 {
+		i -= len(m.EndTimestamp)
+		copy(dAtA[i:], m.EndTimestamp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EndTimestamp)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxOccurrences != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxOccurrences))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.TaskGasFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TaskGasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ExpiryTimestamp) > 0 {
+		i -= len(m.ExpiryTimestamp)
+		copy(dAtA[i:], m.ExpiryTimestamp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiryTimestamp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ScheduledTimestamp) > 0 {
+		i -= len(m.ScheduledTimestamp)
+		copy(dAtA[i:], m.ScheduledTimestamp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduledTimestamp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TaskGasFee != nil {
+		{
+			size, err := m.TaskGasFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TaskGasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExpiryTimestamp) > 0 {
+		i -= len(m.ExpiryTimestamp)
+		copy(dAtA[i:], m.ExpiryTimestamp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiryTimestamp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ScheduledTimestamp) > 0 {
+		i -= len(m.ScheduledTimestamp)
+		copy(dAtA[i:], m.ScheduledTimestamp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScheduledTimestamp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.TaskId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TaskId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWorkflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWorkflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWorkflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelWorkflowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelWorkflowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelWorkflowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CancelledTaskIds) > 0 {
+		dAtA10 := make([]byte, len(m.CancelledTaskIds)*10)
+		var j9 int
+		for _, num := range m.CancelledTaskIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintTx(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePayoutSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePayoutSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePayoutSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalReleases != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TotalReleases))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IntervalSeconds != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StartTimestamp) > 0 {
+		i -= len(m.StartTimestamp)
+		copy(dAtA[i:], m.StartTimestamp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartTimestamp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePayoutScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePayoutScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePayoutScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Escrow) > 0 {
+		for iNdEx := len(m.Escrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PayoutId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayoutId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPayoutSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPayoutSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPayoutSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PayoutId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PayoutId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPayoutScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPayoutScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPayoutScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScheduledTimestamp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpiryTimestamp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskGasLimit != 0 {
+		n += 1 + sovTx(uint64(m.TaskGasLimit))
+	}
+	l = m.TaskGasFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovTx(uint64(m.IntervalSeconds))
+	}
+	if m.MaxOccurrences != 0 {
+		n += 1 + sovTx(uint64(m.MaxOccurrences))
+	}
+	l = len(m.EndTimestamp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Condition != nil {
+		l = m.Condition.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Dependencies) > 0 {
+		for _, e := range m.Dependencies {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExecutionPhase)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 2 + sovTx(uint64(m.ScheduledHeight))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.Callback != nil {
+		l = m.Callback.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgDeleteTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	return n
+}
+
+func (m *MsgDeleteTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	l = len(m.ScheduledTimestamp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpiryTimestamp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskGasLimit != 0 {
+		n += 1 + sovTx(uint64(m.TaskGasLimit))
+	}
+	if m.TaskGasFee != nil {
+		l = m.TaskGasFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TaskId != 0 {
+		n += 1 + sovTx(uint64(m.TaskId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelWorkflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelWorkflowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CancelledTaskIds) > 0 {
+		l = 0
+		for _, e := range m.CancelledTaskIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgCreatePayoutSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.StartTimestamp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntervalSeconds != 0 {
+		n += 1 + sovTx(uint64(m.IntervalSeconds))
+	}
+	if m.TotalReleases != 0 {
+		n += 1 + sovTx(uint64(m.TotalReleases))
+	}
+	return n
+}
+
+func (m *MsgCreatePayoutScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PayoutId != 0 {
+		n += 1 + sovTx(uint64(m.PayoutId))
+	}
+	if len(m.Escrow) > 0 {
+		for _, e := range m.Escrow {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCancelPayoutSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PayoutId != 0 {
+		n += 1 + sovTx(uint64(m.PayoutId))
+	}
+	return n
+}
+
+func (m *MsgCancelPayoutScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+			}
+			m.TaskGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TaskGasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
+			}
+			m.IntervalSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOccurrences", wireType)
+			}
+			m.MaxOccurrences = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOccurrences |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Condition == nil {
+				m.Condition = &TaskCondition{}
+			}
+			if err := m.Condition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dependencies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dependencies = append(m.Dependencies, TaskDependency{})
+			if err := m.Dependencies[len(m.Dependencies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionPhase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionPhase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &RetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Callback == nil {
+				m.Callback = &TaskCallback{}
+			}
+			if err := m.Callback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+			}
+			m.TaskGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskGasFee == nil {
+				m.TaskGasFee = &types.Coin{}
+			}
+			if err := m.TaskGasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &any.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			m.TaskId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TaskId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelWorkflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelWorkflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelWorkflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgCancelWorkflowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelWorkflowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelWorkflowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CancelledTaskIds = append(m.CancelledTaskIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CancelledTaskIds) == 0 {
+					m.CancelledTaskIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CancelledTaskIds = append(m.CancelledTaskIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledTaskIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePayoutSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -936,10 +4274,10 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePayoutSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePayoutSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -976,9 +4314,9 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -988,27 +4326,29 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScheduledTimestamp = string(dAtA[iNdEx:postIndex])
+			m.Recipients = append(m.Recipients, PayoutRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1036,13 +4376,13 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpiryTimestamp = string(dAtA[iNdEx:postIndex])
+			m.StartTimestamp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalSeconds", wireType)
 			}
-			m.TaskGasLimit = 0
+			m.IntervalSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1052,49 +4392,16 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskGasLimit |= uint64(b&0x7F) << shift
+				m.IntervalSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGasFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TaskGasFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReleases", wireType)
 			}
-			var msglen int
+			m.TotalReleases = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1104,26 +4411,11 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalReleases |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Msgs = append(m.Msgs, &any.Any{})
-			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1145,7 +4437,7 @@ func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePayoutScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1168,17 +4460,17 @@ func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePayoutScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePayoutScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutId", wireType)
 			}
-			m.TaskId = 0
+			m.PayoutId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1188,11 +4480,45 @@ func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				m.PayoutId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrow = append(m.Escrow, types.Coin{})
+			if err := m.Escrow[len(m.Escrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1214,7 +4540,7 @@ func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPayoutSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1237,10 +4563,10 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPayoutSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPayoutSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1277,9 +4603,9 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutId", wireType)
 			}
-			m.TaskId = 0
+			m.PayoutId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1289,7 +4615,7 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TaskId |= uint64(b&0x7F) << shift
+				m.PayoutId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1315,7 +4641,7 @@ func (m *MsgDeleteTask) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPayoutScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1338,12 +4664,80 @@ func (m *MsgDeleteTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPayoutScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPayoutScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DependencyOutcome constants define the outcomes of a parent task a dependency can require
+const (
+	DependencyOutcome_SUCCESS = "SUCCESS"
+	DependencyOutcome_FAILURE = "FAILURE"
+)
+
+const (
+	// MaxTaskDependencies is the maximum number of dependencies of a task
+	MaxTaskDependencies = 16
+
+	// MaxWorkflowIDLength is the maximum length of a workflow ID
+	MaxWorkflowIDLength = 64
+)
+
+// SatisfiedBy reports whether a parent task with the given status satisfies the dependency.
+// The second value reports whether the outcome is final, that is the parent will not run again.
+func (d TaskDependency) SatisfiedBy(status string) (satisfied bool, final bool) {
+	if !IsTerminalStatus(status) {
+		return false, false
+	}
+	switch d.Outcome {
+	case DependencyOutcome_SUCCESS:
+		return status == TaskStatus_DONE, true
+	case DependencyOutcome_FAILURE:
+		return status == TaskStatus_FAILED || status == TaskStatus_EXPIRED, true
+	}
+	return false, true
+}
+
+// ValidateDependencies checks the dependencies and workflow ID of a task. It does not check
+// that the parent tasks exist.
+func (t Task) ValidateDependencies() error {
+	if err := ValidateWorkflowID(t.WorkflowId); err != nil {
+		return err
+	}
+
+	if len(t.Dependencies) > MaxTaskDependencies {
+		return fmt.Errorf("a task can have at most %d dependencies, got %d", MaxTaskDependencies, len(t.Dependencies))
+	}
+
+	seen := make(map[uint64]bool, len(t.Dependencies))
+	for _, dep := range t.Dependencies {
+		if dep.TaskId == t.TaskId {
+			return fmt.Errorf("task %d cannot depend on itself", t.TaskId)
+		}
+		if seen[dep.TaskId] {
+			return fmt.Errorf("duplicate dependency on task %d", dep.TaskId)
+		}
+		seen[dep.TaskId] = true
+
+		if dep.Outcome != DependencyOutcome_SUCCESS && dep.Outcome != DependencyOutcome_FAILURE {
+			return fmt.Errorf("invalid outcome %q of dependency on task %d, must be %s or %s",
+				dep.Outcome, dep.TaskId, DependencyOutcome_SUCCESS, DependencyOutcome_FAILURE)
+		}
+	}
+	return nil
+}
+
+// ValidateWorkflowID checks that a workflow ID is empty or made of at most MaxWorkflowIDLength
+// letters, digits, '-', '_' and '.'
+func ValidateWorkflowID(id string) error {
+	if len(id) > MaxWorkflowIDLength {
+		return fmt.Errorf("workflow ID cannot be longer than %d characters", MaxWorkflowIDLength)
+	}
+	for _, c := range id {
+		isAlnum := c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+		if !isAlnum && c != '-' && c != '_' && c != '.' {
+			return fmt.Errorf("invalid character %q in workflow ID %q", c, id)
+		}
+	}
+	return nil
+}
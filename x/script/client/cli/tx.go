@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -33,6 +34,10 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(NewCreateNewScriptCmd())
 	txCmd.AddCommand(NewExecScriptCmd())
 	txCmd.AddCommand(CmdGrantScriptExecAuthorization())
+	txCmd.AddCommand(NewRegisterSnapshotQueryCmd())
+	txCmd.AddCommand(NewDeleteSnapshotQueryCmd())
+	txCmd.AddCommand(NewSetGasSponsorshipCmd())
+	txCmd.AddCommand(NewDeleteGasSponsorshipCmd())
 
 	return txCmd
 }
@@ -338,6 +343,18 @@ Examples:
   $ dysond tx script grant-exec <grantee-addr> \
     --script-address=<scriptAddr> \
     --expiration="2025-06-30T12:00:00Z" \
+    --from=<granter-key-or-address>
+
+  # Session key: 1000 calls to move() with a direction argument, at most
+  # 10 calls per 10 blocks, and no funds can be sent
+  $ dysond tx script grant-exec <grantee-addr> \
+    --script-address=<scriptAddr> \
+    --function-names=move \
+    --restrict-attached-messages \
+    --max-calls=1000 \
+    --max-calls-per-window=10 --window-blocks=10 \
+    --args-pattern='move=\["(up|down|left|right)"\]' \
+    --expiration="2025-06-30T12:00:00Z" \
     --from=<granter-key-or-address>`,
 		Args: cobra.ExactArgs(1), // only one positional: grantee-address
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -391,13 +408,66 @@ Examples:
 				return fmt.Errorf("expiration timestamp invalid: %w", err)
 			}
 
-			// 6. Construct custom ScriptExecAuthorization
+			// 6. Read the optional session limits
+			maxCalls, err := cmd.Flags().GetUint64("max-calls")
+			if err != nil {
+				return err
+			}
+			maxFundsStr, err := cmd.Flags().GetString("max-funds")
+			if err != nil {
+				return err
+			}
+			maxFunds, err := sdk.ParseCoinsNormalized(maxFundsStr)
+			if err != nil {
+				return fmt.Errorf("invalid max funds: %w", err)
+			}
+			restrictAttached, err := cmd.Flags().GetBool("restrict-attached-messages")
+			if err != nil {
+				return err
+			}
+			expiryHeight, err := cmd.Flags().GetInt64("expiry-height")
+			if err != nil {
+				return err
+			}
+			maxCallsPerWindow, err := cmd.Flags().GetUint64("max-calls-per-window")
+			if err != nil {
+				return err
+			}
+			windowBlocks, err := cmd.Flags().GetInt64("window-blocks")
+			if err != nil {
+				return err
+			}
+			argsPatterns, err := cmd.Flags().GetStringArray("args-pattern")
+			if err != nil {
+				return err
+			}
+			kwargsPatterns, err := cmd.Flags().GetStringArray("kwargs-pattern")
+			if err != nil {
+				return err
+			}
+			constraints, err := parseArgumentConstraints(argsPatterns, kwargsPatterns)
+			if err != nil {
+				return err
+			}
+
+			// 7. Construct custom ScriptExecAuthorization
 			customAuth := &scripttypes.ScriptExecAuthorization{
-				ScriptAddress: scriptAddrStr,
-				FunctionNames: fnames,
+				ScriptAddress:       scriptAddrStr,
+				FunctionNames:       fnames,
+				MaxCalls:            maxCalls,
+				MaxFunds:            maxFunds,
+				ExpiryHeight:        expiryHeight,
+				ArgumentConstraints: constraints,
+				MaxCallsPerWindow:   maxCallsPerWindow,
+				WindowBlocks:        windowBlocks,
+				// Max funds only bound attached messages of a restricted grant
+				RestrictAttachedMessages: restrictAttached || !maxFunds.IsZero(),
+			}
+			if err := customAuth.ValidateBasic(); err != nil {
+				return err
 			}
 
-			// 7. Wrap in MsgGrant
+			// 8. Wrap in MsgGrant
 			expTimeValue := ts.AsTime()
 			msg, err := authztypes.NewMsgGrant(
 				granterAddr,
@@ -409,15 +479,23 @@ Examples:
 				return fmt.Errorf("failed to create MsgGrant: %w", err)
 			}
 
-			// 8. Broadcast transaction via standard SDK machinery
+			// 9. Broadcast transaction via standard SDK machinery
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	// 9. Define flags
+	// 10. Define flags
 	cmd.Flags().String("script-address", "", "Bech32 address of the script to authorize (required)")
 	cmd.Flags().StringSlice("function-names", []string{}, "Comma-separated list of function names allowed (optional)")
 	cmd.Flags().String("expiration", "", "Expiration time as RFC3339 timestamp (e.g. 2025-06-30T12:00:00Z) (required)")
+	cmd.Flags().Uint64("max-calls", 0, "Number of executions allowed before the grant is deleted, 0 for no limit")
+	cmd.Flags().String("max-funds", "", "Total amount attached bank sends may transfer (e.g. 100dys), implies --restrict-attached-messages")
+	cmd.Flags().Bool("restrict-attached-messages", false, "Only allow attached bank sends bounded by --max-funds, none if --max-funds is empty")
+	cmd.Flags().Int64("expiry-height", 0, "Block height from which the grant is no longer accepted, 0 for none")
+	cmd.Flags().Uint64("max-calls-per-window", 0, "Maximum executions within --window-blocks blocks, 0 for no rate limit")
+	cmd.Flags().Int64("window-blocks", 0, "Length in blocks of the rate limit window")
+	cmd.Flags().StringArray("args-pattern", []string{}, "Regex the JSON args of a function must match, as function=regex (can be used multiple times)")
+	cmd.Flags().StringArray("kwargs-pattern", []string{}, "Regex the JSON kwargs of a function must match, as function=regex (can be used multiple times)")
 
 	// Mark required flags
 	_ = cmd.MarkFlagRequired("script-address")
@@ -427,3 +505,193 @@ Examples:
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// parseArgumentConstraints builds argument constraints from function=regex flag values
+func parseArgumentConstraints(argsPatterns, kwargsPatterns []string) ([]scripttypes.ArgumentConstraint, error) {
+	var constraints []scripttypes.ArgumentConstraint
+	byFunction := make(map[string]int)
+
+	constraintFor := func(value string) (*scripttypes.ArgumentConstraint, string, error) {
+		fn, pattern, ok := strings.Cut(value, "=")
+		if !ok || fn == "" {
+			return nil, "", fmt.Errorf("invalid pattern %q, expected function=regex", value)
+		}
+		i, exists := byFunction[fn]
+		if !exists {
+			i = len(constraints)
+			byFunction[fn] = i
+			constraints = append(constraints, scripttypes.ArgumentConstraint{FunctionName: fn})
+		}
+		return &constraints[i], pattern, nil
+	}
+
+	for _, value := range argsPatterns {
+		c, pattern, err := constraintFor(value)
+		if err != nil {
+			return nil, err
+		}
+		c.ArgsPattern = pattern
+	}
+	for _, value := range kwargsPatterns {
+		c, pattern, err := constraintFor(value)
+		if err != nil {
+			return nil, err
+		}
+		c.KwargsPattern = pattern
+	}
+
+	return constraints, nil
+}
+
+// NewRegisterSnapshotQueryCmd returns the CLI command handler for registering a snapshot query.
+func NewRegisterSnapshotQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-snapshot-query [name] [json-query]",
+		Short: "Registers a query whose result is recorded on-chain every snapshot interval",
+		Long: `Registers (or replaces) a snapshot query owned by the sender (--from).
+The query result is recorded in EndBlock every snapshot_interval blocks and the
+latest snapshot_retention snapshots are kept. Snapshots can be read with
+'dysond query script snapshot' or from scripts with the
+/dysonprotocol.script.v1.QuerySnapshotRequest query.
+
+Examples:
+  $ dysond tx script register-snapshot-query my-balance '{"@type":"/cosmos.bank.v1beta1.QueryBalanceRequest","address":"dys1...","denom":"dys"}' --from myaccount`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &scripttypes.MsgRegisterSnapshotQuery{
+				Owner:     clientCtx.GetFromAddress().String(),
+				Name:      args[0],
+				JsonQuery: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteSnapshotQueryCmd returns the CLI command handler for deleting a snapshot query.
+func NewDeleteSnapshotQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-snapshot-query [name]",
+		Short: "Deletes a snapshot query of the sender and all of its snapshots",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &scripttypes.MsgDeleteSnapshotQuery{
+				Owner: clientCtx.GetFromAddress().String(),
+				Name:  args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSetGasSponsorshipCmd returns the CLI command handler for setting the gas sponsorship of a script.
+func NewSetGasSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-gas-sponsorship [spend-limit]",
+		Short: "Sets the policy under which the sender's script pays the fees of calls to it",
+		Long: `Sets (or replaces) the gas sponsorship of the script at the sender's address (--from).
+When a transaction only contains MsgExec calls to the script that satisfy the policy,
+its fee is deducted from the script account instead of the signer. The spend limit is
+the total fee budget and is reduced by every sponsored fee.
+
+Examples:
+  $ dysond tx script set-gas-sponsorship 1000000dys --allowed-functions vote,comment --max-gas 500000 --max-txs-per-user 10 --window-blocks 1000 --from myscript`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid spend limit: %w", err)
+			}
+
+			allowedFunctions, err := cmd.Flags().GetStringSlice("allowed-functions")
+			if err != nil {
+				return err
+			}
+			maxGas, err := cmd.Flags().GetUint64("max-gas")
+			if err != nil {
+				return err
+			}
+			maxTxsPerUser, err := cmd.Flags().GetUint64("max-txs-per-user")
+			if err != nil {
+				return err
+			}
+			windowBlocks, err := cmd.Flags().GetInt64("window-blocks")
+			if err != nil {
+				return err
+			}
+
+			scriptAddress := clientCtx.GetFromAddress().String()
+			msg := &scripttypes.MsgSetGasSponsorship{
+				ScriptAddress: scriptAddress,
+				Sponsorship: scripttypes.GasSponsorship{
+					ScriptAddress:    scriptAddress,
+					SpendLimit:       spendLimit,
+					AllowedFunctions: allowedFunctions,
+					MaxGas:           maxGas,
+					MaxTxsPerUser:    maxTxsPerUser,
+					WindowBlocks:     windowBlocks,
+				},
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice("allowed-functions", nil, "Functions whose calls are sponsored, all functions if empty")
+	cmd.Flags().Uint64("max-gas", 0, "Maximum gas limit of a sponsored transaction, 0 for no limit")
+	cmd.Flags().Uint64("max-txs-per-user", 0, "Maximum sponsored transactions per user within a window, 0 for no limit")
+	cmd.Flags().Int64("window-blocks", 0, "Length in blocks of the per-user rate limit window")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteGasSponsorshipCmd returns the CLI command handler for deleting the gas sponsorship of a script.
+func NewDeleteGasSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-gas-sponsorship",
+		Short: "Stops the script at the sender's address from sponsoring gas",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &scripttypes.MsgDeleteGasSponsorship{
+				ScriptAddress: clientCtx.GetFromAddress().String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
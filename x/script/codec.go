@@ -45,6 +45,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &scripttypes.MsgUpdateScript{}, "dys/script/MsgUpdateScript")
 	legacy.RegisterAminoMsg(cdc, &scripttypes.MsgCreateNewScript{}, "dys/script/MsgCreateNewScript")
 	legacy.RegisterAminoMsg(cdc, &scripttypes.MsgUpdateParams{}, "dys/script/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &scripttypes.MsgRegisterSnapshotQuery{}, "dys/script/MsgRegisterSnapshotQuery")
+	legacy.RegisterAminoMsg(cdc, &scripttypes.MsgDeleteSnapshotQuery{}, "dys/script/MsgDeleteSnapshotQuery")
+	legacy.RegisterAminoMsg(cdc, &scripttypes.MsgSetGasSponsorship{}, "dys/script/MsgSetGasSponsorship")
+	legacy.RegisterAminoMsg(cdc, &scripttypes.MsgDeleteGasSponsorship{}, "dys/script/MsgDeleteGasSponsorship")
 
 	// Register ScriptExecAuthorization
 	cdc.RegisterConcrete(&scripttypes.ScriptExecAuthorization{}, "dys/script/ScriptExecAuthorization", nil)
@@ -72,6 +76,10 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&scripttypes.MsgUpdateScript{}, &scripttypes.MsgUpdateScriptResponse{},
 		&scripttypes.MsgCreateNewScript{}, &scripttypes.MsgCreateNewScriptResponse{},
 		&scripttypes.MsgUpdateParams{}, &scripttypes.MsgUpdateParamsResponse{},
+		&scripttypes.MsgRegisterSnapshotQuery{}, &scripttypes.MsgRegisterSnapshotQueryResponse{},
+		&scripttypes.MsgDeleteSnapshotQuery{}, &scripttypes.MsgDeleteSnapshotQueryResponse{},
+		&scripttypes.MsgSetGasSponsorship{}, &scripttypes.MsgSetGasSponsorshipResponse{},
+		&scripttypes.MsgDeleteGasSponsorship{}, &scripttypes.MsgDeleteGasSponsorshipResponse{},
 		&scripttypes.Script{},
 		&scripttypes.MsgArbitraryData{},
 
@@ -79,6 +87,9 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&scripttypes.QueryScriptInfoRequest{}, &scripttypes.QueryScriptInfoResponse{},
 		&scripttypes.QueryEncodeJsonRequest{}, &scripttypes.QueryEncodeJsonResponse{},
 		&scripttypes.QueryDecodeBytesRequest{}, &scripttypes.QueryDecodeBytesResponse{},
+		&scripttypes.QuerySnapshotRequest{}, &scripttypes.QuerySnapshotResponse{},
+		&scripttypes.QuerySnapshotQueriesRequest{}, &scripttypes.QuerySnapshotQueriesResponse{},
+		&scripttypes.QueryGasSponsorshipRequest{}, &scripttypes.QueryGasSponsorshipResponse{},
 
 		// script web service
 		&scripttypes.WebRequest{}, &scripttypes.WebResponse{},
@@ -1,6 +1,8 @@
 package script
 
 import (
+	"fmt"
+
 	"dysonprotocol.com/x/script/types"
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 )
@@ -14,6 +16,42 @@ func NewGenesisState() *types.GenesisState {
 
 // ValidateGenesis validates the genesis state
 func ValidateGenesis(s *types.GenesisState) error {
+	if err := s.Params.Validate(); err != nil {
+		return err
+	}
+
+	queries := make(map[[2]string]bool, len(s.SnapshotQueries))
+	for _, q := range s.SnapshotQueries {
+		key := [2]string{q.Owner, q.Name}
+		if queries[key] {
+			return fmt.Errorf("duplicate snapshot query %s/%s", q.Owner, q.Name)
+		}
+		queries[key] = true
+	}
+
+	for _, snapshot := range s.Snapshots {
+		if !queries[[2]string{snapshot.Owner, snapshot.Name}] {
+			return fmt.Errorf("snapshot at height %d references unknown snapshot query %s/%s", snapshot.Height, snapshot.Owner, snapshot.Name)
+		}
+	}
+
+	sponsorships := make(map[string]bool, len(s.GasSponsorships))
+	for _, sponsorship := range s.GasSponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return fmt.Errorf("invalid gas sponsorship of %s: %w", sponsorship.ScriptAddress, err)
+		}
+		if sponsorships[sponsorship.ScriptAddress] {
+			return fmt.Errorf("duplicate gas sponsorship %s", sponsorship.ScriptAddress)
+		}
+		sponsorships[sponsorship.ScriptAddress] = true
+	}
+
+	for _, usage := range s.SponsorshipUsages {
+		if !sponsorships[usage.ScriptAddress] {
+			return fmt.Errorf("sponsorship usage of %s references unknown gas sponsorship %s", usage.User, usage.ScriptAddress)
+		}
+	}
+
 	return nil
 }
 
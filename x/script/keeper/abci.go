@@ -85,6 +85,8 @@ func (k Keeper) validateHistoricalBlocks(ctx sdk.Context, params scripttypes.Par
 	return nil
 }
 
+// EndBlocker records snapshots of the registered snapshot queries so historical reads
+// are served from consensus state instead of node-local history
 func (k Keeper) EndBlocker(ctx context.Context) error {
-	return nil
+	return k.TakeSnapshots(sdk.UnwrapSDKContext(ctx))
 }
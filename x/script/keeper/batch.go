@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	cosmossdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	scriptv1 "dysonprotocol.com/api/script/types"
+)
+
+const (
+	// BatchModeAtomic keeps the state changes of a batch only if every sub-message succeeds
+	BatchModeAtomic = "atomic"
+	// BatchModeBestEffort keeps the state changes of every sub-message that succeeds
+	BatchModeBestEffort = "best_effort"
+
+	// MaxBatchMsgs is the maximum number of sub-messages in a batch
+	MaxBatchMsgs = 64
+
+	// BatchMsgIndexAttribute is added to the events emitted by a batch sub-message
+	BatchMsgIndexAttribute = "batch_msg_index"
+)
+
+// MsgBatchRequest defines a request to dispatch several messages as a batch
+type MsgBatchRequest struct {
+	JsonMsgs []string `json:"json_msgs"`
+	Mode     string   `json:"mode"`
+	GasLimit uint64   `json:"gas_limit"`
+}
+
+// BatchMsgResult is the outcome of a single sub-message of a batch
+type BatchMsgResult struct {
+	Index    int             `json:"index"`
+	TypeURL  string          `json:"type_url"`
+	Success  bool            `json:"success"`
+	Executed bool            `json:"executed"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	GasUsed  uint64          `json:"gas_used"`
+}
+
+// MsgBatchResponse is the outcome of a batch. Success is true when every sub-message succeeded.
+type MsgBatchResponse struct {
+	Mode    string           `json:"mode"`
+	Success bool             `json:"success"`
+	GasUsed uint64           `json:"gas_used"`
+	Results []BatchMsgResult `json:"results"`
+}
+
+// HandleJSONMsgBatch dispatches a batch of JSON messages on behalf of a script.
+//
+// In atomic mode the batch runs in a single cache context that is written only if
+// every sub-message succeeds; execution stops at the first failure. In best-effort
+// mode each sub-message runs in its own cache context which is written on success,
+// and execution continues past failures. The batch runs within the gas the caller has
+// left, capped by its gas limit, and its gas is charged to the caller. A failing
+// sub-message never returns an error, its outcome is reported in the per-message
+// results instead.
+func (k Keeper) HandleJSONMsgBatch(ctx context.Context, scriptAddress sdk.AccAddress, req *MsgBatchRequest) (*MsgBatchResponse, error) {
+	mode := req.Mode
+	if mode == "" {
+		mode = BatchModeAtomic
+	}
+	if mode != BatchModeAtomic && mode != BatchModeBestEffort {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid batch mode %q, expected %q or %q", mode, BatchModeAtomic, BatchModeBestEffort)
+	}
+	if len(req.JsonMsgs) == 0 {
+		return nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "batch must contain at least one message")
+	}
+	if len(req.JsonMsgs) > MaxBatchMsgs {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "batch contains %d messages, maximum is %d", len(req.JsonMsgs), MaxBatchMsgs)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// The batch cannot use more gas than the caller has left
+	gasLimit := sdkCtx.GasMeter().GasRemaining()
+	if req.GasLimit > 0 {
+		gasLimit = min(req.GasLimit, gasLimit)
+	}
+
+	// All sub-messages share the gas limit of the batch
+	batchCtx := sdkCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+	batchCtx, writeBatch := batchCtx.CacheContext()
+
+	resp := &MsgBatchResponse{
+		Mode:    mode,
+		Success: true,
+		Results: make([]BatchMsgResult, len(req.JsonMsgs)),
+	}
+
+	for i, jsonMsg := range req.JsonMsgs {
+		result := &resp.Results[i]
+		result.Index = i
+		result.TypeURL = batchMsgTypeURL(jsonMsg)
+
+		if mode == BatchModeAtomic && !resp.Success {
+			result.Error = "not executed: an earlier message in the atomic batch failed"
+			continue
+		}
+
+		result.Executed = true
+		gasBefore := batchCtx.GasMeter().GasConsumed()
+		respJSON, err := k.dispatchBatchMsg(batchCtx, scriptAddress, i, jsonMsg)
+		result.GasUsed = batchCtx.GasMeter().GasConsumed() - gasBefore
+
+		if err != nil {
+			result.Error = err.Error()
+			resp.Success = false
+			continue
+		}
+
+		result.Success = true
+		result.Response = json.RawMessage(respJSON)
+	}
+
+	// The gas of the batch is charged to the caller
+	resp.GasUsed = batchCtx.GasMeter().GasConsumedToLimit()
+	sdkCtx.GasMeter().ConsumeGas(resp.GasUsed, "script msg batch")
+
+	// An atomic batch that failed discards the state changes of the messages that succeeded
+	if mode == BatchModeAtomic && !resp.Success {
+		for i := range resp.Results {
+			if resp.Results[i].Success {
+				resp.Results[i].Success = false
+				resp.Results[i].Response = nil
+				resp.Results[i].Error = "rolled back: a later message in the atomic batch failed"
+			}
+		}
+	} else {
+		writeBatch()
+	}
+
+	// Outcome events are emitted outside the batch cache so they survive a rollback
+	for _, result := range resp.Results {
+		err := sdkCtx.EventManager().EmitTypedEvent(&scriptv1.EventScriptBatchMsg{
+			ScriptAddress: scriptAddress.String(),
+			Mode:          mode,
+			MsgIndex:      uint32(result.Index),
+			MsgTypeUrl:    result.TypeURL,
+			Success:       result.Success,
+			Error:         result.Error,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// dispatchBatchMsg runs a single sub-message of a batch in its own cache context, writing it
+// to ctx on success. Events of the sub-message are tagged with its index in the batch. Running
+// out of gas is returned as an error so the remaining results can still be reported.
+func (k Keeper) dispatchBatchMsg(ctx sdk.Context, scriptAddress sdk.AccAddress, index int, jsonMsg string) (respJSON string, err error) {
+	// The cache is written by hand so the events are re-emitted with the index attribute only
+	cms := ctx.MultiStore().CacheMultiStore()
+	msgCtx := ctx.WithMultiStore(cms).WithEventManager(sdk.NewEventManager())
+
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			respJSON = ""
+			err = cosmossdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "batch message %d out of gas in %s", index, oog.Descriptor)
+		}
+	}()
+
+	respJSON, err = k.dispatchJSONMsg(msgCtx, scriptAddress, jsonMsg)
+	if err != nil {
+		return "", err
+	}
+
+	cms.Write()
+
+	indexAttr := sdk.NewAttribute(BatchMsgIndexAttribute, strconv.Itoa(index))
+	for _, event := range msgCtx.EventManager().Events() {
+		ctx.EventManager().EmitEvent(event.AppendAttributes(indexAttr))
+	}
+
+	return respJSON, nil
+}
+
+// batchMsgTypeURL returns the @type of a JSON message, or an empty string if it has none
+func batchMsgTypeURL(jsonMsg string) string {
+	var anyMsg map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonMsg), &anyMsg); err != nil {
+		return ""
+	}
+	typeURL, _ := anyMsg["@type"].(string)
+	return typeURL
+}
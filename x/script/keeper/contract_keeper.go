@@ -87,6 +87,8 @@ func (k *Keeper) IBCOnAcknowledgementPacketCallback(
 		} `json:"src_callback"`
 	}
 	var callbackError string
+	// Height of the host chain state the MsgModuleQuerySafe responses were read from
+	var proofHeight uint64
 
 	// Helper function to execute callback with current data
 	executeCallback := func() error {
@@ -168,6 +170,8 @@ func (k *Keeper) IBCOnAcknowledgementPacketCallback(
 			Packet              map[string]interface{}   `json:"packet"`
 			MemoJson            interface{}              `json:"memo_json"`
 			Error               string                   `json:"error"`
+			ProofHeight         uint64                   `json:"proof_height"`
+			QueryResults        []map[string]interface{} `json:"query_results"`
 		}
 		// Convert packet to map with decoded data
 		packetMap := map[string]interface{}{
@@ -220,6 +224,8 @@ func (k *Keeper) IBCOnAcknowledgementPacketCallback(
 			Packet:              packetMap,
 			MemoJson:            memoJSON,
 			Error:               callbackError,
+			ProofHeight:         proofHeight,
+			QueryResults:        k.CollectQueryResults(enrichedAckMsgsJson, enrichedPacketMessages),
 		}
 
 		// Combine src_callback.kwargs with beta_ibc_callback_data_v1
@@ -508,6 +514,9 @@ func (k *Keeper) IBCOnAcknowledgementPacketCallback(
 					}
 
 					logger.Info(fmt.Sprintf("Successfully unmarshalled response %d as sdk.Msg msg: %+v", i, unpackedMsg))
+					if querySafeResponse, ok := unpackedMsg.(*hosttypes.MsgModuleQuerySafeResponse); ok {
+						proofHeight = querySafeResponse.Height
+					}
 					jsonMsg, err := k.cdc.MarshalInterfaceJSON(unpackedMsg)
 					if err != nil {
 						logger.Error("Failed to MarshalJSON msg", "error", err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"cosmossdk.io/collections"
 	"dysonprotocol.com/x/script/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -29,6 +30,30 @@ func (k Keeper) InitGenesis(ctx context.Context, cdc codec.JSONCodec, data json.
 		}
 	}
 
+	for _, q := range genesisState.SnapshotQueries {
+		if err := k.SnapshotQueryMap.Set(ctx, collections.Join(q.Owner, q.Name), q); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range genesisState.Snapshots {
+		if err := k.SnapshotMap.Set(ctx, collections.Join3(s.Owner, s.Name, s.Height), s); err != nil {
+			return err
+		}
+	}
+
+	for _, s := range genesisState.GasSponsorships {
+		if err := k.GasSponsorshipMap.Set(ctx, s.ScriptAddress, s); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range genesisState.SponsorshipUsages {
+		if err := k.SponsorUsageMap.Set(ctx, collections.Join(u.ScriptAddress, u.User), u); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -54,9 +79,49 @@ func (k Keeper) ExportGenesis(ctx context.Context, _ codec.JSONCodec) (*types.Ge
 		scripts = append(scripts, &value)
 	}
 
-	// Create and return a new genesis state with the params and scripts
+	queryIter, err := k.SnapshotQueryMap.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	snapshotQueries, err := queryIter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotIter, err := k.SnapshotMap.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := snapshotIter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	sponsorshipIter, err := k.GasSponsorshipMap.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	sponsorships, err := sponsorshipIter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	usageIter, err := k.SponsorUsageMap.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := usageIter.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	// Create and return a new genesis state with the params, scripts, snapshots and sponsorships
 	return &types.GenesisState{
-		Params:  params,
-		Scripts: scripts,
+		Params:            params,
+		Scripts:           scripts,
+		SnapshotQueries:   snapshotQueries,
+		Snapshots:         snapshots,
+		GasSponsorships:   sponsorships,
+		SponsorshipUsages: usages,
 	}, nil
 }
@@ -31,6 +31,7 @@ import (
 	// Transaction verification imports
 	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -240,3 +241,81 @@ func (k Keeper) VerifyTx(ctx context.Context, req *scripttypes.QueryVerifyTxRequ
 	// All signatures have been successfully verified
 	return &scripttypes.QueryVerifyTxResponse{}, nil
 }
+
+// Snapshot returns the latest snapshot of a snapshot query taken at or before the requested height
+func (k Keeper) Snapshot(ctx context.Context, req *scripttypes.QuerySnapshotRequest) (*scripttypes.QuerySnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Height < 0 {
+		return nil, status.Error(codes.InvalidArgument, "height must not be negative")
+	}
+
+	snapshot, err := k.GetSnapshotAt(ctx, req.Owner, req.Name, req.Height)
+	if err != nil {
+		if cosmossdkerrors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Snapshot lookups are historical reads and are priced by the historical query params
+	params := k.GetParams(ctx)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.GasMeter().ConsumeGas(params.HistoricalQueryBaseGas, "snapshot query")
+	sdkCtx.GasMeter().ConsumeGas(params.HistoricalQueryGasPerByte*uint64(len(snapshot.JsonResponse)), "snapshot query response")
+
+	return &scripttypes.QuerySnapshotResponse{Snapshot: snapshot}, nil
+}
+
+// SnapshotQueries lists the snapshot queries registered by an owner
+func (k Keeper) SnapshotQueries(ctx context.Context, req *scripttypes.QuerySnapshotQueriesRequest) (*scripttypes.QuerySnapshotQueriesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.SnapshotQueryMap,
+		req.Pagination,
+		func(_ collections.Pair[string, string], value scripttypes.SnapshotQuery) (scripttypes.SnapshotQuery, error) {
+			return value, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Owner),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &scripttypes.QuerySnapshotQueriesResponse{
+		SnapshotQueries: results,
+		Pagination:      pageRes,
+	}, nil
+}
+
+// GasSponsorship returns the gas sponsorship policy of a script and optionally a user's usage
+func (k Keeper) GasSponsorship(ctx context.Context, req *scripttypes.QueryGasSponsorshipRequest) (*scripttypes.QueryGasSponsorshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsorship, err := k.GasSponsorshipMap.Get(ctx, req.ScriptAddress)
+	if err != nil {
+		if cosmossdkerrors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "gas sponsorship of %s not found", req.ScriptAddress)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &scripttypes.QueryGasSponsorshipResponse{Sponsorship: &sponsorship}
+	if req.User != "" {
+		usage, err := k.SponsorUsageMap.Get(ctx, collections.Join(req.ScriptAddress, req.User))
+		if err == nil {
+			resp.Usage = &usage
+		} else if !cosmossdkerrors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return resp, nil
+}
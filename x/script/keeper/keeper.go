@@ -38,8 +38,12 @@ import (
 )
 
 var (
-	ScriptMapPrefix = collections.NewPrefix(0)
-	ParamsKey       = collections.NewPrefix(1)
+	ScriptMapPrefix       = collections.NewPrefix(0)
+	ParamsKey             = collections.NewPrefix(1)
+	SnapshotQueriesPrefix = collections.NewPrefix(2)
+	SnapshotsPrefix       = collections.NewPrefix(3)
+	GasSponsorshipsPrefix = collections.NewPrefix(4)
+	SponsorUsagePrefix    = collections.NewPrefix(5)
 )
 
 // Ensure the keeper types implement required interfaces
@@ -95,6 +99,15 @@ type Keeper struct {
 	ScriptMap collections.Map[string, scripttypes.Script]
 	params    collections.Item[scripttypes.Params]
 
+	// SnapshotQueryMap maps (owner, name) to a registered snapshot query
+	SnapshotQueryMap collections.Map[collections.Pair[string, string], scripttypes.SnapshotQuery]
+	// SnapshotMap maps (owner, name, height) to the snapshot taken at that height
+	SnapshotMap collections.Map[collections.Triple[string, string, int64], scripttypes.Snapshot]
+	// GasSponsorshipMap maps a script address to its gas sponsorship policy
+	GasSponsorshipMap collections.Map[string, scripttypes.GasSponsorship]
+	// SponsorUsageMap maps (script address, user) to the user's rate limit window
+	SponsorUsageMap collections.Map[collections.Pair[string, string], scripttypes.SponsorshipUsage]
+
 	currentDepth int
 
 	// Authority for governance operations
@@ -153,6 +166,10 @@ func NewKeeper(
 		AuthzKeeper:        authzKeeper,
 		ScriptMap:          collections.NewMap(sb, ScriptMapPrefix, "script_map", collections.StringKey, codec.CollValue[scripttypes.Script](cdc)),
 		params:             collections.NewItem(sb, ParamsKey, "params", codec.CollValue[scripttypes.Params](cdc)),
+		SnapshotQueryMap:   collections.NewMap(sb, SnapshotQueriesPrefix, "snapshot_queries", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[scripttypes.SnapshotQuery](cdc)),
+		SnapshotMap:        collections.NewMap(sb, SnapshotsPrefix, "snapshots", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Int64Key), codec.CollValue[scripttypes.Snapshot](cdc)),
+		GasSponsorshipMap:  collections.NewMap(sb, GasSponsorshipsPrefix, "gas_sponsorships", collections.StringKey, codec.CollValue[scripttypes.GasSponsorship](cdc)),
+		SponsorUsageMap:    collections.NewMap(sb, SponsorUsagePrefix, "sponsorship_usage", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[scripttypes.SponsorshipUsage](cdc)),
 		MsgRouterService:   msgServiceRouter,
 		QueryRouterService: queryServiceRouter,
 		authority:          authority,
@@ -313,6 +330,12 @@ func GetResponseTypeURL(reqTypeURL string) string {
 }
 
 func (k Keeper) HandleJSONAnyQuery(ctx context.Context, req *QueryRequest) (string, error) {
+	// Reads at a past height depend on the history each node retains, so every node rejects
+	// them the same way. Historical values are read from snapshot queries instead.
+	if req.QueryHeight != 0 {
+		return "", cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query height %d is not supported, use a snapshot query for historical reads", req.QueryHeight)
+	}
+
 	// Parse the type URL from the JSON
 	var anyMsg map[string]interface{}
 	err := json.Unmarshal([]byte(req.JsonQuery), &anyMsg)
@@ -354,28 +377,6 @@ func (k Keeper) HandleJSONAnyQuery(ctx context.Context, req *QueryRequest) (stri
 	// Unwrap the SDK context
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	// Handle historical queries if QueryHeight is specified
-	if req.QueryHeight != 0 {
-
-		if req.QueryHeight < 0 {
-			return "", cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query height must be greater than 0")
-		}
-
-		// Get module parameters to determine the maximum allowed historical query height
-		params := k.GetParams(ctx)
-		maxRelativeHistoricalBlocks := params.MaxRelativeHistoricalBlocks
-
-		relativeHeight := sdkCtx.BlockHeight() - req.QueryHeight
-		if relativeHeight > maxRelativeHistoricalBlocks {
-			return "", cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Max relative historical query height is %d blocks in the past", maxRelativeHistoricalBlocks)
-		}
-
-		sdkCtx, err = k.App.CreateQueryContextWithCheckHeader(req.QueryHeight, false, false)
-		if err != nil {
-			return "", err
-		}
-	}
-
 	path := ConvertRPCPath(typeURL)
 
 	handler := k.QueryRouterService.Route(path)
@@ -387,16 +388,8 @@ func (k Keeper) HandleJSONAnyQuery(ctx context.Context, req *QueryRequest) (stri
 		Data: binaryData,
 	}
 
-	// For historical queries, use the historical context directly
-	// For current queries, use a cached context to avoid state changes
-	var queryCtx sdk.Context
-	if req.QueryHeight != 0 {
-		// Historical query - use the historical context directly
-		queryCtx = sdkCtx
-	} else {
-		// Current query - use cached context to avoid state changes
-		queryCtx, _ = sdkCtx.CacheContext()
-	}
+	// Use a cached context to avoid state changes
+	queryCtx, _ := sdkCtx.CacheContext()
 
 	respQuery, err := handler(queryCtx, &abciReqQuery)
 	if err != nil {
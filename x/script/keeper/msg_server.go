@@ -4,7 +4,9 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"math"
 
+	"cosmossdk.io/collections"
 	cosmossdkerrors "cosmossdk.io/errors"
 	scriptv1 "dysonprotocol.com/api/script/types"
 	"dysonprotocol.com/dysvm"
@@ -247,6 +249,170 @@ func (k Keeper) UpdateParams(ctx context.Context, msg *scripttypes.MsgUpdatePara
 	return &scripttypes.MsgUpdateParamsResponse{}, nil
 }
 
+// RegisterSnapshotQuery registers or replaces a query whose result is recorded every snapshot interval
+func (k Keeper) RegisterSnapshotQuery(ctx context.Context, msg *scripttypes.MsgRegisterSnapshotQuery) (*scripttypes.MsgRegisterSnapshotQueryResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := k.addressCodec.StringToBytes(msg.Owner); err != nil {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address: %s", err)
+	}
+
+	if msg.Name == "" || len(msg.Name) > MaxSnapshotQueryNameLength {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "snapshot query name must be between 1 and %d characters", MaxSnapshotQueryNameLength)
+	}
+
+	if err := k.validateSnapshotJSONQuery(msg.JsonQuery); err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	if params.SnapshotInterval < 1 {
+		return nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "snapshots are disabled")
+	}
+
+	key := collections.Join(msg.Owner, msg.Name)
+	exists, err := k.SnapshotQueryMap.Has(ctx, key)
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to check if snapshot query exists")
+	}
+
+	if !exists {
+		total, ownerTotal, err := k.countSnapshotQueries(ctx, msg.Owner)
+		if err != nil {
+			return nil, cosmossdkerrors.Wrap(err, "failed to count snapshot queries")
+		}
+		if total >= params.MaxSnapshotQueries {
+			return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max snapshot queries reached: %d", params.MaxSnapshotQueries)
+		}
+		if ownerTotal >= params.MaxSnapshotQueriesPerOwner {
+			return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "max snapshot queries per owner reached: %d", params.MaxSnapshotQueriesPerOwner)
+		}
+	}
+
+	// The registering transaction pays for one execution of the query up front
+	sdkCtx.GasMeter().ConsumeGas(params.SnapshotQueryGasLimit, "snapshot query registration")
+
+	err = k.SnapshotQueryMap.Set(ctx, key, scripttypes.SnapshotQuery{
+		Owner:         msg.Owner,
+		Name:          msg.Name,
+		JsonQuery:     msg.JsonQuery,
+		CreatedHeight: sdkCtx.BlockHeight(),
+	})
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to set snapshot query")
+	}
+
+	err = sdkCtx.EventManager().EmitTypedEvent(&scriptv1.EventSnapshotQueryRegistered{
+		Owner: msg.Owner,
+		Name:  msg.Name,
+	})
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to emit event")
+	}
+
+	return &scripttypes.MsgRegisterSnapshotQueryResponse{}, nil
+}
+
+// DeleteSnapshotQuery removes a snapshot query and all of its snapshots
+func (k Keeper) DeleteSnapshotQuery(ctx context.Context, msg *scripttypes.MsgDeleteSnapshotQuery) (*scripttypes.MsgDeleteSnapshotQueryResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	key := collections.Join(msg.Owner, msg.Name)
+	exists, err := k.SnapshotQueryMap.Has(ctx, key)
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to check if snapshot query exists")
+	}
+	if !exists {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot query %s/%s not found", msg.Owner, msg.Name)
+	}
+
+	if err := k.SnapshotQueryMap.Remove(ctx, key); err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to remove snapshot query")
+	}
+
+	if err := k.removeSnapshots(ctx, msg.Owner, msg.Name, math.MaxInt64); err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to remove snapshots")
+	}
+
+	err = sdkCtx.EventManager().EmitTypedEvent(&scriptv1.EventSnapshotQueryDeleted{
+		Owner: msg.Owner,
+		Name:  msg.Name,
+	})
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to emit event")
+	}
+
+	return &scripttypes.MsgDeleteSnapshotQueryResponse{}, nil
+}
+
+// SetGasSponsorship creates or replaces the gas sponsorship policy of a script
+func (k Keeper) SetGasSponsorship(ctx context.Context, msg *scripttypes.MsgSetGasSponsorship) (*scripttypes.MsgSetGasSponsorshipResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sponsorship := msg.Sponsorship
+	if sponsorship.ScriptAddress == "" {
+		sponsorship.ScriptAddress = msg.ScriptAddress
+	}
+	if sponsorship.ScriptAddress != msg.ScriptAddress {
+		return nil, cosmossdkerrors.Wrap(sdkerrors.ErrUnauthorized, "sponsorship script address must match the signer")
+	}
+
+	if err := sponsorship.Validate(); err != nil {
+		return nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	exists, err := k.ScriptMap.Has(ctx, msg.ScriptAddress)
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to check if script exists")
+	}
+	if !exists {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrNotFound, "script %s not found", msg.ScriptAddress)
+	}
+
+	if err := k.GasSponsorshipMap.Set(ctx, msg.ScriptAddress, sponsorship); err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to set gas sponsorship")
+	}
+
+	err = sdkCtx.EventManager().EmitTypedEvent(&scriptv1.EventGasSponsorshipSet{
+		ScriptAddress: msg.ScriptAddress,
+	})
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to emit event")
+	}
+
+	return &scripttypes.MsgSetGasSponsorshipResponse{}, nil
+}
+
+// DeleteGasSponsorship removes the gas sponsorship policy of a script and its rate limit usage
+func (k Keeper) DeleteGasSponsorship(ctx context.Context, msg *scripttypes.MsgDeleteGasSponsorship) (*scripttypes.MsgDeleteGasSponsorshipResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	exists, err := k.GasSponsorshipMap.Has(ctx, msg.ScriptAddress)
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to check if gas sponsorship exists")
+	}
+	if !exists {
+		return nil, cosmossdkerrors.Wrapf(sdkerrors.ErrNotFound, "gas sponsorship of %s not found", msg.ScriptAddress)
+	}
+
+	if err := k.GasSponsorshipMap.Remove(ctx, msg.ScriptAddress); err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to remove gas sponsorship")
+	}
+
+	if err := k.removeSponsorUsage(ctx, msg.ScriptAddress); err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to remove gas sponsorship usage")
+	}
+
+	err = sdkCtx.EventManager().EmitTypedEvent(&scriptv1.EventGasSponsorshipDeleted{
+		ScriptAddress: msg.ScriptAddress,
+	})
+	if err != nil {
+		return nil, cosmossdkerrors.Wrap(err, "failed to emit event")
+	}
+
+	return &scripttypes.MsgDeleteGasSponsorshipResponse{}, nil
+}
+
 // HandleRunRecovery is an exported version of handleRunRecovery for testing
 func HandleRunRecovery(r interface{}) error {
 	return handleRunRecovery(r)
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"net/http"
@@ -48,6 +49,21 @@ func (rpcservice *RpcService) Msg(_ *http.Request, req *MsgRequest, response *st
 	return nil
 }
 
+// method MsgBatch calls the HandleJSONMsgBatch method of the keeper and returns the per-message results as JSON
+func (rpcservice *RpcService) MsgBatch(_ *http.Request, req *MsgBatchRequest, response *string) (err error) {
+	r, err := rpcservice.k.HandleJSONMsgBatch(rpcservice.ctx, rpcservice.ScriptAddress, req)
+	if err != nil {
+		return err
+	}
+
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	*response = string(bz)
+	return nil
+}
+
 // method Query calls the HandleJSONAnyQuery method of the keeper
 func (rpcservice *RpcService) Query(_ *http.Request, req *QueryRequest, response *string) (err error) {
 
@@ -138,6 +138,51 @@ func (k *Keeper) ProcessQueryResponses(ackMsgsJson []map[string]interface{}, pac
 	return result
 }
 
+// CollectQueryResults flattens the enriched MsgModuleQuerySafe requests and responses into
+// one entry per interchain query so scripts do not have to pair them up by index.
+// Each entry holds the query "path", the decoded "request", the decoded "response" and
+// the host chain "height" the response was read at.
+func (k *Keeper) CollectQueryResults(enrichedAckMsgs []map[string]interface{}, enrichedPacketMessages []interface{}) []map[string]interface{} {
+	results := []map[string]interface{}{}
+
+	for ackIdx, ackMsg := range enrichedAckMsgs {
+		if !checkMessageType(ackMsg, MsgModuleQuerySafeResponseType) {
+			continue
+		}
+		if ackIdx >= len(enrichedPacketMessages) {
+			continue
+		}
+
+		packetMsg, ok := enrichedPacketMessages[ackIdx].(map[string]interface{})
+		if !ok || !checkMessageType(packetMsg, MsgModuleQuerySafeType) {
+			continue
+		}
+
+		requests, _ := packetMsg["requests"].([]interface{})
+		responses, _ := ackMsg["responses"].([]interface{})
+
+		for i, response := range responses {
+			result := map[string]interface{}{
+				"response": response,
+				"height":   ackMsg["height"],
+			}
+			if i < len(requests) {
+				if request, ok := requests[i].(map[string]interface{}); ok {
+					result["path"] = request["path"]
+					if decoded, ok := request["decoded_data"]; ok {
+						result["request"] = decoded
+					} else {
+						result["request"] = request["data"]
+					}
+				}
+			}
+			results = append(results, result)
+		}
+	}
+
+	return results
+}
+
 // ProcessPacketMessages enriches packet messages by decoding query request data.
 // It finds MsgModuleQuerySafe messages and decodes the base64 request data using the appropriate request types.
 func (k *Keeper) ProcessPacketMessages(packetMessages []interface{}) []interface{} {
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	cosmossdkerrors "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	scripttypes "dysonprotocol.com/x/script/types"
+)
+
+// MaxSnapshotQueryNameLength is the maximum length of a snapshot query name
+const MaxSnapshotQueryNameLength = 128
+
+// validateSnapshotJSONQuery checks that the query has an @type that resolves to a routable query request
+func (k Keeper) validateSnapshotJSONQuery(jsonQuery string) error {
+	var anyMsg map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonQuery), &anyMsg); err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "json_query is not valid JSON: %v", err)
+	}
+
+	typeURL, ok := anyMsg["@type"].(string)
+	if !ok {
+		return cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "json_query doesn't contain @type field")
+	}
+
+	var msg sdk.Msg
+	if err := k.cdc.UnmarshalInterfaceJSON([]byte(jsonQuery), &msg); err != nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to unmarshal json_query: %v", err)
+	}
+
+	if k.QueryRouterService.Route(ConvertRPCPath(typeURL)) == nil {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no query handler found for %s", typeURL)
+	}
+
+	return nil
+}
+
+// countSnapshotQueries returns the total number of snapshot queries and the number registered by owner
+func (k Keeper) countSnapshotQueries(ctx context.Context, owner string) (total uint64, ownerTotal uint64, err error) {
+	iter, err := k.SnapshotQueryMap.Iterate(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return 0, 0, err
+		}
+		total++
+		if key.K1() == owner {
+			ownerTotal++
+		}
+	}
+	return total, ownerTotal, nil
+}
+
+// GetSnapshotAt returns the latest snapshot of a snapshot query taken at or before height.
+// A height of zero returns the latest snapshot.
+func (k Keeper) GetSnapshotAt(ctx context.Context, owner, name string, height int64) (scripttypes.Snapshot, error) {
+	var rng collections.Ranger[collections.Triple[string, string, int64]]
+	if height == 0 {
+		rng = collections.NewSuperPrefixedTripleRangeReversed[string, string, int64](owner, name)
+	} else {
+		rng = new(collections.Range[collections.Triple[string, string, int64]]).
+			StartInclusive(collections.TripleSuperPrefix[string, string, int64](owner, name)).
+			EndInclusive(collections.Join3(owner, name, height)).
+			Descending()
+	}
+
+	iter, err := k.SnapshotMap.Iterate(ctx, rng)
+	if err != nil {
+		return scripttypes.Snapshot{}, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return scripttypes.Snapshot{}, cosmossdkerrors.Wrapf(collections.ErrNotFound, "no snapshot of %s/%s at or before height %d", owner, name, height)
+	}
+	return iter.Value()
+}
+
+// removeSnapshots deletes the snapshots of a snapshot query with a height at or below maxHeight
+func (k Keeper) removeSnapshots(ctx context.Context, owner, name string, maxHeight int64) error {
+	rng := new(collections.Range[collections.Triple[string, string, int64]]).
+		StartInclusive(collections.TripleSuperPrefix[string, string, int64](owner, name)).
+		EndInclusive(collections.Join3(owner, name, maxHeight))
+
+	iter, err := k.SnapshotMap.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.SnapshotMap.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runSnapshotQuery executes a snapshot query against the current state with its own gas limit.
+// Running out of gas and any other panic of the query are returned as an error so the failure
+// can be recorded in the snapshot, as this runs in EndBlocker. The query runs on a discarded
+// cache so a panicking query cannot leave partial writes.
+func (k Keeper) runSnapshotQuery(ctx sdk.Context, query scripttypes.SnapshotQuery, gasLimit uint64) (resp string, err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	queryCtx := cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+
+	defer func() {
+		if r := recover(); r != nil {
+			resp = ""
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = cosmossdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "snapshot query out of gas in %s, gas limit: %d", oog.Descriptor, gasLimit)
+				return
+			}
+			err = cosmossdkerrors.Wrapf(sdkerrors.ErrPanic, "snapshot query panicked: %v", r)
+		}
+	}()
+
+	return k.HandleJSONAnyQuery(queryCtx, &QueryRequest{JsonQuery: query.JsonQuery})
+}
+
+// TakeSnapshots records the result of every registered snapshot query when the block height
+// is a multiple of the snapshot interval and prunes snapshots older than the retention window.
+func (k Keeper) TakeSnapshots(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	if params.SnapshotInterval < 1 {
+		return nil
+	}
+
+	height := ctx.BlockHeight()
+	if height%params.SnapshotInterval != 0 {
+		return nil
+	}
+
+	iter, err := k.SnapshotQueryMap.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	queries, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	pruneHeight := height - params.SnapshotInterval*params.SnapshotRetention
+
+	for _, query := range queries {
+		snapshot := scripttypes.Snapshot{
+			Owner:  query.Owner,
+			Name:   query.Name,
+			Height: height,
+		}
+
+		resp, err := k.runSnapshotQuery(ctx, query, params.SnapshotQueryGasLimit)
+		if err != nil {
+			snapshot.Error = err.Error()
+		} else {
+			snapshot.JsonResponse = resp
+		}
+
+		if err := k.SnapshotMap.Set(ctx, collections.Join3(query.Owner, query.Name, height), snapshot); err != nil {
+			return err
+		}
+
+		if err := k.removeSnapshots(ctx, query.Owner, query.Name, pruneHeight); err != nil {
+			return err
+		}
+	}
+
+	if len(queries) > 0 {
+		k.Logger(ctx).Debug(fmt.Sprintf("took %d snapshots", len(queries)), "height", height)
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	cosmossdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	scriptv1 "dysonprotocol.com/api/script/types"
+	scripttypes "dysonprotocol.com/x/script/types"
+)
+
+// sponsoredScript returns the script address called by msgs when every message is a MsgExec
+// from user to the same script without attached messages or extra code
+func (k Keeper) sponsoredScript(ctx context.Context, user string, msgs []sdk.Msg) (string, []string, error) {
+	if len(msgs) == 0 {
+		return "", nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "no messages")
+	}
+
+	var scriptAddress string
+	functionNames := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		execMsg, ok := msg.(*scripttypes.MsgExec)
+		if !ok {
+			return "", nil, cosmossdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s is not sponsored", sdk.MsgTypeURL(msg))
+		}
+
+		// Attached messages run with the executor's authority, sponsoring them would let users
+		// spend the script's budget on arbitrary messages
+		if len(execMsg.AttachedMessages) > 0 {
+			return "", nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages with attached messages are not sponsored")
+		}
+
+		// Extra code could redefine the sponsored functions and run arbitrary code on the budget
+		if execMsg.ExtraCode != "" {
+			return "", nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "messages with extra code are not sponsored")
+		}
+
+		if execMsg.ExecutorAddress != user {
+			return "", nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "executor must be the fee payer")
+		}
+
+		addr, err := k.NameserviceKeeper.ResolveNameOrAddress(ctx, execMsg.ScriptAddress)
+		if err != nil {
+			return "", nil, err
+		}
+		if scriptAddress != "" && addr != scriptAddress {
+			return "", nil, cosmossdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "all messages must call the same script")
+		}
+		scriptAddress = addr
+		functionNames = append(functionNames, execMsg.FunctionName)
+	}
+
+	return scriptAddress, functionNames, nil
+}
+
+// checkGasSponsorship returns the sponsorship and the user's updated rate limit usage when the
+// script called by msgs sponsors a transaction paying fee
+func (k Keeper) checkGasSponsorship(ctx context.Context, user sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (scripttypes.GasSponsorship, scripttypes.SponsorshipUsage, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	userStr := user.String()
+	scriptAddress, functionNames, err := k.sponsoredScript(ctx, userStr, msgs)
+	if err != nil {
+		return scripttypes.GasSponsorship{}, scripttypes.SponsorshipUsage{}, err
+	}
+
+	sponsorship, err := k.GasSponsorshipMap.Get(ctx, scriptAddress)
+	if err != nil {
+		return scripttypes.GasSponsorship{}, scripttypes.SponsorshipUsage{}, cosmossdkerrors.Wrapf(err, "script %s has no gas sponsorship", scriptAddress)
+	}
+
+	for _, fn := range functionNames {
+		if !sponsorship.AllowsFunction(fn) {
+			return scripttypes.GasSponsorship{}, scripttypes.SponsorshipUsage{}, cosmossdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "function %s is not sponsored", fn)
+		}
+	}
+
+	// The ante handler sets the gas meter limit to the gas limit of the transaction
+	if sponsorship.MaxGas > 0 && sdkCtx.GasMeter().Limit() > sponsorship.MaxGas {
+		return scripttypes.GasSponsorship{}, scripttypes.SponsorshipUsage{}, cosmossdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "gas limit %d exceeds sponsored max gas %d", sdkCtx.GasMeter().Limit(), sponsorship.MaxGas)
+	}
+
+	if !fee.IsAllLTE(sponsorship.SpendLimit) {
+		return scripttypes.GasSponsorship{}, scripttypes.SponsorshipUsage{}, cosmossdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "fee %s exceeds sponsorship spend limit %s", fee, sponsorship.SpendLimit)
+	}
+
+	usage, err := k.SponsorUsageMap.Get(ctx, collections.Join(scriptAddress, userStr))
+	if err != nil {
+		usage = scripttypes.SponsorshipUsage{ScriptAddress: scriptAddress, User: userStr}
+	}
+
+	height := sdkCtx.BlockHeight()
+	if sponsorship.WindowBlocks > 0 && height >= usage.WindowStart+sponsorship.WindowBlocks {
+		usage.WindowStart = height
+		usage.TxCount = 0
+	}
+
+	if sponsorship.MaxTxsPerUser > 0 && usage.TxCount >= sponsorship.MaxTxsPerUser {
+		return scripttypes.GasSponsorship{}, scripttypes.SponsorshipUsage{}, cosmossdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sponsored transaction limit of %d per %d blocks reached", sponsorship.MaxTxsPerUser, sponsorship.WindowBlocks)
+	}
+	usage.TxCount++
+
+	return sponsorship, usage, nil
+}
+
+// GetGasSponsor returns the script that would pay the fee of a transaction from user, if any.
+// It does not modify state.
+func (k Keeper) GetGasSponsor(ctx context.Context, user sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (sdk.AccAddress, bool) {
+	sponsorship, _, err := k.checkGasSponsorship(ctx, user, fee, msgs)
+	if err != nil {
+		return nil, false
+	}
+
+	sponsor, err := k.addressCodec.StringToBytes(sponsorship.ScriptAddress)
+	if err != nil {
+		return nil, false
+	}
+	return sponsor, true
+}
+
+// UseGasSponsorship charges a sponsored fee to the sponsorship of a script. It has the
+// signature of the fee grant keeper so the fee deduction of the ante handler can use it.
+func (k Keeper) UseGasSponsorship(ctx context.Context, sponsor, user sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	sponsorship, usage, err := k.checkGasSponsorship(ctx, user, fee, msgs)
+	if err != nil {
+		return err
+	}
+
+	if sponsorship.ScriptAddress != sponsor.String() {
+		return cosmossdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s does not sponsor these messages", sponsor)
+	}
+
+	sponsorship.SpendLimit = sponsorship.SpendLimit.Sub(fee...)
+	if err := k.GasSponsorshipMap.Set(ctx, sponsorship.ScriptAddress, sponsorship); err != nil {
+		return err
+	}
+
+	// The usage is only read to enforce a per-user limit
+	if sponsorship.MaxTxsPerUser > 0 {
+		if err := k.SponsorUsageMap.Set(ctx, collections.Join(usage.ScriptAddress, usage.User), usage); err != nil {
+			return err
+		}
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&scriptv1.EventGasSponsored{
+		ScriptAddress: sponsorship.ScriptAddress,
+		User:          usage.User,
+		Fee:           fee.String(),
+	})
+}
+
+// removeSponsorUsage deletes the rate limit usage of every user of a script's sponsorship
+func (k Keeper) removeSponsorUsage(ctx context.Context, scriptAddress string) error {
+	iter, err := k.SponsorUsageMap.Iterate(ctx, collections.NewPrefixedPairRange[string, string](scriptAddress))
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := k.SponsorUsageMap.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -18,6 +18,31 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "script-info --address <script_address>",
 					Short:     "Query for script info by address",
 				},
+				{
+					RpcMethod: "Snapshot",
+					Use:       "snapshot [owner] [name]",
+					Short:     "Query the latest snapshot of a snapshot query, optionally at or before --height",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+						{ProtoField: "name"},
+					},
+				},
+				{
+					RpcMethod: "SnapshotQueries",
+					Use:       "snapshot-queries [owner]",
+					Short:     "List the snapshot queries registered by an owner",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "GasSponsorship",
+					Use:       "gas-sponsorship [script_address]",
+					Short:     "Query the gas sponsorship of a script, optionally with the usage of --user",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "script_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -1,11 +1,15 @@
 package types
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"regexp"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 var _ authz.Authorization = &ScriptExecAuthorization{}
@@ -47,6 +51,42 @@ func (a ScriptExecAuthorization) ValidateBasic() error {
 		seen[fn] = true
 	}
 
+	if !a.MaxFunds.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid max funds: %s", a.MaxFunds)
+	}
+	if !a.MaxFunds.IsZero() && !a.RestrictAttachedMessages {
+		return sdkerrors.ErrInvalidRequest.Wrap("restrict attached messages must be set when max funds is set")
+	}
+
+	if a.ExpiryHeight < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("expiry height cannot be negative")
+	}
+
+	if a.WindowBlocks < 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("window blocks cannot be negative")
+	}
+	if a.MaxCallsPerWindow > 0 && a.WindowBlocks == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("window blocks must be set when max calls per window is set")
+	}
+
+	constrained := make(map[string]bool)
+	for _, c := range a.ArgumentConstraints {
+		if !seen[c.FunctionName] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("argument constraint for function %s which is not authorized", c.FunctionName)
+		}
+		if constrained[c.FunctionName] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate argument constraint for function %s", c.FunctionName)
+		}
+		constrained[c.FunctionName] = true
+
+		if _, err := compileArgumentPattern(c.ArgsPattern); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid args pattern for function %s: %s", c.FunctionName, err)
+		}
+		if _, err := compileArgumentPattern(c.KwargsPattern); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid kwargs pattern for function %s: %s", c.FunctionName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -57,20 +97,82 @@ func (a ScriptExecAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch: expected MsgExec")
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	if a.ExpiryHeight > 0 && height >= a.ExpiryHeight {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("authorization expired at height %d", a.ExpiryHeight)
+	}
+
 	// Check if the script address matches
 	if execMsg.ScriptAddress != a.ScriptAddress {
 		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("script address mismatch: expected %s, got %s", a.ScriptAddress, execMsg.ScriptAddress)
 	}
 
-	// Direct execution (empty function name) is always allowed
+	// Direct execution (empty function name) is only allowed without a function whitelist
 	if execMsg.FunctionName == "" {
-		return authz.AcceptResponse{Accept: true}, nil
+		if len(a.FunctionNames) > 0 {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("direct script execution not authorized, only the listed functions can be called")
+		}
+	} else if err := a.acceptFunction(execMsg); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	// Extra code could redefine the whitelisted functions, argument constraints included
+	if len(a.FunctionNames) > 0 && execMsg.ExtraCode != "" {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("extra code not authorized with a function whitelist")
+	}
+
+	// Attached messages run with the granter's authority, a restricted grant only allows bounded fund transfers
+	sent := sdk.NewCoins()
+	if a.RestrictAttachedMessages {
+		var err error
+		sent, err = attachedFunds(execMsg)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		if !sent.IsZero() {
+			remaining, hasNeg := a.MaxFunds.SafeSub(sent...)
+			if hasNeg {
+				return authz.AcceptResponse{}, sdkerrors.ErrInsufficientFunds.Wrapf("attached messages send %s, authorization allows %s", sent, a.MaxFunds)
+			}
+			a.MaxFunds = remaining
+		}
+	}
+
+	if a.MaxCallsPerWindow > 0 {
+		if height >= a.WindowStart+a.WindowBlocks {
+			a.WindowStart = height
+			a.WindowCalls = 0
+		}
+		if a.WindowCalls >= a.MaxCallsPerWindow {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("rate limit of %d calls per %d blocks reached", a.MaxCallsPerWindow, a.WindowBlocks)
+		}
+		a.WindowCalls++
+	}
+
+	if a.MaxCalls > 0 {
+		if a.MaxCalls == 1 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+		a.MaxCalls--
 	}
 
+	// Only stateful authorizations need to be stored again
+	if a.MaxCalls > 0 || a.MaxCallsPerWindow > 0 || !sent.IsZero() {
+		return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+	}
+
+	// Accept the execution
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// acceptFunction checks that the called function and its arguments are authorized
+func (a ScriptExecAuthorization) acceptFunction(execMsg *MsgExec) error {
 	// If a function name is specified, check if it's in the allowed list
 	// Empty FunctionNames list means only direct execution is allowed
 	if len(a.FunctionNames) == 0 {
-		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("function calls not authorized, only direct script execution allowed")
+		return sdkerrors.ErrUnauthorized.Wrap("function calls not authorized, only direct script execution allowed")
 	}
 
 	// Check if the function is in the allowed list
@@ -83,9 +185,77 @@ func (a ScriptExecAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz
 	}
 
 	if !isAllowed {
-		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("function %s is not authorized for execution", execMsg.FunctionName)
+		return sdkerrors.ErrUnauthorized.Wrapf("function %s is not authorized for execution", execMsg.FunctionName)
 	}
 
-	// Accept the execution
-	return authz.AcceptResponse{Accept: true}, nil
+	for _, c := range a.ArgumentConstraints {
+		if c.FunctionName != execMsg.FunctionName {
+			continue
+		}
+		if err := matchArgument(c.ArgsPattern, execMsg.Args, "[]"); err != nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("args of function %s: %s", execMsg.FunctionName, err)
+		}
+		if err := matchArgument(c.KwargsPattern, execMsg.Kwargs, "{}"); err != nil {
+			return sdkerrors.ErrUnauthorized.Wrapf("kwargs of function %s: %s", execMsg.FunctionName, err)
+		}
+	}
+
+	return nil
+}
+
+// compileArgumentPattern compiles a pattern that must match a whole JSON value
+func compileArgumentPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// matchArgument matches the compact JSON form of value against pattern. An empty value is
+// treated as defaultValue.
+func matchArgument(pattern, value, defaultValue string) error {
+	re, err := compileArgumentPattern(pattern)
+	if err != nil || re == nil {
+		return err
+	}
+
+	if value == "" {
+		value = defaultValue
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid JSON: %s", err)
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(decoded); err != nil {
+		return err
+	}
+	compact := bytes.TrimSpace(buf.Bytes())
+
+	if !re.Match(compact) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s does not match %s", compact, pattern)
+	}
+	return nil
+}
+
+// attachedFunds returns the total amount sent by the attached messages of a MsgExec. Attached
+// messages that are not bank sends are rejected as their transfers cannot be bounded.
+func attachedFunds(execMsg *MsgExec) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	for _, anyMsg := range execMsg.AttachedMessages {
+		switch m := anyMsg.GetCachedValue().(type) {
+		case *banktypes.MsgSend:
+			total = total.Add(m.Amount...)
+		case *banktypes.MsgMultiSend:
+			for _, input := range m.Inputs {
+				total = total.Add(input.Coins...)
+			}
+		default:
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("attached message %s is not authorized", anyMsg.TypeUrl)
+		}
+	}
+	return total, nil
 }
@@ -6,7 +6,10 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -25,13 +28,43 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ScriptExecAuthorization allows the grantee to execute specific scripts and
-// functions.
+// functions. The optional limits make it suitable for short-lived session keys.
 type ScriptExecAuthorization struct {
 	// script_address is the address of the script that can be executed
 	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
 	// function_names is a list of function names that can be called on this
-	// script if empty, only the script itself can be run without a function call
+	// script if empty, only the script itself can be run without a function call.
+	// When set, running the script without a function call or with extra code is
+	// not allowed.
 	FunctionNames []string `protobuf:"bytes,2,rep,name=function_names,json=functionNames,proto3" json:"function_names,omitempty"`
+	// max_calls is the number of executions left. It is decremented on every
+	// execution and the grant is deleted when it reaches zero. Zero means no
+	// limit.
+	MaxCalls uint64 `protobuf:"varint,3,opt,name=max_calls,json=maxCalls,proto3" json:"max_calls,omitempty"`
+	// max_funds is the remaining amount the attached messages of executions may
+	// send when restrict_attached_messages is set. The amounts of attached bank
+	// sends are subtracted from it. When empty no funds can be sent.
+	MaxFunds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_funds,json=maxFunds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_funds"`
+	// expiry_height is the block height from which the grant is no longer
+	// accepted and is deleted. Zero means no expiry height.
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// argument_constraints restrict the arguments of specific functions.
+	ArgumentConstraints []ArgumentConstraint `protobuf:"bytes,6,rep,name=argument_constraints,json=argumentConstraints,proto3" json:"argument_constraints"`
+	// max_calls_per_window is the maximum number of executions within a window
+	// of window_blocks blocks. Zero means no rate limit.
+	MaxCallsPerWindow uint64 `protobuf:"varint,7,opt,name=max_calls_per_window,json=maxCallsPerWindow,proto3" json:"max_calls_per_window,omitempty"`
+	// window_blocks is the length in blocks of the rate limit window.
+	WindowBlocks int64 `protobuf:"varint,8,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// window_start is the block height the current rate limit window started
+	// at. It is maintained by the module.
+	WindowStart int64 `protobuf:"varint,9,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// window_calls is the number of executions in the current rate limit
+	// window. It is maintained by the module.
+	WindowCalls uint64 `protobuf:"varint,10,opt,name=window_calls,json=windowCalls,proto3" json:"window_calls,omitempty"`
+	// restrict_attached_messages only allows bank MsgSend and MsgMultiSend
+	// attached messages, bounded by max_funds. When false attached messages are
+	// not restricted.
+	RestrictAttachedMessages bool `protobuf:"varint,11,opt,name=restrict_attached_messages,json=restrictAttachedMessages,proto3" json:"restrict_attached_messages,omitempty"`
 }
 
 func (m *ScriptExecAuthorization) Reset()         { *m = ScriptExecAuthorization{} }
@@ -81,8 +114,139 @@ func (m *ScriptExecAuthorization) GetFunctionNames() []string {
 	return nil
 }
 
+func (m *ScriptExecAuthorization) GetMaxCalls() uint64 {
+	if m != nil {
+		return m.MaxCalls
+	}
+	return 0
+}
+
+func (m *ScriptExecAuthorization) GetMaxFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxFunds
+	}
+	return nil
+}
+
+func (m *ScriptExecAuthorization) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *ScriptExecAuthorization) GetArgumentConstraints() []ArgumentConstraint {
+	if m != nil {
+		return m.ArgumentConstraints
+	}
+	return nil
+}
+
+func (m *ScriptExecAuthorization) GetMaxCallsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxCallsPerWindow
+	}
+	return 0
+}
+
+func (m *ScriptExecAuthorization) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *ScriptExecAuthorization) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *ScriptExecAuthorization) GetWindowCalls() uint64 {
+	if m != nil {
+		return m.WindowCalls
+	}
+	return 0
+}
+
+func (m *ScriptExecAuthorization) GetRestrictAttachedMessages() bool {
+	if m != nil {
+		return m.RestrictAttachedMessages
+	}
+	return false
+}
+
+// ArgumentConstraint restricts the arguments of a function call. Patterns are
+// RE2 regular expressions that must match the whole JSON encoded value.
+type ArgumentConstraint struct {
+	// function_name is the constrained function.
+	FunctionName string `protobuf:"bytes,1,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// args_pattern must match the JSON list of positional arguments. Empty
+	// means unconstrained.
+	ArgsPattern string `protobuf:"bytes,2,opt,name=args_pattern,json=argsPattern,proto3" json:"args_pattern,omitempty"`
+	// kwargs_pattern must match the JSON dict of keyword arguments. Empty means
+	// unconstrained.
+	KwargsPattern string `protobuf:"bytes,3,opt,name=kwargs_pattern,json=kwargsPattern,proto3" json:"kwargs_pattern,omitempty"`
+}
+
+func (m *ArgumentConstraint) Reset()         { *m = ArgumentConstraint{} }
+func (m *ArgumentConstraint) String() string { return proto.CompactTextString(m) }
+func (*ArgumentConstraint) ProtoMessage()    {}
+func (*ArgumentConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c8073cef14effcc3, []int{1}
+}
+func (m *ArgumentConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArgumentConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArgumentConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArgumentConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArgumentConstraint.Merge(m, src)
+}
+func (m *ArgumentConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArgumentConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArgumentConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArgumentConstraint proto.InternalMessageInfo
+
+func (m *ArgumentConstraint) GetFunctionName() string {
+	if m != nil {
+		return m.FunctionName
+	}
+	return ""
+}
+
+func (m *ArgumentConstraint) GetArgsPattern() string {
+	if m != nil {
+		return m.ArgsPattern
+	}
+	return ""
+}
+
+func (m *ArgumentConstraint) GetKwargsPattern() string {
+	if m != nil {
+		return m.KwargsPattern
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ScriptExecAuthorization)(nil), "dysonprotocol.script.v1.ScriptExecAuthorization")
+	proto.RegisterType((*ArgumentConstraint)(nil), "dysonprotocol.script.v1.ArgumentConstraint")
 }
 
 func init() {
@@ -90,25 +254,48 @@ func init() {
 }
 
 var fileDescriptor_c8073cef14effcc3 = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xa9, 0x2c, 0xce,
-	0xcf, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4e, 0x2e, 0xca, 0x2c, 0x28,
-	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x03, 0x4b, 0x08, 0x89, 0xa3, 0x28,
-	0xd2, 0x83, 0x28, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
-	0x10, 0xb5, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03,
-	0x91, 0x52, 0x7a, 0xca, 0xc8, 0x25, 0x1e, 0x0c, 0xd6, 0xeb, 0x5a, 0x91, 0x9a, 0xec, 0x58, 0x5a,
-	0x92, 0x91, 0x5f, 0x94, 0x59, 0x95, 0x58, 0x92, 0x99, 0x9f, 0x27, 0x64, 0xcf, 0xc5, 0x07, 0x31,
-	0x36, 0x3e, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0x49,
-	0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x29, 0x8e, 0x10, 0x99, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4,
-	0x20, 0x5e, 0x88, 0x7a, 0xa8, 0xa0, 0x90, 0x2a, 0x17, 0x5f, 0x5a, 0x69, 0x5e, 0x32, 0xc8, 0xb0,
-	0xf8, 0xbc, 0xc4, 0xdc, 0xd4, 0x62, 0x09, 0x26, 0x05, 0x66, 0x0d, 0xce, 0x20, 0x5e, 0x98, 0xa8,
-	0x1f, 0x48, 0xd0, 0x2a, 0xe9, 0xd4, 0x16, 0x5d, 0x25, 0xa8, 0x79, 0x10, 0x2f, 0x96, 0x19, 0x26,
-	0xa5, 0x96, 0x24, 0x1a, 0xea, 0xa1, 0xb8, 0xe7, 0xd2, 0x16, 0x5d, 0x41, 0x14, 0x4f, 0x2b, 0x18,
-	0xe9, 0x19, 0x74, 0x3d, 0xdf, 0xa0, 0xa5, 0x94, 0x52, 0x59, 0x0c, 0x0b, 0x25, 0x1c, 0x7e, 0x71,
-	0xb2, 0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c,
-	0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x05, 0xd4, 0x80, 0x4c,
-	0xce, 0xcf, 0xd5, 0xaf, 0x80, 0x99, 0x56, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x96, 0x34,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xdd, 0xb2, 0x41, 0xa2, 0x98, 0x01, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4f, 0x4b, 0x1b, 0x4f,
+	0x18, 0xce, 0x1a, 0xf5, 0x67, 0x36, 0x46, 0x70, 0x7f, 0x01, 0x57, 0x0b, 0xeb, 0x36, 0x52, 0x58,
+	0x2c, 0xd9, 0x6d, 0xec, 0x4d, 0x0a, 0x25, 0x91, 0x4a, 0x2f, 0x2d, 0xb2, 0x1e, 0x0a, 0xbd, 0x0c,
+	0x93, 0xd9, 0x71, 0x33, 0x98, 0x9d, 0x09, 0x33, 0x13, 0x4d, 0x3c, 0xf4, 0xd2, 0x5b, 0x4f, 0x3d,
+	0xf7, 0x13, 0x94, 0x9e, 0x3c, 0xf8, 0x21, 0xa4, 0x27, 0xf1, 0xd4, 0x53, 0x5b, 0xf4, 0x90, 0xaf,
+	0x51, 0xe6, 0x4f, 0xc4, 0x20, 0x5e, 0x92, 0x7d, 0x9f, 0xe7, 0x99, 0x7d, 0xdf, 0x7d, 0xde, 0x67,
+	0xdc, 0xad, 0x6c, 0x2c, 0x18, 0x1d, 0x70, 0x26, 0x19, 0x62, 0xfd, 0x44, 0x20, 0x4e, 0x06, 0x32,
+	0x39, 0x69, 0x25, 0x70, 0x28, 0x7b, 0x67, 0xb1, 0x26, 0xbc, 0xb5, 0x19, 0x51, 0x6c, 0x44, 0xf1,
+	0x49, 0x6b, 0x63, 0x15, 0x16, 0x84, 0xb2, 0x44, 0xff, 0x1a, 0xed, 0x46, 0x80, 0x98, 0x28, 0x98,
+	0x48, 0xba, 0x50, 0xe0, 0xe4, 0xa4, 0xd5, 0xc5, 0x12, 0xb6, 0x12, 0xc4, 0x08, 0xb5, 0xfc, 0xba,
+	0xe1, 0x81, 0xae, 0x12, 0x53, 0x58, 0xaa, 0x9e, 0xb3, 0x9c, 0x19, 0x5c, 0x3d, 0x19, 0xb4, 0x31,
+	0x59, 0x70, 0xd7, 0x0e, 0x75, 0xc7, 0x37, 0x23, 0x8c, 0xda, 0x43, 0xd9, 0x63, 0x9c, 0x9c, 0x41,
+	0x49, 0x18, 0xf5, 0x5e, 0xbb, 0x2b, 0x66, 0x18, 0x00, 0xb3, 0x8c, 0x63, 0x21, 0x7c, 0x27, 0x74,
+	0xa2, 0x4a, 0xc7, 0xbf, 0xbe, 0x68, 0xd6, 0xed, 0xbb, 0xdb, 0x86, 0x39, 0x94, 0x9c, 0xd0, 0x3c,
+	0xad, 0x19, 0xbd, 0x05, 0xbd, 0x67, 0xee, 0xca, 0xd1, 0x90, 0x22, 0xf5, 0x32, 0x40, 0x61, 0x81,
+	0x85, 0x3f, 0x17, 0x96, 0xa3, 0x4a, 0x5a, 0x9b, 0xa2, 0xef, 0x15, 0xe8, 0x3d, 0x71, 0x2b, 0x05,
+	0x1c, 0x01, 0x04, 0xfb, 0x7d, 0xe1, 0x97, 0x43, 0x27, 0x9a, 0x4f, 0x97, 0x0a, 0x38, 0xda, 0x53,
+	0xb5, 0xf7, 0xc9, 0x90, 0x47, 0x43, 0x9a, 0x09, 0x7f, 0x3e, 0x2c, 0x47, 0xd5, 0x9d, 0xf5, 0xd8,
+	0x36, 0x57, 0x2e, 0xc4, 0xd6, 0x85, 0x78, 0x8f, 0x11, 0xda, 0xd9, 0xbf, 0xfc, 0xbd, 0x59, 0xfa,
+	0xf1, 0x67, 0x33, 0xca, 0x89, 0xec, 0x0d, 0xbb, 0x31, 0x62, 0x85, 0x75, 0xc1, 0xfe, 0x35, 0x45,
+	0x76, 0x9c, 0xc8, 0xf1, 0x00, 0x0b, 0x7d, 0x40, 0x7c, 0x9b, 0x9c, 0x6f, 0x2f, 0xf7, 0x71, 0x0e,
+	0xd1, 0x18, 0x28, 0x1f, 0xc5, 0xf7, 0xc9, 0xf9, 0xb6, 0xa3, 0xfb, 0xef, 0xab, 0x96, 0xde, 0x96,
+	0x5b, 0xc3, 0xa3, 0x01, 0xe1, 0x63, 0xd0, 0xc3, 0x24, 0xef, 0x49, 0x7f, 0x21, 0x74, 0xa2, 0x72,
+	0xba, 0x6c, 0xc0, 0xb7, 0x1a, 0xf3, 0x32, 0xb7, 0x0e, 0x79, 0x3e, 0x2c, 0x30, 0x95, 0x00, 0x31,
+	0x2a, 0x24, 0x87, 0x84, 0x4a, 0xe1, 0x2f, 0xea, 0x79, 0x9f, 0xc7, 0x8f, 0x6c, 0x38, 0x6e, 0xdb,
+	0x43, 0x7b, 0x77, 0x67, 0x3a, 0xf3, 0xea, 0x0b, 0xd2, 0xff, 0xe1, 0x03, 0x46, 0x78, 0x89, 0x5b,
+	0xbf, 0xf3, 0x09, 0x0c, 0x30, 0x07, 0xa7, 0x84, 0x66, 0xec, 0xd4, 0xff, 0x4f, 0x5b, 0xb6, 0x3a,
+	0xb5, 0xec, 0x00, 0xf3, 0x0f, 0x9a, 0x50, 0xb3, 0x1b, 0x09, 0xe8, 0xf6, 0x19, 0x3a, 0x16, 0xfe,
+	0x92, 0x99, 0xdd, 0x80, 0x1d, 0x8d, 0x79, 0x4f, 0x5d, 0x5b, 0x03, 0x21, 0x21, 0x97, 0x7e, 0x45,
+	0x6b, 0xaa, 0x06, 0x3b, 0x54, 0xd0, 0x3d, 0x89, 0xd9, 0x91, 0xab, 0x1b, 0x5a, 0x89, 0x59, 0xd3,
+	0x2b, 0x77, 0x83, 0x63, 0x21, 0x39, 0x41, 0x12, 0x40, 0x29, 0x21, 0xea, 0xe1, 0x0c, 0x14, 0x58,
+	0x08, 0x98, 0x63, 0xe1, 0x57, 0x43, 0x27, 0x5a, 0x4a, 0xfd, 0xa9, 0xa2, 0x6d, 0x05, 0xef, 0x2c,
+	0xbf, 0xdb, 0xfd, 0x79, 0xd1, 0x6c, 0xd8, 0xa5, 0x9a, 0xab, 0x31, 0xdd, 0xea, 0x4c, 0x22, 0xaf,
+	0x2f, 0x9a, 0xab, 0x33, 0x56, 0x86, 0x3b, 0xf1, 0x8b, 0x2f, 0x93, 0xf3, 0xed, 0x46, 0x36, 0x16,
+	0xd3, 0xdb, 0xf5, 0x48, 0x9a, 0x1b, 0x9f, 0x1d, 0xd7, 0x7b, 0xe8, 0xb7, 0xf2, 0x68, 0x26, 0xa3,
+	0x26, 0xe3, 0xe9, 0xf2, 0xfd, 0x88, 0x2a, 0x03, 0x20, 0xcf, 0x05, 0x18, 0x40, 0x29, 0x31, 0xa7,
+	0xfe, 0x9c, 0xd6, 0x54, 0x15, 0x76, 0x60, 0x20, 0x95, 0xf5, 0xe3, 0xd3, 0x19, 0x51, 0x59, 0x8b,
+	0x6a, 0x06, 0xb5, 0xb2, 0xce, 0xee, 0xe5, 0x4d, 0xe0, 0x5c, 0xdd, 0x04, 0xce, 0xdf, 0x9b, 0xc0,
+	0xf9, 0x7a, 0x1b, 0x94, 0xae, 0x6e, 0x83, 0xd2, 0xaf, 0xdb, 0xa0, 0xf4, 0x31, 0x9c, 0x0d, 0x89,
+	0x4a, 0xee, 0x68, 0xfa, 0x4d, 0x3a, 0xb0, 0xdd, 0x45, 0x4d, 0xbe, 0xfc, 0x17, 0x00, 0x00, 0xff,
+	0xff, 0x0d, 0x81, 0x05, 0x71, 0x56, 0x04, 0x00, 0x00,
 }
 
 func (m *ScriptExecAuthorization) Marshal() (dAtA []byte, err error) {
@@ -131,6 +318,74 @@ func (m *ScriptExecAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.RestrictAttachedMessages {
+		i--
+		if m.RestrictAttachedMessages {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.WindowCalls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowCalls))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxCallsPerWindow != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxCallsPerWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ArgumentConstraints) > 0 {
+		for iNdEx := len(m.ArgumentConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArgumentConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MaxFunds) > 0 {
+		for iNdEx := len(m.MaxFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxCalls != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxCalls))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.FunctionNames) > 0 {
 		for iNdEx := len(m.FunctionNames) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.FunctionNames[iNdEx])
@@ -150,6 +405,50 @@ func (m *ScriptExecAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ArgumentConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArgumentConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArgumentConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KwargsPattern) > 0 {
+		i -= len(m.KwargsPattern)
+		copy(dAtA[i:], m.KwargsPattern)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.KwargsPattern)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ArgsPattern) > 0 {
+		i -= len(m.ArgsPattern)
+		copy(dAtA[i:], m.ArgsPattern)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ArgsPattern)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -177,6 +476,60 @@ func (m *ScriptExecAuthorization) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if m.MaxCalls != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxCalls))
+	}
+	if len(m.MaxFunds) > 0 {
+		for _, e := range m.MaxFunds {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.ExpiryHeight))
+	}
+	if len(m.ArgumentConstraints) > 0 {
+		for _, e := range m.ArgumentConstraints {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.MaxCallsPerWindow != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxCallsPerWindow))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowBlocks))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowStart))
+	}
+	if m.WindowCalls != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowCalls))
+	}
+	if m.RestrictAttachedMessages {
+		n += 2
+	}
+	return n
+}
+
+func (m *ArgumentConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.ArgsPattern)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.KwargsPattern)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
 	return n
 }
 
@@ -279,6 +632,354 @@ func (m *ScriptExecAuthorization) Unmarshal(dAtA []byte) error {
 			}
 			m.FunctionNames = append(m.FunctionNames, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCalls", wireType)
+			}
+			m.MaxCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFunds = append(m.MaxFunds, types.Coin{})
+			if err := m.MaxFunds[len(m.MaxFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgumentConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArgumentConstraints = append(m.ArgumentConstraints, ArgumentConstraint{})
+			if err := m.ArgumentConstraints[len(m.ArgumentConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallsPerWindow", wireType)
+			}
+			m.MaxCallsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowCalls", wireType)
+			}
+			m.WindowCalls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowCalls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictAttachedMessages", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictAttachedMessages = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgumentConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgumentConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgumentConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgsPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArgsPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KwargsPattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KwargsPattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -190,6 +190,99 @@ func (m *EventScriptEvent) GetValue() string {
 	return ""
 }
 
+// EventScriptBatchMsg is emitted for every sub-message of a script message
+// batch and records which sub-message produced which outcome. Events emitted
+// by a successful sub-message carry a matching batch_msg_index attribute.
+type EventScriptBatchMsg struct {
+	// Address of the script that sent the batch.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	// The batch mode, "atomic" or "best_effort".
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// Index of the sub-message in the batch.
+	MsgIndex uint32 `protobuf:"varint,3,opt,name=msg_index,json=msgIndex,proto3" json:"msg_index,omitempty"`
+	// Type URL of the sub-message.
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Whether the sub-message was executed and its state changes kept.
+	Success bool `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// The error of the sub-message, empty on success.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventScriptBatchMsg) Reset()         { *m = EventScriptBatchMsg{} }
+func (m *EventScriptBatchMsg) String() string { return proto.CompactTextString(m) }
+func (*EventScriptBatchMsg) ProtoMessage()    {}
+func (*EventScriptBatchMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848ca6ed468fa3ce, []int{3}
+}
+func (m *EventScriptBatchMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScriptBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScriptBatchMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScriptBatchMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScriptBatchMsg.Merge(m, src)
+}
+func (m *EventScriptBatchMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScriptBatchMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScriptBatchMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScriptBatchMsg proto.InternalMessageInfo
+
+func (m *EventScriptBatchMsg) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *EventScriptBatchMsg) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *EventScriptBatchMsg) GetMsgIndex() uint32 {
+	if m != nil {
+		return m.MsgIndex
+	}
+	return 0
+}
+
+func (m *EventScriptBatchMsg) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *EventScriptBatchMsg) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EventScriptBatchMsg) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // EventCreateNewScript is an event emitted when a new script is created.
 type EventCreateNewScript struct {
 	// The address of the newly created script.
@@ -204,7 +297,7 @@ func (m *EventCreateNewScript) Reset()         { *m = EventCreateNewScript{} }
 func (m *EventCreateNewScript) String() string { return proto.CompactTextString(m) }
 func (*EventCreateNewScript) ProtoMessage()    {}
 func (*EventCreateNewScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_848ca6ed468fa3ce, []int{3}
+	return fileDescriptor_848ca6ed468fa3ce, []int{4}
 }
 func (m *EventCreateNewScript) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -254,11 +347,285 @@ func (m *EventCreateNewScript) GetVersion() uint64 {
 	return 0
 }
 
+// EventSnapshotQueryRegistered is emitted when a snapshot query is registered
+// or replaced.
+type EventSnapshotQueryRegistered struct {
+	// owner is the account that registered the query.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name of the snapshot query.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *EventSnapshotQueryRegistered) Reset()         { *m = EventSnapshotQueryRegistered{} }
+func (m *EventSnapshotQueryRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventSnapshotQueryRegistered) ProtoMessage()    {}
+func (*EventSnapshotQueryRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848ca6ed468fa3ce, []int{5}
+}
+func (m *EventSnapshotQueryRegistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSnapshotQueryRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSnapshotQueryRegistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSnapshotQueryRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSnapshotQueryRegistered.Merge(m, src)
+}
+func (m *EventSnapshotQueryRegistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSnapshotQueryRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSnapshotQueryRegistered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSnapshotQueryRegistered proto.InternalMessageInfo
+
+func (m *EventSnapshotQueryRegistered) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventSnapshotQueryRegistered) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// EventSnapshotQueryDeleted is emitted when a snapshot query is deleted.
+type EventSnapshotQueryDeleted struct {
+	// owner is the account that registered the query.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name of the snapshot query.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *EventSnapshotQueryDeleted) Reset()         { *m = EventSnapshotQueryDeleted{} }
+func (m *EventSnapshotQueryDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventSnapshotQueryDeleted) ProtoMessage()    {}
+func (*EventSnapshotQueryDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848ca6ed468fa3ce, []int{6}
+}
+func (m *EventSnapshotQueryDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSnapshotQueryDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSnapshotQueryDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSnapshotQueryDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSnapshotQueryDeleted.Merge(m, src)
+}
+func (m *EventSnapshotQueryDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSnapshotQueryDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSnapshotQueryDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSnapshotQueryDeleted proto.InternalMessageInfo
+
+func (m *EventSnapshotQueryDeleted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventSnapshotQueryDeleted) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// EventGasSponsorshipSet is emitted when a script sets its gas sponsorship.
+type EventGasSponsorshipSet struct {
+	// script_address is the sponsoring script.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+}
+
+func (m *EventGasSponsorshipSet) Reset()         { *m = EventGasSponsorshipSet{} }
+func (m *EventGasSponsorshipSet) String() string { return proto.CompactTextString(m) }
+func (*EventGasSponsorshipSet) ProtoMessage()    {}
+func (*EventGasSponsorshipSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848ca6ed468fa3ce, []int{7}
+}
+func (m *EventGasSponsorshipSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasSponsorshipSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasSponsorshipSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasSponsorshipSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasSponsorshipSet.Merge(m, src)
+}
+func (m *EventGasSponsorshipSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasSponsorshipSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasSponsorshipSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasSponsorshipSet proto.InternalMessageInfo
+
+func (m *EventGasSponsorshipSet) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+// EventGasSponsorshipDeleted is emitted when a script deletes its gas
+// sponsorship.
+type EventGasSponsorshipDeleted struct {
+	// script_address is the sponsoring script.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+}
+
+func (m *EventGasSponsorshipDeleted) Reset()         { *m = EventGasSponsorshipDeleted{} }
+func (m *EventGasSponsorshipDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventGasSponsorshipDeleted) ProtoMessage()    {}
+func (*EventGasSponsorshipDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848ca6ed468fa3ce, []int{8}
+}
+func (m *EventGasSponsorshipDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasSponsorshipDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasSponsorshipDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasSponsorshipDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasSponsorshipDeleted.Merge(m, src)
+}
+func (m *EventGasSponsorshipDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasSponsorshipDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasSponsorshipDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasSponsorshipDeleted proto.InternalMessageInfo
+
+func (m *EventGasSponsorshipDeleted) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+// EventGasSponsored is emitted when a script pays the fee of a transaction.
+type EventGasSponsored struct {
+	// script_address is the sponsoring script.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	// user is the signer whose fee was paid.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// fee is the sponsored fee.
+	Fee string `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`
+}
+
+func (m *EventGasSponsored) Reset()         { *m = EventGasSponsored{} }
+func (m *EventGasSponsored) String() string { return proto.CompactTextString(m) }
+func (*EventGasSponsored) ProtoMessage()    {}
+func (*EventGasSponsored) Descriptor() ([]byte, []int) {
+	return fileDescriptor_848ca6ed468fa3ce, []int{9}
+}
+func (m *EventGasSponsored) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGasSponsored) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGasSponsored.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGasSponsored) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGasSponsored.Merge(m, src)
+}
+func (m *EventGasSponsored) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGasSponsored) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGasSponsored.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGasSponsored proto.InternalMessageInfo
+
+func (m *EventGasSponsored) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *EventGasSponsored) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *EventGasSponsored) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateScript)(nil), "dysonprotocol.script.v1.EventUpdateScript")
 	proto.RegisterType((*EventExecScript)(nil), "dysonprotocol.script.v1.EventExecScript")
 	proto.RegisterType((*EventScriptEvent)(nil), "dysonprotocol.script.v1.EventScriptEvent")
+	proto.RegisterType((*EventScriptBatchMsg)(nil), "dysonprotocol.script.v1.EventScriptBatchMsg")
 	proto.RegisterType((*EventCreateNewScript)(nil), "dysonprotocol.script.v1.EventCreateNewScript")
+	proto.RegisterType((*EventSnapshotQueryRegistered)(nil), "dysonprotocol.script.v1.EventSnapshotQueryRegistered")
+	proto.RegisterType((*EventSnapshotQueryDeleted)(nil), "dysonprotocol.script.v1.EventSnapshotQueryDeleted")
+	proto.RegisterType((*EventGasSponsorshipSet)(nil), "dysonprotocol.script.v1.EventGasSponsorshipSet")
+	proto.RegisterType((*EventGasSponsorshipDeleted)(nil), "dysonprotocol.script.v1.EventGasSponsorshipDeleted")
+	proto.RegisterType((*EventGasSponsored)(nil), "dysonprotocol.script.v1.EventGasSponsored")
 }
 
 func init() {
@@ -266,31 +633,44 @@ func init() {
 }
 
 var fileDescriptor_848ca6ed468fa3ce = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x6f, 0xe2, 0x40,
-	0x10, 0xc5, 0xc7, 0xdd, 0x71, 0xec, 0xe9, 0xf8, 0xb0, 0x90, 0xce, 0x50, 0x58, 0xc8, 0xba, 0x53,
-	0x68, 0xe2, 0x15, 0x49, 0x47, 0x97, 0x0f, 0xca, 0xa4, 0x70, 0x92, 0x26, 0x0d, 0x32, 0xf6, 0xc4,
-	0x42, 0x01, 0xaf, 0xb3, 0xbb, 0x38, 0xb8, 0xcb, 0x4f, 0x88, 0x94, 0x3f, 0x95, 0x92, 0x32, 0x65,
-	0x04, 0x7f, 0x24, 0xf2, 0xec, 0x1a, 0xc5, 0x05, 0x4a, 0xb3, 0x9a, 0x79, 0xf3, 0xde, 0xbe, 0x37,
-	0xab, 0x25, 0xff, 0xc2, 0x4c, 0xb0, 0x38, 0xe1, 0x4c, 0xb2, 0x80, 0xcd, 0xa9, 0x08, 0xf8, 0x2c,
-	0x91, 0x34, 0x1d, 0x52, 0x48, 0x21, 0x96, 0xc2, 0xc5, 0x89, 0xf9, 0xb7, 0xc4, 0x72, 0x15, 0xcb,
-	0x4d, 0x87, 0xbd, 0x6e, 0xc0, 0xc4, 0x82, 0x89, 0x09, 0x8e, 0xa8, 0x6a, 0x94, 0xa6, 0xd7, 0xdf,
-	0x77, 0xb3, 0x5c, 0x69, 0x46, 0x27, 0x62, 0x11, 0x53, 0xca, 0xbc, 0xd2, 0x68, 0xdb, 0x5f, 0xcc,
-	0x62, 0x46, 0xf1, 0xd4, 0x50, 0x37, 0x62, 0x2c, 0x9a, 0x03, 0xc5, 0x6e, 0xba, 0xbc, 0xa3, 0x7e,
-	0x9c, 0xa9, 0x91, 0x73, 0x48, 0xda, 0xe3, 0x3c, 0xe9, 0x4d, 0x12, 0xfa, 0x12, 0xae, 0xd0, 0xc4,
-	0xb4, 0x48, 0x2d, 0x05, 0x2e, 0x66, 0x2c, 0xb6, 0x8c, 0xbe, 0x31, 0xf8, 0xee, 0x15, 0xad, 0xf3,
-	0x62, 0x90, 0x26, 0xf2, 0xc7, 0x2b, 0x08, 0x34, 0x7b, 0x44, 0x6a, 0x1c, 0x1e, 0x96, 0x20, 0x24,
-	0xb2, 0x7f, 0x1f, 0xf5, 0xdd, 0x3d, 0xeb, 0xba, 0x17, 0x22, 0xca, 0x85, 0x5e, 0x21, 0x30, 0xcf,
-	0xc9, 0x2f, 0x0e, 0x22, 0x61, 0xb1, 0x00, 0xeb, 0x1b, 0x8a, 0x07, 0x5f, 0x8a, 0x35, 0xdf, 0xdb,
-	0x29, 0x9d, 0x6b, 0xd2, 0xc2, 0x50, 0x2a, 0x10, 0x96, 0xf9, 0x0e, 0x7e, 0x18, 0x72, 0x10, 0x02,
-	0x53, 0xd5, 0xbd, 0xa2, 0x35, 0x5b, 0xa4, 0x7a, 0x0f, 0x19, 0xda, 0xd5, 0xbd, 0xbc, 0x34, 0x3b,
-	0xe4, 0x47, 0xea, 0xcf, 0x97, 0x60, 0x55, 0x11, 0x53, 0x8d, 0xf3, 0x64, 0x90, 0x0e, 0xde, 0x75,
-	0xc6, 0xc1, 0x97, 0x70, 0x09, 0x8f, 0x7a, 0xe1, 0xff, 0xa4, 0xa1, 0x52, 0x4d, 0xca, 0x0e, 0x7f,
-	0x14, 0x7a, 0xa2, 0x7d, 0x0e, 0x48, 0x33, 0xc8, 0x95, 0x8c, 0xef, 0x78, 0xca, 0xb3, 0xa1, 0xe1,
-	0x82, 0xf8, 0xe9, 0xb9, 0xab, 0xa5, 0xe7, 0x3e, 0x1d, 0xbd, 0x6e, 0x6c, 0x63, 0xbd, 0xb1, 0x8d,
-	0xf7, 0x8d, 0x6d, 0x3c, 0x6f, 0xed, 0xca, 0x7a, 0x6b, 0x57, 0xde, 0xb6, 0x76, 0xe5, 0xb6, 0xfc,
-	0x3b, 0xdc, 0x80, 0x2d, 0xe8, 0xaa, 0xf8, 0x23, 0x32, 0x4b, 0x40, 0x4c, 0x7f, 0xe2, 0xf0, 0xf8,
-	0x23, 0x00, 0x00, 0xff, 0xff, 0xce, 0x8d, 0x59, 0x94, 0xa2, 0x02, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xad, 0xe9, 0x57, 0xb2, 0xd0, 0x2f, 0x13, 0x81, 0x13, 0x90, 0x15, 0x59, 0x20, 0x72, 0xc1,
+	0x56, 0xe1, 0xd6, 0x0b, 0xa2, 0x6d, 0x04, 0x1c, 0x8a, 0x84, 0xd3, 0x5e, 0xb8, 0x04, 0xd7, 0x9e,
+	0x3a, 0x16, 0xb6, 0xd7, 0xec, 0xac, 0xd3, 0xf8, 0xc6, 0x4f, 0x40, 0xe2, 0x07, 0x71, 0xe5, 0xd8,
+	0x23, 0x47, 0x94, 0xfc, 0x11, 0xb4, 0x1f, 0x0e, 0x8d, 0xa0, 0x22, 0x5c, 0xaa, 0x79, 0x33, 0xef,
+	0xcd, 0xcc, 0xdb, 0x4e, 0x4c, 0x1e, 0x45, 0x15, 0xd2, 0xbc, 0x60, 0x94, 0xd3, 0x90, 0xa6, 0x1e,
+	0x86, 0x2c, 0x29, 0xb8, 0x37, 0xde, 0xf7, 0x60, 0x0c, 0x39, 0x47, 0x57, 0x56, 0xcc, 0xfb, 0x0b,
+	0x2c, 0x57, 0xb1, 0xdc, 0xf1, 0x7e, 0xa7, 0x1d, 0x52, 0xcc, 0x28, 0x0e, 0x65, 0xc9, 0x53, 0x40,
+	0x69, 0x3a, 0xdd, 0x9b, 0x3a, 0xf3, 0x89, 0x66, 0xb4, 0x62, 0x1a, 0x53, 0xa5, 0x14, 0x91, 0xce,
+	0xee, 0x05, 0x59, 0x92, 0x53, 0x4f, 0xfe, 0xd5, 0xa9, 0x76, 0x4c, 0x69, 0x9c, 0x82, 0x27, 0xd1,
+	0x79, 0x79, 0xe1, 0x05, 0x79, 0xa5, 0x4a, 0xce, 0x53, 0xb2, 0xd7, 0x17, 0x9b, 0x9e, 0x15, 0x51,
+	0xc0, 0x61, 0x20, 0x87, 0x98, 0x16, 0xd9, 0x1c, 0x03, 0xc3, 0x84, 0xe6, 0x96, 0xd1, 0x35, 0x7a,
+	0x6b, 0x7e, 0x0d, 0x9d, 0xaf, 0x06, 0xd9, 0x91, 0xfc, 0xfe, 0x04, 0x42, 0xcd, 0x3e, 0x20, 0x9b,
+	0x0c, 0x3e, 0x95, 0x80, 0x5c, 0xb2, 0x6f, 0x3f, 0xeb, 0xba, 0x37, 0xd8, 0x75, 0x4f, 0x30, 0x16,
+	0x42, 0xbf, 0x16, 0x98, 0xc7, 0xa4, 0xc1, 0x00, 0x0b, 0x9a, 0x23, 0x58, 0xb7, 0xa4, 0xb8, 0xf7,
+	0x4f, 0xb1, 0xe6, 0xfb, 0x73, 0xa5, 0x73, 0x4a, 0x76, 0xe5, 0x52, 0x6a, 0x21, 0x19, 0x0a, 0x0f,
+	0x41, 0x14, 0x31, 0x40, 0x94, 0x5b, 0x35, 0xfd, 0x1a, 0x9a, 0xbb, 0x64, 0xf5, 0x23, 0x54, 0x72,
+	0x5c, 0xd3, 0x17, 0xa1, 0xd9, 0x22, 0xeb, 0xe3, 0x20, 0x2d, 0xc1, 0x5a, 0x95, 0x39, 0x05, 0x9c,
+	0x6f, 0x06, 0xb9, 0x7b, 0xad, 0xed, 0x61, 0xc0, 0xc3, 0xd1, 0x09, 0xc6, 0xe6, 0x63, 0xb2, 0xad,
+	0x96, 0x1a, 0x2e, 0x0e, 0xd8, 0x52, 0xd9, 0x97, 0x7a, 0x8c, 0x49, 0xd6, 0x32, 0x1a, 0x81, 0x9e,
+	0x23, 0x63, 0xf3, 0x01, 0x69, 0x66, 0x18, 0x0f, 0x93, 0x3c, 0x82, 0x89, 0x1c, 0xb6, 0xe5, 0x37,
+	0x32, 0x8c, 0xdf, 0x08, 0x6c, 0x76, 0xc9, 0x1d, 0x51, 0xe4, 0x55, 0x01, 0xc3, 0x92, 0xa5, 0xd6,
+	0x9a, 0x14, 0x92, 0x0c, 0xe3, 0xd3, 0xaa, 0x80, 0x33, 0x96, 0x0a, 0x4f, 0x58, 0x86, 0xa1, 0x18,
+	0xb9, 0xde, 0x35, 0x7a, 0x0d, 0xbf, 0x86, 0xc2, 0x01, 0x30, 0x46, 0x99, 0xb5, 0xa1, 0x1c, 0x48,
+	0xe0, 0x7c, 0x36, 0x48, 0x4b, 0x3a, 0x38, 0x62, 0x10, 0x70, 0x78, 0x0b, 0x97, 0xfa, 0x5f, 0xb6,
+	0xa4, 0x85, 0x27, 0x64, 0x27, 0x14, 0x4a, 0xca, 0xe6, 0x3c, 0xe5, 0x66, 0x5b, 0xa7, 0x6b, 0xe2,
+	0xb5, 0x83, 0x59, 0x5d, 0x3c, 0x98, 0xd7, 0xe4, 0xa1, 0x7a, 0xc3, 0x3c, 0x28, 0x70, 0x44, 0xf9,
+	0xbb, 0x12, 0x58, 0xe5, 0x43, 0x9c, 0x20, 0x07, 0x06, 0x91, 0x58, 0x9c, 0x5e, 0xe6, 0xc0, 0xf4,
+	0x02, 0x0a, 0x88, 0xb7, 0xcb, 0x83, 0x6c, 0xfe, 0x76, 0x22, 0x76, 0xfa, 0xa4, 0xfd, 0x67, 0xa7,
+	0x63, 0x48, 0x81, 0xff, 0x57, 0x9b, 0x17, 0xe4, 0x9e, 0x6c, 0xf3, 0x2a, 0xc0, 0x81, 0xb8, 0x1e,
+	0xca, 0x70, 0x94, 0x14, 0x03, 0x58, 0xf6, 0x51, 0x9c, 0x23, 0xd2, 0xf9, 0x4b, 0x83, 0x7a, 0x91,
+	0x25, 0x9b, 0x7c, 0xd0, 0x3f, 0xbb, 0xdf, 0x4d, 0x96, 0xd6, 0x0a, 0x57, 0x25, 0x02, 0xab, 0x5d,
+	0x89, 0x58, 0xdc, 0xf4, 0x05, 0xd4, 0xf7, 0x2b, 0xc2, 0xc3, 0x83, 0xef, 0x53, 0xdb, 0xb8, 0x9a,
+	0xda, 0xc6, 0xcf, 0xa9, 0x6d, 0x7c, 0x99, 0xd9, 0x2b, 0x57, 0x33, 0x7b, 0xe5, 0xc7, 0xcc, 0x5e,
+	0x79, 0xbf, 0xf8, 0x61, 0x71, 0x43, 0x9a, 0x79, 0x93, 0xfa, 0xf3, 0x22, 0x8e, 0x0f, 0xcf, 0x37,
+	0x64, 0xf1, 0xf9, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4c, 0x7b, 0x1e, 0x9a, 0xdd, 0x04, 0x00,
+	0x00,
 }
 
 func (m *EventUpdateScript) Marshal() (dAtA []byte, err error) {
@@ -412,6 +792,72 @@ func (m *EventScriptEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventScriptBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScriptBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScriptBatchMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MsgIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MsgIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Mode) > 0 {
+		i -= len(m.Mode)
+		copy(dAtA[i:], m.Mode)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Mode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventCreateNewScript) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -454,11 +900,189 @@ func (m *EventCreateNewScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+func (m *EventSnapshotQueryRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSnapshotQueryRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSnapshotQueryRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSnapshotQueryDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSnapshotQueryDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSnapshotQueryDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGasSponsorshipSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasSponsorshipSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasSponsorshipSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGasSponsorshipDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasSponsorshipDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasSponsorshipDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGasSponsored) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGasSponsored) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGasSponsored) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -515,6 +1139,37 @@ func (m *EventScriptEvent) Size() (n int) {
 	return n
 }
 
+func (m *EventScriptBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MsgIndex != 0 {
+		n += 1 + sovEvents(uint64(m.MsgIndex))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventCreateNewScript) Size() (n int) {
 	if m == nil {
 		return 0
@@ -535,6 +1190,87 @@ func (m *EventCreateNewScript) Size() (n int) {
 	return n
 }
 
+func (m *EventSnapshotQueryRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSnapshotQueryDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventGasSponsorshipSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventGasSponsorshipDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventGasSponsored) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -551,26 +1287,644 @@ func (m *EventUpdateScript) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowEvents
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventExecScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventExecScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventExecScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Request == nil {
+				m.Request = &MsgExec{}
+			}
+			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Response == nil {
+				m.Response = &MsgExecResponse{}
+			}
+			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScriptEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScriptEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScriptEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScriptBatchMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScriptBatchMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScriptBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgIndex", wireType)
+			}
+			m.MsgIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MsgIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateNewScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateNewScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateNewScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddress", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateScript: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateScript: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
@@ -610,7 +1964,7 @@ func (m *EventUpdateScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventExecScript) Unmarshal(dAtA []byte) error {
+func (m *EventSnapshotQueryRegistered) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -633,17 +1987,17 @@ func (m *EventExecScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventExecScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSnapshotQueryRegistered: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventExecScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSnapshotQueryRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -653,33 +2007,29 @@ func (m *EventExecScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Request == nil {
-				m.Request = &MsgExec{}
-			}
-			if err := m.Request.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -689,27 +2039,23 @@ func (m *EventExecScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Response == nil {
-				m.Response = &MsgExecResponse{}
-			}
-			if err := m.Response.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -732,7 +2078,7 @@ func (m *EventExecScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventScriptEvent) Unmarshal(dAtA []byte) error {
+func (m *EventSnapshotQueryDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -755,15 +2101,15 @@ func (m *EventScriptEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventScriptEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSnapshotQueryDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventScriptEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSnapshotQueryDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -791,11 +2137,11 @@ func (m *EventScriptEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -823,11 +2169,61 @@ func (m *EventScriptEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGasSponsorshipSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGasSponsorshipSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGasSponsorshipSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -855,7 +2251,7 @@ func (m *EventScriptEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -878,7 +2274,7 @@ func (m *EventScriptEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreateNewScript) Unmarshal(dAtA []byte) error {
+func (m *EventGasSponsorshipDeleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -901,10 +2297,92 @@ func (m *EventCreateNewScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreateNewScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventGasSponsorshipDeleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreateNewScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventGasSponsorshipDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGasSponsored) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGasSponsored: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGasSponsored: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -941,7 +2419,7 @@ func (m *EventCreateNewScript) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -969,13 +2447,13 @@ func (m *EventCreateNewScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatorAddress = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -985,11 +2463,24 @@ func (m *EventCreateNewScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -29,6 +29,14 @@ type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params  Params    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Scripts []*Script `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	// snapshot_queries are the registered snapshot queries.
+	SnapshotQueries []SnapshotQuery `protobuf:"bytes,3,rep,name=snapshot_queries,json=snapshotQueries,proto3" json:"snapshot_queries"`
+	// snapshots are the retained snapshots of the registered queries.
+	Snapshots []Snapshot `protobuf:"bytes,4,rep,name=snapshots,proto3" json:"snapshots"`
+	// gas_sponsorships are the gas sponsorship policies of scripts.
+	GasSponsorships []GasSponsorship `protobuf:"bytes,5,rep,name=gas_sponsorships,json=gasSponsorships,proto3" json:"gas_sponsorships"`
+	// sponsorship_usages are the per-user rate limit windows of sponsorships.
+	SponsorshipUsages []SponsorshipUsage `protobuf:"bytes,6,rep,name=sponsorship_usages,json=sponsorshipUsages,proto3" json:"sponsorship_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +86,34 @@ func (m *GenesisState) GetScripts() []*Script {
 	return nil
 }
 
+func (m *GenesisState) GetSnapshotQueries() []SnapshotQuery {
+	if m != nil {
+		return m.SnapshotQueries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSnapshots() []Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGasSponsorships() []GasSponsorship {
+	if m != nil {
+		return m.GasSponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorshipUsages() []SponsorshipUsage {
+	if m != nil {
+		return m.SponsorshipUsages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dysonprotocol.script.v1.GenesisState")
 }
@@ -87,21 +123,30 @@ func init() {
 }
 
 var fileDescriptor_b4e9d64dafc7e4e6 = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xa9, 0x2c, 0xce,
-	0xcf, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4e, 0x2e, 0xca, 0x2c, 0x28,
-	0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0x4b, 0x09, 0x89,
-	0xa3, 0x28, 0xd3, 0x83, 0x28, 0xd3, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7,
-	0x07, 0x93, 0x10, 0xb5, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15,
-	0x55, 0xc1, 0x65, 0x11, 0xd4, 0x2c, 0x02, 0xaa, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0xae, 0x51,
-	0xea, 0x60, 0xe4, 0xe2, 0x71, 0x87, 0xb8, 0x2f, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x96, 0x8b,
-	0x0d, 0xa2, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5e, 0x0f, 0x87, 0x7b, 0xf5, 0x02,
-	0xc0, 0xca, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a, 0x12, 0xb2, 0xe4, 0x62, 0x87,
-	0xa8, 0x28, 0x96, 0x60, 0x52, 0x60, 0xc6, 0xab, 0x3f, 0x18, 0xcc, 0x0a, 0x82, 0xa9, 0x77, 0xb2,
-	0x3a, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96,
-	0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x05, 0x54, 0x23, 0x92, 0xf3,
-	0x73, 0xf5, 0x2b, 0x60, 0x1e, 0x2a, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x4b, 0x1a, 0x03,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0x00, 0x17, 0x2a, 0x97, 0x84, 0x01, 0x00, 0x00,
+	// 359 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x4b, 0x02, 0x41,
+	0x18, 0xc6, 0x77, 0xd3, 0x8c, 0xa6, 0x20, 0x1d, 0x82, 0x16, 0x0f, 0xab, 0x45, 0x59, 0x5e, 0x76,
+	0xb1, 0x4e, 0x05, 0x5d, 0x84, 0xf0, 0x5a, 0x4a, 0x14, 0x1d, 0x92, 0xc9, 0x86, 0x75, 0x21, 0x77,
+	0xa6, 0x79, 0x57, 0xc9, 0x6f, 0xd1, 0xc7, 0xf2, 0xe8, 0xb1, 0x53, 0xc4, 0xfa, 0x45, 0xc2, 0xf9,
+	0x83, 0x1a, 0x4c, 0x5e, 0x96, 0xe1, 0x99, 0xdf, 0xf3, 0x9b, 0x77, 0x86, 0x45, 0x27, 0xaf, 0x63,
+	0x60, 0x09, 0x17, 0x2c, 0x65, 0x3d, 0xf6, 0x16, 0x42, 0x4f, 0xc4, 0x3c, 0x0d, 0x47, 0x8d, 0x30,
+	0xa2, 0x09, 0x85, 0x18, 0x02, 0xb9, 0x85, 0x0f, 0x56, 0xb0, 0x40, 0x61, 0xc1, 0xa8, 0x51, 0x2e,
+	0x91, 0x41, 0x9c, 0xb0, 0x50, 0x7e, 0x15, 0x5b, 0xde, 0x8f, 0x58, 0xc4, 0xe4, 0x32, 0x9c, 0xaf,
+	0x74, 0x7a, 0x6c, 0x3b, 0x48, 0xbb, 0xd6, 0x50, 0x9c, 0x08, 0x32, 0xd0, 0xd3, 0x94, 0x6b, 0x56,
+	0x57, 0x42, 0x38, 0xf4, 0x99, 0xb1, 0xd5, 0xad, 0x1c, 0x67, 0x09, 0x30, 0x01, 0xfd, 0x98, 0x2b,
+	0xf4, 0x28, 0xcb, 0xa1, 0xdd, 0x96, 0xba, 0x72, 0x27, 0x25, 0x29, 0xc5, 0xd7, 0xa8, 0xa0, 0xce,
+	0xf4, 0xdc, 0xaa, 0x7b, 0xb6, 0x73, 0x5e, 0x09, 0x2c, 0x4f, 0x10, 0xdc, 0x4a, 0xac, 0x99, 0x9f,
+	0x7c, 0x57, 0x9c, 0xb6, 0x2e, 0xe1, 0x4b, 0xb4, 0xa5, 0x08, 0xf0, 0x36, 0xaa, 0xb9, 0x7f, 0xfb,
+	0x1d, 0xb9, 0x6a, 0x1b, 0x1e, 0x3f, 0xa0, 0xa2, 0xb9, 0x47, 0xf7, 0x7d, 0x48, 0x45, 0x4c, 0xc1,
+	0xcb, 0x49, 0x47, 0xcd, 0xee, 0xd0, 0x85, 0xbb, 0x21, 0x15, 0x63, 0x3d, 0xca, 0x1e, 0x2c, 0x85,
+	0x31, 0x05, 0x7c, 0x83, 0xb6, 0x4d, 0x04, 0x5e, 0x5e, 0x1a, 0x0f, 0xd7, 0x1a, 0xb5, 0x6c, 0xd1,
+	0xc4, 0x8f, 0xa8, 0x18, 0x11, 0xe8, 0x2e, 0xbd, 0x21, 0x78, 0x9b, 0xd2, 0x76, 0x6a, 0xb5, 0xb5,
+	0x08, 0x74, 0x16, 0xbc, 0x19, 0x30, 0x5a, 0x49, 0x01, 0x3f, 0x23, 0xbc, 0x64, 0xed, 0x0e, 0x81,
+	0x44, 0x14, 0xbc, 0x82, 0x74, 0xd7, 0xed, 0x93, 0x2e, 0x2a, 0xf7, 0xf3, 0x86, 0xb6, 0x97, 0xe0,
+	0x4f, 0x0e, 0xcd, 0xab, 0x49, 0xe6, 0xbb, 0xd3, 0xcc, 0x77, 0x7f, 0x32, 0xdf, 0xfd, 0x9c, 0xf9,
+	0xce, 0x74, 0xe6, 0x3b, 0x5f, 0x33, 0xdf, 0x79, 0xaa, 0xae, 0xca, 0x7b, 0x6c, 0x10, 0x7e, 0x98,
+	0xff, 0x25, 0x1d, 0x73, 0x0a, 0x2f, 0x05, 0xb9, 0x79, 0xf1, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x7b,
+	0x2c, 0x41, 0xfd, 0x31, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +169,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SponsorshipUsages) > 0 {
+		for iNdEx := len(m.SponsorshipUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsorshipUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GasSponsorships) > 0 {
+		for iNdEx := len(m.GasSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SnapshotQueries) > 0 {
+		for iNdEx := len(m.SnapshotQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Scripts) > 0 {
 		for iNdEx := len(m.Scripts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +277,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SnapshotQueries) > 0 {
+		for _, e := range m.SnapshotQueries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GasSponsorships) > 0 {
+		for _, e := range m.GasSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsorshipUsages) > 0 {
+		for _, e := range m.SponsorshipUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +406,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotQueries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SnapshotQueries = append(m.SnapshotQueries, SnapshotQuery{})
+			if err := m.SnapshotQueries[len(m.SnapshotQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, Snapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasSponsorships = append(m.GasSponsorships, GasSponsorship{})
+			if err := m.GasSponsorships[len(m.GasSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorshipUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorshipUsages = append(m.SponsorshipUsages, SponsorshipUsage{})
+			if err := m.SponsorshipUsages[len(m.SponsorshipUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,17 +22,74 @@ const MinAbsoluteHistoricalBlockCutoff = int64(1)
 // MaxAbsoluteHistoricalBlockCutoff is the maximum allowed value for the absolute historical block cutoff parameter
 const MaxAbsoluteHistoricalBlockCutoff = int64(1000000) // 1 million blocks
 
+// DefaultSnapshotInterval is the default number of blocks between snapshots of registered snapshot queries
+const DefaultSnapshotInterval = int64(10)
+
+// MaxSnapshotInterval is the maximum allowed value for the snapshot interval parameter
+const MaxSnapshotInterval = int64(100000)
+
+// DefaultSnapshotRetention is the default number of snapshots kept per snapshot query
+const DefaultSnapshotRetention = int64(100)
+
+// MaxSnapshotRetention is the maximum allowed value for the snapshot retention parameter
+const MaxSnapshotRetention = int64(10000)
+
+// DefaultMaxSnapshotQueries is the default maximum number of snapshot queries across all owners
+const DefaultMaxSnapshotQueries = uint64(100)
+
+// DefaultMaxSnapshotQueriesPerOwner is the default maximum number of snapshot queries per owner
+const DefaultMaxSnapshotQueriesPerOwner = uint64(10)
+
+// DefaultSnapshotQueryGasLimit is the default gas limit of a single snapshot query
+const DefaultSnapshotQueryGasLimit = uint64(100000)
+
+// MaxSnapshotQueryGasLimit is the maximum allowed value for the snapshot query gas limit parameter
+const MaxSnapshotQueryGasLimit = uint64(10000000)
+
+// DefaultHistoricalQueryBaseGas is the default flat gas charged for a historical read
+const DefaultHistoricalQueryBaseGas = uint64(10000)
+
+// DefaultHistoricalQueryGasPerByte is the default gas charged per byte of a historical read response
+const DefaultHistoricalQueryGasPerByte = uint64(10)
+
 // NewParams creates a new Params instance with given values
-func NewParams(maxRelativeHistoricalBlocks int64, absoluteHistoricalBlockCutoff int64) Params {
+func NewParams(
+	maxRelativeHistoricalBlocks int64,
+	absoluteHistoricalBlockCutoff int64,
+	snapshotInterval int64,
+	snapshotRetention int64,
+	maxSnapshotQueries uint64,
+	maxSnapshotQueriesPerOwner uint64,
+	snapshotQueryGasLimit uint64,
+	historicalQueryBaseGas uint64,
+	historicalQueryGasPerByte uint64,
+) Params {
 	return Params{
 		MaxRelativeHistoricalBlocks:   maxRelativeHistoricalBlocks,
 		AbsoluteHistoricalBlockCutoff: absoluteHistoricalBlockCutoff,
+		SnapshotInterval:              snapshotInterval,
+		SnapshotRetention:             snapshotRetention,
+		MaxSnapshotQueries:            maxSnapshotQueries,
+		MaxSnapshotQueriesPerOwner:    maxSnapshotQueriesPerOwner,
+		SnapshotQueryGasLimit:         snapshotQueryGasLimit,
+		HistoricalQueryBaseGas:        historicalQueryBaseGas,
+		HistoricalQueryGasPerByte:     historicalQueryGasPerByte,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultMaxRelativeHistoricalBlocks, DefaultAbsoluteHistoricalBlockCutoff)
+	return NewParams(
+		DefaultMaxRelativeHistoricalBlocks,
+		DefaultAbsoluteHistoricalBlockCutoff,
+		DefaultSnapshotInterval,
+		DefaultSnapshotRetention,
+		DefaultMaxSnapshotQueries,
+		DefaultMaxSnapshotQueriesPerOwner,
+		DefaultSnapshotQueryGasLimit,
+		DefaultHistoricalQueryBaseGas,
+		DefaultHistoricalQueryGasPerByte,
+	)
 }
 
 // Validate validates the params
@@ -43,6 +100,9 @@ func (p Params) Validate() error {
 	if err := validateAbsoluteHistoricalBlockCutoff(p.AbsoluteHistoricalBlockCutoff); err != nil {
 		return err
 	}
+	if err := validateSnapshotParams(p); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -69,3 +129,31 @@ func validateAbsoluteHistoricalBlockCutoff(absoluteHistoricalBlockCutoff int64)
 
 	return nil
 }
+
+func validateSnapshotParams(p Params) error {
+	if p.SnapshotInterval < 0 || p.SnapshotInterval > MaxSnapshotInterval {
+		return fmt.Errorf("snapshot interval must be between 0 and %d, got: %d", MaxSnapshotInterval, p.SnapshotInterval)
+	}
+
+	if p.SnapshotRetention < 0 || p.SnapshotRetention > MaxSnapshotRetention {
+		return fmt.Errorf("snapshot retention must be between 0 and %d, got: %d", MaxSnapshotRetention, p.SnapshotRetention)
+	}
+
+	if p.SnapshotInterval > 0 && p.SnapshotRetention < 1 {
+		return fmt.Errorf("snapshot retention must be at least 1 when snapshots are enabled")
+	}
+
+	if p.SnapshotInterval > 0 && p.SnapshotQueryGasLimit == 0 {
+		return fmt.Errorf("snapshot query gas limit must be greater than 0 when snapshots are enabled")
+	}
+
+	if p.MaxSnapshotQueriesPerOwner > p.MaxSnapshotQueries {
+		return fmt.Errorf("max snapshot queries per owner (%d) must not exceed max snapshot queries (%d)", p.MaxSnapshotQueriesPerOwner, p.MaxSnapshotQueries)
+	}
+
+	if p.SnapshotQueryGasLimit > MaxSnapshotQueryGasLimit {
+		return fmt.Errorf("snapshot query gas limit must be at most %d, got: %d", MaxSnapshotQueryGasLimit, p.SnapshotQueryGasLimit)
+	}
+
+	return nil
+}
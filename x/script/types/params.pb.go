@@ -39,6 +39,28 @@ type Params struct {
 	// height will be max(current_height - max_relative_historical_blocks,
 	// absolute_historical_block_cutoff).
 	AbsoluteHistoricalBlockCutoff int64 `protobuf:"varint,2,opt,name=absolute_historical_block_cutoff,json=absoluteHistoricalBlockCutoff,proto3" json:"absolute_historical_block_cutoff,omitempty" yaml:"absolute_historical_block_cutoff"`
+	// snapshot_interval is the number of blocks between snapshots of registered
+	// snapshot queries. Snapshots are taken in EndBlock when the block height is
+	// a multiple of this value. Zero disables snapshots.
+	SnapshotInterval int64 `protobuf:"varint,3,opt,name=snapshot_interval,json=snapshotInterval,proto3" json:"snapshot_interval,omitempty" yaml:"snapshot_interval"`
+	// snapshot_retention is the number of most recent snapshots kept for each
+	// snapshot query. Older snapshots are pruned when a new one is taken.
+	SnapshotRetention int64 `protobuf:"varint,4,opt,name=snapshot_retention,json=snapshotRetention,proto3" json:"snapshot_retention,omitempty" yaml:"snapshot_retention"`
+	// max_snapshot_queries is the maximum number of snapshot queries registered
+	// across all owners. It bounds the work done in EndBlock.
+	MaxSnapshotQueries uint64 `protobuf:"varint,5,opt,name=max_snapshot_queries,json=maxSnapshotQueries,proto3" json:"max_snapshot_queries,omitempty" yaml:"max_snapshot_queries"`
+	// max_snapshot_queries_per_owner is the maximum number of snapshot queries a
+	// single owner can register.
+	MaxSnapshotQueriesPerOwner uint64 `protobuf:"varint,6,opt,name=max_snapshot_queries_per_owner,json=maxSnapshotQueriesPerOwner,proto3" json:"max_snapshot_queries_per_owner,omitempty" yaml:"max_snapshot_queries_per_owner"`
+	// snapshot_query_gas_limit is the gas limit for a single snapshot query
+	// executed in EndBlock. It is also charged to the registering transaction.
+	SnapshotQueryGasLimit uint64 `protobuf:"varint,7,opt,name=snapshot_query_gas_limit,json=snapshotQueryGasLimit,proto3" json:"snapshot_query_gas_limit,omitempty" yaml:"snapshot_query_gas_limit"`
+	// historical_query_base_gas is the flat gas charged for every snapshot
+	// lookup.
+	HistoricalQueryBaseGas uint64 `protobuf:"varint,8,opt,name=historical_query_base_gas,json=historicalQueryBaseGas,proto3" json:"historical_query_base_gas,omitempty" yaml:"historical_query_base_gas"`
+	// historical_query_gas_per_byte is the gas charged per byte of a snapshot
+	// lookup's JSON response.
+	HistoricalQueryGasPerByte uint64 `protobuf:"varint,9,opt,name=historical_query_gas_per_byte,json=historicalQueryGasPerByte,proto3" json:"historical_query_gas_per_byte,omitempty" yaml:"historical_query_gas_per_byte"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +110,55 @@ func (m *Params) GetAbsoluteHistoricalBlockCutoff() int64 {
 	return 0
 }
 
+func (m *Params) GetSnapshotInterval() int64 {
+	if m != nil {
+		return m.SnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotRetention() int64 {
+	if m != nil {
+		return m.SnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSnapshotQueries() uint64 {
+	if m != nil {
+		return m.MaxSnapshotQueries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSnapshotQueriesPerOwner() uint64 {
+	if m != nil {
+		return m.MaxSnapshotQueriesPerOwner
+	}
+	return 0
+}
+
+func (m *Params) GetSnapshotQueryGasLimit() uint64 {
+	if m != nil {
+		return m.SnapshotQueryGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetHistoricalQueryBaseGas() uint64 {
+	if m != nil {
+		return m.HistoricalQueryBaseGas
+	}
+	return 0
+}
+
+func (m *Params) GetHistoricalQueryGasPerByte() uint64 {
+	if m != nil {
+		return m.HistoricalQueryGasPerByte
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dysonprotocol.script.v1.Params")
 }
@@ -97,23 +168,39 @@ func init() {
 }
 
 var fileDescriptor_aa0300f7a93fc716 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xa9, 0x2c, 0xce,
-	0xcf, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x4e, 0x2e, 0xca, 0x2c, 0x28,
-	0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0xcb, 0x08, 0x89, 0xa3,
-	0xa8, 0xd2, 0x83, 0xa8, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x8b, 0xeb,
-	0x83, 0x58, 0x10, 0xe5, 0x4a, 0xef, 0x18, 0xb9, 0xd8, 0x02, 0xc0, 0xfa, 0x85, 0xf2, 0xb8, 0xe4,
-	0x72, 0x13, 0x2b, 0xe2, 0x8b, 0x52, 0x73, 0x12, 0x4b, 0x32, 0xcb, 0x52, 0xe3, 0x33, 0x32, 0x8b,
-	0x4b, 0xf2, 0x8b, 0x32, 0x93, 0x13, 0x73, 0xe2, 0x93, 0x72, 0xf2, 0x93, 0xb3, 0x8b, 0x25, 0x18,
-	0x15, 0x18, 0x35, 0x98, 0x9d, 0x34, 0x3f, 0xdd, 0x93, 0x57, 0xad, 0x4c, 0xcc, 0xcd, 0xb1, 0x52,
-	0xc2, 0xaf, 0x5e, 0x29, 0x48, 0x3a, 0x37, 0xb1, 0x22, 0x08, 0x2a, 0xef, 0x01, 0x97, 0x76, 0x02,
-	0xcb, 0x0a, 0x95, 0x70, 0x29, 0x24, 0x26, 0x15, 0xe7, 0xe7, 0x94, 0x96, 0x60, 0xea, 0x8d, 0x4f,
-	0x2e, 0x2d, 0xc9, 0x4f, 0x4b, 0x93, 0x60, 0x02, 0xdb, 0xa8, 0xfd, 0xe9, 0x9e, 0xbc, 0x3a, 0xc4,
-	0x46, 0x42, 0x3a, 0x94, 0x82, 0x64, 0x61, 0x4a, 0xd0, 0x2c, 0x74, 0x06, 0xcb, 0x3b, 0x59, 0x9d,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x02, 0x6a, 0xc8, 0x25, 0xe7, 0xe7,
-	0xea, 0x57, 0xc0, 0x42, 0xb9, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x2c, 0x69, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0xe2, 0x83, 0xe7, 0xe3, 0x8a, 0x01, 0x00, 0x00,
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x17, 0x36, 0x0a, 0xf8, 0x04, 0xd6, 0x00, 0x77, 0xa3, 0x71, 0x65, 0x8a, 0x28, 0x42,
+	0x6a, 0x35, 0x71, 0xdb, 0x31, 0x1c, 0xca, 0xa4, 0x49, 0x6c, 0xe6, 0x86, 0x90, 0x2c, 0x27, 0x78,
+	0x5d, 0x20, 0x89, 0x83, 0xed, 0x96, 0xe6, 0x2d, 0x78, 0x2c, 0x8e, 0x3b, 0x72, 0x8a, 0x50, 0xfb,
+	0x06, 0x11, 0x0f, 0x80, 0xe2, 0xb4, 0x59, 0x9b, 0xaa, 0x70, 0x8b, 0xbe, 0xef, 0xf7, 0xfd, 0x3f,
+	0xe7, 0x6f, 0x19, 0xf4, 0x3e, 0x67, 0x5a, 0x26, 0xa9, 0x92, 0x46, 0x06, 0x32, 0x1a, 0xea, 0x40,
+	0x85, 0xa9, 0x19, 0x4e, 0x4f, 0x86, 0x29, 0x57, 0x3c, 0xd6, 0x03, 0xeb, 0xc0, 0xa7, 0x1b, 0xd4,
+	0xa0, 0xa2, 0x06, 0xd3, 0x93, 0xa3, 0xc3, 0xb1, 0x1c, 0x4b, 0xab, 0x0f, 0xcb, 0xaf, 0x0a, 0x27,
+	0x7f, 0x5a, 0xa0, 0x75, 0x61, 0xf3, 0x30, 0x01, 0x6e, 0xcc, 0x67, 0x4c, 0x89, 0x88, 0x9b, 0x70,
+	0x2a, 0xd8, 0x75, 0xa8, 0x8d, 0x54, 0x61, 0xc0, 0x23, 0xe6, 0x47, 0x32, 0xf8, 0xaa, 0x91, 0xd3,
+	0x75, 0xfa, 0xfb, 0xde, 0xab, 0x22, 0xc7, 0x2f, 0x32, 0x1e, 0x47, 0xa7, 0xe4, 0xdf, 0x3c, 0xa1,
+	0xc7, 0x31, 0x9f, 0xd1, 0xa5, 0xff, 0xae, 0xb6, 0x3d, 0xeb, 0x42, 0x03, 0xba, 0xdc, 0xd7, 0x32,
+	0x9a, 0x98, 0xed, 0x2c, 0x0b, 0x26, 0x46, 0x5e, 0x5d, 0xa1, 0x3b, 0xb6, 0xf1, 0x75, 0x91, 0xe3,
+	0x97, 0x55, 0xe3, 0xff, 0x12, 0x84, 0x76, 0x56, 0x48, 0xa3, 0xf0, 0xad, 0xf5, 0xe1, 0x19, 0x78,
+	0xa4, 0x13, 0x9e, 0xea, 0x6b, 0x69, 0x58, 0x98, 0x18, 0xa1, 0xa6, 0x3c, 0x42, 0xfb, 0xb6, 0xe6,
+	0x59, 0x91, 0x63, 0x54, 0xd5, 0x6c, 0x21, 0x84, 0x3e, 0x5c, 0x69, 0x67, 0x4b, 0x09, 0x9e, 0x03,
+	0x58, 0x73, 0x4a, 0x18, 0x91, 0x98, 0x50, 0x26, 0xe8, 0xc0, 0xce, 0xea, 0x14, 0x39, 0x6e, 0x37,
+	0x66, 0xd5, 0x0c, 0xa1, 0xf5, 0x19, 0xe8, 0x4a, 0x83, 0x97, 0xe0, 0xb0, 0x5c, 0x67, 0x4d, 0x7f,
+	0x9b, 0x08, 0x15, 0x0a, 0x8d, 0xee, 0x76, 0x9d, 0xfe, 0x81, 0x87, 0x8b, 0x1c, 0x1f, 0xdf, 0x2e,
+	0xbd, 0x49, 0x11, 0x0a, 0x63, 0x3e, 0xfb, 0xb0, 0x54, 0x2f, 0x2b, 0x11, 0xc6, 0xd5, 0x8d, 0x36,
+	0x61, 0x96, 0x0a, 0xc5, 0xe4, 0xf7, 0x44, 0x28, 0xd4, 0xb2, 0xc3, 0x1b, 0x37, 0xba, 0x9b, 0x27,
+	0xf4, 0x68, 0xbb, 0xe6, 0x42, 0xa8, 0xf7, 0xa5, 0x09, 0x3f, 0x01, 0xb4, 0x11, 0xcd, 0xd8, 0x98,
+	0x6b, 0x16, 0x85, 0x71, 0x68, 0xd0, 0x3d, 0x5b, 0xf4, 0xbc, 0xc8, 0x31, 0x6e, 0x6c, 0xa5, 0x41,
+	0x12, 0xfa, 0x58, 0xaf, 0xcd, 0xcf, 0x46, 0x5c, 0x9f, 0x97, 0x3a, 0x64, 0xa0, 0xbd, 0x76, 0xe7,
+	0x55, 0xca, 0xe7, 0x5a, 0x94, 0x51, 0x74, 0xdf, 0x8e, 0xef, 0x15, 0x39, 0xee, 0x56, 0xe3, 0x77,
+	0xa2, 0x84, 0x3e, 0xb9, 0xf5, 0x6c, 0x83, 0xc7, 0xb5, 0x18, 0x71, 0x0d, 0xbf, 0x80, 0xce, 0x56,
+	0xaa, 0x3c, 0x56, 0xf9, 0xf7, 0x7e, 0x66, 0x04, 0x7a, 0x60, 0x4b, 0xfa, 0x45, 0x8e, 0x7b, 0x3b,
+	0x4a, 0xd6, 0x71, 0x42, 0xdb, 0x8d, 0xa2, 0x11, 0x2f, 0x77, 0xe5, 0x65, 0x46, 0x78, 0xa7, 0x3f,
+	0xe7, 0xae, 0x73, 0x33, 0x77, 0x9d, 0xdf, 0x73, 0xd7, 0xf9, 0xb1, 0x70, 0xf7, 0x6e, 0x16, 0xee,
+	0xde, 0xaf, 0x85, 0xbb, 0xf7, 0xb1, 0xbb, 0xf9, 0x7e, 0x03, 0x19, 0x0f, 0x67, 0xab, 0xb7, 0x6e,
+	0xb2, 0x54, 0x68, 0xbf, 0x65, 0xcd, 0x37, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xca, 0x62, 0xd0,
+	0xc0, 0x10, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +223,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HistoricalQueryGasPerByte != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoricalQueryGasPerByte))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HistoricalQueryBaseGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoricalQueryBaseGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SnapshotQueryGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotQueryGasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxSnapshotQueriesPerOwner != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSnapshotQueriesPerOwner))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxSnapshotQueries != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSnapshotQueries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SnapshotRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotRetention))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SnapshotInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SnapshotInterval))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.AbsoluteHistoricalBlockCutoff != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.AbsoluteHistoricalBlockCutoff))
 		i--
@@ -172,6 +294,27 @@ func (m *Params) Size() (n int) {
 	if m.AbsoluteHistoricalBlockCutoff != 0 {
 		n += 1 + sovParams(uint64(m.AbsoluteHistoricalBlockCutoff))
 	}
+	if m.SnapshotInterval != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotInterval))
+	}
+	if m.SnapshotRetention != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotRetention))
+	}
+	if m.MaxSnapshotQueries != 0 {
+		n += 1 + sovParams(uint64(m.MaxSnapshotQueries))
+	}
+	if m.MaxSnapshotQueriesPerOwner != 0 {
+		n += 1 + sovParams(uint64(m.MaxSnapshotQueriesPerOwner))
+	}
+	if m.SnapshotQueryGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.SnapshotQueryGasLimit))
+	}
+	if m.HistoricalQueryBaseGas != 0 {
+		n += 1 + sovParams(uint64(m.HistoricalQueryBaseGas))
+	}
+	if m.HistoricalQueryGasPerByte != 0 {
+		n += 1 + sovParams(uint64(m.HistoricalQueryGasPerByte))
+	}
 	return n
 }
 
@@ -248,6 +391,139 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotInterval", wireType)
+			}
+			m.SnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotRetention", wireType)
+			}
+			m.SnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotQueries", wireType)
+			}
+			m.MaxSnapshotQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshotQueries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSnapshotQueriesPerOwner", wireType)
+			}
+			m.MaxSnapshotQueriesPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSnapshotQueriesPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotQueryGasLimit", wireType)
+			}
+			m.SnapshotQueryGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SnapshotQueryGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalQueryBaseGas", wireType)
+			}
+			m.HistoricalQueryBaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalQueryBaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalQueryGasPerByte", wireType)
+			}
+			m.HistoricalQueryGasPerByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalQueryGasPerByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
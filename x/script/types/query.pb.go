@@ -8,7 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -584,6 +584,340 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QuerySnapshotRequest is the request type for the Query/Snapshot RPC method.
+type QuerySnapshotRequest struct {
+	// owner is the account that registered the snapshot query.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name of the snapshot query.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// height returns the latest snapshot taken at or before this height. Zero
+	// returns the latest snapshot.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QuerySnapshotRequest) Reset()         { *m = QuerySnapshotRequest{} }
+func (m *QuerySnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotRequest) ProtoMessage()    {}
+func (*QuerySnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4e496d35dcddd4, []int{12}
+}
+func (m *QuerySnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotRequest.Merge(m, src)
+}
+func (m *QuerySnapshotRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotRequest proto.InternalMessageInfo
+
+func (m *QuerySnapshotRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QuerySnapshotRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QuerySnapshotResponse is the response type for the Query/Snapshot RPC
+// method.
+type QuerySnapshotResponse struct {
+	// snapshot is the matching snapshot.
+	Snapshot Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot"`
+}
+
+func (m *QuerySnapshotResponse) Reset()         { *m = QuerySnapshotResponse{} }
+func (m *QuerySnapshotResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotResponse) ProtoMessage()    {}
+func (*QuerySnapshotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4e496d35dcddd4, []int{13}
+}
+func (m *QuerySnapshotResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySnapshotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotResponse.Merge(m, src)
+}
+func (m *QuerySnapshotResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotResponse proto.InternalMessageInfo
+
+func (m *QuerySnapshotResponse) GetSnapshot() Snapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return Snapshot{}
+}
+
+// QuerySnapshotQueriesRequest is the request type for the
+// Query/SnapshotQueries RPC method.
+type QuerySnapshotQueriesRequest struct {
+	// owner is the account whose snapshot queries are listed.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySnapshotQueriesRequest) Reset()         { *m = QuerySnapshotQueriesRequest{} }
+func (m *QuerySnapshotQueriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotQueriesRequest) ProtoMessage()    {}
+func (*QuerySnapshotQueriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4e496d35dcddd4, []int{14}
+}
+func (m *QuerySnapshotQueriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotQueriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotQueriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySnapshotQueriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotQueriesRequest.Merge(m, src)
+}
+func (m *QuerySnapshotQueriesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotQueriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotQueriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotQueriesRequest proto.InternalMessageInfo
+
+func (m *QuerySnapshotQueriesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySnapshotQueriesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySnapshotQueriesResponse is the response type for the
+// Query/SnapshotQueries RPC method.
+type QuerySnapshotQueriesResponse struct {
+	// snapshot_queries registered by the owner.
+	SnapshotQueries []SnapshotQuery `protobuf:"bytes,1,rep,name=snapshot_queries,json=snapshotQueries,proto3" json:"snapshot_queries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySnapshotQueriesResponse) Reset()         { *m = QuerySnapshotQueriesResponse{} }
+func (m *QuerySnapshotQueriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySnapshotQueriesResponse) ProtoMessage()    {}
+func (*QuerySnapshotQueriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4e496d35dcddd4, []int{15}
+}
+func (m *QuerySnapshotQueriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySnapshotQueriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySnapshotQueriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySnapshotQueriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySnapshotQueriesResponse.Merge(m, src)
+}
+func (m *QuerySnapshotQueriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySnapshotQueriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySnapshotQueriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySnapshotQueriesResponse proto.InternalMessageInfo
+
+func (m *QuerySnapshotQueriesResponse) GetSnapshotQueries() []SnapshotQuery {
+	if m != nil {
+		return m.SnapshotQueries
+	}
+	return nil
+}
+
+func (m *QuerySnapshotQueriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGasSponsorshipRequest is the Query/GasSponsorship request type.
+type QueryGasSponsorshipRequest struct {
+	// script_address is the sponsoring script.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	// user optionally selects the signer whose usage is returned.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *QueryGasSponsorshipRequest) Reset()         { *m = QueryGasSponsorshipRequest{} }
+func (m *QueryGasSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasSponsorshipRequest) ProtoMessage()    {}
+func (*QueryGasSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4e496d35dcddd4, []int{16}
+}
+func (m *QueryGasSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasSponsorshipRequest.Merge(m, src)
+}
+func (m *QueryGasSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasSponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasSponsorshipRequest proto.InternalMessageInfo
+
+func (m *QueryGasSponsorshipRequest) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *QueryGasSponsorshipRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// QueryGasSponsorshipResponse is the Query/GasSponsorship response type.
+type QueryGasSponsorshipResponse struct {
+	// sponsorship is the policy of the script.
+	Sponsorship *GasSponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship,omitempty"`
+	// usage is the rate limit usage of the requested user, if any.
+	Usage *SponsorshipUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (m *QueryGasSponsorshipResponse) Reset()         { *m = QueryGasSponsorshipResponse{} }
+func (m *QueryGasSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasSponsorshipResponse) ProtoMessage()    {}
+func (*QueryGasSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b4e496d35dcddd4, []int{17}
+}
+func (m *QueryGasSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasSponsorshipResponse.Merge(m, src)
+}
+func (m *QueryGasSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasSponsorshipResponse proto.InternalMessageInfo
+
+func (m *QueryGasSponsorshipResponse) GetSponsorship() *GasSponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return nil
+}
+
+func (m *QueryGasSponsorshipResponse) GetUsage() *SponsorshipUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*WebRequest)(nil), "dysonprotocol.script.v1.WebRequest")
 	proto.RegisterType((*WebResponse)(nil), "dysonprotocol.script.v1.WebResponse")
@@ -597,6 +931,12 @@ func init() {
 	proto.RegisterType((*QueryVerifyTxResponse)(nil), "dysonprotocol.script.v1.QueryVerifyTxResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "dysonprotocol.script.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dysonprotocol.script.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySnapshotRequest)(nil), "dysonprotocol.script.v1.QuerySnapshotRequest")
+	proto.RegisterType((*QuerySnapshotResponse)(nil), "dysonprotocol.script.v1.QuerySnapshotResponse")
+	proto.RegisterType((*QuerySnapshotQueriesRequest)(nil), "dysonprotocol.script.v1.QuerySnapshotQueriesRequest")
+	proto.RegisterType((*QuerySnapshotQueriesResponse)(nil), "dysonprotocol.script.v1.QuerySnapshotQueriesResponse")
+	proto.RegisterType((*QueryGasSponsorshipRequest)(nil), "dysonprotocol.script.v1.QueryGasSponsorshipRequest")
+	proto.RegisterType((*QueryGasSponsorshipResponse)(nil), "dysonprotocol.script.v1.QueryGasSponsorshipResponse")
 }
 
 func init() {
@@ -604,55 +944,78 @@ func init() {
 }
 
 var fileDescriptor_0b4e496d35dcddd4 = []byte{
-	// 755 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0x8d, 0xfb, 0xda, 0xa4, 0xef, 0xa6, 0x4f, 0x4f, 0x0c, 0x81, 0xa4, 0x11, 0x4a, 0x8b, 0x1b,
-	0x5a, 0x28, 0xad, 0xa7, 0x29, 0x12, 0x48, 0x95, 0x58, 0x10, 0xc1, 0x82, 0x0a, 0xf1, 0x91, 0x96,
-	0x56, 0x62, 0x63, 0x39, 0xc9, 0x34, 0x18, 0x25, 0x33, 0xae, 0x3d, 0x0d, 0x89, 0x10, 0x0b, 0x58,
-	0xb3, 0x40, 0x42, 0x2c, 0x91, 0x60, 0xc5, 0x1f, 0xe0, 0x47, 0x74, 0x59, 0xc1, 0x86, 0x15, 0x42,
-	0x2d, 0x3f, 0x04, 0x65, 0xe6, 0x9a, 0x24, 0x4d, 0x9d, 0x94, 0x4d, 0x64, 0xdf, 0x7b, 0xce, 0xb9,
-	0xe7, 0x66, 0x8e, 0x6d, 0x98, 0xab, 0xb6, 0x03, 0xc1, 0x3d, 0x5f, 0x48, 0x51, 0x11, 0x75, 0x1a,
-	0x54, 0x7c, 0xd7, 0x93, 0xb4, 0x59, 0xa0, 0xbb, 0x7b, 0xcc, 0x6f, 0x5b, 0xaa, 0x41, 0xd2, 0x7d,
-	0x20, 0x4b, 0x83, 0xac, 0x66, 0x21, 0x9b, 0x8f, 0x62, 0x23, 0x44, 0x75, 0xb2, 0xb3, 0x51, 0x28,
-	0xd9, 0x42, 0x44, 0xa4, 0x8e, 0xe7, 0xf8, 0x4e, 0x23, 0x40, 0x54, 0xae, 0x22, 0x82, 0x86, 0x08,
-	0x68, 0xd9, 0x09, 0x18, 0x6d, 0x16, 0xca, 0x4c, 0x3a, 0x05, 0x5a, 0x11, 0x2e, 0xc7, 0x7e, 0xaa,
-	0x26, 0x6a, 0x42, 0x5d, 0xd2, 0xce, 0x15, 0x56, 0x2f, 0xd4, 0x84, 0xa8, 0xd5, 0x19, 0x75, 0x3c,
-	0x97, 0x3a, 0x9c, 0x0b, 0xe9, 0x48, 0x57, 0xf0, 0x50, 0x73, 0xb1, 0x57, 0x53, 0xed, 0xfc, 0x47,
-	0xd9, 0x73, 0x6a, 0x2e, 0x57, 0x60, 0xc4, 0x4e, 0x6b, 0xac, 0xad, 0x47, 0xe8, 0x1b, 0x6c, 0x9d,
-	0x71, 0x1a, 0x2e, 0x17, 0x54, 0xfd, 0xea, 0x92, 0xb9, 0x05, 0xb0, 0xcd, 0xca, 0x25, 0xb6, 0xbb,
-	0xc7, 0x02, 0x49, 0xe6, 0xe1, 0x7f, 0xa7, 0x5a, 0xf5, 0x59, 0x10, 0xd8, 0xc2, 0xb7, 0xb9, 0xd3,
-	0x60, 0x19, 0x63, 0xd6, 0xb8, 0xfc, 0x6f, 0xe9, 0x3f, 0x2c, 0x3f, 0xf0, 0xef, 0x3b, 0x0d, 0x46,
-	0x66, 0x21, 0xf9, 0x54, 0x4a, 0xcf, 0xd7, 0xb4, 0xcc, 0x98, 0xc2, 0xf4, 0x96, 0xcc, 0x02, 0x24,
-	0x95, 0x6e, 0xe0, 0x09, 0x1e, 0x30, 0x62, 0xc2, 0x94, 0xee, 0xea, 0x7b, 0x54, 0xed, 0xab, 0x99,
-	0xf7, 0xe0, 0xfc, 0xa3, 0xce, 0x6a, 0x1b, 0xea, 0x7f, 0xbd, 0xcb, 0x77, 0x44, 0x68, 0x6b, 0x15,
-	0x12, 0x38, 0x5f, 0x13, 0x8b, 0x99, 0xaf, 0x5f, 0x96, 0x53, 0xb8, 0xda, 0x2d, 0xdd, 0xd9, 0x90,
-	0xbe, 0xcb, 0x6b, 0xa5, 0x10, 0x68, 0x96, 0x20, 0x3d, 0xa0, 0x86, 0x66, 0x6e, 0x40, 0x5c, 0x9f,
-	0x9d, 0x52, 0x4b, 0xae, 0xce, 0x58, 0x11, 0xc9, 0xb1, 0x34, 0xb9, 0x84, 0x70, 0x73, 0x09, 0x1d,
-	0xde, 0xe1, 0x15, 0x51, 0x65, 0xeb, 0x81, 0xe0, 0xa1, 0x43, 0x02, 0xe3, 0xcf, 0x02, 0xc1, 0x71,
-	0x2f, 0x75, 0x6d, 0x52, 0x74, 0xd0, 0x8b, 0x46, 0x07, 0x29, 0x98, 0x28, 0xb7, 0x25, 0xd3, 0xeb,
-	0x4c, 0x95, 0xf4, 0x8d, 0xb9, 0x8e, 0x84, 0xdb, 0xac, 0x43, 0x28, 0x76, 0x6a, 0xa1, 0xfe, 0x34,
-	0x4c, 0xca, 0xb6, 0xc7, 0xec, 0x3d, 0xbf, 0x8e, 0x33, 0x12, 0x9d, 0xfb, 0xc7, 0x7e, 0xbd, 0xab,
-	0x35, 0xd6, 0xab, 0x65, 0x41, 0x66, 0x50, 0x0b, 0xa7, 0x9f, 0x6c, 0x36, 0xa5, 0xf0, 0x5b, 0xcc,
-	0x77, 0x77, 0xda, 0x9b, 0xad, 0x70, 0x70, 0x1a, 0x12, 0xb2, 0x65, 0xf7, 0xc0, 0xe3, 0xb2, 0xd5,
-	0x59, 0xc5, 0x4c, 0xc3, 0xb9, 0x63, 0x04, 0x3c, 0xc6, 0x14, 0x10, 0xd5, 0x78, 0xa8, 0x1e, 0x0a,
-	0xd4, 0x31, 0x37, 0xe1, 0x6c, 0x5f, 0x15, 0xad, 0xdc, 0x84, 0xb8, 0x7e, 0x78, 0x46, 0x1e, 0x85,
-	0x26, 0x16, 0xc7, 0xf7, 0x7f, 0xcc, 0xc4, 0x4a, 0x48, 0x5a, 0xfd, 0x98, 0x80, 0x09, 0x25, 0x4b,
-	0x3e, 0x1b, 0x00, 0xdd, 0xa3, 0x26, 0x34, 0x52, 0xe7, 0xe4, 0x88, 0x65, 0x57, 0x4e, 0x4f, 0xc0,
-	0x3d, 0xaf, 0xbf, 0xfe, 0xf6, 0xeb, 0xdd, 0xd8, 0x0a, 0xb1, 0xe8, 0xf0, 0xd7, 0x8b, 0xed, 0xf2,
-	0x1d, 0x41, 0x5f, 0x60, 0x2e, 0x5f, 0x92, 0x0f, 0x06, 0x40, 0x37, 0x12, 0xa3, 0x9c, 0x0e, 0x44,
-	0x6d, 0x94, 0xd3, 0xc1, 0xb4, 0x99, 0x4b, 0xca, 0xe9, 0x3c, 0xc9, 0x47, 0x3a, 0x65, 0x8a, 0xa4,
-	0x8e, 0x99, 0x7c, 0x32, 0x20, 0xd9, 0x93, 0x1a, 0x32, 0x62, 0xde, 0x60, 0x58, 0xb3, 0x85, 0xbf,
-	0x60, 0xa0, 0xc5, 0x65, 0x65, 0x71, 0x81, 0x5c, 0x8a, 0xb4, 0x58, 0x55, 0x2c, 0x5b, 0xa5, 0x9b,
-	0xbc, 0x37, 0x60, 0x32, 0x0c, 0x1e, 0x59, 0x1e, 0x3e, 0xee, 0x58, 0xa2, 0xb3, 0xd6, 0x69, 0xe1,
-	0x68, 0x6d, 0x51, 0x59, 0xcb, 0x13, 0x33, 0xd2, 0x5a, 0x53, 0x51, 0x6c, 0xd9, 0x22, 0x6f, 0x0c,
-	0x88, 0xeb, 0xa0, 0x92, 0xab, 0xc3, 0xc7, 0xf4, 0x3d, 0x1d, 0xd9, 0xa5, 0xd3, 0x81, 0xd1, 0xd1,
-	0x82, 0x72, 0x74, 0x91, 0xcc, 0xd0, 0xe1, 0x1f, 0x24, 0xf2, 0xca, 0x80, 0x7f, 0xb6, 0x59, 0x99,
-	0xcc, 0x45, 0xca, 0x77, 0xdf, 0xfd, 0xd9, 0xfc, 0x70, 0x10, 0xce, 0xa6, 0x6a, 0xf6, 0x95, 0x35,
-	0x63, 0xd1, 0x8c, 0x8e, 0xd3, 0x73, 0x56, 0xb6, 0xf1, 0x43, 0x50, 0x5c, 0xdb, 0x3f, 0xcc, 0x19,
-	0x07, 0x87, 0x39, 0xe3, 0xe7, 0x61, 0xce, 0x78, 0x7b, 0x94, 0x8b, 0x1d, 0x1c, 0xe5, 0x62, 0xdf,
-	0x8f, 0x72, 0xb1, 0x27, 0xfd, 0x1f, 0x5c, 0xab, 0x22, 0x1a, 0xb4, 0x15, 0x8a, 0x74, 0x5e, 0x6e,
-	0x41, 0x39, 0xae, 0x9a, 0xd7, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x8b, 0xe7, 0x56, 0xa3, 0xff,
-	0x07, 0x00, 0x00,
+	// 1128 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0xcf, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x93, 0x66, 0x93, 0xef, 0xdb, 0xb6, 0xf9, 0x32, 0x84, 0x66, 0x6b, 0xaa, 0x4d, 0xea,
+	0x86, 0xa4, 0x09, 0x89, 0x27, 0x9b, 0x94, 0x1f, 0x2d, 0x42, 0x55, 0xc3, 0x8f, 0xaa, 0x15, 0x82,
+	0xe2, 0xb4, 0x8d, 0x84, 0x90, 0x2c, 0xef, 0x66, 0xe2, 0x18, 0x65, 0x3d, 0x8e, 0xc7, 0x49, 0x37,
+	0x8a, 0x72, 0x80, 0x33, 0x07, 0x24, 0xd4, 0x23, 0x07, 0xc4, 0x01, 0x71, 0xe7, 0xc2, 0x05, 0x21,
+	0x71, 0xe9, 0xb1, 0x82, 0x0b, 0x27, 0x84, 0x12, 0xfe, 0x10, 0xe4, 0x99, 0xe7, 0xae, 0xbd, 0xbb,
+	0x5e, 0x6f, 0x2e, 0x91, 0x67, 0xe6, 0xf3, 0x79, 0xef, 0xf3, 0x9e, 0x67, 0x3f, 0xcf, 0x81, 0x6b,
+	0x5b, 0x87, 0x82, 0xfb, 0x41, 0xc8, 0x23, 0xde, 0xe0, 0xbb, 0x54, 0x34, 0x42, 0x2f, 0x88, 0xe8,
+	0x41, 0x8d, 0xee, 0xed, 0xb3, 0xf0, 0xd0, 0x94, 0x07, 0x64, 0x2a, 0x03, 0x32, 0x15, 0xc8, 0x3c,
+	0xa8, 0xe9, 0xb3, 0x79, 0x6c, 0x84, 0xc8, 0x13, 0x7d, 0x26, 0x0f, 0x15, 0xb5, 0x10, 0x91, 0x1b,
+	0x27, 0x70, 0x42, 0xa7, 0x29, 0x10, 0x35, 0x97, 0x9b, 0xcd, 0x77, 0x02, 0xb1, 0xc3, 0x93, 0x7c,
+	0x0b, 0xb9, 0xb8, 0x80, 0xfb, 0x82, 0x87, 0x62, 0xc7, 0x0b, 0x10, 0x5a, 0x6d, 0x70, 0xd1, 0xe4,
+	0x82, 0xd6, 0x1d, 0xc1, 0xe8, 0x41, 0xad, 0xce, 0x22, 0xa7, 0x46, 0x1b, 0xdc, 0xf3, 0xf1, 0x7c,
+	0xd2, 0xe5, 0x2e, 0x97, 0x8f, 0x34, 0x7e, 0xc2, 0xdd, 0x2b, 0x2e, 0xe7, 0xee, 0x2e, 0xa3, 0x4e,
+	0xe0, 0x51, 0xc7, 0xf7, 0x79, 0xe4, 0x44, 0x1e, 0xf7, 0x13, 0x99, 0x8b, 0xe9, 0x98, 0xb2, 0x8d,
+	0x2f, 0x22, 0x07, 0x8e, 0xeb, 0xf9, 0x12, 0x8c, 0xd8, 0xcb, 0x0a, 0x6b, 0xab, 0x14, 0x6a, 0x81,
+	0x47, 0x2f, 0x39, 0x4d, 0xcf, 0xe7, 0x54, 0xfe, 0x55, 0x5b, 0xc6, 0x63, 0x80, 0x4d, 0x56, 0xb7,
+	0xd8, 0xde, 0x3e, 0x13, 0x11, 0x99, 0x83, 0x09, 0x67, 0x6b, 0x2b, 0x64, 0x42, 0xd8, 0x3c, 0xb4,
+	0x7d, 0xa7, 0xc9, 0x2a, 0xda, 0x8c, 0x76, 0xfd, 0x7f, 0xd6, 0x05, 0xdc, 0xfe, 0x24, 0xfc, 0xd8,
+	0x69, 0x32, 0x32, 0x03, 0xe5, 0x9d, 0x28, 0x0a, 0x42, 0x45, 0xab, 0x0c, 0x4b, 0x4c, 0x7a, 0xcb,
+	0xa8, 0x41, 0x59, 0xc6, 0x95, 0xfd, 0x61, 0xc4, 0x80, 0xf3, 0xea, 0x54, 0xad, 0x31, 0x6a, 0x66,
+	0xcf, 0xf8, 0x08, 0x2e, 0x7d, 0x1a, 0x97, 0xb6, 0x21, 0x9b, 0x7b, 0xcf, 0xdf, 0xe6, 0x89, 0xac,
+	0x55, 0x18, 0xc3, 0xfc, 0x8a, 0xb8, 0x5e, 0xf9, 0xe3, 0xe7, 0xe5, 0x49, 0x2c, 0xed, 0x8e, 0x3a,
+	0xd9, 0x88, 0x42, 0xcf, 0x77, 0xad, 0x04, 0x68, 0x58, 0x30, 0xd5, 0x15, 0x0d, 0xc5, 0xbc, 0x05,
+	0x25, 0xf5, 0x02, 0x65, 0xb4, 0xf2, 0xea, 0xb4, 0x99, 0x73, 0x19, 0x4d, 0x45, 0xb6, 0x10, 0x6e,
+	0x2c, 0xa1, 0xc2, 0x0f, 0xfc, 0x06, 0xdf, 0x62, 0xf7, 0x05, 0xf7, 0x13, 0x85, 0x04, 0xce, 0x7d,
+	0x21, 0xb8, 0x8f, 0x75, 0xc9, 0x67, 0x83, 0xa2, 0x82, 0x34, 0x1a, 0x15, 0x4c, 0xc2, 0x68, 0xfd,
+	0x30, 0x62, 0xaa, 0x9c, 0xf3, 0x96, 0x5a, 0x18, 0xf7, 0x91, 0xf0, 0x3e, 0x8b, 0x09, 0xeb, 0xf1,
+	0x5e, 0x12, 0xff, 0x32, 0x8c, 0x47, 0x87, 0x01, 0xb3, 0xf7, 0xc3, 0x5d, 0xcc, 0x31, 0x16, 0xaf,
+	0x1f, 0x85, 0xbb, 0xed, 0x58, 0xc3, 0xe9, 0x58, 0x26, 0x54, 0xba, 0x63, 0x61, 0xf6, 0xde, 0x62,
+	0x27, 0x25, 0xfe, 0x31, 0x0b, 0xbd, 0xed, 0xc3, 0x87, 0xad, 0x24, 0xf1, 0x14, 0x8c, 0x45, 0x2d,
+	0x3b, 0x05, 0x2f, 0x45, 0xad, 0xb8, 0x14, 0x63, 0x0a, 0x5e, 0xe9, 0x20, 0xe0, 0x6b, 0x9c, 0x04,
+	0x22, 0x0f, 0x1e, 0xc8, 0xdf, 0x19, 0xc6, 0x31, 0x1e, 0xc2, 0xcb, 0x99, 0x5d, 0x94, 0xf2, 0x2e,
+	0x94, 0xd4, 0xef, 0xb1, 0xf0, 0x55, 0x28, 0xe2, 0xfa, 0xb9, 0x67, 0x7f, 0x4f, 0x0f, 0x59, 0x48,
+	0x32, 0x42, 0x54, 0xbd, 0x81, 0xbf, 0xd6, 0x44, 0xb5, 0x09, 0xa3, 0xfc, 0x89, 0xcf, 0xc2, 0xc2,
+	0xeb, 0xa2, 0x60, 0x71, 0x47, 0xe4, 0x65, 0x57, 0x17, 0x59, 0x3e, 0x93, 0x4b, 0x50, 0xda, 0x61,
+	0x9e, 0xbb, 0x13, 0x55, 0x46, 0x66, 0xb4, 0xeb, 0x23, 0x16, 0xae, 0x8c, 0xcf, 0xb1, 0xf0, 0x76,
+	0x4e, 0xac, 0xe5, 0x3d, 0x18, 0x4f, 0x5c, 0x03, 0xab, 0xb9, 0x9a, 0x7f, 0xb1, 0x10, 0x88, 0xf5,
+	0xbc, 0x20, 0x1a, 0x4f, 0x35, 0x78, 0x35, 0x13, 0x3e, 0x5e, 0x78, 0xed, 0x8b, 0x70, 0xd6, 0xca,
+	0x3e, 0x04, 0x68, 0x3b, 0x84, 0xac, 0xaf, 0xbc, 0x3a, 0x67, 0x22, 0x23, 0xb6, 0x13, 0x53, 0xb9,
+	0x32, 0xda, 0x89, 0xf9, 0xc0, 0x71, 0x19, 0xe6, 0xb2, 0x52, 0x4c, 0xe3, 0x37, 0x0d, 0xae, 0xf4,
+	0xd6, 0x85, 0xd5, 0x6f, 0xc2, 0xff, 0x93, 0x22, 0xec, 0x3d, 0x75, 0x56, 0xd1, 0x66, 0x46, 0x64,
+	0xba, 0xa2, 0x2e, 0xc8, 0xc0, 0xd8, 0x8a, 0x09, 0x91, 0x4d, 0x40, 0xee, 0xf6, 0xa8, 0x60, 0xbe,
+	0xb0, 0x02, 0xa5, 0x2a, 0x53, 0xc2, 0x1e, 0xe8, 0x32, 0xd1, 0x5d, 0x47, 0x6c, 0xb4, 0x5d, 0x3b,
+	0x69, 0xec, 0x6d, 0xb8, 0xa8, 0x84, 0xd9, 0x83, 0x5a, 0xcd, 0x05, 0x85, 0xc7, 0xcd, 0xf8, 0x0e,
+	0xed, 0x0b, 0x16, 0x26, 0x77, 0x28, 0x7e, 0x36, 0x7e, 0x4a, 0xde, 0x66, 0x67, 0x4e, 0x6c, 0xda,
+	0x3d, 0x28, 0xa7, 0x06, 0x08, 0xde, 0x9a, 0xf9, 0xdc, 0x7e, 0x75, 0x44, 0x49, 0x73, 0xc9, 0x6d,
+	0x18, 0xdd, 0x17, 0x8e, 0xcb, 0xb0, 0x43, 0x0b, 0xf9, 0x4d, 0x6f, 0x93, 0x1e, 0xc5, 0x04, 0x4b,
+	0xf1, 0x56, 0x7f, 0x2f, 0xc3, 0xa8, 0xd4, 0x4a, 0x7e, 0xd4, 0x00, 0xda, 0xb6, 0x49, 0x68, 0x6e,
+	0xa8, 0xde, 0x76, 0xad, 0xaf, 0x0c, 0x4e, 0x40, 0xcf, 0x78, 0xf3, 0xab, 0x3f, 0xff, 0xfd, 0x76,
+	0x78, 0x85, 0x98, 0xb4, 0xff, 0xf4, 0xb7, 0x3d, 0x7f, 0x9b, 0xd3, 0x23, 0x7c, 0x43, 0xc7, 0xe4,
+	0x3b, 0x0d, 0xa0, 0x6d, 0xaf, 0x45, 0x4a, 0xbb, 0x6c, 0xbb, 0x48, 0x69, 0xb7, 0x73, 0x1b, 0x4b,
+	0x52, 0xe9, 0x1c, 0x99, 0xcd, 0x55, 0xca, 0x24, 0x49, 0x5a, 0x26, 0xf9, 0x5e, 0x83, 0x72, 0xca,
+	0x81, 0x49, 0x41, 0xbe, 0x6e, 0xe3, 0xd7, 0x6b, 0x67, 0x60, 0xa0, 0xc4, 0x65, 0x29, 0x71, 0x9e,
+	0xbc, 0x96, 0x2b, 0x71, 0x4b, 0xb2, 0x6c, 0x39, 0x29, 0xc8, 0x53, 0x0d, 0xc6, 0x13, 0x13, 0x27,
+	0xcb, 0xfd, 0xd3, 0x75, 0x4c, 0x07, 0xdd, 0x1c, 0x14, 0x8e, 0xd2, 0x16, 0xa5, 0xb4, 0x59, 0x62,
+	0xe4, 0x4a, 0x3b, 0x90, 0x14, 0x3b, 0x6a, 0x91, 0xaf, 0x35, 0x28, 0x29, 0xd3, 0x27, 0xaf, 0xf7,
+	0x4f, 0x93, 0x99, 0x34, 0xfa, 0xd2, 0x60, 0x60, 0x54, 0x34, 0x2f, 0x15, 0x5d, 0x25, 0xd3, 0xb4,
+	0xff, 0xf7, 0x22, 0xf9, 0x41, 0x83, 0xf1, 0xc4, 0xaf, 0x8a, 0xda, 0xd4, 0x31, 0x8e, 0x8a, 0xda,
+	0xd4, 0x39, 0x49, 0x8c, 0xb7, 0xa5, 0xa8, 0x55, 0xb2, 0x42, 0x8b, 0x3e, 0x4f, 0xe9, 0x91, 0xb4,
+	0xf9, 0x63, 0x7a, 0x14, 0xcf, 0xac, 0x63, 0xf2, 0x8b, 0x06, 0x13, 0x1d, 0x0e, 0x4d, 0x6e, 0x0c,
+	0x96, 0x3d, 0x3b, 0x68, 0xf4, 0x37, 0xce, 0xc8, 0x42, 0xe9, 0x37, 0xa5, 0xf4, 0x35, 0x52, 0x2b,
+	0x94, 0x9e, 0x4c, 0x89, 0xa4, 0x04, 0xf2, 0xab, 0x06, 0x17, 0xb3, 0x0e, 0x47, 0xd6, 0xfa, 0x8b,
+	0xe8, 0xe9, 0xe4, 0xfa, 0x8d, 0xb3, 0x91, 0x50, 0xf8, 0x1d, 0x29, 0xfc, 0x1d, 0x72, 0x33, 0x57,
+	0xb8, 0xeb, 0x08, 0x3b, 0xe5, 0xb8, 0xf4, 0x28, 0x3b, 0x2f, 0x8e, 0xc9, 0x97, 0x1a, 0x8c, 0x6c,
+	0xb2, 0x3a, 0xb9, 0x96, 0x2b, 0xa0, 0xfd, 0xa9, 0xad, 0xcf, 0xf6, 0x07, 0xa1, 0x2a, 0x2a, 0x55,
+	0x2d, 0xdc, 0xd2, 0x16, 0x8d, 0x7c, 0xc7, 0x79, 0xc2, 0xea, 0x36, 0x7e, 0x77, 0xaf, 0xdf, 0x7a,
+	0x76, 0x52, 0xd5, 0x9e, 0x9f, 0x54, 0xb5, 0x7f, 0x4e, 0xaa, 0xda, 0x37, 0xa7, 0xd5, 0xa1, 0xe7,
+	0xa7, 0xd5, 0xa1, 0xbf, 0x4e, 0xab, 0x43, 0x9f, 0x65, 0xff, 0x65, 0x32, 0x1b, 0xbc, 0x49, 0x5b,
+	0x49, 0x90, 0xf8, 0x5b, 0x52, 0xd4, 0x4b, 0xf2, 0x70, 0xed, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xc8, 0xc9, 0x00, 0x25, 0xc1, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -677,6 +1040,14 @@ type QueryClient interface {
 	VerifyTx(ctx context.Context, in *QueryVerifyTxRequest, opts ...grpc.CallOption) (*QueryVerifyTxResponse, error)
 	// Params queries the parameters of the script module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Snapshot returns the most recent snapshot of a snapshot query taken at or
+	// before the given height.
+	Snapshot(ctx context.Context, in *QuerySnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error)
+	// SnapshotQueries lists the snapshot queries registered by an owner.
+	SnapshotQueries(ctx context.Context, in *QuerySnapshotQueriesRequest, opts ...grpc.CallOption) (*QuerySnapshotQueriesResponse, error)
+	// GasSponsorship queries the gas sponsorship policy of a script and
+	// optionally the rate limit usage of a user.
+	GasSponsorship(ctx context.Context, in *QueryGasSponsorshipRequest, opts ...grpc.CallOption) (*QueryGasSponsorshipResponse, error)
 	// Queries the WSGI web application function of a script.
 	Web(ctx context.Context, in *WebRequest, opts ...grpc.CallOption) (*WebResponse, error)
 }
@@ -734,6 +1105,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) Snapshot(ctx context.Context, in *QuerySnapshotRequest, opts ...grpc.CallOption) (*QuerySnapshotResponse, error) {
+	out := new(QuerySnapshotResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Query/Snapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SnapshotQueries(ctx context.Context, in *QuerySnapshotQueriesRequest, opts ...grpc.CallOption) (*QuerySnapshotQueriesResponse, error) {
+	out := new(QuerySnapshotQueriesResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Query/SnapshotQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasSponsorship(ctx context.Context, in *QueryGasSponsorshipRequest, opts ...grpc.CallOption) (*QueryGasSponsorshipResponse, error) {
+	out := new(QueryGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Query/GasSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Web(ctx context.Context, in *WebRequest, opts ...grpc.CallOption) (*WebResponse, error) {
 	out := new(WebResponse)
 	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Query/Web", in, out, opts...)
@@ -755,6 +1153,14 @@ type QueryServer interface {
 	VerifyTx(context.Context, *QueryVerifyTxRequest) (*QueryVerifyTxResponse, error)
 	// Params queries the parameters of the script module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Snapshot returns the most recent snapshot of a snapshot query taken at or
+	// before the given height.
+	Snapshot(context.Context, *QuerySnapshotRequest) (*QuerySnapshotResponse, error)
+	// SnapshotQueries lists the snapshot queries registered by an owner.
+	SnapshotQueries(context.Context, *QuerySnapshotQueriesRequest) (*QuerySnapshotQueriesResponse, error)
+	// GasSponsorship queries the gas sponsorship policy of a script and
+	// optionally the rate limit usage of a user.
+	GasSponsorship(context.Context, *QueryGasSponsorshipRequest) (*QueryGasSponsorshipResponse, error)
 	// Queries the WSGI web application function of a script.
 	Web(context.Context, *WebRequest) (*WebResponse, error)
 }
@@ -778,6 +1184,15 @@ func (*UnimplementedQueryServer) VerifyTx(ctx context.Context, req *QueryVerifyT
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Snapshot(ctx context.Context, req *QuerySnapshotRequest) (*QuerySnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Snapshot not implemented")
+}
+func (*UnimplementedQueryServer) SnapshotQueries(ctx context.Context, req *QuerySnapshotQueriesRequest) (*QuerySnapshotQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotQueries not implemented")
+}
+func (*UnimplementedQueryServer) GasSponsorship(ctx context.Context, req *QueryGasSponsorshipRequest) (*QueryGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasSponsorship not implemented")
+}
 func (*UnimplementedQueryServer) Web(ctx context.Context, req *WebRequest) (*WebResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Web not implemented")
 }
@@ -876,6 +1291,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Snapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Snapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.script.v1.Query/Snapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Snapshot(ctx, req.(*QuerySnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SnapshotQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySnapshotQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SnapshotQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.script.v1.Query/SnapshotQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SnapshotQueries(ctx, req.(*QuerySnapshotQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.script.v1.Query/GasSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasSponsorship(ctx, req.(*QueryGasSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Web_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WebRequest)
 	if err := dec(in); err != nil {
@@ -919,6 +1388,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "Snapshot",
+			Handler:    _Query_Snapshot_Handler,
+		},
+		{
+			MethodName: "SnapshotQueries",
+			Handler:    _Query_SnapshotQueries_Handler,
+		},
+		{
+			MethodName: "GasSponsorship",
+			Handler:    _Query_GasSponsorship_Handler,
+		},
 		{
 			MethodName: "Web",
 			Handler:    _Query_Web_Handler,
@@ -1296,12 +1777,262 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
+func (m *QuerySnapshotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotQueriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotQueriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotQueriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySnapshotQueriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySnapshotQueriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySnapshotQueriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SnapshotQueries) > 0 {
+		for iNdEx := len(m.SnapshotQueries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SnapshotQueries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sponsorship != nil {
+		{
+			size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
 		offset++
 	}
 	dAtA[offset] = uint8(v)
@@ -1445,29 +2176,660 @@ func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySnapshotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QuerySnapshotResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Snapshot.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySnapshotQueriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySnapshotQueriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SnapshotQueries) > 0 {
+		for _, e := range m.SnapshotQueries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sponsorship != nil {
+		l = m.Sponsorship.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *WebRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressOrName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressOrName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Httprequest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Httprequest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WebResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WebResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WebResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Httpresponse", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Httpresponse = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScriptInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScriptInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScriptInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScriptInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScriptInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScriptInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Script == nil {
+				m.Script = &Script{}
+			}
+			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncodeJsonRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncodeJsonRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncodeJsonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Json = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncodeJsonResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncodeJsonResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncodeJsonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *WebRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDecodeBytesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1490,15 +2852,15 @@ func (m *WebRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecodeBytesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecodeBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddressOrName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1526,13 +2888,13 @@ func (m *WebRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AddressOrName = string(dAtA[iNdEx:postIndex])
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Httprequest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1542,23 +2904,25 @@ func (m *WebRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Httprequest = string(dAtA[iNdEx:postIndex])
+			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bytes == nil {
+				m.Bytes = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1581,7 +2945,7 @@ func (m *WebRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WebResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDecodeBytesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,15 +2968,15 @@ func (m *WebResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WebResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDecodeBytesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WebResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDecodeBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Httpresponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1640,7 +3004,7 @@ func (m *WebResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Httpresponse = string(dAtA[iNdEx:postIndex])
+			m.Json = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1663,7 +3027,7 @@ func (m *WebResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScriptInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1686,15 +3050,15 @@ func (m *QueryScriptInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScriptInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScriptInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxJson", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1722,7 +3086,7 @@ func (m *QueryScriptInfoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TxJson = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1745,7 +3109,7 @@ func (m *QueryScriptInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryScriptInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVerifyTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1768,15 +3132,115 @@ func (m *QueryScriptInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryScriptInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVerifyTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryScriptInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVerifyTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Script", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1803,10 +3267,7 @@ func (m *QueryScriptInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Script == nil {
-				m.Script = &Script{}
-			}
-			if err := m.Script.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1831,7 +3292,7 @@ func (m *QueryScriptInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEncodeJsonRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySnapshotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1854,15 +3315,47 @@ func (m *QueryEncodeJsonRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncodeJsonRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySnapshotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncodeJsonRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySnapshotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1890,8 +3383,27 @@ func (m *QueryEncodeJsonRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Json = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1913,7 +3425,7 @@ func (m *QueryEncodeJsonRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEncodeJsonResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySnapshotResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1936,17 +3448,17 @@ func (m *QueryEncodeJsonResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncodeJsonResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySnapshotResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncodeJsonResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySnapshotResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1956,24 +3468,23 @@ func (m *QueryEncodeJsonResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytes == nil {
-				m.Bytes = []byte{}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -1997,7 +3508,7 @@ func (m *QueryEncodeJsonResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDecodeBytesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySnapshotQueriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2020,15 +3531,15 @@ func (m *QueryDecodeBytesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDecodeBytesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySnapshotQueriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDecodeBytesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySnapshotQueriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2056,13 +3567,13 @@ func (m *QueryDecodeBytesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2072,24 +3583,26 @@ func (m *QueryDecodeBytesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bytes = append(m.Bytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.Bytes == nil {
-				m.Bytes = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2113,7 +3626,7 @@ func (m *QueryDecodeBytesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDecodeBytesResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySnapshotQueriesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2136,17 +3649,17 @@ func (m *QueryDecodeBytesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDecodeBytesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySnapshotQueriesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDecodeBytesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySnapshotQueriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotQueries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2156,23 +3669,61 @@ func (m *QueryDecodeBytesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Json = string(dAtA[iNdEx:postIndex])
+			m.SnapshotQueries = append(m.SnapshotQueries, SnapshotQuery{})
+			if err := m.SnapshotQueries[len(m.SnapshotQueries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2195,7 +3746,7 @@ func (m *QueryDecodeBytesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVerifyTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasSponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2218,15 +3769,15 @@ func (m *QueryVerifyTxRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasSponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxJson", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2254,58 +3805,40 @@ func (m *QueryVerifyTxRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxJson = string(dAtA[iNdEx:postIndex])
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryVerifyTxResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVerifyTxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVerifyTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2327,7 +3860,7 @@ func (m *QueryVerifyTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGasSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2350,65 +3883,51 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGasSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGasSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Sponsorship == nil {
+				m.Sponsorship = &GasSponsorship{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2435,7 +3954,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Usage == nil {
+				m.Usage = &SponsorshipUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxSponsoredFunctions is the maximum number of allowed functions in a gas sponsorship
+const MaxSponsoredFunctions = 64
+
+// Validate performs basic validation of a gas sponsorship policy
+func (s GasSponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.ScriptAddress); err != nil {
+		return fmt.Errorf("invalid script address: %w", err)
+	}
+
+	if !s.SpendLimit.IsValid() {
+		return fmt.Errorf("invalid spend limit: %s", s.SpendLimit)
+	}
+
+	if len(s.AllowedFunctions) > MaxSponsoredFunctions {
+		return fmt.Errorf("at most %d allowed functions, got: %d", MaxSponsoredFunctions, len(s.AllowedFunctions))
+	}
+
+	seen := make(map[string]bool, len(s.AllowedFunctions))
+	for _, fn := range s.AllowedFunctions {
+		if fn == "" {
+			return fmt.Errorf("allowed function name cannot be empty")
+		}
+		if seen[fn] {
+			return fmt.Errorf("duplicate allowed function: %s", fn)
+		}
+		seen[fn] = true
+	}
+
+	if s.WindowBlocks < 0 {
+		return fmt.Errorf("window blocks cannot be negative, got: %d", s.WindowBlocks)
+	}
+
+	if s.MaxTxsPerUser > 0 && s.WindowBlocks == 0 {
+		return fmt.Errorf("window blocks must be set when max txs per user is set")
+	}
+
+	return nil
+}
+
+// AllowsFunction returns true if the sponsorship covers calls to the function
+func (s GasSponsorship) AllowsFunction(functionName string) bool {
+	if len(s.AllowedFunctions) == 0 {
+		return true
+	}
+	for _, fn := range s.AllowedFunctions {
+		if fn == functionName {
+			return true
+		}
+	}
+	return false
+}
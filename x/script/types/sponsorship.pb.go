@@ -0,0 +1,876 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dysonprotocol/script/v1/sponsorship.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GasSponsorship is a script's policy for paying the fees of transactions that
+// call it. When a transaction only contains MsgExec messages to a sponsoring
+// script and satisfies the policy, the fee is deducted from the script account
+// instead of the signer.
+type GasSponsorship struct {
+	// script_address is the sponsoring script, which pays the fees.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	// spend_limit is the remaining fee budget. Each sponsored fee is subtracted
+	// from it and transactions whose fee exceeds it are not sponsored.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// allowed_functions restricts sponsorship to calls of these functions. An
+	// empty list allows every function.
+	AllowedFunctions []string `protobuf:"bytes,3,rep,name=allowed_functions,json=allowedFunctions,proto3" json:"allowed_functions,omitempty"`
+	// max_gas is the maximum gas limit of a sponsored transaction. Zero means
+	// no limit.
+	MaxGas uint64 `protobuf:"varint,4,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+	// max_txs_per_user is the maximum number of transactions sponsored for a
+	// single signer within a window. Zero means no limit.
+	MaxTxsPerUser uint64 `protobuf:"varint,5,opt,name=max_txs_per_user,json=maxTxsPerUser,proto3" json:"max_txs_per_user,omitempty"`
+	// window_blocks is the length in blocks of the per-user rate limit window.
+	WindowBlocks int64 `protobuf:"varint,6,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+}
+
+func (m *GasSponsorship) Reset()         { *m = GasSponsorship{} }
+func (m *GasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*GasSponsorship) ProtoMessage()    {}
+func (*GasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2c5c11504d70a0a, []int{0}
+}
+func (m *GasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasSponsorship.Merge(m, src)
+}
+func (m *GasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasSponsorship proto.InternalMessageInfo
+
+func (m *GasSponsorship) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *GasSponsorship) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *GasSponsorship) GetAllowedFunctions() []string {
+	if m != nil {
+		return m.AllowedFunctions
+	}
+	return nil
+}
+
+func (m *GasSponsorship) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+func (m *GasSponsorship) GetMaxTxsPerUser() uint64 {
+	if m != nil {
+		return m.MaxTxsPerUser
+	}
+	return 0
+}
+
+func (m *GasSponsorship) GetWindowBlocks() int64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+// SponsorshipUsage tracks the transactions a script sponsored for a signer in
+// the current rate limit window.
+type SponsorshipUsage struct {
+	// script_address is the sponsoring script.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	// user is the signer whose fees were sponsored.
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// window_start is the block height the current window started at.
+	WindowStart int64 `protobuf:"varint,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// tx_count is the number of transactions sponsored in the current window.
+	TxCount uint64 `protobuf:"varint,4,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+}
+
+func (m *SponsorshipUsage) Reset()         { *m = SponsorshipUsage{} }
+func (m *SponsorshipUsage) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipUsage) ProtoMessage()    {}
+func (*SponsorshipUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2c5c11504d70a0a, []int{1}
+}
+func (m *SponsorshipUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipUsage.Merge(m, src)
+}
+func (m *SponsorshipUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipUsage proto.InternalMessageInfo
+
+func (m *SponsorshipUsage) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *SponsorshipUsage) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SponsorshipUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+func (m *SponsorshipUsage) GetTxCount() uint64 {
+	if m != nil {
+		return m.TxCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GasSponsorship)(nil), "dysonprotocol.script.v1.GasSponsorship")
+	proto.RegisterType((*SponsorshipUsage)(nil), "dysonprotocol.script.v1.SponsorshipUsage")
+}
+
+func init() {
+	proto.RegisterFile("dysonprotocol/script/v1/sponsorship.proto", fileDescriptor_b2c5c11504d70a0a)
+}
+
+var fileDescriptor_b2c5c11504d70a0a = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xe3, 0x24, 0xa4, 0xf4, 0xd2, 0x54, 0xa9, 0x55, 0xa9, 0x4e, 0x07, 0xd7, 0x94, 0x01,
+	0x53, 0xa8, 0xad, 0xc0, 0xc6, 0x82, 0x48, 0xa5, 0x76, 0x61, 0x40, 0x09, 0x5d, 0x58, 0x4e, 0x67,
+	0xfb, 0x70, 0x4f, 0xb5, 0xef, 0x2c, 0xbf, 0x4b, 0xe2, 0xac, 0x7c, 0x02, 0x66, 0x3e, 0x01, 0x62,
+	0xea, 0xc0, 0xce, 0xda, 0xb1, 0x62, 0x62, 0x02, 0x94, 0x0c, 0x95, 0xf8, 0x14, 0xc8, 0x77, 0x57,
+	0x41, 0x27, 0x06, 0x16, 0xdb, 0xef, 0xff, 0xff, 0x3f, 0xdf, 0xef, 0xde, 0x1d, 0x7a, 0x98, 0x2c,
+	0x40, 0xf0, 0xa2, 0x14, 0x52, 0xc4, 0x22, 0x0b, 0x21, 0x2e, 0x59, 0x21, 0xc3, 0xd9, 0x30, 0x84,
+	0x42, 0x70, 0x10, 0x25, 0x9c, 0xb1, 0x22, 0x50, 0xb6, 0xbd, 0x73, 0x2b, 0x1a, 0xe8, 0x68, 0x30,
+	0x1b, 0xee, 0x6e, 0x91, 0x9c, 0x71, 0x11, 0xaa, 0xa7, 0xce, 0xee, 0xba, 0xb1, 0x80, 0x5c, 0x40,
+	0x18, 0x11, 0xa0, 0xe1, 0x6c, 0x18, 0x51, 0x49, 0x86, 0x61, 0x2c, 0x18, 0x37, 0xfe, 0x40, 0xfb,
+	0x58, 0x55, 0xa1, 0x2e, 0x8c, 0xb5, 0x9d, 0x8a, 0x54, 0x68, 0xbd, 0xfe, 0xd2, 0xea, 0xfe, 0xaf,
+	0x26, 0xda, 0x3c, 0x21, 0x30, 0xf9, 0x43, 0x65, 0x3f, 0x47, 0x9b, 0x9a, 0x01, 0x93, 0x24, 0x29,
+	0x29, 0x80, 0x63, 0x79, 0x96, 0xbf, 0x3e, 0x72, 0xbe, 0x7e, 0x3e, 0xdc, 0x36, 0xbf, 0x7c, 0xa1,
+	0x9d, 0x89, 0x2c, 0x19, 0x4f, 0xc7, 0x3d, 0x9d, 0x37, 0xa2, 0xfd, 0xce, 0x42, 0x5d, 0x28, 0x28,
+	0x4f, 0x70, 0xc6, 0x72, 0x26, 0x9d, 0xa6, 0xd7, 0xf2, 0xbb, 0x4f, 0x06, 0x81, 0xe9, 0xad, 0xd9,
+	0x03, 0xc3, 0x1e, 0x1c, 0x09, 0xc6, 0x47, 0xc7, 0x97, 0xdf, 0xf7, 0x1a, 0x9f, 0x7e, 0xec, 0xf9,
+	0x29, 0x93, 0x67, 0xd3, 0x28, 0x88, 0x45, 0x6e, 0xd8, 0xcd, 0xeb, 0x10, 0x92, 0xf3, 0x50, 0x2e,
+	0x0a, 0x0a, 0xaa, 0x01, 0x3e, 0x5c, 0x5f, 0x1c, 0x6c, 0x64, 0x34, 0x25, 0xf1, 0x02, 0xd7, 0xbb,
+	0x87, 0x8f, 0xd7, 0x17, 0x07, 0xd6, 0x18, 0xa9, 0x55, 0x5f, 0xd6, 0x8b, 0xda, 0x8f, 0xd0, 0x16,
+	0xc9, 0x32, 0x31, 0xa7, 0x09, 0x7e, 0x3b, 0xe5, 0xb1, 0x64, 0x82, 0x83, 0xd3, 0xf2, 0x5a, 0xfe,
+	0xfa, 0xb8, 0x6f, 0x8c, 0xe3, 0x1b, 0xdd, 0xde, 0x41, 0x6b, 0x39, 0xa9, 0x70, 0x4a, 0xc0, 0x69,
+	0x7b, 0x96, 0xdf, 0x1e, 0x77, 0x72, 0x52, 0x9d, 0x10, 0xb0, 0x1f, 0xa0, 0x7e, 0x6d, 0xc8, 0x0a,
+	0x70, 0x41, 0x4b, 0x3c, 0x05, 0x5a, 0x3a, 0x77, 0x54, 0xa2, 0x97, 0x93, 0xea, 0x75, 0x05, 0xaf,
+	0x68, 0x79, 0x0a, 0xb4, 0xb4, 0xef, 0xa3, 0xde, 0x9c, 0xf1, 0x44, 0xcc, 0x71, 0x94, 0x89, 0xf8,
+	0x1c, 0x9c, 0x8e, 0x67, 0xf9, 0xad, 0xf1, 0x86, 0x16, 0x47, 0x4a, 0xdb, 0xff, 0x62, 0xa1, 0xfe,
+	0x5f, 0x93, 0x3e, 0x05, 0x92, 0xd2, 0xff, 0x1f, 0xf7, 0x63, 0xd4, 0x56, 0x5c, 0xcd, 0x7f, 0xb4,
+	0xa9, 0x94, 0x7d, 0x0f, 0x19, 0x26, 0x0c, 0x92, 0x94, 0xd2, 0x69, 0x29, 0xce, 0xae, 0xd6, 0x26,
+	0xb5, 0x64, 0x0f, 0xd0, 0x5d, 0x59, 0xe1, 0x58, 0x4c, 0xb9, 0x34, 0xe3, 0x58, 0x93, 0xd5, 0x51,
+	0x5d, 0x8e, 0x9e, 0x5d, 0x2e, 0x5d, 0xeb, 0x6a, 0xe9, 0x5a, 0x3f, 0x97, 0xae, 0xf5, 0x7e, 0xe5,
+	0x36, 0xae, 0x56, 0x6e, 0xe3, 0xdb, 0xca, 0x6d, 0xbc, 0xf1, 0x6e, 0xdf, 0xe2, 0xfa, 0x08, 0xab,
+	0x9b, 0x6b, 0xaf, 0x4e, 0x2e, 0xea, 0x28, 0xf3, 0xe9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x7e,
+	0x80, 0x57, 0xce, 0x1b, 0x03, 0x00, 0x00,
+}
+
+func (m *GasSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTxsPerUser != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.MaxTxsPerUser))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxGas != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.AllowedFunctions) > 0 {
+		for iNdEx := len(m.AllowedFunctions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedFunctions[iNdEx])
+			copy(dAtA[i:], m.AllowedFunctions[iNdEx])
+			i = encodeVarintSponsorship(dAtA, i, uint64(len(m.AllowedFunctions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSponsorship(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintSponsorship(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorshipUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TxCount != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.TxCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintSponsorship(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintSponsorship(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintSponsorship(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSponsorship(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSponsorship(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	if len(m.AllowedFunctions) > 0 {
+		for _, s := range m.AllowedFunctions {
+			l = len(s)
+			n += 1 + l + sovSponsorship(uint64(l))
+		}
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovSponsorship(uint64(m.MaxGas))
+	}
+	if m.MaxTxsPerUser != 0 {
+		n += 1 + sovSponsorship(uint64(m.MaxTxsPerUser))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovSponsorship(uint64(m.WindowBlocks))
+	}
+	return n
+}
+
+func (m *SponsorshipUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovSponsorship(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovSponsorship(uint64(m.WindowStart))
+	}
+	if m.TxCount != 0 {
+		n += 1 + sovSponsorship(uint64(m.TxCount))
+	}
+	return n
+}
+
+func sovSponsorship(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSponsorship(x uint64) (n int) {
+	return sovSponsorship(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GasSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedFunctions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedFunctions = append(m.AllowedFunctions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerUser", wireType)
+			}
+			m.MaxTxsPerUser = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerUser |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorshipUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorshipUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxCount", wireType)
+			}
+			m.TxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSponsorship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSponsorship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSponsorship(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSponsorship
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSponsorship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSponsorship
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSponsorship
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSponsorship
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSponsorship        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSponsorship          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSponsorship = fmt.Errorf("proto: unexpected end of group")
+)
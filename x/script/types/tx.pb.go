@@ -607,6 +607,377 @@ func (m *MsgArbitraryDataResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgArbitraryDataResponse proto.InternalMessageInfo
 
+// MsgRegisterSnapshotQuery is the Msg/RegisterSnapshotQuery request type.
+type MsgRegisterSnapshotQuery struct {
+	// owner is the account registering the query, usually a script address.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name identifies the query among the owner's snapshot queries.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// json_query is the query request as JSON with an "@type" field.
+	JsonQuery string `protobuf:"bytes,3,opt,name=json_query,json=jsonQuery,proto3" json:"json_query,omitempty"`
+}
+
+func (m *MsgRegisterSnapshotQuery) Reset()         { *m = MsgRegisterSnapshotQuery{} }
+func (m *MsgRegisterSnapshotQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSnapshotQuery) ProtoMessage()    {}
+func (*MsgRegisterSnapshotQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{10}
+}
+func (m *MsgRegisterSnapshotQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSnapshotQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSnapshotQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSnapshotQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSnapshotQuery.Merge(m, src)
+}
+func (m *MsgRegisterSnapshotQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSnapshotQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSnapshotQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSnapshotQuery proto.InternalMessageInfo
+
+func (m *MsgRegisterSnapshotQuery) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRegisterSnapshotQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRegisterSnapshotQuery) GetJsonQuery() string {
+	if m != nil {
+		return m.JsonQuery
+	}
+	return ""
+}
+
+// MsgRegisterSnapshotQueryResponse is the Msg/RegisterSnapshotQuery response
+// type.
+type MsgRegisterSnapshotQueryResponse struct {
+}
+
+func (m *MsgRegisterSnapshotQueryResponse) Reset()         { *m = MsgRegisterSnapshotQueryResponse{} }
+func (m *MsgRegisterSnapshotQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSnapshotQueryResponse) ProtoMessage()    {}
+func (*MsgRegisterSnapshotQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{11}
+}
+func (m *MsgRegisterSnapshotQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSnapshotQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSnapshotQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSnapshotQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSnapshotQueryResponse.Merge(m, src)
+}
+func (m *MsgRegisterSnapshotQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSnapshotQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSnapshotQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSnapshotQueryResponse proto.InternalMessageInfo
+
+// MsgDeleteSnapshotQuery is the Msg/DeleteSnapshotQuery request type.
+type MsgDeleteSnapshotQuery struct {
+	// owner is the account that registered the query.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name of the snapshot query to delete.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgDeleteSnapshotQuery) Reset()         { *m = MsgDeleteSnapshotQuery{} }
+func (m *MsgDeleteSnapshotQuery) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteSnapshotQuery) ProtoMessage()    {}
+func (*MsgDeleteSnapshotQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{12}
+}
+func (m *MsgDeleteSnapshotQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteSnapshotQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteSnapshotQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteSnapshotQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteSnapshotQuery.Merge(m, src)
+}
+func (m *MsgDeleteSnapshotQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteSnapshotQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteSnapshotQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteSnapshotQuery proto.InternalMessageInfo
+
+func (m *MsgDeleteSnapshotQuery) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgDeleteSnapshotQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgDeleteSnapshotQueryResponse is the Msg/DeleteSnapshotQuery response type.
+type MsgDeleteSnapshotQueryResponse struct {
+}
+
+func (m *MsgDeleteSnapshotQueryResponse) Reset()         { *m = MsgDeleteSnapshotQueryResponse{} }
+func (m *MsgDeleteSnapshotQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteSnapshotQueryResponse) ProtoMessage()    {}
+func (*MsgDeleteSnapshotQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{13}
+}
+func (m *MsgDeleteSnapshotQueryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteSnapshotQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteSnapshotQueryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteSnapshotQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteSnapshotQueryResponse.Merge(m, src)
+}
+func (m *MsgDeleteSnapshotQueryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteSnapshotQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteSnapshotQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteSnapshotQueryResponse proto.InternalMessageInfo
+
+// MsgSetGasSponsorship is the Msg/SetGasSponsorship request type.
+type MsgSetGasSponsorship struct {
+	// script_address is the sponsoring script. The script_address field of the
+	// sponsorship must match it.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+	// sponsorship is the new policy.
+	Sponsorship GasSponsorship `protobuf:"bytes,2,opt,name=sponsorship,proto3" json:"sponsorship"`
+}
+
+func (m *MsgSetGasSponsorship) Reset()         { *m = MsgSetGasSponsorship{} }
+func (m *MsgSetGasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasSponsorship) ProtoMessage()    {}
+func (*MsgSetGasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{14}
+}
+func (m *MsgSetGasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasSponsorship.Merge(m, src)
+}
+func (m *MsgSetGasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasSponsorship proto.InternalMessageInfo
+
+func (m *MsgSetGasSponsorship) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+func (m *MsgSetGasSponsorship) GetSponsorship() GasSponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return GasSponsorship{}
+}
+
+// MsgSetGasSponsorshipResponse is the Msg/SetGasSponsorship response type.
+type MsgSetGasSponsorshipResponse struct {
+}
+
+func (m *MsgSetGasSponsorshipResponse) Reset()         { *m = MsgSetGasSponsorshipResponse{} }
+func (m *MsgSetGasSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetGasSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetGasSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{15}
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetGasSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetGasSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetGasSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetGasSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetGasSponsorshipResponse proto.InternalMessageInfo
+
+// MsgDeleteGasSponsorship is the Msg/DeleteGasSponsorship request type.
+type MsgDeleteGasSponsorship struct {
+	// script_address is the sponsoring script.
+	ScriptAddress string `protobuf:"bytes,1,opt,name=script_address,json=scriptAddress,proto3" json:"script_address,omitempty"`
+}
+
+func (m *MsgDeleteGasSponsorship) Reset()         { *m = MsgDeleteGasSponsorship{} }
+func (m *MsgDeleteGasSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteGasSponsorship) ProtoMessage()    {}
+func (*MsgDeleteGasSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{16}
+}
+func (m *MsgDeleteGasSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteGasSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteGasSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteGasSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteGasSponsorship.Merge(m, src)
+}
+func (m *MsgDeleteGasSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteGasSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteGasSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteGasSponsorship proto.InternalMessageInfo
+
+func (m *MsgDeleteGasSponsorship) GetScriptAddress() string {
+	if m != nil {
+		return m.ScriptAddress
+	}
+	return ""
+}
+
+// MsgDeleteGasSponsorshipResponse is the Msg/DeleteGasSponsorship response
+// type.
+type MsgDeleteGasSponsorshipResponse struct {
+}
+
+func (m *MsgDeleteGasSponsorshipResponse) Reset()         { *m = MsgDeleteGasSponsorshipResponse{} }
+func (m *MsgDeleteGasSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteGasSponsorshipResponse) ProtoMessage()    {}
+func (*MsgDeleteGasSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_450aca301391b140, []int{17}
+}
+func (m *MsgDeleteGasSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteGasSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteGasSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteGasSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteGasSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgDeleteGasSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteGasSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteGasSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteGasSponsorshipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateScript)(nil), "dysonprotocol.script.v1.MsgUpdateScript")
 	proto.RegisterType((*MsgUpdateScriptResponse)(nil), "dysonprotocol.script.v1.MsgUpdateScriptResponse")
@@ -618,63 +989,89 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dysonprotocol.script.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgArbitraryData)(nil), "dysonprotocol.script.v1.MsgArbitraryData")
 	proto.RegisterType((*MsgArbitraryDataResponse)(nil), "dysonprotocol.script.v1.MsgArbitraryDataResponse")
+	proto.RegisterType((*MsgRegisterSnapshotQuery)(nil), "dysonprotocol.script.v1.MsgRegisterSnapshotQuery")
+	proto.RegisterType((*MsgRegisterSnapshotQueryResponse)(nil), "dysonprotocol.script.v1.MsgRegisterSnapshotQueryResponse")
+	proto.RegisterType((*MsgDeleteSnapshotQuery)(nil), "dysonprotocol.script.v1.MsgDeleteSnapshotQuery")
+	proto.RegisterType((*MsgDeleteSnapshotQueryResponse)(nil), "dysonprotocol.script.v1.MsgDeleteSnapshotQueryResponse")
+	proto.RegisterType((*MsgSetGasSponsorship)(nil), "dysonprotocol.script.v1.MsgSetGasSponsorship")
+	proto.RegisterType((*MsgSetGasSponsorshipResponse)(nil), "dysonprotocol.script.v1.MsgSetGasSponsorshipResponse")
+	proto.RegisterType((*MsgDeleteGasSponsorship)(nil), "dysonprotocol.script.v1.MsgDeleteGasSponsorship")
+	proto.RegisterType((*MsgDeleteGasSponsorshipResponse)(nil), "dysonprotocol.script.v1.MsgDeleteGasSponsorshipResponse")
 }
 
 func init() { proto.RegisterFile("dysonprotocol/script/v1/tx.proto", fileDescriptor_450aca301391b140) }
 
 var fileDescriptor_450aca301391b140 = []byte{
-	// 808 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcd, 0x4e, 0xeb, 0x46,
-	0x14, 0x8e, 0x49, 0x6e, 0x22, 0xe6, 0xfe, 0x84, 0x3b, 0x42, 0xe0, 0xeb, 0xea, 0xe6, 0x46, 0x6e,
-	0x2b, 0x45, 0x50, 0x6c, 0x08, 0x12, 0x8b, 0x48, 0x55, 0x15, 0xa0, 0xcb, 0xa0, 0xca, 0xa8, 0x52,
-	0xd5, 0x4d, 0x34, 0xd8, 0x83, 0x71, 0x8b, 0x3d, 0xd6, 0xcc, 0x04, 0xe2, 0x5d, 0xd5, 0x45, 0x17,
-	0x5d, 0xf5, 0x1d, 0xda, 0x07, 0x40, 0x6a, 0xa5, 0xbe, 0x02, 0x4b, 0xd4, 0x45, 0xd5, 0x55, 0x55,
-	0xc1, 0x82, 0xd7, 0xa8, 0xe6, 0xc7, 0x01, 0x1b, 0xe5, 0x87, 0x4d, 0x34, 0xf3, 0xcd, 0x77, 0x7c,
-	0xce, 0x7c, 0xe7, 0x3b, 0x13, 0xd0, 0x0e, 0x32, 0x46, 0x92, 0x94, 0x12, 0x4e, 0x7c, 0x72, 0xee,
-	0x32, 0x9f, 0x46, 0x29, 0x77, 0x2f, 0x76, 0x5c, 0x3e, 0x76, 0x24, 0x0a, 0xd7, 0x0b, 0x0c, 0x47,
-	0x31, 0x9c, 0x8b, 0x1d, 0x6b, 0x35, 0x24, 0x21, 0x91, 0xb8, 0x2b, 0x56, 0x8a, 0x6e, 0xbd, 0xf3,
-	0x09, 0x8b, 0x09, 0x1b, 0xaa, 0x03, 0xb5, 0xc9, 0x8f, 0x42, 0x42, 0xc2, 0x73, 0xec, 0xca, 0xdd,
-	0xc9, 0xe8, 0xd4, 0x45, 0x49, 0xa6, 0x8f, 0xd6, 0x15, 0xd1, 0x8d, 0x59, 0x28, 0x92, 0xc7, 0x2c,
-	0xd4, 0x07, 0x6f, 0x51, 0x1c, 0x25, 0xc4, 0x95, 0xbf, 0x1a, 0xfa, 0x64, 0x5a, 0xc9, 0x29, 0xa2,
-	0x28, 0xd6, 0xc9, 0xec, 0x9f, 0x0c, 0xd0, 0x1c, 0xb0, 0xf0, 0xeb, 0x34, 0x40, 0x1c, 0x1f, 0x4b,
-	0x0e, 0xec, 0x82, 0x06, 0x0a, 0x02, 0x8a, 0x19, 0x33, 0x8d, 0xb6, 0xd1, 0x59, 0xde, 0x37, 0xff,
-	0xfa, 0x63, 0x6b, 0x55, 0xd7, 0xd8, 0x57, 0x27, 0xc7, 0x9c, 0x46, 0x49, 0xe8, 0xe5, 0x44, 0x08,
-	0x41, 0xcd, 0x27, 0x01, 0x36, 0x97, 0x44, 0x80, 0x27, 0xd7, 0xbd, 0xcd, 0x1f, 0xef, 0xaf, 0x36,
-	0x72, 0xc6, 0xcf, 0xf7, 0x57, 0x1b, 0x56, 0x90, 0xb1, 0xbc, 0x90, 0x52, 0x52, 0x7b, 0x17, 0xac,
-	0x97, 0x20, 0x0f, 0xb3, 0x94, 0x24, 0x0c, 0x43, 0x13, 0x34, 0x2e, 0x30, 0x65, 0x11, 0x49, 0x64,
-	0x3d, 0x35, 0x2f, 0xdf, 0xda, 0x7f, 0x2f, 0x81, 0xc6, 0x80, 0x85, 0x5f, 0x8e, 0xb1, 0x0f, 0x0f,
-	0xc0, 0x0a, 0x1e, 0x63, 0x7f, 0xc4, 0x09, 0x1d, 0x2e, 0x5a, 0x7e, 0x33, 0x8f, 0xd0, 0x30, 0xfc,
-	0x14, 0xbc, 0x51, 0xf5, 0x4d, 0x3e, 0xa1, 0x2e, 0xf4, 0x5a, 0xa1, 0x39, 0xed, 0x3d, 0x00, 0x78,
-	0xcc, 0x29, 0x1a, 0xca, 0x3b, 0x57, 0x25, 0x65, 0x59, 0x22, 0x07, 0x24, 0xc0, 0xf0, 0x63, 0xf0,
-	0xfa, 0x74, 0x94, 0xf8, 0x3c, 0x22, 0xc9, 0x30, 0x41, 0x31, 0x36, 0x6b, 0x92, 0xf1, 0x2a, 0x07,
-	0x8f, 0x50, 0x8c, 0x85, 0x62, 0x88, 0x86, 0xcc, 0x7c, 0xa1, 0x14, 0x13, 0x6b, 0xb8, 0x06, 0xea,
-	0xdf, 0x5f, 0x4a, 0xb4, 0x2e, 0x51, 0xbd, 0x83, 0x7d, 0xf0, 0x16, 0x71, 0x8e, 0xfc, 0x33, 0x1c,
-	0x0c, 0x63, 0xcc, 0x18, 0x0a, 0x31, 0x33, 0x1b, 0xed, 0x6a, 0xe7, 0x65, 0x77, 0xd5, 0x51, 0x76,
-	0x71, 0x72, 0xbb, 0x38, 0xfd, 0x24, 0xf3, 0x56, 0x72, 0xfa, 0x40, 0xb3, 0x7b, 0x9f, 0x89, 0x66,
-	0x3c, 0x51, 0x48, 0x74, 0x05, 0x16, 0xbb, 0x22, 0xc4, 0xb4, 0x33, 0xe9, 0x0a, 0xb1, 0x9c, 0x74,
-	0x61, 0x0d, 0xd4, 0x29, 0x66, 0xa3, 0x73, 0xae, 0x54, 0xf5, 0xf4, 0x0e, 0x1e, 0x01, 0xb3, 0x5c,
-	0xdb, 0x50, 0x1d, 0x31, 0xb3, 0x36, 0xa3, 0xc4, 0xb5, 0x52, 0x89, 0x9e, 0x8a, 0xb1, 0x7f, 0x35,
-	0x00, 0x1c, 0xb0, 0xf0, 0x80, 0x62, 0xc4, 0xf1, 0x11, 0xbe, 0xd4, 0xa6, 0xec, 0x83, 0xa6, 0x2f,
-	0xa0, 0x67, 0x74, 0xf7, 0x8d, 0x0e, 0xe8, 0xcf, 0xf0, 0xe8, 0x9e, 0x90, 0xa5, 0xfc, 0x65, 0xa1,
-	0xca, 0xfb, 0xa2, 0x2a, 0xa5, 0x72, 0xec, 0x4b, 0x60, 0x3d, 0x45, 0x27, 0x5a, 0x7d, 0xf1, 0xc4,
-	0x46, 0xf3, 0x6a, 0x2d, 0x19, 0xec, 0x91, 0xe5, 0x97, 0x8a, 0x96, 0xff, 0xf3, 0xf1, 0xc0, 0x7e,
-	0x25, 0x47, 0x19, 0xee, 0x81, 0x65, 0x34, 0xe2, 0x67, 0x84, 0x46, 0x3c, 0x9b, 0x9b, 0xe9, 0x81,
-	0x0a, 0x3f, 0x07, 0x75, 0xf5, 0x18, 0xc8, 0x24, 0x2f, 0xbb, 0x1f, 0x9c, 0x29, 0x8f, 0x98, 0xa3,
-	0x12, 0xed, 0xd7, 0xae, 0xff, 0xfd, 0x50, 0xf1, 0x74, 0x50, 0x6f, 0x4b, 0x68, 0xf7, 0xf0, 0xb9,
-	0xa9, 0x13, 0xae, 0x82, 0xed, 0x77, 0x8f, 0x26, 0x5c, 0x41, 0xb9, 0x5e, 0xf6, 0x6f, 0x06, 0x58,
-	0x19, 0xb0, 0xb0, 0x4f, 0x4f, 0x22, 0x4e, 0x11, 0xcd, 0x0e, 0x11, 0x47, 0x70, 0x1b, 0xd4, 0x59,
-	0x14, 0x26, 0x98, 0xce, 0xbd, 0x92, 0xe6, 0x89, 0x06, 0x07, 0x88, 0xa3, 0xbc, 0xc1, 0x62, 0x2d,
-	0x46, 0x15, 0xa5, 0xe9, 0x30, 0x20, 0x31, 0x8a, 0x92, 0x7c, 0x54, 0x51, 0x9a, 0x1e, 0x4a, 0x40,
-	0xbd, 0x51, 0x3a, 0x5e, 0x5c, 0xe0, 0xa3, 0xe2, 0x05, 0x0a, 0x15, 0xd9, 0x16, 0x30, 0xcb, 0x58,
-	0x7e, 0x85, 0xee, 0xef, 0x55, 0x50, 0x1d, 0xb0, 0x10, 0x7e, 0x07, 0x5e, 0x15, 0x1e, 0xd3, 0xce,
-	0x54, 0x4d, 0x4b, 0xcf, 0x9d, 0xb5, 0xbd, 0x28, 0x73, 0x62, 0xb3, 0x6f, 0x00, 0x10, 0x23, 0xaa,
-	0x33, 0xb5, 0x67, 0xc5, 0x0b, 0x9e, 0xd5, 0x99, 0xc7, 0x98, 0x7c, 0x99, 0x81, 0x66, 0x79, 0x00,
-	0x37, 0x67, 0x05, 0x97, 0xc8, 0xd6, 0xee, 0x33, 0xc8, 0x93, 0xa4, 0x13, 0xe9, 0xb4, 0xad, 0x17,
-	0x90, 0x4e, 0x31, 0x17, 0x91, 0xae, 0xe8, 0x38, 0xeb, 0xc5, 0x0f, 0xf7, 0x57, 0x1b, 0xc6, 0x7e,
-	0xef, 0xfa, 0xb6, 0x65, 0xdc, 0xdc, 0xb6, 0x8c, 0xff, 0x6e, 0x5b, 0xc6, 0x2f, 0x77, 0xad, 0xca,
-	0xcd, 0x5d, 0xab, 0xf2, 0xcf, 0x5d, 0xab, 0xf2, 0x6d, 0xf1, 0x1f, 0xdf, 0xf1, 0x49, 0xec, 0x8e,
-	0x73, 0x63, 0xf0, 0x2c, 0xc5, 0xec, 0xa4, 0x2e, 0x0f, 0x77, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff,
-	0x65, 0x79, 0x30, 0x77, 0x1c, 0x08, 0x00, 0x00,
+	// 1092 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0xf3, 0x67, 0xa3, 0xbc, 0xb4, 0x4d, 0x62, 0x42, 0xb2, 0x35, 0x74, 0xb3, 0x75, 0x41,
+	0x84, 0x94, 0xd8, 0xf9, 0x23, 0x5a, 0x58, 0x09, 0xa1, 0x4d, 0x82, 0x38, 0x6d, 0x04, 0x0e, 0x48,
+	0x88, 0xcb, 0x6a, 0x62, 0x4f, 0x1d, 0x97, 0xd8, 0x63, 0x3c, 0xb3, 0x49, 0x7c, 0x02, 0x55, 0x82,
+	0x03, 0x07, 0xc4, 0x89, 0x2f, 0x00, 0x07, 0x8e, 0x41, 0x42, 0xe2, 0xc2, 0x07, 0xe8, 0xb1, 0xe2,
+	0x80, 0x38, 0x21, 0x94, 0x48, 0xe4, 0x6b, 0xa0, 0x99, 0xb1, 0x9d, 0xd8, 0xeb, 0xdd, 0xec, 0xaa,
+	0xbd, 0x44, 0xe3, 0xf7, 0x7e, 0x6f, 0xde, 0xef, 0xfd, 0x66, 0xde, 0x9b, 0x0d, 0xd4, 0x9d, 0x98,
+	0x92, 0x20, 0x8c, 0x08, 0x23, 0x36, 0x39, 0x34, 0xa9, 0x1d, 0x79, 0x21, 0x33, 0x8f, 0xd6, 0x4d,
+	0x76, 0x62, 0x08, 0xab, 0xba, 0x98, 0x43, 0x18, 0x12, 0x61, 0x1c, 0xad, 0x6b, 0xf3, 0x2e, 0x71,
+	0x89, 0xb0, 0x9b, 0x7c, 0x25, 0xe1, 0xda, 0x6d, 0x9b, 0x50, 0x9f, 0xd0, 0xb6, 0x74, 0xc8, 0x8f,
+	0xd4, 0xe5, 0x12, 0xe2, 0x1e, 0x62, 0x53, 0x7c, 0xed, 0x77, 0x1e, 0x99, 0x28, 0x88, 0x13, 0xd7,
+	0xa2, 0x04, 0x9a, 0x3e, 0x75, 0x79, 0x72, 0x9f, 0xba, 0x89, 0x63, 0x0e, 0xf9, 0x5e, 0x40, 0x4c,
+	0xf1, 0x37, 0x31, 0xbd, 0xd6, 0x8b, 0x72, 0x88, 0x22, 0xe4, 0xa7, 0xc9, 0xde, 0xec, 0x85, 0xa2,
+	0x21, 0x09, 0x28, 0x89, 0xe8, 0x81, 0x17, 0x4a, 0xa8, 0xfe, 0xad, 0x02, 0x33, 0x2d, 0xea, 0x7e,
+	0x1a, 0x3a, 0x88, 0xe1, 0x3d, 0x01, 0x54, 0x37, 0x60, 0x12, 0x39, 0x4e, 0x84, 0x29, 0xad, 0x2a,
+	0x75, 0x65, 0x79, 0x6a, 0xab, 0xfa, 0xe7, 0x6f, 0xab, 0xf3, 0x49, 0x39, 0x4d, 0xe9, 0xd9, 0x63,
+	0x91, 0x17, 0xb8, 0x56, 0x0a, 0x54, 0x55, 0x18, 0xb7, 0x89, 0x83, 0xab, 0xa3, 0x3c, 0xc0, 0x12,
+	0xeb, 0xc6, 0xfd, 0x27, 0x17, 0xa7, 0x2b, 0x29, 0xe2, 0xbb, 0x8b, 0xd3, 0x15, 0xcd, 0x89, 0x69,
+	0xca, 0xa6, 0x90, 0x54, 0xdf, 0x84, 0xc5, 0x82, 0xc9, 0xc2, 0x82, 0x2e, 0x56, 0xab, 0x30, 0x79,
+	0x84, 0x23, 0xea, 0x91, 0x40, 0xf0, 0x19, 0xb7, 0xd2, 0x4f, 0xfd, 0xaf, 0x51, 0x98, 0x6c, 0x51,
+	0xf7, 0x83, 0x13, 0x6c, 0xab, 0xdb, 0x30, 0x8b, 0x4f, 0xb0, 0xdd, 0x61, 0x24, 0x6a, 0x0f, 0x4a,
+	0x7f, 0x26, 0x8d, 0x48, 0xcc, 0xea, 0xeb, 0x70, 0x4b, 0xf2, 0xcb, 0xb6, 0x90, 0x05, 0xdd, 0x94,
+	0xd6, 0x14, 0x76, 0x07, 0x00, 0x9f, 0xb0, 0x08, 0xb5, 0x45, 0xcd, 0x63, 0x02, 0x32, 0x25, 0x2c,
+	0xdb, 0xc4, 0xc1, 0xea, 0x3d, 0xb8, 0xf9, 0xa8, 0x13, 0xd8, 0xcc, 0x23, 0x41, 0x3b, 0x40, 0x3e,
+	0xae, 0x8e, 0x0b, 0xc4, 0x8d, 0xd4, 0xb8, 0x8b, 0x7c, 0xcc, 0x15, 0x43, 0x91, 0x4b, 0xab, 0x13,
+	0x52, 0x31, 0xbe, 0x56, 0x17, 0xa0, 0xf2, 0xc5, 0xb1, 0xb0, 0x56, 0x84, 0x35, 0xf9, 0x52, 0x9b,
+	0x30, 0x87, 0x18, 0x43, 0xf6, 0x01, 0x76, 0xda, 0x3e, 0xa6, 0x14, 0xb9, 0x98, 0x56, 0x27, 0xeb,
+	0x63, 0xcb, 0xd3, 0x1b, 0xf3, 0x86, 0xbc, 0x59, 0x46, 0x7a, 0xb3, 0x8c, 0x66, 0x10, 0x5b, 0xb3,
+	0x29, 0xbc, 0x95, 0xa0, 0x1b, 0x6f, 0xf1, 0xc3, 0xe8, 0x52, 0x88, 0x9f, 0x8a, 0x9a, 0x3f, 0x15,
+	0x2e, 0xa6, 0x1e, 0x8b, 0x5b, 0xc1, 0x97, 0xd9, 0x29, 0x2c, 0x40, 0x25, 0xc2, 0xb4, 0x73, 0xc8,
+	0xa4, 0xaa, 0x56, 0xf2, 0xa5, 0xee, 0x42, 0xb5, 0xc8, 0xad, 0x2d, 0x5d, 0xb4, 0x3a, 0xde, 0x87,
+	0xe2, 0x42, 0x81, 0xa2, 0x25, 0x63, 0xf4, 0x9f, 0x14, 0x50, 0x5b, 0xd4, 0xdd, 0x8e, 0x30, 0x62,
+	0x78, 0x17, 0x1f, 0x27, 0x97, 0xb2, 0x09, 0x33, 0x36, 0x37, 0x0d, 0x71, 0xba, 0xb7, 0x92, 0x80,
+	0x66, 0x9f, 0x3b, 0xfa, 0x80, 0xcb, 0x52, 0xdc, 0x99, 0xab, 0x72, 0x27, 0xaf, 0x4a, 0x81, 0x8e,
+	0x7e, 0x0c, 0x5a, 0xb7, 0x35, 0xd3, 0xea, 0xfd, 0xae, 0x6b, 0x74, 0x1d, 0xd7, 0xc2, 0x05, 0xbb,
+	0x72, 0xe5, 0x47, 0xf3, 0x57, 0xfe, 0xf7, 0xab, 0x0d, 0xfb, 0x91, 0xe8, 0x7a, 0xf5, 0x01, 0x4c,
+	0xa1, 0x0e, 0x3b, 0x20, 0x91, 0xc7, 0xe2, 0x6b, 0x33, 0x5d, 0x42, 0xd5, 0xf7, 0xa0, 0x22, 0xe7,
+	0x86, 0x48, 0x32, 0xbd, 0xb1, 0x64, 0xf4, 0x98, 0x77, 0x86, 0x4c, 0xb4, 0x35, 0xfe, 0xf4, 0x9f,
+	0xa5, 0x11, 0x2b, 0x09, 0x6a, 0xac, 0x72, 0xed, 0x2e, 0xb7, 0xeb, 0xd9, 0xe1, 0x32, 0x58, 0xbf,
+	0x7d, 0xa5, 0xc3, 0xa5, 0x29, 0xd5, 0x4b, 0xff, 0x59, 0x81, 0xd9, 0x16, 0x75, 0x9b, 0xd1, 0xbe,
+	0xc7, 0x22, 0x14, 0xc5, 0x3b, 0x88, 0x21, 0x75, 0x0d, 0x2a, 0xd4, 0x73, 0x03, 0x1c, 0x5d, 0x5b,
+	0x52, 0x82, 0xe3, 0x07, 0xec, 0x20, 0x86, 0xd2, 0x03, 0xe6, 0x6b, 0xde, 0xaa, 0x28, 0x0c, 0xdb,
+	0x0e, 0xf1, 0x91, 0x17, 0xa4, 0xad, 0x8a, 0xc2, 0x70, 0x47, 0x18, 0xe4, 0x8c, 0x4a, 0xe2, 0x79,
+	0x01, 0xaf, 0xe4, 0x0b, 0xc8, 0x31, 0xd2, 0x35, 0xa8, 0x16, 0x6d, 0x59, 0x09, 0xbf, 0x2a, 0xc2,
+	0x69, 0x61, 0xd7, 0xa3, 0x0c, 0x47, 0x7b, 0x01, 0x0a, 0xe9, 0x01, 0x61, 0x1f, 0x77, 0x70, 0x14,
+	0xab, 0x06, 0x4c, 0x90, 0xe3, 0x41, 0x2a, 0x91, 0x30, 0x5e, 0x88, 0x98, 0x1b, 0x49, 0x21, 0x7c,
+	0xcd, 0x0b, 0x79, 0x4c, 0x49, 0xd0, 0xfe, 0x92, 0xef, 0x98, 0x16, 0xc2, 0x2d, 0x22, 0x45, 0x63,
+	0x83, 0x17, 0x22, 0xc3, 0x79, 0x1d, 0xf7, 0xf2, 0x75, 0x94, 0xd2, 0xd2, 0x75, 0xa8, 0xf7, 0xf2,
+	0x65, 0x75, 0x7d, 0xaf, 0xc0, 0x42, 0x8b, 0xba, 0x3b, 0xf8, 0x10, 0x33, 0xfc, 0xc2, 0xab, 0x6a,
+	0xac, 0xe5, 0x69, 0xdf, 0xcd, 0xd3, 0x2e, 0xc9, 0xaa, 0xd7, 0xa1, 0x56, 0xee, 0xc9, 0x28, 0xff,
+	0xa7, 0xc0, 0x7c, 0x8b, 0xba, 0x7b, 0x98, 0x7d, 0x88, 0xe8, 0xde, 0xe5, 0x93, 0xf7, 0xfc, 0x6d,
+	0xf9, 0x09, 0x4c, 0x5f, 0x79, 0x42, 0x93, 0xae, 0x79, 0xa3, 0x67, 0xd7, 0xe4, 0xd3, 0x6f, 0x4d,
+	0xf1, 0xee, 0xf9, 0xe5, 0xe2, 0x74, 0x45, 0xb1, 0xae, 0x6e, 0xd3, 0x78, 0xc8, 0x35, 0x28, 0x30,
+	0xe3, 0x62, 0x2c, 0xe5, 0xc5, 0xe8, 0xaa, 0x47, 0xaf, 0xc1, 0xab, 0x65, 0xf6, 0x4c, 0x88, 0x1f,
+	0x15, 0xd1, 0x72, 0x52, 0xab, 0x17, 0xac, 0x45, 0xa3, 0xd1, 0x83, 0xb5, 0x5e, 0x76, 0x84, 0x05,
+	0xe2, 0x77, 0x61, 0xa9, 0x87, 0x2b, 0xe5, 0xbe, 0xf1, 0xc7, 0x24, 0x8c, 0xb5, 0xa8, 0xab, 0x3e,
+	0x86, 0x1b, 0xb9, 0x1f, 0x27, 0xcb, 0x3d, 0xd5, 0x2e, 0xfc, 0x7c, 0xd0, 0xd6, 0x06, 0x45, 0x66,
+	0x63, 0xfb, 0x33, 0x00, 0xfe, 0xe4, 0x25, 0x99, 0xea, 0xfd, 0xe2, 0x39, 0x4e, 0x5b, 0xbe, 0x0e,
+	0x91, 0xed, 0x4c, 0x61, 0xa6, 0xf8, 0xa0, 0xdd, 0xef, 0x17, 0x5c, 0x00, 0x6b, 0x9b, 0x43, 0x80,
+	0xb3, 0xa4, 0x99, 0x74, 0xc9, 0x33, 0x31, 0x80, 0x74, 0x12, 0x39, 0x88, 0x74, 0xf9, 0x09, 0xae,
+	0x7e, 0xa3, 0xc0, 0xcb, 0xe5, 0xb3, 0x6f, 0xbd, 0xdf, 0x5e, 0xa5, 0x21, 0xda, 0xbb, 0x43, 0x87,
+	0x64, 0x3c, 0xbe, 0x82, 0x97, 0xca, 0x46, 0x95, 0xd9, 0x6f, 0xc7, 0x92, 0x00, 0xed, 0xe1, 0x90,
+	0x01, 0x19, 0x81, 0x18, 0xe6, 0xba, 0x07, 0xcf, 0x6a, 0xbf, 0xdd, 0xba, 0xe0, 0xda, 0xdb, 0x43,
+	0xc1, 0xb3, 0xd4, 0x4f, 0x14, 0x98, 0x2f, 0xed, 0xf5, 0xb5, 0xeb, 0x8b, 0x29, 0x30, 0x78, 0x67,
+	0xd8, 0x88, 0x94, 0x84, 0x36, 0xf1, 0x35, 0x1f, 0x70, 0x5b, 0x8d, 0xa7, 0x67, 0x35, 0xe5, 0xd9,
+	0x59, 0x4d, 0xf9, 0xf7, 0xac, 0xa6, 0xfc, 0x70, 0x5e, 0x1b, 0x79, 0x76, 0x5e, 0x1b, 0xf9, 0xfb,
+	0xbc, 0x36, 0xf2, 0x79, 0xfe, 0xbf, 0x2e, 0xc3, 0x26, 0xbe, 0x79, 0x92, 0xce, 0x0b, 0x16, 0x87,
+	0x98, 0xee, 0x57, 0x84, 0x73, 0xf3, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x63, 0x21, 0xeb, 0xc9,
+	0xa0, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -700,6 +1097,16 @@ type MsgClient interface {
 	// UpdateParams defines a governance operation for updating the x/script
 	// module parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterSnapshotQuery registers or replaces a query whose result is
+	// recorded on-chain every snapshot_interval blocks.
+	RegisterSnapshotQuery(ctx context.Context, in *MsgRegisterSnapshotQuery, opts ...grpc.CallOption) (*MsgRegisterSnapshotQueryResponse, error)
+	// DeleteSnapshotQuery removes a snapshot query and all of its snapshots.
+	DeleteSnapshotQuery(ctx context.Context, in *MsgDeleteSnapshotQuery, opts ...grpc.CallOption) (*MsgDeleteSnapshotQueryResponse, error)
+	// SetGasSponsorship creates or replaces the gas sponsorship policy of a
+	// script.
+	SetGasSponsorship(ctx context.Context, in *MsgSetGasSponsorship, opts ...grpc.CallOption) (*MsgSetGasSponsorshipResponse, error)
+	// DeleteGasSponsorship stops a script from sponsoring gas.
+	DeleteGasSponsorship(ctx context.Context, in *MsgDeleteGasSponsorship, opts ...grpc.CallOption) (*MsgDeleteGasSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -746,6 +1153,42 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RegisterSnapshotQuery(ctx context.Context, in *MsgRegisterSnapshotQuery, opts ...grpc.CallOption) (*MsgRegisterSnapshotQueryResponse, error) {
+	out := new(MsgRegisterSnapshotQueryResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Msg/RegisterSnapshotQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteSnapshotQuery(ctx context.Context, in *MsgDeleteSnapshotQuery, opts ...grpc.CallOption) (*MsgDeleteSnapshotQueryResponse, error) {
+	out := new(MsgDeleteSnapshotQueryResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Msg/DeleteSnapshotQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGasSponsorship(ctx context.Context, in *MsgSetGasSponsorship, opts ...grpc.CallOption) (*MsgSetGasSponsorshipResponse, error) {
+	out := new(MsgSetGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Msg/SetGasSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteGasSponsorship(ctx context.Context, in *MsgDeleteGasSponsorship, opts ...grpc.CallOption) (*MsgDeleteGasSponsorshipResponse, error) {
+	out := new(MsgDeleteGasSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.script.v1.Msg/DeleteGasSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Update Script updates the script of the given address and increments the
@@ -759,6 +1202,16 @@ type MsgServer interface {
 	// UpdateParams defines a governance operation for updating the x/script
 	// module parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterSnapshotQuery registers or replaces a query whose result is
+	// recorded on-chain every snapshot_interval blocks.
+	RegisterSnapshotQuery(context.Context, *MsgRegisterSnapshotQuery) (*MsgRegisterSnapshotQueryResponse, error)
+	// DeleteSnapshotQuery removes a snapshot query and all of its snapshots.
+	DeleteSnapshotQuery(context.Context, *MsgDeleteSnapshotQuery) (*MsgDeleteSnapshotQueryResponse, error)
+	// SetGasSponsorship creates or replaces the gas sponsorship policy of a
+	// script.
+	SetGasSponsorship(context.Context, *MsgSetGasSponsorship) (*MsgSetGasSponsorshipResponse, error)
+	// DeleteGasSponsorship stops a script from sponsoring gas.
+	DeleteGasSponsorship(context.Context, *MsgDeleteGasSponsorship) (*MsgDeleteGasSponsorshipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -777,6 +1230,18 @@ func (*UnimplementedMsgServer) CreateNewScript(ctx context.Context, req *MsgCrea
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RegisterSnapshotQuery(ctx context.Context, req *MsgRegisterSnapshotQuery) (*MsgRegisterSnapshotQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSnapshotQuery not implemented")
+}
+func (*UnimplementedMsgServer) DeleteSnapshotQuery(ctx context.Context, req *MsgDeleteSnapshotQuery) (*MsgDeleteSnapshotQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSnapshotQuery not implemented")
+}
+func (*UnimplementedMsgServer) SetGasSponsorship(ctx context.Context, req *MsgSetGasSponsorship) (*MsgSetGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGasSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) DeleteGasSponsorship(ctx context.Context, req *MsgDeleteGasSponsorship) (*MsgDeleteGasSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGasSponsorship not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -854,26 +1319,114 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dysonprotocol.script.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateScript",
-			Handler:    _Msg_UpdateScript_Handler,
-		},
-		{
-			MethodName: "ExecScript",
-			Handler:    _Msg_ExecScript_Handler,
-		},
-		{
-			MethodName: "CreateNewScript",
-			Handler:    _Msg_CreateNewScript_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
+func _Msg_RegisterSnapshotQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSnapshotQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSnapshotQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.script.v1.Msg/RegisterSnapshotQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSnapshotQuery(ctx, req.(*MsgRegisterSnapshotQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteSnapshotQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteSnapshotQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteSnapshotQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.script.v1.Msg/DeleteSnapshotQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteSnapshotQuery(ctx, req.(*MsgDeleteSnapshotQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGasSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.script.v1.Msg/SetGasSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGasSponsorship(ctx, req.(*MsgSetGasSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteGasSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteGasSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteGasSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.script.v1.Msg/DeleteGasSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteGasSponsorship(ctx, req.(*MsgDeleteGasSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dysonprotocol.script.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateScript",
+			Handler:    _Msg_UpdateScript_Handler,
+		},
+		{
+			MethodName: "ExecScript",
+			Handler:    _Msg_ExecScript_Handler,
+		},
+		{
+			MethodName: "CreateNewScript",
+			Handler:    _Msg_CreateNewScript_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterSnapshotQuery",
+			Handler:    _Msg_RegisterSnapshotQuery_Handler,
+		},
+		{
+			MethodName: "DeleteSnapshotQuery",
+			Handler:    _Msg_DeleteSnapshotQuery_Handler,
+		},
+		{
+			MethodName: "SetGasSponsorship",
+			Handler:    _Msg_SetGasSponsorship_Handler,
+		},
+		{
+			MethodName: "DeleteGasSponsorship",
+			Handler:    _Msg_DeleteGasSponsorship_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -1270,140 +1823,383 @@ func (m *MsgArbitraryDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateScript) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterSnapshotQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateScriptResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
-	}
-	return n
+func (m *MsgRegisterSnapshotQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRegisterSnapshotQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExecutorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ScriptAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExtraCode)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FunctionName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Args)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.JsonQuery) > 0 {
+		i -= len(m.JsonQuery)
+		copy(dAtA[i:], m.JsonQuery)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.JsonQuery)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Kwargs)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.AttachedMessages) > 0 {
-		for _, e := range m.AttachedMessages {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterSnapshotQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSnapshotQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSnapshotQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Result)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.AttachedMessageResults) > 0 {
-		for _, e := range m.AttachedMessageResults {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateNewScript) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteSnapshotQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSnapshotQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSnapshotQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CreatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateNewScriptResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteSnapshotQueryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteSnapshotQueryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteSnapshotQueryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ScriptAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovTx(uint64(m.Version))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetGasSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetGasSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetGasSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetGasSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteGasSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteGasSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteGasSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScriptAddress) > 0 {
+		i -= len(m.ScriptAddress)
+		copy(dAtA[i:], m.ScriptAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ScriptAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteGasSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteGasSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteGasSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateScript) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateScriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgExec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExtraCode)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Args)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kwargs)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AttachedMessages) > 0 {
+		for _, e := range m.AttachedMessages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AttachedMessageResults) > 0 {
+		for _, e := range m.AttachedMessageResults {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateNewScript) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CreatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateNewScriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1455,13 +2251,804 @@ func (m *MsgArbitraryDataResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgRegisterSnapshotQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.JsonQuery)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgUpdateScript) Unmarshal(dAtA []byte) error {
+
+func (m *MsgRegisterSnapshotQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteSnapshotQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteSnapshotQueryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetGasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetGasSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteGasSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScriptAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteGasSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateScriptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateScriptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kwargs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kwargs = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachedMessages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttachedMessages = append(m.AttachedMessages, &any.Any{})
+			if err := m.AttachedMessages[len(m.AttachedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttachedMessageResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttachedMessageResults = append(m.AttachedMessageResults, &any.Any{})
+			if err := m.AttachedMessageResults[len(m.AttachedMessageResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNewScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNewScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNewScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNewScriptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1484,15 +3071,15 @@ func (m *MsgUpdateScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateNewScriptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateNewScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1520,13 +3107,13 @@ func (m *MsgUpdateScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var stringLen uint64
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1536,24 +3123,11 @@ func (m *MsgUpdateScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Code = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1575,7 +3149,7 @@ func (m *MsgUpdateScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1598,17 +3172,17 @@ func (m *MsgUpdateScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1618,11 +3192,57 @@ func (m *MsgUpdateScriptResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1644,7 +3264,7 @@ func (m *MsgUpdateScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExec) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1667,15 +3287,65 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExec: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArbitraryData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArbitraryData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArbitraryData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1703,11 +3373,11 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorAddress = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1735,11 +3405,11 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppDomain", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1767,43 +3437,111 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtraCode = string(dAtA[iNdEx:postIndex])
+			m.AppDomain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArbitraryDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArbitraryDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArbitraryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSnapshotQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunctionName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSnapshotQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSnapshotQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1831,11 +3569,11 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kwargs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1863,13 +3601,13 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kwargs = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttachedMessages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonQuery", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1879,25 +3617,23 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttachedMessages = append(m.AttachedMessages, &any.Any{})
-			if err := m.AttachedMessages[len(m.AttachedMessages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.JsonQuery = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1920,7 +3656,7 @@ func (m *MsgExec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterSnapshotQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1943,78 +3679,12 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterSnapshotQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterSnapshotQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Result = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttachedMessageResults", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AttachedMessageResults = append(m.AttachedMessageResults, &any.Any{})
-			if err := m.AttachedMessageResults[len(m.AttachedMessageResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2036,7 +3706,7 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNewScript) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteSnapshotQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2059,15 +3729,15 @@ func (m *MsgCreateNewScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNewScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteSnapshotQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNewScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteSnapshotQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2095,11 +3765,11 @@ func (m *MsgCreateNewScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2127,7 +3797,7 @@ func (m *MsgCreateNewScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2150,7 +3820,7 @@ func (m *MsgCreateNewScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNewScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteSnapshotQueryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2173,63 +3843,12 @@ func (m *MsgCreateNewScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNewScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteSnapshotQueryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNewScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteSnapshotQueryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
-			}
-			m.Version = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Version |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2251,7 +3870,7 @@ func (m *MsgCreateNewScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetGasSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2274,15 +3893,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetGasSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetGasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2310,11 +3929,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2341,7 +3960,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2366,7 +3985,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetGasSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2389,10 +4008,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetGasSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetGasSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2416,7 +4035,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgArbitraryData) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteGasSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2439,79 +4058,15 @@ func (m *MsgArbitraryData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgArbitraryData: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteGasSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgArbitraryData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteGasSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Data = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScriptAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2539,7 +4094,7 @@ func (m *MsgArbitraryData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppDomain = string(dAtA[iNdEx:postIndex])
+			m.ScriptAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2562,7 +4117,7 @@ func (m *MsgArbitraryData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgArbitraryDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteGasSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2585,10 +4140,10 @@ func (m *MsgArbitraryDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgArbitraryDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteGasSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgArbitraryDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteGasSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -24,6 +24,12 @@ func NewQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(NewQueryStorageGetCmd())
 	queryCmd.AddCommand(NewQueryStorageListCmd())
+	queryCmd.AddCommand(NewQueryStorageUsageCmd())
+	queryCmd.AddCommand(NewQueryStorageUploadCmd())
+	queryCmd.AddCommand(NewQueryStorageHistoryCmd())
+	queryCmd.AddCommand(NewQueryStorageSecondaryIndexesCmd())
+	queryCmd.AddCommand(NewQueryStorageFindCmd())
+	queryCmd.AddCommand(NewQueryParamsCmd())
 
 	return queryCmd
 }
@@ -40,7 +46,10 @@ Examples:
   $ dysond query storage get dys1... --index "config/settings"
   
   # Get a storage entry with JSON output
-  $ dysond query storage get dys1... --index "user/profile" --output json`,
+  $ dysond query storage get dys1... --index "user/profile" --output json
+
+  # Get the first KiB of a binary entry
+  $ dysond query storage get dys1... --index "static/app.wasm" --offset 0 --length 1024`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -57,6 +66,14 @@ Examples:
 			if err != nil {
 				return err
 			}
+			offset, err := cmd.Flags().GetUint64("offset")
+			if err != nil {
+				return err
+			}
+			length, err := cmd.Flags().GetUint64("length")
+			if err != nil {
+				return err
+			}
 
 			if index == "" {
 				return fmt.Errorf("--index flag is required")
@@ -68,6 +85,8 @@ Examples:
 				Owner:   owner,
 				Index:   index,
 				Extract: extract,
+				Offset:  offset,
+				Length:  length,
 			}
 
 			res, err := queryClient.StorageGet(context.Background(), req)
@@ -81,6 +100,8 @@ Examples:
 
 	cmd.Flags().String("index", "", "The index/key to query (required)")
 	cmd.Flags().String("extract", "", "Optional GJSON path to extract sub-field from data")
+	cmd.Flags().Uint64("offset", 0, "Optional offset in bytes of the range of the content to return")
+	cmd.Flags().Uint64("length", 0, "Optional length in bytes of the range of the content to return, 0 for the rest")
 	_ = cmd.MarkFlagRequired("index")
 
 	flags.AddQueryFlagsToCmd(cmd)
@@ -157,3 +178,267 @@ Examples:
 
 	return cmd
 }
+
+// NewQueryStorageUsageCmd returns the CLI command handler for querying the storage used by an owner.
+func NewQueryStorageUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage <owner>",
+		Short: "Query the bytes used, the number of entries and the deposit held for an owner",
+		Long: `Query the storage used by an owner address: the total size of its entries (indexes and data),
+the number of entries and the deposit held in the module account for them.
+
+Examples:
+  $ dysond query storage usage dys1...`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := storagetypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StorageUsage(context.Background(), &storagetypes.QueryStorageUsageRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryStorageUploadCmd returns the CLI command handler for querying a chunked upload in progress.
+func NewQueryStorageUploadCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload <owner> --index <index>",
+		Short: "Query a chunked upload in progress and the chunks it is missing",
+		Long: `Query the manifest of a chunked upload in progress and the positions of the chunks not uploaded yet.
+
+Examples:
+  $ dysond query storage upload dys1... --index "static/app.wasm"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index, err := cmd.Flags().GetString("index")
+			if err != nil {
+				return err
+			}
+
+			queryClient := storagetypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StorageUpload(context.Background(), &storagetypes.QueryStorageUploadRequest{
+				Owner: args[0],
+				Index: index,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("index", "", "The index of the storage entry being uploaded (required)")
+	_ = cmd.MarkFlagRequired("index")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryStorageHistoryCmd returns the CLI command handler for querying the versions of a storage entry.
+func NewQueryStorageHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history <owner> --index <index>",
+		Short: "Query the retained previous versions of a storage entry",
+		Long: `Query the previous versions retained for a storage entry set with --keep-versions, oldest first.
+
+Examples:
+  $ dysond query storage history dys1... --index "doc"
+
+  # Newest first
+  $ dysond query storage history dys1... --index "doc" --reverse`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index, err := cmd.Flags().GetString("index")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := storagetypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StorageHistory(context.Background(), &storagetypes.QueryStorageHistoryRequest{
+				Owner:      args[0],
+				Index:      index,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("index", "", "The index of the storage entry (required)")
+	_ = cmd.MarkFlagRequired("index")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "storage versions")
+
+	return cmd
+}
+
+// NewQueryStorageSecondaryIndexesCmd returns the CLI command handler for querying the secondary indexes of an owner.
+func NewQueryStorageSecondaryIndexesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secondary-indexes <owner>",
+		Short: "Query the secondary indexes of an owner",
+		Long: `Query the secondary indexes created by an owner address with their JSON path and index prefix.
+
+Examples:
+  $ dysond query storage secondary-indexes dys1...`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := storagetypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StorageSecondaryIndexes(context.Background(), &storagetypes.QueryStorageSecondaryIndexesRequest{
+				Owner: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryStorageFindCmd returns the CLI command handler for finding storage entries through a secondary index.
+func NewQueryStorageFindCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find <owner> --name <name> [--value <json> | --min <json> --max <json>]",
+		Short: "Find the storage entries of an owner by a value of a secondary index",
+		Long: `Find the storage entries of an owner through one of its secondary indexes, ordered by the
+indexed value. Values are JSON encoded, a value that is not valid JSON is taken as a string.
+--min is inclusive and --max is exclusive, either can be left out.
+
+Examples:
+  # Entries whose indexed value is the string "books"
+  $ dysond query storage find dys1... --name category --value books
+
+  # Entries whose indexed value is a number from 10 up to 20
+  $ dysond query storage find dys1... --name price --min 10 --max 20 --reverse`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			name, err := cmd.Flags().GetString("name")
+			if err != nil {
+				return err
+			}
+			value, err := cmd.Flags().GetString("value")
+			if err != nil {
+				return err
+			}
+			minValue, err := cmd.Flags().GetString("min")
+			if err != nil {
+				return err
+			}
+			maxValue, err := cmd.Flags().GetString("max")
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := storagetypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StorageFind(context.Background(), &storagetypes.QueryStorageFindRequest{
+				Owner:      args[0],
+				Name:       name,
+				Value:      value,
+				Min:        minValue,
+				Max:        maxValue,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String("name", "", "The name of the secondary index (required)")
+	cmd.Flags().String("value", "", "The JSON encoded value to match exactly")
+	cmd.Flags().String("min", "", "The JSON encoded lower bound of the values, inclusive")
+	cmd.Flags().String("max", "", "The JSON encoded upper bound of the values, exclusive")
+	_ = cmd.MarkFlagRequired("name")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "storage entries")
+
+	return cmd
+}
+
+// NewQueryParamsCmd returns the CLI command handler for querying the storage module parameters.
+func NewQueryParamsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the storage module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := storagetypes.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(context.Background(), &storagetypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -3,13 +3,19 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"mime"
+	"net/http"
 	"os"
+	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authztypes "github.com/cosmos/cosmos-sdk/x/authz"
 
 	storagetypes "dysonprotocol.com/x/storage/types"
 )
@@ -26,6 +32,9 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(NewStorageSetCmd())
 	txCmd.AddCommand(NewStorageDeleteCmd())
+	txCmd.AddCommand(NewStorageUploadBeginCmd())
+	txCmd.AddCommand(NewStorageUploadChunkCmd())
+	txCmd.AddCommand(NewGrantStorageWriteAuthorizationCmd())
 
 	return txCmd
 }
@@ -33,7 +42,7 @@ func NewTxCmd() *cobra.Command {
 // NewStorageSetCmd returns the CLI command handler for setting/updating a storage entry.
 func NewStorageSetCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "set --index <index> [--data <data> | --data-path <path to data file>]",
+		Use:   "set --index <index> [--data <data> | --data-path <path to data file>] [--expected-hash <hash> | --if-not-exists] [--keep-versions <n>] [--ttl <duration> | --expires-at <unix>]",
 		Short: "Set or update a storage entry with the specified index and data",
 		Long: `Set or update a storage entry with the specified index and data. The owner is automatically set to the transaction signer.
 
@@ -48,7 +57,16 @@ Examples:
   $ dysond tx storage set --index "user/profile" --data '{"name": "Alice", "age": 30}' --from myaccount
   
   # Set with empty data
-  $ dysond tx storage set --index "placeholder" --data "" --from myaccount`,
+  $ dysond tx storage set --index "placeholder" --data "" --from myaccount
+
+  # Set a binary file with its content type
+  $ dysond tx storage set --index "static/logo.png" --data-path ./logo.png --blob --content-type image/png --from myaccount
+
+  # Update only if the entry was not changed since it was read, keeping its last 5 versions
+  $ dysond tx storage set --index "doc" --data "v2" --expected-hash "sha256-..." --keep-versions 5 --from myaccount
+
+  # Set an entry deleted after an hour, refunding its deposit
+  $ dysond tx storage set --index "sessions/abc" --data '{"user": "alice"}' --ttl 1h --from myaccount`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -99,13 +117,63 @@ Examples:
 				dataContent = string(dataBytes)
 			}
 
+			contentType, err := cmd.Flags().GetString("content-type")
+			if err != nil {
+				return err
+			}
+			blob, err := cmd.Flags().GetBool("blob")
+			if err != nil {
+				return err
+			}
+			expectedHash, err := cmd.Flags().GetString("expected-hash")
+			if err != nil {
+				return err
+			}
+			ifNotExists, err := cmd.Flags().GetBool("if-not-exists")
+			if err != nil {
+				return err
+			}
+			if expectedHash != "" && ifNotExists {
+				return errors.New("cannot provide both --expected-hash and --if-not-exists, use only one")
+			}
+			keepVersions, err := cmd.Flags().GetUint32("keep-versions")
+			if err != nil {
+				return err
+			}
+			expiresAt, err := cmd.Flags().GetInt64("expires-at")
+			if err != nil {
+				return err
+			}
+			ttl, err := cmd.Flags().GetDuration("ttl")
+			if err != nil {
+				return err
+			}
+			if ttl != 0 {
+				if expiresAt != 0 {
+					return errors.New("cannot provide both --ttl and --expires-at, use only one")
+				}
+				if ttl < 0 {
+					return errors.New("--ttl must be positive")
+				}
+				// The expiry is computed from the local time, which is close to the block time
+				expiresAt = time.Now().Add(ttl).Unix()
+			}
+
 			// Use the sender address as the owner
 			owner := clientCtx.GetFromAddress().String()
 
 			msg := &storagetypes.MsgStorageSet{
-				Owner: owner,
-				Index: index,
-				Data:  dataContent,
+				Owner:        owner,
+				Index:        index,
+				Data:         dataContent,
+				ContentType:  contentType,
+				ExpectedHash: expectedHash,
+				IfNotExists:  ifNotExists,
+				KeepVersions: keepVersions,
+				ExpiresAt:    expiresAt,
+			}
+			if blob {
+				msg.Data, msg.Blob = "", []byte(dataContent)
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -115,6 +183,13 @@ Examples:
 	cmd.Flags().String("index", "", "The unique identifier/key for the storage entry (required)")
 	cmd.Flags().String("data", "", "The data to store as a string")
 	cmd.Flags().String("data-path", "", "Path to the data file")
+	cmd.Flags().String("content-type", "", "The MIME type of the data, for example image/png")
+	cmd.Flags().Bool("blob", false, "Store the data as a binary blob instead of a string")
+	cmd.Flags().String("expected-hash", "", "Only write if the current hash of the entry is this one")
+	cmd.Flags().Bool("if-not-exists", false, "Only write if the entry does not exist yet")
+	cmd.Flags().Uint32("keep-versions", 0, "The number of previous versions of the entry to retain, 0 for none")
+	cmd.Flags().Int64("expires-at", 0, "The unix timestamp after which the entry is deleted, 0 for no expiry")
+	cmd.Flags().Duration("ttl", 0, "The time after which the entry is deleted, for example 30m or 24h")
 
 	// Mark index as required
 	_ = cmd.MarkFlagRequired("index")
@@ -175,3 +250,261 @@ Examples:
 
 	return cmd
 }
+
+// NewStorageUploadBeginCmd returns the CLI command handler for beginning a chunked upload of a file.
+func NewStorageUploadBeginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-begin --index <index> --file <path> [--content-type <type>] [--chunk-size <bytes>]",
+		Short: "Begin a chunked upload of a file to a storage entry",
+		Long: `Begin a chunked upload of a file to a storage entry. The file is split in chunks of --chunk-size
+bytes and the manifest of their hashes is registered on chain with the deposit of the entry.
+Upload every chunk with upload-chunk using the same file and chunk size, then commit the upload
+with upload-commit to write the whole file to the entry at once.
+
+The content type defaults to the one of the file extension.
+
+Examples:
+  $ dysond tx storage upload-begin --index "static/app.wasm" --file ./app.wasm --from myaccount
+  $ dysond tx storage upload-chunk --index "static/app.wasm" --file ./app.wasm --chunk 0 --from myaccount
+  $ dysond tx storage upload-commit --index "static/app.wasm" --from myaccount`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index, content, chunks, err := readUploadFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			contentType, err := cmd.Flags().GetString("content-type")
+			if err != nil {
+				return err
+			}
+			if contentType == "" {
+				filePath, _ := cmd.Flags().GetString("file")
+				contentType = mime.TypeByExtension(filepath.Ext(filePath))
+			}
+			if contentType == "" {
+				contentType = http.DetectContentType(content)
+			}
+
+			chunkHashes := make([]string, len(chunks))
+			for i, chunk := range chunks {
+				chunkHashes[i] = storagetypes.ContentHash(chunk)
+			}
+
+			msg := &storagetypes.MsgStorageUploadBegin{
+				Owner:       clientCtx.GetFromAddress().String(),
+				Index:       index,
+				ContentType: contentType,
+				Size_:       uint64(len(content)),
+				ChunkHashes: chunkHashes,
+				Hash:        storagetypes.ContentHash(content),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUploadFlags(cmd)
+	cmd.Flags().String("content-type", "", "The MIME type of the file, detected from its extension by default")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewStorageUploadChunkCmd returns the CLI command handler for uploading a chunk of a file.
+func NewStorageUploadChunkCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-chunk --index <index> --file <path> --chunk <n> [--chunk-size <bytes>]",
+		Short: "Upload one chunk of a file to a chunked upload in progress",
+		Long: `Upload the 0-based chunk n of a file to a chunked upload begun with upload-begin. The file and the
+chunk size must be the ones the upload was begun with.
+
+Examples:
+  $ dysond tx storage upload-chunk --index "static/app.wasm" --file ./app.wasm --chunk 0 --from myaccount`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			index, _, chunks, err := readUploadFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			chunk, err := cmd.Flags().GetUint32("chunk")
+			if err != nil {
+				return err
+			}
+			if int(chunk) >= len(chunks) {
+				return fmt.Errorf("chunk %d is out of the %d chunks of the file", chunk, len(chunks))
+			}
+
+			msg := &storagetypes.MsgStorageUploadChunk{
+				Owner:      clientCtx.GetFromAddress().String(),
+				Index:      index,
+				ChunkIndex: chunk,
+				Data:       chunks[chunk],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addUploadFlags(cmd)
+	cmd.Flags().Uint32("chunk", 0, "The 0-based position of the chunk to upload")
+	_ = cmd.MarkFlagRequired("chunk")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// DefaultUploadChunkSize is the default size in bytes of the chunks of an upload
+const DefaultUploadChunkSize = 128 * 1024
+
+// addUploadFlags adds the flags shared by the upload commands
+func addUploadFlags(cmd *cobra.Command) {
+	cmd.Flags().String("index", "", "The index of the storage entry to upload (required)")
+	cmd.Flags().String("file", "", "Path to the file to upload (required)")
+	cmd.Flags().Int("chunk-size", DefaultUploadChunkSize, "The size in bytes of the chunks the file is split in")
+	_ = cmd.MarkFlagRequired("index")
+	_ = cmd.MarkFlagRequired("file")
+}
+
+// readUploadFlags returns the index, the file content and its chunks of an upload command
+func readUploadFlags(cmd *cobra.Command) (string, []byte, [][]byte, error) {
+	index, err := cmd.Flags().GetString("index")
+	if err != nil {
+		return "", nil, nil, err
+	}
+	filePath, err := cmd.Flags().GetString("file")
+	if err != nil {
+		return "", nil, nil, err
+	}
+	chunkSize, err := cmd.Flags().GetInt("chunk-size")
+	if err != nil {
+		return "", nil, nil, err
+	}
+	if chunkSize <= 0 {
+		return "", nil, nil, errors.New("--chunk-size must be positive")
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to read file %s: %w", filePath, err)
+	}
+	if len(content) == 0 {
+		return "", nil, nil, fmt.Errorf("file %s is empty", filePath)
+	}
+
+	var chunks [][]byte
+	for start := 0; start < len(content); start += chunkSize {
+		chunks = append(chunks, content[start:min(start+chunkSize, len(content))])
+	}
+	return index, content, chunks, nil
+}
+
+// NewGrantStorageWriteAuthorizationCmd returns a command to grant StorageWriteAuthorization to a grantee.
+func NewGrantStorageWriteAuthorizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-write <grantee-address> [--index-prefix <prefix>] [--max-bytes <bytes>] [--json-schema <schema> | --json-schema-path <path>]",
+		Short: "Grant a StorageWriteAuthorization to a grantee (via authz)",
+		Long: `Creates an Authz grant of type StorageWriteAuthorization, allowing the grantee to set the
+storage entries of the granter whose index starts with --index-prefix. The grantee sets entries
+with "dysond tx authz exec" and a MsgStorageSet whose owner is the granter.
+
+--max-bytes bounds the total size of the entries the grantee can write, as they lock the deposit
+of the granter. --json-schema restricts the data of the entries to JSON matching the schema.
+
+Examples:
+  # Let a teammate maintain the pages of a DWApp
+  $ dysond tx storage grant-write <grantee-addr> --index-prefix "pages/" --max-bytes 1000000 --from <granter>
+
+  # Only accept posts with a title
+  $ dysond tx storage grant-write <grantee-addr> --index-prefix "posts/" \
+    --json-schema '{"type": "object", "required": ["title"], "properties": {"title": {"type": "string"}}}' \
+    --expiration "2025-06-30T12:00:00Z" --from <granter>`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address: %w", err)
+			}
+
+			indexPrefix, err := cmd.Flags().GetString("index-prefix")
+			if err != nil {
+				return err
+			}
+			maxBytes, err := cmd.Flags().GetUint64("max-bytes")
+			if err != nil {
+				return err
+			}
+
+			jsonSchema, err := cmd.Flags().GetString("json-schema")
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed("json-schema-path") {
+				if jsonSchema != "" {
+					return errors.New("cannot provide both --json-schema and --json-schema-path, use only one")
+				}
+				schemaPath, err := cmd.Flags().GetString("json-schema-path")
+				if err != nil {
+					return err
+				}
+				schemaBytes, err := os.ReadFile(schemaPath)
+				if err != nil {
+					return fmt.Errorf("failed to read file %s: %w", schemaPath, err)
+				}
+				jsonSchema = string(schemaBytes)
+			}
+
+			var expiration *time.Time
+			expStr, err := cmd.Flags().GetString("expiration")
+			if err != nil {
+				return err
+			}
+			if expStr != "" {
+				expTime, err := time.Parse(time.RFC3339, expStr)
+				if err != nil {
+					return fmt.Errorf("parsing expiration as RFC3339 failed: %w", err)
+				}
+				expTime = expTime.UTC()
+				expiration = &expTime
+			}
+
+			authorization := storagetypes.NewStorageWriteAuthorization(indexPrefix, maxBytes, jsonSchema)
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			msg, err := authztypes.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return fmt.Errorf("failed to create MsgGrant: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String("index-prefix", "", "Prefix of the indexes the grantee can set, every index if empty")
+	cmd.Flags().Uint64("max-bytes", 0, "Total bytes of entries the grantee can write before the grant is deleted, 0 for no limit")
+	cmd.Flags().String("json-schema", "", "JSON schema the data of the set entries must match (optional)")
+	cmd.Flags().String("json-schema-path", "", "Path to a file with the JSON schema (optional)")
+	cmd.Flags().String("expiration", "", "Expiration time as RFC3339 timestamp (e.g. 2025-06-30T12:00:00Z), none if empty")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -5,6 +5,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterLegacyAminoCodec registers all the necessary group module concrete
@@ -15,9 +16,22 @@ func RegisterLegacyAminoCodec(registrar interface{}) {
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
+	// Register StorageWriteAuthorization as Authorization implementation
+	registrar.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&types.StorageWriteAuthorization{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&types.MsgStorageSet{},
 		&types.MsgStorageDelete{},
+		&types.MsgStorageUploadBegin{},
+		&types.MsgStorageUploadChunk{},
+		&types.MsgStorageUploadCommit{},
+		&types.MsgStorageUploadCancel{},
+		&types.MsgStorageCreateSecondaryIndex{},
+		&types.MsgStorageDeleteSecondaryIndex{},
+		&types.MsgUpdateParams{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &types.Msg_serviceDesc)
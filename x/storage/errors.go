@@ -10,4 +10,13 @@ var (
 
 	// ErrEmptyIndex is returned when the index is empty
 	ErrEmptyIndex = errors.Register("storage", 2, "index cannot be empty")
+
+	// ErrEntryTooLarge is returned when an entry exceeds the max entry size
+	ErrEntryTooLarge = errors.Register("storage", 3, "entry too large")
+
+	// ErrQuotaExceeded is returned when an owner exceeds its storage quota
+	ErrQuotaExceeded = errors.Register("storage", 4, "storage quota exceeded")
+
+	// ErrPreconditionFailed is returned when a conditional write does not match the current entry
+	ErrPreconditionFailed = errors.Register("storage", 5, "storage precondition failed")
 )
@@ -1,6 +1,8 @@
 package storage
 
 import (
+	"fmt"
+
 	"dysonprotocol.com/x/storage/types"
 	gogoprotoany "github.com/cosmos/gogoproto/types/any"
 )
@@ -8,7 +10,12 @@ import (
 // NewGenesisState creates a new genesis state with default values.
 func NewGenesisState() *types.GenesisState {
 	return &types.GenesisState{
-		Entries: []types.Storage{},
+		Entries:          []types.Storage{},
+		Params:           types.DefaultParams(),
+		Uploads:          []types.StorageUpload{},
+		UploadChunks:     []types.UploadChunk{},
+		Versions:         []types.Storage{},
+		SecondaryIndexes: []types.SecondaryIndex{},
 	}
 }
 
@@ -19,10 +26,38 @@ func DefaultGenesis() *types.GenesisState {
 
 // ValidateGenesisState performs basic genesis state validation returning an error upon any failure.
 func ValidateGenesisState(s types.GenesisState) error {
+	if err := s.Params.Validate(); err != nil {
+		return err
+	}
 	for _, entry := range s.Entries {
 		if entry.Index == "" {
 			return ErrEmptyIndex
 		}
+		if !entry.Deposit.IsValid() {
+			return fmt.Errorf("invalid deposit of entry %s/%s: %s", entry.Owner, entry.Index, entry.Deposit)
+		}
+		if entry.ExpiresAt < 0 {
+			return fmt.Errorf("invalid expiry of entry %s/%s: %d", entry.Owner, entry.Index, entry.ExpiresAt)
+		}
+	}
+	for _, version := range s.Versions {
+		if version.Index == "" {
+			return ErrEmptyIndex
+		}
+	}
+	indexes := make(map[string]int)
+	for _, idx := range s.SecondaryIndexes {
+		if err := idx.Validate(); err != nil {
+			return fmt.Errorf("invalid secondary index %s/%s: %w", idx.Owner, idx.Name, err)
+		}
+		if indexes[idx.Owner]++; indexes[idx.Owner] > types.MaxSecondaryIndexesPerOwner {
+			return fmt.Errorf("owner %s has more than %d secondary indexes", idx.Owner, types.MaxSecondaryIndexesPerOwner)
+		}
+	}
+	for _, upload := range s.Uploads {
+		if err := upload.ValidateManifest(); err != nil {
+			return fmt.Errorf("invalid upload %s/%s: %w", upload.Owner, upload.Index, err)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storage "dysonprotocol.com/x/storage"
+	storagetypes "dysonprotocol.com/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// chargeEntry accounts for an entry replacing old, nil for a new entry. It checks the entry
+// size and the owner quotas, locks or refunds the difference between the deposit held for old
+// and the deposit required for the stored size of the entry, updates the owner usage and
+// returns the deposit now held for the entry.
+func (k Keeper) chargeEntry(ctx context.Context, old *storagetypes.Storage, entry storagetypes.Storage) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if entry.EntrySize() > params.MaxEntrySize {
+		return nil, errorsmod.Wrapf(storage.ErrEntryTooLarge, "entry size %d exceeds the max entry size %d", entry.EntrySize(), params.MaxEntrySize)
+	}
+
+	// Retained versions are charged like the entry itself
+	owner, size := entry.Owner, entry.StoredSize()
+
+	usage, err := k.GetOwnerUsage(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldSize uint64
+	var held sdk.Coins
+	if old != nil {
+		oldSize = old.StoredSize()
+		held = old.Deposit
+	} else {
+		usage.Entries++
+		if params.MaxEntriesPerOwner != 0 && usage.Entries > params.MaxEntriesPerOwner {
+			return nil, errorsmod.Wrapf(storage.ErrQuotaExceeded, "owner %s would have %d entries, max %d", owner, usage.Entries, params.MaxEntriesPerOwner)
+		}
+	}
+
+	// An owner over a lowered quota can still shrink its entries
+	bytes := usage.Bytes - min(usage.Bytes, oldSize) + size
+	if params.MaxBytesPerOwner != 0 && bytes > params.MaxBytesPerOwner && size > oldSize {
+		return nil, errorsmod.Wrapf(storage.ErrQuotaExceeded, "owner %s would use %d bytes, max %d", owner, bytes, params.MaxBytesPerOwner)
+	}
+	usage.Bytes = bytes
+
+	required := params.RequiredDeposit(size)
+	if err := k.settleDeposit(ctx, owner, held, required); err != nil {
+		return nil, err
+	}
+	deposit, negative := usage.Deposit.SafeSub(held...)
+	if negative {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrLogic, "deposit %s of owner %s does not cover the entry deposit %s", usage.Deposit, owner, held)
+	}
+	usage.Deposit = deposit.Add(required...)
+
+	if err := k.setOwnerUsage(ctx, usage); err != nil {
+		return nil, err
+	}
+	return required, nil
+}
+
+// releaseEntry refunds the deposit held for a removed entry to its owner and removes the entry
+// and its retained versions from the owner usage
+func (k Keeper) releaseEntry(ctx context.Context, entry storagetypes.Storage) error {
+	usage, err := k.GetOwnerUsage(ctx, entry.Owner)
+	if err != nil {
+		return err
+	}
+
+	if err := k.settleDeposit(ctx, entry.Owner, entry.Deposit, nil); err != nil {
+		return err
+	}
+
+	usage.Bytes -= min(usage.Bytes, entry.StoredSize())
+	usage.Entries -= min(usage.Entries, 1)
+	usage.Deposit, _ = usage.Deposit.SafeSub(entry.Deposit...)
+	return k.setOwnerUsage(ctx, usage)
+}
+
+// settleDeposit moves funds between an owner and the module account so that the deposit held
+// for an entry goes from held to required
+func (k Keeper) settleDeposit(ctx context.Context, owner string, held, required sdk.Coins) error {
+	if held.Equal(required) {
+		return nil
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return err
+	}
+
+	// The deposit denom may have changed since the deposit was locked, in which case the
+	// whole deposit is refunded and the new one locked
+	refund, charge := held, required
+	switch {
+	case held.IsAllLTE(required):
+		refund, charge = nil, required.Sub(held...)
+	case required.IsAllLTE(held):
+		refund, charge = held.Sub(required...), nil
+	}
+
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, storage.ModuleName, ownerAddr, refund); err != nil {
+			return errorsmod.Wrap(err, "failed to refund storage deposit")
+		}
+	}
+	if !charge.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, ownerAddr, storage.ModuleName, charge); err != nil {
+			return errorsmod.Wrap(err, "failed to lock storage deposit")
+		}
+	}
+	return nil
+}
+
+// rebuildOwnerUsage recomputes the usage of every owner from the stored entries
+func (k Keeper) rebuildOwnerUsage(ctx context.Context) error {
+	if err := k.OwnerUsage.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	usages := make(map[string]*storagetypes.OwnerUsage)
+	var owners []string
+	err := k.StorageMap.Walk(ctx, nil, func(_ collections.Pair[string, string], entry storagetypes.Storage) (bool, error) {
+		usage, ok := usages[entry.Owner]
+		if !ok {
+			usage = &storagetypes.OwnerUsage{Owner: entry.Owner}
+			usages[entry.Owner] = usage
+			owners = append(owners, entry.Owner)
+		}
+		usage.Bytes += entry.StoredSize()
+		usage.Entries++
+		usage.Deposit = usage.Deposit.Add(entry.Deposit...)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, owner := range owners {
+		if err := k.setOwnerUsage(ctx, *usages[owner]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	storagetypes "dysonprotocol.com/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// writeEntry sets the content of an entry, locking the deposit of its new size and refunding
+// the one held for its previous content, and emits EventStorageUpdated. The previous content is
+// retained as a version when the entry keeps versions, and the secondary indexes of the owner and
+// the expiry of the entry are updated. The height, timestamp, hash, version and
+// deposit of the entry are set from the block and its content.
+func (k Keeper) writeEntry(ctx context.Context, entry storagetypes.Storage) (storagetypes.Storage, error) {
+	// Create the key directly using strings
+	key := collections.Join(entry.Owner, entry.Index)
+
+	old, err := k.getEntry(ctx, entry.Owner, entry.Index)
+	if err != nil {
+		return entry, err
+	}
+
+	entry.Version, entry.HistorySize = 1, 0
+	if old != nil {
+		entry.Version = old.Version + 1
+		if entry.HistorySize, err = k.retainVersion(ctx, *old, entry); err != nil {
+			return entry, err
+		}
+	}
+
+	// Lock the deposit of the new size, refunding the one held for the previous value
+	deposit, err := k.chargeEntry(ctx, old, entry)
+	if err != nil {
+		return entry, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	entry.UpdatedHeight = uint64(sdkCtx.BlockHeight())
+	entry.UpdatedTimestamp = sdkCtx.BlockTime().UTC().Format(time.RFC3339)
+	entry.Hash = storagetypes.ContentHash(entry.Content())
+	entry.Deposit = deposit
+
+	if err := k.updateSecondaryIndexes(ctx, old, &entry); err != nil {
+		return entry, err
+	}
+	if err := k.updateExpiry(ctx, old, &entry); err != nil {
+		return entry, err
+	}
+	if err := k.StorageMap.Set(ctx, key, entry); err != nil {
+		return entry, err
+	}
+
+	// Emit the StorageUpdated event
+	event := storagetypes.EventStorageUpdated{
+		Address: entry.Owner,
+		Index:   entry.Index,
+	}
+	if err := sdkCtx.EventManager().EmitTypedEvent(&event); err != nil {
+		return entry, err
+	}
+	return entry, nil
+}
+
+// removeEntry deletes an entry, its retained versions, its secondary index entries and its expiry
+// and refunds its deposit to its owner
+func (k Keeper) removeEntry(ctx context.Context, entry storagetypes.Storage) error {
+	if err := k.StorageMap.Remove(ctx, collections.Join(entry.Owner, entry.Index)); err != nil {
+		return err
+	}
+	if err := k.updateSecondaryIndexes(ctx, &entry, nil); err != nil {
+		return err
+	}
+	if err := k.updateExpiry(ctx, &entry, nil); err != nil {
+		return err
+	}
+	if entry.HistorySize > 0 {
+		versions := collections.NewPrefixedPairRange[collections.Pair[string, string], uint64](collections.Join(entry.Owner, entry.Index))
+		if err := k.Versions.Clear(ctx, versions); err != nil {
+			return err
+		}
+	}
+	return k.releaseEntry(ctx, entry)
+}
+
+// getEntry returns the entry of an owner at an index, nil if there is none
+func (k Keeper) getEntry(ctx context.Context, owner, index string) (*storagetypes.Storage, error) {
+	entry, err := k.StorageMap.Get(ctx, collections.Join(owner, index))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// retainVersion saves old as a version of the entry replacing it when the entry keeps versions,
+// prunes the versions beyond its retention and returns the size of the versions left
+func (k Keeper) retainVersion(ctx context.Context, old, entry storagetypes.Storage) (uint64, error) {
+	historySize := old.HistorySize
+	if entry.KeepVersions > 0 {
+		version := old
+		version.Deposit, version.HistorySize = nil, 0
+		if err := k.Versions.Set(ctx, collections.Join(collections.Join(old.Owner, old.Index), old.Version), version); err != nil {
+			return 0, err
+		}
+		historySize += version.EntrySize()
+	}
+	if historySize == 0 {
+		return 0, nil
+	}
+
+	// Versions are walked oldest first and pruned until the retained ones are reached
+	keepFrom := entry.Version - min(entry.Version, uint64(entry.KeepVersions))
+	versions := collections.NewPrefixedPairRange[collections.Pair[string, string], uint64](collections.Join(entry.Owner, entry.Index))
+	var pruned []collections.Pair[collections.Pair[string, string], uint64]
+	err := k.Versions.Walk(ctx, versions, func(key collections.Pair[collections.Pair[string, string], uint64], version storagetypes.Storage) (bool, error) {
+		if key.K2() >= keepFrom {
+			return true, nil
+		}
+		pruned = append(pruned, key)
+		historySize -= min(historySize, version.EntrySize())
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range pruned {
+		if err := k.Versions.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+	return historySize, nil
+}
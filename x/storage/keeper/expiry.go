@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storagetypes "dysonprotocol.com/x/storage/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// updateExpiry moves an entry in the expiries from the expiry of its old content to the one of
+// its new content. old is nil for a new entry and entry is nil for a deleted one.
+func (k Keeper) updateExpiry(ctx context.Context, old, entry *storagetypes.Storage) error {
+	if old != nil && old.ExpiresAt > 0 && (entry == nil || entry.ExpiresAt != old.ExpiresAt) {
+		if err := k.Expiries.Remove(ctx, collections.Join3(old.ExpiresAt, old.Owner, old.Index)); err != nil {
+			return err
+		}
+	}
+	if entry != nil && entry.ExpiresAt > 0 {
+		return k.Expiries.Set(ctx, collections.Join3(entry.ExpiresAt, entry.Owner, entry.Index))
+	}
+	return nil
+}
+
+// EndBlocker is called at the end of every block. It deletes up to MaxExpiriesPerBlock entries
+// whose expiry is reached, oldest expiry first, refunding their deposit.
+func (k Keeper) EndBlocker(ctx sdk.Context) error {
+	currentTime := ctx.BlockTime().Unix()
+
+	var expired []collections.Triple[int64, string, string]
+	err := k.Expiries.Walk(ctx, collections.NewPrefixUntilTripleRange[int64, string, string](currentTime), func(key collections.Triple[int64, string, string]) (bool, error) {
+		expired = append(expired, key)
+		return len(expired) >= storagetypes.MaxExpiriesPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.expireEntry(cacheCtx, key); err != nil {
+			// The expiry is dropped, retrying it every block would hold back the others
+			ctx.Logger().Error("failed to expire storage entry", "owner", key.K2(), "index", key.K3(), "error", err)
+			if err := k.Expiries.Remove(ctx, key); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
+
+// expireEntry deletes an entry at its expiry, refunding its deposit, and emits
+// EventStorageExpired
+func (k Keeper) expireEntry(ctx sdk.Context, key collections.Triple[int64, string, string]) error {
+	entry, err := k.getEntry(ctx, key.K2(), key.K3())
+	if err != nil {
+		return err
+	}
+	if entry == nil || entry.ExpiresAt != key.K1() {
+		// A stale expiry of an entry deleted or rewritten since
+		return k.Expiries.Remove(ctx, key)
+	}
+
+	if err := k.removeEntry(ctx, *entry); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&storagetypes.EventStorageExpired{
+		Owner:     entry.Owner,
+		Index:     entry.Index,
+		ExpiresAt: entry.ExpiresAt,
+		Refunded:  entry.Deposit,
+	})
+}
@@ -11,6 +11,10 @@ import (
 
 // InitGenesis initializes the storage module's state from a genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState *storagev1.GenesisState) {
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
 	// Iterate through all the storage entries in the genesis state and set them
 	for i, entry := range genState.Entries {
 		fmt.Printf("Processing genesis entry %d: owner=%s, index=%s\n", i, entry.Owner, entry.Index)
@@ -27,15 +31,62 @@ func (k Keeper) InitGenesis(ctx context.Context, genState *storagev1.GenesisStat
 
 		// Set the storage entry
 		if err := k.StorageMap.Set(ctx, pairKey, storagev1.Storage{
-			Owner: entry.Owner,
-			Index: entry.Index,
-			Data:  entry.Data,
+			Owner:            entry.Owner,
+			Index:            entry.Index,
+			Data:             entry.Data,
+			Deposit:          entry.Deposit,
+			ContentType:      entry.ContentType,
+			Blob:             entry.Blob,
+			UpdatedHeight:    entry.UpdatedHeight,
+			UpdatedTimestamp: entry.UpdatedTimestamp,
+			Hash:             entry.Hash,
+			KeepVersions:     entry.KeepVersions,
+			Version:          entry.Version,
+			HistorySize:      entry.HistorySize,
+			ExpiresAt:        entry.ExpiresAt,
 		}); err != nil {
 			fmt.Printf("Failed to set storage entry: %v\n", err)
 			panic(err)
 		}
+		if entry.ExpiresAt > 0 {
+			if err := k.Expiries.Set(ctx, collections.Join3(entry.ExpiresAt, entry.Owner, entry.Index)); err != nil {
+				panic(err)
+			}
+		}
 		fmt.Printf("Successfully set storage entry\n")
 	}
+
+	for _, upload := range genState.Uploads {
+		if err := k.Uploads.Set(ctx, collections.Join(upload.Owner, upload.Index), upload); err != nil {
+			panic(err)
+		}
+	}
+	for _, chunk := range genState.UploadChunks {
+		if err := k.UploadChunks.Set(ctx, collections.Join3(chunk.Owner, chunk.Index, chunk.ChunkIndex), chunk.Data); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, version := range genState.Versions {
+		if err := k.Versions.Set(ctx, collections.Join(collections.Join(version.Owner, version.Index), version.Version), version); err != nil {
+			panic(err)
+		}
+	}
+
+	// The secondary indexes are rebuilt from the entries
+	for _, idx := range genState.SecondaryIndexes {
+		if err := k.SecondaryIndexes.Set(ctx, collections.Join(idx.Owner, idx.Name), idx); err != nil {
+			panic(err)
+		}
+		if _, err := k.indexEntries(ctx, idx); err != nil {
+			panic(err)
+		}
+	}
+
+	// The usage of every owner is rebuilt from its entries
+	if err := k.rebuildOwnerUsage(ctx); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis exports the storage module's state to a genesis state.
@@ -59,8 +110,54 @@ func (k Keeper) ExportGenesis(ctx context.Context) *storagev1.GenesisState {
 		entries = append(entries, value)
 	}
 
+	uploads := []storagev1.StorageUpload{}
+	err = k.Uploads.Walk(ctx, nil, func(_ collections.Pair[string, string], upload storagev1.StorageUpload) (bool, error) {
+		uploads = append(uploads, upload)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	chunks := []storagev1.UploadChunk{}
+	err = k.UploadChunks.Walk(ctx, nil, func(key collections.Triple[string, string, uint32], data []byte) (bool, error) {
+		chunks = append(chunks, storagev1.UploadChunk{
+			Owner:      key.K1(),
+			Index:      key.K2(),
+			ChunkIndex: key.K3(),
+			Data:       data,
+		})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	versions := []storagev1.Storage{}
+	err = k.Versions.Walk(ctx, nil, func(_ collections.Pair[collections.Pair[string, string], uint64], version storagev1.Storage) (bool, error) {
+		versions = append(versions, version)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	indexes := []storagev1.SecondaryIndex{}
+	err = k.SecondaryIndexes.Walk(ctx, nil, func(_ collections.Pair[string, string], idx storagev1.SecondaryIndex) (bool, error) {
+		indexes = append(indexes, idx)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	// Create and return a new genesis state with the entries
 	return &storagev1.GenesisState{
-		Entries: entries,
+		Entries:          entries,
+		Params:           k.GetParams(ctx),
+		Uploads:          uploads,
+		UploadChunks:     chunks,
+		Versions:         versions,
+		SecondaryIndexes: indexes,
 	}
 }
@@ -26,11 +26,31 @@ func (k Keeper) StorageGet(ctx context.Context, req *storagetypes.QueryStorageGe
 	if len(req.Extract) > 100 {
 		return nil, status.Errorf(codes.InvalidArgument, "extract path too long: max 100 characters")
 	}
+	ranged := req.Offset != 0 || req.Length != 0
+	if ranged && req.Extract != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a byte range cannot be combined with extract")
+	}
 
 	// Create the key directly using strings
 	pairKey := collections.Join(req.Owner, req.Index)
 	record, err := k.StorageMap.Get(ctx, pairKey)
 	if err == nil {
+		size := uint64(len(record.Content()))
+		if ranged {
+			if req.Offset > size {
+				return nil, status.Errorf(codes.InvalidArgument, "offset %d is beyond the entry size %d", req.Offset, size)
+			}
+			end := size
+			if req.Length != 0 && req.Length < size-req.Offset {
+				end = req.Offset + req.Length
+			}
+			// The range of a text entry is in bytes and may split a multi-byte character
+			if record.Blob != nil {
+				record.Blob = record.Blob[req.Offset:end]
+			} else {
+				record.Data = record.Data[req.Offset:end]
+			}
+		}
 		// Apply optional GJSON extract if provided
 		if req.Extract != "" {
 			res := gjson.Get(record.Data, req.Extract)
@@ -43,6 +63,7 @@ func (k Keeper) StorageGet(ctx context.Context, req *storagetypes.QueryStorageGe
 		}
 		return &storagetypes.QueryStorageGetResponse{
 			Entry: &record, // single struct
+			Size_: size,
 		}, nil
 	}
 	if errors.IsOf(err, collections.ErrNotFound) {
@@ -116,3 +137,213 @@ func (k Keeper) StorageList(ctx context.Context, req *storagetypes.QueryStorageL
 		Pagination: pageRes,
 	}, nil
 }
+
+func (k Keeper) StorageUsage(ctx context.Context, req *storagetypes.QueryStorageUsageRequest) (*storagetypes.QueryStorageUsageResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %v", err)
+	}
+
+	usage, err := k.GetOwnerUsage(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &storagetypes.QueryStorageUsageResponse{Usage: usage}, nil
+}
+
+func (k Keeper) StorageUpload(ctx context.Context, req *storagetypes.QueryStorageUploadRequest) (*storagetypes.QueryStorageUploadResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %v", err)
+	}
+
+	upload, err := k.Uploads.Get(ctx, collections.Join(req.Owner, req.Index))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no upload to (owner=%s,index=%s) in progress", req.Owner, req.Index)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	sizes, err := k.uploadChunkSizes(ctx, upload)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &storagetypes.QueryStorageUploadResponse{
+		Upload:        upload,
+		MissingChunks: missingChunks(upload, sizes),
+	}, nil
+}
+
+func (k Keeper) StorageHistory(ctx context.Context, req *storagetypes.QueryStorageHistoryRequest) (*storagetypes.QueryStorageHistoryResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %v", err)
+	}
+
+	versions, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Versions,
+		req.Pagination,
+		func(_ collections.Pair[collections.Pair[string, string], uint64], version storagetypes.Storage) (storagetypes.Storage, error) {
+			return version, nil
+		},
+		query.WithCollectionPaginationPairPrefix[collections.Pair[string, string], uint64](collections.Join(req.Owner, req.Index)),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &storagetypes.QueryStorageHistoryResponse{
+		Versions:   versions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) StorageSecondaryIndexes(ctx context.Context, req *storagetypes.QueryStorageSecondaryIndexesRequest) (*storagetypes.QueryStorageSecondaryIndexesResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %v", err)
+	}
+
+	indexes, err := k.ownerSecondaryIndexes(ctx, req.Owner)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &storagetypes.QueryStorageSecondaryIndexesResponse{Indexes: indexes}, nil
+}
+
+func (k Keeper) StorageFind(ctx context.Context, req *storagetypes.QueryStorageFindRequest) (*storagetypes.QueryStorageFindResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner address: %v", err)
+	}
+	if req.Value != "" && (req.Min != "" || req.Max != "") {
+		return nil, status.Errorf(codes.InvalidArgument, "a value cannot be combined with a range")
+	}
+
+	has, err := k.SecondaryIndexes.Has(ctx, collections.Join(req.Owner, req.Name))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return nil, status.Errorf(codes.NotFound, "secondary index %s of %s doesn't exist", req.Name, req.Owner)
+	}
+
+	ranger, err := findRange(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page := req.Pagination
+	if page == nil {
+		page = &query.PageRequest{}
+	}
+	if page.Offset > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "offset pagination is not supported, use the next key")
+	}
+	limit := page.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	if page.Key != nil {
+		_, key, err := k.IndexEntries.KeyCodec().Decode(page.Key)
+		if err != nil || key.K1() != req.Owner || key.K2() != req.Name {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid pagination key")
+		}
+		if page.Reverse {
+			ranger.EndInclusive(key)
+		} else {
+			ranger.StartInclusive(key)
+		}
+	}
+	if page.Reverse {
+		ranger.Descending()
+	}
+
+	// One more key than the limit is read to tell whether there is a next page
+	var keys []collections.Quad[string, string, string, string]
+	err = k.IndexEntries.Walk(ctx, ranger, func(key collections.Quad[string, string, string, string]) (bool, error) {
+		keys = append(keys, key)
+		return uint64(len(keys)) > limit, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pageRes := &query.PageResponse{}
+	if uint64(len(keys)) > limit {
+		pageRes.NextKey = make([]byte, k.IndexEntries.KeyCodec().Size(keys[limit]))
+		if _, err := k.IndexEntries.KeyCodec().Encode(pageRes.NextKey, keys[limit]); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		keys = keys[:limit]
+	}
+
+	entries := make([]storagetypes.Storage, 0, len(keys))
+	for _, key := range keys {
+		entry, err := k.StorageMap.Get(ctx, collections.Join(req.Owner, key.K4()))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		entries = append(entries, entry)
+	}
+
+	return &storagetypes.QueryStorageFindResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// findRange returns the range of the index entries matching the value or the range of a find
+// request, all the entries of the index when neither is set
+func findRange(req *storagetypes.QueryStorageFindRequest) (*collections.Range[collections.Quad[string, string, string, string]], error) {
+	ranger := new(collections.Range[collections.Quad[string, string, string, string]])
+	if req.Value != "" {
+		key, ok := storagetypes.ParseIndexValue(req.Value)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "value %s is not indexable", req.Value)
+		}
+		return ranger.Prefix(collections.QuadSuperPrefix3[string, string, string, string](req.Owner, req.Name, key)), nil
+	}
+
+	ranger.Prefix(collections.QuadSuperPrefix[string, string, string, string](req.Owner, req.Name))
+	var minKey, maxKey string
+	if req.Min != "" {
+		key, ok := storagetypes.ParseIndexValue(req.Min)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "min %s is not indexable", req.Min)
+		}
+		minKey = key
+	}
+	if req.Max != "" {
+		key, ok := storagetypes.ParseIndexValue(req.Max)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "max %s is not indexable", req.Max)
+		}
+		maxKey = key
+	}
+	if minKey != "" && maxKey != "" && minKey[0] != maxKey[0] {
+		return nil, status.Errorf(codes.InvalidArgument, "min and max must have the same type")
+	}
+
+	// A range open on one side stays within the type of its other bound, the keys of each type
+	// starting with its tag
+	if minKey != "" {
+		ranger.StartInclusive(collections.QuadSuperPrefix3[string, string, string, string](req.Owner, req.Name, minKey))
+		if maxKey == "" {
+			ranger.EndExclusive(collections.QuadSuperPrefix3[string, string, string, string](req.Owner, req.Name, string(minKey[0]+1)))
+		}
+	}
+	if maxKey != "" {
+		ranger.EndExclusive(collections.QuadSuperPrefix3[string, string, string, string](req.Owner, req.Name, maxKey))
+		if minKey == "" {
+			ranger.StartInclusive(collections.QuadSuperPrefix3[string, string, string, string](req.Owner, req.Name, maxKey[:1]))
+		}
+	}
+	return ranger, nil
+}
+
+func (k Keeper) Params(ctx context.Context, req *storagetypes.QueryParamsRequest) (*storagetypes.QueryParamsResponse, error) {
+	return &storagetypes.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	storage "dysonprotocol.com/x/storage"
@@ -11,28 +14,84 @@ import (
 // We store each entry under (ownerString, indexString).
 var StoragePrefix = collections.NewPrefix(0)
 
+// ParamsPrefix is the prefix of the module parameters
+var ParamsPrefix = collections.NewPrefix(1)
+
+// OwnerUsagePrefix is the prefix of the storage used by each owner
+var OwnerUsagePrefix = collections.NewPrefix(2)
+
+// UploadsPrefix is the prefix of the chunked uploads in progress
+var UploadsPrefix = collections.NewPrefix(3)
+
+// UploadChunksPrefix is the prefix of the chunks of the uploads in progress
+var UploadChunksPrefix = collections.NewPrefix(4)
+
+// VersionsPrefix is the prefix of the retained previous versions of the entries
+var VersionsPrefix = collections.NewPrefix(5)
+
+// SecondaryIndexesPrefix is the prefix of the secondary indexes of the owners
+var SecondaryIndexesPrefix = collections.NewPrefix(6)
+
+// IndexEntriesPrefix is the prefix of the entries of the secondary indexes
+var IndexEntriesPrefix = collections.NewPrefix(7)
+
+// ExpiriesPrefix is the prefix of the entries by expiry time
+var ExpiriesPrefix = collections.NewPrefix(8)
+
 type Keeper struct {
-	config    storage.Config
-	cdc       codec.Codec
-	accKeeper storage.AccountKeeper
+	config     storage.Config
+	cdc        codec.Codec
+	accKeeper  storage.AccountKeeper
+	bankKeeper storage.BankKeeper
+
+	// Authority for governance operations
+	authority string
 
 	Schema collections.Schema
 
 	// The robust store-level map (owner,index)->Storage
 	StorageMap collections.Map[collections.Pair[string, string], storagev1.Storage]
+
+	// The module parameters
+	params collections.Item[storagev1.Params]
+
+	// The bytes, entries and deposit of each owner, owner->OwnerUsage
+	OwnerUsage collections.Map[string, storagev1.OwnerUsage]
+
+	// The chunked uploads in progress, (owner,index)->StorageUpload
+	Uploads collections.Map[collections.Pair[string, string], storagev1.StorageUpload]
+
+	// The uploaded chunks, (owner,index,chunk)->content
+	UploadChunks collections.Map[collections.Triple[string, string, uint32], []byte]
+
+	// The retained previous versions of the entries, ((owner,index),version)->Storage
+	Versions collections.Map[collections.Pair[collections.Pair[string, string], uint64], storagev1.Storage]
+
+	// The secondary indexes, (owner,name)->SecondaryIndex
+	SecondaryIndexes collections.Map[collections.Pair[string, string], storagev1.SecondaryIndex]
+
+	// The indexed entries, (owner,name,value key,index)
+	IndexEntries collections.KeySet[collections.Quad[string, string, string, string]]
+
+	// The entries that expire, (expires at,owner,index)
+	Expiries collections.KeySet[collections.Triple[int64, string, string]]
 }
 
 func NewKeeper(
 	storeService store.KVStoreService,
 	cdc codec.Codec,
 	accKeeper storage.AccountKeeper,
+	bankKeeper storage.BankKeeper,
+	authority string,
 	config storage.Config,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		config:    config,
-		cdc:       cdc,
-		accKeeper: accKeeper,
+		config:     config,
+		cdc:        cdc,
+		accKeeper:  accKeeper,
+		bankKeeper: bankKeeper,
+		authority:  authority,
 		StorageMap: collections.NewMap(
 			sb,
 			StoragePrefix, // prefix partition
@@ -40,6 +99,59 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
 			codec.CollValue[storagev1.Storage](cdc),
 		),
+		params: collections.NewItem(
+			sb,
+			ParamsPrefix,
+			"params",
+			codec.CollValue[storagev1.Params](cdc),
+		),
+		OwnerUsage: collections.NewMap(
+			sb,
+			OwnerUsagePrefix,
+			"owner_usage",
+			collections.StringKey,
+			codec.CollValue[storagev1.OwnerUsage](cdc),
+		),
+		Uploads: collections.NewMap(
+			sb,
+			UploadsPrefix,
+			"uploads",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[storagev1.StorageUpload](cdc),
+		),
+		UploadChunks: collections.NewMap(
+			sb,
+			UploadChunksPrefix,
+			"upload_chunks",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Uint32Key),
+			collections.BytesValue,
+		),
+		Versions: collections.NewMap(
+			sb,
+			VersionsPrefix,
+			"versions",
+			collections.PairKeyCodec(collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Key),
+			codec.CollValue[storagev1.Storage](cdc),
+		),
+		SecondaryIndexes: collections.NewMap(
+			sb,
+			SecondaryIndexesPrefix,
+			"secondary_indexes",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[storagev1.SecondaryIndex](cdc),
+		),
+		IndexEntries: collections.NewKeySet(
+			sb,
+			IndexEntriesPrefix,
+			"index_entries",
+			collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey, collections.StringKey),
+		),
+		Expiries: collections.NewKeySet(
+			sb,
+			ExpiriesPrefix,
+			"expiries",
+			collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.StringKey),
+		),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -49,3 +161,43 @@ func NewKeeper(
 
 	return k
 }
+
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the current module parameters
+func (k Keeper) GetParams(ctx context.Context) storagev1.Params {
+	params, err := k.params.Get(ctx)
+	if err != nil {
+		// If params don't exist, return defaults
+		return storagev1.DefaultParams()
+	}
+	return params
+}
+
+// SetParams sets the module parameters
+func (k Keeper) SetParams(ctx context.Context, params storagev1.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.params.Set(ctx, params)
+}
+
+// GetOwnerUsage returns the storage used by an owner, empty when the owner has no entries
+func (k Keeper) GetOwnerUsage(ctx context.Context, owner string) (storagev1.OwnerUsage, error) {
+	usage, err := k.OwnerUsage.Get(ctx, owner)
+	if errors.Is(err, collections.ErrNotFound) {
+		return storagev1.OwnerUsage{Owner: owner}, nil
+	}
+	return usage, err
+}
+
+// setOwnerUsage saves the storage used by an owner, removing it once the owner has no entries
+func (k Keeper) setOwnerUsage(ctx context.Context, usage storagev1.OwnerUsage) error {
+	if usage.Entries == 0 {
+		return k.OwnerUsage.Remove(ctx, usage.Owner)
+	}
+	return k.OwnerUsage.Set(ctx, usage.Owner, usage)
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator handles the in-place store migrations of the storage module
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 builds the usage of every owner from its entries. The entries of consensus
+// version 1 hold no deposit.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.rebuildOwnerUsage(ctx)
+}
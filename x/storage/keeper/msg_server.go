@@ -2,16 +2,15 @@ package keeper
 
 import (
 	"context"
-	"crypto/sha256"
-	"encoding/base64"
-	"fmt"
-	"time"
+	"errors"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	storage "dysonprotocol.com/x/storage"
 	storagetypes "dysonprotocol.com/x/storage/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // We assume your keeper implements storagetypes.MsgServer
@@ -23,37 +22,57 @@ func (k Keeper) StorageSet(ctx context.Context, msg *storagetypes.MsgStorageSet)
 		return nil, err
 	}
 
-	// Create the key directly using strings
-	key := collections.Join(msg.Owner, msg.Index)
+	if msg.Data != "" && len(msg.Blob) > 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "data and blob cannot both be set")
+	}
 
-	blockHeight := uint64(sdk.UnwrapSDKContext(ctx).BlockHeight())
-	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
-	hashBytes := sha256.Sum256([]byte(msg.Data))
-	hashB64 := base64.StdEncoding.EncodeToString(hashBytes[:])
-	entry := storagetypes.Storage{
-		Owner:            msg.Owner,
-		Data:             msg.Data,
-		Index:            msg.Index,
-		UpdatedHeight:    blockHeight,
-		UpdatedTimestamp: blockTime.UTC().Format(time.RFC3339),
-		Hash:             fmt.Sprintf("sha256-%s", hashB64),
+	if msg.ExpiresAt != 0 && msg.ExpiresAt <= sdk.UnwrapSDKContext(ctx).BlockTime().Unix() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry %d is not in the future", msg.ExpiresAt)
 	}
 
-	if err := k.StorageMap.Set(ctx, key, entry); err != nil {
+	if err := k.checkSetPrecondition(ctx, msg); err != nil {
 		return nil, err
 	}
 
-	// Emit the StorageUpdated event
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	event := storagetypes.EventStorageUpdated{
-		Address: msg.Owner,
-		Index:   msg.Index,
-	}
-	if err := sdkCtx.EventManager().EmitTypedEvent(&event); err != nil {
+	entry, err := k.writeEntry(ctx, storagetypes.Storage{
+		Owner:        msg.Owner,
+		Index:        msg.Index,
+		Data:         msg.Data,
+		ContentType:  msg.ContentType,
+		Blob:         msg.Blob,
+		KeepVersions: msg.KeepVersions,
+		ExpiresAt:    msg.ExpiresAt,
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &storagetypes.MsgStorageSetResponse{}, nil
+	return &storagetypes.MsgStorageSetResponse{Deposit: entry.Deposit, Version: entry.Version}, nil
+}
+
+// checkSetPrecondition checks the compare-and-swap conditions of a MsgStorageSet against the
+// current entry
+func (k Keeper) checkSetPrecondition(ctx context.Context, msg *storagetypes.MsgStorageSet) error {
+	if msg.ExpectedHash == "" && !msg.IfNotExists {
+		return nil
+	}
+	if msg.ExpectedHash != "" && msg.IfNotExists {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "expected hash and if not exists cannot both be set")
+	}
+
+	current, err := k.getEntry(ctx, msg.Owner, msg.Index)
+	if err != nil {
+		return err
+	}
+	switch {
+	case msg.IfNotExists && current != nil:
+		return errorsmod.Wrapf(storage.ErrPreconditionFailed, "entry %s already exists", msg.Index)
+	case msg.ExpectedHash != "" && current == nil:
+		return errorsmod.Wrapf(storage.ErrPreconditionFailed, "entry %s does not exist", msg.Index)
+	case msg.ExpectedHash != "" && current.Hash != msg.ExpectedHash:
+		return errorsmod.Wrapf(storage.ErrPreconditionFailed, "entry %s has hash %s, expected %s", msg.Index, current.Hash, msg.ExpectedHash)
+	}
+	return nil
 }
 
 func (k Keeper) StorageDelete(ctx context.Context, msg *storagetypes.MsgStorageDelete) (*storagetypes.MsgStorageDeleteResponse, error) {
@@ -68,25 +87,30 @@ func (k Keeper) StorageDelete(ctx context.Context, msg *storagetypes.MsgStorageD
 	// Track the indexes that were deleted
 	var deletedIndexes []string
 
+	// Track the deposit refunded for the deleted entries
+	refunded := sdk.NewCoins()
+
 	// Delete each index in the list
 	for _, index := range msg.Indexes {
 		// Create the key for this index
 		key := collections.Join(msg.Owner, index)
 
 		// Check if the entry exists first
-		exists, err := k.StorageMap.Has(ctx, key)
+		entry, err := k.StorageMap.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
 
 		// Only try to delete if it exists
-		if exists {
-			if err := k.StorageMap.Remove(ctx, key); err != nil {
-				return nil, err
-			}
-			deletedCount++
-			deletedIndexes = append(deletedIndexes, index)
+		if err := k.removeEntry(ctx, entry); err != nil {
+			return nil, err
 		}
+		refunded = refunded.Add(entry.Deposit...)
+		deletedCount++
+		deletedIndexes = append(deletedIndexes, index)
 	}
 
 	if len(deletedIndexes) == 0 {
@@ -104,5 +128,261 @@ func (k Keeper) StorageDelete(ctx context.Context, msg *storagetypes.MsgStorageD
 	}
 	return &storagetypes.MsgStorageDeleteResponse{
 		DeletedIndexes: deletedIndexes,
+		Refunded:       refunded,
 	}, nil
 }
+
+func (k Keeper) StorageUploadBegin(ctx context.Context, msg *storagetypes.MsgStorageUploadBegin) (*storagetypes.MsgStorageUploadBeginResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(msg.Owner, msg.Index)
+	inProgress, err := k.Uploads.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if inProgress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "an upload to index %s is already in progress", msg.Index)
+	}
+
+	upload := storagetypes.StorageUpload{
+		Owner:         msg.Owner,
+		Index:         msg.Index,
+		ContentType:   msg.ContentType,
+		Size_:         msg.Size_,
+		ChunkHashes:   msg.ChunkHashes,
+		Hash:          msg.Hash,
+		CreatedHeight: uint64(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+	}
+	if err := upload.ValidateManifest(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// The deposit of the entry is held until the upload is committed or cancelled
+	params := k.GetParams(ctx)
+	if upload.EntrySize() > params.MaxEntrySize {
+		return nil, errorsmod.Wrapf(storage.ErrEntryTooLarge, "entry size %d exceeds the max entry size %d", upload.EntrySize(), params.MaxEntrySize)
+	}
+	upload.Deposit = params.RequiredDeposit(upload.EntrySize())
+	if err := k.settleDeposit(ctx, msg.Owner, nil, upload.Deposit); err != nil {
+		return nil, err
+	}
+
+	if err := k.Uploads.Set(ctx, key, upload); err != nil {
+		return nil, err
+	}
+
+	return &storagetypes.MsgStorageUploadBeginResponse{Deposit: upload.Deposit}, nil
+}
+
+func (k Keeper) StorageUploadChunk(ctx context.Context, msg *storagetypes.MsgStorageUploadChunk) (*storagetypes.MsgStorageUploadChunkResponse, error) {
+	upload, err := k.getUpload(ctx, msg.Owner, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	if int(msg.ChunkIndex) >= len(upload.ChunkHashes) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chunk %d is out of the %d chunks of the upload", msg.ChunkIndex, len(upload.ChunkHashes))
+	}
+	if storagetypes.ContentHash(msg.Data) != upload.ChunkHashes[msg.ChunkIndex] {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chunk %d does not match its manifest hash", msg.ChunkIndex)
+	}
+
+	// The chunks cannot hold more than the size the deposit was locked for
+	sizes, err := k.uploadChunkSizes(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	sizes[msg.ChunkIndex] = uint64(len(msg.Data))
+	var uploaded uint64
+	for _, size := range sizes {
+		uploaded += size
+	}
+	if uploaded > upload.Size_ {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "chunks of %d bytes exceed the upload size %d", uploaded, upload.Size_)
+	}
+
+	if err := k.UploadChunks.Set(ctx, collections.Join3(msg.Owner, msg.Index, msg.ChunkIndex), msg.Data); err != nil {
+		return nil, err
+	}
+
+	return &storagetypes.MsgStorageUploadChunkResponse{
+		ChunksMissing: uint32(len(missingChunks(upload, sizes))),
+	}, nil
+}
+
+func (k Keeper) StorageUploadCommit(ctx context.Context, msg *storagetypes.MsgStorageUploadCommit) (*storagetypes.MsgStorageUploadCommitResponse, error) {
+	upload, err := k.getUpload(ctx, msg.Owner, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes, err := k.uploadChunkSizes(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	if missing := missingChunks(upload, sizes); len(missing) > 0 {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "the upload is missing %d chunks: %v", len(missing), missing)
+	}
+
+	content, err := k.assembleUpload(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(content)) != upload.Size_ {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "the uploaded content has %d bytes, expected %d", len(content), upload.Size_)
+	}
+	if storagetypes.ContentHash(content) != upload.Hash {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the uploaded content does not match the manifest hash")
+	}
+
+	// The deposit of the upload is refunded and the one of the entry locked in its place
+	if err := k.settleDeposit(ctx, msg.Owner, upload.Deposit, nil); err != nil {
+		return nil, err
+	}
+	if err := k.removeUpload(ctx, upload); err != nil {
+		return nil, err
+	}
+
+	// The committed content keeps the retention and the expiry of the entry it replaces
+	entry := storagetypes.Storage{
+		Owner:       upload.Owner,
+		Index:       upload.Index,
+		ContentType: upload.ContentType,
+		Blob:        content,
+	}
+	current, err := k.getEntry(ctx, upload.Owner, upload.Index)
+	if err != nil {
+		return nil, err
+	}
+	if current != nil {
+		entry.KeepVersions = current.KeepVersions
+		if current.ExpiresAt > sdk.UnwrapSDKContext(ctx).BlockTime().Unix() {
+			entry.ExpiresAt = current.ExpiresAt
+		}
+	}
+
+	entry, err = k.writeEntry(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagetypes.MsgStorageUploadCommitResponse{Deposit: entry.Deposit}, nil
+}
+
+func (k Keeper) StorageUploadCancel(ctx context.Context, msg *storagetypes.MsgStorageUploadCancel) (*storagetypes.MsgStorageUploadCancelResponse, error) {
+	upload, err := k.getUpload(ctx, msg.Owner, msg.Index)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.settleDeposit(ctx, msg.Owner, upload.Deposit, nil); err != nil {
+		return nil, err
+	}
+	if err := k.removeUpload(ctx, upload); err != nil {
+		return nil, err
+	}
+
+	return &storagetypes.MsgStorageUploadCancelResponse{Refunded: upload.Deposit}, nil
+}
+
+func (k Keeper) StorageCreateSecondaryIndex(ctx context.Context, msg *storagetypes.MsgStorageCreateSecondaryIndex) (*storagetypes.MsgStorageCreateSecondaryIndexResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return nil, err
+	}
+
+	idx := storagetypes.SecondaryIndex{
+		Owner:       msg.Owner,
+		Name:        msg.Name,
+		Path:        msg.Path,
+		IndexPrefix: msg.IndexPrefix,
+	}
+	if err := idx.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	indexes, err := k.ownerSecondaryIndexes(ctx, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, existing := range indexes {
+		if existing.Name == msg.Name {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "secondary index %s already exists", msg.Name)
+		}
+	}
+	if len(indexes) >= storagetypes.MaxSecondaryIndexesPerOwner {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "an owner can have at most %d secondary indexes", storagetypes.MaxSecondaryIndexesPerOwner)
+	}
+
+	if err := k.SecondaryIndexes.Set(ctx, collections.Join(idx.Owner, idx.Name), idx); err != nil {
+		return nil, err
+	}
+	// The existing entries are indexed right away
+	indexed, err := k.indexEntries(ctx, idx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storagetypes.MsgStorageCreateSecondaryIndexResponse{Indexed: indexed}, nil
+}
+
+func (k Keeper) StorageDeleteSecondaryIndex(ctx context.Context, msg *storagetypes.MsgStorageDeleteSecondaryIndex) (*storagetypes.MsgStorageDeleteSecondaryIndexResponse, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(msg.Owner, msg.Name)
+	has, err := k.SecondaryIndexes.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "secondary index %s does not exist", msg.Name)
+	}
+
+	if err := k.SecondaryIndexes.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+	if err := k.clearSecondaryIndex(ctx, msg.Owner, msg.Name); err != nil {
+		return nil, err
+	}
+
+	return &storagetypes.MsgStorageDeleteSecondaryIndexResponse{}, nil
+}
+
+// getUpload returns the upload in progress of an owner to an index
+func (k Keeper) getUpload(ctx context.Context, owner, index string) (storagetypes.StorageUpload, error) {
+	// Validate the owner address is properly formatted
+	if _, err := sdk.AccAddressFromBech32(owner); err != nil {
+		return storagetypes.StorageUpload{}, err
+	}
+
+	upload, err := k.Uploads.Get(ctx, collections.Join(owner, index))
+	if errors.Is(err, collections.ErrNotFound) {
+		return upload, errorsmod.Wrapf(sdkerrors.ErrNotFound, "no upload to index %s in progress", index)
+	}
+	return upload, err
+}
+
+func (k Keeper) UpdateParams(ctx context.Context, msg *storagetypes.MsgUpdateParams) (*storagetypes.MsgUpdateParamsResponse, error) {
+	// Validate authority
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	// Validate the parameters
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid parameters")
+	}
+
+	// Set the parameters
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set parameters")
+	}
+
+	return &storagetypes.MsgUpdateParamsResponse{}, nil
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storagetypes "dysonprotocol.com/x/storage/types"
+)
+
+// ownerSecondaryIndexes returns the secondary indexes of an owner
+func (k Keeper) ownerSecondaryIndexes(ctx context.Context, owner string) ([]storagetypes.SecondaryIndex, error) {
+	iter, err := k.SecondaryIndexes.Iterate(ctx, collections.NewPrefixedPairRange[string, string](owner))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// updateSecondaryIndexes moves an entry in the secondary indexes of its owner from the values of
+// its old content to the ones of its new content. old is nil for a new entry and entry is nil for
+// a deleted one.
+func (k Keeper) updateSecondaryIndexes(ctx context.Context, old, entry *storagetypes.Storage) error {
+	owner := ""
+	switch {
+	case entry != nil:
+		owner = entry.Owner
+	case old != nil:
+		owner = old.Owner
+	default:
+		return nil
+	}
+
+	indexes, err := k.ownerSecondaryIndexes(ctx, owner)
+	if err != nil {
+		return err
+	}
+	for _, idx := range indexes {
+		var oldKey, newKey string
+		var oldOk, newOk bool
+		if old != nil {
+			oldKey, oldOk = idx.ValueKey(*old)
+		}
+		if entry != nil {
+			newKey, newOk = idx.ValueKey(*entry)
+		}
+		if oldOk && newOk && oldKey == newKey {
+			continue
+		}
+		if oldOk {
+			if err := k.IndexEntries.Remove(ctx, collections.Join4(owner, idx.Name, oldKey, old.Index)); err != nil {
+				return err
+			}
+		}
+		if newOk {
+			if err := k.IndexEntries.Set(ctx, collections.Join4(owner, idx.Name, newKey, entry.Index)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexEntries adds the entries covered by a secondary index to it and returns their number
+func (k Keeper) indexEntries(ctx context.Context, idx storagetypes.SecondaryIndex) (uint64, error) {
+	var keys []collections.Quad[string, string, string, string]
+	err := k.StorageMap.Walk(ctx, collections.NewPrefixedPairRange[string, string](idx.Owner), func(_ collections.Pair[string, string], entry storagetypes.Storage) (bool, error) {
+		if key, ok := idx.ValueKey(entry); ok {
+			keys = append(keys, collections.Join4(idx.Owner, idx.Name, key, entry.Index))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range keys {
+		if err := k.IndexEntries.Set(ctx, key); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(keys)), nil
+}
+
+// clearSecondaryIndex removes the entries of a secondary index
+func (k Keeper) clearSecondaryIndex(ctx context.Context, owner, name string) error {
+	return k.IndexEntries.Clear(ctx, collections.NewSuperPrefixedQuadRange[string, string, string, string](owner, name))
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	storagetypes "dysonprotocol.com/x/storage/types"
+)
+
+// uploadChunkSizes returns the size of every chunk uploaded so far, by chunk position
+func (k Keeper) uploadChunkSizes(ctx context.Context, upload storagetypes.StorageUpload) (map[uint32]uint64, error) {
+	sizes := make(map[uint32]uint64)
+	rng := collections.NewSuperPrefixedTripleRange[string, string, uint32](upload.Owner, upload.Index)
+	err := k.UploadChunks.Walk(ctx, rng, func(key collections.Triple[string, string, uint32], chunk []byte) (bool, error) {
+		sizes[key.K3()] = uint64(len(chunk))
+		return false, nil
+	})
+	return sizes, err
+}
+
+// missingChunks returns the positions of the chunks of an upload not uploaded yet
+func missingChunks(upload storagetypes.StorageUpload, sizes map[uint32]uint64) []uint32 {
+	missing := make([]uint32, 0)
+	for i := range upload.ChunkHashes {
+		if _, ok := sizes[uint32(i)]; !ok {
+			missing = append(missing, uint32(i))
+		}
+	}
+	return missing
+}
+
+// assembleUpload concatenates the chunks of a complete upload
+func (k Keeper) assembleUpload(ctx context.Context, upload storagetypes.StorageUpload) ([]byte, error) {
+	content := make([]byte, 0, upload.Size_)
+	for i := range upload.ChunkHashes {
+		chunk, err := k.UploadChunks.Get(ctx, collections.Join3(upload.Owner, upload.Index, uint32(i)))
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, chunk...)
+	}
+	return content, nil
+}
+
+// removeUpload deletes an upload and its chunks. Its deposit is not refunded.
+func (k Keeper) removeUpload(ctx context.Context, upload storagetypes.StorageUpload) error {
+	rng := collections.NewSuperPrefixedTripleRange[string, string, uint32](upload.Owner, upload.Index)
+	if err := k.UploadChunks.Clear(ctx, rng); err != nil {
+		return err
+	}
+	return k.Uploads.Remove(ctx, collections.Join(upload.Owner, upload.Index))
+}
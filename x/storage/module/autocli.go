@@ -43,6 +43,42 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Delete one or more storage entries with the specified indexes",
 					Long:      "Delete one or more storage entries with the specified indexes. Only existing entries will be deleted. Returns the count of entries deleted.",
 				},
+				{
+					RpcMethod: "StorageUploadBegin",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "StorageUploadChunk",
+					Skip:      true,
+				},
+				{
+					RpcMethod: "StorageUploadCommit",
+					Use:       "upload-commit --index <index>",
+					Short:     "Commit a chunked upload once all its chunks are uploaded",
+					Long:      "Commit a chunked upload to its storage entry. The chunks are assembled and checked against the manifest hash, and the whole content is written to the entry in a single write.",
+				},
+				{
+					RpcMethod: "StorageUploadCancel",
+					Use:       "upload-cancel --index <index>",
+					Short:     "Cancel a chunked upload and refund its deposit",
+				},
+				{
+					RpcMethod: "StorageCreateSecondaryIndex",
+					Use:       "create-secondary-index --name <name> --path <path> [--index-prefix <prefix>]",
+					Short:     "Create a secondary index on a JSON path of the data of your storage entries",
+					Long:      "Create a secondary index on a JSON path such as $.category of the data of your storage entries under an index prefix. The existing entries are indexed right away and every write keeps the index up to date. Strings, numbers and booleans are indexed.",
+				},
+				{
+					RpcMethod: "StorageDeleteSecondaryIndex",
+					Use:       "delete-secondary-index --name <name>",
+					Short:     "Delete a secondary index and its entries",
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Use:       "update-params --params <json>",
+					Short:     "Update storage module parameters (governance authority only)",
+					Long:      "Update the x/storage module Params, the per-byte deposit, the max entry size and the owner quotas. Requires the module authority account as signer, usually through a gov proposal.",
+				},
 			},
 		},
 	}
@@ -9,6 +9,8 @@ import (
 	"dysonprotocol.com/x/storage"
 	"dysonprotocol.com/x/storage/keeper"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -32,6 +34,7 @@ type StorageInputs struct {
 	Cdc           codec.Codec
 	StoreService  store.KVStoreService
 	AccountKeeper authkeeper.AccountKeeper
+	BankKeeper    storage.BankKeeper
 	Registry      cdctypes.InterfaceRegistry
 }
 
@@ -43,10 +46,15 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in StorageInputs) ModuleOutputs {
+	// Params are updated by the gov module account
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
 	k := keeper.NewKeeper(
 		in.StoreService,
 		in.Cdc,
 		in.AccountKeeper,
+		in.BankKeeper,
+		authority,
 		storage.Config{},
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.Registry)
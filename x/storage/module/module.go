@@ -22,7 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.AppModuleBasic        = AppModuleBasic{}
@@ -148,6 +148,11 @@ func (AppModule) RegisterInterfaces(registrar cdctypes.InterfaceRegistry) {
 func (am AppModule) RegisterServices(configurator module.Configurator) {
 	storagetypes.RegisterMsgServer(configurator.MsgServer(), am.keeper)
 	storagetypes.RegisterQueryServer(configurator.QueryServer(), am.keeper)
+
+	m := keeper.NewMigrator(am.keeper)
+	if err := configurator.RegisterMigration(storage.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", storage.ModuleName, err))
+	}
 }
 
 // RegisterMigrations registers module migrations
@@ -160,7 +165,7 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 
 // EndBlock implements the appmodule.HasEndBlocker interface
 func (am AppModule) EndBlock(ctx context.Context) error {
-	return nil
+	return am.keeper.EndBlocker(sdk.UnwrapSDKContext(ctx))
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the storage module.
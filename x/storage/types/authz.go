@@ -0,0 +1,76 @@
+package types
+
+import (
+	"context"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &StorageWriteAuthorization{}
+
+// NewStorageWriteAuthorization creates a new StorageWriteAuthorization object.
+func NewStorageWriteAuthorization(indexPrefix string, maxBytes uint64, jsonSchema string) *StorageWriteAuthorization {
+	return &StorageWriteAuthorization{
+		IndexPrefix: indexPrefix,
+		MaxBytes:    maxBytes,
+		JsonSchema:  jsonSchema,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a StorageWriteAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgStorageSet{})
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a StorageWriteAuthorization) ValidateBasic() error {
+	if a.JsonSchema != "" {
+		if _, err := ParseJSONSchema(a.JsonSchema); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid json schema: %s", err)
+		}
+	}
+	return nil
+}
+
+// Accept implements Authorization.Accept.
+func (a StorageWriteAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	setMsg, ok := msg.(*MsgStorageSet)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch: expected MsgStorageSet")
+	}
+
+	if !strings.HasPrefix(setMsg.Index, a.IndexPrefix) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("index %s is outside of the authorized prefix %s", setMsg.Index, a.IndexPrefix)
+	}
+
+	if a.JsonSchema != "" {
+		if len(setMsg.Blob) > 0 {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("blobs cannot be set under a json schema")
+		}
+		schema, err := ParseJSONSchema(a.JsonSchema)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid json schema: %s", err)
+		}
+		if err := schema.Validate(setMsg.Data); err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("data does not match the json schema: %s", err)
+		}
+	}
+
+	if a.MaxBytes > 0 {
+		// Writes are counted like the entry size of deposits and quotas
+		written := uint64(len(setMsg.Index) + len(setMsg.Data) + len(setMsg.Blob))
+		if written > a.MaxBytes {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("write of %d bytes exceeds the %d bytes left", written, a.MaxBytes)
+		}
+		if written == a.MaxBytes {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+		a.MaxBytes -= written
+		return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
@@ -6,6 +6,10 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -132,9 +136,83 @@ func (m *EventStorageDelete) GetDeletedIndexes() []string {
 	return nil
 }
 
+// EventStorageExpired is an event emitted when an entry is deleted at its expiry.
+type EventStorageExpired struct {
+	// owner is the address of the storage owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// index is the index of the expired entry
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// expires_at is the unix timestamp the entry expired at
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// refunded is the deposit refunded to the owner
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
+}
+
+func (m *EventStorageExpired) Reset()         { *m = EventStorageExpired{} }
+func (m *EventStorageExpired) String() string { return proto.CompactTextString(m) }
+func (*EventStorageExpired) ProtoMessage()    {}
+func (*EventStorageExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0c08a583139ec9b, []int{2}
+}
+func (m *EventStorageExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStorageExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStorageExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStorageExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStorageExpired.Merge(m, src)
+}
+func (m *EventStorageExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStorageExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStorageExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStorageExpired proto.InternalMessageInfo
+
+func (m *EventStorageExpired) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventStorageExpired) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *EventStorageExpired) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *EventStorageExpired) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EventStorageUpdated)(nil), "dysonprotocol.storage.v1.EventStorageUpdated")
 	proto.RegisterType((*EventStorageDelete)(nil), "dysonprotocol.storage.v1.EventStorageDelete")
+	proto.RegisterType((*EventStorageExpired)(nil), "dysonprotocol.storage.v1.EventStorageExpired")
 }
 
 func init() {
@@ -142,23 +220,33 @@ func init() {
 }
 
 var fileDescriptor_b0c08a583139ec9b = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xa9, 0x2c, 0xce,
-	0xcf, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c,
-	0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x03, 0x4b, 0x09, 0x49,
-	0xa0, 0x28, 0xd3, 0x83, 0x2a, 0xd3, 0x2b, 0x33, 0x94, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f,
-	0x8e, 0x07, 0xcb, 0xe9, 0x43, 0x38, 0x10, 0x4d, 0x4a, 0xae, 0x5c, 0xc2, 0xae, 0x20, 0x43, 0x82,
-	0x21, 0xaa, 0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x53, 0x84, 0x24, 0xb8, 0xd8, 0x13, 0x53, 0x52,
-	0x8a, 0x52, 0x8b, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0x60, 0x5c, 0x21, 0x11, 0x2e,
-	0xd6, 0xcc, 0xbc, 0x94, 0xd4, 0x0a, 0x09, 0x26, 0xb0, 0x38, 0x84, 0xa3, 0x94, 0xcb, 0x25, 0x84,
-	0x6c, 0x8c, 0x4b, 0x6a, 0x4e, 0x6a, 0x49, 0xaa, 0x90, 0x1e, 0x17, 0x6b, 0x7e, 0x79, 0x5e, 0x6a,
-	0x11, 0xc4, 0x0c, 0x27, 0x89, 0x4b, 0x5b, 0x74, 0x45, 0xa0, 0xb6, 0x3b, 0x42, 0x8c, 0x0b, 0x2e,
-	0x29, 0xca, 0xcc, 0x4b, 0x0f, 0x82, 0x28, 0x13, 0x52, 0xe7, 0xe2, 0x4f, 0x01, 0xeb, 0x4c, 0x89,
-	0x07, 0x1b, 0x9b, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x19, 0xc4, 0x07, 0x15, 0xf6, 0x84,
-	0x88, 0x3a, 0x59, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c,
-	0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x22, 0x6a,
-	0x20, 0x24, 0xe7, 0xe7, 0xea, 0x57, 0xc0, 0x43, 0xac, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d,
-	0x2c, 0x6b, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x97, 0x75, 0xde, 0x32, 0x57, 0x01, 0x00, 0x00,
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xb1, 0xae, 0xd3, 0x30,
+	0x14, 0x8d, 0x5f, 0x78, 0x40, 0x8d, 0x04, 0xc2, 0x74, 0xf0, 0x7b, 0x12, 0x79, 0xa1, 0x12, 0x22,
+	0x42, 0x7a, 0xb6, 0x0a, 0x62, 0x62, 0x6a, 0xa1, 0x03, 0x6b, 0x2a, 0x16, 0x96, 0x2a, 0x89, 0x2f,
+	0x21, 0xa2, 0xb1, 0xa3, 0xd8, 0x2d, 0xed, 0x5f, 0xf0, 0x19, 0x88, 0x89, 0x81, 0x8f, 0xe8, 0x58,
+	0x31, 0x31, 0x01, 0x6a, 0x07, 0xf8, 0x0c, 0x14, 0xdb, 0x54, 0x54, 0x62, 0x61, 0x49, 0x72, 0xcf,
+	0x39, 0x3e, 0xe7, 0xe6, 0xfa, 0xe2, 0xfb, 0x62, 0xad, 0x95, 0x6c, 0x5a, 0x65, 0x54, 0xa1, 0xe6,
+	0x5c, 0x1b, 0xd5, 0x66, 0x25, 0xf0, 0xe5, 0x90, 0xc3, 0x12, 0xa4, 0xd1, 0xcc, 0x52, 0x84, 0x1e,
+	0xc9, 0x98, 0x97, 0xb1, 0xe5, 0xf0, 0xbc, 0x5f, 0xaa, 0x52, 0x59, 0x82, 0x77, 0x5f, 0x4e, 0x7f,
+	0x7e, 0x56, 0x28, 0x5d, 0x2b, 0x3d, 0x73, 0x84, 0x2b, 0x3c, 0x75, 0x3b, 0xab, 0x2b, 0xa9, 0xb8,
+	0x7d, 0x7a, 0x28, 0x72, 0x02, 0x9e, 0x67, 0xba, 0x8b, 0xce, 0xc1, 0x64, 0x43, 0x5e, 0xa8, 0x4a,
+	0x3a, 0x7e, 0x30, 0xc1, 0x77, 0x26, 0x5d, 0x37, 0x53, 0x17, 0xfb, 0xb2, 0x11, 0x99, 0x01, 0x41,
+	0x28, 0xbe, 0x96, 0x09, 0xd1, 0x82, 0xd6, 0x14, 0xc5, 0x28, 0xe9, 0xa5, 0x7f, 0x4a, 0xd2, 0xc7,
+	0xa7, 0x95, 0x14, 0xb0, 0xa2, 0x27, 0x16, 0x77, 0xc5, 0xa0, 0xc6, 0xe4, 0x6f, 0x9b, 0xe7, 0x30,
+	0x07, 0x03, 0x84, 0xe1, 0x53, 0xf5, 0x4e, 0x42, 0xeb, 0x3c, 0xc6, 0xf4, 0xcb, 0xe7, 0xcb, 0xbe,
+	0x6f, 0x78, 0xe4, 0xec, 0xa6, 0xa6, 0xad, 0x64, 0x99, 0x3a, 0x19, 0x79, 0x80, 0x6f, 0x09, 0x7b,
+	0x52, 0xcc, 0xac, 0x2d, 0x68, 0x7a, 0x12, 0x87, 0x49, 0x2f, 0xbd, 0xe9, 0xe1, 0x17, 0x0e, 0x1d,
+	0xfc, 0x42, 0xc7, 0x6d, 0x4f, 0x56, 0x4d, 0xd5, 0x82, 0xf8, 0xef, 0xc0, 0x7f, 0xfe, 0x0c, 0xb9,
+	0x8b, 0x31, 0x58, 0x43, 0x3d, 0xcb, 0x0c, 0x0d, 0x63, 0x94, 0x84, 0x69, 0xcf, 0x23, 0x23, 0x43,
+	0xe6, 0xf8, 0x7a, 0x0b, 0xaf, 0x17, 0x52, 0x80, 0xa0, 0x57, 0xe2, 0x30, 0xb9, 0xf1, 0xe8, 0x8c,
+	0xf9, 0x90, 0x6e, 0xca, 0xcc, 0x4f, 0x99, 0x3d, 0x53, 0x95, 0x1c, 0x3f, 0xd9, 0x7c, 0xbb, 0x08,
+	0x3e, 0x7e, 0xbf, 0x48, 0xca, 0xca, 0xbc, 0x59, 0xe4, 0xac, 0x50, 0xb5, 0xbf, 0x33, 0xff, 0xba,
+	0xd4, 0xe2, 0x2d, 0x37, 0xeb, 0x06, 0xb4, 0x3d, 0xa0, 0x3f, 0xfc, 0xfc, 0xf4, 0x10, 0xa5, 0x87,
+	0x84, 0xf1, 0xd3, 0xcd, 0x2e, 0x42, 0xdb, 0x5d, 0x84, 0x7e, 0xec, 0x22, 0xf4, 0x7e, 0x1f, 0x05,
+	0xdb, 0x7d, 0x14, 0x7c, 0xdd, 0x47, 0xc1, 0xab, 0x7b, 0xc7, 0x8b, 0xd3, 0x39, 0xaf, 0x0e, 0x5b,
+	0x66, 0x1d, 0xf3, 0xab, 0x96, 0x7d, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x64, 0x8e, 0xce, 0xa6,
+	0x8b, 0x02, 0x00, 0x00,
 }
 
 func (m *EventStorageUpdated) Marshal() (dAtA []byte, err error) {
@@ -237,6 +325,62 @@ func (m *EventStorageDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventStorageExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStorageExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStorageExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -284,6 +428,32 @@ func (m *EventStorageDelete) Size() (n int) {
 	return n
 }
 
+func (m *EventStorageExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAt))
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -518,6 +688,173 @@ func (m *EventStorageDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventStorageExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStorageExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStorageExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,6 +30,17 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// entries defines the storage entries contained in the GenesisState
 	Entries []Storage `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// params defines the parameters of the storage module
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// uploads defines the chunked uploads in progress
+	Uploads []StorageUpload `protobuf:"bytes,3,rep,name=uploads,proto3" json:"uploads"`
+	// upload_chunks defines the chunks uploaded to the uploads in progress
+	UploadChunks []UploadChunk `protobuf:"bytes,4,rep,name=upload_chunks,json=uploadChunks,proto3" json:"upload_chunks"`
+	// versions defines the retained previous versions of the entries
+	Versions []Storage `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions"`
+	// secondary_indexes defines the secondary indexes of the owners, their
+	// entries are indexed again on import
+	SecondaryIndexes []SecondaryIndex `protobuf:"bytes,6,rep,name=secondary_indexes,json=secondaryIndexes,proto3" json:"secondary_indexes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -64,8 +76,78 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// UploadChunk is a chunk uploaded to a chunked upload in progress
+type UploadChunk struct {
+	Owner      string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Index      string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	ChunkIndex uint32 `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	Data       []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *UploadChunk) Reset()         { *m = UploadChunk{} }
+func (m *UploadChunk) String() string { return proto.CompactTextString(m) }
+func (*UploadChunk) ProtoMessage()    {}
+func (*UploadChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5037ccb412dbd1a7, []int{1}
+}
+func (m *UploadChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UploadChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UploadChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UploadChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadChunk.Merge(m, src)
+}
+func (m *UploadChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *UploadChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UploadChunk proto.InternalMessageInfo
+
+func (m *UploadChunk) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *UploadChunk) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *UploadChunk) GetChunkIndex() uint32 {
+	if m != nil {
+		return m.ChunkIndex
+	}
+	return 0
+}
+
+func (m *UploadChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dysonprotocol.storage.v1.GenesisState")
+	proto.RegisterType((*UploadChunk)(nil), "dysonprotocol.storage.v1.UploadChunk")
 }
 
 func init() {
@@ -73,22 +155,37 @@ func init() {
 }
 
 var fileDescriptor_5037ccb412dbd1a7 = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xa9, 0x2c, 0xce,
-	0xcf, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x4f, 0xce, 0xcf, 0xd1, 0x2f, 0x2e, 0xc9, 0x2f, 0x4a, 0x4c,
-	0x4f, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x03, 0xcb, 0x09,
-	0x49, 0xa0, 0xa8, 0xd3, 0x83, 0xaa, 0xd3, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0x4b, 0xe8, 0x83, 0x58, 0x10, 0xf5, 0x52, 0xf2, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x60,
-	0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e, 0x01, 0x54, 0x01,
-	0x6e, 0x8b, 0x61, 0x66, 0x43, 0xd4, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88,
-	0x90, 0x52, 0x34, 0x17, 0x8f, 0x3b, 0xc4, 0x71, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x8e, 0x5c,
-	0xec, 0xa9, 0x79, 0x25, 0x45, 0x99, 0xa9, 0xc5, 0x12, 0x8c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x8a,
-	0x7a, 0xb8, 0x5c, 0xab, 0x17, 0x0c, 0x61, 0x3a, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0xd3,
-	0x67, 0xc5, 0xd1, 0xb1, 0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x06, 0x27, 0xeb, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x44, 0x35, 0x34, 0x39, 0x3f, 0x57, 0xbf, 0x02,
-	0xee, 0xee, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xac, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0x19, 0x2a, 0x38, 0xdb, 0x56, 0x01, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x8f, 0xe9, 0x9f, 0x6d, 0x6e, 0x27, 0x31, 0xab, 0x07, 0xb3, 0x43, 0x9a, 0x4d, 0x1a, 0xe4,
+	0x42, 0xa2, 0x8d, 0x1b, 0x48, 0x48, 0xeb, 0x0e, 0x13, 0xb7, 0x29, 0x15, 0x17, 0x38, 0x54, 0x5e,
+	0x62, 0x42, 0x44, 0x63, 0x47, 0xfe, 0xdc, 0xb2, 0xbe, 0xc1, 0x8e, 0x3c, 0xc2, 0x1e, 0x82, 0x87,
+	0xd8, 0x71, 0xe2, 0xb4, 0x13, 0x42, 0xed, 0x85, 0xc7, 0x40, 0xb5, 0x9d, 0x41, 0x0f, 0x01, 0x71,
+	0x89, 0x3e, 0x7f, 0xbf, 0x3f, 0xdf, 0xef, 0x73, 0x8c, 0x9f, 0x66, 0x0b, 0x90, 0xa2, 0x52, 0x52,
+	0xcb, 0x54, 0x4e, 0x63, 0xd0, 0x52, 0xb1, 0x9c, 0xc7, 0xf3, 0xe3, 0x38, 0xe7, 0x82, 0x43, 0x01,
+	0x91, 0xc1, 0x08, 0xdd, 0xe0, 0x45, 0x8e, 0x17, 0xcd, 0x8f, 0xf7, 0x07, 0xb9, 0xcc, 0xa5, 0x01,
+	0xe2, 0x75, 0x65, 0xf9, 0xfb, 0x4f, 0x52, 0x09, 0xa5, 0x84, 0x89, 0x05, 0xec, 0xc1, 0x41, 0xc3,
+	0x5c, 0xca, 0x7c, 0xca, 0x63, 0x73, 0xba, 0x9c, 0x7d, 0x88, 0x75, 0x51, 0x72, 0xd0, 0xac, 0xac,
+	0x1c, 0xa1, 0x39, 0x53, 0x3d, 0xd6, 0xf2, 0x8e, 0x1a, 0x79, 0x15, 0x53, 0xac, 0xac, 0xe7, 0xed,
+	0xb1, 0xb2, 0x10, 0x32, 0x36, 0x5f, 0xdb, 0x3a, 0xbc, 0x6f, 0xe1, 0xfe, 0xb9, 0xdd, 0x6f, 0xac,
+	0x99, 0xe6, 0xe4, 0x14, 0x6f, 0x71, 0xa1, 0x55, 0xc1, 0x81, 0xa2, 0xa0, 0x15, 0xf6, 0x4e, 0x0e,
+	0xa2, 0xa6, 0x85, 0xa3, 0xb1, 0x2d, 0x47, 0xed, 0xdb, 0xef, 0x43, 0x2f, 0xa9, 0x75, 0xe4, 0x35,
+	0xee, 0xda, 0xb1, 0xf4, 0x51, 0x80, 0xc2, 0xde, 0x49, 0xd0, 0xec, 0x70, 0x61, 0x78, 0xce, 0xc0,
+	0xa9, 0xc8, 0x39, 0xde, 0x9a, 0x55, 0x53, 0xc9, 0x32, 0xa0, 0x2d, 0x13, 0xe1, 0xd9, 0x3f, 0x23,
+	0xbc, 0x35, 0xfc, 0x3a, 0x88, 0x53, 0x93, 0x0b, 0xbc, 0x6b, 0xcb, 0x49, 0xfa, 0x71, 0x26, 0x3e,
+	0x01, 0x6d, 0x1b, 0xbb, 0xa3, 0x66, 0x3b, 0xeb, 0x73, 0xb6, 0x66, 0x3b, 0xb3, 0xfe, 0xec, 0x77,
+	0x0b, 0xc8, 0x19, 0xde, 0x9e, 0x73, 0x05, 0x85, 0x14, 0x40, 0x3b, 0xff, 0x77, 0x3d, 0x0f, 0x42,
+	0xf2, 0x1e, 0xef, 0x01, 0x4f, 0xa5, 0xc8, 0x98, 0x5a, 0x4c, 0x0a, 0x91, 0xf1, 0x2b, 0x0e, 0xb4,
+	0x6b, 0xdc, 0xc2, 0xbf, 0xb8, 0xd5, 0x92, 0x37, 0x6b, 0x85, 0x33, 0x7d, 0x0c, 0x1b, 0x5d, 0x0e,
+	0x2f, 0xb7, 0xaf, 0x6f, 0x86, 0xde, 0xcf, 0x9b, 0xa1, 0x77, 0x78, 0x8d, 0x70, 0xef, 0x8f, 0x7d,
+	0x48, 0x84, 0x3b, 0xf2, 0xb3, 0xe0, 0x8a, 0xa2, 0x00, 0x85, 0x3b, 0x23, 0xfa, 0xed, 0xeb, 0xf3,
+	0x81, 0x7b, 0x8e, 0xa7, 0x59, 0xa6, 0x38, 0xc0, 0x58, 0xab, 0x42, 0xe4, 0x89, 0xa5, 0x91, 0x01,
+	0xee, 0x98, 0x70, 0xe6, 0x2f, 0xee, 0x24, 0xf6, 0x40, 0x86, 0xb8, 0x67, 0x2e, 0xd3, 0x06, 0xa7,
+	0xad, 0x00, 0x85, 0xbb, 0x09, 0x36, 0x2d, 0x13, 0x81, 0x10, 0xdc, 0xce, 0x98, 0x66, 0xb4, 0x1d,
+	0xa0, 0xb0, 0x9f, 0x98, 0x7a, 0xf4, 0xea, 0x76, 0xe9, 0xa3, 0xbb, 0xa5, 0x8f, 0x7e, 0x2c, 0x7d,
+	0xf4, 0x65, 0xe5, 0x7b, 0x77, 0x2b, 0xdf, 0xbb, 0x5f, 0xf9, 0xde, 0xbb, 0x83, 0xcd, 0x7d, 0x53,
+	0x59, 0xc6, 0x57, 0x0f, 0xef, 0x57, 0x2f, 0x2a, 0x0e, 0x97, 0x5d, 0x83, 0xbe, 0xf8, 0x15, 0x00,
+	0x00, 0xff, 0xff, 0x71, 0xe6, 0xbe, 0xfc, 0xa1, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -111,6 +208,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SecondaryIndexes) > 0 {
+		for iNdEx := len(m.SecondaryIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SecondaryIndexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.UploadChunks) > 0 {
+		for iNdEx := len(m.UploadChunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UploadChunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Uploads) > 0 {
+		for iNdEx := len(m.Uploads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Uploads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -128,6 +291,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UploadChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UploadChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UploadChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -151,6 +363,56 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Uploads) > 0 {
+		for _, e := range m.Uploads {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UploadChunks) > 0 {
+		for _, e := range m.UploadChunks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SecondaryIndexes) > 0 {
+		for _, e := range m.SecondaryIndexes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UploadChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovGenesis(uint64(m.ChunkIndex))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -223,6 +485,342 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploads = append(m.Uploads, StorageUpload{})
+			if err := m.Uploads[len(m.Uploads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadChunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UploadChunks = append(m.UploadChunks, UploadChunk{})
+			if err := m.UploadChunks[len(m.UploadChunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, Storage{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryIndexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryIndexes = append(m.SecondaryIndexes, SecondaryIndex{})
+			if err := m.SecondaryIndexes[len(m.SecondaryIndexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UploadChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UploadChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UploadChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
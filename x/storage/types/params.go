@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDepositDenom is the default denom of the per-byte deposit
+const DefaultDepositDenom = "dys"
+
+// DefaultMaxEntrySize is the default maximum size of a single entry (1 MiB)
+const DefaultMaxEntrySize = uint64(1 << 20)
+
+// DefaultMaxBytesPerOwner is the default maximum total size of the entries of an owner, 0 for no quota
+const DefaultMaxBytesPerOwner = uint64(0)
+
+// DefaultMaxEntriesPerOwner is the default maximum number of entries of an owner, 0 for no quota
+const DefaultMaxEntriesPerOwner = uint64(0)
+
+// NewParams creates a new Params instance with given values
+func NewParams(
+	depositPerByte sdk.Coin,
+	maxEntrySize uint64,
+	maxBytesPerOwner uint64,
+	maxEntriesPerOwner uint64,
+) Params {
+	return Params{
+		DepositPerByte:     depositPerByte,
+		MaxEntrySize:       maxEntrySize,
+		MaxBytesPerOwner:   maxBytesPerOwner,
+		MaxEntriesPerOwner: maxEntriesPerOwner,
+	}
+}
+
+// DefaultParams returns a default set of parameters. Deposits are disabled until governance
+// sets a deposit per byte.
+func DefaultParams() Params {
+	return NewParams(
+		sdk.NewCoin(DefaultDepositDenom, sdkmath.ZeroInt()),
+		DefaultMaxEntrySize,
+		DefaultMaxBytesPerOwner,
+		DefaultMaxEntriesPerOwner,
+	)
+}
+
+// Validate validates the params
+func (p Params) Validate() error {
+	if p.DepositPerByte.Amount.IsNil() || !p.DepositPerByte.IsValid() {
+		return fmt.Errorf("invalid deposit per byte: %s", p.DepositPerByte)
+	}
+
+	if p.MaxEntrySize == 0 {
+		return fmt.Errorf("max entry size must be positive")
+	}
+
+	if p.MaxBytesPerOwner != 0 && p.MaxBytesPerOwner < p.MaxEntrySize {
+		return fmt.Errorf("max bytes per owner %d is lower than the max entry size %d", p.MaxBytesPerOwner, p.MaxEntrySize)
+	}
+
+	return nil
+}
+
+// RequiredDeposit returns the deposit held for an entry of a size
+func (p Params) RequiredDeposit(size uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(p.DepositPerByte.Denom, p.DepositPerByte.Amount.Mul(sdkmath.NewIntFromUint64(size))))
+}
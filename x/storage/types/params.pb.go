@@ -0,0 +1,450 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dysonprotocol/storage/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the storage module.
+type Params struct {
+	// deposit_per_byte is the deposit locked in the module account for every
+	// byte of an entry (index and data) while it is stored. It is refunded to
+	// the owner when the entry shrinks or is deleted. A zero amount disables
+	// deposits.
+	DepositPerByte types.Coin `protobuf:"bytes,1,opt,name=deposit_per_byte,json=depositPerByte,proto3" json:"deposit_per_byte" yaml:"deposit_per_byte"`
+	// max_entry_size is the maximum size in bytes of a single entry, index and
+	// data included.
+	MaxEntrySize uint64 `protobuf:"varint,2,opt,name=max_entry_size,json=maxEntrySize,proto3" json:"max_entry_size,omitempty" yaml:"max_entry_size"`
+	// max_bytes_per_owner is the maximum total size in bytes of the entries of
+	// a single owner. Zero means no quota.
+	MaxBytesPerOwner uint64 `protobuf:"varint,3,opt,name=max_bytes_per_owner,json=maxBytesPerOwner,proto3" json:"max_bytes_per_owner,omitempty" yaml:"max_bytes_per_owner"`
+	// max_entries_per_owner is the maximum number of entries of a single owner.
+	// Zero means no quota.
+	MaxEntriesPerOwner uint64 `protobuf:"varint,4,opt,name=max_entries_per_owner,json=maxEntriesPerOwner,proto3" json:"max_entries_per_owner,omitempty" yaml:"max_entries_per_owner"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aa3a4bfed2284591, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDepositPerByte() types.Coin {
+	if m != nil {
+		return m.DepositPerByte
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxEntrySize() uint64 {
+	if m != nil {
+		return m.MaxEntrySize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBytesPerOwner() uint64 {
+	if m != nil {
+		return m.MaxBytesPerOwner
+	}
+	return 0
+}
+
+func (m *Params) GetMaxEntriesPerOwner() uint64 {
+	if m != nil {
+		return m.MaxEntriesPerOwner
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "dysonprotocol.storage.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("dysonprotocol/storage/v1/params.proto", fileDescriptor_aa3a4bfed2284591)
+}
+
+var fileDescriptor_aa3a4bfed2284591 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x31, 0x6b, 0xe3, 0x30,
+	0x00, 0x85, 0xed, 0x5c, 0xc8, 0xe0, 0x3b, 0x42, 0xce, 0x77, 0xe1, 0x9c, 0x70, 0xc8, 0x39, 0x73,
+	0x07, 0xe1, 0x06, 0x8b, 0xdc, 0x6d, 0xed, 0x50, 0x70, 0xe9, 0x58, 0x1a, 0x92, 0xad, 0x8b, 0x91,
+	0x1d, 0x11, 0x04, 0x91, 0x65, 0x24, 0x91, 0xda, 0xf9, 0x15, 0xfd, 0x19, 0x1d, 0xfb, 0x33, 0x32,
+	0x66, 0xcc, 0x64, 0x4a, 0x32, 0x74, 0xf7, 0x2f, 0x28, 0x92, 0xdd, 0x06, 0x67, 0x31, 0xd6, 0x7b,
+	0x8f, 0xef, 0x3d, 0x90, 0xac, 0x3f, 0x8b, 0x5c, 0xb0, 0x24, 0xe5, 0x4c, 0xb2, 0x98, 0xad, 0xa0,
+	0x90, 0x8c, 0xa3, 0x25, 0x86, 0xeb, 0x09, 0x4c, 0x11, 0x47, 0x54, 0xf8, 0xda, 0xb2, 0x9d, 0x46,
+	0xcc, 0xaf, 0x63, 0xfe, 0x7a, 0x32, 0xfc, 0xbe, 0x64, 0x4b, 0xa6, 0x0d, 0xa8, 0xfe, 0xaa, 0xfc,
+	0xf0, 0x2b, 0xa2, 0x24, 0x61, 0x50, 0x7f, 0x6b, 0x09, 0xc4, 0x4c, 0x50, 0x26, 0x60, 0x84, 0x84,
+	0xe2, 0x47, 0x58, 0xa2, 0x09, 0x8c, 0x19, 0x49, 0x2a, 0xdf, 0xdb, 0xb7, 0xac, 0xce, 0x54, 0x77,
+	0xda, 0xc4, 0xea, 0x2d, 0x70, 0xca, 0x04, 0x91, 0x61, 0x8a, 0x79, 0x18, 0xe5, 0x12, 0x3b, 0xe6,
+	0xc8, 0x1c, 0x7f, 0xfe, 0x37, 0xf0, 0x2b, 0x8a, 0xaf, 0x28, 0x7e, 0x4d, 0xf1, 0xaf, 0x19, 0x49,
+	0x82, 0xdf, 0xdb, 0xc2, 0x35, 0xca, 0xc2, 0xfd, 0x91, 0x23, 0xba, 0xba, 0xf0, 0xce, 0x01, 0xde,
+	0xd3, 0xeb, 0xf3, 0x5f, 0x73, 0xd6, 0xad, 0xf5, 0x29, 0xe6, 0x41, 0x2e, 0xb1, 0x7d, 0x65, 0x75,
+	0x29, 0xca, 0x42, 0x9c, 0x48, 0x9e, 0x87, 0x82, 0x6c, 0xb0, 0xd3, 0x1a, 0x99, 0xe3, 0x76, 0x30,
+	0x28, 0x0b, 0xb7, 0x5f, 0x91, 0x9a, 0xbe, 0x37, 0xfb, 0x42, 0x51, 0x76, 0xa3, 0xce, 0x73, 0xb2,
+	0xc1, 0xf6, 0xad, 0xf5, 0x4d, 0x05, 0x54, 0x85, 0xd0, 0x65, 0xec, 0x21, 0xc1, 0xdc, 0xf9, 0xa4,
+	0x29, 0xa0, 0x2c, 0xdc, 0xe1, 0x89, 0x72, 0x16, 0xf2, 0x66, 0x3d, 0x8a, 0x32, 0x35, 0x43, 0x4c,
+	0x31, 0xbf, 0x53, 0x92, 0x3d, 0xb7, 0xfa, 0xef, 0x7d, 0xa4, 0x01, 0x6c, 0x6b, 0xe0, 0xa8, 0x2c,
+	0xdc, 0x9f, 0xcd, 0x59, 0xa4, 0x89, 0xb4, 0xeb, 0x75, 0xe4, 0x04, 0x0d, 0x2e, 0xb7, 0x07, 0x60,
+	0xee, 0x0e, 0xc0, 0x7c, 0x39, 0x00, 0xf3, 0xf1, 0x08, 0x8c, 0xdd, 0x11, 0x18, 0xfb, 0x23, 0x30,
+	0xee, 0x7f, 0x35, 0xef, 0x35, 0x66, 0x14, 0x66, 0x1f, 0x8f, 0x40, 0xe6, 0x29, 0x16, 0x51, 0x47,
+	0xbb, 0xff, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa2, 0x0b, 0x75, 0x2a, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEntriesPerOwner != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEntriesPerOwner))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBytesPerOwner != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBytesPerOwner))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxEntrySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxEntrySize))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.DepositPerByte.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositPerByte.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxEntrySize != 0 {
+		n += 1 + sovParams(uint64(m.MaxEntrySize))
+	}
+	if m.MaxBytesPerOwner != 0 {
+		n += 1 + sovParams(uint64(m.MaxBytesPerOwner))
+	}
+	if m.MaxEntriesPerOwner != 0 {
+		n += 1 + sovParams(uint64(m.MaxEntriesPerOwner))
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositPerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntrySize", wireType)
+			}
+			m.MaxEntrySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntrySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBytesPerOwner", wireType)
+			}
+			m.MaxBytesPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBytesPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEntriesPerOwner", wireType)
+			}
+			m.MaxEntriesPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEntriesPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -39,6 +39,12 @@ type QueryStorageGetRequest struct {
 	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 	// The gjson path to extract from the storage entry.
 	Extract string `protobuf:"bytes,3,opt,name=extract,proto3" json:"extract,omitempty"`
+	// The optional offset in bytes of the range of the content to return.
+	Offset uint64 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The optional length in bytes of the range of the content to return, 0 to
+	// read until the end. The range applies to the blob of a binary entry and to
+	// the data otherwise, and cannot be combined with extract.
+	Length uint64 `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
 }
 
 func (m *QueryStorageGetRequest) Reset()         { *m = QueryStorageGetRequest{} }
@@ -95,8 +101,24 @@ func (m *QueryStorageGetRequest) GetExtract() string {
 	return ""
 }
 
+func (m *QueryStorageGetRequest) GetOffset() uint64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+func (m *QueryStorageGetRequest) GetLength() uint64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
 type QueryStorageGetResponse struct {
 	Entry *Storage `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// The size in bytes of the whole content of the entry, before any range.
+	Size_ uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
 func (m *QueryStorageGetResponse) Reset()         { *m = QueryStorageGetResponse{} }
@@ -139,6 +161,13 @@ func (m *QueryStorageGetResponse) GetEntry() *Storage {
 	return nil
 }
 
+func (m *QueryStorageGetResponse) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
 type QueryStorageListRequest struct {
 	// The address of the owner of the storage entry.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -276,470 +305,3304 @@ func (m *QueryStorageListResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryStorageGetRequest)(nil), "dysonprotocol.storage.v1.QueryStorageGetRequest")
-	proto.RegisterType((*QueryStorageGetResponse)(nil), "dysonprotocol.storage.v1.QueryStorageGetResponse")
-	proto.RegisterType((*QueryStorageListRequest)(nil), "dysonprotocol.storage.v1.QueryStorageListRequest")
-	proto.RegisterType((*QueryStorageListResponse)(nil), "dysonprotocol.storage.v1.QueryStorageListResponse")
+type QueryStorageUsageRequest struct {
+	// The address of the owner of the storage entries.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dysonprotocol/storage/v1/query.proto", fileDescriptor_ad09eeace4391645)
+func (m *QueryStorageUsageRequest) Reset()         { *m = QueryStorageUsageRequest{} }
+func (m *QueryStorageUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageUsageRequest) ProtoMessage()    {}
+func (*QueryStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{4}
 }
-
-var fileDescriptor_ad09eeace4391645 = []byte{
-	// 523 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0x13, 0x31,
-	0x10, 0x8d, 0x53, 0xd2, 0x0a, 0x87, 0x0b, 0x56, 0x54, 0x96, 0x08, 0xad, 0x9a, 0x08, 0xd2, 0x0a,
-	0xa9, 0x36, 0x1b, 0x0e, 0x1c, 0x7a, 0xa2, 0x07, 0x7a, 0xe1, 0x50, 0xb6, 0x37, 0x2e, 0xd1, 0x26,
-	0x99, 0xae, 0x2c, 0x25, 0xf6, 0xd6, 0x76, 0x43, 0x72, 0xe5, 0x0b, 0x90, 0xf8, 0x81, 0x1e, 0xf8,
-	0x04, 0x3e, 0x82, 0x63, 0x81, 0x0b, 0x47, 0x94, 0x20, 0xbe, 0x03, 0xad, 0xed, 0xd0, 0x5d, 0x95,
-	0x28, 0x2d, 0x97, 0xd5, 0xce, 0xcc, 0x1b, 0xbf, 0xf7, 0xc6, 0x63, 0xfc, 0x78, 0x38, 0xd3, 0x52,
-	0x64, 0x4a, 0x1a, 0x39, 0x90, 0x23, 0xa6, 0x8d, 0x54, 0x49, 0x0a, 0x6c, 0x12, 0xb1, 0xb3, 0x73,
-	0x50, 0x33, 0x6a, 0x2b, 0x24, 0x28, 0xa1, 0xa8, 0x47, 0xd1, 0x49, 0xd4, 0x6c, 0xa4, 0x32, 0x95,
-	0xb6, 0xc0, 0xf2, 0x3f, 0x87, 0x6f, 0x3e, 0x4a, 0xa5, 0x4c, 0x47, 0xc0, 0x92, 0x8c, 0xb3, 0x44,
-	0x08, 0x69, 0x12, 0xc3, 0xa5, 0xd0, 0xbe, 0xfa, 0x74, 0x20, 0xf5, 0x58, 0x6a, 0xd6, 0x4f, 0x34,
-	0x38, 0x1a, 0x36, 0x89, 0xfa, 0x60, 0x92, 0x88, 0x65, 0x49, 0xca, 0x85, 0x05, 0x7b, 0xec, 0x43,
-	0x87, 0xed, 0x39, 0x0a, 0x17, 0xf8, 0xd2, 0xfd, 0x64, 0xcc, 0x85, 0x64, 0xf6, 0xeb, 0x53, 0x9d,
-	0x95, 0x6e, 0x96, 0x92, 0x6d, 0xad, 0x3d, 0xc5, 0xdb, 0x6f, 0x72, 0xde, 0x13, 0x97, 0x3d, 0x02,
-	0x13, 0xc3, 0xd9, 0x39, 0x68, 0x43, 0x28, 0xae, 0xc9, 0x77, 0x02, 0x54, 0x80, 0x76, 0xd0, 0xde,
-	0xdd, 0xc3, 0xe0, 0xdb, 0xe7, 0xfd, 0x86, 0x67, 0x7d, 0x39, 0x1c, 0x2a, 0xd0, 0xfa, 0xc4, 0x28,
-	0x2e, 0xd2, 0xd8, 0xc1, 0x48, 0x03, 0xd7, 0xb8, 0x18, 0xc2, 0x34, 0xa8, 0xe6, 0xf8, 0xd8, 0x05,
-	0x24, 0xc0, 0x5b, 0x30, 0x35, 0x2a, 0x19, 0x98, 0x60, 0xc3, 0xe6, 0x97, 0x61, 0x3b, 0xc6, 0x0f,
-	0xae, 0x31, 0xeb, 0x4c, 0x0a, 0x0d, 0xe4, 0x05, 0xae, 0x81, 0x30, 0x6a, 0x66, 0xa9, 0xeb, 0xdd,
-	0x16, 0x5d, 0x35, 0x74, 0xea, 0x9b, 0x63, 0x87, 0x6f, 0xff, 0x46, 0xe5, 0x43, 0x5f, 0x73, 0xfd,
-	0xdf, 0x7e, 0x5a, 0xf8, 0x9e, 0xb5, 0xd0, 0xcb, 0x14, 0x9c, 0xf2, 0xa5, 0xad, 0xba, 0xcd, 0x1d,
-	0xdb, 0x14, 0xd9, 0xc6, 0x9b, 0xa7, 0x7c, 0x64, 0x40, 0x79, 0x6f, 0x3e, 0x2a, 0x9a, 0xbe, 0x53,
-	0x32, 0x4d, 0x5e, 0x61, 0x7c, 0x75, 0xb1, 0x41, 0xcd, 0xda, 0xeb, 0x50, 0x2f, 0x23, 0xdf, 0x02,
-	0xea, 0x96, 0xcd, 0x6f, 0x01, 0x3d, 0xce, 0xcd, 0x39, 0x03, 0x71, 0xa1, 0xb3, 0x7d, 0x81, 0x70,
-	0x70, 0xdd, 0xa8, 0x1f, 0xdf, 0x01, 0xde, 0xca, 0xc7, 0xc1, 0x41, 0x07, 0x68, 0x67, 0xe3, 0x66,
-	0x03, 0x5c, 0x76, 0x90, 0xa3, 0x92, 0xc2, 0xaa, 0x55, 0xb8, 0xbb, 0x56, 0xa1, 0x63, 0x2e, 0x4a,
-	0xec, 0x7e, 0xad, 0xe2, 0x9a, 0x95, 0x48, 0x2e, 0x10, 0xc6, 0x57, 0xb7, 0x4c, 0x9e, 0xad, 0x56,
-	0xf3, 0xef, 0x55, 0x6c, 0x46, 0xb7, 0xe8, 0x70, 0x4a, 0xda, 0xfb, 0xef, 0xbf, 0xff, 0xfa, 0x58,
-	0xdd, 0x25, 0x4f, 0xd8, 0xba, 0x87, 0xd0, 0x4b, 0xc1, 0x90, 0x4f, 0x08, 0xd7, 0x0b, 0xa3, 0x24,
-	0x37, 0x64, 0x2c, 0xec, 0x57, 0xb3, 0x7b, 0x9b, 0x16, 0xaf, 0x92, 0x5a, 0x95, 0x7b, 0xa4, 0xb3,
-	0x5e, 0xe5, 0x88, 0x6b, 0x73, 0x78, 0xf0, 0x65, 0x1e, 0xa2, 0xcb, 0x79, 0x88, 0x7e, 0xce, 0x43,
-	0xf4, 0x61, 0x11, 0x56, 0x2e, 0x17, 0x61, 0xe5, 0xc7, 0x22, 0xac, 0xbc, 0x6d, 0x95, 0xc9, 0x07,
-	0x72, 0xcc, 0xa6, 0x7f, 0x8f, 0x31, 0xb3, 0x0c, 0x74, 0x7f, 0xd3, 0x56, 0x9f, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xee, 0x31, 0xe2, 0xa4, 0xe9, 0x04, 0x00, 0x00,
+func (m *QueryStorageUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageUsageRequest.Merge(m, src)
+}
+func (m *QueryStorageUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageUsageRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryStorageUsageRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Gets the stored data for the given owner and index.
-	StorageGet(ctx context.Context, in *QueryStorageGetRequest, opts ...grpc.CallOption) (*QueryStorageGetResponse, error)
-	// Lists all storage entries for the owner under a given index prefix. Use
-	// filter and extract to filter and extract the data and iterate over the data
-	// efficiently.
-	StorageList(ctx context.Context, in *QueryStorageListRequest, opts ...grpc.CallOption) (*QueryStorageListResponse, error)
+func (m *QueryStorageUsageRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryStorageUsageResponse struct {
+	Usage OwnerUsage `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryStorageUsageResponse) Reset()         { *m = QueryStorageUsageResponse{} }
+func (m *QueryStorageUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageUsageResponse) ProtoMessage()    {}
+func (*QueryStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{5}
 }
-
-func (c *queryClient) StorageGet(ctx context.Context, in *QueryStorageGetRequest, opts ...grpc.CallOption) (*QueryStorageGetResponse, error) {
-	out := new(QueryStorageGetResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageGet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryStorageUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryStorageUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageUsageResponse.Merge(m, src)
+}
+func (m *QueryStorageUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageUsageResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) StorageList(ctx context.Context, in *QueryStorageListRequest, opts ...grpc.CallOption) (*QueryStorageListResponse, error) {
-	out := new(QueryStorageListResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStorageUsageResponse proto.InternalMessageInfo
+
+func (m *QueryStorageUsageResponse) GetUsage() OwnerUsage {
+	if m != nil {
+		return m.Usage
 	}
-	return out, nil
+	return OwnerUsage{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Gets the stored data for the given owner and index.
-	StorageGet(context.Context, *QueryStorageGetRequest) (*QueryStorageGetResponse, error)
-	// Lists all storage entries for the owner under a given index prefix. Use
-	// filter and extract to filter and extract the data and iterate over the data
-	// efficiently.
-	StorageList(context.Context, *QueryStorageListRequest) (*QueryStorageListResponse, error)
+type QueryStorageUploadRequest struct {
+	// The address of the owner of the upload.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The index of the storage entry being uploaded.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryStorageUploadRequest) Reset()         { *m = QueryStorageUploadRequest{} }
+func (m *QueryStorageUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageUploadRequest) ProtoMessage()    {}
+func (*QueryStorageUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{6}
 }
-
-func (*UnimplementedQueryServer) StorageGet(ctx context.Context, req *QueryStorageGetRequest) (*QueryStorageGetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageGet not implemented")
+func (m *QueryStorageUploadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) StorageList(ctx context.Context, req *QueryStorageListRequest) (*QueryStorageListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageList not implemented")
+func (m *QueryStorageUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageUploadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryStorageUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageUploadRequest.Merge(m, src)
+}
+func (m *QueryStorageUploadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageUploadRequest.DiscardUnknown(m)
 }
 
-func _Query_StorageGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageGetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorageGet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.storage.v1.Query/StorageGet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorageGet(ctx, req.(*QueryStorageGetRequest))
+var xxx_messageInfo_QueryStorageUploadRequest proto.InternalMessageInfo
+
+func (m *QueryStorageUploadRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_StorageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStorageListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StorageList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.storage.v1.Query/StorageList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StorageList(ctx, req.(*QueryStorageListRequest))
+func (m *QueryStorageUploadRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dysonprotocol.storage.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StorageGet",
-			Handler:    _Query_StorageGet_Handler,
-		},
-		{
-			MethodName: "StorageList",
-			Handler:    _Query_StorageList_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dysonprotocol/storage/v1/query.proto",
+type QueryStorageUploadResponse struct {
+	Upload StorageUpload `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload"`
+	// The 0-based positions of the chunks not uploaded yet.
+	MissingChunks []uint32 `protobuf:"varint,2,rep,packed,name=missing_chunks,json=missingChunks,proto3" json:"missing_chunks,omitempty"`
 }
 
-func (m *QueryStorageGetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStorageUploadResponse) Reset()         { *m = QueryStorageUploadResponse{} }
+func (m *QueryStorageUploadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageUploadResponse) ProtoMessage()    {}
+func (*QueryStorageUploadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{7}
+}
+func (m *QueryStorageUploadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageUploadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageUploadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryStorageGetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStorageUploadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageUploadResponse.Merge(m, src)
+}
+func (m *QueryStorageUploadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageUploadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageUploadResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStorageGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Extract) > 0 {
-		i -= len(m.Extract)
-		copy(dAtA[i:], m.Extract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Extract)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryStorageUploadResponse proto.InternalMessageInfo
+
+func (m *QueryStorageUploadResponse) GetUpload() StorageUpload {
+	if m != nil {
+		return m.Upload
 	}
-	return len(dAtA) - i, nil
+	return StorageUpload{}
 }
 
-func (m *QueryStorageGetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryStorageUploadResponse) GetMissingChunks() []uint32 {
+	if m != nil {
+		return m.MissingChunks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryStorageGetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryStorageHistoryRequest struct {
+	// The address of the owner of the storage entry.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The index of the storage entry.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// The pagination request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryStorageGetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Entry != nil {
-		{
-			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryStorageHistoryRequest) Reset()         { *m = QueryStorageHistoryRequest{} }
+func (m *QueryStorageHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageHistoryRequest) ProtoMessage()    {}
+func (*QueryStorageHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{8}
+}
+func (m *QueryStorageHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStorageHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageHistoryRequest.Merge(m, src)
+}
+func (m *QueryStorageHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryStorageListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryStorageHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryStorageHistoryRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryStorageListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryStorageHistoryRequest) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func (m *QueryStorageListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryStorageHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryStorageHistoryResponse struct {
+	// The retained previous versions of the entry.
+	Versions   []Storage           `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageHistoryResponse) Reset()         { *m = QueryStorageHistoryResponse{} }
+func (m *QueryStorageHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageHistoryResponse) ProtoMessage()    {}
+func (*QueryStorageHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{9}
+}
+func (m *QueryStorageHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	if len(m.Extract) > 0 {
-		i -= len(m.Extract)
-		copy(dAtA[i:], m.Extract)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Extract)))
-		i--
-		dAtA[i] = 0x22
+}
+func (m *QueryStorageHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageHistoryResponse.Merge(m, src)
+}
+func (m *QueryStorageHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryStorageHistoryResponse) GetVersions() []Storage {
+	if m != nil {
+		return m.Versions
 	}
-	if len(m.Filter) > 0 {
-		i -= len(m.Filter)
-		copy(dAtA[i:], m.Filter)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Filter)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *QueryStorageHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	if len(m.IndexPrefix) > 0 {
-		i -= len(m.IndexPrefix)
-		copy(dAtA[i:], m.IndexPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IndexPrefix)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+type QueryStorageSecondaryIndexesRequest struct {
+	// The address of the owner of the indexes.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryStorageSecondaryIndexesRequest) Reset()         { *m = QueryStorageSecondaryIndexesRequest{} }
+func (m *QueryStorageSecondaryIndexesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageSecondaryIndexesRequest) ProtoMessage()    {}
+func (*QueryStorageSecondaryIndexesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{10}
+}
+func (m *QueryStorageSecondaryIndexesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageSecondaryIndexesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageSecondaryIndexesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryStorageSecondaryIndexesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageSecondaryIndexesRequest.Merge(m, src)
+}
+func (m *QueryStorageSecondaryIndexesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageSecondaryIndexesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageSecondaryIndexesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageSecondaryIndexesRequest proto.InternalMessageInfo
+
+func (m *QueryStorageSecondaryIndexesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryStorageListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type QueryStorageSecondaryIndexesResponse struct {
+	Indexes []SecondaryIndex `protobuf:"bytes,1,rep,name=indexes,proto3" json:"indexes"`
+}
+
+func (m *QueryStorageSecondaryIndexesResponse) Reset()         { *m = QueryStorageSecondaryIndexesResponse{} }
+func (m *QueryStorageSecondaryIndexesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageSecondaryIndexesResponse) ProtoMessage()    {}
+func (*QueryStorageSecondaryIndexesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{11}
+}
+func (m *QueryStorageSecondaryIndexesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageSecondaryIndexesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageSecondaryIndexesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryStorageSecondaryIndexesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageSecondaryIndexesResponse.Merge(m, src)
+}
+func (m *QueryStorageSecondaryIndexesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageSecondaryIndexesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageSecondaryIndexesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStorageListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryStorageSecondaryIndexesResponse proto.InternalMessageInfo
+
+func (m *QueryStorageSecondaryIndexesResponse) GetIndexes() []SecondaryIndex {
+	if m != nil {
+		return m.Indexes
+	}
+	return nil
 }
 
-func (m *QueryStorageListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryStorageFindRequest struct {
+	// The address of the owner of the entries.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The name of the secondary index.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The JSON encoded value to match exactly, for example "\"books\"" or "42".
+	// A value that is not valid JSON is matched as a string.
+	Value string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The JSON encoded lower bound of a range of values, inclusive. Cannot be
+	// combined with value.
+	Min string `protobuf:"bytes,4,opt,name=min,proto3" json:"min,omitempty"`
+	// The JSON encoded upper bound of a range of values, exclusive. Cannot be
+	// combined with value.
+	Max string `protobuf:"bytes,5,opt,name=max,proto3" json:"max,omitempty"`
+	// The pagination request. Only the key, the limit and reverse are
+	// supported.
+	Pagination *query.PageRequest `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageFindRequest) Reset()         { *m = QueryStorageFindRequest{} }
+func (m *QueryStorageFindRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageFindRequest) ProtoMessage()    {}
+func (*QueryStorageFindRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{12}
+}
+func (m *QueryStorageFindRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageFindRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageFindRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryStorageFindRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageFindRequest.Merge(m, src)
+}
+func (m *QueryStorageFindRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageFindRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageFindRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageFindRequest proto.InternalMessageInfo
+
+func (m *QueryStorageFindRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryStorageFindRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryStorageFindRequest) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *QueryStorageFindRequest) GetMin() string {
+	if m != nil {
+		return m.Min
+	}
+	return ""
+}
+
+func (m *QueryStorageFindRequest) GetMax() string {
+	if m != nil {
+		return m.Max
+	}
+	return ""
+}
+
+func (m *QueryStorageFindRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryStorageFindResponse struct {
+	Entries    []Storage           `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStorageFindResponse) Reset()         { *m = QueryStorageFindResponse{} }
+func (m *QueryStorageFindResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStorageFindResponse) ProtoMessage()    {}
+func (*QueryStorageFindResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{13}
+}
+func (m *QueryStorageFindResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStorageFindResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStorageFindResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStorageFindResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStorageFindResponse.Merge(m, src)
+}
+func (m *QueryStorageFindResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStorageFindResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStorageFindResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStorageFindResponse proto.InternalMessageInfo
+
+func (m *QueryStorageFindResponse) GetEntries() []Storage {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryStorageFindResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{14}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad09eeace4391645, []int{15}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QueryStorageGetRequest)(nil), "dysonprotocol.storage.v1.QueryStorageGetRequest")
+	proto.RegisterType((*QueryStorageGetResponse)(nil), "dysonprotocol.storage.v1.QueryStorageGetResponse")
+	proto.RegisterType((*QueryStorageListRequest)(nil), "dysonprotocol.storage.v1.QueryStorageListRequest")
+	proto.RegisterType((*QueryStorageListResponse)(nil), "dysonprotocol.storage.v1.QueryStorageListResponse")
+	proto.RegisterType((*QueryStorageUsageRequest)(nil), "dysonprotocol.storage.v1.QueryStorageUsageRequest")
+	proto.RegisterType((*QueryStorageUsageResponse)(nil), "dysonprotocol.storage.v1.QueryStorageUsageResponse")
+	proto.RegisterType((*QueryStorageUploadRequest)(nil), "dysonprotocol.storage.v1.QueryStorageUploadRequest")
+	proto.RegisterType((*QueryStorageUploadResponse)(nil), "dysonprotocol.storage.v1.QueryStorageUploadResponse")
+	proto.RegisterType((*QueryStorageHistoryRequest)(nil), "dysonprotocol.storage.v1.QueryStorageHistoryRequest")
+	proto.RegisterType((*QueryStorageHistoryResponse)(nil), "dysonprotocol.storage.v1.QueryStorageHistoryResponse")
+	proto.RegisterType((*QueryStorageSecondaryIndexesRequest)(nil), "dysonprotocol.storage.v1.QueryStorageSecondaryIndexesRequest")
+	proto.RegisterType((*QueryStorageSecondaryIndexesResponse)(nil), "dysonprotocol.storage.v1.QueryStorageSecondaryIndexesResponse")
+	proto.RegisterType((*QueryStorageFindRequest)(nil), "dysonprotocol.storage.v1.QueryStorageFindRequest")
+	proto.RegisterType((*QueryStorageFindResponse)(nil), "dysonprotocol.storage.v1.QueryStorageFindResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "dysonprotocol.storage.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dysonprotocol.storage.v1.QueryParamsResponse")
+}
+
+func init() {
+	proto.RegisterFile("dysonprotocol/storage/v1/query.proto", fileDescriptor_ad09eeace4391645)
+}
+
+var fileDescriptor_ad09eeace4391645 = []byte{
+	// 1049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xc0, 0x33, 0x89, 0xed, 0xc0, 0x4b, 0x53, 0xc1, 0x10, 0xb5, 0xdb, 0x05, 0x19, 0x67, 0xc9,
+	0x1f, 0xb7, 0x90, 0xdd, 0x3a, 0xa1, 0xe2, 0x10, 0x51, 0xd1, 0x54, 0xb4, 0x05, 0x21, 0x11, 0x1c,
+	0xe5, 0x82, 0x84, 0xac, 0x8d, 0x3d, 0xde, 0xac, 0xb0, 0x67, 0xdc, 0x9d, 0xb5, 0x89, 0x39, 0x72,
+	0xe4, 0x02, 0x12, 0x5f, 0xa0, 0x07, 0x84, 0x2a, 0xe0, 0xc0, 0x81, 0x0f, 0xd1, 0x63, 0x05, 0x42,
+	0xe2, 0x04, 0x28, 0x41, 0x5c, 0xf9, 0x0a, 0xd5, 0xcc, 0x3c, 0x37, 0xbb, 0x6e, 0x5c, 0xaf, 0xad,
+	0x5c, 0xa2, 0x9d, 0xb7, 0xef, 0xcf, 0xef, 0x3d, 0xbf, 0x79, 0x6f, 0x03, 0x2b, 0x8d, 0xbe, 0x14,
+	0xbc, 0x13, 0x89, 0x58, 0xd4, 0x45, 0xcb, 0x93, 0xb1, 0x88, 0xfc, 0x80, 0x79, 0xbd, 0x8a, 0x77,
+	0xbf, 0xcb, 0xa2, 0xbe, 0xab, 0xdf, 0x50, 0x2b, 0xa5, 0xe5, 0xa2, 0x96, 0xdb, 0xab, 0xd8, 0x4b,
+	0x81, 0x08, 0x84, 0x7e, 0xe1, 0xa9, 0x27, 0xa3, 0x6f, 0xbf, 0x16, 0x08, 0x11, 0xb4, 0x98, 0xe7,
+	0x77, 0x42, 0xcf, 0xe7, 0x5c, 0xc4, 0x7e, 0x1c, 0x0a, 0x2e, 0xf1, 0xed, 0xb5, 0xba, 0x90, 0x6d,
+	0x21, 0xbd, 0x03, 0x5f, 0x32, 0x13, 0xc6, 0xeb, 0x55, 0x0e, 0x58, 0xec, 0x57, 0xbc, 0x8e, 0x1f,
+	0x84, 0x5c, 0x2b, 0xa3, 0xee, 0x15, 0xa3, 0x5b, 0x33, 0x21, 0xcc, 0x01, 0x5f, 0xbd, 0xec, 0xb7,
+	0x43, 0x2e, 0x3c, 0xfd, 0x17, 0x45, 0x6b, 0x23, 0xb3, 0x19, 0x20, 0x1b, 0xbd, 0xd5, 0x91, 0x7a,
+	0x1d, 0x3f, 0xf2, 0xdb, 0x18, 0xc1, 0x79, 0x48, 0xe0, 0xd2, 0x27, 0x8a, 0x6f, 0xcf, 0x28, 0xdc,
+	0x65, 0x71, 0x95, 0xdd, 0xef, 0x32, 0x19, 0x53, 0x17, 0xf2, 0xe2, 0x0b, 0xce, 0x22, 0x8b, 0x94,
+	0x48, 0xf9, 0xc5, 0x1d, 0xeb, 0xb7, 0x5f, 0x37, 0x96, 0x90, 0xee, 0x56, 0xa3, 0x11, 0x31, 0x29,
+	0xf7, 0xe2, 0x28, 0xe4, 0x41, 0xd5, 0xa8, 0xd1, 0x25, 0xc8, 0x87, 0xbc, 0xc1, 0x8e, 0xac, 0x59,
+	0xa5, 0x5f, 0x35, 0x07, 0x6a, 0xc1, 0x3c, 0x3b, 0x8a, 0x23, 0xbf, 0x1e, 0x5b, 0x73, 0x5a, 0x3e,
+	0x38, 0xd2, 0x4b, 0x50, 0x10, 0xcd, 0xa6, 0x64, 0xb1, 0x95, 0x2b, 0x91, 0x72, 0xae, 0x8a, 0x27,
+	0x25, 0x6f, 0x31, 0x1e, 0xc4, 0x87, 0x56, 0xde, 0xc8, 0xcd, 0xc9, 0x69, 0xc2, 0xe5, 0x67, 0x48,
+	0x65, 0x47, 0x70, 0xc9, 0xe8, 0x3b, 0x90, 0x67, 0x3c, 0x8e, 0xfa, 0x1a, 0x75, 0x61, 0x73, 0xd9,
+	0x1d, 0xf5, 0x63, 0xba, 0x68, 0x5c, 0x35, 0xfa, 0x94, 0x42, 0x4e, 0x86, 0x5f, 0x32, 0x8d, 0x9c,
+	0xab, 0xea, 0x67, 0xe7, 0x3f, 0x92, 0x0e, 0xf4, 0x51, 0x28, 0xa7, 0xae, 0xc9, 0x32, 0x5c, 0xd0,
+	0x65, 0xa8, 0x75, 0x22, 0xd6, 0x0c, 0x07, 0xa5, 0x59, 0xd0, 0xb2, 0x5d, 0x2d, 0x52, 0xe9, 0x36,
+	0xc3, 0x56, 0xcc, 0x22, 0xac, 0x0f, 0x9e, 0x92, 0x85, 0xcb, 0xa5, 0x0b, 0x77, 0x07, 0xe0, 0xb4,
+	0x89, 0x74, 0x91, 0x16, 0x36, 0xd7, 0x5c, 0xc4, 0x50, 0x1d, 0xe7, 0x9a, 0xc6, 0xc6, 0x8e, 0x73,
+	0x77, 0x55, 0xc2, 0x26, 0x81, 0x6a, 0xc2, 0xd2, 0x79, 0x40, 0xc0, 0x7a, 0x36, 0x51, 0x2c, 0xe9,
+	0x36, 0xcc, 0xab, 0x12, 0x85, 0x4c, 0x5a, 0xa4, 0x34, 0x97, 0xad, 0xa8, 0x03, 0x0b, 0x7a, 0x37,
+	0x45, 0x38, 0xab, 0x09, 0xd7, 0xc7, 0x12, 0x9a, 0xc8, 0x29, 0xc4, 0x0f, 0xd3, 0x84, 0xfb, 0xf2,
+	0x34, 0x95, 0x49, 0x7f, 0x0b, 0xe7, 0x33, 0xb8, 0x72, 0x86, 0x2f, 0x4c, 0xf7, 0x3d, 0xc8, 0x77,
+	0x95, 0x00, 0x3b, 0x68, 0x65, 0x74, 0xb2, 0x1f, 0x2b, 0x67, 0xda, 0x78, 0x27, 0xf7, 0xe8, 0xaf,
+	0xd7, 0x67, 0xaa, 0xc6, 0xd0, 0xf1, 0x87, 0xdc, 0x77, 0x5a, 0xc2, 0x6f, 0x9c, 0xeb, 0x5d, 0x72,
+	0xbe, 0x26, 0x60, 0x9f, 0x15, 0x03, 0x73, 0x78, 0x1f, 0x0a, 0x5d, 0x2d, 0xc1, 0x24, 0xd6, 0xc7,
+	0xfe, 0x62, 0xc6, 0x01, 0xe6, 0x81, 0xc6, 0x74, 0x15, 0x2e, 0xb6, 0x43, 0x29, 0x43, 0x1e, 0xd4,
+	0xea, 0x87, 0x5d, 0xfe, 0xb9, 0xb4, 0x66, 0x4b, 0x73, 0xe5, 0xc5, 0xea, 0x22, 0x4a, 0x6f, 0x6b,
+	0xa1, 0xf3, 0xe3, 0x10, 0xcc, 0xbd, 0x50, 0xf9, 0xef, 0x9f, 0xef, 0xf4, 0x48, 0xb7, 0xfa, 0xdc,
+	0xd4, 0xad, 0xfe, 0x13, 0x81, 0x57, 0xcf, 0x84, 0xc5, 0xd2, 0xdd, 0x86, 0x17, 0x7a, 0x2c, 0x92,
+	0x6a, 0x82, 0x67, 0x6e, 0x77, 0x2c, 0xdb, 0x53, 0xc3, 0xf3, 0xeb, 0xfa, 0x7d, 0x78, 0x23, 0x09,
+	0xbb, 0xc7, 0xea, 0x82, 0x37, 0xfc, 0xa8, 0xff, 0x81, 0xaa, 0x09, 0x93, 0xd3, 0x5e, 0x80, 0x0e,
+	0xac, 0x3c, 0xdf, 0x2d, 0x16, 0xe3, 0x1e, 0xcc, 0x87, 0x46, 0x84, 0xb5, 0x28, 0x3f, 0xa7, 0x16,
+	0x29, 0x27, 0x58, 0x92, 0x81, 0xb9, 0xf3, 0xf7, 0xd0, 0x28, 0xbd, 0x13, 0xf2, 0xa9, 0xaf, 0x04,
+	0x85, 0x1c, 0xf7, 0xdb, 0x0c, 0xfb, 0x43, 0x3f, 0xab, 0xa6, 0xe9, 0xf9, 0xad, 0x2e, 0xc3, 0xd1,
+	0x69, 0x0e, 0xf4, 0x25, 0x98, 0x6b, 0x87, 0x1c, 0xa7, 0xa6, 0x7a, 0xd4, 0x12, 0xff, 0x48, 0x8f,
+	0x4a, 0x25, 0xf1, 0x87, 0x1b, 0xab, 0x30, 0x75, 0x63, 0xfd, 0x30, 0x34, 0x43, 0x4d, 0x86, 0x58,
+	0xc8, 0x5b, 0x93, 0xcf, 0xd0, 0x41, 0x05, 0xcf, 0x7d, 0x92, 0x2e, 0x01, 0xd5, 0x9c, 0xbb, 0x7a,
+	0xfb, 0x63, 0x2a, 0xce, 0x3e, 0xbc, 0x92, 0x92, 0x22, 0xf8, 0x4d, 0x28, 0x98, 0xaf, 0x04, 0x9c,
+	0x24, 0xa5, 0xd1, 0xdc, 0xc6, 0x72, 0x30, 0x42, 0x8c, 0xd5, 0xe6, 0xff, 0x00, 0x79, 0xed, 0x97,
+	0x3e, 0x20, 0x00, 0xa7, 0x0b, 0x9b, 0x5e, 0x1f, 0xed, 0xe8, 0xec, 0xaf, 0x10, 0xbb, 0x32, 0x81,
+	0x85, 0xa1, 0x77, 0x36, 0xbe, 0xfa, 0xfd, 0xdf, 0xef, 0x66, 0xd7, 0xe9, 0xaa, 0x37, 0xee, 0x5b,
+	0xa9, 0x16, 0xb0, 0x98, 0x7e, 0x4f, 0x60, 0x21, 0xb1, 0x01, 0x69, 0xc6, 0x88, 0x89, 0xcf, 0x02,
+	0x7b, 0x73, 0x12, 0x13, 0xa4, 0x74, 0x35, 0x65, 0x99, 0xae, 0x8d, 0xa7, 0x6c, 0x29, 0xac, 0x87,
+	0x04, 0x2e, 0x24, 0x57, 0x17, 0xcd, 0x18, 0x34, 0xb9, 0x33, 0xed, 0xad, 0x89, 0x6c, 0x90, 0xd4,
+	0xd3, 0xa4, 0x57, 0xe9, 0xfa, 0x78, 0x52, 0xbd, 0x0a, 0xe9, 0xcf, 0x04, 0x16, 0x53, 0x1b, 0x86,
+	0x66, 0x8d, 0x9b, 0x5c, 0x9a, 0xf6, 0xdb, 0x93, 0x19, 0x21, 0xed, 0x75, 0x4d, 0x7b, 0x8d, 0x96,
+	0x33, 0xd0, 0x1a, 0xb8, 0x5f, 0x08, 0x5c, 0x4c, 0xef, 0x05, 0x9a, 0x31, 0x74, 0x7a, 0xe7, 0xd9,
+	0x37, 0x26, 0xb4, 0x42, 0xe2, 0x8a, 0x26, 0x7e, 0x93, 0x5e, 0x1d, 0x4f, 0x7c, 0x88, 0x7c, 0x7f,
+	0x10, 0xb8, 0x3c, 0x62, 0x8c, 0xd3, 0x77, 0xb3, 0x51, 0x8c, 0xd8, 0x2a, 0xf6, 0xcd, 0x69, 0xcd,
+	0x31, 0x9b, 0x6d, 0x9d, 0xcd, 0x0d, 0xba, 0x35, 0x3e, 0x1b, 0x39, 0xf0, 0x51, 0xc3, 0x85, 0x91,
+	0xbc, 0x8b, 0x6a, 0x92, 0x66, 0xbd, 0x8b, 0x89, 0xbd, 0x92, 0xf5, 0x2e, 0x26, 0x07, 0xf5, 0x24,
+	0x77, 0xb1, 0xa9, 0xb0, 0xbe, 0x21, 0x50, 0x30, 0x83, 0x8f, 0xbe, 0x35, 0x26, 0x5c, 0x6a, 0xde,
+	0xda, 0x1b, 0x19, 0xb5, 0x91, 0xab, 0xac, 0xb9, 0x1c, 0x5a, 0xf2, 0xc6, 0xfc, 0x37, 0xb7, 0xb3,
+	0xfd, 0xe8, 0xb8, 0x48, 0x1e, 0x1f, 0x17, 0xc9, 0x3f, 0xc7, 0x45, 0xf2, 0xed, 0x49, 0x71, 0xe6,
+	0xf1, 0x49, 0x71, 0xe6, 0xcf, 0x93, 0xe2, 0xcc, 0xa7, 0xcb, 0xe9, 0x88, 0x75, 0xd1, 0xf6, 0x8e,
+	0x9e, 0x3a, 0x88, 0xfb, 0x1d, 0x26, 0x0f, 0x0a, 0xfa, 0xed, 0xd6, 0x93, 0x00, 0x00, 0x00, 0xff,
+	0xff, 0x7a, 0x33, 0x36, 0xfb, 0x2a, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Gets the stored data for the given owner and index.
+	StorageGet(ctx context.Context, in *QueryStorageGetRequest, opts ...grpc.CallOption) (*QueryStorageGetResponse, error)
+	// Lists all storage entries for the owner under a given index prefix. Use
+	// filter and extract to filter and extract the data and iterate over the data
+	// efficiently.
+	StorageList(ctx context.Context, in *QueryStorageListRequest, opts ...grpc.CallOption) (*QueryStorageListResponse, error)
+	// Returns the bytes used, the number of entries and the deposit held for an
+	// owner.
+	StorageUsage(ctx context.Context, in *QueryStorageUsageRequest, opts ...grpc.CallOption) (*QueryStorageUsageResponse, error)
+	// Returns a chunked upload in progress and the chunks it is missing.
+	StorageUpload(ctx context.Context, in *QueryStorageUploadRequest, opts ...grpc.CallOption) (*QueryStorageUploadResponse, error)
+	// Returns the retained previous versions of a storage entry in version
+	// order, newest first with a reverse pagination.
+	StorageHistory(ctx context.Context, in *QueryStorageHistoryRequest, opts ...grpc.CallOption) (*QueryStorageHistoryResponse, error)
+	// Returns the secondary indexes of an owner.
+	StorageSecondaryIndexes(ctx context.Context, in *QueryStorageSecondaryIndexesRequest, opts ...grpc.CallOption) (*QueryStorageSecondaryIndexesResponse, error)
+	// Finds the entries of an owner through a secondary index, by the exact
+	// value or a range of values at its path, in value order.
+	StorageFind(ctx context.Context, in *QueryStorageFindRequest, opts ...grpc.CallOption) (*QueryStorageFindResponse, error)
+	// Params queries the parameters of the storage module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) StorageGet(ctx context.Context, in *QueryStorageGetRequest, opts ...grpc.CallOption) (*QueryStorageGetResponse, error) {
+	out := new(QueryStorageGetResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageList(ctx context.Context, in *QueryStorageListRequest, opts ...grpc.CallOption) (*QueryStorageListResponse, error) {
+	out := new(QueryStorageListResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageUsage(ctx context.Context, in *QueryStorageUsageRequest, opts ...grpc.CallOption) (*QueryStorageUsageResponse, error) {
+	out := new(QueryStorageUsageResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageUpload(ctx context.Context, in *QueryStorageUploadRequest, opts ...grpc.CallOption) (*QueryStorageUploadResponse, error) {
+	out := new(QueryStorageUploadResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageHistory(ctx context.Context, in *QueryStorageHistoryRequest, opts ...grpc.CallOption) (*QueryStorageHistoryResponse, error) {
+	out := new(QueryStorageHistoryResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageSecondaryIndexes(ctx context.Context, in *QueryStorageSecondaryIndexesRequest, opts ...grpc.CallOption) (*QueryStorageSecondaryIndexesResponse, error) {
+	out := new(QueryStorageSecondaryIndexesResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageSecondaryIndexes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StorageFind(ctx context.Context, in *QueryStorageFindRequest, opts ...grpc.CallOption) (*QueryStorageFindResponse, error) {
+	out := new(QueryStorageFindResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/StorageFind", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Gets the stored data for the given owner and index.
+	StorageGet(context.Context, *QueryStorageGetRequest) (*QueryStorageGetResponse, error)
+	// Lists all storage entries for the owner under a given index prefix. Use
+	// filter and extract to filter and extract the data and iterate over the data
+	// efficiently.
+	StorageList(context.Context, *QueryStorageListRequest) (*QueryStorageListResponse, error)
+	// Returns the bytes used, the number of entries and the deposit held for an
+	// owner.
+	StorageUsage(context.Context, *QueryStorageUsageRequest) (*QueryStorageUsageResponse, error)
+	// Returns a chunked upload in progress and the chunks it is missing.
+	StorageUpload(context.Context, *QueryStorageUploadRequest) (*QueryStorageUploadResponse, error)
+	// Returns the retained previous versions of a storage entry in version
+	// order, newest first with a reverse pagination.
+	StorageHistory(context.Context, *QueryStorageHistoryRequest) (*QueryStorageHistoryResponse, error)
+	// Returns the secondary indexes of an owner.
+	StorageSecondaryIndexes(context.Context, *QueryStorageSecondaryIndexesRequest) (*QueryStorageSecondaryIndexesResponse, error)
+	// Finds the entries of an owner through a secondary index, by the exact
+	// value or a range of values at its path, in value order.
+	StorageFind(context.Context, *QueryStorageFindRequest) (*QueryStorageFindResponse, error)
+	// Params queries the parameters of the storage module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) StorageGet(ctx context.Context, req *QueryStorageGetRequest) (*QueryStorageGetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageGet not implemented")
+}
+func (*UnimplementedQueryServer) StorageList(ctx context.Context, req *QueryStorageListRequest) (*QueryStorageListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageList not implemented")
+}
+func (*UnimplementedQueryServer) StorageUsage(ctx context.Context, req *QueryStorageUsageRequest) (*QueryStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUsage not implemented")
+}
+func (*UnimplementedQueryServer) StorageUpload(ctx context.Context, req *QueryStorageUploadRequest) (*QueryStorageUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUpload not implemented")
+}
+func (*UnimplementedQueryServer) StorageHistory(ctx context.Context, req *QueryStorageHistoryRequest) (*QueryStorageHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageHistory not implemented")
+}
+func (*UnimplementedQueryServer) StorageSecondaryIndexes(ctx context.Context, req *QueryStorageSecondaryIndexesRequest) (*QueryStorageSecondaryIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageSecondaryIndexes not implemented")
+}
+func (*UnimplementedQueryServer) StorageFind(ctx context.Context, req *QueryStorageFindRequest) (*QueryStorageFindResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageFind not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_StorageGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/StorageGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageGet(ctx, req.(*QueryStorageGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/StorageList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageList(ctx, req.(*QueryStorageListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/StorageUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageUsage(ctx, req.(*QueryStorageUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/StorageUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageUpload(ctx, req.(*QueryStorageUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/StorageHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageHistory(ctx, req.(*QueryStorageHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageSecondaryIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageSecondaryIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageSecondaryIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/StorageSecondaryIndexes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageSecondaryIndexes(ctx, req.(*QueryStorageSecondaryIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StorageFind_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStorageFindRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StorageFind(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/StorageFind",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StorageFind(ctx, req.(*QueryStorageFindRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dysonprotocol.storage.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StorageGet",
+			Handler:    _Query_StorageGet_Handler,
+		},
+		{
+			MethodName: "StorageList",
+			Handler:    _Query_StorageList_Handler,
+		},
+		{
+			MethodName: "StorageUsage",
+			Handler:    _Query_StorageUsage_Handler,
+		},
+		{
+			MethodName: "StorageUpload",
+			Handler:    _Query_StorageUpload_Handler,
+		},
+		{
+			MethodName: "StorageHistory",
+			Handler:    _Query_StorageHistory_Handler,
+		},
+		{
+			MethodName: "StorageSecondaryIndexes",
+			Handler:    _Query_StorageSecondaryIndexes_Handler,
+		},
+		{
+			MethodName: "StorageFind",
+			Handler:    _Query_StorageFind_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dysonprotocol/storage/v1/query.proto",
+}
+
+func (m *QueryStorageGetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageGetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageGetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Length != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Offset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Offset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Extract) > 0 {
+		i -= len(m.Extract)
+		copy(dAtA[i:], m.Extract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Extract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageGetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageGetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageGetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Entry != nil {
+		{
+			size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Extract) > 0 {
+		i -= len(m.Extract)
+		copy(dAtA[i:], m.Extract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Extract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Filter) > 0 {
+		i -= len(m.Filter)
+		copy(dAtA[i:], m.Filter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Filter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IndexPrefix) > 0 {
+		i -= len(m.IndexPrefix)
+		copy(dAtA[i:], m.IndexPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IndexPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageUploadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageUploadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageUploadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageUploadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageUploadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageUploadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingChunks) > 0 {
+		dAtA6 := make([]byte, len(m.MissingChunks)*10)
+		var j5 int
+		for _, num := range m.MissingChunks {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintQuery(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Upload.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Versions) > 0 {
+		for iNdEx := len(m.Versions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Versions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageSecondaryIndexesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageSecondaryIndexesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageSecondaryIndexesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageSecondaryIndexesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageSecondaryIndexesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageSecondaryIndexesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Indexes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageFindRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageFindRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageFindRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Max) > 0 {
+		i -= len(m.Max)
+		copy(dAtA[i:], m.Max)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Max)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Min) > 0 {
+		i -= len(m.Min)
+		copy(dAtA[i:], m.Min)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Min)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStorageFindResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStorageFindResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStorageFindResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStorageGetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Extract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Offset != 0 {
+		n += 1 + sovQuery(uint64(m.Offset))
+	}
+	if m.Length != 0 {
+		n += 1 + sovQuery(uint64(m.Length))
+	}
+	return n
+}
+
+func (m *QueryStorageGetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Entry != nil {
+		l = m.Entry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *QueryStorageListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.IndexPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Filter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Extract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStorageUploadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageUploadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Upload.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MissingChunks) > 0 {
+		l = 0
+		for _, e := range m.MissingChunks {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryStorageHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageSecondaryIndexesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageSecondaryIndexesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for _, e := range m.Indexes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryStorageFindRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Min)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Max)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStorageFindResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryStorageGetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageGetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			m.Offset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Offset |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			m.Length = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Length |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageGetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageGetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Entry == nil {
+				m.Entry = &Storage{}
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Extract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Extract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &Storage{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageUploadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageUploadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageUploadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageUploadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageUploadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageUploadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Upload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingChunks = append(m.MissingChunks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingChunks) == 0 {
+					m.MissingChunks = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingChunks = append(m.MissingChunks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingChunks", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			i--
-			dAtA[i] = 0xa
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryStorageGetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Extract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryStorageGetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Entry != nil {
-		l = m.Entry.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryStorageListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.IndexPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Filter)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Extract)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryStorageListResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStorageHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryStorageGetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -762,17 +3625,17 @@ func (m *QueryStorageGetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageGetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageGetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -782,29 +3645,31 @@ func (m *QueryStorageGetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Versions = append(m.Versions, Storage{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -814,27 +3679,81 @@ func (m *QueryStorageGetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStorageSecondaryIndexesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStorageSecondaryIndexesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStorageSecondaryIndexesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -862,7 +3781,7 @@ func (m *QueryStorageGetRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extract = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -885,7 +3804,7 @@ func (m *QueryStorageGetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageGetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageSecondaryIndexesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -908,15 +3827,15 @@ func (m *QueryStorageGetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageGetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageSecondaryIndexesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageGetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageSecondaryIndexesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -943,10 +3862,8 @@ func (m *QueryStorageGetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Entry == nil {
-				m.Entry = &Storage{}
-			}
-			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Indexes = append(m.Indexes, SecondaryIndex{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -971,7 +3888,7 @@ func (m *QueryStorageGetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageFindRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -994,10 +3911,10 @@ func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageFindRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageFindRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1034,7 +3951,7 @@ func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1062,11 +3979,11 @@ func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IndexPrefix = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Filter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1094,11 +4011,11 @@ func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Filter = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Extract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1126,9 +4043,41 @@ func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Extract = string(dAtA[iNdEx:postIndex])
+			m.Min = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Max = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1185,7 +4134,7 @@ func (m *QueryStorageListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStorageListResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStorageFindResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1208,10 +4157,10 @@ func (m *QueryStorageListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStorageListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStorageFindResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStorageListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStorageFindResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1243,7 +4192,7 @@ func (m *QueryStorageListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &Storage{})
+			m.Entries = append(m.Entries, Storage{})
 			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1305,6 +4254,139 @@ func (m *QueryStorageListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,369 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// JSONSchema is a parsed JSON schema. Only a subset of the keywords is supported, the ones that
+// can be checked deterministically without resolving references:
+// type, enum, const, properties, required, additionalProperties, items, minItems, maxItems,
+// minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum and exclusiveMaximum.
+// Annotations such as title and description are ignored.
+type JSONSchema struct {
+	root any
+}
+
+// schemaAnnotations are the keywords that do not constrain values
+var schemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true, "default": true, "examples": true,
+}
+
+// schemaTypes are the values of the type keyword
+var schemaTypes = map[string]bool{
+	"null": true, "boolean": true, "object": true, "array": true, "number": true, "integer": true, "string": true,
+}
+
+// ParseJSONSchema parses a JSON schema and checks that it only uses supported keywords
+func ParseJSONSchema(schema string) (*JSONSchema, error) {
+	root, err := decodeJSON(schema)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkSchema(root, "$"); err != nil {
+		return nil, err
+	}
+	return &JSONSchema{root: root}, nil
+}
+
+// Validate checks that a JSON document matches the schema
+func (s *JSONSchema) Validate(document string) error {
+	value, err := decodeJSON(document)
+	if err != nil {
+		return err
+	}
+	return validateSchema(s.root, value, "$")
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers exact
+func decodeJSON(s string) (any, error) {
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("invalid JSON: unexpected data after the value")
+	}
+	return value, nil
+}
+
+// sortedKeys returns the keys of an object in order, so that errors are deterministic
+func sortedKeys(object map[string]any) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// checkSchema checks the keywords of a schema and of its subschemas
+func checkSchema(node any, path string) error {
+	if _, ok := node.(bool); ok {
+		return nil
+	}
+	schema, ok := node.(map[string]any)
+	if !ok {
+		return fmt.Errorf("schema at %s must be an object or a boolean", path)
+	}
+
+	for _, keyword := range sortedKeys(schema) {
+		value := schema[keyword]
+		switch keyword {
+		case "type":
+			types, ok := value.([]any)
+			if !ok {
+				types = []any{value}
+			}
+			for _, t := range types {
+				if name, ok := t.(string); !ok || !schemaTypes[name] {
+					return fmt.Errorf("invalid type %v at %s", t, path)
+				}
+			}
+		case "enum":
+			if _, ok := value.([]any); !ok {
+				return fmt.Errorf("enum at %s must be an array", path)
+			}
+		case "const":
+		case "properties":
+			properties, ok := value.(map[string]any)
+			if !ok {
+				return fmt.Errorf("properties at %s must be an object", path)
+			}
+			for _, name := range sortedKeys(properties) {
+				if err := checkSchema(properties[name], path+"."+name); err != nil {
+					return err
+				}
+			}
+		case "required":
+			required, ok := value.([]any)
+			if !ok {
+				return fmt.Errorf("required at %s must be an array of strings", path)
+			}
+			for _, name := range required {
+				if _, ok := name.(string); !ok {
+					return fmt.Errorf("required at %s must be an array of strings", path)
+				}
+			}
+		case "additionalProperties":
+			if err := checkSchema(value, path+".*"); err != nil {
+				return err
+			}
+		case "items":
+			if err := checkSchema(value, path+"[*]"); err != nil {
+				return err
+			}
+		case "minItems", "maxItems", "minLength", "maxLength":
+			n, ok := schemaNumber(value)
+			if !ok || !n.IsInt() || n.Sign() < 0 {
+				return fmt.Errorf("%s at %s must be a non-negative integer", keyword, path)
+			}
+		case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+			if _, ok := schemaNumber(value); !ok {
+				return fmt.Errorf("%s at %s must be a number", keyword, path)
+			}
+		case "pattern":
+			pattern, ok := value.(string)
+			if !ok {
+				return fmt.Errorf("pattern at %s must be a string", path)
+			}
+			if _, err := regexp.Compile(pattern); err != nil {
+				return fmt.Errorf("invalid pattern at %s: %w", path, err)
+			}
+		default:
+			if !schemaAnnotations[keyword] {
+				return fmt.Errorf("unsupported keyword %s at %s", keyword, path)
+			}
+		}
+	}
+	return nil
+}
+
+// validateSchema checks a value against a schema checked by checkSchema
+func validateSchema(node, value any, path string) error {
+	if allowed, ok := node.(bool); ok {
+		if !allowed {
+			return fmt.Errorf("%s is not allowed", path)
+		}
+		return nil
+	}
+	schema := node.(map[string]any)
+
+	if t, ok := schema["type"]; ok && !matchesType(t, value) {
+		return fmt.Errorf("%s must be of type %v", path, t)
+	}
+	if enum, ok := schema["enum"]; ok {
+		found := false
+		for _, option := range enum.([]any) {
+			if jsonEqual(option, value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s must be one of the enum values", path)
+		}
+	}
+	if constant, ok := schema["const"]; ok && !jsonEqual(constant, value) {
+		return fmt.Errorf("%s must be equal to the const value", path)
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := int64(utf8.RuneCountInString(v))
+		if limit, ok := schemaLimit(schema, "minLength"); ok && length < limit {
+			return fmt.Errorf("%s must have at least %d characters", path, limit)
+		}
+		if limit, ok := schemaLimit(schema, "maxLength"); ok && length > limit {
+			return fmt.Errorf("%s must have at most %d characters", path, limit)
+		}
+		if pattern, ok := schema["pattern"]; ok && !regexp.MustCompile(pattern.(string)).MatchString(v) {
+			return fmt.Errorf("%s must match the pattern %s", path, pattern)
+		}
+	case json.Number:
+		_, hasMinimum := schema["minimum"]
+		_, hasMaximum := schema["maximum"]
+		_, hasExclusiveMinimum := schema["exclusiveMinimum"]
+		_, hasExclusiveMaximum := schema["exclusiveMaximum"]
+		if !hasMinimum && !hasMaximum && !hasExclusiveMinimum && !hasExclusiveMaximum {
+			break
+		}
+		n, ok := schemaNumber(v)
+		if !ok {
+			return fmt.Errorf("%s is out of range", path)
+		}
+		if limit, ok := schemaNumber(schema["minimum"]); ok && n.Cmp(limit) < 0 {
+			return fmt.Errorf("%s must be at least %s", path, schema["minimum"])
+		}
+		if limit, ok := schemaNumber(schema["maximum"]); ok && n.Cmp(limit) > 0 {
+			return fmt.Errorf("%s must be at most %s", path, schema["maximum"])
+		}
+		if limit, ok := schemaNumber(schema["exclusiveMinimum"]); ok && n.Cmp(limit) <= 0 {
+			return fmt.Errorf("%s must be greater than %s", path, schema["exclusiveMinimum"])
+		}
+		if limit, ok := schemaNumber(schema["exclusiveMaximum"]); ok && n.Cmp(limit) >= 0 {
+			return fmt.Errorf("%s must be less than %s", path, schema["exclusiveMaximum"])
+		}
+	case []any:
+		length := int64(len(v))
+		if limit, ok := schemaLimit(schema, "minItems"); ok && length < limit {
+			return fmt.Errorf("%s must have at least %d items", path, limit)
+		}
+		if limit, ok := schemaLimit(schema, "maxItems"); ok && length > limit {
+			return fmt.Errorf("%s must have at most %d items", path, limit)
+		}
+		if items, ok := schema["items"]; ok {
+			for i, item := range v {
+				if err := validateSchema(items, item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]any:
+		if required, ok := schema["required"]; ok {
+			for _, name := range required.([]any) {
+				if _, ok := v[name.(string)]; !ok {
+					return fmt.Errorf("%s.%s is required", path, name)
+				}
+			}
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		additional, hasAdditional := schema["additionalProperties"]
+		for _, name := range sortedKeys(v) {
+			if property, ok := properties[name]; ok {
+				if err := validateSchema(property, v[name], path+"."+name); err != nil {
+					return err
+				}
+			} else if hasAdditional {
+				if err := validateSchema(additional, v[name], path+"."+name); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// matchesType returns whether a value is of one of the types of the type keyword
+func matchesType(t, value any) bool {
+	types, ok := t.([]any)
+	if !ok {
+		types = []any{t}
+	}
+	for _, name := range types {
+		switch name {
+		case "null":
+			if value == nil {
+				return true
+			}
+		case "boolean":
+			if _, ok := value.(bool); ok {
+				return true
+			}
+		case "object":
+			if _, ok := value.(map[string]any); ok {
+				return true
+			}
+		case "array":
+			if _, ok := value.([]any); ok {
+				return true
+			}
+		case "number":
+			if _, ok := value.(json.Number); ok {
+				return true
+			}
+		case "integer":
+			if n, ok := schemaNumber(value); ok && n.IsInt() {
+				return true
+			}
+		case "string":
+			if _, ok := value.(string); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jsonEqual returns whether two decoded JSON values are equal, comparing numbers by value
+func jsonEqual(a, b any) bool {
+	switch av := a.(type) {
+	case json.Number:
+		an, _ := schemaNumber(av)
+		bn, ok := schemaNumber(b)
+		return ok && an.Cmp(bn) == 0
+	case []any:
+		bv, ok := b.([]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		bv, ok := b.(map[string]any)
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for key, value := range av {
+			other, ok := bv[key]
+			if !ok || !jsonEqual(value, other) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// maxNumberExponent bounds the exponent of the numbers compared exactly, the float64 range
+const maxNumberExponent = 308
+
+// schemaNumber returns the exact value of a decoded JSON number. Numbers with an exponent
+// beyond maxNumberExponent are rejected as their exact value is too costly to compute.
+func schemaNumber(value any) (*big.Rat, bool) {
+	n, ok := value.(json.Number)
+	if !ok {
+		return nil, false
+	}
+	if i := strings.IndexAny(n.String(), "eE"); i >= 0 {
+		exponent, err := strconv.Atoi(strings.TrimPrefix(n.String()[i+1:], "+"))
+		if err != nil || exponent > maxNumberExponent || exponent < -maxNumberExponent {
+			return nil, false
+		}
+	}
+	return new(big.Rat).SetString(n.String())
+}
+
+// schemaLimit returns the value of a non-negative integer keyword of a schema
+func schemaLimit(schema map[string]any, keyword string) (int64, bool) {
+	n, ok := schemaNumber(schema[keyword])
+	if !ok || !n.Num().IsInt64() {
+		return 0, false
+	}
+	return n.Num().Int64(), true
+}
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+// MaxSecondaryIndexesPerOwner is the maximum number of secondary indexes of an owner, as every
+// write of an entry updates each of them
+const MaxSecondaryIndexesPerOwner = 8
+
+// MaxSecondaryIndexNameLength is the maximum length of the name of a secondary index
+const MaxSecondaryIndexNameLength = 64
+
+// MaxIndexedValueLength is the maximum length of an indexed string, longer values are not indexed
+const MaxIndexedValueLength = 256
+
+// secondaryIndexNameRe matches the names of secondary indexes
+var secondaryIndexNameRe = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// secondaryIndexPathRe matches the JSON paths of secondary indexes, "$" followed by object keys
+var secondaryIndexPathRe = regexp.MustCompile(`^\$(\.[A-Za-z0-9_-]+)+$`)
+
+// Validate checks the name and the path of a secondary index
+func (i SecondaryIndex) Validate() error {
+	if i.Name == "" || len(i.Name) > MaxSecondaryIndexNameLength {
+		return fmt.Errorf("secondary index name must have between 1 and %d characters", MaxSecondaryIndexNameLength)
+	}
+	if !secondaryIndexNameRe.MatchString(i.Name) {
+		return fmt.Errorf("invalid secondary index name %q, expected letters, digits, '_', '-' or '.'", i.Name)
+	}
+	if !secondaryIndexPathRe.MatchString(i.Path) {
+		return fmt.Errorf("invalid secondary index path %q, expected $.<key>[.<key>...]", i.Path)
+	}
+	return nil
+}
+
+// Covers returns whether an entry is indexed by the secondary index
+func (i SecondaryIndex) Covers(entry Storage) bool {
+	return entry.Owner == i.Owner && strings.HasPrefix(entry.Index, i.IndexPrefix)
+}
+
+// ValueKey returns the key of the value at the path of the index in the data of an entry, false
+// when the entry has no indexable value there
+func (i SecondaryIndex) ValueKey(entry Storage) (string, bool) {
+	if !i.Covers(entry) || entry.Data == "" {
+		return "", false
+	}
+	return IndexValueKey(gjson.Get(entry.Data, strings.TrimPrefix(i.Path, "$.")))
+}
+
+// ParseIndexValue returns the key of a JSON encoded value of a query. A value that is not valid
+// JSON is taken as a string.
+func ParseIndexValue(value string) (string, bool) {
+	if !gjson.Valid(value) {
+		return IndexValueKey(gjson.Result{Type: gjson.String, Str: value})
+	}
+	return IndexValueKey(gjson.Parse(value))
+}
+
+// IndexValueKey encodes a JSON value so that the keys of values of the same type sort like the
+// values. Strings, numbers and booleans are indexable, other values are not.
+func IndexValueKey(value gjson.Result) (string, bool) {
+	switch value.Type {
+	case gjson.String:
+		if len(value.Str) > MaxIndexedValueLength || strings.ContainsRune(value.Str, 0) {
+			return "", false
+		}
+		return "s" + value.Str, true
+	case gjson.Number:
+		f, err := strconv.ParseFloat(value.Raw, 64)
+		if err != nil || math.IsInf(f, 0) {
+			return "", false
+		}
+		if f == 0 {
+			// -0 is indexed as 0
+			f = 0
+		}
+		// The sign bit is flipped for positive numbers and every bit for negative ones so that
+		// the encoded numbers sort like the numbers
+		bits := math.Float64bits(f)
+		if f >= 0 {
+			bits ^= 1 << 63
+		} else {
+			bits = ^bits
+		}
+		return fmt.Sprintf("n%016x", bits), true
+	case gjson.True:
+		return "b1", true
+	case gjson.False:
+		return "b0", true
+	default:
+		return "", false
+	}
+}
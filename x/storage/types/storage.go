@@ -0,0 +1,74 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
+)
+
+// MaxUploadChunks is the maximum number of chunks of a chunked upload
+const MaxUploadChunks = 1024
+
+// MaxExpiriesPerBlock is the maximum number of expired entries deleted in a block, the other
+// ones are deleted in the next blocks
+const MaxExpiriesPerBlock = 100
+
+// ContentHash returns the "sha256-<base64>" hash of a content
+func ContentHash(content []byte) string {
+	hashBytes := sha256.Sum256(content)
+	return fmt.Sprintf("sha256-%s", base64.StdEncoding.EncodeToString(hashBytes[:]))
+}
+
+// EntrySize returns the size in bytes an entry is charged for, its index and content
+func (s Storage) EntrySize() uint64 {
+	return uint64(len(s.Index) + len(s.Data) + len(s.Blob))
+}
+
+// StoredSize returns the size in bytes an entry uses in the owner usage and deposit, its entry
+// size and the size of its retained versions
+func (s Storage) StoredSize() uint64 {
+	return s.EntrySize() + s.HistorySize
+}
+
+// Content returns the content of an entry, its blob for a binary entry and its data otherwise
+func (s Storage) Content() []byte {
+	if s.Blob != nil {
+		return s.Blob
+	}
+	return []byte(s.Data)
+}
+
+// EntrySize returns the size in bytes the entry of an upload will be charged for
+func (u StorageUpload) EntrySize() uint64 {
+	return uint64(len(u.Index)) + u.Size_
+}
+
+// ValidateManifest checks the chunk hashes and the size of an upload
+func (u StorageUpload) ValidateManifest() error {
+	if len(u.ChunkHashes) == 0 || len(u.ChunkHashes) > MaxUploadChunks {
+		return fmt.Errorf("an upload must have between 1 and %d chunks, got %d", MaxUploadChunks, len(u.ChunkHashes))
+	}
+	if u.Size_ < uint64(len(u.ChunkHashes)) {
+		return fmt.Errorf("upload size %d is smaller than its %d chunks", u.Size_, len(u.ChunkHashes))
+	}
+	for i, hash := range append([]string{u.Hash}, u.ChunkHashes...) {
+		if err := validateContentHash(hash); err != nil {
+			if i == 0 {
+				return fmt.Errorf("invalid upload hash: %w", err)
+			}
+			return fmt.Errorf("invalid hash of chunk %d: %w", i-1, err)
+		}
+	}
+	return nil
+}
+
+// validateContentHash checks that a hash has the format returned by ContentHash
+func validateContentHash(hash string) error {
+	encoded, ok := strings.CutPrefix(hash, "sha256-")
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if !ok || err != nil || len(decoded) != sha256.Size {
+		return fmt.Errorf("hash %q must be sha256-<base64>", hash)
+	}
+	return nil
+}
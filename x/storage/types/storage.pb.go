@@ -6,7 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -44,6 +45,25 @@ type Storage struct {
 	UpdatedTimestamp string `protobuf:"bytes,5,opt,name=updated_timestamp,json=updatedTimestamp,proto3" json:"updated_timestamp,omitempty"`
 	// hash is SHA-256 hex of data field.
 	Hash string `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	// deposit is the deposit held in the module account for this entry. It is
+	// refunded to the owner when the entry is deleted.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// content_type is the MIME type of the entry, for example "image/png".
+	ContentType string `protobuf:"bytes,8,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// blob is the binary content of the entry. An entry holds either data or a
+	// blob, and hash is the SHA-256 of the one that is set.
+	Blob []byte `protobuf:"bytes,9,opt,name=blob,proto3" json:"blob,omitempty"`
+	// keep_versions is the number of previous versions of the entry retained in
+	// its history.
+	KeepVersions uint32 `protobuf:"varint,10,opt,name=keep_versions,json=keepVersions,proto3" json:"keep_versions,omitempty"`
+	// version is incremented on every write of the entry, starting at 1.
+	Version uint64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// history_size is the total size in bytes of the retained versions of the
+	// entry. It counts towards the owner usage and the deposit of the entry.
+	HistorySize uint64 `protobuf:"varint,12,opt,name=history_size,json=historySize,proto3" json:"history_size,omitempty"`
+	// expires_at is the unix timestamp after which the entry is deleted and its
+	// deposit refunded, 0 if the entry does not expire.
+	ExpiresAt int64 `protobuf:"varint,13,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *Storage) Reset()         { *m = Storage{} }
@@ -121,8 +141,321 @@ func (m *Storage) GetHash() string {
 	return ""
 }
 
+func (m *Storage) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Storage) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Storage) GetBlob() []byte {
+	if m != nil {
+		return m.Blob
+	}
+	return nil
+}
+
+func (m *Storage) GetKeepVersions() uint32 {
+	if m != nil {
+		return m.KeepVersions
+	}
+	return 0
+}
+
+func (m *Storage) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Storage) GetHistorySize() uint64 {
+	if m != nil {
+		return m.HistorySize
+	}
+	return 0
+}
+
+func (m *Storage) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// StorageUpload is a chunked upload in progress. Its manifest lists the hash of
+// every chunk and of the whole content. Once every chunk is uploaded, the
+// content is committed to the (owner, index) entry in a single write.
+type StorageUpload struct {
+	// owner is the account address that owns the upload.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// index is the index of the entry the upload is committed to.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// content_type is the MIME type of the uploaded content.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// size is the size in bytes of the whole content.
+	Size_ uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// chunk_hashes are the "sha256-<base64>" hashes of the chunks, in order.
+	ChunkHashes []string `protobuf:"bytes,5,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// hash is the "sha256-<base64>" hash of the whole content.
+	Hash string `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	// deposit is the deposit held for the upload until it is committed or
+	// cancelled.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// created_height is the block height at which the upload began.
+	CreatedHeight uint64 `protobuf:"varint,8,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (m *StorageUpload) Reset()         { *m = StorageUpload{} }
+func (m *StorageUpload) String() string { return proto.CompactTextString(m) }
+func (*StorageUpload) ProtoMessage()    {}
+func (*StorageUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1642448e4e30a9e, []int{1}
+}
+func (m *StorageUpload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StorageUpload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StorageUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUpload.Merge(m, src)
+}
+func (m *StorageUpload) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUpload proto.InternalMessageInfo
+
+func (m *StorageUpload) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *StorageUpload) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
+}
+
+func (m *StorageUpload) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *StorageUpload) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *StorageUpload) GetChunkHashes() []string {
+	if m != nil {
+		return m.ChunkHashes
+	}
+	return nil
+}
+
+func (m *StorageUpload) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *StorageUpload) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *StorageUpload) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+// SecondaryIndex is an index of the entries of an owner by the value at a JSON
+// path of their data, maintained on every write.
+type SecondaryIndex struct {
+	// owner is the account address that owns the indexed entries.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// name is the name of the index, unique for the owner.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// path is the JSON path of the indexed value, for example "$.category" or
+	// "$.author.name".
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// index_prefix restricts the index to the entries whose index starts with
+	// it. Empty means every entry of the owner.
+	IndexPrefix string `protobuf:"bytes,4,opt,name=index_prefix,json=indexPrefix,proto3" json:"index_prefix,omitempty"`
+}
+
+func (m *SecondaryIndex) Reset()         { *m = SecondaryIndex{} }
+func (m *SecondaryIndex) String() string { return proto.CompactTextString(m) }
+func (*SecondaryIndex) ProtoMessage()    {}
+func (*SecondaryIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1642448e4e30a9e, []int{2}
+}
+func (m *SecondaryIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecondaryIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecondaryIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecondaryIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecondaryIndex.Merge(m, src)
+}
+func (m *SecondaryIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecondaryIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecondaryIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecondaryIndex proto.InternalMessageInfo
+
+func (m *SecondaryIndex) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *SecondaryIndex) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SecondaryIndex) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SecondaryIndex) GetIndexPrefix() string {
+	if m != nil {
+		return m.IndexPrefix
+	}
+	return ""
+}
+
+// OwnerUsage is the storage used by an owner and the deposit held for it.
+type OwnerUsage struct {
+	// owner is the account address that owns the entries.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// bytes is the total size of the owner's entries, indexes and data.
+	Bytes uint64 `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	// entries is the number of entries of the owner.
+	Entries uint64 `protobuf:"varint,3,opt,name=entries,proto3" json:"entries,omitempty"`
+	// deposit is the total deposit held for the owner's entries.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+}
+
+func (m *OwnerUsage) Reset()         { *m = OwnerUsage{} }
+func (m *OwnerUsage) String() string { return proto.CompactTextString(m) }
+func (*OwnerUsage) ProtoMessage()    {}
+func (*OwnerUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1642448e4e30a9e, []int{3}
+}
+func (m *OwnerUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerUsage.Merge(m, src)
+}
+func (m *OwnerUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerUsage proto.InternalMessageInfo
+
+func (m *OwnerUsage) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OwnerUsage) GetBytes() uint64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *OwnerUsage) GetEntries() uint64 {
+	if m != nil {
+		return m.Entries
+	}
+	return 0
+}
+
+func (m *OwnerUsage) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Storage)(nil), "dysonprotocol.storage.v1.Storage")
+	proto.RegisterType((*StorageUpload)(nil), "dysonprotocol.storage.v1.StorageUpload")
+	proto.RegisterType((*SecondaryIndex)(nil), "dysonprotocol.storage.v1.SecondaryIndex")
+	proto.RegisterType((*OwnerUsage)(nil), "dysonprotocol.storage.v1.OwnerUsage")
 }
 
 func init() {
@@ -130,30 +463,51 @@ func init() {
 }
 
 var fileDescriptor_c1642448e4e30a9e = []byte{
-	// 357 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x3b, 0xf7, 0xb6, 0xbd, 0xdc, 0x81, 0x7b, 0xb1, 0x43, 0x17, 0xb1, 0x48, 0xac, 0x82,
-	0x52, 0x14, 0x1b, 0x06, 0x97, 0xae, 0xec, 0xca, 0x75, 0xeb, 0xca, 0x4d, 0xc9, 0x4c, 0x62, 0x26,
-	0xd0, 0xc9, 0x19, 0x27, 0x69, 0x6d, 0xdf, 0xc2, 0x87, 0xf1, 0x21, 0x04, 0x37, 0xc5, 0x95, 0x4b,
-	0x69, 0x5f, 0x44, 0x26, 0xc9, 0x14, 0xbb, 0x19, 0xfe, 0xf3, 0xff, 0x1f, 0xf3, 0xe7, 0x70, 0xc2,
-	0x73, 0xb6, 0xd2, 0xa0, 0x8a, 0x12, 0x0c, 0xa4, 0x30, 0x23, 0xda, 0x40, 0x49, 0x05, 0x27, 0x8b,
-	0xb8, 0x96, 0x43, 0x9b, 0x45, 0x68, 0x8f, 0x1b, 0xd6, 0xe1, 0x22, 0xee, 0x75, 0x05, 0x08, 0xb0,
-	0x01, 0xa9, 0x94, 0xe3, 0x7b, 0x47, 0x02, 0x40, 0xcc, 0x38, 0xa1, 0x85, 0x24, 0x54, 0x29, 0x30,
-	0xd4, 0x48, 0x50, 0xda, 0xa7, 0x17, 0x29, 0xe8, 0x1c, 0x34, 0x49, 0xa8, 0xe6, 0xe4, 0x69, 0xce,
-	0xcb, 0x15, 0x59, 0xc4, 0x09, 0x37, 0x34, 0x26, 0x05, 0x15, 0x52, 0x59, 0xd8, 0xb3, 0x87, 0x8e,
-	0x9d, 0xba, 0x0a, 0x37, 0xf8, 0xa8, 0x43, 0x73, 0xa9, 0x80, 0xd8, 0xaf, 0xb7, 0x8e, 0x7d, 0xaf,
-	0x9d, 0x92, 0xf9, 0x23, 0x31, 0x32, 0xe7, 0xda, 0xd0, 0xbc, 0xf0, 0x00, 0xfe, 0x59, 0x5d, 0x97,
-	0xa6, 0x20, 0x7d, 0xdd, 0xe9, 0x7b, 0x10, 0xfe, 0x99, 0xb8, 0xed, 0xa2, 0x61, 0xd8, 0x82, 0x67,
-	0xc5, 0x4b, 0x14, 0xf4, 0x83, 0xc1, 0xdf, 0x11, 0xfa, 0x78, 0xbd, 0xea, 0xfa, 0x07, 0xdc, 0x32,
-	0x56, 0x72, 0xad, 0x27, 0xa6, 0x94, 0x4a, 0x8c, 0x1d, 0x16, 0x75, 0xc3, 0x96, 0x54, 0x8c, 0x2f,
-	0xd1, 0xaf, 0x8a, 0x1f, 0xbb, 0x21, 0x8a, 0xc2, 0x26, 0xa3, 0x86, 0xa2, 0xdf, 0xd6, 0xb4, 0x3a,
-	0x3a, 0x0b, 0xff, 0xcf, 0x0b, 0x46, 0x0d, 0x67, 0xd3, 0x8c, 0x4b, 0x91, 0x19, 0xd4, 0xec, 0x07,
-	0x83, 0xe6, 0xf8, 0x9f, 0x77, 0xef, 0xac, 0x19, 0x5d, 0x86, 0x9d, 0x1a, 0xdb, 0xed, 0x81, 0x5a,
-	0xf6, 0x3f, 0x07, 0x3e, 0xb8, 0xaf, 0xfd, 0xaa, 0x27, 0xa3, 0x3a, 0x43, 0x6d, 0xd7, 0x53, 0xe9,
-	0xd1, 0xcd, 0xdb, 0x06, 0x07, 0xeb, 0x0d, 0x0e, 0xbe, 0x36, 0x38, 0x78, 0xd9, 0xe2, 0xc6, 0x7a,
-	0x8b, 0x1b, 0x9f, 0x5b, 0xdc, 0x78, 0x38, 0xd9, 0x3f, 0x68, 0x0a, 0x39, 0x59, 0xee, 0xce, 0x6f,
-	0x56, 0x05, 0xd7, 0x49, 0xdb, 0xa6, 0xd7, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x0d, 0xb5,
-	0x15, 0x24, 0x02, 0x00, 0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xb1, 0x6e, 0xdb, 0x48,
+	0x10, 0x15, 0x2d, 0xc9, 0xb2, 0x56, 0x92, 0x71, 0x26, 0x5c, 0xac, 0x8d, 0x3b, 0x59, 0xd6, 0xc1,
+	0x07, 0xc1, 0x07, 0x8b, 0xd0, 0x1d, 0xae, 0xba, 0xca, 0xbe, 0xc6, 0x57, 0x25, 0xa0, 0xec, 0x14,
+	0x69, 0x88, 0xa5, 0x38, 0x26, 0x37, 0x96, 0x76, 0x19, 0xee, 0x4a, 0x91, 0xfc, 0x0b, 0x69, 0xf2,
+	0x19, 0x41, 0xaa, 0x14, 0xf9, 0x08, 0x97, 0x46, 0x8a, 0xc0, 0x55, 0x12, 0xd8, 0x45, 0xca, 0xfc,
+	0x42, 0xb0, 0xc3, 0xa5, 0x61, 0x07, 0x69, 0x12, 0x24, 0x48, 0x23, 0xcd, 0xbc, 0x79, 0xbb, 0x33,
+	0xf3, 0x66, 0xb8, 0xe4, 0x8f, 0x68, 0xa1, 0xa4, 0x48, 0x33, 0xa9, 0xe5, 0x48, 0x8e, 0x3d, 0xa5,
+	0x65, 0xc6, 0x62, 0xf0, 0x66, 0x83, 0xc2, 0xec, 0x63, 0xcc, 0xa5, 0x77, 0x78, 0xfd, 0x22, 0x38,
+	0x1b, 0x6c, 0xae, 0xc7, 0x32, 0x96, 0x18, 0xf0, 0x8c, 0x95, 0xf3, 0x37, 0x7f, 0x8d, 0xa5, 0x8c,
+	0xc7, 0xe0, 0xb1, 0x94, 0x7b, 0x4c, 0x08, 0xa9, 0x99, 0xe6, 0x52, 0x28, 0x1b, 0xdd, 0x1d, 0x49,
+	0x35, 0x91, 0xca, 0x0b, 0x99, 0x02, 0xef, 0xf1, 0x14, 0xb2, 0x85, 0x37, 0x1b, 0x84, 0xa0, 0xd9,
+	0xc0, 0x4b, 0x59, 0xcc, 0x05, 0x92, 0x2d, 0x77, 0x23, 0xe7, 0x06, 0x79, 0x8a, 0xdc, 0xb1, 0xa1,
+	0x35, 0x36, 0xe1, 0x42, 0x7a, 0xf8, 0x6b, 0xa1, 0x2d, 0x9b, 0x17, 0xbd, 0x70, 0x7a, 0xe2, 0x69,
+	0x3e, 0x01, 0xa5, 0xd9, 0x24, 0xb5, 0x84, 0xf6, 0xed, 0xd4, 0x45, 0xd2, 0x91, 0xe4, 0x36, 0x5d,
+	0xf7, 0x63, 0x99, 0xd4, 0x86, 0x79, 0x77, 0x6e, 0x9f, 0x54, 0xe5, 0x13, 0x01, 0x19, 0x75, 0x3a,
+	0x4e, 0xaf, 0x7e, 0x40, 0x5f, 0xbf, 0xda, 0x5b, 0xb7, 0x05, 0xec, 0x47, 0x51, 0x06, 0x4a, 0x0d,
+	0x75, 0xc6, 0x45, 0xec, 0xe7, 0x34, 0x77, 0x9d, 0x54, 0xb9, 0x88, 0x60, 0x4e, 0x97, 0x0c, 0xdf,
+	0xcf, 0x1d, 0xd7, 0x25, 0x95, 0x88, 0x69, 0x46, 0xcb, 0x08, 0xa2, 0xed, 0xee, 0x90, 0xd5, 0x69,
+	0x1a, 0x31, 0x0d, 0x51, 0x90, 0x00, 0x8f, 0x13, 0x4d, 0x2b, 0x1d, 0xa7, 0x57, 0xf1, 0x5b, 0x16,
+	0x3d, 0x44, 0xd0, 0xfd, 0x93, 0xac, 0x15, 0xb4, 0x9b, 0x3e, 0x68, 0x15, 0xef, 0xf9, 0xc5, 0x06,
+	0x8e, 0x0a, 0xdc, 0xe4, 0x49, 0x98, 0x4a, 0xe8, 0x72, 0x9e, 0xc7, 0xd8, 0xee, 0x23, 0x52, 0x8b,
+	0x20, 0x95, 0x8a, 0x6b, 0x5a, 0xeb, 0x94, 0x7b, 0x8d, 0xbf, 0x36, 0xfa, 0xb6, 0x01, 0xd3, 0x7f,
+	0xdf, 0xf6, 0xdf, 0xff, 0x4f, 0x72, 0x71, 0xf0, 0xcf, 0xf9, 0xdb, 0xad, 0xd2, 0x8b, 0x77, 0x5b,
+	0xbd, 0x98, 0xeb, 0x64, 0x1a, 0xf6, 0x47, 0x72, 0x62, 0xe5, 0xb6, 0x7f, 0x7b, 0x2a, 0x3a, 0xf5,
+	0xf4, 0x22, 0x05, 0x85, 0x07, 0xd4, 0xf3, 0x0f, 0x2f, 0x77, 0x1d, 0xbf, 0x48, 0xe0, 0x6e, 0x93,
+	0xe6, 0x48, 0x0a, 0x0d, 0x42, 0x07, 0x86, 0x44, 0x57, 0xb0, 0x8e, 0x86, 0xc5, 0x8e, 0x16, 0x29,
+	0x98, 0x12, 0xc3, 0xb1, 0x0c, 0x69, 0xbd, 0xe3, 0xf4, 0x9a, 0x3e, 0xda, 0xee, 0xef, 0xa4, 0x75,
+	0x0a, 0x90, 0x06, 0x33, 0xc8, 0x94, 0x59, 0x11, 0x4a, 0x3a, 0x4e, 0xaf, 0xe5, 0x37, 0x0d, 0xf8,
+	0xc0, 0x62, 0x2e, 0x25, 0x35, 0x1b, 0xa7, 0x0d, 0x14, 0xaa, 0x70, 0x4d, 0xd6, 0x84, 0x9b, 0x75,
+	0x5c, 0x04, 0x8a, 0x9f, 0x01, 0x6d, 0x62, 0xb8, 0x61, 0xb1, 0x21, 0x3f, 0x03, 0xf7, 0x37, 0x42,
+	0x60, 0x9e, 0xf2, 0x0c, 0x54, 0xc0, 0x34, 0x6d, 0x75, 0x9c, 0x5e, 0xd9, 0xaf, 0x5b, 0x64, 0x5f,
+	0x77, 0xdf, 0x2c, 0x91, 0x96, 0x9d, 0xf8, 0x71, 0x3a, 0x96, 0x2c, 0xfa, 0x4e, 0x73, 0xff, 0x5c,
+	0x8f, 0xf2, 0x17, 0xf5, 0xc0, 0xa2, 0xf3, 0xe1, 0xa3, 0x8d, 0xc7, 0x92, 0xa9, 0x38, 0x0d, 0xcc,
+	0x00, 0x41, 0xd1, 0x6a, 0xa7, 0x8c, 0xc7, 0x0c, 0x76, 0x88, 0xd0, 0x4f, 0x9f, 0xf4, 0x0e, 0x59,
+	0x1d, 0x65, 0x70, 0x7b, 0x7b, 0x57, 0xf2, 0xed, 0xb5, 0x68, 0xbe, 0xbd, 0xdd, 0xa7, 0x0e, 0x59,
+	0x1d, 0xc2, 0x48, 0x8a, 0x88, 0x65, 0x8b, 0xff, 0x51, 0x93, 0xaf, 0x55, 0xd6, 0x25, 0x15, 0xc1,
+	0x26, 0x60, 0x85, 0x45, 0xdb, 0x60, 0x29, 0xd3, 0x49, 0xf1, 0x3d, 0x19, 0xdb, 0x88, 0x86, 0xa2,
+	0x07, 0x69, 0x06, 0x27, 0x7c, 0x8e, 0x82, 0xd6, 0xfd, 0x06, 0x62, 0xf7, 0x11, 0xea, 0x5e, 0x3a,
+	0x84, 0xdc, 0x33, 0x97, 0x1e, 0xab, 0x6f, 0xfc, 0xb6, 0xc3, 0x85, 0x06, 0x85, 0xa5, 0x54, 0xfc,
+	0xdc, 0x31, 0x7b, 0x09, 0x42, 0x67, 0x1c, 0x14, 0x96, 0x53, 0xf1, 0x0b, 0xf7, 0xf6, 0x3c, 0x2a,
+	0x3f, 0x78, 0x1e, 0x07, 0xff, 0x9e, 0x5f, 0xb5, 0x9d, 0x8b, 0xab, 0xb6, 0xf3, 0xfe, 0xaa, 0xed,
+	0x3c, 0xbb, 0x6e, 0x97, 0x2e, 0xae, 0xdb, 0xa5, 0xcb, 0xeb, 0x76, 0xe9, 0xe1, 0xf6, 0xdd, 0x67,
+	0xdb, 0x5c, 0x3c, 0xbf, 0x79, 0xe4, 0xf1, 0xc2, 0x70, 0x19, 0xa3, 0x7f, 0x7f, 0x0a, 0x00, 0x00,
+	0xff, 0xff, 0x83, 0xff, 0x4d, 0x31, 0x0a, 0x06, 0x00, 0x00,
 }
 
 func (m *Storage) Marshal() (dAtA []byte, err error) {
@@ -176,6 +530,54 @@ func (m *Storage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.HistorySize != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.HistorySize))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Version != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.KeepVersions != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.KeepVersions))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Blob) > 0 {
+		i -= len(m.Blob)
+		copy(dAtA[i:], m.Blob)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Blob)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Hash) > 0 {
 		i -= len(m.Hash)
 		copy(dAtA[i:], m.Hash)
@@ -216,59 +618,1054 @@ func (m *Storage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageUpload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageUpload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageUpload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChunkHashes) > 0 {
+		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChunkHashes[iNdEx])
+			copy(dAtA[i:], m.ChunkHashes[iNdEx])
+			i = encodeVarintStorage(dAtA, i, uint64(len(m.ChunkHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecondaryIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecondaryIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecondaryIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IndexPrefix) > 0 {
+		i -= len(m.IndexPrefix)
+		copy(dAtA[i:], m.IndexPrefix)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.IndexPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStorage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Entries != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Entries))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Bytes != 0 {
+		i = encodeVarintStorage(dAtA, i, uint64(m.Bytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStorage(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStorage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Storage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovStorage(uint64(m.UpdatedHeight))
+	}
+	l = len(m.UpdatedTimestamp)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Blob)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.KeepVersions != 0 {
+		n += 1 + sovStorage(uint64(m.KeepVersions))
+	}
+	if m.Version != 0 {
+		n += 1 + sovStorage(uint64(m.Version))
+	}
+	if m.HistorySize != 0 {
+		n += 1 + sovStorage(uint64(m.HistorySize))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovStorage(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *StorageUpload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovStorage(uint64(m.Size_))
+	}
+	if len(m.ChunkHashes) > 0 {
+		for _, s := range m.ChunkHashes {
+			l = len(s)
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovStorage(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *SecondaryIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	l = len(m.IndexPrefix)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	return n
+}
+
+func (m *OwnerUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStorage(uint64(l))
+	}
+	if m.Bytes != 0 {
+		n += 1 + sovStorage(uint64(m.Bytes))
+	}
+	if m.Entries != 0 {
+		n += 1 + sovStorage(uint64(m.Entries))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovStorage(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStorage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStorage(x uint64) (n int) {
+	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Storage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTimestamp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedTimestamp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blob = append(m.Blob[:0], dAtA[iNdEx:postIndex]...)
+			if m.Blob == nil {
+				m.Blob = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepVersions", wireType)
+			}
+			m.KeepVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistorySize", wireType)
+			}
+			m.HistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageUpload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageUpload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageUpload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkHashes = append(m.ChunkHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintStorage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStorage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Storage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	if m.UpdatedHeight != 0 {
-		n += 1 + sovStorage(uint64(m.UpdatedHeight))
-	}
-	l = len(m.UpdatedTimestamp)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
-	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovStorage(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovStorage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStorage(x uint64) (n int) {
-	return sovStorage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Storage) Unmarshal(dAtA []byte) error {
+func (m *SecondaryIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -291,10 +1688,10 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Storage: wiretype end group for non-group")
+			return fmt.Errorf("proto: SecondaryIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Storage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SecondaryIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -331,7 +1728,7 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -359,11 +1756,11 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -391,13 +1788,13 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrefix", wireType)
 			}
-			m.UpdatedHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -407,14 +1804,77 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdatedHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStorage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStorage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStorage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStorage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -442,13 +1902,51 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedTimestamp = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			m.Bytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			m.Entries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStorage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Entries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStorage
@@ -458,23 +1956,25 @@ func (m *Storage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStorage
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStorage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -39,6 +41,22 @@ type MsgStorageSet struct {
 	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 	// The data to set for the storage entry.
 	Data string `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	// The optional MIME type of the storage entry.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The binary content to set for the storage entry, instead of data.
+	Blob []byte `protobuf:"bytes,5,opt,name=blob,proto3" json:"blob,omitempty"`
+	// The optional hash the current entry must have for the write to happen,
+	// for compare-and-swap updates.
+	ExpectedHash string `protobuf:"bytes,6,opt,name=expected_hash,json=expectedHash,proto3" json:"expected_hash,omitempty"`
+	// If true, the write only happens if the entry does not exist yet.
+	IfNotExists bool `protobuf:"varint,7,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	// The number of previous versions of the entry to retain, 0 to retain none.
+	// Every write sets the retention of the entry.
+	KeepVersions uint32 `protobuf:"varint,8,opt,name=keep_versions,json=keepVersions,proto3" json:"keep_versions,omitempty"`
+	// The optional unix timestamp after which the entry is deleted and its
+	// deposit refunded, 0 for no expiry. Every write sets the expiry of the
+	// entry.
+	ExpiresAt int64 `protobuf:"varint,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
 func (m *MsgStorageSet) Reset()         { *m = MsgStorageSet{} }
@@ -95,8 +113,54 @@ func (m *MsgStorageSet) GetData() string {
 	return ""
 }
 
+func (m *MsgStorageSet) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *MsgStorageSet) GetBlob() []byte {
+	if m != nil {
+		return m.Blob
+	}
+	return nil
+}
+
+func (m *MsgStorageSet) GetExpectedHash() string {
+	if m != nil {
+		return m.ExpectedHash
+	}
+	return ""
+}
+
+func (m *MsgStorageSet) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
+func (m *MsgStorageSet) GetKeepVersions() uint32 {
+	if m != nil {
+		return m.KeepVersions
+	}
+	return 0
+}
+
+func (m *MsgStorageSet) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 // MsgStorageSetResponse is the response for setting a storage entry.
 type MsgStorageSetResponse struct {
+	// The deposit now held for the entry
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// The version of the entry written
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *MsgStorageSetResponse) Reset()         { *m = MsgStorageSetResponse{} }
@@ -132,6 +196,20 @@ func (m *MsgStorageSetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStorageSetResponse proto.InternalMessageInfo
 
+func (m *MsgStorageSetResponse) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *MsgStorageSetResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // MsgStorageDelete is the message for deleting storage entries.
 type MsgStorageDelete struct {
 	// The owner of the storage entries to delete.
@@ -191,6 +269,8 @@ func (m *MsgStorageDelete) GetIndexes() []string {
 type MsgStorageDeleteResponse struct {
 	// List of indexes that were successfully deleted
 	DeletedIndexes []string `protobuf:"bytes,1,rep,name=deleted_indexes,json=deletedIndexes,proto3" json:"deleted_indexes,omitempty"`
+	// The deposit of the deleted entries refunded to the owner
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
 }
 
 func (m *MsgStorageDeleteResponse) Reset()         { *m = MsgStorageDeleteResponse{} }
@@ -233,384 +313,3946 @@ func (m *MsgStorageDeleteResponse) GetDeletedIndexes() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgStorageSet)(nil), "dysonprotocol.storage.v1.MsgStorageSet")
-	proto.RegisterType((*MsgStorageSetResponse)(nil), "dysonprotocol.storage.v1.MsgStorageSetResponse")
-	proto.RegisterType((*MsgStorageDelete)(nil), "dysonprotocol.storage.v1.MsgStorageDelete")
-	proto.RegisterType((*MsgStorageDeleteResponse)(nil), "dysonprotocol.storage.v1.MsgStorageDeleteResponse")
+func (m *MsgStorageDeleteResponse) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("dysonprotocol/storage/v1/tx.proto", fileDescriptor_fe2368d460e32dd8) }
-
-var fileDescriptor_fe2368d460e32dd8 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x65, 0xe0, 0xf1, 0x5e, 0xb8, 0x09, 0xef, 0x3d, 0x1b, 0x0c, 0x63, 0x17, 0x0d, 0xb2, 0x81,
-	0x90, 0xd8, 0x09, 0xb8, 0xd3, 0x95, 0xe8, 0xc6, 0x05, 0x9b, 0xb2, 0x73, 0x43, 0x2a, 0x33, 0x8e,
-	0x24, 0xb4, 0x43, 0x3a, 0x23, 0xc2, 0xce, 0xf8, 0x05, 0x7e, 0x0a, 0x0b, 0x3f, 0xc2, 0x25, 0x71,
-	0xe5, 0xd2, 0x40, 0x0c, 0xbf, 0x61, 0x98, 0x69, 0xd1, 0x9a, 0x98, 0xe0, 0xa6, 0x99, 0x7b, 0xce,
-	0xe9, 0x39, 0xa7, 0xd3, 0x0b, 0xfb, 0x74, 0x2a, 0x45, 0x38, 0x8a, 0x84, 0x12, 0x7d, 0x31, 0x24,
-	0x52, 0x89, 0xc8, 0xe7, 0x8c, 0x8c, 0x9b, 0x44, 0x4d, 0x5c, 0x0d, 0x5b, 0x38, 0x25, 0x71, 0x63,
-	0x89, 0x3b, 0x6e, 0xda, 0x25, 0x2e, 0xb8, 0xd0, 0x04, 0x59, 0x9f, 0x8c, 0xde, 0xde, 0xeb, 0x0b,
-	0x19, 0x08, 0xd9, 0x33, 0x84, 0x19, 0x62, 0xaa, 0x6c, 0x26, 0x12, 0x48, 0xbe, 0x8e, 0x08, 0x24,
-	0x8f, 0x89, 0x1d, 0x3f, 0x18, 0x84, 0x82, 0xe8, 0xa7, 0x81, 0xaa, 0x37, 0x50, 0xec, 0x48, 0xde,
-	0x35, 0x69, 0x5d, 0xa6, 0x2c, 0x17, 0xf2, 0xe2, 0x36, 0x64, 0x11, 0x46, 0x15, 0x54, 0x2f, 0xb4,
-	0xf1, 0xf3, 0xe3, 0x41, 0x29, 0x76, 0x3f, 0xa1, 0x34, 0x62, 0x52, 0x76, 0x55, 0x34, 0x08, 0xb9,
-	0x67, 0x64, 0x56, 0x09, 0xf2, 0x83, 0x90, 0xb2, 0x09, 0xce, 0xae, 0xf5, 0x9e, 0x19, 0x2c, 0x0b,
-	0x7e, 0x51, 0x5f, 0xf9, 0x38, 0xa7, 0x41, 0x7d, 0x3e, 0x82, 0xfb, 0xd5, 0xac, 0x61, 0xde, 0xaa,
-	0x96, 0x61, 0x37, 0x15, 0xeb, 0x31, 0x39, 0x12, 0xa1, 0x64, 0xd5, 0x6b, 0xf8, 0xff, 0x41, 0x9c,
-	0xb1, 0x21, 0x53, 0xec, 0xc7, 0x95, 0x30, 0xfc, 0xd1, 0x2d, 0x98, 0xc4, 0xd9, 0x4a, 0xae, 0x5e,
-	0xf0, 0x92, 0x31, 0x55, 0xe1, 0x14, 0xf0, 0xd7, 0xa4, 0xa4, 0x85, 0x55, 0x83, 0x7f, 0x54, 0x23,
-	0xb4, 0x97, 0x38, 0x21, 0xed, 0xf4, 0x37, 0x86, 0xcf, 0x0d, 0xda, 0x7a, 0x43, 0x90, 0xeb, 0x48,
-	0x6e, 0x5d, 0x01, 0x7c, 0xba, 0xc3, 0x9a, 0xfb, 0xdd, 0xcf, 0x74, 0x53, 0x5f, 0x6d, 0x93, 0x2d,
-	0x85, 0x9b, 0x62, 0x02, 0x8a, 0xe9, 0xbb, 0x69, 0x6c, 0xe3, 0x60, 0xb4, 0x76, 0x6b, 0x7b, 0x6d,
-	0x12, 0x68, 0xe7, 0xef, 0x56, 0xb3, 0x06, 0x6a, 0x1f, 0x3f, 0x2d, 0x1c, 0x34, 0x5f, 0x38, 0xe8,
-	0x75, 0xe1, 0xa0, 0x87, 0xa5, 0x93, 0x99, 0x2f, 0x9d, 0xcc, 0xcb, 0xd2, 0xc9, 0x5c, 0xa4, 0x57,
-	0xdb, 0xed, 0x8b, 0x80, 0x4c, 0x36, 0x0b, 0xae, 0xa6, 0x23, 0x26, 0x2f, 0x7f, 0x6b, 0xf6, 0xf0,
-	0x3d, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x05, 0xe7, 0x48, 0x06, 0x03, 0x00, 0x00,
+// MsgStorageUploadBegin begins a chunked upload to a storage entry.
+type MsgStorageUploadBegin struct {
+	// The owner of the storage entry to upload.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The index of the storage entry to upload.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// The MIME type of the content.
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// The size in bytes of the whole content.
+	Size_ uint64 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	// The "sha256-<base64>" hashes of the chunks, in order.
+	ChunkHashes []string `protobuf:"bytes,5,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// The "sha256-<base64>" hash of the whole content.
+	Hash string `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgStorageUploadBegin) Reset()         { *m = MsgStorageUploadBegin{} }
+func (m *MsgStorageUploadBegin) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadBegin) ProtoMessage()    {}
+func (*MsgStorageUploadBegin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{4}
+}
+func (m *MsgStorageUploadBegin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageUploadBegin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadBegin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStorageUploadBegin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadBegin.Merge(m, src)
+}
+func (m *MsgStorageUploadBegin) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadBegin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadBegin.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgStorageUploadBegin proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Sets a storage entry. Only the owner can set.
-	StorageSet(ctx context.Context, in *MsgStorageSet, opts ...grpc.CallOption) (*MsgStorageSetResponse, error)
-	// Deletes storage entries. Only the owner can delete.
-	StorageDelete(ctx context.Context, in *MsgStorageDelete, opts ...grpc.CallOption) (*MsgStorageDeleteResponse, error)
+func (m *MsgStorageUploadBegin) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgStorageUploadBegin) GetIndex() string {
+	if m != nil {
+		return m.Index
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgStorageUploadBegin) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
 }
 
-func (c *msgClient) StorageSet(ctx context.Context, in *MsgStorageSet, opts ...grpc.CallOption) (*MsgStorageSetResponse, error) {
-	out := new(MsgStorageSetResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageSet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgStorageUploadBegin) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) StorageDelete(ctx context.Context, in *MsgStorageDelete, opts ...grpc.CallOption) (*MsgStorageDeleteResponse, error) {
-	out := new(MsgStorageDeleteResponse)
-	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageDelete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgStorageUploadBegin) GetChunkHashes() []string {
+	if m != nil {
+		return m.ChunkHashes
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Sets a storage entry. Only the owner can set.
-	StorageSet(context.Context, *MsgStorageSet) (*MsgStorageSetResponse, error)
-	// Deletes storage entries. Only the owner can delete.
-	StorageDelete(context.Context, *MsgStorageDelete) (*MsgStorageDeleteResponse, error)
+func (m *MsgStorageUploadBegin) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgStorageUploadBeginResponse is the response for beginning a chunked upload.
+type MsgStorageUploadBeginResponse struct {
+	// The deposit held for the upload
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
-func (*UnimplementedMsgServer) StorageSet(ctx context.Context, req *MsgStorageSet) (*MsgStorageSetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageSet not implemented")
+func (m *MsgStorageUploadBeginResponse) Reset()         { *m = MsgStorageUploadBeginResponse{} }
+func (m *MsgStorageUploadBeginResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadBeginResponse) ProtoMessage()    {}
+func (*MsgStorageUploadBeginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{5}
 }
-func (*UnimplementedMsgServer) StorageDelete(ctx context.Context, req *MsgStorageDelete) (*MsgStorageDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StorageDelete not implemented")
+func (m *MsgStorageUploadBeginResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgStorageUploadBeginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadBeginResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStorageUploadBeginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadBeginResponse.Merge(m, src)
+}
+func (m *MsgStorageUploadBeginResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadBeginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadBeginResponse.DiscardUnknown(m)
 }
 
-func _Msg_StorageSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStorageSet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).StorageSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageSet",
+var xxx_messageInfo_MsgStorageUploadBeginResponse proto.InternalMessageInfo
+
+func (m *MsgStorageUploadBeginResponse) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StorageSet(ctx, req.(*MsgStorageSet))
+	return nil
+}
+
+// MsgStorageUploadChunk uploads one chunk of a chunked upload.
+type MsgStorageUploadChunk struct {
+	// The owner of the upload.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The index of the storage entry being uploaded.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	// The 0-based position of the chunk in the manifest.
+	ChunkIndex uint32 `protobuf:"varint,3,opt,name=chunk_index,json=chunkIndex,proto3" json:"chunk_index,omitempty"`
+	// The content of the chunk.
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgStorageUploadChunk) Reset()         { *m = MsgStorageUploadChunk{} }
+func (m *MsgStorageUploadChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadChunk) ProtoMessage()    {}
+func (*MsgStorageUploadChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{6}
+}
+func (m *MsgStorageUploadChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageUploadChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgStorageUploadChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadChunk.Merge(m, src)
+}
+func (m *MsgStorageUploadChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadChunk.DiscardUnknown(m)
 }
 
-func _Msg_StorageDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgStorageDelete)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgStorageUploadChunk proto.InternalMessageInfo
+
+func (m *MsgStorageUploadChunk) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).StorageDelete(ctx, in)
+	return ""
+}
+
+func (m *MsgStorageUploadChunk) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageDelete",
+	return ""
+}
+
+func (m *MsgStorageUploadChunk) GetChunkIndex() uint32 {
+	if m != nil {
+		return m.ChunkIndex
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).StorageDelete(ctx, req.(*MsgStorageDelete))
+	return 0
+}
+
+func (m *MsgStorageUploadChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dysonprotocol.storage.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "StorageSet",
-			Handler:    _Msg_StorageSet_Handler,
-		},
-		{
-			MethodName: "StorageDelete",
-			Handler:    _Msg_StorageDelete_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dysonprotocol/storage/v1/tx.proto",
+// MsgStorageUploadChunkResponse is the response for uploading a chunk.
+type MsgStorageUploadChunkResponse struct {
+	// The number of chunks of the upload still missing
+	ChunksMissing uint32 `protobuf:"varint,1,opt,name=chunks_missing,json=chunksMissing,proto3" json:"chunks_missing,omitempty"`
 }
 
-func (m *MsgStorageSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStorageUploadChunkResponse) Reset()         { *m = MsgStorageUploadChunkResponse{} }
+func (m *MsgStorageUploadChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadChunkResponse) ProtoMessage()    {}
+func (*MsgStorageUploadChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{7}
+}
+func (m *MsgStorageUploadChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageUploadChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgStorageUploadChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadChunkResponse.Merge(m, src)
+}
+func (m *MsgStorageUploadChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadChunkResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStorageSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgStorageUploadChunkResponse proto.InternalMessageInfo
+
+func (m *MsgStorageUploadChunkResponse) GetChunksMissing() uint32 {
+	if m != nil {
+		return m.ChunksMissing
+	}
+	return 0
 }
 
-func (m *MsgStorageSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Index) > 0 {
-		i -= len(m.Index)
-		copy(dAtA[i:], m.Index)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
-		i--
-		dAtA[i] = 0x12
+// MsgStorageUploadCommit commits a chunked upload to its storage entry.
+type MsgStorageUploadCommit struct {
+	// The owner of the upload.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The index of the storage entry being uploaded.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *MsgStorageUploadCommit) Reset()         { *m = MsgStorageUploadCommit{} }
+func (m *MsgStorageUploadCommit) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadCommit) ProtoMessage()    {}
+func (*MsgStorageUploadCommit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{8}
+}
+func (m *MsgStorageUploadCommit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageUploadCommit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadCommit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgStorageUploadCommit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadCommit.Merge(m, src)
+}
+func (m *MsgStorageUploadCommit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadCommit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadCommit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStorageUploadCommit proto.InternalMessageInfo
+
+func (m *MsgStorageUploadCommit) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgStorageSetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStorageUploadCommit) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStorageSetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgStorageUploadCommitResponse is the response for committing an upload.
+type MsgStorageUploadCommitResponse struct {
+	// The deposit now held for the entry
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
 }
 
-func (m *MsgStorageSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgStorageUploadCommitResponse) Reset()         { *m = MsgStorageUploadCommitResponse{} }
+func (m *MsgStorageUploadCommitResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadCommitResponse) ProtoMessage()    {}
+func (*MsgStorageUploadCommitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{9}
+}
+func (m *MsgStorageUploadCommitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageUploadCommitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadCommitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStorageUploadCommitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadCommitResponse.Merge(m, src)
+}
+func (m *MsgStorageUploadCommitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadCommitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadCommitResponse.DiscardUnknown(m)
 }
 
-func (m *MsgStorageDelete) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgStorageUploadCommitResponse proto.InternalMessageInfo
+
+func (m *MsgStorageUploadCommitResponse) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgStorageDelete) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgStorageUploadCancel cancels a chunked upload.
+type MsgStorageUploadCancel struct {
+	// The owner of the upload.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The index of the storage entry being uploaded.
+	Index string `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *MsgStorageDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Indexes) > 0 {
-		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Indexes[iNdEx])
-			copy(dAtA[i:], m.Indexes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Indexes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgStorageUploadCancel) Reset()         { *m = MsgStorageUploadCancel{} }
+func (m *MsgStorageUploadCancel) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadCancel) ProtoMessage()    {}
+func (*MsgStorageUploadCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{10}
+}
+func (m *MsgStorageUploadCancel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageUploadCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadCancel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgStorageUploadCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadCancel.Merge(m, src)
+}
+func (m *MsgStorageUploadCancel) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadCancel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStorageUploadCancel proto.InternalMessageInfo
+
+func (m *MsgStorageUploadCancel) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgStorageDeleteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgStorageUploadCancel) GetIndex() string {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgStorageDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgStorageUploadCancelResponse is the response for cancelling an upload.
+type MsgStorageUploadCancelResponse struct {
+	// The deposit of the upload refunded to the owner
+	Refunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=refunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded"`
 }
 
-func (m *MsgStorageDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeletedIndexes) > 0 {
-		for iNdEx := len(m.DeletedIndexes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DeletedIndexes[iNdEx])
-			copy(dAtA[i:], m.DeletedIndexes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedIndexes[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *MsgStorageUploadCancelResponse) Reset()         { *m = MsgStorageUploadCancelResponse{} }
+func (m *MsgStorageUploadCancelResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageUploadCancelResponse) ProtoMessage()    {}
+func (*MsgStorageUploadCancelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{11}
+}
+func (m *MsgStorageUploadCancelResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageUploadCancelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageUploadCancelResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgStorageUploadCancelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageUploadCancelResponse.Merge(m, src)
+}
+func (m *MsgStorageUploadCancelResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageUploadCancelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageUploadCancelResponse.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgStorageUploadCancelResponse proto.InternalMessageInfo
+
+func (m *MsgStorageUploadCancelResponse) GetRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Refunded
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgStorageSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Index)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+// MsgStorageCreateSecondaryIndex is the message for creating a secondary index.
+type MsgStorageCreateSecondaryIndex struct {
+	// The owner of the indexed entries.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The name of the index.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The JSON path of the indexed value, for example "$.category".
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The optional prefix of the indexes of the indexed entries.
+	IndexPrefix string `protobuf:"bytes,4,opt,name=index_prefix,json=indexPrefix,proto3" json:"index_prefix,omitempty"`
+}
+
+func (m *MsgStorageCreateSecondaryIndex) Reset()         { *m = MsgStorageCreateSecondaryIndex{} }
+func (m *MsgStorageCreateSecondaryIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageCreateSecondaryIndex) ProtoMessage()    {}
+func (*MsgStorageCreateSecondaryIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{12}
+}
+func (m *MsgStorageCreateSecondaryIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageCreateSecondaryIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageCreateSecondaryIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgStorageCreateSecondaryIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageCreateSecondaryIndex.Merge(m, src)
+}
+func (m *MsgStorageCreateSecondaryIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageCreateSecondaryIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageCreateSecondaryIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStorageCreateSecondaryIndex proto.InternalMessageInfo
+
+func (m *MsgStorageCreateSecondaryIndex) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return n
+	return ""
 }
 
-func (m *MsgStorageSetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStorageCreateSecondaryIndex) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgStorageDelete) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStorageCreateSecondaryIndex) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgStorageCreateSecondaryIndex) GetIndexPrefix() string {
+	if m != nil {
+		return m.IndexPrefix
 	}
-	if len(m.Indexes) > 0 {
-		for _, s := range m.Indexes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgStorageCreateSecondaryIndexResponse is the response for creating a
+// secondary index.
+type MsgStorageCreateSecondaryIndexResponse struct {
+	// The number of existing entries indexed
+	Indexed uint64 `protobuf:"varint,1,opt,name=indexed,proto3" json:"indexed,omitempty"`
+}
+
+func (m *MsgStorageCreateSecondaryIndexResponse) Reset() {
+	*m = MsgStorageCreateSecondaryIndexResponse{}
+}
+func (m *MsgStorageCreateSecondaryIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageCreateSecondaryIndexResponse) ProtoMessage()    {}
+func (*MsgStorageCreateSecondaryIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{13}
+}
+func (m *MsgStorageCreateSecondaryIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageCreateSecondaryIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageCreateSecondaryIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStorageCreateSecondaryIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageCreateSecondaryIndexResponse.Merge(m, src)
+}
+func (m *MsgStorageCreateSecondaryIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageCreateSecondaryIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageCreateSecondaryIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStorageCreateSecondaryIndexResponse proto.InternalMessageInfo
+
+func (m *MsgStorageCreateSecondaryIndexResponse) GetIndexed() uint64 {
+	if m != nil {
+		return m.Indexed
+	}
+	return 0
+}
+
+// MsgStorageDeleteSecondaryIndex is the message for deleting a secondary index.
+type MsgStorageDeleteSecondaryIndex struct {
+	// The owner of the index.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// The name of the index.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgStorageDeleteSecondaryIndex) Reset()         { *m = MsgStorageDeleteSecondaryIndex{} }
+func (m *MsgStorageDeleteSecondaryIndex) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageDeleteSecondaryIndex) ProtoMessage()    {}
+func (*MsgStorageDeleteSecondaryIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{14}
+}
+func (m *MsgStorageDeleteSecondaryIndex) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageDeleteSecondaryIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageDeleteSecondaryIndex.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStorageDeleteSecondaryIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageDeleteSecondaryIndex.Merge(m, src)
+}
+func (m *MsgStorageDeleteSecondaryIndex) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageDeleteSecondaryIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageDeleteSecondaryIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStorageDeleteSecondaryIndex proto.InternalMessageInfo
+
+func (m *MsgStorageDeleteSecondaryIndex) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgStorageDeleteSecondaryIndex) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgStorageDeleteSecondaryIndexResponse is the response for deleting a
+// secondary index.
+type MsgStorageDeleteSecondaryIndexResponse struct {
+}
+
+func (m *MsgStorageDeleteSecondaryIndexResponse) Reset() {
+	*m = MsgStorageDeleteSecondaryIndexResponse{}
+}
+func (m *MsgStorageDeleteSecondaryIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStorageDeleteSecondaryIndexResponse) ProtoMessage()    {}
+func (*MsgStorageDeleteSecondaryIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{15}
+}
+func (m *MsgStorageDeleteSecondaryIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStorageDeleteSecondaryIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStorageDeleteSecondaryIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStorageDeleteSecondaryIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStorageDeleteSecondaryIndexResponse.Merge(m, src)
+}
+func (m *MsgStorageDeleteSecondaryIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStorageDeleteSecondaryIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStorageDeleteSecondaryIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStorageDeleteSecondaryIndexResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless
+	// overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/storage parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{16}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe2368d460e32dd8, []int{17}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgStorageSet)(nil), "dysonprotocol.storage.v1.MsgStorageSet")
+	proto.RegisterType((*MsgStorageSetResponse)(nil), "dysonprotocol.storage.v1.MsgStorageSetResponse")
+	proto.RegisterType((*MsgStorageDelete)(nil), "dysonprotocol.storage.v1.MsgStorageDelete")
+	proto.RegisterType((*MsgStorageDeleteResponse)(nil), "dysonprotocol.storage.v1.MsgStorageDeleteResponse")
+	proto.RegisterType((*MsgStorageUploadBegin)(nil), "dysonprotocol.storage.v1.MsgStorageUploadBegin")
+	proto.RegisterType((*MsgStorageUploadBeginResponse)(nil), "dysonprotocol.storage.v1.MsgStorageUploadBeginResponse")
+	proto.RegisterType((*MsgStorageUploadChunk)(nil), "dysonprotocol.storage.v1.MsgStorageUploadChunk")
+	proto.RegisterType((*MsgStorageUploadChunkResponse)(nil), "dysonprotocol.storage.v1.MsgStorageUploadChunkResponse")
+	proto.RegisterType((*MsgStorageUploadCommit)(nil), "dysonprotocol.storage.v1.MsgStorageUploadCommit")
+	proto.RegisterType((*MsgStorageUploadCommitResponse)(nil), "dysonprotocol.storage.v1.MsgStorageUploadCommitResponse")
+	proto.RegisterType((*MsgStorageUploadCancel)(nil), "dysonprotocol.storage.v1.MsgStorageUploadCancel")
+	proto.RegisterType((*MsgStorageUploadCancelResponse)(nil), "dysonprotocol.storage.v1.MsgStorageUploadCancelResponse")
+	proto.RegisterType((*MsgStorageCreateSecondaryIndex)(nil), "dysonprotocol.storage.v1.MsgStorageCreateSecondaryIndex")
+	proto.RegisterType((*MsgStorageCreateSecondaryIndexResponse)(nil), "dysonprotocol.storage.v1.MsgStorageCreateSecondaryIndexResponse")
+	proto.RegisterType((*MsgStorageDeleteSecondaryIndex)(nil), "dysonprotocol.storage.v1.MsgStorageDeleteSecondaryIndex")
+	proto.RegisterType((*MsgStorageDeleteSecondaryIndexResponse)(nil), "dysonprotocol.storage.v1.MsgStorageDeleteSecondaryIndexResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "dysonprotocol.storage.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dysonprotocol.storage.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("dysonprotocol/storage/v1/tx.proto", fileDescriptor_fe2368d460e32dd8) }
+
+var fileDescriptor_fe2368d460e32dd8 = []byte{
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc4, 0xce, 0x0f, 0xbf, 0xd8, 0xed, 0xf7, 0xbb, 0x04, 0xba, 0x71, 0x55, 0xc7, 0x5d,
+	0x54, 0x6a, 0x22, 0x75, 0x4d, 0x82, 0x80, 0xaa, 0x48, 0x88, 0x3a, 0x80, 0xda, 0x43, 0x50, 0xb5,
+	0xa1, 0x1c, 0xb8, 0xac, 0xd6, 0xde, 0xf1, 0x7a, 0x1b, 0xef, 0xce, 0x6a, 0x67, 0x12, 0x6c, 0x0e,
+	0x08, 0x38, 0x02, 0x42, 0x48, 0x9c, 0x38, 0xc0, 0x15, 0xc4, 0x29, 0x48, 0x5c, 0xfa, 0x1f, 0xf4,
+	0x58, 0x71, 0x81, 0x13, 0xa0, 0xe4, 0x90, 0x7f, 0x03, 0xed, 0x9b, 0xdd, 0x8d, 0x37, 0xdd, 0x18,
+	0xbb, 0x4a, 0xd4, 0x4b, 0x32, 0xf3, 0x99, 0xf7, 0xe3, 0xf3, 0x3e, 0x33, 0xfb, 0x66, 0x0c, 0x57,
+	0xed, 0x21, 0x67, 0x7e, 0x10, 0x32, 0xc1, 0x3a, 0xac, 0xdf, 0xe4, 0x82, 0x85, 0x96, 0x43, 0x9b,
+	0x7b, 0xeb, 0x4d, 0x31, 0xd0, 0x11, 0x56, 0xd4, 0x8c, 0x89, 0x1e, 0x9b, 0xe8, 0x7b, 0xeb, 0xd5,
+	0x65, 0x87, 0x39, 0x0c, 0x17, 0x9a, 0xd1, 0x48, 0xda, 0x57, 0x57, 0x3a, 0x8c, 0x7b, 0x8c, 0x9b,
+	0x72, 0x41, 0x4e, 0xe2, 0xa5, 0x4b, 0x72, 0xd6, 0xf4, 0xb8, 0x13, 0xa5, 0xf0, 0xb8, 0x13, 0x2f,
+	0xfc, 0xdf, 0xf2, 0x5c, 0x9f, 0x35, 0xf1, 0x6f, 0x0c, 0xd5, 0x62, 0xdb, 0xb6, 0xc5, 0x23, 0x3e,
+	0x6d, 0x2a, 0xac, 0xf5, 0x66, 0x87, 0xb9, 0x7e, 0xbc, 0x7e, 0xed, 0x54, 0xe6, 0x81, 0x15, 0x5a,
+	0x5e, 0x9c, 0x52, 0x7b, 0x38, 0x0b, 0x95, 0x2d, 0xee, 0x6c, 0xcb, 0xe5, 0x6d, 0x2a, 0x14, 0x1d,
+	0xe6, 0xd8, 0xc7, 0x3e, 0x0d, 0x55, 0x52, 0x27, 0x8d, 0x52, 0x4b, 0xfd, 0xfd, 0xb7, 0x1b, 0xcb,
+	0x31, 0xcb, 0xdb, 0xb6, 0x1d, 0x52, 0xce, 0xb7, 0x45, 0xe8, 0xfa, 0x8e, 0x21, 0xcd, 0x94, 0x65,
+	0x98, 0x73, 0x7d, 0x9b, 0x0e, 0xd4, 0xd9, 0xc8, 0xde, 0x90, 0x13, 0x45, 0x81, 0xa2, 0x6d, 0x09,
+	0x4b, 0x2d, 0x20, 0x88, 0x63, 0xe5, 0x2a, 0x94, 0x3b, 0xcc, 0x17, 0xd4, 0x17, 0xa6, 0x18, 0x06,
+	0x54, 0x2d, 0xe2, 0xda, 0x52, 0x8c, 0x7d, 0x30, 0x0c, 0x68, 0xe4, 0xd6, 0xee, 0xb3, 0xb6, 0x3a,
+	0x57, 0x27, 0x8d, 0xb2, 0x81, 0x63, 0xe5, 0x45, 0xa8, 0xd0, 0x41, 0x40, 0x3b, 0x82, 0xda, 0x66,
+	0xcf, 0xe2, 0x3d, 0x75, 0x1e, 0xfd, 0xca, 0x09, 0x78, 0xc7, 0xe2, 0x3d, 0x45, 0x83, 0x8a, 0xdb,
+	0x35, 0x7d, 0x26, 0x4c, 0x3a, 0x70, 0xb9, 0xe0, 0xea, 0x42, 0x9d, 0x34, 0x16, 0x8d, 0x25, 0xb7,
+	0xfb, 0x3e, 0x13, 0xef, 0x22, 0x14, 0x05, 0xda, 0xa1, 0x34, 0x30, 0xf7, 0x68, 0xc8, 0x5d, 0xe6,
+	0x73, 0x75, 0xb1, 0x4e, 0x1a, 0x15, 0xa3, 0x1c, 0x81, 0x1f, 0xc6, 0x98, 0x72, 0x05, 0x80, 0x0e,
+	0x02, 0x37, 0xa4, 0xdc, 0xb4, 0x84, 0x5a, 0xaa, 0x93, 0x46, 0xc1, 0x28, 0xc5, 0xc8, 0x6d, 0x71,
+	0x0b, 0xbe, 0x38, 0xda, 0x5f, 0x93, 0x95, 0x6b, 0x3f, 0x10, 0x78, 0x3e, 0xa3, 0x9d, 0x41, 0x79,
+	0xc0, 0x7c, 0x4e, 0x95, 0x07, 0xb0, 0x60, 0xd3, 0x80, 0x71, 0x57, 0xa8, 0xa4, 0x5e, 0x68, 0x2c,
+	0x6d, 0xac, 0xe8, 0xb1, 0x84, 0xd1, 0x76, 0xe9, 0xf1, 0x76, 0xe9, 0x9b, 0xcc, 0xf5, 0x5b, 0xaf,
+	0x3d, 0xfa, 0x6b, 0x75, 0xe6, 0x97, 0xbf, 0x57, 0x1b, 0x8e, 0x2b, 0x7a, 0xbb, 0x6d, 0xbd, 0xc3,
+	0xbc, 0xf8, 0x54, 0xc4, 0xff, 0x6e, 0x70, 0x7b, 0xa7, 0x19, 0x09, 0xc6, 0xd1, 0x81, 0xff, 0x7c,
+	0xb4, 0xbf, 0x46, 0x8c, 0x24, 0x81, 0xa2, 0xc2, 0x42, 0x5c, 0x10, 0xee, 0x40, 0xd1, 0x48, 0xa6,
+	0x5a, 0x0f, 0xfe, 0x77, 0x4c, 0xef, 0x1d, 0xda, 0xa7, 0x82, 0x4e, 0xbd, 0xbb, 0x2a, 0x2c, 0xe0,
+	0x86, 0x52, 0xae, 0xce, 0xd6, 0x0b, 0x8d, 0x92, 0x91, 0x4c, 0x33, 0x4a, 0xfc, 0x4a, 0x40, 0x3d,
+	0x99, 0x2a, 0x15, 0xe3, 0x3a, 0x5c, 0xb4, 0x11, 0xb1, 0xcd, 0x24, 0x14, 0xc1, 0x50, 0x17, 0x62,
+	0xf8, 0xae, 0x44, 0x95, 0x3e, 0x2c, 0x86, 0xb4, 0xbb, 0xeb, 0xdb, 0xd4, 0xc6, 0x64, 0xe7, 0x21,
+	0x5b, 0x9a, 0x41, 0xfb, 0x23, 0xb3, 0x7b, 0xf7, 0x83, 0x3e, 0xb3, 0xec, 0x16, 0x75, 0x5c, 0xff,
+	0x8c, 0xbe, 0x80, 0x93, 0xa7, 0xbd, 0x90, 0x7b, 0xda, 0xb9, 0xfb, 0x89, 0xfc, 0x10, 0x8a, 0x06,
+	0x8e, 0xd1, 0xad, 0xb7, 0xeb, 0xef, 0xe0, 0x51, 0xa7, 0x5c, 0x9d, 0x43, 0xa9, 0x96, 0x10, 0xbb,
+	0x83, 0x50, 0xe4, 0x36, 0xf2, 0x1d, 0xe0, 0x38, 0xb3, 0x1b, 0x5f, 0x11, 0xb8, 0x92, 0x5b, 0xd9,
+	0xb3, 0x38, 0x9f, 0xda, 0x8f, 0x39, 0x3a, 0x6f, 0x46, 0xd5, 0x9c, 0x91, 0xce, 0xab, 0x20, 0xc5,
+	0x91, 0x87, 0x0b, 0x65, 0xae, 0x18, 0x80, 0xd0, 0xdd, 0x4c, 0x2b, 0x2a, 0xca, 0x9e, 0x12, 0x8d,
+	0x33, 0x72, 0xbd, 0xf7, 0xa4, 0x5a, 0xc8, 0x2f, 0x55, 0xeb, 0x1a, 0x5c, 0xc0, 0x70, 0xdc, 0xf4,
+	0x5c, 0xce, 0x5d, 0xdf, 0x41, 0xc2, 0x15, 0xa3, 0x22, 0xd1, 0x2d, 0x09, 0x6a, 0x0f, 0xe0, 0x85,
+	0x27, 0xe2, 0x30, 0xcf, 0x73, 0xcf, 0xa8, 0xa5, 0x66, 0x38, 0x7f, 0x4d, 0xa0, 0x96, 0x9f, 0xec,
+	0x99, 0xec, 0x71, 0x5e, 0xe9, 0x96, 0xdf, 0xa1, 0xfd, 0x73, 0x28, 0xfd, 0x9b, 0xbc, 0xd2, 0x31,
+	0x59, 0x5a, 0xfa, 0x68, 0x23, 0x21, 0xe7, 0xde, 0x48, 0x7e, 0xca, 0x10, 0xda, 0x0c, 0xa9, 0x25,
+	0xe8, 0x36, 0xed, 0x30, 0xdf, 0xb6, 0xc2, 0xa1, 0x3c, 0x82, 0xd3, 0xaa, 0xa0, 0x40, 0xd1, 0xb7,
+	0x3c, 0x1a, 0x8b, 0x80, 0xe3, 0x08, 0x0b, 0x2c, 0xd1, 0x4b, 0x6e, 0xd4, 0x68, 0x1c, 0x35, 0x0b,
+	0x14, 0xc8, 0x0c, 0x42, 0xda, 0x75, 0x07, 0xc9, 0x8d, 0x8a, 0xd8, 0x3d, 0x84, 0x32, 0xd2, 0xb5,
+	0xe0, 0xa5, 0xf1, 0x44, 0x53, 0x05, 0xd3, 0xb6, 0x6f, 0x23, 0xe5, 0x62, 0xd2, 0xf6, 0x6d, 0x2d,
+	0x18, 0x2d, 0x56, 0x76, 0xfa, 0xb3, 0x2f, 0x36, 0xc3, 0xba, 0x31, 0xca, 0x3a, 0x2f, 0x63, 0xc2,
+	0x5a, 0x7b, 0x48, 0xe0, 0xe2, 0x16, 0x77, 0xee, 0x07, 0xb6, 0x25, 0xe8, 0x3d, 0x7c, 0xe6, 0x28,
+	0xaf, 0x43, 0xc9, 0xda, 0x15, 0x3d, 0x16, 0xba, 0x62, 0xf8, 0x9f, 0x8c, 0x8e, 0x4d, 0x95, 0xb7,
+	0x60, 0x5e, 0x3e, 0x94, 0x90, 0xd7, 0xd2, 0x46, 0x5d, 0x3f, 0xed, 0x9d, 0xa7, 0xcb, 0x4c, 0xad,
+	0x62, 0x74, 0x90, 0x8c, 0xd8, 0xeb, 0x96, 0x1e, 0x55, 0x70, 0x1c, 0xef, 0xcb, 0xa3, 0xfd, 0xb5,
+	0xcb, 0xf6, 0x90, 0xa7, 0x0f, 0xb1, 0x13, 0x3c, 0xb5, 0x15, 0xb8, 0x74, 0x02, 0x4a, 0xca, 0xda,
+	0xf8, 0xae, 0x04, 0x85, 0x2d, 0xee, 0x28, 0x5d, 0x80, 0x91, 0x77, 0xda, 0xf5, 0xd3, 0x09, 0x65,
+	0x1e, 0x25, 0xd5, 0xe6, 0x84, 0x86, 0xe9, 0xe6, 0x33, 0xa8, 0x64, 0x1f, 0x0d, 0x6b, 0x93, 0x44,
+	0x90, 0xb6, 0xd5, 0x8d, 0xc9, 0x6d, 0xd3, 0x84, 0x9f, 0x82, 0x92, 0x73, 0x0d, 0x4f, 0xc4, 0x7b,
+	0xc4, 0xa1, 0xfa, 0xc6, 0x94, 0x0e, 0xa7, 0xe6, 0x97, 0xd7, 0xd3, 0x14, 0xf9, 0xd1, 0x61, 0x9a,
+	0xfc, 0xd9, 0x0b, 0xe6, 0x73, 0x02, 0xcf, 0xe5, 0xdd, 0x1b, 0xaf, 0x4c, 0x11, 0x10, 0x3d, 0xaa,
+	0x37, 0xa7, 0xf5, 0x18, 0xc3, 0x41, 0x36, 0xf0, 0x69, 0x38, 0xa0, 0xc7, 0x54, 0x1c, 0xb2, 0x7d,
+	0xfb, 0x7b, 0x02, 0x97, 0xc7, 0xb5, 0xd1, 0x89, 0x22, 0xe7, 0x79, 0x56, 0xdf, 0x7e, 0x5a, 0xcf,
+	0x3c, 0x6e, 0xb9, 0x5d, 0xef, 0xe6, 0xe4, 0xe7, 0xfe, 0x69, 0xb8, 0x8d, 0xeb, 0x7b, 0x4a, 0x1f,
+	0xca, 0x99, 0x9e, 0xf7, 0xf2, 0xd8, 0x88, 0xa3, 0xa6, 0xd5, 0xf5, 0x89, 0x4d, 0x93, 0x6c, 0xd5,
+	0xb9, 0xcf, 0xa2, 0x0b, 0xb0, 0xf5, 0xe6, 0xa3, 0x83, 0x1a, 0x79, 0x7c, 0x50, 0x23, 0xff, 0x1c,
+	0xd4, 0xc8, 0xb7, 0x87, 0xb5, 0x99, 0xc7, 0x87, 0xb5, 0x99, 0x3f, 0x0f, 0x6b, 0x33, 0x1f, 0x65,
+	0x7f, 0x34, 0xe3, 0x85, 0x3a, 0x48, 0xfb, 0x1e, 0x5e, 0xa4, 0xed, 0x79, 0x5c, 0x7d, 0xf5, 0xdf,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xdd, 0x3e, 0x8a, 0x60, 0x0f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Sets a storage entry. Only the owner can set.
+	StorageSet(ctx context.Context, in *MsgStorageSet, opts ...grpc.CallOption) (*MsgStorageSetResponse, error)
+	// Deletes storage entries. Only the owner can delete.
+	StorageDelete(ctx context.Context, in *MsgStorageDelete, opts ...grpc.CallOption) (*MsgStorageDeleteResponse, error)
+	// Begins a chunked upload of a large entry from its manifest.
+	StorageUploadBegin(ctx context.Context, in *MsgStorageUploadBegin, opts ...grpc.CallOption) (*MsgStorageUploadBeginResponse, error)
+	// Uploads one chunk of a chunked upload.
+	StorageUploadChunk(ctx context.Context, in *MsgStorageUploadChunk, opts ...grpc.CallOption) (*MsgStorageUploadChunkResponse, error)
+	// Commits a chunked upload once all its chunks are uploaded.
+	StorageUploadCommit(ctx context.Context, in *MsgStorageUploadCommit, opts ...grpc.CallOption) (*MsgStorageUploadCommitResponse, error)
+	// Cancels a chunked upload and refunds its deposit.
+	StorageUploadCancel(ctx context.Context, in *MsgStorageUploadCancel, opts ...grpc.CallOption) (*MsgStorageUploadCancelResponse, error)
+	// Creates a secondary index of the entries of the owner, indexing its
+	// existing entries.
+	StorageCreateSecondaryIndex(ctx context.Context, in *MsgStorageCreateSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageCreateSecondaryIndexResponse, error)
+	// Deletes a secondary index of the owner.
+	StorageDeleteSecondaryIndex(ctx context.Context, in *MsgStorageDeleteSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageDeleteSecondaryIndexResponse, error)
+	// UpdateParams defines a governance operation for updating the x/storage
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) StorageSet(ctx context.Context, in *MsgStorageSet, opts ...grpc.CallOption) (*MsgStorageSetResponse, error) {
+	out := new(MsgStorageSetResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageDelete(ctx context.Context, in *MsgStorageDelete, opts ...grpc.CallOption) (*MsgStorageDeleteResponse, error) {
+	out := new(MsgStorageDeleteResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageUploadBegin(ctx context.Context, in *MsgStorageUploadBegin, opts ...grpc.CallOption) (*MsgStorageUploadBeginResponse, error) {
+	out := new(MsgStorageUploadBeginResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageUploadBegin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageUploadChunk(ctx context.Context, in *MsgStorageUploadChunk, opts ...grpc.CallOption) (*MsgStorageUploadChunkResponse, error) {
+	out := new(MsgStorageUploadChunkResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageUploadChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageUploadCommit(ctx context.Context, in *MsgStorageUploadCommit, opts ...grpc.CallOption) (*MsgStorageUploadCommitResponse, error) {
+	out := new(MsgStorageUploadCommitResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageUploadCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageUploadCancel(ctx context.Context, in *MsgStorageUploadCancel, opts ...grpc.CallOption) (*MsgStorageUploadCancelResponse, error) {
+	out := new(MsgStorageUploadCancelResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageUploadCancel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageCreateSecondaryIndex(ctx context.Context, in *MsgStorageCreateSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageCreateSecondaryIndexResponse, error) {
+	out := new(MsgStorageCreateSecondaryIndexResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageCreateSecondaryIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) StorageDeleteSecondaryIndex(ctx context.Context, in *MsgStorageDeleteSecondaryIndex, opts ...grpc.CallOption) (*MsgStorageDeleteSecondaryIndexResponse, error) {
+	out := new(MsgStorageDeleteSecondaryIndexResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/StorageDeleteSecondaryIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dysonprotocol.storage.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Sets a storage entry. Only the owner can set.
+	StorageSet(context.Context, *MsgStorageSet) (*MsgStorageSetResponse, error)
+	// Deletes storage entries. Only the owner can delete.
+	StorageDelete(context.Context, *MsgStorageDelete) (*MsgStorageDeleteResponse, error)
+	// Begins a chunked upload of a large entry from its manifest.
+	StorageUploadBegin(context.Context, *MsgStorageUploadBegin) (*MsgStorageUploadBeginResponse, error)
+	// Uploads one chunk of a chunked upload.
+	StorageUploadChunk(context.Context, *MsgStorageUploadChunk) (*MsgStorageUploadChunkResponse, error)
+	// Commits a chunked upload once all its chunks are uploaded.
+	StorageUploadCommit(context.Context, *MsgStorageUploadCommit) (*MsgStorageUploadCommitResponse, error)
+	// Cancels a chunked upload and refunds its deposit.
+	StorageUploadCancel(context.Context, *MsgStorageUploadCancel) (*MsgStorageUploadCancelResponse, error)
+	// Creates a secondary index of the entries of the owner, indexing its
+	// existing entries.
+	StorageCreateSecondaryIndex(context.Context, *MsgStorageCreateSecondaryIndex) (*MsgStorageCreateSecondaryIndexResponse, error)
+	// Deletes a secondary index of the owner.
+	StorageDeleteSecondaryIndex(context.Context, *MsgStorageDeleteSecondaryIndex) (*MsgStorageDeleteSecondaryIndexResponse, error)
+	// UpdateParams defines a governance operation for updating the x/storage
+	// module parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) StorageSet(ctx context.Context, req *MsgStorageSet) (*MsgStorageSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageSet not implemented")
+}
+func (*UnimplementedMsgServer) StorageDelete(ctx context.Context, req *MsgStorageDelete) (*MsgStorageDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageDelete not implemented")
+}
+func (*UnimplementedMsgServer) StorageUploadBegin(ctx context.Context, req *MsgStorageUploadBegin) (*MsgStorageUploadBeginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadBegin not implemented")
+}
+func (*UnimplementedMsgServer) StorageUploadChunk(ctx context.Context, req *MsgStorageUploadChunk) (*MsgStorageUploadChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadChunk not implemented")
+}
+func (*UnimplementedMsgServer) StorageUploadCommit(ctx context.Context, req *MsgStorageUploadCommit) (*MsgStorageUploadCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadCommit not implemented")
+}
+func (*UnimplementedMsgServer) StorageUploadCancel(ctx context.Context, req *MsgStorageUploadCancel) (*MsgStorageUploadCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageUploadCancel not implemented")
+}
+func (*UnimplementedMsgServer) StorageCreateSecondaryIndex(ctx context.Context, req *MsgStorageCreateSecondaryIndex) (*MsgStorageCreateSecondaryIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageCreateSecondaryIndex not implemented")
+}
+func (*UnimplementedMsgServer) StorageDeleteSecondaryIndex(ctx context.Context, req *MsgStorageDeleteSecondaryIndex) (*MsgStorageDeleteSecondaryIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StorageDeleteSecondaryIndex not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_StorageSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageSet(ctx, req.(*MsgStorageSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageDelete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageDelete(ctx, req.(*MsgStorageDelete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageUploadBegin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadBegin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadBegin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageUploadBegin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadBegin(ctx, req.(*MsgStorageUploadBegin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageUploadChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadChunk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageUploadChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadChunk(ctx, req.(*MsgStorageUploadChunk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageUploadCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadCommit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageUploadCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadCommit(ctx, req.(*MsgStorageUploadCommit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageUploadCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageUploadCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageUploadCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageUploadCancel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageUploadCancel(ctx, req.(*MsgStorageUploadCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageCreateSecondaryIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageCreateSecondaryIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageCreateSecondaryIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageCreateSecondaryIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageCreateSecondaryIndex(ctx, req.(*MsgStorageCreateSecondaryIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_StorageDeleteSecondaryIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStorageDeleteSecondaryIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StorageDeleteSecondaryIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/StorageDeleteSecondaryIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StorageDeleteSecondaryIndex(ctx, req.(*MsgStorageDeleteSecondaryIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dysonprotocol.storage.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dysonprotocol.storage.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StorageSet",
+			Handler:    _Msg_StorageSet_Handler,
+		},
+		{
+			MethodName: "StorageDelete",
+			Handler:    _Msg_StorageDelete_Handler,
+		},
+		{
+			MethodName: "StorageUploadBegin",
+			Handler:    _Msg_StorageUploadBegin_Handler,
+		},
+		{
+			MethodName: "StorageUploadChunk",
+			Handler:    _Msg_StorageUploadChunk_Handler,
+		},
+		{
+			MethodName: "StorageUploadCommit",
+			Handler:    _Msg_StorageUploadCommit_Handler,
+		},
+		{
+			MethodName: "StorageUploadCancel",
+			Handler:    _Msg_StorageUploadCancel_Handler,
+		},
+		{
+			MethodName: "StorageCreateSecondaryIndex",
+			Handler:    _Msg_StorageCreateSecondaryIndex_Handler,
+		},
+		{
+			MethodName: "StorageDeleteSecondaryIndex",
+			Handler:    _Msg_StorageDeleteSecondaryIndex_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dysonprotocol/storage/v1/tx.proto",
+}
+
+func (m *MsgStorageSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.KeepVersions != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeepVersions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IfNotExists {
+		i--
+		if m.IfNotExists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ExpectedHash) > 0 {
+		i -= len(m.ExpectedHash)
+		copy(dAtA[i:], m.ExpectedHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Blob) > 0 {
+		i -= len(m.Blob)
+		copy(dAtA[i:], m.Blob)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Blob)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageSetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageSetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageDelete) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indexes) > 0 {
+		for iNdEx := len(m.Indexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Indexes[iNdEx])
+			copy(dAtA[i:], m.Indexes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Indexes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageDeleteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageDeleteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DeletedIndexes) > 0 {
+		for iNdEx := len(m.DeletedIndexes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeletedIndexes[iNdEx])
+			copy(dAtA[i:], m.DeletedIndexes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeletedIndexes[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadBegin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadBegin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadBegin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ChunkHashes) > 0 {
+		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChunkHashes[iNdEx])
+			copy(dAtA[i:], m.ChunkHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChunkHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadBeginResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadBeginResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadBeginResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ChunkIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChunkIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChunksMissing != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ChunksMissing))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadCommitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadCommitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadCommitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadCancel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadCancel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadCancel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Index) > 0 {
+		i -= len(m.Index)
+		copy(dAtA[i:], m.Index)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Index)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageUploadCancelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageUploadCancelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageUploadCancelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageCreateSecondaryIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageCreateSecondaryIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageCreateSecondaryIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IndexPrefix) > 0 {
+		i -= len(m.IndexPrefix)
+		copy(dAtA[i:], m.IndexPrefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IndexPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageCreateSecondaryIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageCreateSecondaryIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageCreateSecondaryIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Indexed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Indexed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageDeleteSecondaryIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageDeleteSecondaryIndex) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageDeleteSecondaryIndex) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStorageDeleteSecondaryIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStorageDeleteSecondaryIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStorageDeleteSecondaryIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgStorageSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Blob)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IfNotExists {
+		n += 2
+	}
+	if m.KeepVersions != 0 {
+		n += 1 + sovTx(uint64(m.KeepVersions))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *MsgStorageSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgStorageDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Indexes) > 0 {
+		for _, s := range m.Indexes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStorageDeleteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DeletedIndexes) > 0 {
+		for _, s := range m.DeletedIndexes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStorageUploadBegin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovTx(uint64(m.Size_))
+	}
+	if len(m.ChunkHashes) > 0 {
+		for _, s := range m.ChunkHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStorageUploadBeginResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStorageUploadChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ChunkIndex != 0 {
+		n += 1 + sovTx(uint64(m.ChunkIndex))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStorageUploadChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChunksMissing != 0 {
+		n += 1 + sovTx(uint64(m.ChunksMissing))
+	}
+	return n
+}
+
+func (m *MsgStorageUploadCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStorageUploadCommitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStorageUploadCancel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Index)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStorageUploadCancelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStorageCreateSecondaryIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IndexPrefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStorageCreateSecondaryIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Indexed != 0 {
+		n += 1 + sovTx(uint64(m.Indexed))
+	}
+	return n
+}
+
+func (m *MsgStorageDeleteSecondaryIndex) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStorageDeleteSecondaryIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgStorageSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blob = append(m.Blob[:0], dAtA[iNdEx:postIndex]...)
+			if m.Blob == nil {
+				m.Blob = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IfNotExists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IfNotExists = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepVersions", wireType)
+			}
+			m.KeepVersions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepVersions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageDelete) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageDelete: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageDeleteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageDeleteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedIndexes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedIndexes = append(m.DeletedIndexes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadBegin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadBegin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadBegin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChunkHashes = append(m.ChunkHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadBeginResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadBeginResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadBeginResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkIndex", wireType)
+			}
+			m.ChunkIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunkIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadChunkResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadChunkResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunksMissing", wireType)
+			}
+			m.ChunksMissing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChunksMissing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadCommitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadCommitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadCommitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadCancel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadCancel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadCancel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Index = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStorageUploadCancelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStorageUploadCancelResponse: wiretype end group for non-group")
 		}
-	}
-	return n
-}
-
-func (m *MsgStorageDeleteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.DeletedIndexes) > 0 {
-		for _, s := range m.DeletedIndexes {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStorageUploadCancelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgStorageSet) Unmarshal(dAtA []byte) error {
+func (m *MsgStorageCreateSecondaryIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -633,10 +4275,10 @@ func (m *MsgStorageSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStorageSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStorageCreateSecondaryIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStorageSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStorageCreateSecondaryIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -673,7 +4315,7 @@ func (m *MsgStorageSet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -701,11 +4343,11 @@ func (m *MsgStorageSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Index = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -733,7 +4375,39 @@ func (m *MsgStorageSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IndexPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -756,7 +4430,7 @@ func (m *MsgStorageSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStorageSetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStorageCreateSecondaryIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -779,12 +4453,31 @@ func (m *MsgStorageSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStorageSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStorageCreateSecondaryIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStorageSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStorageCreateSecondaryIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexed", wireType)
+			}
+			m.Indexed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Indexed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -806,7 +4499,7 @@ func (m *MsgStorageSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStorageDelete) Unmarshal(dAtA []byte) error {
+func (m *MsgStorageDeleteSecondaryIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -829,10 +4522,10 @@ func (m *MsgStorageDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStorageDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStorageDeleteSecondaryIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStorageDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStorageDeleteSecondaryIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -869,7 +4562,7 @@ func (m *MsgStorageDelete) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -897,7 +4590,7 @@ func (m *MsgStorageDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Indexes = append(m.Indexes, string(dAtA[iNdEx:postIndex]))
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -920,7 +4613,7 @@ func (m *MsgStorageDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgStorageDeleteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStorageDeleteSecondaryIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -943,15 +4636,65 @@ func (m *MsgStorageDeleteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgStorageDeleteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStorageDeleteSecondaryIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgStorageDeleteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStorageDeleteSecondaryIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeletedIndexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -979,7 +4722,40 @@ func (m *MsgStorageDeleteResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeletedIndexes = append(m.DeletedIndexes, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1002,6 +4778,56 @@ func (m *MsgStorageDeleteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0